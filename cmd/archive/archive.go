@@ -0,0 +1,247 @@
+package archive
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/id"
+)
+
+func New() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archive",
+		Short: "move old events to the archive",
+		Long: `moves old events, which are covered by snapshots, from the events table to the archive.
+Eventstore.Archive must be enabled on all ZITADEL processes before events are archived,
+otherwise the archived events are missing in their filters.
+The command refuses to run if Eventstore.Archive is not enabled in its own configuration.
+
+Snapshots are currently only stored for human users (Eventstore.SnapshotThreshold must be set),
+so only events of the user aggregates are archived.
+
+The views of the auth and admin api don't read the archive,
+only events already processed by all of their views are archived.
+These views must not be reset after events were archived, they would miss the archived events.`,
+	}
+	cmd.AddCommand(
+		newRun(),
+		newVerify(),
+	)
+	return cmd
+}
+
+var errArchiveDisabled = errors.New("Eventstore.Archive must be enabled on all ZITADEL processes before events are archived")
+
+type flags struct {
+	instanceID string
+	exportDir  string
+}
+
+func (f *flags) add(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.instanceID, "instance", "", "id of the instance, all instances if empty")
+	cmd.Flags().StringVar(&f.exportDir, "export-dir", "", "directory of the exported archives, an archive is written to <export-dir>/<instance id>/<archive id>.jsonl")
+}
+
+func newRun() *cobra.Command {
+	f := new(flags)
+	var (
+		olderThan time.Duration
+		bulk      uint64
+	)
+	cmd := &cobra.Command{
+		Use:     "run",
+		Short:   "archive the events older than the given duration",
+		Example: `run --older-than 8760h --export-dir /var/zitadel/archive`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if olderThan <= 0 {
+				return fmt.Errorf("--older-than must be greater than 0")
+			}
+			config := MustNewConfig(viper.GetViper())
+			es, err := start(config)
+			if err != nil {
+				return err
+			}
+			return run(cmd.Context(), es, f, time.Now().Add(-olderThan), bulk)
+		},
+	}
+	f.add(cmd)
+	cmd.Flags().DurationVar(&olderThan, "older-than", 0, "only events older than the duration are archived")
+	cmd.Flags().Uint64Var(&bulk, "bulk", 1000, "maximum amount of events per archive")
+	return cmd
+}
+
+func newVerify() *cobra.Command {
+	f := new(flags)
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "verify the archived events against the checksums computed while archiving",
+		Long: `verifies the count and checksum of every archive.
+If --export-dir is set, the exported files are verified as well.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := MustNewConfig(viper.GetViper())
+			es, err := start(config)
+			if err != nil {
+				return err
+			}
+			return verify(cmd.Context(), es, f)
+		},
+	}
+	f.add(cmd)
+	return cmd
+}
+
+func start(config *Config) (*eventstore.Eventstore, error) {
+	if config.Eventstore == nil || !config.Eventstore.Archive {
+		return nil, errArchiveDisabled
+	}
+	dbClient, err := database.Connect(config.Database, false)
+	if err != nil {
+		return nil, err
+	}
+	config.Eventstore.Client = dbClient
+	config.Eventstore.Notifications = false
+	return eventstore.Start(config.Eventstore)
+}
+
+func run(ctx context.Context, es *eventstore.Eventstore, f *flags, before time.Time, bulk uint64) error {
+	instanceIDs, err := instances(ctx, es, f.instanceID)
+	if err != nil {
+		return err
+	}
+	for _, instanceID := range instanceIDs {
+		var count uint64
+		for {
+			archiveID, err := id.SonyFlakeGenerator().Next()
+			if err != nil {
+				return err
+			}
+			archive, err := es.ArchiveEvents(ctx, archiveID, instanceID, before, bulk)
+			if err != nil {
+				return err
+			}
+			if archive == nil {
+				break
+			}
+			count += archive.EventCount
+			if f.exportDir != "" {
+				if err = export(ctx, es, f.exportDir, archive); err != nil {
+					return err
+				}
+			}
+			if archive.EventCount < bulk {
+				break
+			}
+		}
+		logging.WithFields("instance", instanceID, "events", count).Info("events archived")
+	}
+	return nil
+}
+
+func verify(ctx context.Context, es *eventstore.Eventstore, f *flags) error {
+	archives, err := es.Archives(ctx, f.instanceID)
+	if err != nil {
+		return err
+	}
+	var failed int
+	for _, archive := range archives {
+		err = verifyArchive(ctx, es, f.exportDir, archive)
+		if err != nil {
+			failed++
+			fmt.Printf("%s/%s: %v\n", archive.InstanceID, archive.ID, err)
+			continue
+		}
+		fmt.Printf("%s/%s: %d events ok\n", archive.InstanceID, archive.ID, archive.EventCount)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d archives are invalid", failed, len(archives))
+	}
+	return nil
+}
+
+func verifyArchive(ctx context.Context, es *eventstore.Eventstore, exportDir string, archive *repository.Archive) error {
+	if _, err := es.ArchivedEvents(ctx, archive); err != nil {
+		return err
+	}
+	if exportDir == "" {
+		return nil
+	}
+	events, err := readExport(exportFile(exportDir, archive))
+	if err != nil {
+		return err
+	}
+	return eventstore.VerifyArchive(archive, events)
+}
+
+func instances(ctx context.Context, es *eventstore.Eventstore, instanceID string) ([]string, error) {
+	if instanceID != "" {
+		return []string{instanceID}, nil
+	}
+	return es.InstanceIDs(ctx, eventstore.NewSearchQueryBuilder(eventstore.ColumnsInstanceIDs).AddQuery().ExcludedInstanceID("").Builder())
+}
+
+func exportFile(exportDir string, archive *repository.Archive) string {
+	return filepath.Join(exportDir, archive.InstanceID, archive.ID+".jsonl")
+}
+
+// export writes the events of the archive as json lines,
+// the events are verified before they are written
+func export(ctx context.Context, es *eventstore.Eventstore, exportDir string, archive *repository.Archive) (err error) {
+	events, err := es.ArchivedEvents(ctx, archive)
+	if err != nil {
+		return err
+	}
+	path := exportFile(exportDir, archive)
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		closeErr := file.Close()
+		if err == nil {
+			err = closeErr
+		}
+	}()
+	encoder := json.NewEncoder(file)
+	for _, event := range events {
+		if err = encoder.Encode(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readExport(path string) ([]*repository.Event, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	events := make([]*repository.Event, 0)
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		event := new(repository.Event)
+		if err = json.Unmarshal(scanner.Bytes(), event); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, scanner.Err()
+}
@@ -12,7 +12,6 @@ import (
 	caos_errs "github.com/zitadel/zitadel/internal/errors"
 
 	"github.com/zitadel/zitadel/internal/crypto"
-	cryptoDB "github.com/zitadel/zitadel/internal/crypto/database"
 	"github.com/zitadel/zitadel/internal/database"
 )
 
@@ -22,6 +21,7 @@ const (
 
 type Config struct {
 	Database database.Config
+	KMS      *KMSConfig
 }
 
 func New() *cobra.Command {
@@ -31,6 +31,7 @@ func New() *cobra.Command {
 	}
 	AddMasterKeyFlag(cmd)
 	cmd.AddCommand(newKey())
+	cmd.AddCommand(newRotate())
 	return cmd
 }
 
@@ -70,7 +71,7 @@ new -f keys.yaml key2=anotherkey`,
 			if err != nil {
 				return err
 			}
-			storage, err := keyStorage(config.Database, masterKey)
+			storage, err := keyStorage(config.Database, config.KMS, masterKey)
 			if err != nil {
 				return err
 			}
@@ -123,10 +124,10 @@ func openFile(fileName string) (io.Reader, error) {
 	return file, nil
 }
 
-func keyStorage(config database.Config, masterKey string) (crypto.KeyStorage, error) {
+func keyStorage(config database.Config, kms *KMSConfig, masterKey string) (crypto.KeyStorage, error) {
 	db, err := database.Connect(config, false)
 	if err != nil {
 		return nil, err
 	}
-	return cryptoDB.NewKeyStorage(db.DB, masterKey)
+	return NewKeyStorage(db.DB, kms, masterKey)
 }
@@ -0,0 +1,86 @@
+package key
+
+import (
+	"database/sql"
+
+	"github.com/spf13/viper"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	cryptoDB "github.com/zitadel/zitadel/internal/crypto/database"
+	"github.com/zitadel/zitadel/internal/crypto/pkcs11"
+	"github.com/zitadel/zitadel/internal/crypto/vault"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+)
+
+const (
+	KMSTypeVault  = "vault"
+	KMSTypePKCS11 = "pkcs11"
+
+	configKMSType = "KMS.Type"
+)
+
+// KMSConfig selects the key management system, which encrypts the encryption keys in the database.
+// If no Type is set, the encryption keys are encrypted with the masterkey.
+//
+// With the PKCS11 type, the keys of the encryption algorithms (e.g. OTP, SMTP) and optionally the token signing key
+// are stored in the HSM itself, other key material (e.g. the cookie keys) is stored in the database encrypted by the HSM.
+type KMSConfig struct {
+	Type   string
+	Vault  *vault.Config
+	PKCS11 *pkcs11.Config
+}
+
+func (c *KMSConfig) enabled() bool {
+	return c != nil && c.Type != ""
+}
+
+// NewKeyStorage returns the database storage of the encryption keys,
+// which are either encrypted by the configured KMS or by the masterkey
+func NewKeyStorage(client *sql.DB, config *KMSConfig, masterKey string) (crypto.KeyStorage, error) {
+	if !config.enabled() {
+		return cryptoDB.NewKeyStorage(client, masterKey)
+	}
+	if config.Type == KMSTypePKCS11 {
+		hsm, err := pkcs11.New(config.PKCS11)
+		if err != nil {
+			return nil, err
+		}
+		keyStorage, err := cryptoDB.NewKMSKeyStorage(client, hsm)
+		if err != nil {
+			return nil, err
+		}
+		return hsm.KeyStorage(keyStorage), nil
+	}
+	kms, err := newKMS(config)
+	if err != nil {
+		return nil, err
+	}
+	return cryptoDB.NewKMSKeyStorage(client, kms)
+}
+
+func newKMS(config *KMSConfig) (crypto.KMS, error) {
+	switch config.Type {
+	case KMSTypeVault:
+		return vault.NewTransit(config.Vault)
+	default:
+		return nil, caos_errs.ThrowInvalidArgumentf(nil, "KEY-Wc3rj", "unsupported kms type %q", config.Type)
+	}
+}
+
+// SigningKey returns the token signing key stored in the HSM,
+// nil if the signing keys are generated and stored in the database
+func SigningKey(keyStorage crypto.KeyStorage, config *KMSConfig) (*pkcs11.SigningKey, error) {
+	if !config.enabled() || config.Type != KMSTypePKCS11 || config.PKCS11 == nil || config.PKCS11.SigningKeyLabel == "" {
+		return nil, nil
+	}
+	hsmStorage, ok := keyStorage.(*pkcs11.KeyStorage)
+	if !ok {
+		return nil, caos_errs.ThrowInternal(nil, "KEY-Hs3mr", "key storage is not stored in the hsm")
+	}
+	return hsmStorage.SigningKey(config.PKCS11.SigningKeyLabel)
+}
+
+// kmsEnabled checks if the KMS is configured, in which case no masterkey is required
+func kmsEnabled() bool {
+	return viper.GetString(configKMSType) != ""
+}
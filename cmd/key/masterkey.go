@@ -33,6 +33,9 @@ func MasterKey(cmd *cobra.Command) (string, error) {
 	masterKeyFile, _ := cmd.Flags().GetString(flagMasterKey)
 	masterKeyFromArg, _ := cmd.Flags().GetString(flagMasterKeyArg)
 	masterKeyFromEnv, _ := cmd.Flags().GetBool(flagMasterKeyEnv)
+	if masterKeyFile == "" && masterKeyFromArg == "" && !masterKeyFromEnv && kmsEnabled() {
+		return "", nil
+	}
 	if err := checkSingleFlag(masterKeyFile, masterKeyFromArg, masterKeyFromEnv); err != nil {
 		return "", err
 	}
@@ -0,0 +1,154 @@
+package key
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/config/systemdefaults"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	flagDryRun = "dry-run"
+)
+
+type RotateConfig struct {
+	Database       database.Config
+	KMS            *KMSConfig
+	ExternalDomain string
+	ExternalPort   uint16
+	ExternalSecure bool
+	EncryptionKeys *rotateEncryptionKeyConfig
+}
+
+type rotateEncryptionKeyConfig struct {
+	IDPConfig *crypto.KeyConfig
+	OTP       *crypto.KeyConfig
+	SMS       *crypto.KeyConfig
+	SMTP      *crypto.KeyConfig
+	User      *crypto.KeyConfig
+}
+
+func newRotate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate [--dry-run]",
+		Short: "re-encrypt secrets with the current encryption keys",
+		Long: `re-encrypts all secrets (IdP client secrets, LDAP bind passwords, SMTP passwords, Twilio tokens, OTP secrets and the keys of the users' personal data),
+which are not encrypted with the current EncryptionKeyID of their EncryptionKeys configuration.
+Old key ids must remain in the DecryptionKeyIDs until no secret is reported as outdated anymore.
+Requirements:
+- cockroachdb`,
+		Example: `rotate --dry-run
+rotate`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := new(RotateConfig)
+			if err := viper.Unmarshal(config); err != nil {
+				return err
+			}
+			masterKey, err := MasterKey(cmd)
+			if err != nil {
+				return err
+			}
+			dryRun, _ := cmd.Flags().GetBool(flagDryRun)
+			return rotate(cmd.Context(), config, masterKey, dryRun)
+		},
+	}
+	cmd.Flags().Bool(flagDryRun, false, "only count the outdated secrets without re-encrypting them")
+	return cmd
+}
+
+func rotate(ctx context.Context, config *RotateConfig, masterKey string, dryRun bool) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	dbClient, err := database.Connect(config.Database, false)
+	if err != nil {
+		return err
+	}
+	keyStorage, err := NewKeyStorage(dbClient.DB, config.KMS, masterKey)
+	if err != nil {
+		return err
+	}
+	idpConfigEncryption, err := crypto.NewEncryptionAlgorithm(config.EncryptionKeys.IDPConfig, keyStorage)
+	if err != nil {
+		return err
+	}
+	otpEncryption, err := crypto.NewEncryptionAlgorithm(config.EncryptionKeys.OTP, keyStorage)
+	if err != nil {
+		return err
+	}
+	smsEncryption, err := crypto.NewEncryptionAlgorithm(config.EncryptionKeys.SMS, keyStorage)
+	if err != nil {
+		return err
+	}
+	smtpEncryption, err := crypto.NewEncryptionAlgorithm(config.EncryptionKeys.SMTP, keyStorage)
+	if err != nil {
+		return err
+	}
+	userEncryption, err := crypto.NewEncryptionAlgorithm(config.EncryptionKeys.User, keyStorage)
+	if err != nil {
+		return err
+	}
+	eventstoreClient, err := eventstore.Start(&eventstore.Config{
+		Client:                 dbClient,
+		PersonalDataEncryption: userEncryption,
+	})
+	if err != nil {
+		return err
+	}
+	commands, err := command.StartCommands(
+		eventstoreClient,
+		systemdefaults.SystemDefaults{},
+		nil,
+		nil,
+		nil,
+		config.ExternalDomain,
+		config.ExternalSecure,
+		config.ExternalPort,
+		idpConfigEncryption,
+		otpEncryption,
+		smtpEncryption,
+		smsEncryption,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		0,
+		0,
+		0,
+	)
+	if err != nil {
+		return err
+	}
+	summary, err := commands.ReencryptSecrets(ctx, nil, dryRun, func(progress *command.SecretReencryptionProgress) {
+		if progress.Outdated == 0 {
+			return
+		}
+		fmt.Printf("instance %s: %s: %d of %d outdated, %d re-encrypted, %d failed, %d skipped\n", progress.InstanceID, progress.Type, progress.Outdated, progress.Total, progress.Reencrypted, progress.Failed, progress.Skipped)
+	})
+	if err != nil {
+		return err
+	}
+	for _, progress := range summary {
+		fmt.Printf("%s: %d of %d outdated, %d re-encrypted, %d failed, %d skipped\n", progress.Type, progress.Outdated, progress.Total, progress.Reencrypted, progress.Failed, progress.Skipped)
+		keyIDs := make([]string, 0, len(progress.OutdatedKeyIDs))
+		for keyID := range progress.OutdatedKeyIDs {
+			keyIDs = append(keyIDs, keyID)
+		}
+		sort.Strings(keyIDs)
+		for _, keyID := range keyIDs {
+			fmt.Printf("  key %s: %d secrets\n", keyID, progress.OutdatedKeyIDs[keyID])
+		}
+	}
+	return nil
+}
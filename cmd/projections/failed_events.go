@@ -0,0 +1,98 @@
+package projections
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/zitadel/zitadel/cmd/key"
+	"github.com/zitadel/zitadel/internal/query/projection"
+)
+
+func newFailedEvents() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "failed-events",
+		Short: "retry or skip failed events of the projections",
+	}
+	cmd.AddCommand(
+		newRetryFailedEvents(),
+		newSkipFailedEvent(),
+	)
+	return cmd
+}
+
+func newRetryFailedEvents() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retry <projection name>",
+		Short: "retry skipped failed events of a projection",
+		Long: `reduces skipped failed events of the projection again,
+followed by the events of the same aggregate the projection already processed.
+Without --instance and --sequence all skipped failed events of the projection are retried in the order of their sequence.
+Failed events are removed as soon as their statements succeed.`,
+		Example: `retry projections.users8 --instance 840498034930840 --sequence 9823758`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			instanceID, _ := cmd.Flags().GetString("instance")
+			sequence, _ := cmd.Flags().GetUint64("sequence")
+			if (instanceID == "") != (sequence == 0) {
+				return errors.New("--instance and --sequence must be set together")
+			}
+			config := MustNewConfig(viper.GetViper())
+			masterKey, err := key.MasterKey(cmd)
+			if err != nil {
+				return err
+			}
+			ctx, err := prepare(cmd.Context(), config, masterKey)
+			if err != nil {
+				return err
+			}
+			if instanceID != "" {
+				return projection.RetryFailedEvent(ctx, args[0], instanceID, sequence)
+			}
+			failed, err := projection.RetryFailedEvents(ctx, args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Printf("%s: %d failed events failed again\n", args[0], failed)
+			return nil
+		},
+	}
+	cmd.Flags().String("instance", "", "id of the instance of the failed event")
+	cmd.Flags().Uint64("sequence", 0, "sequence of the failed event")
+	return cmd
+}
+
+func newSkipFailedEvent() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "skip <projection name>",
+		Short: "skip a failed event of a projection",
+		Long: `lets the projection continue with the next event and records the reason why the failed event was skipped.
+The projection skips the event as soon as it retries it.`,
+		Example: `skip projections.users8 --instance 840498034930840 --sequence 9823758 --reason "user was removed manually"`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			instanceID, _ := cmd.Flags().GetString("instance")
+			sequence, _ := cmd.Flags().GetUint64("sequence")
+			reason, _ := cmd.Flags().GetString("reason")
+			if instanceID == "" || sequence == 0 || reason == "" {
+				return errors.New("--instance, --sequence and --reason are required")
+			}
+			config := MustNewConfig(viper.GetViper())
+			masterKey, err := key.MasterKey(cmd)
+			if err != nil {
+				return err
+			}
+			ctx, err := prepare(cmd.Context(), config, masterKey)
+			if err != nil {
+				return err
+			}
+			return projection.SkipFailedEvent(ctx, args[0], instanceID, sequence, reason)
+		},
+	}
+	cmd.Flags().String("instance", "", "id of the instance of the failed event")
+	cmd.Flags().Uint64("sequence", 0, "sequence of the failed event")
+	cmd.Flags().String("reason", "", "reason why the failed event is skipped")
+	return cmd
+}
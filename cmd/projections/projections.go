@@ -0,0 +1,179 @@
+package projections
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/cmd/key"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/query/projection"
+)
+
+func New() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projections",
+		Short: "manage the projections",
+	}
+	key.AddMasterKeyFlag(cmd)
+	cmd.AddCommand(
+		newRebuild(),
+		newStatus(),
+		newAbort(),
+		newFailedEvents(),
+	)
+	return cmd
+}
+
+func newRebuild() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rebuild <projection name>",
+		Short: "rebuild a projection without downtime",
+		Long: `rebuilds the projection into shadow tables by reducing all events of the eventstore
+and replaces the tables of the projection as soon as the shadow tables caught up.
+Running ZITADEL instances keep serving the current state of the projection during the rebuild.
+The command returns after the rebuild has finished.`,
+		Example: `rebuild projections.users8`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := MustNewConfig(viper.GetViper())
+			masterKey, err := key.MasterKey(cmd)
+			if err != nil {
+				return err
+			}
+			return rebuild(cmd.Context(), config, masterKey, args[0])
+		},
+	}
+}
+
+func newStatus() *cobra.Command {
+	return &cobra.Command{
+		Use:     "status <projection name>",
+		Short:   "show the state of the latest rebuild of a projection",
+		Example: `status projections.users8`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := MustNewConfig(viper.GetViper())
+			masterKey, err := key.MasterKey(cmd)
+			if err != nil {
+				return err
+			}
+			ctx, err := prepare(cmd.Context(), config, masterKey)
+			if err != nil {
+				return err
+			}
+			rebuild, err := projection.RebuildStatus(ctx, args[0])
+			if err != nil {
+				return err
+			}
+			if rebuild.ID == "" {
+				fmt.Printf("%s: never rebuilt\n", rebuild.ProjectionName)
+				return nil
+			}
+			fmt.Printf("%s: rebuild %s %s (started %s, changed %s)\n", rebuild.ProjectionName, rebuild.ID, rebuildState(rebuild.State), rebuild.CreationDate, rebuild.ChangeDate)
+			if rebuild.Error != "" {
+				fmt.Printf("  error: %s\n", rebuild.Error)
+			}
+			return nil
+		},
+	}
+}
+
+func newAbort() *cobra.Command {
+	return &cobra.Command{
+		Use:     "abort <projection name>",
+		Short:   "abort the running rebuild of a projection",
+		Example: `abort projections.users8`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := MustNewConfig(viper.GetViper())
+			masterKey, err := key.MasterKey(cmd)
+			if err != nil {
+				return err
+			}
+			ctx, err := prepare(cmd.Context(), config, masterKey)
+			if err != nil {
+				return err
+			}
+			return projection.AbortRebuild(ctx, args[0])
+		},
+	}
+}
+
+func rebuild(ctx context.Context, config *Config, masterKey, projectionName string) error {
+	ctx, err := prepare(ctx, config, masterKey)
+	if err != nil {
+		return err
+	}
+	rebuildID, err := id.SonyFlakeGenerator().Next()
+	if err != nil {
+		return err
+	}
+	if err = projection.StartRebuild(ctx, projectionName, rebuildID); err != nil {
+		return err
+	}
+	logging.WithFields("projection", projectionName, "rebuild", rebuildID).Info("rebuild started")
+	err = projection.Rebuild(ctx, projectionName, rebuildID, func(done, total int) {
+		fmt.Printf("%s: %d of %d instances projected\n", projectionName, done, total)
+	})
+	if err != nil {
+		return err
+	}
+	logging.WithFields("projection", projectionName, "rebuild", rebuildID).Info("rebuild succeeded")
+	return nil
+}
+
+func prepare(ctx context.Context, config *Config, masterKey string) (context.Context, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	dbClient, err := database.Connect(config.Database, false)
+	if err != nil {
+		return nil, err
+	}
+	keyStorage, err := key.NewKeyStorage(dbClient.DB, config.KMS, masterKey)
+	if err != nil {
+		return nil, err
+	}
+	// the projections reduce the personal data of the users
+	personalDataEncryption, err := crypto.NewEncryptionAlgorithm(config.EncryptionKeys.User, keyStorage)
+	if err != nil {
+		return nil, err
+	}
+	config.Eventstore.Client = dbClient
+	config.Eventstore.PersonalDataEncryption = personalDataEncryption
+	// the projections only listen for notifications of pushed events while ZITADEL is running
+	config.Eventstore.Notifications = false
+	eventstoreClient, err := eventstore.Start(config.Eventstore)
+	if err != nil {
+		return nil, err
+	}
+	query.RegisterEventMappers(eventstoreClient)
+	if err = projection.Create(ctx, dbClient, eventstoreClient, config.Projections, nil, nil); err != nil {
+		return nil, err
+	}
+	return ctx, nil
+}
+
+func rebuildState(state handler.RebuildState) string {
+	switch state {
+	case handler.RebuildStateRunning:
+		return "running"
+	case handler.RebuildStateSucceeded:
+		return "succeeded"
+	case handler.RebuildStateFailed:
+		return "failed"
+	case handler.RebuildStateAborted:
+		return "aborted"
+	default:
+		return "unspecified"
+	}
+}
@@ -1,10 +1,12 @@
 package ready
 
 import (
+	"io"
 	"net"
 	"net/http"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -32,6 +34,10 @@ func ready(config *Config) bool {
 		return false
 	}
 	defer res.Body.Close()
-	logging.WithFields("status", res.StatusCode).Warn("ready check failed")
-	return res.StatusCode == 200
+	if res.StatusCode != http.StatusOK {
+		reason, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		logging.WithFields("status", res.StatusCode, "reason", strings.TrimSpace(string(reason))).Warn("ready check failed")
+		return false
+	}
+	return true
 }
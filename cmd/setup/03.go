@@ -9,11 +9,11 @@ import (
 
 	"golang.org/x/text/language"
 
+	"github.com/zitadel/zitadel/cmd/key"
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/config/systemdefaults"
 	"github.com/zitadel/zitadel/internal/crypto"
-	crypto_db "github.com/zitadel/zitadel/internal/crypto/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 )
@@ -30,6 +30,7 @@ type FirstInstance struct {
 	smtpEncryptionKey *crypto.KeyConfig
 	oidcEncryptionKey *crypto.KeyConfig
 	masterKey         string
+	kms               *key.KMSConfig
 	db                *sql.DB
 	es                *eventstore.Eventstore
 	defaults          systemdefaults.SystemDefaults
@@ -41,14 +42,14 @@ type FirstInstance struct {
 }
 
 func (mig *FirstInstance) Execute(ctx context.Context) error {
-	keyStorage, err := crypto_db.NewKeyStorage(mig.db, mig.masterKey)
+	keyStorage, err := key.NewKeyStorage(mig.db, mig.kms, mig.masterKey)
 	if err != nil {
 		return fmt.Errorf("cannot start key storage: %w", err)
 	}
 	if err = verifyKey(mig.userEncryptionKey, keyStorage); err != nil {
 		return err
 	}
-	userAlg, err := crypto.NewAESCrypto(mig.userEncryptionKey, keyStorage)
+	userAlg, err := crypto.NewEncryptionAlgorithm(mig.userEncryptionKey, keyStorage)
 	if err != nil {
 		return err
 	}
@@ -56,7 +57,7 @@ func (mig *FirstInstance) Execute(ctx context.Context) error {
 	if err = verifyKey(mig.smtpEncryptionKey, keyStorage); err != nil {
 		return err
 	}
-	smtpEncryption, err := crypto.NewAESCrypto(mig.smtpEncryptionKey, keyStorage)
+	smtpEncryption, err := crypto.NewEncryptionAlgorithm(mig.smtpEncryptionKey, keyStorage)
 	if err != nil {
 		return err
 	}
@@ -64,7 +65,7 @@ func (mig *FirstInstance) Execute(ctx context.Context) error {
 	if err = verifyKey(mig.oidcEncryptionKey, keyStorage); err != nil {
 		return err
 	}
-	oidcEncryption, err := crypto.NewAESCrypto(mig.oidcEncryptionKey, keyStorage)
+	oidcEncryption, err := crypto.NewEncryptionAlgorithm(mig.oidcEncryptionKey, keyStorage)
 	if err != nil {
 		return err
 	}
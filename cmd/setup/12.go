@@ -0,0 +1,26 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+)
+
+var (
+	//go:embed 12/12_events_archive.sql
+	createEventsArchive string
+)
+
+type EventsArchive struct {
+	dbClient *database.DB
+}
+
+func (mig *EventsArchive) Execute(ctx context.Context) error {
+	_, err := mig.dbClient.ExecContext(ctx, createEventsArchive)
+	return err
+}
+
+func (mig *EventsArchive) String() string {
+	return "12_events_archive"
+}
@@ -0,0 +1,61 @@
+CREATE TABLE IF NOT EXISTS eventstore.events_archive (
+	id UUID
+	, event_type TEXT NOT NULL
+	, aggregate_type TEXT NOT NULL
+	, aggregate_id TEXT NOT NULL
+	, aggregate_version TEXT NOT NULL
+	, event_sequence BIGINT NOT NULL
+	, previous_aggregate_sequence BIGINT
+	, previous_aggregate_type_sequence INT8
+	, creation_date TIMESTAMPTZ NOT NULL
+	, event_data JSONB
+	, editor_user TEXT NOT NULL
+	, editor_service TEXT NOT NULL
+	, resource_owner TEXT NOT NULL
+	, instance_id TEXT NOT NULL
+	, created_at TIMESTAMPTZ NOT NULL
+	, archive_id TEXT NOT NULL
+
+	, PRIMARY KEY (instance_id, event_sequence)
+);
+
+CREATE INDEX IF NOT EXISTS events_archive_aggregate ON eventstore.events_archive (aggregate_type, aggregate_id, instance_id);
+CREATE INDEX IF NOT EXISTS events_archive_archive_id ON eventstore.events_archive (archive_id);
+
+CREATE TABLE IF NOT EXISTS eventstore.archives (
+	id TEXT NOT NULL
+	, instance_id TEXT NOT NULL
+	, creation_date TIMESTAMPTZ NOT NULL
+	, event_count BIGINT NOT NULL
+	, min_sequence BIGINT NOT NULL
+	, max_sequence BIGINT NOT NULL
+	, checksum TEXT NOT NULL
+
+	, PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS eventstore.snapshots (
+	instance_id TEXT NOT NULL
+	, snapshot_type TEXT NOT NULL
+	, query_key TEXT NOT NULL
+	, aggregate_type TEXT NOT NULL
+	, aggregate_id TEXT NOT NULL
+	, resource_owner TEXT NOT NULL
+	, event_sequence BIGINT NOT NULL
+	, change_date TIMESTAMPTZ NOT NULL
+	, payload JSONB NOT NULL
+	, creation_date TIMESTAMPTZ NOT NULL DEFAULT now()
+
+	, PRIMARY KEY (instance_id, snapshot_type, query_key)
+);
+
+CREATE INDEX IF NOT EXISTS snapshots_aggregate ON eventstore.snapshots (instance_id, aggregate_type, aggregate_id);
+
+CREATE OR REPLACE VIEW eventstore.events_with_archive AS
+	SELECT id, event_type, aggregate_type, aggregate_id, aggregate_version, event_sequence, previous_aggregate_sequence, previous_aggregate_type_sequence
+		, creation_date, event_data, editor_user, editor_service, resource_owner, instance_id, created_at
+	FROM eventstore.events
+	UNION ALL
+	SELECT id, event_type, aggregate_type, aggregate_id, aggregate_version, event_sequence, previous_aggregate_sequence, previous_aggregate_type_sequence
+		, creation_date, event_data, editor_user, editor_service, resource_owner, instance_id, created_at
+	FROM eventstore.events_archive;
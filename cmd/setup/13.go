@@ -0,0 +1,26 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+)
+
+var (
+	//go:embed 13/13_personal_data_keys.sql
+	createPersonalDataKeys string
+)
+
+type PersonalDataKeys struct {
+	dbClient *database.DB
+}
+
+func (mig *PersonalDataKeys) Execute(ctx context.Context) error {
+	_, err := mig.dbClient.ExecContext(ctx, createPersonalDataKeys)
+	return err
+}
+
+func (mig *PersonalDataKeys) String() string {
+	return "13_personal_data_keys"
+}
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS eventstore.personal_data_keys (
+	instance_id TEXT NOT NULL
+	, subject_id TEXT NOT NULL
+	, key JSONB
+	, creation_date TIMESTAMPTZ NOT NULL DEFAULT now()
+	, destruction_date TIMESTAMPTZ
+
+	, PRIMARY KEY (instance_id, subject_id)
+);
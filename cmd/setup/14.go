@@ -0,0 +1,26 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+)
+
+var (
+	//go:embed 14/14_failed_events_history.sql
+	failedEventsHistory string
+)
+
+type FailedEventsHistory struct {
+	dbClient *database.DB
+}
+
+func (mig *FailedEventsHistory) Execute(ctx context.Context) error {
+	_, err := mig.dbClient.ExecContext(ctx, failedEventsHistory)
+	return err
+}
+
+func (mig *FailedEventsHistory) String() string {
+	return "14_failed_events_history"
+}
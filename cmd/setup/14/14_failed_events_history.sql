@@ -0,0 +1,3 @@
+ALTER TABLE projections.failed_events ADD COLUMN IF NOT EXISTS error_history JSONB;
+ALTER TABLE projections.failed_events ADD COLUMN IF NOT EXISTS skip_reason TEXT;
+ALTER TABLE projections.failed_events ADD COLUMN IF NOT EXISTS skipped_at TIMESTAMPTZ;
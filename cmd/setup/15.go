@@ -0,0 +1,26 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+)
+
+var (
+	//go:embed 15/15_token_authorization_details.sql
+	addTokenAuthorizationDetails string
+)
+
+type TokenAuthorizationDetails struct {
+	dbClient *database.DB
+}
+
+func (mig *TokenAuthorizationDetails) Execute(ctx context.Context) error {
+	_, err := mig.dbClient.ExecContext(ctx, addTokenAuthorizationDetails)
+	return err
+}
+
+func (mig *TokenAuthorizationDetails) String() string {
+	return "15_token_authorization_details"
+}
@@ -0,0 +1 @@
+ALTER TABLE auth.tokens ADD COLUMN IF NOT EXISTS authorization_details JSONB;
@@ -0,0 +1,26 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+)
+
+var (
+	//go:embed 16/16_token_level_of_assurance.sql
+	addTokenLevelOfAssurance string
+)
+
+type TokenLevelOfAssurance struct {
+	dbClient *database.DB
+}
+
+func (mig *TokenLevelOfAssurance) Execute(ctx context.Context) error {
+	_, err := mig.dbClient.ExecContext(ctx, addTokenLevelOfAssurance)
+	return err
+}
+
+func (mig *TokenLevelOfAssurance) String() string {
+	return "16_token_level_of_assurance"
+}
@@ -0,0 +1 @@
+ALTER TABLE auth.tokens ADD COLUMN IF NOT EXISTS level_of_assurance SMALLINT NOT NULL DEFAULT 0;
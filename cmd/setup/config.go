@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/viper"
 	"github.com/zitadel/logging"
 
+	"github.com/zitadel/zitadel/cmd/key"
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/config/hook"
@@ -28,6 +29,7 @@ type Config struct {
 	ExternalSecure  bool
 	Log             *logging.Config
 	EncryptionKeys  *encryptionKeyConfig
+	KMS             *key.KMSConfig
 	DefaultInstance command.InstanceSetup
 	Machine         *id.Config
 	Projections     projection.Config
@@ -56,17 +58,22 @@ func MustNewConfig(v *viper.Viper) *Config {
 }
 
 type Steps struct {
-	s1ProjectionTable    *ProjectionTable
-	s2AssetsTable        *AssetTable
-	FirstInstance        *FirstInstance
-	s4EventstoreIndexes  *EventstoreIndexesNew
-	s5LastFailed         *LastFailed
-	s6OwnerRemoveColumns *OwnerRemoveColumns
-	s7LogstoreTables     *LogstoreTables
-	s8AuthTokens         *AuthTokenIndexes
-	s9EventstoreIndexes2 *EventstoreIndexesNew
-	CorrectCreationDate  *CorrectCreationDate
-	AddEventCreatedAt    *AddEventCreatedAt
+	s1ProjectionTable      *ProjectionTable
+	s2AssetsTable          *AssetTable
+	FirstInstance          *FirstInstance
+	s4EventstoreIndexes    *EventstoreIndexesNew
+	s5LastFailed           *LastFailed
+	s6OwnerRemoveColumns   *OwnerRemoveColumns
+	s7LogstoreTables       *LogstoreTables
+	s8AuthTokens           *AuthTokenIndexes
+	s9EventstoreIndexes2   *EventstoreIndexesNew
+	CorrectCreationDate    *CorrectCreationDate
+	AddEventCreatedAt      *AddEventCreatedAt
+	s12EventsArchive       *EventsArchive
+	s13PersonalDataKeys    *PersonalDataKeys
+	s14FailedEventsHistory *FailedEventsHistory
+	s15TokenAuthzDetails   *TokenAuthorizationDetails
+	s16TokenLOA            *TokenLevelOfAssurance
 }
 
 type encryptionKeyConfig struct {
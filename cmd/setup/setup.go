@@ -12,6 +12,7 @@ import (
 	"github.com/zitadel/zitadel/cmd/build"
 	"github.com/zitadel/zitadel/cmd/key"
 	"github.com/zitadel/zitadel/cmd/tls"
+	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/migration"
@@ -65,7 +66,14 @@ func Setup(config *Config, steps *Steps, masterKey string) {
 	dbClient, err := database.Connect(config.Database, false)
 	logging.OnError(err).Fatal("unable to connect to database")
 
-	eventstoreClient, err := eventstore.Start(&eventstore.Config{Client: dbClient})
+	keyStorage, err := key.NewKeyStorage(dbClient.DB, config.KMS, masterKey)
+	logging.OnError(err).Fatal("unable to start key storage")
+	err = verifyKey(config.EncryptionKeys.User, keyStorage)
+	logging.OnError(err).Fatal("unable to verify user encryption key")
+	personalDataEncryption, err := crypto.NewEncryptionAlgorithm(config.EncryptionKeys.User, keyStorage)
+	logging.OnError(err).Fatal("unable to load user encryption key")
+
+	eventstoreClient, err := eventstore.Start(&eventstore.Config{Client: dbClient, PersonalDataEncryption: personalDataEncryption})
 	logging.OnError(err).Fatal("unable to start eventstore")
 	migration.RegisterMappers(eventstoreClient)
 
@@ -77,6 +85,7 @@ func Setup(config *Config, steps *Steps, masterKey string) {
 	steps.FirstInstance.smtpEncryptionKey = config.EncryptionKeys.SMTP
 	steps.FirstInstance.oidcEncryptionKey = config.EncryptionKeys.OIDC
 	steps.FirstInstance.masterKey = masterKey
+	steps.FirstInstance.kms = config.KMS
 	steps.FirstInstance.db = dbClient.DB
 	steps.FirstInstance.es = eventstoreClient
 	steps.FirstInstance.defaults = config.SystemDefaults
@@ -94,6 +103,11 @@ func Setup(config *Config, steps *Steps, masterKey string) {
 	steps.CorrectCreationDate.dbClient = dbClient
 	steps.AddEventCreatedAt.dbClient = dbClient
 	steps.AddEventCreatedAt.step10 = steps.CorrectCreationDate
+	steps.s12EventsArchive = &EventsArchive{dbClient: dbClient}
+	steps.s13PersonalDataKeys = &PersonalDataKeys{dbClient: dbClient}
+	steps.s14FailedEventsHistory = &FailedEventsHistory{dbClient: dbClient}
+	steps.s15TokenAuthzDetails = &TokenAuthorizationDetails{dbClient: dbClient}
+	steps.s16TokenLOA = &TokenLevelOfAssurance{dbClient: dbClient}
 
 	err = projection.Create(ctx, dbClient, eventstoreClient, config.Projections, nil, nil)
 	logging.OnError(err).Fatal("unable to start projections")
@@ -116,6 +130,9 @@ func Setup(config *Config, steps *Steps, masterKey string) {
 	logging.OnError(err).Fatal("unable to migrate step 1")
 	err = migration.Migrate(ctx, eventstoreClient, steps.s2AssetsTable)
 	logging.OnError(err).Fatal("unable to migrate step 2")
+	// the personal data keys are required as soon as the first instance adds its users
+	err = migration.Migrate(ctx, eventstoreClient, steps.s13PersonalDataKeys)
+	logging.OnError(err).Fatal("unable to migrate step 13")
 	err = migration.Migrate(ctx, eventstoreClient, steps.FirstInstance)
 	logging.OnError(err).Fatal("unable to migrate step 3")
 	err = migration.Migrate(ctx, eventstoreClient, steps.s4EventstoreIndexes)
@@ -134,6 +151,14 @@ func Setup(config *Config, steps *Steps, masterKey string) {
 	logging.OnError(err).Fatal("unable to migrate step 10")
 	err = migration.Migrate(ctx, eventstoreClient, steps.AddEventCreatedAt)
 	logging.OnError(err).Fatal("unable to migrate step 11")
+	err = migration.Migrate(ctx, eventstoreClient, steps.s12EventsArchive)
+	logging.OnError(err).Fatal("unable to migrate step 12")
+	err = migration.Migrate(ctx, eventstoreClient, steps.s14FailedEventsHistory)
+	logging.OnError(err).Fatal("unable to migrate step 14")
+	err = migration.Migrate(ctx, eventstoreClient, steps.s15TokenAuthzDetails)
+	logging.OnError(err).Fatal("unable to migrate step 15")
+	err = migration.Migrate(ctx, eventstoreClient, steps.s16TokenLOA)
+	logging.OnError(err).Fatal("unable to migrate step 16")
 
 	for _, repeatableStep := range repeatableSteps {
 		err = migration.Migrate(ctx, eventstoreClient, repeatableStep)
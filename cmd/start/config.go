@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/viper"
 	"github.com/zitadel/logging"
 
+	"github.com/zitadel/zitadel/cmd/key"
 	"github.com/zitadel/zitadel/internal/actions"
 	admin_es "github.com/zitadel/zitadel/internal/admin/repository/eventsourcing"
 	internal_authz "github.com/zitadel/zitadel/internal/api/authz"
@@ -18,6 +19,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/ui/console"
 	"github.com/zitadel/zitadel/internal/api/ui/login"
 	auth_es "github.com/zitadel/zitadel/internal/auth/repository/eventsourcing"
+	cache_config "github.com/zitadel/zitadel/internal/cache/config"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/config/hook"
 	"github.com/zitadel/zitadel/internal/config/network"
@@ -59,6 +61,7 @@ type Config struct {
 	InternalAuthZ     internal_authz.Config
 	SystemDefaults    systemdefaults.SystemDefaults
 	EncryptionKeys    *encryptionKeyConfig
+	KMS               *key.KMSConfig
 	DefaultInstance   command.InstanceSetup
 	AuditLogRetention time.Duration
 	SystemAPIUsers    SystemAPIUsers
@@ -69,6 +72,7 @@ type Config struct {
 	LogStore          *logstore.Configs
 	Quotas            *QuotasConfig
 	Telemetry         *handlers.TelemetryPusherConfig
+	Caches            *cache_config.QueryCachesConfig
 }
 
 type QuotasConfig struct {
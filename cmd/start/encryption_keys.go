@@ -2,6 +2,7 @@ package start
 
 import (
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/crypto/pkcs11"
 	caos_errs "github.com/zitadel/zitadel/internal/errors"
 )
 
@@ -32,6 +33,8 @@ type encryptionKeys struct {
 	CSRFCookieKey      []byte
 	UserAgentCookieKey []byte
 	OIDCKey            []byte
+	// HSMSigningKey signs the OIDC tokens if configured
+	HSMSigningKey *pkcs11.SigningKey
 }
 
 func ensureEncryptionKeys(keyConfig *encryptionKeyConfig, keyStorage crypto.KeyStorage) (keys *encryptionKeys, err error) {
@@ -39,19 +42,19 @@ func ensureEncryptionKeys(keyConfig *encryptionKeyConfig, keyStorage crypto.KeyS
 		return nil, err
 	}
 	keys = new(encryptionKeys)
-	keys.DomainVerification, err = crypto.NewAESCrypto(keyConfig.DomainVerification, keyStorage)
+	keys.DomainVerification, err = crypto.NewEncryptionAlgorithm(keyConfig.DomainVerification, keyStorage)
 	if err != nil {
 		return nil, err
 	}
-	keys.IDPConfig, err = crypto.NewAESCrypto(keyConfig.IDPConfig, keyStorage)
+	keys.IDPConfig, err = crypto.NewEncryptionAlgorithm(keyConfig.IDPConfig, keyStorage)
 	if err != nil {
 		return nil, err
 	}
-	keys.OIDC, err = crypto.NewAESCrypto(keyConfig.OIDC, keyStorage)
+	keys.OIDC, err = crypto.NewEncryptionAlgorithm(keyConfig.OIDC, keyStorage)
 	if err != nil {
 		return nil, err
 	}
-	keys.SAML, err = crypto.NewAESCrypto(keyConfig.SAML, keyStorage)
+	keys.SAML, err = crypto.NewEncryptionAlgorithm(keyConfig.SAML, keyStorage)
 	if err != nil {
 		return nil, err
 	}
@@ -60,19 +63,19 @@ func ensureEncryptionKeys(keyConfig *encryptionKeyConfig, keyStorage crypto.KeyS
 		return nil, err
 	}
 	keys.OIDCKey = []byte(key)
-	keys.OTP, err = crypto.NewAESCrypto(keyConfig.OTP, keyStorage)
+	keys.OTP, err = crypto.NewEncryptionAlgorithm(keyConfig.OTP, keyStorage)
 	if err != nil {
 		return nil, err
 	}
-	keys.SMS, err = crypto.NewAESCrypto(keyConfig.SMS, keyStorage)
+	keys.SMS, err = crypto.NewEncryptionAlgorithm(keyConfig.SMS, keyStorage)
 	if err != nil {
 		return nil, err
 	}
-	keys.SMTP, err = crypto.NewAESCrypto(keyConfig.SMTP, keyStorage)
+	keys.SMTP, err = crypto.NewEncryptionAlgorithm(keyConfig.SMTP, keyStorage)
 	if err != nil {
 		return nil, err
 	}
-	keys.User, err = crypto.NewAESCrypto(keyConfig.User, keyStorage)
+	keys.User, err = crypto.NewEncryptionAlgorithm(keyConfig.User, keyStorage)
 	if err != nil {
 		return nil, err
 	}
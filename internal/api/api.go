@@ -40,6 +40,7 @@ type API struct {
 
 type healthCheck interface {
 	Health(ctx context.Context) error
+	ProjectionsReady(ctx context.Context) ([]*query.ProjectionLag, error)
 }
 
 func New(
@@ -199,6 +200,10 @@ func (a *API) healthHandler() http.Handler {
 			}
 			return nil
 		},
+		func(ctx context.Context) error {
+			_, err := a.health.ProjectionsReady(ctx)
+			return err
+		},
 	}
 	handler := http.NewServeMux()
 	handler.HandleFunc("/healthz", handleHealth)
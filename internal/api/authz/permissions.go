@@ -52,10 +52,50 @@ func getUserPermissions(ctx context.Context, resolver MembershipsResolver, requi
 			return nil, nil, nil
 		}
 	}
+	roleMappings, err = withCustomRoleMappings(ctx, resolver, memberships, roleMappings)
+	if err != nil {
+		return nil, nil, err
+	}
 	requestedPermissions, allPermissions = mapMembershipsToPermissions(requiredPerm, memberships, roleMappings)
 	return requestedPermissions, allPermissions, nil
 }
 
+// withCustomRoleMappings appends the custom roles of the instance to the static role mappings.
+// They are only resolved if a membership contains a role which is not statically mapped.
+func withCustomRoleMappings(ctx context.Context, resolver MembershipsResolver, memberships []*Membership, roleMappings []RoleMapping) ([]RoleMapping, error) {
+	customRolesResolver, ok := resolver.(CustomRolesResolver)
+	if !ok || !hasUnmappedRole(memberships, roleMappings) {
+		return roleMappings, nil
+	}
+	customRoleMappings, err := customRolesResolver.CustomRoleMappings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mappings := make([]RoleMapping, 0, len(roleMappings)+len(customRoleMappings))
+	mappings = append(mappings, roleMappings...)
+	return append(mappings, customRoleMappings...), nil
+}
+
+func hasUnmappedRole(memberships []*Membership, roleMappings []RoleMapping) bool {
+	for _, membership := range memberships {
+		for _, role := range membership.Roles {
+			if !isMappedRole(role, roleMappings) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isMappedRole(role string, roleMappings []RoleMapping) bool {
+	for _, roleMapping := range roleMappings {
+		if roleMapping.Role == role {
+			return true
+		}
+	}
+	return false
+}
+
 // checkUserResourcePermissions checks that if a user i granted either the requested permission globally (project.write)
 // or the specific resource (project.write:123)
 func checkUserResourcePermissions(userPerms []string, resourceID string) error {
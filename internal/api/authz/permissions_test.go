@@ -13,6 +13,7 @@ func getTestCtx(userID, orgID string) context.Context {
 
 type testVerifier struct {
 	memberships []*Membership
+	customRoles []RoleMapping
 }
 
 func (v *testVerifier) VerifyAccessToken(ctx context.Context, token, clientID, projectID string) (string, string, string, string, string, error) {
@@ -22,6 +23,10 @@ func (v *testVerifier) SearchMyMemberships(ctx context.Context, orgID string) ([
 	return v.memberships, nil
 }
 
+func (v *testVerifier) CustomRoleMappings(ctx context.Context) ([]RoleMapping, error) {
+	return v.customRoles, nil
+}
+
 func (v *testVerifier) ProjectIDAndOriginsByClientID(ctx context.Context, clientID string) (string, []string, error) {
 	return "", nil, nil
 }
@@ -136,6 +141,38 @@ func Test_GetUserPermissions(t *testing.T) {
 			},
 			result: []string{"project.read"},
 		},
+		{
+			name: "Get Permissions of custom role",
+			args: args{
+				ctxData: CtxData{UserID: "userID", OrgID: "orgID"},
+				verifier: Start(&testVerifier{
+					memberships: []*Membership{
+						{
+							AggregateID: "orgID",
+							ObjectID:    "orgID",
+							MemberType:  MemberTypeOrganisation,
+							Roles:       []string{"ORG_HELPDESK"},
+						},
+					},
+					customRoles: []RoleMapping{
+						{
+							Role:        "ORG_HELPDESK",
+							Permissions: []string{"org.read"},
+						},
+					},
+				}, "", nil),
+				requiredPerm: "org.read",
+				authConfig: Config{
+					RolePermissionMappings: []RoleMapping{
+						{
+							Role:        "ORG_OWNER",
+							Permissions: []string{"org.read", "project.read"},
+						},
+					},
+				},
+			},
+			result: []string{"org.read"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -35,10 +35,17 @@ type MembershipsResolver interface {
 	SearchMyMemberships(ctx context.Context, orgID string) ([]*Membership, error)
 }
 
+// CustomRolesResolver is implemented by MembershipsResolvers
+// which can resolve the roles defined on the instance in addition to the static role mappings
+type CustomRolesResolver interface {
+	CustomRoleMappings(ctx context.Context) ([]RoleMapping, error)
+}
+
 type authZRepo interface {
 	VerifyAccessToken(ctx context.Context, token, verifierClientID, projectID string) (userID, agentID, clientID, prefLang, resourceOwner string, err error)
 	VerifierClientID(ctx context.Context, name string) (clientID, projectID string, err error)
 	SearchMyMemberships(ctx context.Context, orgID string) ([]*Membership, error)
+	CustomRoleMappings(ctx context.Context) ([]RoleMapping, error)
 	ProjectIDAndOriginsByClientID(ctx context.Context, clientID string) (projectID string, origins []string, err error)
 	ExistsOrg(ctx context.Context, id, domain string) (string, error)
 }
@@ -141,6 +148,12 @@ func (v *TokenVerifier) SearchMyMemberships(ctx context.Context, orgID string) (
 	return v.authZRepo.SearchMyMemberships(ctx, orgID)
 }
 
+func (v *TokenVerifier) CustomRoleMappings(ctx context.Context) (_ []RoleMapping, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	return v.authZRepo.CustomRoleMappings(ctx)
+}
+
 func (v *TokenVerifier) ProjectIDAndOriginsByClientID(ctx context.Context, clientID string) (_ string, _ []string, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -0,0 +1,64 @@
+package admin
+
+import (
+	"context"
+
+	member_grpc "github.com/zitadel/zitadel/internal/api/grpc/member"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) ListCustomRoles(ctx context.Context, req *admin_pb.ListCustomRolesRequest) (*admin_pb.ListCustomRolesResponse, error) {
+	queries, err := ListCustomRolesRequestToQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.query.SearchCustomRoles(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListCustomRolesResponse{
+		Result:  member_grpc.CustomRolesToPb(result.CustomRoles),
+		Details: object.ToListDetails(result.Count, result.Sequence, result.Timestamp),
+	}, nil
+}
+
+func (s *Server) GetCustomRole(ctx context.Context, req *admin_pb.GetCustomRoleRequest) (*admin_pb.GetCustomRoleResponse, error) {
+	role, err := s.query.CustomRoleByName(ctx, req.Role)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetCustomRoleResponse{
+		Role: member_grpc.CustomRoleToPb(role),
+	}, nil
+}
+
+func (s *Server) AddCustomRole(ctx context.Context, req *admin_pb.AddCustomRoleRequest) (*admin_pb.AddCustomRoleResponse, error) {
+	details, err := s.command.AddCustomRole(ctx, AddCustomRoleToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddCustomRoleResponse{
+		Details: object.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateCustomRole(ctx context.Context, req *admin_pb.UpdateCustomRoleRequest) (*admin_pb.UpdateCustomRoleResponse, error) {
+	details, err := s.command.ChangeCustomRole(ctx, UpdateCustomRoleToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateCustomRoleResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveCustomRole(ctx context.Context, req *admin_pb.RemoveCustomRoleRequest) (*admin_pb.RemoveCustomRoleResponse, error) {
+	details, err := s.command.RemoveCustomRole(ctx, req.Role)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.RemoveCustomRoleResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
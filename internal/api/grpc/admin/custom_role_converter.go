@@ -0,0 +1,41 @@
+package admin
+
+import (
+	member_grpc "github.com/zitadel/zitadel/internal/api/grpc/member"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func AddCustomRoleToDomain(req *admin_pb.AddCustomRoleRequest) *domain.CustomRole {
+	return &domain.CustomRole{
+		Role:        req.Role,
+		DisplayName: req.DisplayName,
+		Permissions: req.Permissions,
+	}
+}
+
+func UpdateCustomRoleToDomain(req *admin_pb.UpdateCustomRoleRequest) *domain.CustomRole {
+	return &domain.CustomRole{
+		Role:        req.Role,
+		DisplayName: req.DisplayName,
+		Permissions: req.Permissions,
+	}
+}
+
+func ListCustomRolesRequestToQuery(req *admin_pb.ListCustomRolesRequest) (*query.CustomRoleSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries, err := member_grpc.CustomRoleQueriesToQuery(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	return &query.CustomRoleSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: queries,
+	}, nil
+}
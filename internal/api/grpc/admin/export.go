@@ -399,6 +399,7 @@ func (s *Server) getLoginPolicy(ctx context.Context, orgID string, orgIDPs []str
 		mfaInitSkip := durationpb.New(queriedLogin.MFAInitSkipLifetime)
 		secondFactor := durationpb.New(queriedLogin.SecondFactorCheckLifetime)
 		multiFactor := durationpb.New(queriedLogin.MultiFactorCheckLifetime)
+		trustedDevice := durationpb.New(queriedLogin.TrustedDeviceLifetime)
 
 		secondFactors := []policy_pb.SecondFactorType{}
 		for _, factor := range queriedLogin.SecondFactors {
@@ -450,6 +451,7 @@ func (s *Server) getLoginPolicy(ctx context.Context, orgID string, orgIDPs []str
 			MfaInitSkipLifetime:        mfaInitSkip,
 			SecondFactorCheckLifetime:  secondFactor,
 			MultiFactorCheckLifetime:   multiFactor,
+			TrustedDeviceLifetime:      trustedDevice,
 			SecondFactors:              secondFactors,
 			MultiFactors:               multiFactors,
 			Idps:                       idpLinks,
@@ -532,10 +534,11 @@ func (s *Server) getPrivacyPolicy(ctx context.Context, orgID string) (_ *managem
 	}
 	if !queriedPrivacy.IsDefault {
 		return &management_pb.AddCustomPrivacyPolicyRequest{
-			TosLink:      queriedPrivacy.TOSLink,
-			PrivacyLink:  queriedPrivacy.PrivacyLink,
-			HelpLink:     queriedPrivacy.HelpLink,
-			SupportEmail: string(queriedPrivacy.SupportEmail),
+			TosLink:           queriedPrivacy.TOSLink,
+			PrivacyLink:       queriedPrivacy.PrivacyLink,
+			HelpLink:          queriedPrivacy.HelpLink,
+			SupportEmail:      string(queriedPrivacy.SupportEmail),
+			AllowSelfDeletion: queriedPrivacy.AllowSelfDeletion,
 		}, nil
 	}
 	return nil, nil
@@ -794,23 +797,27 @@ func (s *Server) getProjectsAndApps(ctx context.Context, org string) ([]*v1_pb.D
 				oidcApps = append(oidcApps, &v1_pb.DataOIDCApplication{
 					AppId: app.ID,
 					App: &management_pb.AddOIDCAppRequest{
-						ProjectId:                app.ProjectID,
-						Name:                     app.Name,
-						RedirectUris:             app.OIDCConfig.RedirectURIs,
-						ResponseTypes:            responseTypes,
-						GrantTypes:               grantTypes,
-						AppType:                  app_pb.OIDCAppType(app.OIDCConfig.AppType),
-						AuthMethodType:           app_pb.OIDCAuthMethodType(app.OIDCConfig.AuthMethodType),
-						PostLogoutRedirectUris:   app.OIDCConfig.PostLogoutRedirectURIs,
-						Version:                  app_pb.OIDCVersion(app.OIDCConfig.Version),
-						DevMode:                  app.OIDCConfig.IsDevMode,
-						AccessTokenType:          app_pb.OIDCTokenType(app.OIDCConfig.AccessTokenType),
-						AccessTokenRoleAssertion: app.OIDCConfig.AssertAccessTokenRole,
-						IdTokenRoleAssertion:     app.OIDCConfig.AssertIDTokenRole,
-						IdTokenUserinfoAssertion: app.OIDCConfig.AssertIDTokenUserinfo,
-						ClockSkew:                durationpb.New(app.OIDCConfig.ClockSkew),
-						AdditionalOrigins:        app.OIDCConfig.AdditionalOrigins,
-						SkipNativeAppSuccessPage: app.OIDCConfig.SkipNativeAppSuccessPage,
+						ProjectId:                             app.ProjectID,
+						Name:                                  app.Name,
+						RedirectUris:                          app.OIDCConfig.RedirectURIs,
+						ResponseTypes:                         responseTypes,
+						GrantTypes:                            grantTypes,
+						AppType:                               app_pb.OIDCAppType(app.OIDCConfig.AppType),
+						AuthMethodType:                        app_pb.OIDCAuthMethodType(app.OIDCConfig.AuthMethodType),
+						PostLogoutRedirectUris:                app.OIDCConfig.PostLogoutRedirectURIs,
+						Version:                               app_pb.OIDCVersion(app.OIDCConfig.Version),
+						DevMode:                               app.OIDCConfig.IsDevMode,
+						AccessTokenType:                       app_pb.OIDCTokenType(app.OIDCConfig.AccessTokenType),
+						AccessTokenRoleAssertion:              app.OIDCConfig.AssertAccessTokenRole,
+						IdTokenRoleAssertion:                  app.OIDCConfig.AssertIDTokenRole,
+						IdTokenUserinfoAssertion:              app.OIDCConfig.AssertIDTokenUserinfo,
+						ClockSkew:                             durationpb.New(app.OIDCConfig.ClockSkew),
+						AdditionalOrigins:                     app.OIDCConfig.AdditionalOrigins,
+						SkipNativeAppSuccessPage:              app.OIDCConfig.SkipNativeAppSuccessPage,
+						IdTokenSigningAlgorithm:               app.OIDCConfig.IDTokenSigningAlgorithm,
+						ConsentRequired:                       app.OIDCConfig.ConsentRequired,
+						BackchannelTokenDeliveryMode:          app_pb.OIDCBackchannelTokenDeliveryMode(app.OIDCConfig.BackchannelTokenDeliveryMode),
+						BackchannelClientNotificationEndpoint: app.OIDCConfig.BackchannelClientNotificationEndpoint,
 					},
 				})
 			}
@@ -0,0 +1,92 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+	keypair_pb "github.com/zitadel/zitadel/pkg/grpc/keypair"
+)
+
+func (s *Server) ListKeyPairs(ctx context.Context, req *admin_pb.ListKeyPairsRequest) (*admin_pb.ListKeyPairsResponse, error) {
+	queries, err := listKeyPairsToModel(req)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.query.SearchKeyPairs(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListKeyPairsResponse{
+		Result:  KeyPairsToPb(result.KeyPairs),
+		Details: object.ToListDetails(result.Count, result.Sequence, result.Timestamp),
+	}, nil
+}
+
+func (s *Server) AddKeyPair(ctx context.Context, req *admin_pb.AddKeyPairRequest) (*admin_pb.AddKeyPairResponse, error) {
+	var (
+		id      string
+		details *domain.ObjectDetails
+		err     error
+	)
+	switch req.Usage {
+	case keypair_pb.KeyPairUsage_KEY_PAIR_USAGE_SIGNING:
+		id, details, err = s.command.AddSigningKeyPair(ctx, req.Algorithm, req.Staged)
+	case keypair_pb.KeyPairUsage_KEY_PAIR_USAGE_SAML_RESPONSE_SIGNING:
+		id, details, err = s.command.AddSAMLResponseCertificate(ctx, req.Staged)
+	default:
+		return nil, errors.ThrowInvalidArgument(nil, "ADMIN-Kp7la", "Errors.KeyPair.UsageNotSupported")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddKeyPairResponse{
+		Id:      id,
+		Details: object.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ImportKeyPair(ctx context.Context, req *admin_pb.ImportKeyPairRequest) (*admin_pb.ImportKeyPairResponse, error) {
+	var (
+		id      string
+		details *domain.ObjectDetails
+		err     error
+	)
+	switch req.Usage {
+	case keypair_pb.KeyPairUsage_KEY_PAIR_USAGE_SIGNING:
+		id, details, err = s.command.ImportSigningKeyPair(ctx, req.Algorithm, req.PrivateKey, req.ExpirationDate.AsTime(), req.Staged)
+	case keypair_pb.KeyPairUsage_KEY_PAIR_USAGE_SAML_RESPONSE_SIGNING:
+		id, details, err = s.command.ImportSAMLResponseCertificate(ctx, req.PrivateKey, req.Certificate, req.Staged)
+	default:
+		return nil, errors.ThrowInvalidArgument(nil, "ADMIN-Kp7lb", "Errors.KeyPair.UsageNotSupported")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ImportKeyPairResponse{
+		Id:      id,
+		Details: object.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ActivateKeyPair(ctx context.Context, req *admin_pb.ActivateKeyPairRequest) (*admin_pb.ActivateKeyPairResponse, error) {
+	details, err := s.command.ActivateKeyPair(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ActivateKeyPairResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) DeactivateKeyPair(ctx context.Context, req *admin_pb.DeactivateKeyPairRequest) (*admin_pb.DeactivateKeyPairResponse, error) {
+	details, err := s.command.DeactivateKeyPair(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.DeactivateKeyPairResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
@@ -0,0 +1,134 @@
+package admin
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+	keypair_pb "github.com/zitadel/zitadel/pkg/grpc/keypair"
+)
+
+func listKeyPairsToModel(req *admin_pb.ListKeyPairsRequest) (*query.KeyPairSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries, err := keyPairQueriesToModel(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	return &query.KeyPairSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: queries,
+	}, nil
+}
+
+func keyPairQueriesToModel(queries []*keypair_pb.KeyPairQuery) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = keyPairQueryToModel(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func keyPairQueryToModel(req *keypair_pb.KeyPairQuery) (query.SearchQuery, error) {
+	switch q := req.Query.(type) {
+	case *keypair_pb.KeyPairQuery_UsageQuery:
+		return query.NewKeyPairUseSearchQuery(keyPairUsageToDomain(q.UsageQuery.Usage))
+	case *keypair_pb.KeyPairQuery_StateQuery:
+		return query.NewKeyPairStateSearchQuery(keyPairStateToDomain(q.StateQuery.State))
+	default:
+		return nil, errors.ThrowInvalidArgument(nil, "ADMIN-Kp8la", "List.Query.Invalid")
+	}
+}
+
+func KeyPairsToPb(keyPairs []*query.KeyPair) []*keypair_pb.KeyPair {
+	k := make([]*keypair_pb.KeyPair, len(keyPairs))
+	for i, keyPair := range keyPairs {
+		k[i] = KeyPairToPb(keyPair)
+	}
+	return k
+}
+
+func KeyPairToPb(keyPair *query.KeyPair) *keypair_pb.KeyPair {
+	return &keypair_pb.KeyPair{
+		Id:                        keyPair.ID,
+		Details:                   object.ToViewDetailsPb(keyPair.Sequence, keyPair.CreationDate, keyPair.ChangeDate, keyPair.ResourceOwner),
+		Algorithm:                 keyPair.Algorithm,
+		Usage:                     keyPairUsageToPb(keyPair.Use),
+		State:                     keyPairStateToPb(keyPair.State),
+		PrivateKeyExpirationDate:  expiryToPb(keyPair.PrivateKeyExpiry),
+		PublicKeyExpirationDate:   expiryToPb(keyPair.PublicKeyExpiry),
+		CertificateExpirationDate: expiryToPb(keyPair.CertificateExpiry),
+	}
+}
+
+func expiryToPb(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func keyPairUsageToPb(usage domain.KeyUsage) keypair_pb.KeyPairUsage {
+	switch usage {
+	case domain.KeyUsageSigning:
+		return keypair_pb.KeyPairUsage_KEY_PAIR_USAGE_SIGNING
+	case domain.KeyUsageSAMLMetadataSigning:
+		return keypair_pb.KeyPairUsage_KEY_PAIR_USAGE_SAML_METADATA_SIGNING
+	case domain.KeyUsageSAMLResponseSinging:
+		return keypair_pb.KeyPairUsage_KEY_PAIR_USAGE_SAML_RESPONSE_SIGNING
+	case domain.KeyUsageSAMLCA:
+		return keypair_pb.KeyPairUsage_KEY_PAIR_USAGE_SAML_CA
+	default:
+		return keypair_pb.KeyPairUsage_KEY_PAIR_USAGE_UNSPECIFIED
+	}
+}
+
+func keyPairUsageToDomain(usage keypair_pb.KeyPairUsage) domain.KeyUsage {
+	switch usage {
+	case keypair_pb.KeyPairUsage_KEY_PAIR_USAGE_SAML_METADATA_SIGNING:
+		return domain.KeyUsageSAMLMetadataSigning
+	case keypair_pb.KeyPairUsage_KEY_PAIR_USAGE_SAML_RESPONSE_SIGNING:
+		return domain.KeyUsageSAMLResponseSinging
+	case keypair_pb.KeyPairUsage_KEY_PAIR_USAGE_SAML_CA:
+		return domain.KeyUsageSAMLCA
+	default:
+		return domain.KeyUsageSigning
+	}
+}
+
+func keyPairStateToPb(state domain.KeyPairState) keypair_pb.KeyPairState {
+	switch state {
+	case domain.KeyPairStateActive:
+		return keypair_pb.KeyPairState_KEY_PAIR_STATE_ACTIVE
+	case domain.KeyPairStatePublished:
+		return keypair_pb.KeyPairState_KEY_PAIR_STATE_PUBLISHED
+	case domain.KeyPairStateInactive:
+		return keypair_pb.KeyPairState_KEY_PAIR_STATE_INACTIVE
+	default:
+		return keypair_pb.KeyPairState_KEY_PAIR_STATE_UNSPECIFIED
+	}
+}
+
+func keyPairStateToDomain(state keypair_pb.KeyPairState) domain.KeyPairState {
+	switch state {
+	case keypair_pb.KeyPairState_KEY_PAIR_STATE_ACTIVE:
+		return domain.KeyPairStateActive
+	case keypair_pb.KeyPairState_KEY_PAIR_STATE_PUBLISHED:
+		return domain.KeyPairStatePublished
+	case keypair_pb.KeyPairState_KEY_PAIR_STATE_INACTIVE:
+		return domain.KeyPairStateInactive
+	default:
+		return domain.KeyPairStateUnspecified
+	}
+}
@@ -37,6 +37,26 @@ func (s *Server) RemoveOrg(ctx context.Context, req *admin_pb.RemoveOrgRequest)
 	}, nil
 }
 
+func (s *Server) SetOrgParent(ctx context.Context, req *admin_pb.SetOrgParentRequest) (*admin_pb.SetOrgParentResponse, error) {
+	details, err := s.command.SetOrgParent(ctx, req.OrgId, req.ParentOrgId)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetOrgParentResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveOrgParent(ctx context.Context, req *admin_pb.RemoveOrgParentRequest) (*admin_pb.RemoveOrgParentResponse, error) {
+	details, err := s.command.RemoveOrgParent(ctx, req.OrgId)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.RemoveOrgParentResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
 func (s *Server) GetDefaultOrg(ctx context.Context, _ *admin_pb.GetDefaultOrgRequest) (*admin_pb.GetDefaultOrgResponse, error) {
 	org, err := s.query.OrgByID(ctx, true, authz.GetInstance(ctx).DefaultOrganisationID())
 	return &admin_pb.GetDefaultOrgResponse{Org: org_grpc.OrgToPb(org)}, err
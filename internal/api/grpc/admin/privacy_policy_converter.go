@@ -7,9 +7,10 @@ import (
 
 func UpdatePrivacyPolicyToDomain(req *admin_pb.UpdatePrivacyPolicyRequest) *domain.PrivacyPolicy {
 	return &domain.PrivacyPolicy{
-		TOSLink:      req.TosLink,
-		PrivacyLink:  req.PrivacyLink,
-		HelpLink:     req.HelpLink,
-		SupportEmail: domain.EmailAddress(req.SupportEmail),
+		TOSLink:           req.TosLink,
+		PrivacyLink:       req.PrivacyLink,
+		HelpLink:          req.HelpLink,
+		SupportEmail:      domain.EmailAddress(req.SupportEmail),
+		AllowSelfDeletion: req.AllowSelfDeletion,
 	}
 }
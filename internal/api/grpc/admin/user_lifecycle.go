@@ -0,0 +1,47 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	policy_grpc "github.com/zitadel/zitadel/internal/api/grpc/policy"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) GetUserLifecyclePolicy(ctx context.Context, req *admin_pb.GetUserLifecyclePolicyRequest) (*admin_pb.GetUserLifecyclePolicyResponse, error) {
+	policy, err := s.query.DefaultUserLifecyclePolicy(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetUserLifecyclePolicyResponse{Policy: policy_grpc.ModelUserLifecyclePolicyToPb(policy)}, nil
+}
+
+func (s *Server) UpdateUserLifecyclePolicy(ctx context.Context, req *admin_pb.UpdateUserLifecyclePolicyRequest) (*admin_pb.UpdateUserLifecyclePolicyResponse, error) {
+	policy, err := s.command.ChangeDefaultUserLifecyclePolicy(ctx, UpdateUserLifecyclePolicyToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateUserLifecyclePolicyResponse{
+		Details: object.ChangeToDetailsPb(
+			policy.Sequence,
+			policy.ChangeDate,
+			policy.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) ListUserLifecycleActions(ctx context.Context, req *admin_pb.ListUserLifecycleActionsRequest) (*admin_pb.ListUserLifecycleActionsResponse, error) {
+	queries, err := ListUserLifecycleActionsRequestToQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.query.UserLifecycleActions(ctx, nil, time.Now(), queries)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListUserLifecycleActionsResponse{
+		Details: object.ToListDetails(res.Count, res.Sequence, res.Timestamp),
+		Result:  policy_grpc.UserLifecycleActionsToPb(res.Actions),
+	}, nil
+}
@@ -0,0 +1,28 @@
+package admin
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func UpdateUserLifecyclePolicyToDomain(p *admin.UpdateUserLifecyclePolicyRequest) *domain.UserLifecyclePolicy {
+	return &domain.UserLifecyclePolicy{
+		InactivityDeactivation: p.InactivityDeactivation.AsDuration(),
+		DeactivatedRemoval:     p.DeactivatedRemoval.AsDuration(),
+		NotificationPeriod:     p.NotificationPeriod.AsDuration(),
+	}
+}
+
+func ListUserLifecycleActionsRequestToQuery(req *admin.ListUserLifecycleActionsRequest) (*query.UserActivitySearchQueries, error) {
+	queries := new(query.UserActivitySearchQueries)
+	if req.OrgId == "" {
+		return queries, nil
+	}
+	orgQuery, err := query.NewUserActivityResourceOwnerSearchQuery(req.OrgId)
+	if err != nil {
+		return nil, err
+	}
+	queries.Queries = append(queries.Queries, orgQuery)
+	return queries, nil
+}
@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	user_grpc "github.com/zitadel/zitadel/internal/api/grpc/user"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/auth"
+)
+
+func (s *Server) ListMyTrustedDevices(ctx context.Context, _ *auth.ListMyTrustedDevicesRequest) (*auth.ListMyTrustedDevicesResponse, error) {
+	queries, err := listMyTrustedDevicesToQuery(authz.GetCtxData(ctx).UserID)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.query.SearchTrustedDevices(ctx, queries, false)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.ListMyTrustedDevicesResponse{
+		Result:  user_grpc.TrustedDevicesToPb(res.TrustedDevices),
+		Details: object.ToListDetails(res.Count, res.Sequence, res.Timestamp),
+	}, nil
+}
+
+func (s *Server) RevokeMyTrustedDevice(ctx context.Context, req *auth.RevokeMyTrustedDeviceRequest) (*auth.RevokeMyTrustedDeviceResponse, error) {
+	ctxData := authz.GetCtxData(ctx)
+	details, err := s.command.HumanRevokeTrustedDevice(ctx, ctxData.UserID, ctxData.ResourceOwner, req.UserAgentId)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.RevokeMyTrustedDeviceResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func listMyTrustedDevicesToQuery(userID string) (*query.TrustedDeviceSearchQueries, error) {
+	userIDQuery, err := query.NewTrustedDeviceUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, err
+	}
+	notExpiredQuery, err := query.NewTrustedDeviceNotExpiredSearchQuery()
+	if err != nil {
+		return nil, err
+	}
+	return &query.TrustedDeviceSearchQueries{
+		Queries: []query.SearchQuery{userIDQuery, notExpiredQuery},
+	}, nil
+}
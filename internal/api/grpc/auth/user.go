@@ -162,6 +162,33 @@ func (s *Server) ListMyUserGrants(ctx context.Context, req *auth_pb.ListMyUserGr
 	}, nil
 }
 
+func (s *Server) RequestMyUserGrant(ctx context.Context, req *auth_pb.RequestMyUserGrantRequest) (*auth_pb.RequestMyUserGrantResponse, error) {
+	request := RequestMyUserGrantRequestToDomain(ctx, req)
+	details, err := s.command.RequestUserGrant(ctx, request, req.Reason, authz.GetCtxData(ctx).ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.RequestMyUserGrantResponse{
+		RequestId: request.AggregateID,
+		Details:   obj_grpc.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListMyUserGrantRequests(ctx context.Context, req *auth_pb.ListMyUserGrantRequestsRequest) (*auth_pb.ListMyUserGrantRequestsResponse, error) {
+	queries, err := ListMyUserGrantRequestsRequestToQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.query.SearchUserGrantRequests(ctx, queries, false)
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.ListMyUserGrantRequestsResponse{
+		Result:  user_grpc.UserGrantRequestsToPb(res.Requests),
+		Details: obj_grpc.ToListDetails(res.Count, res.Sequence, res.Timestamp),
+	}, nil
+}
+
 func (s *Server) ListMyProjectOrgs(ctx context.Context, req *auth_pb.ListMyProjectOrgsRequest) (*auth_pb.ListMyProjectOrgsResponse, error) {
 	queries, err := ListMyProjectOrgsRequestToQuery(req)
 	if err != nil {
@@ -2,10 +2,14 @@ package auth
 
 import (
 	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
 	"github.com/zitadel/zitadel/internal/api/grpc/user"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
 	auth_pb "github.com/zitadel/zitadel/pkg/grpc/auth"
 )
@@ -25,6 +29,7 @@ func ListMyUserGrantsRequestToQuery(ctx context.Context, req *auth_pb.ListMyUser
 		Queries: []query.SearchQuery{
 			userGrantUserID,
 		},
+		WithGroupGrants: req.WithGroupGrants,
 	}, nil
 }
 
@@ -55,5 +60,42 @@ func UserGrantToPb(grant *query.UserGrant) *auth_pb.UserGrant {
 		ProjectGrantId: grant.GrantID,
 		RoleKeys:       grant.Roles,
 		UserType:       user.TypeToPb(grant.UserType),
+		ValidFrom:      optionalTimestampToPb(grant.ValidFrom),
+		ValidUntil:     optionalTimestampToPb(grant.ValidUntil),
+		GroupId:        grant.GroupID,
+	}
+}
+
+func optionalTimestampToPb(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
 	}
+	return timestamppb.New(t)
+}
+
+func RequestMyUserGrantRequestToDomain(ctx context.Context, req *auth_pb.RequestMyUserGrantRequest) *domain.UserGrant {
+	return &domain.UserGrant{
+		UserID:         authz.GetCtxData(ctx).UserID,
+		ProjectID:      req.ProjectId,
+		ProjectGrantID: req.ProjectGrantId,
+		RoleKeys:       req.RoleKeys,
+	}
+}
+
+func ListMyUserGrantRequestsRequestToQuery(ctx context.Context, req *auth_pb.ListMyUserGrantRequestsRequest) (*query.UserGrantRequestSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	userIDQuery, err := query.NewUserGrantRequestUserIDSearchQuery(authz.GetCtxData(ctx).UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.UserGrantRequestSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: []query.SearchQuery{
+			userIDQuery,
+		},
+	}, nil
 }
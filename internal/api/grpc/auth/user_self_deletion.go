@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/pkg/grpc/auth"
+)
+
+func (s *Server) RequestMyUserDeletion(ctx context.Context, req *auth.RequestMyUserDeletionRequest) (*auth.RequestMyUserDeletionResponse, error) {
+	ctxData := authz.GetCtxData(ctx)
+	var (
+		details      *domain.ObjectDetails
+		deletionDate time.Time
+		err          error
+	)
+	if req.GetSessionId() != "" {
+		details, deletionDate, err = s.command.RequestHumanSelfDeletionWithSession(ctx, ctxData.UserID, ctxData.ResourceOwner, req.GetSessionId(), req.GetSessionToken())
+	} else {
+		details, deletionDate, err = s.command.RequestHumanSelfDeletion(ctx, ctxData.UserID, ctxData.ResourceOwner, req.GetPassword())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &auth.RequestMyUserDeletionResponse{
+		Details:      object.DomainToChangeDetailsPb(details),
+		DeletionDate: timestamppb.New(deletionDate),
+	}, nil
+}
+
+func (s *Server) CancelMyUserDeletion(ctx context.Context, _ *auth.CancelMyUserDeletionRequest) (*auth.CancelMyUserDeletionResponse, error) {
+	ctxData := authz.GetCtxData(ctx)
+	details, err := s.command.CancelHumanSelfDeletion(ctx, ctxData.UserID, ctxData.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.CancelMyUserDeletionResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) GetMyUserDeletion(ctx context.Context, _ *auth.GetMyUserDeletionRequest) (*auth.GetMyUserDeletionResponse, error) {
+	deletion, err := s.query.UserSelfDeletionByUserID(ctx, true, authz.GetCtxData(ctx).UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.GetMyUserDeletionResponse{
+		Details:      object.ToViewDetailsPb(deletion.Sequence, deletion.CreationDate, deletion.ChangeDate, deletion.ResourceOwner),
+		DeletionDate: timestamppb.New(deletion.ScheduledAt),
+	}, nil
+}
+
+// ExportMyUserData collects all personal data of the authenticated user by reusing the corresponding list endpoints
+func (s *Server) ExportMyUserData(ctx context.Context, _ *auth.ExportMyUserDataRequest) (*auth.ExportMyUserDataResponse, error) {
+	user, err := s.GetMyUser(ctx, &auth.GetMyUserRequest{})
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := s.ListMyMetadata(ctx, &auth.ListMyMetadataRequest{})
+	if err != nil {
+		return nil, err
+	}
+	grants, err := s.ListMyUserGrants(ctx, &auth.ListMyUserGrantsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := s.ListMyMemberships(ctx, &auth.ListMyMembershipsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	idpLinks, err := s.ListMyLinkedIDPs(ctx, &auth.ListMyLinkedIDPsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	authFactors, err := s.ListMyAuthFactors(ctx, &auth.ListMyAuthFactorsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	passwordless, err := s.ListMyPasswordless(ctx, &auth.ListMyPasswordlessRequest{})
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := s.ListMyUserSessions(ctx, &auth.ListMyUserSessionsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	trustedDevices, err := s.ListMyTrustedDevices(ctx, &auth.ListMyTrustedDevicesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &auth.ExportMyUserDataResponse{
+		User:           user.User,
+		Metadata:       metadata.Result,
+		UserGrants:     grants.Result,
+		Memberships:    memberships.Result,
+		LinkedIdps:     idpLinks.Result,
+		AuthFactors:    authFactors.Result,
+		Passwordless:   passwordless.Result,
+		Sessions:       sessions.Result,
+		TrustedDevices: trustedDevices.Result,
+	}, nil
+}
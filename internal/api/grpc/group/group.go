@@ -0,0 +1,97 @@
+package group
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query"
+	group_pb "github.com/zitadel/zitadel/pkg/grpc/group"
+)
+
+func GroupsToPb(groups []*query.Group) []*group_pb.Group {
+	g := make([]*group_pb.Group, len(groups))
+	for i, group := range groups {
+		g[i] = GroupToPb(group)
+	}
+	return g
+}
+
+func GroupToPb(group *query.Group) *group_pb.Group {
+	return &group_pb.Group{
+		Id:          group.ID,
+		Details:     object.ToViewDetailsPb(group.Sequence, group.CreationDate, group.ChangeDate, group.ResourceOwner),
+		State:       groupStateToPb(group.State),
+		Name:        group.Name,
+		Description: group.Description,
+	}
+}
+
+func groupStateToPb(state domain.GroupState) group_pb.GroupState {
+	switch state {
+	case domain.GroupStateActive:
+		return group_pb.GroupState_GROUP_STATE_ACTIVE
+	default:
+		return group_pb.GroupState_GROUP_STATE_UNSPECIFIED
+	}
+}
+
+func GroupQueriesToQuery(queries []*group_pb.GroupQuery) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = GroupQueryToQuery(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func GroupQueryToQuery(apiQuery *group_pb.GroupQuery) (query.SearchQuery, error) {
+	switch q := apiQuery.Query.(type) {
+	case *group_pb.GroupQuery_NameQuery:
+		return query.NewGroupNameSearchQuery(object.TextMethodToQuery(q.NameQuery.Method), q.NameQuery.Name)
+	case *group_pb.GroupQuery_UserIdQuery:
+		return query.NewGroupUserIDSearchQuery(q.UserIdQuery.UserId)
+	default:
+		return nil, errors.ThrowInvalidArgument(nil, "GROUP-Gq3lm", "List.Query.Invalid")
+	}
+}
+
+func GroupUsersToPb(users []*query.GroupUser) []*group_pb.GroupUser {
+	u := make([]*group_pb.GroupUser, len(users))
+	for i, user := range users {
+		u[i] = &group_pb.GroupUser{
+			UserId:             user.UserID,
+			Details:            object.ToViewDetailsPb(user.Sequence, user.CreationDate, user.ChangeDate, user.ResourceOwner),
+			PreferredLoginName: user.PreferredLoginName,
+			DisplayName:        user.DisplayName,
+		}
+	}
+	return u
+}
+
+func GroupGrantsToPb(grants []*query.GroupGrant) []*group_pb.GroupGrant {
+	g := make([]*group_pb.GroupGrant, len(grants))
+	for i, grant := range grants {
+		g[i] = &group_pb.GroupGrant{
+			Details:        object.ToViewDetailsPb(grant.Sequence, grant.CreationDate, grant.ChangeDate, grant.ResourceOwner),
+			ProjectId:      grant.ProjectID,
+			ProjectGrantId: grant.GrantID,
+			RoleKeys:       grant.Roles,
+			ProjectName:    grant.ProjectName,
+		}
+	}
+	return g
+}
+
+func GroupMembershipsToPb(memberships []*query.GroupMembership) []*group_pb.GroupMembership {
+	m := make([]*group_pb.GroupMembership, len(memberships))
+	for i, membership := range memberships {
+		m[i] = &group_pb.GroupMembership{
+			Details:   object.ToViewDetailsPb(membership.Sequence, membership.CreationDate, membership.ChangeDate, membership.ResourceOwner),
+			ProjectId: membership.ProjectID,
+			Roles:     membership.Roles,
+		}
+	}
+	return m
+}
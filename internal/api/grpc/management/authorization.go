@@ -0,0 +1,31 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	obj_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/api/grpc/user"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) CheckUserAuthorizations(ctx context.Context, req *mgmt_pb.CheckUserAuthorizationsRequest) (*mgmt_pb.CheckUserAuthorizationsResponse, error) {
+	results, err := s.query.CheckAuthorizations(ctx, authz.GetCtxData(ctx).OrgID, AuthorizationChecksToQuery(req.Checks))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.CheckUserAuthorizationsResponse{
+		Results: AuthorizationCheckResultsToPb(results),
+	}, nil
+}
+
+func (s *Server) ListAuthorizedUsers(ctx context.Context, req *mgmt_pb.ListAuthorizedUsersRequest) (*mgmt_pb.ListAuthorizedUsersResponse, error) {
+	res, err := s.query.AuthorizedUsers(ctx, authz.GetCtxData(ctx).OrgID, req.ProjectId, req.RoleKey, req.OrgId, ListAuthorizedUsersRequestToSearchRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListAuthorizedUsersResponse{
+		Result:  user.UserGrantsToPb(s.assetAPIPrefix(ctx), res.UserGrants),
+		Details: obj_grpc.ToListDetails(res.Count, res.Sequence, res.Timestamp),
+	}, nil
+}
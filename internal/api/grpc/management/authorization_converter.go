@@ -0,0 +1,41 @@
+package management
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func AuthorizationChecksToQuery(checks []*mgmt_pb.AuthorizationCheck) []*query.AuthorizationCheck {
+	q := make([]*query.AuthorizationCheck, len(checks))
+	for i, check := range checks {
+		q[i] = &query.AuthorizationCheck{
+			UserID:     check.UserId,
+			ProjectID:  check.ProjectId,
+			OrgID:      check.OrgId,
+			Role:       check.GetRoleKey(),
+			Permission: check.GetPermission(),
+		}
+	}
+	return q
+}
+
+func AuthorizationCheckResultsToPb(results []*query.AuthorizationCheckResult) []*mgmt_pb.AuthorizationCheckResult {
+	r := make([]*mgmt_pb.AuthorizationCheckResult, len(results))
+	for i, result := range results {
+		r[i] = &mgmt_pb.AuthorizationCheckResult{
+			Allowed: result.Allowed,
+			OrgIds:  result.OrgIDs,
+		}
+	}
+	return r
+}
+
+func ListAuthorizedUsersRequestToSearchRequest(req *mgmt_pb.ListAuthorizedUsersRequest) query.SearchRequest {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	return query.SearchRequest{
+		Offset: offset,
+		Limit:  limit,
+		Asc:    asc,
+	}
+}
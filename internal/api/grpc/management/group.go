@@ -0,0 +1,196 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	group_grpc "github.com/zitadel/zitadel/internal/api/grpc/group"
+	obj_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) GetGroupByID(ctx context.Context, req *mgmt_pb.GetGroupByIDRequest) (*mgmt_pb.GetGroupByIDResponse, error) {
+	ownerQuery, err := query.NewGroupResourceOwnerSearchQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	group, err := s.query.GroupByID(ctx, true, req.Id, ownerQuery)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetGroupByIDResponse{
+		Group: group_grpc.GroupToPb(group),
+	}, nil
+}
+
+func (s *Server) ListGroups(ctx context.Context, req *mgmt_pb.ListGroupsRequest) (*mgmt_pb.ListGroupsResponse, error) {
+	queries, err := ListGroupsRequestToQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := s.query.SearchGroups(ctx, queries, false)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListGroupsResponse{
+		Result:  group_grpc.GroupsToPb(groups.Groups),
+		Details: obj_grpc.ToListDetails(groups.Count, groups.Sequence, groups.Timestamp),
+	}, nil
+}
+
+func (s *Server) AddGroup(ctx context.Context, req *mgmt_pb.AddGroupRequest) (*mgmt_pb.AddGroupResponse, error) {
+	id, details, err := s.command.AddGroup(ctx, AddGroupRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddGroupResponse{
+		Id:      id,
+		Details: obj_grpc.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateGroup(ctx context.Context, req *mgmt_pb.UpdateGroupRequest) (*mgmt_pb.UpdateGroupResponse, error) {
+	details, err := s.command.ChangeGroup(ctx, UpdateGroupRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateGroupResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveGroup(ctx context.Context, req *mgmt_pb.RemoveGroupRequest) (*mgmt_pb.RemoveGroupResponse, error) {
+	details, err := s.command.RemoveGroup(ctx, req.Id, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveGroupResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListGroupUsers(ctx context.Context, req *mgmt_pb.ListGroupUsersRequest) (*mgmt_pb.ListGroupUsersResponse, error) {
+	queries, err := ListGroupUsersRequestToQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	users, err := s.query.SearchGroupUsers(ctx, queries, false)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListGroupUsersResponse{
+		Result:  group_grpc.GroupUsersToPb(users.Users),
+		Details: obj_grpc.ToListDetails(users.Count, users.Sequence, users.Timestamp),
+	}, nil
+}
+
+func (s *Server) AddGroupUser(ctx context.Context, req *mgmt_pb.AddGroupUserRequest) (*mgmt_pb.AddGroupUserResponse, error) {
+	details, err := s.command.AddGroupUser(ctx, req.GroupId, req.UserId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddGroupUserResponse{
+		Details: obj_grpc.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveGroupUser(ctx context.Context, req *mgmt_pb.RemoveGroupUserRequest) (*mgmt_pb.RemoveGroupUserResponse, error) {
+	details, err := s.command.RemoveGroupUser(ctx, req.GroupId, req.UserId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveGroupUserResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListGroupGrants(ctx context.Context, req *mgmt_pb.ListGroupGrantsRequest) (*mgmt_pb.ListGroupGrantsResponse, error) {
+	queries, err := ListGroupGrantsRequestToQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := s.query.SearchGroupGrants(ctx, queries, false)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListGroupGrantsResponse{
+		Result:  group_grpc.GroupGrantsToPb(grants.Grants),
+		Details: obj_grpc.ToListDetails(grants.Count, grants.Sequence, grants.Timestamp),
+	}, nil
+}
+
+func (s *Server) AddGroupGrant(ctx context.Context, req *mgmt_pb.AddGroupGrantRequest) (*mgmt_pb.AddGroupGrantResponse, error) {
+	details, err := s.command.AddGroupGrant(ctx, AddGroupGrantRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddGroupGrantResponse{
+		Details: obj_grpc.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateGroupGrant(ctx context.Context, req *mgmt_pb.UpdateGroupGrantRequest) (*mgmt_pb.UpdateGroupGrantResponse, error) {
+	details, err := s.command.ChangeGroupGrant(ctx, UpdateGroupGrantRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateGroupGrantResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveGroupGrant(ctx context.Context, req *mgmt_pb.RemoveGroupGrantRequest) (*mgmt_pb.RemoveGroupGrantResponse, error) {
+	details, err := s.command.RemoveGroupGrant(ctx, req.GroupId, req.ProjectId, req.ProjectGrantId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveGroupGrantResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListGroupMemberships(ctx context.Context, req *mgmt_pb.ListGroupMembershipsRequest) (*mgmt_pb.ListGroupMembershipsResponse, error) {
+	queries, err := ListGroupMembershipsRequestToQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := s.query.SearchGroupMemberships(ctx, queries, false)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListGroupMembershipsResponse{
+		Result:  group_grpc.GroupMembershipsToPb(memberships.Memberships),
+		Details: obj_grpc.ToListDetails(memberships.Count, memberships.Sequence, memberships.Timestamp),
+	}, nil
+}
+
+func (s *Server) AddGroupMembership(ctx context.Context, req *mgmt_pb.AddGroupMembershipRequest) (*mgmt_pb.AddGroupMembershipResponse, error) {
+	details, err := s.command.AddGroupMembership(ctx, AddGroupMembershipRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddGroupMembershipResponse{
+		Details: obj_grpc.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateGroupMembership(ctx context.Context, req *mgmt_pb.UpdateGroupMembershipRequest) (*mgmt_pb.UpdateGroupMembershipResponse, error) {
+	details, err := s.command.ChangeGroupMembership(ctx, UpdateGroupMembershipRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateGroupMembershipResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveGroupMembership(ctx context.Context, req *mgmt_pb.RemoveGroupMembershipRequest) (*mgmt_pb.RemoveGroupMembershipResponse, error) {
+	details, err := s.command.RemoveGroupMembership(ctx, req.GroupId, req.ProjectId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveGroupMembershipResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
@@ -0,0 +1,144 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	group_grpc "github.com/zitadel/zitadel/internal/api/grpc/group"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func AddGroupRequestToDomain(req *mgmt_pb.AddGroupRequest) *domain.Group {
+	return &domain.Group{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+}
+
+func UpdateGroupRequestToDomain(req *mgmt_pb.UpdateGroupRequest) *domain.Group {
+	return &domain.Group{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID: req.Id,
+		},
+		Name:        req.Name,
+		Description: req.Description,
+	}
+}
+
+func AddGroupGrantRequestToDomain(req *mgmt_pb.AddGroupGrantRequest) *domain.GroupGrant {
+	return &domain.GroupGrant{
+		GroupID:   req.GroupId,
+		ProjectID: req.ProjectId,
+		GrantID:   req.ProjectGrantId,
+		RoleKeys:  req.RoleKeys,
+	}
+}
+
+func UpdateGroupGrantRequestToDomain(req *mgmt_pb.UpdateGroupGrantRequest) *domain.GroupGrant {
+	return &domain.GroupGrant{
+		GroupID:   req.GroupId,
+		ProjectID: req.ProjectId,
+		GrantID:   req.ProjectGrantId,
+		RoleKeys:  req.RoleKeys,
+	}
+}
+
+func AddGroupMembershipRequestToDomain(req *mgmt_pb.AddGroupMembershipRequest) *domain.GroupMembership {
+	return &domain.GroupMembership{
+		GroupID:   req.GroupId,
+		ProjectID: req.ProjectId,
+		Roles:     req.Roles,
+	}
+}
+
+func UpdateGroupMembershipRequestToDomain(req *mgmt_pb.UpdateGroupMembershipRequest) *domain.GroupMembership {
+	return &domain.GroupMembership{
+		GroupID:   req.GroupId,
+		ProjectID: req.ProjectId,
+		Roles:     req.Roles,
+	}
+}
+
+func ListGroupsRequestToQuery(ctx context.Context, req *mgmt_pb.ListGroupsRequest) (*query.GroupSearchQueries, error) {
+	queries, err := group_grpc.GroupQueriesToQuery(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	request := &query.GroupSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: queries,
+	}
+	if err = request.AppendMyResourceOwnerQuery(authz.GetCtxData(ctx).OrgID); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func ListGroupUsersRequestToQuery(ctx context.Context, req *mgmt_pb.ListGroupUsersRequest) (*query.GroupUserSearchQueries, error) {
+	groupIDQuery, err := query.NewGroupUserGroupIDSearchQuery(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	request := &query.GroupUserSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: []query.SearchQuery{groupIDQuery},
+	}
+	if err = request.AppendMyResourceOwnerQuery(authz.GetCtxData(ctx).OrgID); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func ListGroupGrantsRequestToQuery(ctx context.Context, req *mgmt_pb.ListGroupGrantsRequest) (*query.GroupGrantSearchQueries, error) {
+	groupIDQuery, err := query.NewGroupGrantGroupIDSearchQuery(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	request := &query.GroupGrantSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: []query.SearchQuery{groupIDQuery},
+	}
+	if err = request.AppendMyResourceOwnerQuery(authz.GetCtxData(ctx).OrgID); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func ListGroupMembershipsRequestToQuery(ctx context.Context, req *mgmt_pb.ListGroupMembershipsRequest) (*query.GroupMembershipSearchQueries, error) {
+	groupIDQuery, err := query.NewGroupMembershipGroupIDSearchQuery(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	request := &query.GroupMembershipSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: []query.SearchQuery{groupIDQuery},
+	}
+	if err = request.AppendMyResourceOwnerQuery(authz.GetCtxData(ctx).OrgID); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
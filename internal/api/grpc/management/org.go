@@ -135,6 +135,41 @@ func (s *Server) RemoveOrg(ctx context.Context, req *mgmt_pb.RemoveOrgRequest) (
 	return &mgmt_pb.RemoveOrgResponse{Details: object.DomainToChangeDetailsPb(details)}, nil
 }
 
+func (s *Server) AddChildOrg(ctx context.Context, req *mgmt_pb.AddChildOrgRequest) (*mgmt_pb.AddChildOrgResponse, error) {
+	userIDs, err := s.getClaimedUserIDsOfOrgDomain(ctx, domain.NewIAMDomainName(req.Name, authz.GetInstance(ctx).RequestedDomain()), "")
+	if err != nil {
+		return nil, err
+	}
+	ctxData := authz.GetCtxData(ctx)
+	org, err := s.command.AddChildOrg(ctx, ctxData.OrgID, req.Name, ctxData.UserID, ctxData.ResourceOwner, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddChildOrgResponse{
+		Id: org.AggregateID,
+		Details: object.AddToDetailsPb(
+			org.Sequence,
+			org.ChangeDate,
+			org.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) ListChildOrgs(ctx context.Context, req *mgmt_pb.ListChildOrgsRequest) (*mgmt_pb.ListChildOrgsResponse, error) {
+	queries, err := ListChildOrgsRequestToModel(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	orgs, err := s.query.SearchOrgs(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListChildOrgsResponse{
+		Result:  org_grpc.OrgViewsToPb(orgs.Orgs),
+		Details: object.ToListDetails(orgs.Count, orgs.Sequence, orgs.Timestamp),
+	}, nil
+}
+
 func (s *Server) GetDomainPolicy(ctx context.Context, req *mgmt_pb.GetDomainPolicyRequest) (*mgmt_pb.GetDomainPolicyResponse, error) {
 	policy, err := s.query.DomainPolicyByOrg(ctx, true, authz.GetCtxData(ctx).OrgID, false)
 	if err != nil {
@@ -251,7 +286,10 @@ func (s *Server) ListOrgMemberRoles(ctx context.Context, _ *mgmt_pb.ListOrgMembe
 	if err != nil {
 		return nil, err
 	}
-	roles := s.query.GetOrgMemberRoles(authz.GetCtxData(ctx).OrgID == instance.DefaultOrgID)
+	roles, err := s.query.GetOrgMemberRoles(ctx, authz.GetCtxData(ctx).OrgID == instance.DefaultOrgID)
+	if err != nil {
+		return nil, err
+	}
 	return &mgmt_pb.ListOrgMemberRolesResponse{
 		Result: roles,
 	}, nil
@@ -12,6 +12,7 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 	"github.com/zitadel/zitadel/internal/query"
 	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+	org_pb "github.com/zitadel/zitadel/pkg/grpc/org"
 )
 
 func ListOrgDomainsRequestToModel(req *mgmt_pb.ListOrgDomainsRequest) (*query.OrgDomainSearchQueries, error) {
@@ -31,6 +32,31 @@ func ListOrgDomainsRequestToModel(req *mgmt_pb.ListOrgDomainsRequest) (*query.Or
 	}, nil
 }
 
+func ListChildOrgsRequestToModel(ctx context.Context, req *mgmt_pb.ListChildOrgsRequest) (*query.OrgSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries, err := org_grpc.OrgQueriesToQuery(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	parentQuery, err := query.NewOrgParentIDSearchQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	sortingColumn := query.Column{}
+	if req.SortingColumn == org_pb.OrgFieldName_ORG_FIELD_NAME_NAME {
+		sortingColumn = query.OrgColumnName
+	}
+	return &query.OrgSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			SortingColumn: sortingColumn,
+			Asc:           asc,
+		},
+		Queries: append(queries, parentQuery),
+	}, nil
+}
+
 func AddOrgDomainRequestToDomain(ctx context.Context, req *mgmt_pb.AddOrgDomainRequest) *domain.OrgDomain {
 	return &domain.OrgDomain{
 		ObjectRoot: models.ObjectRoot{
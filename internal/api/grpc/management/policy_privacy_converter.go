@@ -7,18 +7,20 @@ import (
 
 func AddPrivacyPolicyToDomain(req *mgmt_pb.AddCustomPrivacyPolicyRequest) *domain.PrivacyPolicy {
 	return &domain.PrivacyPolicy{
-		TOSLink:      req.TosLink,
-		PrivacyLink:  req.PrivacyLink,
-		HelpLink:     req.HelpLink,
-		SupportEmail: domain.EmailAddress(req.SupportEmail),
+		TOSLink:           req.TosLink,
+		PrivacyLink:       req.PrivacyLink,
+		HelpLink:          req.HelpLink,
+		SupportEmail:      domain.EmailAddress(req.SupportEmail),
+		AllowSelfDeletion: req.AllowSelfDeletion,
 	}
 }
 
 func UpdatePrivacyPolicyToDomain(req *mgmt_pb.UpdateCustomPrivacyPolicyRequest) *domain.PrivacyPolicy {
 	return &domain.PrivacyPolicy{
-		TOSLink:      req.TosLink,
-		PrivacyLink:  req.PrivacyLink,
-		HelpLink:     req.HelpLink,
-		SupportEmail: domain.EmailAddress(req.SupportEmail),
+		TOSLink:           req.TosLink,
+		PrivacyLink:       req.PrivacyLink,
+		HelpLink:          req.HelpLink,
+		SupportEmail:      domain.EmailAddress(req.SupportEmail),
+		AllowSelfDeletion: req.AllowSelfDeletion,
 	}
 }
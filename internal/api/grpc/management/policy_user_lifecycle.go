@@ -0,0 +1,81 @@
+package management
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	policy_grpc "github.com/zitadel/zitadel/internal/api/grpc/policy"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) GetUserLifecyclePolicy(ctx context.Context, req *mgmt_pb.GetUserLifecyclePolicyRequest) (*mgmt_pb.GetUserLifecyclePolicyResponse, error) {
+	policy, err := s.query.UserLifecyclePolicyByOrg(ctx, true, authz.GetCtxData(ctx).OrgID, false)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetUserLifecyclePolicyResponse{Policy: policy_grpc.ModelUserLifecyclePolicyToPb(policy)}, nil
+}
+
+func (s *Server) GetDefaultUserLifecyclePolicy(ctx context.Context, req *mgmt_pb.GetDefaultUserLifecyclePolicyRequest) (*mgmt_pb.GetDefaultUserLifecyclePolicyResponse, error) {
+	policy, err := s.query.DefaultUserLifecyclePolicy(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetDefaultUserLifecyclePolicyResponse{Policy: policy_grpc.ModelUserLifecyclePolicyToPb(policy)}, nil
+}
+
+func (s *Server) AddCustomUserLifecyclePolicy(ctx context.Context, req *mgmt_pb.AddCustomUserLifecyclePolicyRequest) (*mgmt_pb.AddCustomUserLifecyclePolicyResponse, error) {
+	policy, err := s.command.AddUserLifecyclePolicy(ctx, authz.GetCtxData(ctx).OrgID, AddUserLifecyclePolicyToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddCustomUserLifecyclePolicyResponse{
+		Details: object.AddToDetailsPb(
+			policy.Sequence,
+			policy.ChangeDate,
+			policy.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) UpdateCustomUserLifecyclePolicy(ctx context.Context, req *mgmt_pb.UpdateCustomUserLifecyclePolicyRequest) (*mgmt_pb.UpdateCustomUserLifecyclePolicyResponse, error) {
+	policy, err := s.command.ChangeUserLifecyclePolicy(ctx, authz.GetCtxData(ctx).OrgID, UpdateUserLifecyclePolicyToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateCustomUserLifecyclePolicyResponse{
+		Details: object.ChangeToDetailsPb(
+			policy.Sequence,
+			policy.ChangeDate,
+			policy.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) ResetUserLifecyclePolicyToDefault(ctx context.Context, req *mgmt_pb.ResetUserLifecyclePolicyToDefaultRequest) (*mgmt_pb.ResetUserLifecyclePolicyToDefaultResponse, error) {
+	objectDetails, err := s.command.RemoveUserLifecyclePolicy(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ResetUserLifecyclePolicyToDefaultResponse{
+		Details: object.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
+func (s *Server) ListUserLifecycleActions(ctx context.Context, req *mgmt_pb.ListUserLifecycleActionsRequest) (*mgmt_pb.ListUserLifecycleActionsResponse, error) {
+	orgQuery, err := query.NewUserActivityResourceOwnerSearchQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.query.UserLifecycleActions(ctx, nil, time.Now(), &query.UserActivitySearchQueries{Queries: []query.SearchQuery{orgQuery}})
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListUserLifecycleActionsResponse{
+		Details: object.ToListDetails(res.Count, res.Sequence, res.Timestamp),
+		Result:  policy_grpc.UserLifecycleActionsToPb(res.Actions),
+	}, nil
+}
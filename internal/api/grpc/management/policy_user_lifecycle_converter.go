@@ -0,0 +1,22 @@
+package management
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	mgmt "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func AddUserLifecyclePolicyToDomain(p *mgmt.AddCustomUserLifecyclePolicyRequest) *domain.UserLifecyclePolicy {
+	return &domain.UserLifecyclePolicy{
+		InactivityDeactivation: p.InactivityDeactivation.AsDuration(),
+		DeactivatedRemoval:     p.DeactivatedRemoval.AsDuration(),
+		NotificationPeriod:     p.NotificationPeriod.AsDuration(),
+	}
+}
+
+func UpdateUserLifecyclePolicyToDomain(p *mgmt.UpdateCustomUserLifecyclePolicyRequest) *domain.UserLifecyclePolicy {
+	return &domain.UserLifecyclePolicy{
+		InactivityDeactivation: p.InactivityDeactivation.AsDuration(),
+		DeactivatedRemoval:     p.DeactivatedRemoval.AsDuration(),
+		NotificationPeriod:     p.NotificationPeriod.AsDuration(),
+	}
+}
@@ -330,6 +330,128 @@ func (s *Server) RemoveProjectRole(ctx context.Context, req *mgmt_pb.RemoveProje
 	}, nil
 }
 
+func (s *Server) ListProjectScopes(ctx context.Context, req *mgmt_pb.ListProjectScopesRequest) (*mgmt_pb.ListProjectScopesResponse, error) {
+	queries, err := listProjectScopesRequestToModel(req)
+	if err != nil {
+		return nil, err
+	}
+	err = queries.AppendMyResourceOwnerQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	err = queries.AppendProjectIDQuery(req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+	scopes, err := s.query.SearchProjectScopes(ctx, true, queries, false)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListProjectScopesResponse{
+		Result:  project_grpc.ScopeViewsToPb(scopes.ProjectScopes),
+		Details: object_grpc.ToListDetails(scopes.Count, scopes.Sequence, scopes.Timestamp),
+	}, nil
+}
+
+func (s *Server) AddProjectScope(ctx context.Context, req *mgmt_pb.AddProjectScopeRequest) (*mgmt_pb.AddProjectScopeResponse, error) {
+	scope, err := s.command.AddProjectScope(ctx, AddProjectScopeRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddProjectScopeResponse{
+		Details: object_grpc.AddToDetailsPb(
+			scope.Sequence,
+			scope.ChangeDate,
+			scope.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) UpdateProjectScope(ctx context.Context, req *mgmt_pb.UpdateProjectScopeRequest) (*mgmt_pb.UpdateProjectScopeResponse, error) {
+	scope, err := s.command.ChangeProjectScope(ctx, UpdateProjectScopeRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateProjectScopeResponse{
+		Details: object_grpc.ChangeToDetailsPb(
+			scope.Sequence,
+			scope.ChangeDate,
+			scope.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) RemoveProjectScope(ctx context.Context, req *mgmt_pb.RemoveProjectScopeRequest) (*mgmt_pb.RemoveProjectScopeResponse, error) {
+	details, err := s.command.RemoveProjectScope(ctx, req.ProjectId, req.ScopeKey, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveProjectScopeResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListProjectAuthorizationDetailTypes(ctx context.Context, req *mgmt_pb.ListProjectAuthorizationDetailTypesRequest) (*mgmt_pb.ListProjectAuthorizationDetailTypesResponse, error) {
+	queries, err := listProjectAuthorizationDetailTypesRequestToModel(req)
+	if err != nil {
+		return nil, err
+	}
+	err = queries.AppendMyResourceOwnerQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	err = queries.AppendProjectIDQuery(req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+	detailTypes, err := s.query.SearchProjectAuthorizationDetailTypes(ctx, true, queries, false)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListProjectAuthorizationDetailTypesResponse{
+		Result:  project_grpc.AuthorizationDetailTypeViewsToPb(detailTypes.ProjectAuthorizationDetailTypes),
+		Details: object_grpc.ToListDetails(detailTypes.Count, detailTypes.Sequence, detailTypes.Timestamp),
+	}, nil
+}
+
+func (s *Server) AddProjectAuthorizationDetailType(ctx context.Context, req *mgmt_pb.AddProjectAuthorizationDetailTypeRequest) (*mgmt_pb.AddProjectAuthorizationDetailTypeResponse, error) {
+	detailType, err := s.command.AddProjectAuthorizationDetailType(ctx, AddProjectAuthorizationDetailTypeRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddProjectAuthorizationDetailTypeResponse{
+		Details: object_grpc.AddToDetailsPb(
+			detailType.Sequence,
+			detailType.ChangeDate,
+			detailType.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) UpdateProjectAuthorizationDetailType(ctx context.Context, req *mgmt_pb.UpdateProjectAuthorizationDetailTypeRequest) (*mgmt_pb.UpdateProjectAuthorizationDetailTypeResponse, error) {
+	detailType, err := s.command.ChangeProjectAuthorizationDetailType(ctx, UpdateProjectAuthorizationDetailTypeRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateProjectAuthorizationDetailTypeResponse{
+		Details: object_grpc.ChangeToDetailsPb(
+			detailType.Sequence,
+			detailType.ChangeDate,
+			detailType.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) RemoveProjectAuthorizationDetailType(ctx context.Context, req *mgmt_pb.RemoveProjectAuthorizationDetailTypeRequest) (*mgmt_pb.RemoveProjectAuthorizationDetailTypeResponse, error) {
+	details, err := s.command.RemoveProjectAuthorizationDetailType(ctx, req.ProjectId, req.Type, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveProjectAuthorizationDetailTypeResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
 func (s *Server) ListProjectMemberRoles(ctx context.Context, _ *mgmt_pb.ListProjectMemberRolesRequest) (*mgmt_pb.ListProjectMemberRolesResponse, error) {
 	roles, err := s.query.GetProjectMemberRoles(ctx)
 	if err != nil {
@@ -85,6 +85,52 @@ func UpdateProjectRoleRequestToDomain(req *mgmt_pb.UpdateProjectRoleRequest) *do
 	}
 }
 
+func AddProjectScopeRequestToDomain(req *mgmt_pb.AddProjectScopeRequest) *domain.ProjectScope {
+	return &domain.ProjectScope{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID: req.ProjectId,
+		},
+		Key:         req.ScopeKey,
+		DisplayName: req.DisplayName,
+		Description: req.Description,
+	}
+}
+
+func UpdateProjectScopeRequestToDomain(req *mgmt_pb.UpdateProjectScopeRequest) *domain.ProjectScope {
+	return &domain.ProjectScope{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID: req.ProjectId,
+		},
+		Key:         req.ScopeKey,
+		DisplayName: req.DisplayName,
+		Description: req.Description,
+	}
+}
+
+func AddProjectAuthorizationDetailTypeRequestToDomain(req *mgmt_pb.AddProjectAuthorizationDetailTypeRequest) *domain.ProjectAuthorizationDetailType {
+	return &domain.ProjectAuthorizationDetailType{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID: req.ProjectId,
+		},
+		Type:        req.Type,
+		DisplayName: req.DisplayName,
+		Description: req.Description,
+		Schema:      []byte(req.Schema),
+	}
+}
+
+func UpdateProjectAuthorizationDetailTypeRequestToDomain(req *mgmt_pb.UpdateProjectAuthorizationDetailTypeRequest) *domain.ProjectAuthorizationDetailType {
+	return &domain.ProjectAuthorizationDetailType{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID: req.ProjectId,
+		},
+		Type:        req.Type,
+		DisplayName: req.DisplayName,
+		Description: req.Description,
+		Schema:      []byte(req.Schema),
+	}
+}
+
 func ProjectGrantsToIDs(projectGrants *query.ProjectGrants) []string {
 	converted := make([]string, len(projectGrants.ProjectGrants))
 	for i, grant := range projectGrants.ProjectGrants {
@@ -165,6 +211,38 @@ func listGrantedProjectRolesRequestToModel(req *mgmt_pb.ListGrantedProjectRolesR
 	}, nil
 }
 
+func listProjectScopesRequestToModel(req *mgmt_pb.ListProjectScopesRequest) (*query.ProjectScopeSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries, err := proj_grpc.ScopeQueriesToModel(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	return &query.ProjectScopeSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: queries,
+	}, nil
+}
+
+func listProjectAuthorizationDetailTypesRequestToModel(req *mgmt_pb.ListProjectAuthorizationDetailTypesRequest) (*query.ProjectAuthorizationDetailTypeSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries, err := proj_grpc.AuthorizationDetailTypeQueriesToModel(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	return &query.ProjectAuthorizationDetailTypeSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: queries,
+	}, nil
+}
+
 func ListProjectMembersRequestToModel(ctx context.Context, req *mgmt_pb.ListProjectMembersRequest) (*query.ProjectMembersQuery, error) {
 	offset, limit, asc := object.ListQueryToModel(req.Query)
 	queries, err := member_grpc.MemberQueriesToQuery(req.Queries)
@@ -716,6 +716,41 @@ func (s *Server) RemoveHumanPasswordless(ctx context.Context, req *mgmt_pb.Remov
 	}, nil
 }
 
+func (s *Server) ListUserTrustedDevices(ctx context.Context, req *mgmt_pb.ListUserTrustedDevicesRequest) (*mgmt_pb.ListUserTrustedDevicesResponse, error) {
+	queries := new(query.TrustedDeviceSearchQueries)
+	userIDQuery, err := query.NewTrustedDeviceUserIDSearchQuery(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	notExpiredQuery, err := query.NewTrustedDeviceNotExpiredSearchQuery()
+	if err != nil {
+		return nil, err
+	}
+	queries.Queries = []query.SearchQuery{userIDQuery, notExpiredQuery}
+	err = queries.AppendMyResourceOwnerQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	devices, err := s.query.SearchTrustedDevices(ctx, queries, false)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListUserTrustedDevicesResponse{
+		Result:  user_grpc.TrustedDevicesToPb(devices.TrustedDevices),
+		Details: obj_grpc.ToListDetails(devices.Count, devices.Sequence, devices.Timestamp),
+	}, nil
+}
+
+func (s *Server) RevokeUserTrustedDevice(ctx context.Context, req *mgmt_pb.RevokeUserTrustedDeviceRequest) (*mgmt_pb.RevokeUserTrustedDeviceResponse, error) {
+	objectDetails, err := s.command.HumanRevokeTrustedDevice(ctx, req.UserId, authz.GetCtxData(ctx).OrgID, req.UserAgentId)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RevokeUserTrustedDeviceResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
 func (s *Server) UpdateMachine(ctx context.Context, req *mgmt_pb.UpdateMachineRequest) (*mgmt_pb.UpdateMachineResponse, error) {
 	machine := UpdateMachineRequestToCommand(req, authz.GetCtxData(ctx).OrgID)
 	objectDetails, err := s.command.ChangeMachine(ctx, machine)
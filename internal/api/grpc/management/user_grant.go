@@ -76,6 +76,16 @@ func (s *Server) UpdateUserGrant(ctx context.Context, req *mgmt_pb.UpdateUserGra
 	}, nil
 }
 
+func (s *Server) SetUserGrantValidity(ctx context.Context, req *mgmt_pb.SetUserGrantValidityRequest) (*mgmt_pb.SetUserGrantValidityResponse, error) {
+	objectDetails, err := s.command.SetUserGrantValidity(ctx, req.GrantId, authz.GetCtxData(ctx).OrgID, timestampToTime(req.ValidFrom), timestampToTime(req.ValidUntil))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetUserGrantValidityResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
 func (s *Server) DeactivateUserGrant(ctx context.Context, req *mgmt_pb.DeactivateUserGrantRequest) (*mgmt_pb.DeactivateUserGrantResponse, error) {
 	objectDetails, err := s.command.DeactivateUserGrant(ctx, req.GrantId, authz.GetCtxData(ctx).OrgID)
 	if err != nil {
@@ -113,3 +123,40 @@ func (s *Server) BulkRemoveUserGrant(ctx context.Context, req *mgmt_pb.BulkRemov
 	}
 	return &mgmt_pb.BulkRemoveUserGrantResponse{}, nil
 }
+
+func (s *Server) ListUserGrantRequests(ctx context.Context, req *mgmt_pb.ListUserGrantRequestsRequest) (*mgmt_pb.ListUserGrantRequestsResponse, error) {
+	queries, err := ListUserGrantRequestsRequestToQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.query.SearchUserGrantRequests(ctx, queries, false)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListUserGrantRequestsResponse{
+		Result:  user.UserGrantRequestsToPb(res.Requests),
+		Details: obj_grpc.ToListDetails(res.Count, res.Sequence, res.Timestamp),
+	}, nil
+}
+
+func (s *Server) ApproveUserGrantRequest(ctx context.Context, req *mgmt_pb.ApproveUserGrantRequestRequest) (*mgmt_pb.ApproveUserGrantRequestResponse, error) {
+	objectDetails, err := s.command.ApproveUserGrantRequest(ctx, req.RequestId, authz.GetCtxData(ctx).OrgID, timestampToTime(req.ValidFrom), timestampToTime(req.ValidUntil))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ApproveUserGrantRequestResponse{
+		// the user grant is created on the aggregate of the request
+		UserGrantId: req.RequestId,
+		Details:     obj_grpc.DomainToAddDetailsPb(objectDetails),
+	}, nil
+}
+
+func (s *Server) DenyUserGrantRequest(ctx context.Context, req *mgmt_pb.DenyUserGrantRequestRequest) (*mgmt_pb.DenyUserGrantRequestResponse, error) {
+	objectDetails, err := s.command.DenyUserGrantRequest(ctx, req.RequestId, authz.GetCtxData(ctx).OrgID, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.DenyUserGrantRequestResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
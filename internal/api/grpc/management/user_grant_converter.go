@@ -2,6 +2,9 @@ package management
 
 import (
 	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
@@ -34,7 +37,8 @@ func ListUserGrantsRequestToQuery(ctx context.Context, req *mgmt_pb.ListUserGran
 			Limit:  limit,
 			Asc:    asc,
 		},
-		Queries: queries,
+		Queries:         queries,
+		WithGroupGrants: req.WithGroupGrants,
 	}
 
 	return request, nil
@@ -55,6 +59,8 @@ func AddUserGrantRequestToDomain(req *mgmt_pb.AddUserGrantRequest) *domain.UserG
 		ProjectID:      req.ProjectId,
 		ProjectGrantID: req.ProjectGrantId,
 		RoleKeys:       req.RoleKeys,
+		ValidFrom:      timestampToTime(req.ValidFrom),
+		ValidUntil:     timestampToTime(req.ValidUntil),
 	}
 }
 
@@ -68,3 +74,32 @@ func UpdateUserGrantRequestToDomain(req *mgmt_pb.UpdateUserGrantRequest) *domain
 	}
 
 }
+
+func ListUserGrantRequestsRequestToQuery(ctx context.Context, req *mgmt_pb.ListUserGrantRequestsRequest) (*query.UserGrantRequestSearchQueries, error) {
+	queries, err := user_grpc.UserGrantRequestQueriesToQuery(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	request := &query.UserGrantRequestSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: queries,
+	}
+	if err = request.AppendMyResourceOwnerQuery(authz.GetCtxData(ctx).OrgID); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// timestampToTime returns the zero time for unset timestamps,
+// which represents an open bound of the grant validity
+func timestampToTime(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.AsTime()
+}
@@ -0,0 +1,73 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	user_grpc "github.com/zitadel/zitadel/internal/api/grpc/user"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) CreateUserImportJob(ctx context.Context, req *mgmt_pb.CreateUserImportJobRequest) (*mgmt_pb.CreateUserImportJobResponse, error) {
+	jobID, details, err := s.command.AddUserImportJob(ctx, authz.GetCtxData(ctx).OrgID, user_grpc.UserImportFormatToDomain(req.Format), req.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.CreateUserImportJobResponse{
+		JobId:   jobID,
+		Details: object.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) GetUserImportJob(ctx context.Context, req *mgmt_pb.GetUserImportJobRequest) (*mgmt_pb.GetUserImportJobResponse, error) {
+	job, err := s.query.UserImportJobByID(ctx, true, req.JobId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetUserImportJobResponse{
+		Job: user_grpc.UserImportJobToPb(job),
+	}, nil
+}
+
+func (s *Server) ListUserImportJobResults(ctx context.Context, req *mgmt_pb.ListUserImportJobResultsRequest) (*mgmt_pb.ListUserImportJobResultsResponse, error) {
+	// ensures the job belongs to the organization
+	job, err := s.query.UserImportJobByID(ctx, true, req.JobId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	queries, err := ListUserImportJobResultsRequestToModel(req)
+	if err != nil {
+		return nil, err
+	}
+	results, err := s.query.SearchUserImportResults(ctx, job.ID, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListUserImportJobResultsResponse{
+		Details: object.ToListDetails(results.Count, results.Sequence, results.Timestamp),
+		Result:  user_grpc.UserImportResultsToPb(results.Results),
+	}, nil
+}
+
+func ListUserImportJobResultsRequestToModel(req *mgmt_pb.ListUserImportJobResultsRequest) (*query.UserImportResultSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries := &query.UserImportResultSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: query.UserImportResultColumnRow,
+		},
+	}
+	if req.OnlyFailed {
+		failed, err := query.NewUserImportResultStateSearchQuery(domain.UserImportRowStateFailed)
+		if err != nil {
+			return nil, err
+		}
+		queries.Queries = append(queries.Queries, failed)
+	}
+	return queries, nil
+}
@@ -69,3 +69,47 @@ func MemberQueryToMember(search *member_pb.SearchQuery) (query.SearchQuery, erro
 		return nil, errors.ThrowInvalidArgument(nil, "MEMBE-7Bb92", "Errors.Query.InvalidRequest")
 	}
 }
+
+func CustomRolesToPb(roles []*query.CustomRole) []*member_pb.CustomRole {
+	r := make([]*member_pb.CustomRole, len(roles))
+	for i, role := range roles {
+		r[i] = CustomRoleToPb(role)
+	}
+	return r
+}
+
+func CustomRoleToPb(role *query.CustomRole) *member_pb.CustomRole {
+	return &member_pb.CustomRole{
+		Role:        role.Role,
+		DisplayName: role.DisplayName,
+		Permissions: role.Permissions,
+		Details: object.ToViewDetailsPb(
+			role.Sequence,
+			role.CreationDate,
+			role.ChangeDate,
+			role.ResourceOwner,
+		),
+	}
+}
+
+func CustomRoleQueriesToQuery(queries []*member_pb.CustomRoleQuery) (q []query.SearchQuery, err error) {
+	q = make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = CustomRoleQueryToQuery(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func CustomRoleQueryToQuery(search *member_pb.CustomRoleQuery) (query.SearchQuery, error) {
+	switch q := search.Query.(type) {
+	case *member_pb.CustomRoleQuery_RoleQuery:
+		return query.NewCustomRoleRoleSearchQuery(object.TextMethodToQuery(q.RoleQuery.Method), q.RoleQuery.Role)
+	case *member_pb.CustomRoleQuery_DisplayNameQuery:
+		return query.NewCustomRoleDisplayNameSearchQuery(object.TextMethodToQuery(q.DisplayNameQuery.Method), q.DisplayNameQuery.DisplayName)
+	default:
+		return nil, errors.ThrowInvalidArgument(nil, "MEMBE-Cr1iq", "Errors.Query.InvalidRequest")
+	}
+}
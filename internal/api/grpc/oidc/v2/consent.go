@@ -0,0 +1,43 @@
+package oidc
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	oidc_pb "github.com/zitadel/zitadel/pkg/grpc/oidc/v2alpha"
+)
+
+// checkConsent ensures that the user of the session consented to the scopes requested by a client,
+// which requires the consent of its users (e.g. a third-party application).
+// A consent given on the login UI is stored, so it's only required again for scopes the user did not consent to yet
+// or if the client explicitly requested it by prompt=consent.
+func (s *Server) checkConsent(ctx context.Context, session *oidc_pb.Session, clientID string, requestedScopes []string, prompt []domain.Prompt) error {
+	app, err := s.query.AppByOIDCClientID(ctx, clientID, false)
+	if err != nil {
+		return err
+	}
+	if !app.OIDCConfig.ConsentRequired {
+		return nil
+	}
+	userSession, err := s.query.SessionByID(ctx, true, session.GetSessionId(), session.GetSessionToken())
+	if err != nil {
+		return err
+	}
+	scopes := domain.ConsentScopes(requestedScopes)
+	if session.GetConsentGiven() {
+		_, err = s.command.HumanGrantConsent(ctx, userSession.UserFactor.UserID, userSession.UserFactor.ResourceOwner, clientID, app.ProjectID, scopes)
+		return err
+	}
+	if domain.IsPrompt(prompt, domain.PromptConsent) {
+		return errors.ThrowPreconditionFailed(nil, "OIDCv2-Cn3pq", "Errors.AuthRequest.ConsentRequired")
+	}
+	consent, err := s.query.UserConsentByClientID(ctx, false, userSession.UserFactor.UserID, clientID)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	if !consent.ContainsScopes(scopes) {
+		return errors.ThrowPreconditionFailed(nil, "OIDCv2-Cn5ro", "Errors.AuthRequest.ConsentRequired")
+	}
+	return nil
+}
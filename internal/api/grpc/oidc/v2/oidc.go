@@ -102,6 +102,13 @@ func (s *Server) failAuthRequest(ctx context.Context, authRequestID string, ae *
 }
 
 func (s *Server) linkSessionToAuthRequest(ctx context.Context, authRequestID string, session *oidc_pb.Session) (*oidc_pb.CreateCallbackResponse, error) {
+	authRequest, err := s.query.AuthRequestByID(ctx, true, authRequestID, true)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.checkConsent(ctx, session, authRequest.ClientID, authRequest.Scope, authRequest.Prompt); err != nil {
+		return nil, err
+	}
 	details, aar, err := s.command.LinkSessionToAuthRequest(ctx, authRequestID, session.GetSessionId(), session.GetSessionToken(), true)
 	if err != nil {
 		return nil, err
@@ -202,3 +209,56 @@ func errorReasonToOIDC(reason oidc_pb.ErrorReason) string {
 		return "server_error"
 	}
 }
+
+func (s *Server) GetBackchannelAuthRequest(ctx context.Context, req *oidc_pb.GetBackchannelAuthRequestRequest) (*oidc_pb.GetBackchannelAuthRequestResponse, error) {
+	deviceAuth, err := s.query.DeviceAuthByID(ctx, req.GetBackchannelAuthRequestId())
+	if err != nil {
+		return nil, err
+	}
+	if deviceAuth.UserID == "" {
+		return nil, errors.ThrowNotFound(nil, "OIDCv2-Bc1nf", "Errors.DeviceAuth.NotFound")
+	}
+	return &oidc_pb.GetBackchannelAuthRequestResponse{
+		BackchannelAuthRequest: backchannelAuthRequestToPb(deviceAuth),
+	}, nil
+}
+
+func backchannelAuthRequestToPb(d *domain.DeviceAuth) *oidc_pb.BackchannelAuthRequest {
+	return &oidc_pb.BackchannelAuthRequest{
+		Id:             d.AggregateID,
+		ExpirationDate: timestamppb.New(d.Expires),
+		ClientId:       d.ClientID,
+		Scope:          d.Scopes,
+		UserId:         d.UserID,
+		BindingMessage: d.BindingMessage,
+	}
+}
+
+func (s *Server) AuthorizeOrDenyBackchannelAuthRequest(ctx context.Context, req *oidc_pb.AuthorizeOrDenyBackchannelAuthRequestRequest) (*oidc_pb.AuthorizeOrDenyBackchannelAuthRequestResponse, error) {
+	var (
+		details *domain.ObjectDetails
+		err     error
+	)
+	if req.GetDeny() {
+		details, err = s.command.DenyBackchannelAuthWithSession(ctx, req.GetBackchannelAuthRequestId(), req.GetSession().GetSessionId(), req.GetSession().GetSessionToken())
+	} else {
+		details, err = s.approveBackchannelAuthRequest(ctx, req.GetBackchannelAuthRequestId(), req.GetSession())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &oidc_pb.AuthorizeOrDenyBackchannelAuthRequestResponse{
+		Details: object.DomainToDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) approveBackchannelAuthRequest(ctx context.Context, id string, session *oidc_pb.Session) (*domain.ObjectDetails, error) {
+	deviceAuth, err := s.query.DeviceAuthByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.checkConsent(ctx, session, deviceAuth.ClientID, deviceAuth.Scopes, nil); err != nil {
+		return nil, err
+	}
+	return s.command.ApproveBackchannelAuthWithSession(ctx, id, session.GetSessionId(), session.GetSessionToken())
+}
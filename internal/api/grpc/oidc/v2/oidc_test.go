@@ -11,6 +11,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 	"github.com/zitadel/zitadel/internal/query"
 	oidc_pb "github.com/zitadel/zitadel/pkg/grpc/oidc/v2alpha"
 )
@@ -63,6 +64,32 @@ func Test_authRequestToPb(t *testing.T) {
 	}
 }
 
+func Test_backchannelAuthRequestToPb(t *testing.T) {
+	now := time.Now()
+	arg := &domain.DeviceAuth{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID: "authID",
+		},
+		ClientID:       "clientID",
+		Expires:        now,
+		Scopes:         []string{"openid", "profile"},
+		UserID:         "userID",
+		BindingMessage: "message",
+	}
+	want := &oidc_pb.BackchannelAuthRequest{
+		Id:             "authID",
+		ExpirationDate: timestamppb.New(now),
+		ClientId:       "clientID",
+		Scope:          []string{"openid", "profile"},
+		UserId:         "userID",
+		BindingMessage: "message",
+	}
+	got := backchannelAuthRequestToPb(arg)
+	if !proto.Equal(want, got) {
+		t.Errorf("backchannelAuthRequestToPb() =\n%v\nwant\n%v\n", got, want)
+	}
+}
+
 func Test_errorReasonToOIDC(t *testing.T) {
 	tests := []struct {
 		reason oidc_pb.ErrorReason
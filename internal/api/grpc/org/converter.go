@@ -27,6 +27,8 @@ func OrgQueryToModel(apiQuery *org_pb.OrgQuery) (query.SearchQuery, error) {
 		return query.NewOrgNameSearchQuery(object.TextMethodToQuery(q.NameQuery.Method), q.NameQuery.Name)
 	case *org_pb.OrgQuery_StateQuery:
 		return query.NewOrgStateSearchQuery(OrgStateToDomain(q.StateQuery.State))
+	case *org_pb.OrgQuery_ParentIdQuery:
+		return query.NewOrgParentIDSearchQuery(q.ParentIdQuery.ParentOrgId)
 	default:
 		return nil, errors.ThrowInvalidArgument(nil, "ORG-vR9nC", "List.Query.Invalid")
 	}
@@ -51,6 +53,8 @@ func OrgQueryToQuery(search *org_pb.OrgQuery) (query.SearchQuery, error) {
 		return query.NewOrgNameSearchQuery(object.TextMethodToQuery(q.NameQuery.Method), q.NameQuery.Name)
 	case *org_pb.OrgQuery_StateQuery:
 		return query.NewOrgStateSearchQuery(OrgStateToDomain(q.StateQuery.State))
+	case *org_pb.OrgQuery_ParentIdQuery:
+		return query.NewOrgParentIDSearchQuery(q.ParentIdQuery.ParentOrgId)
 	default:
 		return nil, errors.ThrowInvalidArgument(nil, "ADMIN-ADvsd", "List.Query.Invalid")
 	}
@@ -70,6 +74,7 @@ func OrgViewToPb(org *query.Org) *org_pb.Org {
 		State:         OrgStateToPb(org.State),
 		Name:          org.Name,
 		PrimaryDomain: org.Domain,
+		ParentOrgId:   org.ParentOrgID,
 		Details: object.ToViewDetailsPb(
 			org.Sequence,
 			org.CreationDate,
@@ -92,6 +97,7 @@ func OrgToPb(org *query.Org) *org_pb.Org {
 		Id:            org.ID,
 		Name:          org.Name,
 		PrimaryDomain: org.Domain,
+		ParentOrgId:   org.ParentOrgID,
 		Details:       object.ToViewDetailsPb(org.Sequence, org.CreationDate, org.ChangeDate, org.ResourceOwner),
 		State:         OrgStateToPb(org.State),
 	}
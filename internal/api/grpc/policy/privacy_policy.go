@@ -8,11 +8,12 @@ import (
 
 func ModelPrivacyPolicyToPb(policy *query.PrivacyPolicy) *policy_pb.PrivacyPolicy {
 	return &policy_pb.PrivacyPolicy{
-		IsDefault:    policy.IsDefault,
-		TosLink:      policy.TOSLink,
-		PrivacyLink:  policy.PrivacyLink,
-		HelpLink:     policy.HelpLink,
-		SupportEmail: string(policy.SupportEmail),
+		IsDefault:         policy.IsDefault,
+		TosLink:           policy.TOSLink,
+		PrivacyLink:       policy.PrivacyLink,
+		HelpLink:          policy.HelpLink,
+		SupportEmail:      string(policy.SupportEmail),
+		AllowSelfDeletion: policy.AllowSelfDeletion,
 		Details: object.ToViewDetailsPb(
 			policy.Sequence,
 			policy.CreationDate,
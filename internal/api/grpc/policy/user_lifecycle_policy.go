@@ -0,0 +1,59 @@
+package policy
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	policy_pb "github.com/zitadel/zitadel/pkg/grpc/policy"
+)
+
+func ModelUserLifecyclePolicyToPb(policy *query.UserLifecyclePolicy) *policy_pb.UserLifecyclePolicy {
+	return &policy_pb.UserLifecyclePolicy{
+		IsDefault:              policy.IsDefault,
+		InactivityDeactivation: durationpb.New(policy.InactivityDeactivation),
+		DeactivatedRemoval:     durationpb.New(policy.DeactivatedRemoval),
+		NotificationPeriod:     durationpb.New(policy.NotificationPeriod),
+		Details: object.ToViewDetailsPb(
+			policy.Sequence,
+			policy.CreationDate,
+			policy.ChangeDate,
+			policy.ResourceOwner,
+		),
+	}
+}
+
+func UserLifecycleActionsToPb(actions []*query.UserLifecycleAction) []*policy_pb.UserLifecyclePendingAction {
+	result := make([]*policy_pb.UserLifecyclePendingAction, len(actions))
+	for i, action := range actions {
+		result[i] = UserLifecycleActionToPb(action)
+	}
+	return result
+}
+
+func UserLifecycleActionToPb(action *query.UserLifecycleAction) *policy_pb.UserLifecyclePendingAction {
+	return &policy_pb.UserLifecyclePendingAction{
+		UserId:        action.UserID,
+		ResourceOwner: action.ResourceOwner,
+		Action:        userLifecycleActionToPb(action.Action),
+		DueDate:       timestamppb.New(action.DueDate),
+		LastActivity:  timestamppb.New(action.LastActivity),
+	}
+}
+
+func userLifecycleActionToPb(action domain.UserLifecycleAction) policy_pb.UserLifecycleAction {
+	switch action {
+	case domain.UserLifecycleActionNotifyDeactivation:
+		return policy_pb.UserLifecycleAction_USER_LIFECYCLE_ACTION_NOTIFY_DEACTIVATION
+	case domain.UserLifecycleActionDeactivate:
+		return policy_pb.UserLifecycleAction_USER_LIFECYCLE_ACTION_DEACTIVATE
+	case domain.UserLifecycleActionNotifyRemoval:
+		return policy_pb.UserLifecycleAction_USER_LIFECYCLE_ACTION_NOTIFY_REMOVAL
+	case domain.UserLifecycleActionRemove:
+		return policy_pb.UserLifecycleAction_USER_LIFECYCLE_ACTION_REMOVE
+	default:
+		return policy_pb.UserLifecycleAction_USER_LIFECYCLE_ACTION_UNSPECIFIED
+	}
+}
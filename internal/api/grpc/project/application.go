@@ -42,25 +42,29 @@ func AppConfigToPb(app *query.App) app_pb.AppConfig {
 func AppOIDCConfigToPb(app *query.OIDCApp) *app_pb.App_OidcConfig {
 	return &app_pb.App_OidcConfig{
 		OidcConfig: &app_pb.OIDCConfig{
-			RedirectUris:             app.RedirectURIs,
-			ResponseTypes:            OIDCResponseTypesFromModel(app.ResponseTypes),
-			GrantTypes:               OIDCGrantTypesFromModel(app.GrantTypes),
-			AppType:                  OIDCApplicationTypeToPb(app.AppType),
-			ClientId:                 app.ClientID,
-			AuthMethodType:           OIDCAuthMethodTypeToPb(app.AuthMethodType),
-			PostLogoutRedirectUris:   app.PostLogoutRedirectURIs,
-			Version:                  OIDCVersionToPb(domain.OIDCVersion(app.Version)),
-			NoneCompliant:            len(app.ComplianceProblems) != 0,
-			ComplianceProblems:       ComplianceProblemsToLocalizedMessages(app.ComplianceProblems),
-			DevMode:                  app.IsDevMode,
-			AccessTokenType:          oidcTokenTypeToPb(app.AccessTokenType),
-			AccessTokenRoleAssertion: app.AssertAccessTokenRole,
-			IdTokenRoleAssertion:     app.AssertIDTokenRole,
-			IdTokenUserinfoAssertion: app.AssertIDTokenUserinfo,
-			ClockSkew:                durationpb.New(app.ClockSkew),
-			AdditionalOrigins:        app.AdditionalOrigins,
-			AllowedOrigins:           app.AllowedOrigins,
-			SkipNativeAppSuccessPage: app.SkipNativeAppSuccessPage,
+			RedirectUris:                          app.RedirectURIs,
+			ResponseTypes:                         OIDCResponseTypesFromModel(app.ResponseTypes),
+			GrantTypes:                            OIDCGrantTypesFromModel(app.GrantTypes),
+			AppType:                               OIDCApplicationTypeToPb(app.AppType),
+			ClientId:                              app.ClientID,
+			AuthMethodType:                        OIDCAuthMethodTypeToPb(app.AuthMethodType),
+			PostLogoutRedirectUris:                app.PostLogoutRedirectURIs,
+			Version:                               OIDCVersionToPb(domain.OIDCVersion(app.Version)),
+			NoneCompliant:                         len(app.ComplianceProblems) != 0,
+			ComplianceProblems:                    ComplianceProblemsToLocalizedMessages(app.ComplianceProblems),
+			DevMode:                               app.IsDevMode,
+			AccessTokenType:                       oidcTokenTypeToPb(app.AccessTokenType),
+			AccessTokenRoleAssertion:              app.AssertAccessTokenRole,
+			IdTokenRoleAssertion:                  app.AssertIDTokenRole,
+			IdTokenUserinfoAssertion:              app.AssertIDTokenUserinfo,
+			ClockSkew:                             durationpb.New(app.ClockSkew),
+			AdditionalOrigins:                     app.AdditionalOrigins,
+			AllowedOrigins:                        app.AllowedOrigins,
+			SkipNativeAppSuccessPage:              app.SkipNativeAppSuccessPage,
+			IdTokenSigningAlgorithm:               app.IDTokenSigningAlgorithm,
+			ConsentRequired:                       app.ConsentRequired,
+			BackchannelTokenDeliveryMode:          OIDCBackchannelTokenDeliveryModeFromModel(app.BackchannelTokenDeliveryMode),
+			BackchannelClientNotificationEndpoint: app.BackchannelClientNotificationEndpoint,
 		},
 	}
 }
@@ -138,6 +142,8 @@ func OIDCGrantTypesFromModel(grantTypes []domain.OIDCGrantType) []app_pb.OIDCGra
 			oidcGrantTypes[i] = app_pb.OIDCGrantType_OIDC_GRANT_TYPE_REFRESH_TOKEN
 		case domain.OIDCGrantTypeDeviceCode:
 			oidcGrantTypes[i] = app_pb.OIDCGrantType_OIDC_GRANT_TYPE_DEVICE_CODE
+		case domain.OIDCGrantTypeCIBA:
+			oidcGrantTypes[i] = app_pb.OIDCGrantType_OIDC_GRANT_TYPE_CIBA
 		}
 	}
 	return oidcGrantTypes
@@ -158,6 +164,8 @@ func OIDCGrantTypesToDomain(grantTypes []app_pb.OIDCGrantType) []domain.OIDCGran
 			oidcGrantTypes[i] = domain.OIDCGrantTypeRefreshToken
 		case app_pb.OIDCGrantType_OIDC_GRANT_TYPE_DEVICE_CODE:
 			oidcGrantTypes[i] = domain.OIDCGrantTypeDeviceCode
+		case app_pb.OIDCGrantType_OIDC_GRANT_TYPE_CIBA:
+			oidcGrantTypes[i] = domain.OIDCGrantTypeCIBA
 		}
 	}
 	return oidcGrantTypes
@@ -218,6 +226,28 @@ func OIDCAuthMethodTypeToDomain(authType app_pb.OIDCAuthMethodType) domain.OIDCA
 	}
 }
 
+func OIDCBackchannelTokenDeliveryModeFromModel(mode domain.OIDCBackchannelTokenDeliveryMode) app_pb.OIDCBackchannelTokenDeliveryMode {
+	switch mode {
+	case domain.OIDCBackchannelTokenDeliveryModePoll:
+		return app_pb.OIDCBackchannelTokenDeliveryMode_OIDC_BACKCHANNEL_TOKEN_DELIVERY_MODE_POLL
+	case domain.OIDCBackchannelTokenDeliveryModePing:
+		return app_pb.OIDCBackchannelTokenDeliveryMode_OIDC_BACKCHANNEL_TOKEN_DELIVERY_MODE_PING
+	default:
+		return app_pb.OIDCBackchannelTokenDeliveryMode_OIDC_BACKCHANNEL_TOKEN_DELIVERY_MODE_POLL
+	}
+}
+
+func OIDCBackchannelTokenDeliveryModeToDomain(mode app_pb.OIDCBackchannelTokenDeliveryMode) domain.OIDCBackchannelTokenDeliveryMode {
+	switch mode {
+	case app_pb.OIDCBackchannelTokenDeliveryMode_OIDC_BACKCHANNEL_TOKEN_DELIVERY_MODE_POLL:
+		return domain.OIDCBackchannelTokenDeliveryModePoll
+	case app_pb.OIDCBackchannelTokenDeliveryMode_OIDC_BACKCHANNEL_TOKEN_DELIVERY_MODE_PING:
+		return domain.OIDCBackchannelTokenDeliveryModePing
+	default:
+		return domain.OIDCBackchannelTokenDeliveryModePoll
+	}
+}
+
 func OIDCVersionToPb(version domain.OIDCVersion) app_pb.OIDCVersion {
 	switch version {
 	case domain.OIDCVersionV1:
@@ -195,3 +195,92 @@ func RoleViewToPb(role *query.ProjectRole) *proj_pb.Role {
 		),
 	}
 }
+
+func ScopeQueriesToModel(queries []*proj_pb.ScopeQuery) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = ScopeQueryToModel(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func ScopeQueryToModel(apiQuery *proj_pb.ScopeQuery) (query.SearchQuery, error) {
+	switch q := apiQuery.Query.(type) {
+	case *proj_pb.ScopeQuery_KeyQuery:
+		return query.NewProjectScopeKeySearchQuery(object.TextMethodToQuery(q.KeyQuery.Method), q.KeyQuery.Key)
+	case *proj_pb.ScopeQuery_DisplayNameQuery:
+		return query.NewProjectScopeDisplayNameSearchQuery(object.TextMethodToQuery(q.DisplayNameQuery.Method), q.DisplayNameQuery.DisplayName)
+	default:
+		return nil, errors.ThrowInvalidArgument(nil, "PROJECT-Sc4mz", "List.Query.Invalid")
+	}
+}
+
+func ScopeViewsToPb(scopes []*query.ProjectScope) []*proj_pb.Scope {
+	o := make([]*proj_pb.Scope, len(scopes))
+	for i, scope := range scopes {
+		o[i] = ScopeViewToPb(scope)
+	}
+	return o
+}
+
+func ScopeViewToPb(scope *query.ProjectScope) *proj_pb.Scope {
+	return &proj_pb.Scope{
+		Key:         scope.Key,
+		DisplayName: scope.DisplayName,
+		Description: scope.Description,
+		Details: object.ToViewDetailsPb(
+			scope.Sequence,
+			scope.CreationDate,
+			scope.ChangeDate,
+			scope.ResourceOwner,
+		),
+	}
+}
+
+func AuthorizationDetailTypeQueriesToModel(queries []*proj_pb.AuthorizationDetailTypeQuery) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = AuthorizationDetailTypeQueryToModel(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func AuthorizationDetailTypeQueryToModel(apiQuery *proj_pb.AuthorizationDetailTypeQuery) (query.SearchQuery, error) {
+	switch q := apiQuery.Query.(type) {
+	case *proj_pb.AuthorizationDetailTypeQuery_TypeQuery:
+		return query.NewProjectAuthorizationDetailTypeTypeSearchQuery(object.TextMethodToQuery(q.TypeQuery.Method), q.TypeQuery.Type)
+	case *proj_pb.AuthorizationDetailTypeQuery_DisplayNameQuery:
+		return query.NewProjectAuthorizationDetailTypeDisplayNameSearchQuery(object.TextMethodToQuery(q.DisplayNameQuery.Method), q.DisplayNameQuery.DisplayName)
+	default:
+		return nil, errors.ThrowInvalidArgument(nil, "PROJECT-Ad4mz", "List.Query.Invalid")
+	}
+}
+
+func AuthorizationDetailTypeViewsToPb(detailTypes []*query.ProjectAuthorizationDetailType) []*proj_pb.AuthorizationDetailType {
+	o := make([]*proj_pb.AuthorizationDetailType, len(detailTypes))
+	for i, detailType := range detailTypes {
+		o[i] = AuthorizationDetailTypeViewToPb(detailType)
+	}
+	return o
+}
+
+func AuthorizationDetailTypeViewToPb(detailType *query.ProjectAuthorizationDetailType) *proj_pb.AuthorizationDetailType {
+	return &proj_pb.AuthorizationDetailType{
+		Type:        detailType.Type,
+		DisplayName: detailType.DisplayName,
+		Description: detailType.Description,
+		Schema:      detailType.Schema,
+		Details: object.ToViewDetailsPb(
+			detailType.Sequence,
+			detailType.CreationDate,
+			detailType.ChangeDate,
+			detailType.ResourceOwner,
+		),
+	}
+}
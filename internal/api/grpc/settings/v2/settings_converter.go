@@ -36,6 +36,7 @@ func loginSettingsToPb(current *query.LoginPolicy) *settings.LoginSettings {
 		MfaInitSkipLifetime:        durationpb.New(current.MFAInitSkipLifetime),
 		SecondFactorCheckLifetime:  durationpb.New(current.SecondFactorCheckLifetime),
 		MultiFactorCheckLifetime:   durationpb.New(current.MultiFactorCheckLifetime),
+		TrustedDeviceLifetime:      durationpb.New(current.TrustedDeviceLifetime),
 		SecondFactors:              second,
 		MultiFactors:               multi,
 		ResourceOwnerType:          isDefaultToResourceOwnerTypePb(current.IsDefault),
@@ -137,6 +138,7 @@ func legalAndSupportSettingsToPb(current *query.PrivacyPolicy) *settings.LegalAn
 		HelpLink:          current.HelpLink,
 		SupportEmail:      string(current.SupportEmail),
 		ResourceOwnerType: isDefaultToResourceOwnerTypePb(current.IsDefault),
+		AllowSelfDeletion: current.AllowSelfDeletion,
 	}
 }
 
@@ -38,6 +38,7 @@ func Test_loginSettingsToPb(t *testing.T) {
 		MFAInitSkipLifetime:        time.Millisecond,
 		SecondFactorCheckLifetime:  time.Microsecond,
 		MultiFactorCheckLifetime:   time.Nanosecond,
+		TrustedDeviceLifetime:      time.Second,
 		SecondFactors: []domain.SecondFactorType{
 			domain.SecondFactorTypeTOTP,
 			domain.SecondFactorTypeU2F,
@@ -68,6 +69,7 @@ func Test_loginSettingsToPb(t *testing.T) {
 		MfaInitSkipLifetime:        durationpb.New(time.Millisecond),
 		SecondFactorCheckLifetime:  durationpb.New(time.Microsecond),
 		MultiFactorCheckLifetime:   durationpb.New(time.Nanosecond),
+		TrustedDeviceLifetime:      durationpb.New(time.Second),
 		SecondFactors: []settings.SecondFactorType{
 			settings.SecondFactorType_SECOND_FACTOR_TYPE_OTP,
 			settings.SecondFactorType_SECOND_FACTOR_TYPE_U2F,
@@ -315,8 +317,9 @@ func Test_legalSettingsToPb(t *testing.T) {
 		TOSLink:      "http://example.com/tos",
 		PrivacyLink:  "http://example.com/pricacy",
 		HelpLink:     "http://example.com/help",
-		SupportEmail: "support@zitadel.com",
-		IsDefault:    true,
+		SupportEmail:      "support@zitadel.com",
+		AllowSelfDeletion: true,
+		IsDefault:         true,
 	}
 	want := &settings.LegalAndSupportSettings{
 		TosLink:           "http://example.com/tos",
@@ -324,6 +327,7 @@ func Test_legalSettingsToPb(t *testing.T) {
 		HelpLink:          "http://example.com/help",
 		SupportEmail:      "support@zitadel.com",
 		ResourceOwnerType: settings.ResourceOwnerType_RESOURCE_OWNER_TYPE_INSTANCE,
+		AllowSelfDeletion: true,
 	}
 	got := legalAndSupportSettingsToPb(arg)
 	grpc.AllFieldsSet(t, got.ProtoReflect(), ignoreTypes...)
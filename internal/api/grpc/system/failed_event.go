@@ -34,3 +34,37 @@ func (s *Server) RemoveFailedEvent(ctx context.Context, req *system_pb.RemoveFai
 	}
 	return &system_pb.RemoveFailedEventResponse{}, nil
 }
+
+func (s *Server) GetFailedEvent(ctx context.Context, req *system_pb.GetFailedEventRequest) (*system_pb.GetFailedEventResponse, error) {
+	failedEvent, err := s.query.GetFailedEvent(ctx, req.ProjectionName, req.InstanceId, req.FailedSequence)
+	if err != nil {
+		return nil, err
+	}
+	return &system_pb.GetFailedEventResponse{
+		FailedEvent:  FailedEventToPb(s.database, failedEvent),
+		ErrorHistory: FailedEventErrorsToPb(failedEvent.ErrorHistory),
+		Event:        FailedEventDataToPb(failedEvent.Event),
+	}, nil
+}
+
+func (s *Server) RetryFailedEvent(ctx context.Context, req *system_pb.RetryFailedEventRequest) (*system_pb.RetryFailedEventResponse, error) {
+	if err := s.query.RetryFailedEvent(ctx, req.ProjectionName, req.InstanceId, req.FailedSequence); err != nil {
+		return nil, err
+	}
+	return &system_pb.RetryFailedEventResponse{}, nil
+}
+
+func (s *Server) RetryProjectionFailedEvents(ctx context.Context, req *system_pb.RetryProjectionFailedEventsRequest) (*system_pb.RetryProjectionFailedEventsResponse, error) {
+	failed, err := s.query.RetryProjectionFailedEvents(ctx, req.ProjectionName)
+	if err != nil {
+		return nil, err
+	}
+	return &system_pb.RetryProjectionFailedEventsResponse{FailedAgain: uint32(failed)}, nil
+}
+
+func (s *Server) SkipFailedEvent(ctx context.Context, req *system_pb.SkipFailedEventRequest) (*system_pb.SkipFailedEventResponse, error) {
+	if err := s.query.SkipFailedEvent(ctx, req.ProjectionName, req.InstanceId, req.FailedSequence, req.Reason); err != nil {
+		return nil, err
+	}
+	return &system_pb.SkipFailedEventResponse{}, nil
+}
@@ -28,6 +28,7 @@ func FailedEventViewToPb(failedEvent *model.FailedEvent) *system_pb.FailedEvent
 		FailureCount:   failedEvent.FailureCount,
 		ErrorMessage:   failedEvent.ErrMsg,
 		LastFailed:     lastFailed,
+		InstanceId:     failedEvent.InstanceID,
 	}
 }
 
@@ -40,10 +41,13 @@ func FailedEventsToPb(database string, failedEvents *query.FailedEvents) []*syst
 }
 
 func FailedEventToPb(database string, failedEvent *query.FailedEvent) *system_pb.FailedEvent {
-	var lastFailed *timestamppb.Timestamp
+	var lastFailed, skippedAt *timestamppb.Timestamp
 	if !failedEvent.LastFailed.IsZero() {
 		lastFailed = timestamppb.New(failedEvent.LastFailed)
 	}
+	if !failedEvent.SkippedAt.IsZero() {
+		skippedAt = timestamppb.New(failedEvent.SkippedAt)
+	}
 	return &system_pb.FailedEvent{
 		Database:       database,
 		ViewName:       failedEvent.ProjectionName,
@@ -51,6 +55,34 @@ func FailedEventToPb(database string, failedEvent *query.FailedEvent) *system_pb
 		FailureCount:   failedEvent.FailureCount,
 		ErrorMessage:   failedEvent.Error,
 		LastFailed:     lastFailed,
+		InstanceId:     failedEvent.InstanceID,
+		SkipReason:     failedEvent.SkipReason,
+		SkippedAt:      skippedAt,
+	}
+}
+
+func FailedEventErrorsToPb(errors []*query.FailedEventError) []*system_pb.FailedEventError {
+	history := make([]*system_pb.FailedEventError, len(errors))
+	for i, err := range errors {
+		history[i] = &system_pb.FailedEventError{
+			ErrorMessage: err.Error,
+			FailedAt:     timestamppb.New(err.FailedAt),
+		}
+	}
+	return history
+}
+
+func FailedEventDataToPb(event *query.FailedEventData) *system_pb.FailedEventData {
+	if event == nil {
+		return nil
+	}
+	return &system_pb.FailedEventData{
+		AggregateType: event.AggregateType,
+		AggregateId:   event.AggregateID,
+		ResourceOwner: event.ResourceOwner,
+		EventType:     event.EventType,
+		CreationDate:  timestamppb.New(event.CreationDate),
+		Payload:       event.Payload,
 	}
 }
 
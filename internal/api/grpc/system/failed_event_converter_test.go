@@ -29,6 +29,7 @@ func TestFailedEventsToPbFields(t *testing.T) {
 						FailureCount:   5,
 						LastFailed:     time.Now(),
 						ErrMsg:         "some error",
+						InstanceID:     "instanceID",
 					},
 				},
 			},
@@ -38,7 +39,7 @@ func TestFailedEventsToPbFields(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			got := system_grpc.FailedEventsViewToPb(tt.args.failedEvents)
 			for _, g := range got {
-				test.AssertFieldsMapped(t, g)
+				test.AssertFieldsMapped(t, g, "SkipReason", "SkippedAt")
 			}
 		})
 	}
@@ -62,13 +63,14 @@ func TestFailedEventToPbFields(t *testing.T) {
 					FailureCount:   5,
 					LastFailed:     time.Now(),
 					ErrMsg:         "some error",
+					InstanceID:     "instanceID",
 				},
 			},
 		},
 	}
 	for _, tt := range tests {
 		converted := system_grpc.FailedEventViewToPb(tt.args.failedEvent)
-		test.AssertFieldsMapped(t, converted)
+		test.AssertFieldsMapped(t, converted, "SkipReason", "SkippedAt")
 	}
 }
 
@@ -35,3 +35,45 @@ func (s *Server) ClearView(ctx context.Context, req *system_pb.ClearViewRequest)
 	}
 	return &system_pb.ClearViewResponse{}, nil
 }
+
+func (s *Server) StartProjectionRebuild(ctx context.Context, req *system_pb.StartProjectionRebuildRequest) (*system_pb.StartProjectionRebuildResponse, error) {
+	rebuildID, err := s.query.StartProjectionRebuild(ctx, req.ProjectionName)
+	if err != nil {
+		return nil, err
+	}
+	return &system_pb.StartProjectionRebuildResponse{RebuildId: rebuildID}, nil
+}
+
+func (s *Server) GetProjectionRebuild(ctx context.Context, req *system_pb.GetProjectionRebuildRequest) (*system_pb.GetProjectionRebuildResponse, error) {
+	rebuild, err := s.query.ProjectionRebuildStatus(ctx, req.ProjectionName)
+	if err != nil {
+		return nil, err
+	}
+	return &system_pb.GetProjectionRebuildResponse{Rebuild: ProjectionRebuildToPb(rebuild)}, nil
+}
+
+func (s *Server) AbortProjectionRebuild(ctx context.Context, req *system_pb.AbortProjectionRebuildRequest) (*system_pb.AbortProjectionRebuildResponse, error) {
+	if err := s.query.AbortProjectionRebuild(ctx, req.ProjectionName); err != nil {
+		return nil, err
+	}
+	return &system_pb.AbortProjectionRebuildResponse{}, nil
+}
+
+func (s *Server) ListProjectionLags(ctx context.Context, req *system_pb.ListProjectionLagsRequest) (*system_pb.ListProjectionLagsResponse, error) {
+	lags, err := s.query.ProjectionLags(ctx, req.ProjectionName, req.InstanceId)
+	if err != nil {
+		return nil, err
+	}
+	return &system_pb.ListProjectionLagsResponse{Result: ProjectionLagsToPb(lags)}, nil
+}
+
+func (s *Server) GetProjectionsReadiness(ctx context.Context, _ *system_pb.GetProjectionsReadinessRequest) (*system_pb.GetProjectionsReadinessResponse, error) {
+	lagging, err := s.query.ProjectionsReady(ctx)
+	if err != nil && len(lagging) == 0 {
+		return nil, err
+	}
+	return &system_pb.GetProjectionsReadinessResponse{
+		Ready:   len(lagging) == 0,
+		Lagging: ProjectionLagsToPb(lagging),
+	}, nil
+}
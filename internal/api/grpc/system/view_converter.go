@@ -1,8 +1,12 @@
 package system
 
 import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/view/model"
 	system_pb "github.com/zitadel/zitadel/pkg/grpc/system"
@@ -42,3 +46,62 @@ func CurrentSequenceToPb(database string, currentSequence *query.CurrentSequence
 		LastSuccessfulSpoolerRun: timestamppb.New(currentSequence.Timestamp),
 	}
 }
+
+func ProjectionRebuildToPb(rebuild *handler.Rebuild) *system_pb.ProjectionRebuild {
+	pb := &system_pb.ProjectionRebuild{
+		RebuildId:      rebuild.ID,
+		ProjectionName: rebuild.ProjectionName,
+		State:          projectionRebuildStateToPb(rebuild.State),
+		ErrorMessage:   rebuild.Error,
+	}
+	if !rebuild.CreationDate.IsZero() {
+		pb.CreationDate = timestamppb.New(rebuild.CreationDate)
+		pb.ChangeDate = timestamppb.New(rebuild.ChangeDate)
+	}
+	return pb
+}
+
+func projectionRebuildStateToPb(state handler.RebuildState) system_pb.ProjectionRebuildState {
+	switch state {
+	case handler.RebuildStateRunning:
+		return system_pb.ProjectionRebuildState_PROJECTION_REBUILD_STATE_RUNNING
+	case handler.RebuildStateSucceeded:
+		return system_pb.ProjectionRebuildState_PROJECTION_REBUILD_STATE_SUCCEEDED
+	case handler.RebuildStateFailed:
+		return system_pb.ProjectionRebuildState_PROJECTION_REBUILD_STATE_FAILED
+	case handler.RebuildStateAborted:
+		return system_pb.ProjectionRebuildState_PROJECTION_REBUILD_STATE_ABORTED
+	default:
+		return system_pb.ProjectionRebuildState_PROJECTION_REBUILD_STATE_UNSPECIFIED
+	}
+}
+
+func ProjectionLagsToPb(lags []*query.ProjectionLag) []*system_pb.ProjectionLag {
+	l := make([]*system_pb.ProjectionLag, len(lags))
+	for i, lag := range lags {
+		l[i] = ProjectionLagToPb(lag)
+	}
+	return l
+}
+
+func ProjectionLagToPb(lag *query.ProjectionLag) *system_pb.ProjectionLag {
+	return &system_pb.ProjectionLag{
+		ProjectionName: lag.ProjectionName,
+		InstanceId:     lag.InstanceID,
+		Sequence:       lag.Sequence,
+		LastProcessed:  optionalTimestampToPb(lag.LastProcessed),
+		EventsBehind:   lag.EventsBehind,
+		Lag:            durationpb.New(lag.Lag),
+		LockerId:       lag.LockerID,
+		LockedUntil:    optionalTimestampToPb(lag.LockedUntil),
+		LastError:      lag.LastError,
+		LastFailed:     optionalTimestampToPb(lag.LastFailed),
+	}
+}
+
+func optionalTimestampToPb(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -0,0 +1,24 @@
+package user
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/user"
+)
+
+func ConsentsToPb(consents []*query.UserConsent) []*user.Consent {
+	c := make([]*user.Consent, len(consents))
+	for i, consent := range consents {
+		c[i] = ConsentToPb(consent)
+	}
+	return c
+}
+
+func ConsentToPb(consent *query.UserConsent) *user.Consent {
+	return &user.Consent{
+		ClientId:  consent.ClientID,
+		Details:   object.ToViewDetailsPb(consent.Sequence, consent.CreationDate, consent.ChangeDate, consent.ResourceOwner),
+		ProjectId: consent.ProjectID,
+		Scopes:    consent.Scopes,
+	}
+}
@@ -0,0 +1,27 @@
+package user
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/user"
+)
+
+func TrustedDevicesToPb(devices []*query.TrustedDevice) []*user.TrustedDevice {
+	d := make([]*user.TrustedDevice, len(devices))
+	for i, device := range devices {
+		d[i] = TrustedDeviceToPb(device)
+	}
+	return d
+}
+
+func TrustedDeviceToPb(device *query.TrustedDevice) *user.TrustedDevice {
+	return &user.TrustedDevice{
+		UserAgentId: device.UserAgentID,
+		Details:     object.ToViewDetailsPb(device.Sequence, device.CreationDate, device.ChangeDate, device.ResourceOwner),
+		Name:        device.Name,
+		RemoteIp:    device.RemoteIP,
+		Expiration:  timestamppb.New(device.Expiration),
+	}
+}
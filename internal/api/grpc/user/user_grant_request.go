@@ -0,0 +1,84 @@
+package user
+
+import (
+	"errors"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	user_pb "github.com/zitadel/zitadel/pkg/grpc/user"
+)
+
+func UserGrantRequestsToPb(requests []*query.UserGrantRequest) []*user_pb.UserGrantRequest {
+	r := make([]*user_pb.UserGrantRequest, len(requests))
+	for i, request := range requests {
+		r[i] = UserGrantRequestToPb(request)
+	}
+	return r
+}
+
+func UserGrantRequestToPb(request *query.UserGrantRequest) *user_pb.UserGrantRequest {
+	return &user_pb.UserGrantRequest{
+		Id:             request.ID,
+		Details:        object.ToViewDetailsPb(request.Sequence, request.CreationDate, request.ChangeDate, request.ResourceOwner),
+		State:          UserGrantRequestStateToPb(request.State),
+		UserId:         request.UserID,
+		ProjectId:      request.ProjectID,
+		ProjectGrantId: request.GrantID,
+		RoleKeys:       request.Roles,
+		Reason:         request.Reason,
+		DenialReason:   request.DenialReason,
+	}
+}
+
+func UserGrantRequestStateToPb(state domain.UserGrantRequestState) user_pb.UserGrantRequestState {
+	switch state {
+	case domain.UserGrantRequestStateRequested:
+		return user_pb.UserGrantRequestState_USER_GRANT_REQUEST_STATE_REQUESTED
+	case domain.UserGrantRequestStateApproved:
+		return user_pb.UserGrantRequestState_USER_GRANT_REQUEST_STATE_APPROVED
+	case domain.UserGrantRequestStateDenied:
+		return user_pb.UserGrantRequestState_USER_GRANT_REQUEST_STATE_DENIED
+	default:
+		return user_pb.UserGrantRequestState_USER_GRANT_REQUEST_STATE_UNSPECIFIED
+	}
+}
+
+func UserGrantRequestStateToDomain(state user_pb.UserGrantRequestState) domain.UserGrantRequestState {
+	switch state {
+	case user_pb.UserGrantRequestState_USER_GRANT_REQUEST_STATE_REQUESTED:
+		return domain.UserGrantRequestStateRequested
+	case user_pb.UserGrantRequestState_USER_GRANT_REQUEST_STATE_APPROVED:
+		return domain.UserGrantRequestStateApproved
+	case user_pb.UserGrantRequestState_USER_GRANT_REQUEST_STATE_DENIED:
+		return domain.UserGrantRequestStateDenied
+	default:
+		return domain.UserGrantRequestStateUnspecified
+	}
+}
+
+func UserGrantRequestQueriesToQuery(queries []*user_pb.UserGrantRequestQuery) (q []query.SearchQuery, err error) {
+	q = make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = UserGrantRequestQueryToQuery(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func UserGrantRequestQueryToQuery(req *user_pb.UserGrantRequestQuery) (query.SearchQuery, error) {
+	switch q := req.Query.(type) {
+	case *user_pb.UserGrantRequestQuery_ProjectIdQuery:
+		return query.NewUserGrantRequestProjectIDSearchQuery(q.ProjectIdQuery.ProjectId)
+	case *user_pb.UserGrantRequestQuery_UserIdQuery:
+		return query.NewUserGrantRequestUserIDSearchQuery(q.UserIdQuery.UserId)
+	case *user_pb.UserGrantRequestQuery_ProjectGrantIdQuery:
+		return query.NewUserGrantRequestGrantIDSearchQuery(q.ProjectGrantIdQuery.ProjectGrantId)
+	case *user_pb.UserGrantRequestQuery_StateQuery:
+		return query.NewUserGrantRequestStateSearchQuery(UserGrantRequestStateToDomain(q.StateQuery.State))
+	default:
+		return nil, errors.New("invalid query")
+	}
+}
@@ -0,0 +1,81 @@
+package user
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/user"
+)
+
+func UserImportFormatToDomain(format user.UserImportFormat) domain.UserImportFormat {
+	switch format {
+	case user.UserImportFormat_USER_IMPORT_FORMAT_JSON_LINES:
+		return domain.UserImportFormatJSONLines
+	case user.UserImportFormat_USER_IMPORT_FORMAT_CSV:
+		return domain.UserImportFormatCSV
+	default:
+		return domain.UserImportFormatUnspecified
+	}
+}
+
+func UserImportFormatToPb(format domain.UserImportFormat) user.UserImportFormat {
+	switch format {
+	case domain.UserImportFormatJSONLines:
+		return user.UserImportFormat_USER_IMPORT_FORMAT_JSON_LINES
+	case domain.UserImportFormatCSV:
+		return user.UserImportFormat_USER_IMPORT_FORMAT_CSV
+	default:
+		return user.UserImportFormat_USER_IMPORT_FORMAT_UNSPECIFIED
+	}
+}
+
+func UserImportJobToPb(job *query.UserImportJob) *user.UserImportJob {
+	return &user.UserImportJob{
+		Id:            job.ID,
+		Details:       object.ToViewDetailsPb(job.Sequence, job.CreationDate, job.ChangeDate, job.ResourceOwner),
+		State:         UserImportJobStateToPb(job.State),
+		Format:        UserImportFormatToPb(job.Format),
+		TotalRows:     job.TotalRows,
+		ProcessedRows: job.ProcessedRows,
+		CreatedRows:   job.CreatedRows,
+		UpdatedRows:   job.UpdatedRows,
+		FailedRows:    job.FailedRows,
+	}
+}
+
+func UserImportJobStateToPb(state domain.UserImportJobState) user.UserImportJobState {
+	switch state {
+	case domain.UserImportJobStateRunning:
+		return user.UserImportJobState_USER_IMPORT_JOB_STATE_RUNNING
+	case domain.UserImportJobStateDone:
+		return user.UserImportJobState_USER_IMPORT_JOB_STATE_DONE
+	default:
+		return user.UserImportJobState_USER_IMPORT_JOB_STATE_UNSPECIFIED
+	}
+}
+
+func UserImportResultsToPb(results []*query.UserImportResult) []*user.UserImportResult {
+	r := make([]*user.UserImportResult, len(results))
+	for i, result := range results {
+		r[i] = &user.UserImportResult{
+			Row:    result.Row,
+			UserId: result.UserID,
+			State:  UserImportResultStateToPb(result.State),
+			Error:  result.Error,
+		}
+	}
+	return r
+}
+
+func UserImportResultStateToPb(state domain.UserImportRowState) user.UserImportResultState {
+	switch state {
+	case domain.UserImportRowStateCreated:
+		return user.UserImportResultState_USER_IMPORT_RESULT_STATE_CREATED
+	case domain.UserImportRowStateUpdated:
+		return user.UserImportResultState_USER_IMPORT_RESULT_STATE_UPDATED
+	case domain.UserImportRowStateFailed:
+		return user.UserImportResultState_USER_IMPORT_RESULT_STATE_FAILED
+	default:
+		return user.UserImportResultState_USER_IMPORT_RESULT_STATE_UNSPECIFIED
+	}
+}
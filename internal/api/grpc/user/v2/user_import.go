@@ -0,0 +1,160 @@
+package user
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	user "github.com/zitadel/zitadel/pkg/grpc/user/v2alpha"
+)
+
+func (s *Server) CreateUserImportJob(ctx context.Context, req *user.CreateUserImportJobRequest) (*user.CreateUserImportJobResponse, error) {
+	jobID, details, err := s.command.AddUserImportJob(ctx, authz.GetCtxData(ctx).OrgID, userImportFormatToDomain(req.GetFormat()), req.GetData())
+	if err != nil {
+		return nil, err
+	}
+	return &user.CreateUserImportJobResponse{
+		Details: object.DomainToDetailsPb(details),
+		JobId:   jobID,
+	}, nil
+}
+
+func (s *Server) GetUserImportJob(ctx context.Context, req *user.GetUserImportJobRequest) (*user.GetUserImportJobResponse, error) {
+	job, err := s.query.UserImportJobByID(ctx, true, req.GetJobId(), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &user.GetUserImportJobResponse{
+		Job: userImportJobToPb(job),
+	}, nil
+}
+
+func (s *Server) ListUserImportJobResults(ctx context.Context, req *user.ListUserImportJobResultsRequest) (*user.ListUserImportJobResultsResponse, error) {
+	// ensures the job belongs to the organisation
+	job, err := s.query.UserImportJobByID(ctx, true, req.GetJobId(), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	queries, err := listUserImportJobResultsRequestToModel(req)
+	if err != nil {
+		return nil, err
+	}
+	results, err := s.query.SearchUserImportResults(ctx, job.ID, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &user.ListUserImportJobResultsResponse{
+		Details: object.ToListDetails(results.SearchResponse),
+		Result:  userImportResultsToPb(results.Results),
+	}, nil
+}
+
+func listUserImportJobResultsRequestToModel(req *user.ListUserImportJobResultsRequest) (*query.UserImportResultSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToQuery(req.GetQuery())
+	queries := &query.UserImportResultSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: query.UserImportResultColumnRow,
+		},
+	}
+	if req.GetOnlyFailed() {
+		failed, err := query.NewUserImportResultStateSearchQuery(domain.UserImportRowStateFailed)
+		if err != nil {
+			return nil, err
+		}
+		queries.Queries = append(queries.Queries, failed)
+	}
+	return queries, nil
+}
+
+func userImportFormatToDomain(format user.UserImportFormat) domain.UserImportFormat {
+	switch format {
+	case user.UserImportFormat_USER_IMPORT_FORMAT_JSON_LINES:
+		return domain.UserImportFormatJSONLines
+	case user.UserImportFormat_USER_IMPORT_FORMAT_CSV:
+		return domain.UserImportFormatCSV
+	case user.UserImportFormat_USER_IMPORT_FORMAT_UNSPECIFIED:
+		return domain.UserImportFormatUnspecified
+	default:
+		return domain.UserImportFormatUnspecified
+	}
+}
+
+func userImportFormatToPb(format domain.UserImportFormat) user.UserImportFormat {
+	switch format {
+	case domain.UserImportFormatJSONLines:
+		return user.UserImportFormat_USER_IMPORT_FORMAT_JSON_LINES
+	case domain.UserImportFormatCSV:
+		return user.UserImportFormat_USER_IMPORT_FORMAT_CSV
+	case domain.UserImportFormatUnspecified:
+		return user.UserImportFormat_USER_IMPORT_FORMAT_UNSPECIFIED
+	default:
+		return user.UserImportFormat_USER_IMPORT_FORMAT_UNSPECIFIED
+	}
+}
+
+func userImportJobToPb(job *query.UserImportJob) *user.UserImportJob {
+	return &user.UserImportJob{
+		Id: job.ID,
+		Details: object.DomainToDetailsPb(&domain.ObjectDetails{
+			Sequence:      job.Sequence,
+			EventDate:     job.ChangeDate,
+			ResourceOwner: job.ResourceOwner,
+		}),
+		CreationDate:  timestamppb.New(job.CreationDate),
+		State:         userImportJobStateToPb(job.State),
+		Format:        userImportFormatToPb(job.Format),
+		TotalRows:     job.TotalRows,
+		ProcessedRows: job.ProcessedRows,
+		CreatedRows:   job.CreatedRows,
+		UpdatedRows:   job.UpdatedRows,
+		FailedRows:    job.FailedRows,
+	}
+}
+
+func userImportJobStateToPb(state domain.UserImportJobState) user.UserImportJobState {
+	switch state {
+	case domain.UserImportJobStateRunning:
+		return user.UserImportJobState_USER_IMPORT_JOB_STATE_RUNNING
+	case domain.UserImportJobStateDone:
+		return user.UserImportJobState_USER_IMPORT_JOB_STATE_DONE
+	case domain.UserImportJobStateUnspecified:
+		return user.UserImportJobState_USER_IMPORT_JOB_STATE_UNSPECIFIED
+	default:
+		return user.UserImportJobState_USER_IMPORT_JOB_STATE_UNSPECIFIED
+	}
+}
+
+func userImportResultsToPb(results []*query.UserImportResult) []*user.UserImportResult {
+	converted := make([]*user.UserImportResult, len(results))
+	for i, result := range results {
+		converted[i] = &user.UserImportResult{
+			Row:    result.Row,
+			UserId: result.UserID,
+			State:  userImportResultStateToPb(result.State),
+			Error:  result.Error,
+		}
+	}
+	return converted
+}
+
+func userImportResultStateToPb(state domain.UserImportRowState) user.UserImportResultState {
+	switch state {
+	case domain.UserImportRowStateCreated:
+		return user.UserImportResultState_USER_IMPORT_RESULT_STATE_CREATED
+	case domain.UserImportRowStateUpdated:
+		return user.UserImportResultState_USER_IMPORT_RESULT_STATE_UPDATED
+	case domain.UserImportRowStateFailed:
+		return user.UserImportResultState_USER_IMPORT_RESULT_STATE_FAILED
+	case domain.UserImportRowStateUnspecified:
+		return user.UserImportResultState_USER_IMPORT_RESULT_STATE_UNSPECIFIED
+	default:
+		return user.UserImportResultState_USER_IMPORT_RESULT_STATE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,110 @@
+package oidc
+
+import (
+	"github.com/zitadel/oidc/v2/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+const (
+	ClaimACR = "acr"
+
+	// ACRLevelPassword requires the user to authenticate with at least one factor
+	ACRLevelPassword = "password"
+	// ACRLevelMFA requires the user to authenticate with multiple factors
+	ACRLevelMFA = "mfa"
+	// ACRLevelPhishingResistant requires the user to authenticate with multiple factors,
+	// where at least one is phishing resistant (passkey or u2f)
+	ACRLevelPhishingResistant = "phishing_resistant"
+)
+
+// ACRValueConfig maps an Authentication Context Class Reference value
+// (requested in `acr_values` and returned in the `acr` claim) to a level of assurance
+type ACRValueConfig struct {
+	Value string
+	Level string
+}
+
+// ACRMapping holds the configured acr values ordered by their level of assurance (highest first)
+type ACRMapping []acrValue
+
+type acrValue struct {
+	value string
+	level domain.LevelOfAssurance
+}
+
+func NewACRMapping(configs []ACRValueConfig) (ACRMapping, error) {
+	mapping := make(ACRMapping, 0, len(configs))
+	for _, config := range configs {
+		level, err := levelOfAssuranceFromConfig(config.Level)
+		if err != nil {
+			return nil, err
+		}
+		if config.Value == "" {
+			return nil, errors.ThrowInvalidArgument(nil, "OIDC-Aw3gq", "acr value must not be empty")
+		}
+		mapping = append(mapping, acrValue{value: config.Value, level: level})
+	}
+	// a simple insertion sort is sufficient for the few configured values and keeps the configured order on equal levels
+	for i := 1; i < len(mapping); i++ {
+		for j := i; j > 0 && mapping[j].level > mapping[j-1].level; j-- {
+			mapping[j], mapping[j-1] = mapping[j-1], mapping[j]
+		}
+	}
+	return mapping, nil
+}
+
+func levelOfAssuranceFromConfig(level string) (domain.LevelOfAssurance, error) {
+	switch level {
+	case ACRLevelPassword:
+		return domain.LevelOfAssurancePassword, nil
+	case ACRLevelMFA:
+		return domain.LevelOfAssuranceMFA, nil
+	case ACRLevelPhishingResistant:
+		return domain.LevelOfAssurancePhishingResistant, nil
+	default:
+		return domain.LevelOfAssuranceNone, errors.ThrowInvalidArgumentf(nil, "OIDC-Bf3qw", "unknown acr level %q", level)
+	}
+}
+
+// LevelsOfAssurance maps the requested `acr_values` to their levels of assurance.
+// The order of preference is kept, unknown values are ignored.
+func (m ACRMapping) LevelsOfAssurance(values []string) []domain.LevelOfAssurance {
+	if len(values) == 0 {
+		return nil
+	}
+	levels := make([]domain.LevelOfAssurance, 0, len(values))
+	for _, value := range values {
+		for _, acr := range m {
+			if acr.value == value {
+				levels = append(levels, acr.level)
+				break
+			}
+		}
+	}
+	return levels
+}
+
+// ACR returns the value of the highest configured level, which is satisfied by the reached level of assurance.
+// If none is satisfied, an empty string is returned.
+func (m ACRMapping) ACR(reached domain.LevelOfAssurance) string {
+	for _, acr := range m {
+		if acr.level <= reached {
+			return acr.value
+		}
+	}
+	return ""
+}
+
+// setIntrospectionACR sets the `acr` claim of the level of assurance the token was issued with
+func setIntrospectionACR(introspection *oidc.IntrospectionResponse, mapping ACRMapping, reached domain.LevelOfAssurance) {
+	acr := mapping.ACR(reached)
+	if acr == "" {
+		return
+	}
+	if introspection.Claims == nil {
+		introspection.Claims = make(map[string]any)
+	}
+	introspection.Claims[ClaimACR] = acr
+}
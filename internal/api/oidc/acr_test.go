@@ -0,0 +1,172 @@
+package oidc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v2/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/user/model"
+)
+
+var testACRConfig = []ACRValueConfig{
+	{Value: "urn:test:mfa", Level: ACRLevelMFA},
+	{Value: "urn:test:pwd", Level: ACRLevelPassword},
+	{Value: "urn:test:phr", Level: ACRLevelPhishingResistant},
+}
+
+func TestNewACRMapping(t *testing.T) {
+	tests := []struct {
+		name    string
+		configs []ACRValueConfig
+		want    ACRMapping
+		wantErr func(error) bool
+	}{
+		{
+			"empty",
+			nil,
+			ACRMapping{},
+			nil,
+		},
+		{
+			"unknown level, error",
+			[]ACRValueConfig{{Value: "urn:test", Level: "unknown"}},
+			nil,
+			errors.IsErrorInvalidArgument,
+		},
+		{
+			"empty value, error",
+			[]ACRValueConfig{{Level: ACRLevelMFA}},
+			nil,
+			errors.IsErrorInvalidArgument,
+		},
+		{
+			"ordered by level",
+			testACRConfig,
+			ACRMapping{
+				{value: "urn:test:phr", level: domain.LevelOfAssurancePhishingResistant},
+				{value: "urn:test:mfa", level: domain.LevelOfAssuranceMFA},
+				{value: "urn:test:pwd", level: domain.LevelOfAssurancePassword},
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewACRMapping(tt.configs)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestACRMapping_LevelsOfAssurance(t *testing.T) {
+	mapping, err := NewACRMapping(testACRConfig)
+	require.NoError(t, err)
+	tests := []struct {
+		name   string
+		values []string
+		want   []domain.LevelOfAssurance
+	}{
+		{
+			"none requested",
+			nil,
+			nil,
+		},
+		{
+			"unknown ignored",
+			[]string{"urn:unknown", "urn:test:mfa"},
+			[]domain.LevelOfAssurance{domain.LevelOfAssuranceMFA},
+		},
+		{
+			"order of preference kept",
+			[]string{"urn:test:pwd", "urn:test:phr"},
+			[]domain.LevelOfAssurance{domain.LevelOfAssurancePassword, domain.LevelOfAssurancePhishingResistant},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, mapping.LevelsOfAssurance(tt.values))
+		})
+	}
+}
+
+func TestACRMapping_ACR(t *testing.T) {
+	mapping, err := NewACRMapping(testACRConfig)
+	require.NoError(t, err)
+	tests := []struct {
+		name    string
+		reached domain.LevelOfAssurance
+		want    string
+	}{
+		{
+			"none reached",
+			domain.LevelOfAssuranceNone,
+			"",
+		},
+		{
+			"password",
+			domain.LevelOfAssurancePassword,
+			"urn:test:pwd",
+		},
+		{
+			"mfa",
+			domain.LevelOfAssuranceMFA,
+			"urn:test:mfa",
+		},
+		{
+			"phishing resistant",
+			domain.LevelOfAssurancePhishingResistant,
+			"urn:test:phr",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, mapping.ACR(tt.reached))
+		})
+	}
+}
+
+func Test_setIntrospectionACR(t *testing.T) {
+	mapping, err := NewACRMapping(testACRConfig)
+	require.NoError(t, err)
+	tests := []struct {
+		name   string
+		token  *model.TokenView
+		claims map[string]any
+		want   map[string]any
+	}{
+		{
+			"v1 token without level of assurance",
+			&model.TokenView{ID: "tokenID"},
+			nil,
+			nil,
+		},
+		{
+			"v1 token with mfa",
+			&model.TokenView{ID: "tokenID", LevelOfAssurance: domain.LevelOfAssuranceMFA},
+			nil,
+			map[string]any{ClaimACR: "urn:test:mfa"},
+		},
+		{
+			"v1 token with password, existing claims",
+			&model.TokenView{ID: "tokenID", LevelOfAssurance: domain.LevelOfAssurancePassword},
+			map[string]any{"claim": "value"},
+			map[string]any{"claim": "value", ClaimACR: "urn:test:pwd"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			introspection := &oidc.IntrospectionResponse{Claims: tt.claims}
+			setIntrospectionACR(introspection, mapping, tt.token.LevelOfAssurance)
+			assert.Equal(t, tt.want, introspection.Claims)
+		})
+	}
+}
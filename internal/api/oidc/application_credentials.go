@@ -0,0 +1,181 @@
+package oidc
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/oidc/v2/pkg/oidc"
+	"github.com/zitadel/oidc/v2/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// applicationTokenRequest is the token request of an API application authenticating itself
+// either by client credentials or by a JWT profile grant (private key JWT)
+type applicationTokenRequest struct {
+	clientID      string
+	resourceOwner string
+	audience      []string
+	scopes        []string
+	// authorizationDetails (RFC 9396) requested in the token request and validated against the project
+	authorizationDetails domain.AuthorizationDetails
+}
+
+// GetSubject returns the subject for token to be created, which is the client_id of the application
+func (a *applicationTokenRequest) GetSubject() string {
+	return a.clientID
+}
+
+// GetAudience returns the audience for token to be created, which is restricted to the project of the application
+func (a *applicationTokenRequest) GetAudience() []string {
+	return a.audience
+}
+
+func (a *applicationTokenRequest) GetScopes() []string {
+	return a.scopes
+}
+
+// apiApplication returns the active API application of the provided client_id.
+// If there is no API application with the client_id, nil and no error are returned,
+// so the caller is able to fall back to machine users.
+func (o *OPStorage) apiApplication(ctx context.Context, clientID string) (_ *query.App, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	app, err := o.query.AppByClientID(ctx, clientID, false)
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if app.APIConfig == nil {
+		return nil, nil
+	}
+	if app.State != domain.AppStateActive {
+		return nil, errors.ThrowPermissionDenied(nil, "OIDC-Ap3fw", "Errors.Project.App.NotActive")
+	}
+	return app, nil
+}
+
+// applicationClientCredentials verifies the secret of an API application using client credentials
+func (o *OPStorage) applicationClientCredentials(ctx context.Context, app *query.App, clientSecret string) (op.Client, error) {
+	if app.APIConfig.AuthMethodType != domain.APIAuthMethodTypeBasic {
+		return nil, errors.ThrowPermissionDenied(nil, "OIDC-Ap8xk", "Errors.Project.App.APIAuthMethodNoSecret")
+	}
+	ctx = authz.SetCtxData(ctx, authz.CtxData{
+		UserID: oidcCtx,
+		OrgID:  oidcCtx,
+	})
+	if err := o.command.VerifyAPIClientSecret(ctx, app.ProjectID, app.ID, clientSecret); err != nil {
+		return nil, err
+	}
+	return &clientCredentialsClient{
+		id:        app.APIConfig.ClientID,
+		tokenType: op.AccessTokenTypeBearer,
+	}, nil
+}
+
+// applicationTokenRequest creates the token request of an API application.
+// Only scopes defined on the project of the application are kept,
+// and the audience of the token is restricted to that project.
+func (o *OPStorage) applicationTokenRequest(ctx context.Context, app *query.App, scopes []string) (*applicationTokenRequest, error) {
+	scopes, err := o.assertProjectScopes(ctx, app.ProjectID, scopes)
+	if err != nil {
+		return nil, err
+	}
+	authorizationDetails, err := o.assertAuthorizationDetails(ctx, app.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	return &applicationTokenRequest{
+		clientID:             app.APIConfig.ClientID,
+		resourceOwner:        app.ResourceOwner,
+		audience:             []string{app.ProjectID},
+		scopes:               scopes,
+		authorizationDetails: authorizationDetails,
+	}, nil
+}
+
+// assertProjectScopes removes all scopes which are not defined on the project
+func (o *OPStorage) assertProjectScopes(ctx context.Context, projectID string, scopes []string) ([]string, error) {
+	keys, err := o.query.ProjectScopeKeys(ctx, false, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defined := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		defined[key] = struct{}{}
+	}
+	asserted := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if _, ok := defined[scope]; ok {
+			asserted = append(asserted, scope)
+		}
+	}
+	return asserted, nil
+}
+
+// createApplicationAccessToken creates the access token for an API application authenticated by client credentials or JWT profile grant
+func (o *OPStorage) createApplicationAccessToken(ctx context.Context, req *applicationTokenRequest) (string, time.Time, error) {
+	return o.command.AddApplicationAccessToken(setContextUserSystem(ctx), req.resourceOwner, req.clientID, req.audience, req.scopes, req.authorizationDetails, time.Now())
+}
+
+// jwtProfileApplicationTokenRequest returns the token request of an API application,
+// if the subject of the JWT profile grant is the client_id of an API application using private key JWT
+func (o *OPStorage) jwtProfileApplicationTokenRequest(ctx context.Context, request *oidc.JWTTokenRequest) (*applicationTokenRequest, error) {
+	app, err := o.apiApplication(ctx, request.Subject)
+	if err != nil || app == nil {
+		return nil, err
+	}
+	if app.APIConfig.AuthMethodType != domain.APIAuthMethodTypePrivateKeyJWT {
+		return nil, errors.ThrowPermissionDenied(nil, "OIDC-Ap5qc", "Errors.Project.App.AuthMethodNoPrivateKeyJWT")
+	}
+	return o.applicationTokenRequest(ctx, app, request.Scopes)
+}
+
+// introspectApplicationToken fills the introspection response for an access token issued to an API application.
+// The scopes are validated against the project scopes currently defined, so removed scopes are no longer returned.
+func (o *OPStorage) introspectApplicationToken(
+	ctx context.Context,
+	introspection *oidc.IntrospectionResponse,
+	token *query.OIDCSessionAccessTokenReadModel,
+	introspectionClientID, introspectionProjectID string,
+) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if _, err = o.apiApplication(ctx, token.ClientID); err != nil {
+		return err
+	}
+	for _, aud := range token.Audience {
+		if aud == introspectionClientID || aud == introspectionProjectID {
+			scopes, err := o.assertProjectScopes(ctx, introspectionProjectID, token.Scope)
+			if err != nil {
+				return err
+			}
+			introspection.Subject = token.ClientID
+			introspection.Scope = scopes
+			introspection.ClientID = token.ClientID
+			introspection.TokenType = oidc.BearerToken
+			introspection.Expiration = oidc.FromTime(token.AccessTokenExpiration)
+			introspection.IssuedAt = oidc.FromTime(token.AccessTokenCreation)
+			introspection.NotBefore = oidc.FromTime(token.AccessTokenCreation)
+			introspection.Audience = token.Audience
+			introspection.Issuer = op.IssuerFromContext(ctx)
+			introspection.JWTID = token.AccessTokenID
+			setIntrospectionAuthorizationDetails(introspection, token.AuthorizationDetails)
+			return nil
+		}
+	}
+	return errors.ThrowPermissionDenied(nil, "OIDC-Ap7nd", "token is not valid for this client")
+}
+
+// isApplicationToken returns true if the access token was issued to an API application itself and not to a user
+func isApplicationToken(token *query.OIDCSessionAccessTokenReadModel) bool {
+	return token.SessionID == "" && token.UserID == token.ClientID
+}
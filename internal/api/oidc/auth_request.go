@@ -38,6 +38,9 @@ func (o *OPStorage) CreateAuthRequest(ctx context.Context, req *oidc.AuthRequest
 }
 
 func (o *OPStorage) createAuthRequestLoginClient(ctx context.Context, req *oidc.AuthRequest, hintUserID, loginClient string) (op.AuthRequest, error) {
+	if requestedAuthorizationDetails(ctx) != "" {
+		return nil, oidc.ErrInvalidRequest().WithDescription("authorization_details are not supported for login clients")
+	}
 	project, err := o.query.ProjectByClientID(ctx, req.ClientID, false)
 	if err != nil {
 		return nil, err
@@ -76,7 +79,7 @@ func (o *OPStorage) createAuthRequestLoginClient(ctx context.Context, req *oidc.
 	if err != nil {
 		return nil, err
 	}
-	return &AuthRequestV2{aar}, nil
+	return &AuthRequestV2{CurrentAuthRequest: aar, acr: o.acr}, nil
 }
 
 func (o *OPStorage) createAuthRequest(ctx context.Context, req *oidc.AuthRequest, userID string) (_ op.AuthRequest, err error) {
@@ -88,12 +91,21 @@ func (o *OPStorage) createAuthRequest(ctx context.Context, req *oidc.AuthRequest
 	if err != nil {
 		return nil, errors.ThrowPreconditionFailed(err, "OIDC-Gqrfg", "Errors.Internal")
 	}
-	authRequest := CreateAuthRequestToBusiness(ctx, req, userAgentID, userID)
+	projectID, err := o.query.ProjectIDFromClientID(ctx, req.ClientID, false)
+	if err != nil {
+		return nil, err
+	}
+	authorizationDetails, err := o.assertAuthorizationDetails(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	authRequest := CreateAuthRequestToBusiness(ctx, req, userAgentID, userID, o.acr)
+	authRequest.Request.(*domain.AuthRequestOIDC).AuthorizationDetails = authorizationDetails
 	resp, err := o.repo.CreateAuthRequest(ctx, authRequest)
 	if err != nil {
 		return nil, err
 	}
-	return AuthRequestFromBusiness(resp)
+	return AuthRequestFromBusiness(resp, o.acr)
 }
 
 func (o *OPStorage) audienceFromProjectID(ctx context.Context, projectID string) ([]string, error) {
@@ -118,7 +130,7 @@ func (o *OPStorage) AuthRequestByID(ctx context.Context, id string) (_ op.AuthRe
 		if err != nil {
 			return nil, err
 		}
-		return &AuthRequestV2{req}, nil
+		return &AuthRequestV2{CurrentAuthRequest: req, acr: o.acr}, nil
 	}
 
 	userAgentID, ok := middleware.UserAgentIDFromCtx(ctx)
@@ -129,7 +141,7 @@ func (o *OPStorage) AuthRequestByID(ctx context.Context, id string) (_ op.AuthRe
 	if err != nil {
 		return nil, err
 	}
-	return AuthRequestFromBusiness(resp)
+	return AuthRequestFromBusiness(resp, o.acr)
 }
 
 func (o *OPStorage) AuthRequestByCode(ctx context.Context, code string) (_ op.AuthRequest, err error) {
@@ -145,13 +157,13 @@ func (o *OPStorage) AuthRequestByCode(ctx context.Context, code string) (_ op.Au
 		if err != nil {
 			return nil, err
 		}
-		return &AuthRequestV2{authReq}, nil
+		return &AuthRequestV2{CurrentAuthRequest: authReq, acr: o.acr}, nil
 	}
 	resp, err := o.repo.AuthRequestByCode(ctx, code)
 	if err != nil {
 		return nil, err
 	}
-	return AuthRequestFromBusiness(resp)
+	return AuthRequestFromBusiness(resp, o.acr)
 }
 
 // decryptGrant decrypts a code or refresh_token
@@ -190,13 +202,27 @@ func (o *OPStorage) CreateAccessToken(ctx context.Context, req op.TokenRequest)
 	defer func() { span.EndWithError(err) }()
 
 	var userAgentID, applicationID, userOrgID string
+	var authorizationDetails domain.AuthorizationDetails
+	var levelOfAssurance domain.LevelOfAssurance
 	switch authReq := req.(type) {
 	case *AuthRequest:
 		userAgentID = authReq.AgentID
 		applicationID = authReq.ApplicationID
 		userOrgID = authReq.UserOrgID
+		authorizationDetails = authReq.authorizationDetails()
+		levelOfAssurance = authReq.LevelOfAssurance()
 	case *AuthRequestV2:
 		return o.command.AddOIDCSessionAccessToken(setContextUserSystem(ctx), authReq.GetID())
+	case *applicationTokenRequest:
+		return o.createApplicationAccessToken(ctx, authReq)
+	case *oidc.JWTTokenRequest:
+		appReq, err := o.jwtProfileApplicationTokenRequest(ctx, authReq)
+		if err != nil {
+			return "", time.Time{}, err
+		}
+		if appReq != nil {
+			return o.createApplicationAccessToken(ctx, appReq)
+		}
 	}
 
 	accessTokenLifetime, _, _, _, err := o.getOIDCSettings(ctx)
@@ -204,10 +230,11 @@ func (o *OPStorage) CreateAccessToken(ctx context.Context, req op.TokenRequest)
 		return "", time.Time{}, err
 	}
 
-	resp, err := o.command.AddUserToken(setContextUserSystem(ctx), userOrgID, userAgentID, applicationID, req.GetSubject(), req.GetAudience(), req.GetScopes(), accessTokenLifetime) //PLANNED: lifetime from client
+	resp, err := o.command.AddUserToken(setContextUserSystem(ctx), userOrgID, userAgentID, applicationID, req.GetSubject(), req.GetAudience(), req.GetScopes(), authorizationDetails, levelOfAssurance, accessTokenLifetime) //PLANNED: lifetime from client
 	if err != nil {
 		return "", time.Time{}, err
 	}
+	setGrantedAuthorizationDetails(ctx, resp.AuthorizationDetails)
 	return resp.TokenID, resp.Expiration, nil
 }
 
@@ -237,8 +264,15 @@ func (o *OPStorage) CreateAccessAndRefreshTokens(ctx context.Context, req op.Tok
 		return "", "", time.Time{}, err
 	}
 
+	var authorizationDetails domain.AuthorizationDetails
+	var levelOfAssurance domain.LevelOfAssurance
+	if authReq, ok := req.(*AuthRequest); ok {
+		authorizationDetails = authReq.authorizationDetails()
+		levelOfAssurance = authReq.LevelOfAssurance()
+	}
+
 	resp, token, err := o.command.AddAccessAndRefreshToken(setContextUserSystem(ctx), userOrgID, userAgentID, applicationID, req.GetSubject(),
-		refreshToken, req.GetAudience(), scopes, authMethodsReferences, accessTokenLifetime,
+		refreshToken, req.GetAudience(), scopes, authMethodsReferences, authorizationDetails, levelOfAssurance, accessTokenLifetime,
 		refreshTokenIdleExpiration, refreshTokenExpiration, authTime) //PLANNED: lifetime from client
 	if err != nil {
 		if errors.IsErrorInvalidArgument(err) {
@@ -246,6 +280,7 @@ func (o *OPStorage) CreateAccessAndRefreshTokens(ctx context.Context, req op.Tok
 		}
 		return "", "", time.Time{}, err
 	}
+	setGrantedAuthorizationDetails(ctx, resp.AuthorizationDetails)
 	return resp.TokenID, token, resp.Expiration, nil
 }
 
@@ -541,7 +576,7 @@ func CreateTokenCallbackURL(ctx context.Context, req op.AuthRequest, authorizer
 		return "", err
 	}
 	createAccessToken := req.GetResponseType() != oidc.ResponseTypeIDTokenOnly
-	resp, err := op.CreateTokenResponse(ctx, req, client, authorizer, createAccessToken, "", "")
+	resp, err := createTokenResponse(ctx, req, client, authorizer, createAccessToken, "", "")
 	if err != nil {
 		return "", err
 	}
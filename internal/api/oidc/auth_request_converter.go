@@ -19,6 +19,7 @@ import (
 
 type AuthRequest struct {
 	*domain.AuthRequest
+	acr ACRMapping
 }
 
 func (a *AuthRequest) GetID() string {
@@ -26,8 +27,7 @@ func (a *AuthRequest) GetID() string {
 }
 
 func (a *AuthRequest) GetACR() string {
-	// return a.
-	return "" //PLANNED: impl
+	return a.acr.ACR(a.LevelOfAssurance())
 }
 
 func (a *AuthRequest) GetAMR() []string {
@@ -82,6 +82,11 @@ func (a *AuthRequest) GetScopes() []string {
 	return a.oidc().Scopes
 }
 
+// authorizationDetails returns the authorization details (RFC 9396) requested by the client and consented by the user
+func (a *AuthRequest) authorizationDetails() domain.AuthorizationDetails {
+	return a.oidc().AuthorizationDetails
+}
+
 func (a *AuthRequest) GetState() string {
 	return a.TransferState
 }
@@ -94,14 +99,14 @@ func (a *AuthRequest) oidc() *domain.AuthRequestOIDC {
 	return a.Request.(*domain.AuthRequestOIDC)
 }
 
-func AuthRequestFromBusiness(authReq *domain.AuthRequest) (_ op.AuthRequest, err error) {
+func AuthRequestFromBusiness(authReq *domain.AuthRequest, acr ACRMapping) (_ op.AuthRequest, err error) {
 	if _, ok := authReq.Request.(*domain.AuthRequestOIDC); !ok {
 		return nil, errors.ThrowInvalidArgument(nil, "OIDC-Haz7A", "auth request is not of type oidc")
 	}
-	return &AuthRequest{authReq}, nil
+	return &AuthRequest{AuthRequest: authReq, acr: acr}, nil
 }
 
-func CreateAuthRequestToBusiness(ctx context.Context, authReq *oidc.AuthRequest, userAgentID, userID string) *domain.AuthRequest {
+func CreateAuthRequestToBusiness(ctx context.Context, authReq *oidc.AuthRequest, userAgentID, userID string, acr ACRMapping) *domain.AuthRequest {
 	return &domain.AuthRequest{
 		CreationDate:        time.Now(),
 		AgentID:             userAgentID,
@@ -110,7 +115,7 @@ func CreateAuthRequestToBusiness(ctx context.Context, authReq *oidc.AuthRequest,
 		CallbackURI:         authReq.RedirectURI,
 		TransferState:       authReq.State,
 		Prompt:              PromptToBusiness(authReq.Prompt),
-		PossibleLOAs:        ACRValuesToBusiness(authReq.ACRValues),
+		PossibleLOAs:        acr.LevelsOfAssurance(authReq.ACRValues),
 		UiLocales:           UILocalesToBusiness(authReq.UILocales),
 		LoginHint:           authReq.LoginHint,
 		SelectedIDPConfigID: GetSelectedIDPIDFromScopes(authReq.Scopes),
@@ -173,10 +178,6 @@ func PromptToBusiness(oidcPrompt []string) []domain.Prompt {
 	return prompts
 }
 
-func ACRValuesToBusiness(values []string) []domain.LevelOfAssurance {
-	return nil
-}
-
 func UILocalesToBusiness(tags []language.Tag) []string {
 	if tags == nil {
 		return nil
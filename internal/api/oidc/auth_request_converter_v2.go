@@ -6,10 +6,12 @@ import (
 	"github.com/zitadel/oidc/v2/pkg/oidc"
 
 	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
 )
 
 type AuthRequestV2 struct {
 	*command.CurrentAuthRequest
+	acr ACRMapping
 }
 
 func (a *AuthRequestV2) GetID() string {
@@ -17,7 +19,7 @@ func (a *AuthRequestV2) GetID() string {
 }
 
 func (a *AuthRequestV2) GetACR() string {
-	return "" //PLANNED: impl
+	return a.acr.ACR(domain.LevelOfAssuranceFromAuthMethods(a.AuthMethods))
 }
 
 func (a *AuthRequestV2) GetAMR() []string {
@@ -0,0 +1,98 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/zitadel/oidc/v2/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+type authorizationDetailsKey struct{}
+
+type authorizationDetailsHolder struct {
+	requested string
+	granted   domain.AuthorizationDetails
+}
+
+// authorizationDetailsInterceptor provides the authorization_details parameter (RFC 9396) of the authorization and token request
+// in the request context, as it's not part of the requests parsed by the OpenID Provider.
+// Additionally, it provides a placeholder for the authorization details granted to the token issued during the request,
+// so they can be added to JWT access tokens.
+func authorizationDetailsInterceptor(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		holder := new(authorizationDetailsHolder)
+		// the parsed form is kept on the request, so the OpenID Provider is still able to decode it
+		if err := r.ParseForm(); err == nil {
+			holder.requested = r.Form.Get(domain.AuthorizationDetailsParameter)
+		}
+		ctx := context.WithValue(r.Context(), authorizationDetailsKey{}, holder)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func requestedAuthorizationDetails(ctx context.Context) string {
+	holder, ok := ctx.Value(authorizationDetailsKey{}).(*authorizationDetailsHolder)
+	if !ok {
+		return ""
+	}
+	return holder.requested
+}
+
+func setGrantedAuthorizationDetails(ctx context.Context, details domain.AuthorizationDetails) {
+	if holder, ok := ctx.Value(authorizationDetailsKey{}).(*authorizationDetailsHolder); ok {
+		holder.granted = details
+	}
+}
+
+func grantedAuthorizationDetails(ctx context.Context) domain.AuthorizationDetails {
+	holder, ok := ctx.Value(authorizationDetailsKey{}).(*authorizationDetailsHolder)
+	if !ok {
+		return nil
+	}
+	return holder.granted
+}
+
+// assertAuthorizationDetails parses the authorization details requested by the client
+// and validates them against the types (and their schemas) registered on the project.
+// If the client did not request any authorization details, nil is returned.
+func (o *OPStorage) assertAuthorizationDetails(ctx context.Context, projectID string) (_ domain.AuthorizationDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	details, err := domain.ParseAuthorizationDetails(requestedAuthorizationDetails(ctx))
+	if err != nil {
+		return nil, invalidAuthorizationDetails(err)
+	}
+	if len(details) == 0 {
+		return nil, nil
+	}
+	types, err := o.query.ProjectAuthorizationDetailTypeSchemas(ctx, false, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if err = details.Validate(types); err != nil {
+		return nil, invalidAuthorizationDetails(err)
+	}
+	return details, nil
+}
+
+func invalidAuthorizationDetails(err error) error {
+	return &oidc.Error{
+		ErrorType:   domain.AuthorizationDetailsErrorType,
+		Description: err.Error(),
+	}
+}
+
+// setIntrospectionAuthorizationDetails adds the authorization details granted to the token to the introspection response
+func setIntrospectionAuthorizationDetails(introspection *oidc.IntrospectionResponse, details domain.AuthorizationDetails) {
+	if len(details) == 0 {
+		return
+	}
+	if introspection.Claims == nil {
+		introspection.Claims = make(map[string]any)
+	}
+	introspection.Claims[domain.AuthorizationDetailsParameter] = details
+}
@@ -0,0 +1,386 @@
+package oidc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/zitadel/logging"
+	httphelper "github.com/zitadel/oidc/v2/pkg/http"
+	"github.com/zitadel/oidc/v2/pkg/oidc"
+	"github.com/zitadel/oidc/v2/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+const (
+	// GrantTypeCIBA is used by clients to request the tokens of a backchannel authentication request
+	// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.10.1
+	GrantTypeCIBA oidc.GrantType = "urn:openid:params:grant-type:ciba"
+
+	BackchannelAuthDefaultLifetime     = 5 * time.Minute
+	BackchannelAuthDefaultPollInterval = 5 * time.Second
+
+	backchannelTokenDeliveryModePoll = "poll"
+	backchannelTokenDeliveryModePing = "ping"
+
+	defaultBackchannelAuthEndpoint = "/oauth/v2/bc-authorize"
+
+	errorTypeUnknownUserID = "unknown_user_id"
+)
+
+type BackchannelAuthConfig struct {
+	Lifetime     time.Duration
+	PollInterval time.Duration
+}
+
+// lifetime returns the configured lifetime or the default if none is set.
+// Safe to call when c is nil.
+func (c *BackchannelAuthConfig) lifetime() time.Duration {
+	if c == nil || c.Lifetime == 0 {
+		return BackchannelAuthDefaultLifetime
+	}
+	return c.Lifetime
+}
+
+// pollInterval returns the configured poll interval or the default if none is set.
+// Safe to call when c is nil.
+func (c *BackchannelAuthConfig) pollInterval() time.Duration {
+	if c == nil || c.PollInterval == 0 {
+		return BackchannelAuthDefaultPollInterval
+	}
+	return c.PollInterval
+}
+
+// backchannelProvider extends the OpenID Provider with the Client Initiated Backchannel Authentication (CIBA),
+// which is not supported by the oidc library:
+//   - the backchannel authentication endpoint, where clients request the authentication of a user
+//   - the token request with the CIBA grant type (poll and ping mode)
+//   - the CIBA metadata of the discovery endpoint
+//
+// All other requests are handled by the OpenID Provider itself.
+type backchannelProvider struct {
+	*op.Provider
+	storage          *OPStorage
+	config           *BackchannelAuthConfig
+	userCode         op.UserCodeConfig
+	endpoint         op.Endpoint
+	authorizeHandler http.Handler
+	tokenHandler     http.Handler
+}
+
+func newBackchannelProvider(provider *op.Provider, storage *OPStorage, config Config, interceptors []op.HttpInterceptor) *backchannelProvider {
+	endpoint := op.NewEndpoint(defaultBackchannelAuthEndpoint)
+	if config.CustomEndpoints != nil && config.CustomEndpoints.BackchannelAuth != nil {
+		endpoint = op.NewEndpointWithURL(config.CustomEndpoints.BackchannelAuth.Path, config.CustomEndpoints.BackchannelAuth.URL)
+	}
+	p := &backchannelProvider{
+		Provider: provider,
+		storage:  storage,
+		config:   config.BackchannelAuth,
+		userCode: provider.DeviceAuthorization().UserCode,
+		endpoint: endpoint,
+	}
+	p.authorizeHandler = intercept(provider, interceptors)(http.HandlerFunc(p.backchannelAuthorizeHandler))
+	p.tokenHandler = intercept(provider, interceptors)(http.HandlerFunc(p.backchannelTokenHandler))
+	return p
+}
+
+func (p *backchannelProvider) HttpHandler() http.Handler {
+	return p
+}
+
+func (p *backchannelProvider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == p.endpoint.Relative():
+		p.authorizeHandler.ServeHTTP(w, r)
+	case r.URL.Path == p.TokenEndpoint().Relative() && r.FormValue("grant_type") == string(GrantTypeCIBA):
+		p.tokenHandler.ServeHTTP(w, r)
+	case r.URL.Path == oidc.DiscoveryEndpoint:
+		p.discoveryHandler(w, r)
+	default:
+		p.Provider.HttpHandler().ServeHTTP(w, r)
+	}
+}
+
+type backchannelAuthRequest struct {
+	Scopes                  oidc.SpaceDelimitedArray `schema:"scope"`
+	ClientNotificationToken string                   `schema:"client_notification_token"`
+	LoginHint               string                   `schema:"login_hint"`
+	IDTokenHint             string                   `schema:"id_token_hint"`
+	BindingMessage          string                   `schema:"binding_message"`
+	RequestedExpiry         int64                    `schema:"requested_expiry"`
+}
+
+type backchannelAuthResponse struct {
+	AuthReqID string `json:"auth_req_id"`
+	ExpiresIn int    `json:"expires_in"`
+	Interval  int    `json:"interval,omitempty"`
+}
+
+func (p *backchannelProvider) backchannelAuthorizeHandler(w http.ResponseWriter, r *http.Request) {
+	if err := p.backchannelAuthorize(w, r); err != nil {
+		logging.WithError(err).Info("backchannel authentication request failed")
+		op.RequestError(w, r, err)
+	}
+}
+
+// backchannelAuthorize handles the backchannel authentication request of a client
+// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.7.1
+func (p *backchannelProvider) backchannelAuthorize(w http.ResponseWriter, r *http.Request) (err error) {
+	ctx, span := tracing.NewSpan(r.Context())
+	defer func() { span.EndWithError(err) }()
+
+	client, err := p.backchannelClient(ctx, r)
+	if err != nil {
+		return err
+	}
+	req := new(backchannelAuthRequest)
+	if err = p.Decoder().Decode(req, r.Form); err != nil {
+		return oidc.ErrInvalidRequest().WithDescription("cannot parse request").WithParent(err)
+	}
+	if !containsScope(req.Scopes, oidc.ScopeOpenID) {
+		return oidc.ErrInvalidScope().WithDescription("scope %q is required", oidc.ScopeOpenID)
+	}
+	oidcConfig := client.app.OIDCConfig
+	if oidcConfig.BackchannelTokenDeliveryMode == domain.OIDCBackchannelTokenDeliveryModePing && req.ClientNotificationToken == "" {
+		return oidc.ErrInvalidRequest().WithDescription("client_notification_token is required in ping mode")
+	}
+	userID, err := p.backchannelUserID(ctx, req)
+	if err != nil {
+		return err
+	}
+	scopes, err := p.storage.assertProjectRoleScopes(ctx, client.GetID(), req.Scopes)
+	if err != nil {
+		return errors.ThrowPreconditionFailed(err, "OIDC-Bc3sc", "Errors.Internal")
+	}
+
+	authReqID, err := op.NewDeviceCode(op.RecommendedDeviceCodeBytes)
+	if err != nil {
+		return err
+	}
+	userCode, err := op.NewUserCode([]rune(p.userCode.CharSet), p.userCode.CharAmount, p.userCode.DashInterval)
+	if err != nil {
+		return err
+	}
+	lifetime := p.config.lifetime()
+	if requested := time.Duration(req.RequestedExpiry) * time.Second; requested > 0 && requested < lifetime {
+		lifetime = requested
+	}
+	_, _, err = p.storage.command.AddBackchannelAuth(ctx, client.GetID(), authReqID, userCode, time.Now().Add(lifetime), scopes,
+		&domain.BackchannelAuth{
+			UserID:                  userID,
+			BindingMessage:          req.BindingMessage,
+			DeliveryMode:            oidcConfig.BackchannelTokenDeliveryMode,
+			NotificationEndpoint:    oidcConfig.BackchannelClientNotificationEndpoint,
+			ClientNotificationToken: req.ClientNotificationToken,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	resp := &backchannelAuthResponse{
+		AuthReqID: authReqID,
+		ExpiresIn: int(lifetime / time.Second),
+	}
+	if oidcConfig.BackchannelTokenDeliveryMode == domain.OIDCBackchannelTokenDeliveryModePoll {
+		resp.Interval = int(p.config.pollInterval() / time.Second)
+	}
+	httphelper.MarshalJSON(w, resp)
+	return nil
+}
+
+// backchannelClient returns the authenticated client of the request,
+// if it's allowed to use the CIBA grant type
+func (p *backchannelProvider) backchannelClient(ctx context.Context, r *http.Request) (*Client, error) {
+	clientID, authenticated, err := op.ClientIDFromRequest(r, p.Provider)
+	if err != nil {
+		return nil, err
+	}
+	if !authenticated {
+		clientSecret := r.Form.Get("client_secret")
+		if clientSecret == "" {
+			return nil, oidc.ErrInvalidClient().WithDescription("client authentication is required")
+		}
+		if err = p.storage.AuthorizeClientIDSecret(ctx, clientID, clientSecret); err != nil {
+			return nil, oidc.ErrInvalidClient().WithParent(err)
+		}
+	}
+	opClient, err := p.storage.GetClientByClientID(ctx, clientID)
+	if err != nil {
+		return nil, oidc.ErrInvalidClient().WithParent(err)
+	}
+	client, ok := opClient.(*Client)
+	if !ok || !op.ValidateGrantType(client, GrantTypeCIBA) {
+		return nil, oidc.ErrUnauthorizedClient().WithDescription("grant type %q not allowed for client", GrantTypeCIBA)
+	}
+	return client, nil
+}
+
+// backchannelUserID returns the id of the user identified by exactly one of the provided hints.
+// The user must be active, so the authentication request can be delivered to them.
+func (p *backchannelProvider) backchannelUserID(ctx context.Context, req *backchannelAuthRequest) (string, error) {
+	if (req.LoginHint == "") == (req.IDTokenHint == "") {
+		return "", oidc.ErrInvalidRequest().WithDescription("exactly one of login_hint or id_token_hint is required")
+	}
+	var user *query.User
+	if req.IDTokenHint != "" {
+		claims, err := op.VerifyIDTokenHint[*oidc.TokenClaims](ctx, req.IDTokenHint, p.IDTokenHintVerifier(ctx))
+		if err != nil {
+			return "", oidc.ErrInvalidRequest().WithDescription("id_token_hint is invalid").WithParent(err)
+		}
+		user, err = p.storage.query.GetUserByID(ctx, false, claims.GetSubject(), false)
+		if err != nil && !errors.IsNotFound(err) {
+			return "", err
+		}
+	} else {
+		loginName, err := query.NewUserLoginNamesSearchQuery(req.LoginHint)
+		if err != nil {
+			return "", err
+		}
+		user, err = p.storage.query.GetUser(ctx, false, false, loginName)
+		if err != nil && !errors.IsNotFound(err) {
+			return "", err
+		}
+	}
+	if user == nil || user.Human == nil || user.State != domain.UserStateActive {
+		return "", &oidc.Error{ErrorType: errorTypeUnknownUserID, Description: "the user could not be identified"}
+	}
+	return user.ID, nil
+}
+
+func (p *backchannelProvider) backchannelTokenHandler(w http.ResponseWriter, r *http.Request) {
+	if err := p.backchannelToken(w, r); err != nil {
+		op.RequestError(w, r, err)
+	}
+}
+
+// backchannelToken handles the token request of a client for a backchannel authentication request.
+// In poll mode the client calls it periodically, in ping mode after it was notified about the result.
+// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.10.1
+func (p *backchannelProvider) backchannelToken(w http.ResponseWriter, r *http.Request) (err error) {
+	ctx, span := tracing.NewSpan(r.Context())
+	defer func() { span.EndWithError(err) }()
+
+	client, err := p.backchannelClient(ctx, r)
+	if err != nil {
+		return err
+	}
+	authReqID := r.Form.Get("auth_req_id")
+	if authReqID == "" {
+		return oidc.ErrInvalidRequest().WithDescription("auth_req_id missing")
+	}
+	backchannelAuth, err := p.storage.deviceAuthState(ctx, client.GetID(), authReqID)
+	if err != nil || backchannelAuth.UserID == "" {
+		return oidc.ErrInvalidGrant().WithDescription("auth_req_id is invalid").WithParent(err)
+	}
+	switch backchannelAuth.State {
+	case domain.DeviceAuthStateApproved:
+	case domain.DeviceAuthStateInitiated:
+		return oidc.ErrAuthorizationPending()
+	case domain.DeviceAuthStateExpired:
+		return oidc.ErrExpiredDeviceCode().WithDescription("The \"auth_req_id\" has expired.")
+	default:
+		return oidc.ErrAccessDenied()
+	}
+
+	resp, err := createTokenResponse(ctx, &backchannelTokenRequest{
+		subject:  backchannelAuth.Subject,
+		clientID: client.GetID(),
+		scopes:   backchannelAuth.Scopes,
+		authTime: backchannelAuth.ChangeDate,
+	}, client, p, true, "", "")
+	if err != nil {
+		return err
+	}
+	httphelper.MarshalJSON(w, resp)
+	return nil
+}
+
+// backchannelTokenRequest is the token request of an approved backchannel authentication request
+type backchannelTokenRequest struct {
+	subject  string
+	clientID string
+	scopes   []string
+	authTime time.Time
+}
+
+func (b *backchannelTokenRequest) GetAMR() []string {
+	return nil
+}
+
+func (b *backchannelTokenRequest) GetAudience() []string {
+	return []string{b.clientID}
+}
+
+func (b *backchannelTokenRequest) GetAuthTime() time.Time {
+	return b.authTime
+}
+
+func (b *backchannelTokenRequest) GetClientID() string {
+	return b.clientID
+}
+
+func (b *backchannelTokenRequest) GetScopes() []string {
+	return b.scopes
+}
+
+func (b *backchannelTokenRequest) GetSubject() string {
+	return b.subject
+}
+
+// discoveryHandler adds the CIBA metadata to the discovery document of the OpenID Provider
+// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.4
+func (p *backchannelProvider) discoveryHandler(w http.ResponseWriter, r *http.Request) {
+	recorder := &discoveryRecorder{ResponseWriter: w, status: http.StatusOK}
+	p.Provider.HttpHandler().ServeHTTP(recorder, r)
+	if recorder.status != http.StatusOK {
+		w.WriteHeader(recorder.status)
+		_, err := w.Write(recorder.body.Bytes())
+		logging.OnError(err).Debug("unable to write discovery response")
+		return
+	}
+	discovery := make(map[string]any)
+	if err := json.Unmarshal(recorder.body.Bytes(), &discovery); err != nil {
+		op.RequestError(w, r, err)
+		return
+	}
+	issuer, _ := discovery["issuer"].(string)
+	grantTypes, _ := discovery["grant_types_supported"].([]any)
+	discovery["grant_types_supported"] = append(grantTypes, GrantTypeCIBA)
+	discovery["backchannel_authentication_endpoint"] = p.endpoint.Absolute(issuer)
+	discovery["backchannel_token_delivery_modes_supported"] = []string{backchannelTokenDeliveryModePoll, backchannelTokenDeliveryModePing}
+	discovery["backchannel_user_code_parameter_supported"] = false
+	httphelper.MarshalJSON(w, discovery)
+}
+
+// discoveryRecorder captures the discovery document written by the OpenID Provider,
+// while the headers (e.g. CORS) are set on the actual response.
+type discoveryRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (d *discoveryRecorder) WriteHeader(status int) {
+	d.status = status
+}
+
+func (d *discoveryRecorder) Write(b []byte) (int, error) {
+	return d.body.Write(b)
+}
+
+func containsScope(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -35,6 +35,8 @@ const (
 	ClaimUserMetaData       = ScopeUserMetaData
 	ScopeResourceOwner      = "urn:zitadel:iam:user:resourceowner"
 	ClaimResourceOwner      = ScopeResourceOwner + ":"
+	ScopeUserGroups         = "urn:zitadel:iam:user:groups"
+	ClaimUserGroups         = ScopeUserGroups
 	ClaimActionLogFormat    = "urn:zitadel:iam:action:%s:log"
 
 	oidcCtx = "oidc"
@@ -62,6 +64,11 @@ func (o *OPStorage) GetClientByClientID(ctx context.Context, id string) (_ op.Cl
 	for i, role := range projectRoles.ProjectRoles {
 		allowedScopes[i] = ScopeProjectRolePrefix + role.Key
 	}
+	projectScopes, err := o.query.ProjectScopeKeys(ctx, false, client.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	allowedScopes = append(allowedScopes, projectScopes...)
 
 	accessTokenLifetime, idTokenLifetime, _, _, err := o.getOIDCSettings(ctx)
 	if err != nil {
@@ -78,11 +85,16 @@ func (o *OPStorage) GetKeyByIDAndClientID(ctx context.Context, keyID, userID str
 func (o *OPStorage) GetKeyByIDAndIssuer(ctx context.Context, keyID, issuer string) (_ *jose.JSONWebKey, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
+	// assertions signed with other algorithms than RS256 are verified by the jwtAssertionInterceptor
+	// and passed on signed by the assertionSigner
+	if key := o.assertionSigner.key(keyID); key != nil {
+		return key, nil
+	}
 	publicKeyData, err := o.query.GetAuthNKeyPublicKeyByIDAndIdentifier(ctx, keyID, issuer, false)
 	if err != nil {
 		return nil, err
 	}
-	publicKey, err := crypto.BytesToPublicKey(publicKeyData)
+	publicKey, err := crypto.BytesToSigningPublicKey(publicKeyData)
 	if err != nil {
 		return nil, err
 	}
@@ -94,6 +106,13 @@ func (o *OPStorage) GetKeyByIDAndIssuer(ctx context.Context, keyID, issuer strin
 }
 
 func (o *OPStorage) ValidateJWTProfileScopes(ctx context.Context, subject string, scopes []string) ([]string, error) {
+	app, err := o.apiApplication(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+	if app != nil {
+		return o.assertProjectScopes(ctx, app.ProjectID, scopes)
+	}
 	user, err := o.query.GetUserByID(ctx, true, subject, false)
 	if err != nil {
 		return nil, err
@@ -127,6 +146,9 @@ func (o *OPStorage) SetUserinfoFromToken(ctx context.Context, userInfo *oidc.Use
 		if err != nil {
 			return err
 		}
+		if isApplicationToken(token) {
+			return errors.ThrowPermissionDenied(nil, "OIDC-Ap4hs", "token was not issued to a user")
+		}
 		if err = o.isOriginAllowed(ctx, token.ClientID, origin); err != nil {
 			return err
 		}
@@ -188,10 +210,19 @@ func (o *OPStorage) SetIntrospectionFromToken(ctx context.Context, introspection
 		if err != nil {
 			return errors.ThrowPermissionDenied(nil, "OIDC-Adfg5", "client not found")
 		}
-		return o.introspect(ctx, introspection,
+		if isApplicationToken(token) {
+			return o.introspectApplicationToken(ctx, introspection, token, clientID, projectID)
+		}
+		err = o.introspect(ctx, introspection,
 			tokenID, token.UserID, token.ClientID, clientID, projectID,
 			token.Audience, token.Scope,
 			token.AccessTokenCreation, token.AccessTokenExpiration)
+		if err != nil {
+			return err
+		}
+		setIntrospectionACR(introspection, o.acr, domain.LevelOfAssuranceFromAuthMethods(token.AuthMethods))
+		setIntrospectionAuthorizationDetails(introspection, token.AuthorizationDetails)
+		return nil
 	}
 
 	token, err := o.repo.TokenByIDs(ctx, subject, tokenID)
@@ -208,13 +239,26 @@ func (o *OPStorage) SetIntrospectionFromToken(ctx context.Context, introspection
 			return errors.ThrowPreconditionFailed(err, "OIDC-AGefw", "Errors.Internal")
 		}
 	}
-	return o.introspect(ctx, introspection,
+	err = o.introspect(ctx, introspection,
 		token.ID, token.UserID, token.ApplicationID, clientID, projectID,
 		token.Audience, token.Scopes,
 		token.CreationDate, token.Expiration)
+	if err != nil {
+		return err
+	}
+	setIntrospectionACR(introspection, o.acr, token.LevelOfAssurance)
+	setIntrospectionAuthorizationDetails(introspection, token.AuthorizationDetails)
+	return nil
 }
 
 func (o *OPStorage) ClientCredentialsTokenRequest(ctx context.Context, clientID string, scope []string) (op.TokenRequest, error) {
+	app, err := o.apiApplication(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if app != nil {
+		return o.applicationTokenRequest(ctx, app, scope)
+	}
 	loginname, err := query.NewUserLoginNamesSearchQuery(clientID)
 	if err != nil {
 		return nil, err
@@ -236,6 +280,13 @@ func (o *OPStorage) ClientCredentialsTokenRequest(ctx context.Context, clientID
 }
 
 func (o *OPStorage) ClientCredentials(ctx context.Context, clientID, clientSecret string) (op.Client, error) {
+	app, err := o.apiApplication(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if app != nil {
+		return o.applicationClientCredentials(ctx, app, clientSecret)
+	}
 	loginname, err := query.NewUserLoginNamesSearchQuery(clientID)
 	if err != nil {
 		return nil, err
@@ -357,6 +408,10 @@ func (o *OPStorage) setUserinfo(ctx context.Context, userInfo *oidc.UserInfo, us
 			if err := o.setUserInfoResourceOwner(ctx, userInfo, userID); err != nil {
 				return err
 			}
+		case ScopeUserGroups:
+			if err := o.setUserInfoGroups(ctx, userInfo, userID); err != nil {
+				return err
+			}
 		case ScopeProjectsRoles:
 			allRoles = true
 		default:
@@ -446,6 +501,17 @@ func (o *OPStorage) setUserInfoResourceOwner(ctx context.Context, userInfo *oidc
 	return nil
 }
 
+func (o *OPStorage) setUserInfoGroups(ctx context.Context, userInfo *oidc.UserInfo, userID string) error {
+	groups, err := o.assertUserGroups(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if len(groups) > 0 {
+		userInfo.AppendClaims(ClaimUserGroups, groups)
+	}
+	return nil
+}
+
 func (o *OPStorage) setUserInfoRoleClaims(userInfo *oidc.UserInfo, roles *projectsRoles) {
 	if roles != nil && len(roles.projects) > 0 {
 		if roles, ok := roles.projects[roles.requestProjectID]; ok {
@@ -603,6 +669,14 @@ func (o *OPStorage) GetPrivateClaimsFromScopes(ctx context.Context, userID, clie
 			for claim, value := range resourceOwnerClaims {
 				claims = appendClaim(claims, claim, value)
 			}
+		case ScopeUserGroups:
+			groups, err := o.assertUserGroups(ctx, userID)
+			if err != nil {
+				return nil, err
+			}
+			if len(groups) > 0 {
+				claims = appendClaim(claims, ClaimUserGroups, groups)
+			}
 		case ScopeProjectsRoles:
 			allRoles = true
 		}
@@ -645,6 +719,10 @@ func (o *OPStorage) GetPrivateClaimsFromScopes(ctx context.Context, userID, clie
 		}
 	}
 
+	if authorizationDetails := grantedAuthorizationDetails(ctx); len(authorizationDetails) > 0 {
+		claims = appendClaim(claims, domain.AuthorizationDetailsParameter, authorizationDetails)
+	}
+
 	return o.privateClaimsFlows(ctx, userID, userGrants, claims)
 }
 
@@ -663,6 +741,13 @@ func (o *OPStorage) privateClaimsFlows(ctx context.Context, userID string, userG
 			actions.SetFields("claims", func(c *actions.FieldConfig) interface{} {
 				return c.Runtime.ToValue(claims)
 			}),
+			actions.SetFields("authorizationDetails", func(c *actions.FieldConfig) interface{} {
+				authorizationDetails := grantedAuthorizationDetails(ctx)
+				if authorizationDetails == nil {
+					authorizationDetails = domain.AuthorizationDetails{}
+				}
+				return c.Runtime.ToValue(authorizationDetails)
+			}),
 			actions.SetFields("getUser", func(c *actions.FieldConfig) interface{} {
 				return func(call goja.FunctionCall) goja.Value {
 					user, err := o.query.GetUserByID(ctx, true, userID, false)
@@ -780,7 +865,7 @@ func (o *OPStorage) assertRoles(ctx context.Context, userID, applicationID strin
 	if projectID != "" {
 		roleAudience = append(roleAudience, projectID)
 	}
-	queries := make([]query.SearchQuery, 0, 2)
+	queries := make([]query.SearchQuery, 0, 3)
 	projectQuery, err := query.NewUserGrantProjectIDsSearchQuery(roleAudience)
 	if err != nil {
 		return nil, nil, err
@@ -791,12 +876,25 @@ func (o *OPStorage) assertRoles(ctx context.Context, userID, applicationID strin
 		return nil, nil, err
 	}
 	queries = append(queries, userIDQuery)
+	validQuery, err := query.NewUserGrantValidAtQuery(time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+	queries = append(queries, validQuery)
 	grants, err := o.query.UserGrants(ctx, &query.UserGrantsQueries{
 		Queries: queries,
 	}, true, false)
 	if err != nil {
 		return nil, nil, err
 	}
+	// roles granted to the groups of the user are part of the effective roles
+	if len(roleAudience) > 0 {
+		groupGrants, err := o.query.UserGroupGrants(ctx, true, userID, roleAudience...)
+		if err != nil {
+			return nil, nil, err
+		}
+		grants.UserGrants = append(grants.UserGrants, groupGrants...)
+	}
 	roles := new(projectsRoles)
 	// if specific roles where requested, check if they are granted and append them in the roles list
 	if len(requestedRoles) > 0 {
@@ -829,6 +927,23 @@ func (o *OPStorage) assertUserMetaData(ctx context.Context, userID string) (map[
 	return userMetaData, nil
 }
 
+// assertUserGroups returns the names of the groups the user is part of
+func (o *OPStorage) assertUserGroups(ctx context.Context, userID string) ([]string, error) {
+	userIDQuery, err := query.NewGroupUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := o.query.SearchGroups(ctx, &query.GroupSearchQueries{Queries: []query.SearchQuery{userIDQuery}}, false)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(groups.Groups))
+	for i, group := range groups.Groups {
+		names[i] = group.Name
+	}
+	return names, nil
+}
+
 func (o *OPStorage) assertUserResourceOwner(ctx context.Context, userID string) (map[string]string, error) {
 	user, err := o.query.GetUserByID(ctx, true, userID, false)
 	if err != nil {
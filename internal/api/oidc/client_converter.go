@@ -101,6 +101,12 @@ func (c *Client) IDTokenLifetime() time.Duration {
 	return c.defaultIdTokenLifetime //PLANNED: impl from real client
 }
 
+// IDTokenSigningAlgorithm returns the algorithm the id_tokens of the client are signed with,
+// empty if the algorithm of the instance is used
+func (c *Client) IDTokenSigningAlgorithm() string {
+	return c.app.OIDCConfig.IDTokenSigningAlgorithm
+}
+
 func (c *Client) AccessTokenType() op.AccessTokenType {
 	return accessTokenTypeToOIDC(c.app.OIDCConfig.AccessTokenType)
 }
@@ -124,6 +130,9 @@ func (c *Client) IsScopeAllowed(scope string) bool {
 	if scope == ScopeResourceOwner {
 		return true
 	}
+	if scope == ScopeUserGroups {
+		return true
+	}
 	if scope == ScopeProjectsRoles {
 		return true
 	}
@@ -208,6 +217,8 @@ func grantTypeToOIDC(grantType domain.OIDCGrantType) oidc.GrantType {
 		return oidc.GrantTypeRefreshToken
 	case domain.OIDCGrantTypeDeviceCode:
 		return oidc.GrantTypeDeviceCode
+	case domain.OIDCGrantTypeCIBA:
+		return GrantTypeCIBA
 	default:
 		return oidc.GrantTypeCode
 	}
@@ -34,12 +34,12 @@ type clientCredentialsClient struct {
 }
 
 // AccessTokenType returns the AccessTokenType for the token to be created because of the client credentials request
-// machine users currently only have opaque tokens ([op.AccessTokenTypeBearer])
+// machine users define the type on their own, API applications currently only have opaque tokens ([op.AccessTokenTypeBearer])
 func (c *clientCredentialsClient) AccessTokenType() op.AccessTokenType {
 	return c.tokenType
 }
 
-// GetID returns the client_id (username of the machine user) for the token to be created because of the client credentials request
+// GetID returns the client_id (username of the machine user or client_id of the API application) for the token to be created because of the client credentials request
 func (c *clientCredentialsClient) GetID() string {
 	return c.id
 }
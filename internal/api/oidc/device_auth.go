@@ -128,7 +128,7 @@ func (o *OPStorage) GetDeviceAuthorizatonState(ctx context.Context, clientID, de
 		span.EndWithError(err)
 	}()
 
-	deviceAuth, err := o.query.DeviceAuthByDeviceCode(ctx, clientID, deviceCode)
+	deviceAuth, err := o.deviceAuthState(ctx, clientID, deviceCode)
 	if err != nil {
 		return nil, err
 	}
@@ -137,6 +137,22 @@ func (o *OPStorage) GetDeviceAuthorizatonState(ctx context.Context, clientID, de
 		"subject", deviceAuth.Subject, "state", deviceAuth.State,
 	).Debug("device authorization state")
 
+	// backchannel authentication requests (CIBA) can only be redeemed with the CIBA grant type
+	if deviceAuth.UserID != "" {
+		return nil, errors.ThrowNotFound(nil, "OIDC-Bc1dc", "Errors.DeviceAuth.NotFound")
+	}
+	return newDeviceAuthorizationState(deviceAuth), nil
+}
+
+// deviceAuthState returns the current state of a device authorization
+// or backchannel authentication request (CIBA).
+// Expired requests are canceled and requests which are no longer pending are removed.
+func (o *OPStorage) deviceAuthState(ctx context.Context, clientID, deviceCode string) (_ *domain.DeviceAuth, err error) {
+	deviceAuth, err := o.query.DeviceAuthByDeviceCode(ctx, clientID, deviceCode)
+	if err != nil {
+		return nil, err
+	}
+
 	// Cancel the request if it is expired, only if it wasn't Done meanwhile
 	if !deviceAuth.State.Done() && deviceAuth.Expires.Before(time.Now()) {
 		_, err = o.command.CancelDeviceAuth(ctx, deviceAuth.AggregateID, domain.DeviceAuthCanceledExpired)
@@ -154,8 +170,7 @@ func (o *OPStorage) GetDeviceAuthorizatonState(ctx context.Context, clientID, de
 			return nil, err
 		}
 	}
-
-	return newDeviceAuthorizationState(deviceAuth), nil
+	return deviceAuth, nil
 }
 
 // TODO(muhlemmer): remove the following methods with oidc v3.
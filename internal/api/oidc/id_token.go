@@ -0,0 +1,205 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	httphelper "github.com/zitadel/oidc/v2/pkg/http"
+	"github.com/zitadel/oidc/v2/pkg/oidc"
+	"github.com/zitadel/oidc/v2/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+const authCallbackPathSuffix = "/callback"
+
+// idTokenProvider handles the requests of the OpenID Provider which respond with an id_token,
+// so it can be signed with the signing algorithm of the client (see createTokenResponse):
+//   - the token request with the authorization_code and refresh_token grant types
+//   - the callback of the authorization request after the login (implicit flow)
+//
+// All other requests are handled by the next handler.
+type idTokenProvider struct {
+	*op.Provider
+	next            http.Handler
+	tokenHandler    http.Handler
+	callbackHandler http.Handler
+}
+
+func newIDTokenProvider(provider *op.Provider, interceptors []op.HttpInterceptor, next http.Handler) *idTokenProvider {
+	p := &idTokenProvider{
+		Provider: provider,
+		next:     next,
+	}
+	p.tokenHandler = intercept(provider, interceptors)(http.HandlerFunc(p.tokenExchangeHandler))
+	p.callbackHandler = intercept(provider, interceptors)(http.HandlerFunc(p.authorizeCallbackHandler))
+	return p
+}
+
+func (p *idTokenProvider) HttpHandler() http.Handler {
+	return p
+}
+
+func (p *idTokenProvider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == p.TokenEndpoint().Relative() && p.isIDTokenGrantType(r.FormValue("grant_type")):
+		p.tokenHandler.ServeHTTP(w, r)
+	case r.URL.Path == p.AuthorizationEndpoint().Relative()+authCallbackPathSuffix && r.URL.Query().Get("id") != "":
+		p.callbackHandler.ServeHTTP(w, r)
+	default:
+		p.next.ServeHTTP(w, r)
+	}
+}
+
+func (p *idTokenProvider) isIDTokenGrantType(grantType string) bool {
+	return grantType == string(oidc.GrantTypeCode) ||
+		grantType == string(oidc.GrantTypeRefreshToken) && p.GrantTypeRefreshTokenSupported()
+}
+
+func (p *idTokenProvider) tokenExchangeHandler(w http.ResponseWriter, r *http.Request) {
+	resp, err := p.tokenExchange(r)
+	if err != nil {
+		op.RequestError(w, r, err)
+		return
+	}
+	httphelper.MarshalJSON(w, resp)
+}
+
+// tokenExchange validates the token request like the oidc library does for the grant type
+// and creates the token response
+func (p *idTokenProvider) tokenExchange(r *http.Request) (_ *oidc.AccessTokenResponse, err error) {
+	ctx, span := tracing.NewSpan(r.Context())
+	defer func() { span.EndWithError(err) }()
+
+	if r.FormValue("grant_type") == string(oidc.GrantTypeRefreshToken) {
+		tokenReq, err := op.ParseRefreshTokenRequest(r, p.Decoder())
+		if err != nil {
+			return nil, err
+		}
+		request, client, err := op.ValidateRefreshTokenRequest(ctx, tokenReq, p)
+		if err != nil {
+			return nil, err
+		}
+		return createTokenResponse(ctx, request, client, p, true, "", tokenReq.RefreshToken)
+	}
+	tokenReq, err := op.ParseAccessTokenRequest(r, p.Decoder())
+	if err != nil {
+		return nil, err
+	}
+	if tokenReq.Code == "" {
+		return nil, oidc.ErrInvalidRequest().WithDescription("code missing")
+	}
+	authReq, client, err := op.ValidateAccessTokenRequest(ctx, tokenReq, p)
+	if err != nil {
+		return nil, err
+	}
+	return createTokenResponse(ctx, authReq, client, p, true, tokenReq.Code, "")
+}
+
+// authorizeCallbackHandler handles the callback after the login like the oidc library does,
+// but creates the token response of the implicit flow itself
+// and returns access_denied if the user denied the consent
+func (p *idTokenProvider) authorizeCallbackHandler(w http.ResponseWriter, r *http.Request) {
+	authReq, err := p.Storage().AuthRequestByID(r.Context(), r.URL.Query().Get("id"))
+	if err != nil {
+		op.AuthRequestError(w, r, nil, err, p.Encoder())
+		return
+	}
+	if req, ok := authReq.(*AuthRequest); ok && req.ConsentDenied {
+		op.AuthRequestError(w, r, authReq, oidc.ErrAccessDenied().WithDescription("The user denied the consent."), p.Encoder())
+		return
+	}
+	if !authReq.Done() {
+		op.AuthRequestError(w, r, authReq,
+			oidc.ErrInteractionRequired().WithDescription("Unfortunately, the user may be not logged in and/or additional interaction is required."),
+			p.Encoder())
+		return
+	}
+	if authReq.GetResponseType() == oidc.ResponseTypeCode {
+		op.AuthResponseCode(w, r, authReq, p)
+		return
+	}
+	callback, err := CreateTokenCallbackURL(r.Context(), authReq, p)
+	if err != nil {
+		op.AuthRequestError(w, r, authReq, err, p.Encoder())
+		return
+	}
+	http.Redirect(w, r, callback, http.StatusFound)
+}
+
+// createTokenResponse creates the token response like op.CreateTokenResponse does,
+// but signs the id_token with the signing algorithm of the client (if set).
+// The oidc library uses the same signing key for all tokens,
+// where access tokens must always be signed with the algorithm of the instance.
+func createTokenResponse(ctx context.Context, request op.IDTokenRequest, client op.Client, creator op.TokenCreator, createAccessToken bool, code, refreshToken string) (*oidc.AccessTokenResponse, error) {
+	var accessToken, newRefreshToken string
+	var validity time.Duration
+	if createAccessToken {
+		var err error
+		accessToken, newRefreshToken, validity, err = op.CreateAccessToken(ctx, request, client.AccessTokenType(), creator, client, refreshToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+	idToken, err := op.CreateIDToken(ctx, op.IssuerFromContext(ctx), request, client.IDTokenLifetime(), accessToken, code, idTokenSigningStorage(creator.Storage(), client), client)
+	if err != nil {
+		return nil, err
+	}
+
+	var state string
+	if authRequest, ok := request.(op.AuthRequest); ok {
+		err = creator.Storage().DeleteAuthRequest(ctx, authRequest.GetID())
+		if err != nil {
+			return nil, err
+		}
+		state = authRequest.GetState()
+	}
+
+	return &oidc.AccessTokenResponse{
+		AccessToken:  accessToken,
+		IDToken:      idToken,
+		RefreshToken: newRefreshToken,
+		TokenType:    oidc.BearerToken,
+		ExpiresIn:    uint64(validity.Seconds()),
+		State:        state,
+	}, nil
+}
+
+// idTokenSigningStorage returns the storage to create the id_token of the client with
+func idTokenSigningStorage(storage op.Storage, client op.Client) op.Storage {
+	opStorage, ok := storage.(*OPStorage)
+	if !ok {
+		return storage
+	}
+	zitadelClient, ok := client.(*Client)
+	if !ok || zitadelClient.IDTokenSigningAlgorithm() == "" {
+		return storage
+	}
+	return &idTokenStorage{
+		OPStorage: opStorage,
+		algorithm: zitadelClient.IDTokenSigningAlgorithm(),
+	}
+}
+
+// idTokenStorage signs id_tokens with the signing algorithm of the client
+type idTokenStorage struct {
+	*OPStorage
+	algorithm string
+}
+
+// SigningKey implements the op.Storage interface
+func (s *idTokenStorage) SigningKey(ctx context.Context) (op.SigningKey, error) {
+	return s.signingKeyOfAlgorithm(ctx, s.algorithm)
+}
+
+// intercept returns a function, which wraps handlers with the same interceptors
+// as for the endpoints of the OpenID Provider (e.g. for the instance)
+func intercept(provider *op.Provider, interceptors []op.HttpInterceptor) func(http.Handler) http.Handler {
+	return func(handler http.Handler) http.Handler {
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			handler = interceptors[i](handler)
+		}
+		return op.NewIssuerInterceptor(provider.IssuerFromRequest).Handler(handler)
+	}
+}
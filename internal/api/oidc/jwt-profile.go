@@ -11,6 +11,14 @@ import (
 )
 
 func (o *OPStorage) JWTProfileTokenType(ctx context.Context, request op.TokenRequest) (op.AccessTokenType, error) {
+	app, err := o.apiApplication(ctx, request.GetSubject())
+	if err != nil {
+		return 0, err
+	}
+	// API applications only receive opaque tokens, so the scopes can be validated during introspection
+	if app != nil {
+		return op.AccessTokenTypeBearer, nil
+	}
 	mapJWTProfileScopesToAudience(ctx, request)
 	user, err := o.query.GetUserByID(ctx, false, request.GetSubject(), false)
 	if err != nil {
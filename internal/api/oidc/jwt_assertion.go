@@ -0,0 +1,161 @@
+package oidc
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/zitadel/oidc/v2/pkg/oidc"
+	"github.com/zitadel/oidc/v2/pkg/op"
+	"gopkg.in/square/go-jose.v2"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+const (
+	assertionSignerKeyID  = "zitadel-assertion"
+	assertionSignerKeyLen = 2048
+)
+
+// jwtAssertionInterceptor verifies the JWT assertions of the request
+// (client_assertion of the private_key_jwt client authentication and assertion of the JWT profile grant),
+// which are signed with another algorithm than RS256.
+//
+// The oidc library only accepts assertions signed with RS256, so these assertions are verified
+// with the algorithm of the client's key and passed on signed by the assertionSigner.
+func (o *OPStorage) jwtAssertionInterceptor(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.ParseForm() != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if r.PostForm.Get("client_assertion_type") == oidc.ClientAssertionTypeJWTAssertion {
+			if err := o.replaceJWTAssertion(r, "client_assertion"); err != nil {
+				op.RequestError(w, r, oidc.ErrInvalidClient().WithParent(err))
+				return
+			}
+		}
+		if r.PostForm.Get("grant_type") == string(oidc.GrantTypeBearer) {
+			if err := o.replaceJWTAssertion(r, "assertion"); err != nil {
+				op.RequestError(w, r, oidc.ErrInvalidGrant().WithParent(err))
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (o *OPStorage) replaceJWTAssertion(r *http.Request, param string) error {
+	assertion, err := o.verifyJWTAssertion(r.Context(), r.PostForm.Get(param))
+	if err != nil {
+		return err
+	}
+	r.PostForm.Set(param, assertion)
+	r.Form.Set(param, assertion)
+	return nil
+}
+
+// verifyJWTAssertion returns the assertion unchanged if it's signed with RS256 (or invalid),
+// so it's verified by the oidc library itself.
+// Otherwise it's verified with the public key of the issuer and returned signed by the assertionSigner.
+// All other checks (audience, expiration, ...) are still done by the oidc library.
+func (o *OPStorage) verifyJWTAssertion(ctx context.Context, assertion string) (string, error) {
+	jws, err := jose.ParseSigned(assertion)
+	if err != nil || len(jws.Signatures) != 1 {
+		return assertion, nil
+	}
+	keyID, algorithm := oidc.GetKeyIDAndAlg(jws)
+	if algorithm == string(jose.RS256) {
+		return assertion, nil
+	}
+	request := new(oidc.JWTTokenRequest)
+	payload, err := oidc.ParseToken(assertion, request)
+	if err != nil {
+		return "", err
+	}
+	key, err := o.GetKeyByIDAndIssuer(ctx, keyID, request.Issuer)
+	if err != nil {
+		return "", err
+	}
+	if err = verifyJWTAssertionSignature(jws, payload, key.Key); err != nil {
+		return "", err
+	}
+	return o.assertionSigner.sign(payload)
+}
+
+// verifyJWTAssertionSignature verifies the signature of the assertion,
+// if its algorithm can be used with the public key
+func verifyJWTAssertionSignature(jws *jose.JSONWebSignature, payload []byte, publicKey interface{}) error {
+	algorithm := jws.Signatures[0].Header.Algorithm
+	if !containsAlgorithm(crypto.VerificationAlgorithms(publicKey), algorithm) {
+		return errors.ThrowInvalidArgumentf(nil, "OIDC-Wr4ns", "assertion signed with unsupported algorithm %s", algorithm)
+	}
+	signedPayload, err := jws.Verify(publicKey)
+	if err != nil {
+		return errors.ThrowInvalidArgument(err, "OIDC-Ksu3n", "invalid assertion signature")
+	}
+	if !bytes.Equal(signedPayload, payload) {
+		return errors.ThrowInvalidArgument(nil, "OIDC-Ao3md", "invalid assertion payload")
+	}
+	return nil
+}
+
+func containsAlgorithm(algorithms []string, algorithm string) bool {
+	for _, a := range algorithms {
+		if a == algorithm {
+			return true
+		}
+	}
+	return false
+}
+
+// assertionSigner signs the payloads of verified assertions with an RS256 key,
+// which is generated on first use and only known to the running process
+type assertionSigner struct {
+	once      sync.Once
+	signer    jose.Signer
+	publicKey *jose.JSONWebKey
+	err       error
+}
+
+func (s *assertionSigner) init() {
+	s.once.Do(func() {
+		privateKey, publicKey, err := crypto.GenerateKeyPair(assertionSignerKeyLen)
+		if err != nil {
+			s.err = err
+			return
+		}
+		s.signer, s.err = jose.NewSigner(
+			jose.SigningKey{Algorithm: jose.RS256, Key: jose.JSONWebKey{Key: privateKey, KeyID: assertionSignerKeyID}},
+			(&jose.SignerOptions{}).WithType("JWT"),
+		)
+		s.publicKey = &jose.JSONWebKey{KeyID: assertionSignerKeyID, Use: "sig", Key: publicKey}
+	})
+}
+
+func (s *assertionSigner) sign(payload []byte) (string, error) {
+	s.init()
+	if s.err != nil {
+		return "", s.err
+	}
+	signed, err := s.signer.Sign(payload)
+	if err != nil {
+		return "", err
+	}
+	return signed.CompactSerialize()
+}
+
+// key returns the public key to verify the signed assertions with,
+// nil if the key id is not the one of the signer
+func (s *assertionSigner) key(keyID string) *jose.JSONWebKey {
+	if keyID != assertionSignerKeyID {
+		return nil
+	}
+	s.init()
+	if s.err != nil {
+		return nil
+	}
+	return s.publicKey
+}
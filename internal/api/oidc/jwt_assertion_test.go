@@ -0,0 +1,103 @@
+package oidc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/square/go-jose.v2"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+)
+
+func Test_verifyJWTAssertionSignature(t *testing.T) {
+	rsaPrivateKey, rsaPublicKey, err := crypto.GenerateSigningKeyPair(crypto.SigningAlgorithmRS256, 2048)
+	require.NoError(t, err)
+	ecPrivateKey, ecPublicKey, err := crypto.GenerateSigningKeyPair(crypto.SigningAlgorithmES256, 0)
+	require.NoError(t, err)
+	edPrivateKey, edPublicKey, err := crypto.GenerateSigningKeyPair(crypto.SigningAlgorithmEdDSA, 0)
+	require.NoError(t, err)
+	_, otherECPublicKey, err := crypto.GenerateSigningKeyPair(crypto.SigningAlgorithmES256, 0)
+	require.NoError(t, err)
+
+	payload := []byte(`{"iss":"client","sub":"client","aud":"issuer"}`)
+	tests := []struct {
+		name       string
+		algorithm  jose.SignatureAlgorithm
+		privateKey interface{}
+		publicKey  interface{}
+		wantErr    bool
+	}{
+		{
+			name:       "ES256",
+			algorithm:  jose.ES256,
+			privateKey: ecPrivateKey,
+			publicKey:  ecPublicKey,
+		},
+		{
+			name:       "PS256",
+			algorithm:  jose.PS256,
+			privateKey: rsaPrivateKey,
+			publicKey:  rsaPublicKey,
+		},
+		{
+			name:       "EdDSA",
+			algorithm:  jose.EdDSA,
+			privateKey: edPrivateKey,
+			publicKey:  edPublicKey,
+		},
+		{
+			name:       "other key",
+			algorithm:  jose.ES256,
+			privateKey: ecPrivateKey,
+			publicKey:  otherECPublicKey,
+			wantErr:    true,
+		},
+		{
+			name:       "algorithm not of key",
+			algorithm:  jose.ES256,
+			privateKey: ecPrivateKey,
+			publicKey:  rsaPublicKey,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signer, err := jose.NewSigner(jose.SigningKey{Algorithm: tt.algorithm, Key: tt.privateKey}, nil)
+			require.NoError(t, err)
+			signed, err := signer.Sign(payload)
+			require.NoError(t, err)
+			assertion, err := signed.CompactSerialize()
+			require.NoError(t, err)
+			jws, err := jose.ParseSigned(assertion)
+			require.NoError(t, err)
+
+			err = verifyJWTAssertionSignature(jws, payload, tt.publicKey)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_assertionSigner(t *testing.T) {
+	signer := new(assertionSigner)
+	payload := []byte(`{"iss":"client","sub":"client","aud":"issuer"}`)
+
+	assertion, err := signer.sign(payload)
+	require.NoError(t, err)
+	jws, err := jose.ParseSigned(assertion)
+	require.NoError(t, err)
+	require.Len(t, jws.Signatures, 1)
+	assert.Equal(t, string(jose.RS256), jws.Signatures[0].Header.Algorithm)
+
+	key := signer.key(jws.Signatures[0].Header.KeyID)
+	require.NotNil(t, key)
+	signedPayload, err := jws.Verify(key)
+	require.NoError(t, err)
+	assert.Equal(t, payload, signedPayload)
+
+	assert.Nil(t, signer.key("123456789"))
+}
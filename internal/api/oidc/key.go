@@ -8,9 +8,12 @@ import (
 	"github.com/zitadel/logging"
 	"github.com/zitadel/oidc/v2/pkg/op"
 	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/cryptosigner"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/crypto/pkcs11"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/errors"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/query"
@@ -70,7 +73,30 @@ func (s *PublicKey) ID() string {
 	return s.key.ID()
 }
 
+// hsmPublicKey wraps the public key of the pkcs11.SigningKey to implement the op.Key interface
+type hsmPublicKey struct {
+	key *pkcs11.SigningKey
+}
+
+func (s *hsmPublicKey) Algorithm() jose.SignatureAlgorithm {
+	return jose.SignatureAlgorithm(s.key.Algorithm())
+}
+
+func (s *hsmPublicKey) Use() string {
+	return domain.KeyUsageSigning.String()
+}
+
+func (s *hsmPublicKey) Key() interface{} {
+	return s.key.PublicKey()
+}
+
+func (s *hsmPublicKey) ID() string {
+	return s.key.ID()
+}
+
 // KeySet implements the op.Storage interface
+// the public key of the HSM's signing key (if configured) is published in addition to the keys of the database,
+// so tokens signed before it was configured can still be verified
 func (o *OPStorage) KeySet(ctx context.Context) (keys []op.Key, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -79,29 +105,56 @@ func (o *OPStorage) KeySet(ctx context.Context) (keys []op.Key, err error) {
 		if err != nil {
 			return err
 		}
-		keys = make([]op.Key, len(publicKeys.Keys))
+		keys = make([]op.Key, len(publicKeys.Keys), len(publicKeys.Keys)+1)
 		for i, key := range publicKeys.Keys {
 			keys[i] = &PublicKey{key}
 		}
 		return nil
 	})
+	if err == nil && o.hsmSigningKey != nil {
+		keys = append(keys, &hsmPublicKey{o.hsmSigningKey})
+	}
 	return keys, err
 }
 
 // SignatureAlgorithms implements the op.Storage interface
-func (o *OPStorage) SignatureAlgorithms(ctx context.Context) ([]jose.SignatureAlgorithm, error) {
-	key, err := o.SigningKey(ctx)
+// keys are generated on demand, so all supported algorithms can be used to sign tokens,
+// except if the signing key is stored in the HSM, where only its algorithm can be used
+func (o *OPStorage) SignatureAlgorithms(context.Context) ([]jose.SignatureAlgorithm, error) {
+	if o.hsmSigningKey != nil {
+		return []jose.SignatureAlgorithm{jose.SignatureAlgorithm(o.hsmSigningKey.Algorithm())}, nil
+	}
+	supported := crypto.SigningAlgorithms()
+	algorithms := make([]jose.SignatureAlgorithm, len(supported))
+	for i, algorithm := range supported {
+		algorithms[i] = jose.SignatureAlgorithm(algorithm)
+	}
+	return algorithms, nil
+}
+
+// SigningKey implements the op.Storage interface
+// it returns the key of the instance's signing algorithm, which is used for all tokens
+// except the id_tokens of clients with their own signing algorithm (see idTokenStorage)
+func (o *OPStorage) SigningKey(ctx context.Context) (op.SigningKey, error) {
+	algorithm, err := o.signingAlgorithm(ctx)
 	if err != nil {
-		logging.WithError(err).Warn("unable to fetch signing key")
 		return nil, err
 	}
-	return []jose.SignatureAlgorithm{key.SignatureAlgorithm()}, nil
+	return o.signingKeyOfAlgorithm(ctx, algorithm)
 }
 
-// SigningKey implements the op.Storage interface
-func (o *OPStorage) SigningKey(ctx context.Context) (key op.SigningKey, err error) {
+// signingKeyOfAlgorithm returns the signing key of the HSM regardless of the algorithm if it's configured,
+// the settings of the instance and the clients can't use any other algorithm then
+func (o *OPStorage) signingKeyOfAlgorithm(ctx context.Context, algorithm string) (key op.SigningKey, err error) {
+	if o.hsmSigningKey != nil {
+		return &SigningKey{
+			algorithm: jose.SignatureAlgorithm(o.hsmSigningKey.Algorithm()),
+			id:        o.hsmSigningKey.ID(),
+			key:       cryptosigner.Opaque(o.hsmSigningKey.Signer()),
+		}, nil
+	}
 	err = retry(func() error {
-		key, err = o.getSigningKey(ctx)
+		key, err = o.getSigningKey(ctx, algorithm)
 		if err != nil {
 			return err
 		}
@@ -113,19 +166,31 @@ func (o *OPStorage) SigningKey(ctx context.Context) (key op.SigningKey, err erro
 	return key, err
 }
 
-func (o *OPStorage) getSigningKey(ctx context.Context) (op.SigningKey, error) {
+func (o *OPStorage) getSigningKey(ctx context.Context, algorithm string) (op.SigningKey, error) {
 	keys, err := o.query.ActivePrivateSigningKey(ctx, time.Now().Add(gracefulPeriod))
 	if err != nil {
 		return nil, err
 	}
-	if len(keys.Keys) > 0 {
-		return o.privateKeyToSigningKey(selectSigningKey(keys.Keys))
+	if key := selectSigningKey(keys.Keys, algorithm); key != nil {
+		return o.privateKeyToSigningKey(key)
 	}
 	var sequence uint64
 	if keys.LatestSequence != nil {
 		sequence = keys.LatestSequence.Sequence
 	}
-	return nil, o.refreshSigningKey(ctx, o.signingKeyAlgorithm, sequence)
+	return nil, o.refreshSigningKey(ctx, algorithm, sequence)
+}
+
+// signingAlgorithm returns the algorithm of the instance's oidc settings or the configured default
+func (o *OPStorage) signingAlgorithm(ctx context.Context) (string, error) {
+	oidcSettings, err := o.query.OIDCSettingsByAggID(ctx, authz.GetInstance(ctx).InstanceID())
+	if err != nil && !errors.IsNotFound(err) {
+		return "", err
+	}
+	if oidcSettings != nil && oidcSettings.SigningAlgorithm != "" {
+		return oidcSettings.SigningAlgorithm, nil
+	}
+	return o.signingKeyAlgorithm, nil
 }
 
 func (o *OPStorage) refreshSigningKey(ctx context.Context, algorithm string, sequence uint64) error {
@@ -153,7 +218,7 @@ func (o *OPStorage) privateKeyToSigningKey(key query.PrivateKey) (_ op.SigningKe
 	if err != nil {
 		return nil, err
 	}
-	privateKey, err := crypto.BytesToPrivateKey(keyData)
+	privateKey, err := crypto.BytesToSigningPrivateKey(keyData)
 	if err != nil {
 		return nil, err
 	}
@@ -194,8 +259,14 @@ func (o *OPStorage) getMaxKeySequence(ctx context.Context) (uint64, error) {
 	)
 }
 
-func selectSigningKey(keys []query.PrivateKey) query.PrivateKey {
-	return keys[len(keys)-1]
+// selectSigningKey returns the key of the requested algorithm with the latest expiry
+func selectSigningKey(keys []query.PrivateKey, algorithm string) query.PrivateKey {
+	for i := len(keys) - 1; i >= 0; i-- {
+		if keys[i].Algorithm() == algorithm {
+			return keys[i]
+		}
+	}
+	return nil
 }
 
 func setOIDCCtx(ctx context.Context) context.Context {
@@ -17,6 +17,7 @@ import (
 	"github.com/zitadel/zitadel/internal/auth/repository"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/crypto/pkcs11"
 	"github.com/zitadel/zitadel/internal/database"
 	caos_errs "github.com/zitadel/zitadel/internal/errors"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -41,19 +42,22 @@ type Config struct {
 	Cache                             *middleware.CacheConfig
 	CustomEndpoints                   *EndpointConfig
 	DeviceAuth                        *DeviceAuthorizationConfig
+	BackchannelAuth                   *BackchannelAuthConfig
 	DefaultLoginURLV2                 string
 	DefaultLogoutURLV2                string
+	ACRValues                         []ACRValueConfig
 }
 
 type EndpointConfig struct {
-	Auth          *Endpoint
-	Token         *Endpoint
-	Introspection *Endpoint
-	Userinfo      *Endpoint
-	Revocation    *Endpoint
-	EndSession    *Endpoint
-	Keys          *Endpoint
-	DeviceAuth    *Endpoint
+	Auth            *Endpoint
+	Token           *Endpoint
+	Introspection   *Endpoint
+	Userinfo        *Endpoint
+	Revocation      *Endpoint
+	EndSession      *Endpoint
+	Keys            *Endpoint
+	DeviceAuth      *Endpoint
+	BackchannelAuth *Endpoint
 }
 
 type Endpoint struct {
@@ -77,15 +81,23 @@ type OPStorage struct {
 	encAlg                            crypto.EncryptionAlgorithm
 	locker                            crdb.Locker
 	assetAPIPrefix                    func(ctx context.Context) string
+	acr                               ACRMapping
+	assertionSigner                   *assertionSigner
+	hsmSigningKey                     *pkcs11.SigningKey
 }
 
-func NewProvider(config Config, defaultLogoutRedirectURI string, externalSecure bool, command *command.Commands, query *query.Queries, repo repository.Repository, encryptionAlg crypto.EncryptionAlgorithm, cryptoKey []byte, es *eventstore.Eventstore, projections *database.DB, userAgentCookie, instanceHandler, accessHandler func(http.Handler) http.Handler) (op.OpenIDProvider, error) {
+func NewProvider(config Config, defaultLogoutRedirectURI string, externalSecure bool, command *command.Commands, query *query.Queries, repo repository.Repository, encryptionAlg crypto.EncryptionAlgorithm, cryptoKey []byte, hsmSigningKey *pkcs11.SigningKey, es *eventstore.Eventstore, projections *database.DB, userAgentCookie, instanceHandler, accessHandler func(http.Handler) http.Handler) (op.OpenIDProvider, error) {
 	opConfig, err := createOPConfig(config, defaultLogoutRedirectURI, cryptoKey)
 	if err != nil {
 		return nil, caos_errs.ThrowInternal(err, "OIDC-EGrqd", "cannot create op config: %w")
 	}
-	storage := newStorage(config, command, query, repo, encryptionAlg, es, projections, externalSecure)
-	options, err := createOptions(config, externalSecure, userAgentCookie, instanceHandler, accessHandler)
+	acr, err := NewACRMapping(config.ACRValues)
+	if err != nil {
+		return nil, caos_errs.ThrowInternal(err, "OIDC-Xe3gk", "cannot create acr mapping")
+	}
+	storage := newStorage(config, command, query, repo, encryptionAlg, hsmSigningKey, es, projections, externalSecure, acr)
+	interceptors := httpInterceptors(userAgentCookie, instanceHandler, accessHandler, storage.jwtAssertionInterceptor)
+	options, err := createOptions(config, externalSecure, interceptors)
 	if err != nil {
 		return nil, caos_errs.ThrowInternal(err, "OIDC-D3gq1", "cannot create options: %w")
 	}
@@ -98,7 +110,7 @@ func NewProvider(config Config, defaultLogoutRedirectURI string, externalSecure
 	if err != nil {
 		return nil, caos_errs.ThrowInternal(err, "OIDC-DAtg3", "cannot create provider")
 	}
-	return provider, nil
+	return newIDTokenProvider(provider, interceptors, newBackchannelProvider(provider, storage, config, interceptors)), nil
 }
 
 func createOPConfig(config Config, defaultLogoutRedirectURI string, cryptoKey []byte) (*op.Config, error) {
@@ -123,18 +135,26 @@ func createOPConfig(config Config, defaultLogoutRedirectURI string, cryptoKey []
 	return opConfig, nil
 }
 
-func createOptions(config Config, externalSecure bool, userAgentCookie, instanceHandler, accessHandler func(http.Handler) http.Handler) ([]op.Option, error) {
+func httpInterceptors(userAgentCookie, instanceHandler, accessHandler, jwtAssertionHandler func(http.Handler) http.Handler) []op.HttpInterceptor {
 	metricTypes := []metrics.MetricType{metrics.MetricTypeRequestCount, metrics.MetricTypeStatusCode, metrics.MetricTypeTotalCount}
+	return []op.HttpInterceptor{
+		middleware.MetricsHandler(metricTypes),
+		middleware.TelemetryHandler(),
+		middleware.NoCacheInterceptor().Handler,
+		instanceHandler,
+		userAgentCookie,
+		http_utils.CopyHeadersToContext,
+		accessHandler,
+		jwtAssertionHandler,
+		authorizationDetailsInterceptor,
+	}
+}
+
+func createOptions(config Config, externalSecure bool, interceptors []op.HttpInterceptor) ([]op.Option, error) {
 	options := []op.Option{
-		op.WithHttpInterceptors(
-			middleware.MetricsHandler(metricTypes),
-			middleware.TelemetryHandler(),
-			middleware.NoCacheInterceptor().Handler,
-			instanceHandler,
-			userAgentCookie,
-			http_utils.CopyHeadersToContext,
-			accessHandler,
-		),
+		op.WithHttpInterceptors(interceptors...),
+		op.WithAccessTokenVerifierOpts(op.WithSupportedAccessTokenSigningAlgorithms(crypto.SigningAlgorithms()...)),
+		op.WithIDTokenHintVerifierOpts(op.WithSupportedIDTokenHintSigningAlgorithms(crypto.SigningAlgorithms()...)),
 	}
 	if !externalSecure {
 		options = append(options, op.WithAllowInsecure())
@@ -178,7 +198,7 @@ func customEndpoints(endpointConfig *EndpointConfig) []op.Option {
 	return options
 }
 
-func newStorage(config Config, command *command.Commands, query *query.Queries, repo repository.Repository, encAlg crypto.EncryptionAlgorithm, es *eventstore.Eventstore, db *database.DB, externalSecure bool) *OPStorage {
+func newStorage(config Config, command *command.Commands, query *query.Queries, repo repository.Repository, encAlg crypto.EncryptionAlgorithm, hsmSigningKey *pkcs11.SigningKey, es *eventstore.Eventstore, db *database.DB, externalSecure bool, acr ACRMapping) *OPStorage {
 	return &OPStorage{
 		repo:                              repo,
 		command:                           command,
@@ -195,6 +215,9 @@ func newStorage(config Config, command *command.Commands, query *query.Queries,
 		encAlg:                            encAlg,
 		locker:                            crdb.NewLocker(db.DB, locksTable, signingKey),
 		assetAPIPrefix:                    assets.AssetAPI(externalSecure),
+		acr:                               acr,
+		assertionSigner:                   new(assertionSigner),
+		hsmSigningKey:                     hsmSigningKey,
 	}
 }
 
@@ -183,6 +183,24 @@ func (p *Storage) certificateToCertificateAndKey(certificate query.Certificate)
 	}, nil
 }
 
+// publicCertificates returns the (DER encoded) certificates of the usage, which must be published in the metadata:
+// the active ones used for signing and the ones only published for a staged rotation
+func (p *Storage) publicCertificates(ctx context.Context, usage domain.KeyUsage) ([][]byte, error) {
+	certs, err := p.query.PublicCertificates(ctx, time.Now(), usage)
+	if err != nil {
+		return nil, err
+	}
+	certificates := make([][]byte, 0, len(certs.Certificates))
+	for _, certificate := range certs.Certificates {
+		cert, err := crypto.BytesToCertificate(certificate.Certificate())
+		if err != nil {
+			return nil, err
+		}
+		certificates = append(certificates, cert)
+	}
+	return certificates, nil
+}
+
 func selectCertificate(certs []query.Certificate) query.Certificate {
 	return certs[len(certs)-1]
 }
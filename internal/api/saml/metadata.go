@@ -0,0 +1,148 @@
+package saml
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+
+	"github.com/zitadel/logging"
+	"github.com/zitadel/saml/pkg/provider"
+	"github.com/zitadel/saml/pkg/provider/signature"
+	saml_xml "github.com/zitadel/saml/pkg/provider/xml"
+	"github.com/zitadel/saml/pkg/provider/xml/md"
+	"github.com/zitadel/saml/pkg/provider/xml/xml_dsig"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+// Provider extends the SAML provider, so the metadata contains all published response signing certificates.
+// The saml library only publishes the certificate currently used for signing,
+// which would break a staged rotation (publish first, sign later).
+//
+// All other requests are handled by the SAML provider itself.
+type Provider struct {
+	*provider.Provider
+	storage            *Storage
+	signatureAlgorithm string
+	metadataEndpoint   provider.Endpoint
+	metadataHandler    http.Handler
+}
+
+func newProvider(samlProvider *provider.Provider, storage *Storage, conf *provider.Config, interceptors []provider.HttpInterceptor) *Provider {
+	p := &Provider{
+		Provider:         samlProvider,
+		storage:          storage,
+		metadataEndpoint: provider.NewEndpoint(provider.DefaultMetadataEndpoint),
+	}
+	if conf.Metadata != nil {
+		p.metadataEndpoint = *conf.Metadata
+	}
+	if conf.MetadataConfig != nil {
+		p.signatureAlgorithm = conf.MetadataConfig.SignatureAlgorithm
+	}
+	var handler http.Handler = http.HandlerFunc(p.metadataHandle)
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		handler = interceptors[i](handler)
+	}
+	p.metadataHandler = provider.NewIssuerInterceptor(samlProvider.IssuerFromRequest).Handler(handler)
+	return p
+}
+
+func (p *Provider) HttpHandler() http.Handler {
+	return p
+}
+
+func (p *Provider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == p.metadataEndpoint.Relative() {
+		p.metadataHandler.ServeHTTP(w, r)
+		return
+	}
+	p.Provider.HttpHandler().ServeHTTP(w, r)
+}
+
+func (p *Provider) metadataHandle(w http.ResponseWriter, r *http.Request) {
+	metadata, err := p.metadata(r.Context())
+	if err != nil {
+		logging.WithError(err).Error("error while getting metadata")
+		http.Error(w, "error while getting metadata", http.StatusInternalServerError)
+		return
+	}
+	if err = saml_xml.WriteXMLMarshalled(w, metadata); err != nil {
+		http.Error(w, "failed to respond with metadata", http.StatusInternalServerError)
+	}
+}
+
+// metadata returns the metadata of the SAML provider extended by the published response signing certificates.
+// If the metadata is extended, it's signed again (if configured).
+func (p *Provider) metadata(ctx context.Context) (*md.EntityDescriptorType, error) {
+	metadata, err := p.GetMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	certificates, err := p.storage.publicCertificates(ctx, domain.KeyUsageSAMLResponseSinging)
+	if err != nil {
+		return nil, err
+	}
+	var extended bool
+	entityID := string(metadata.EntityID)
+	if metadata.IDPSSODescriptor != nil {
+		metadata.IDPSSODescriptor.KeyDescriptor, extended = appendSigningKeyDescriptors(metadata.IDPSSODescriptor.KeyDescriptor, entityID, certificates)
+	}
+	if metadata.AttributeAuthorityDescriptor != nil {
+		metadata.AttributeAuthorityDescriptor.KeyDescriptor, _ = appendSigningKeyDescriptors(metadata.AttributeAuthorityDescriptor.KeyDescriptor, entityID, certificates)
+	}
+	if !extended || metadata.Signature == nil {
+		return metadata, nil
+	}
+	metadata.Signature = nil
+	certAndKey, err := p.storage.GetMetadataSigningKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := signature.GetSigner(certAndKey.Certificate, certAndKey.Key, p.signatureAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+	metadata.Signature, err = signature.Create(signer, metadata)
+	if err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+// appendSigningKeyDescriptors returns a copy of the key descriptors
+// extended by a signing key descriptor for every certificate not yet contained
+func appendSigningKeyDescriptors(descriptors []md.KeyDescriptorType, entityID string, certificates [][]byte) ([]md.KeyDescriptorType, bool) {
+	extended := make([]md.KeyDescriptorType, len(descriptors), len(descriptors)+len(certificates))
+	copy(extended, descriptors)
+	for _, certificate := range certificates {
+		encoded := base64.StdEncoding.EncodeToString(certificate)
+		if containsSigningCertificate(extended, encoded) {
+			continue
+		}
+		extended = append(extended, md.KeyDescriptorType{
+			Use: md.KeyTypesSigning,
+			KeyInfo: xml_dsig.KeyInfoType{
+				KeyName: []string{entityID + " IDP " + string(md.KeyTypesSigning)},
+				X509Data: []xml_dsig.X509DataType{{
+					X509Certificate: encoded,
+				}},
+			},
+		})
+	}
+	return extended, len(extended) > len(descriptors)
+}
+
+func containsSigningCertificate(descriptors []md.KeyDescriptorType, certificate string) bool {
+	for _, descriptor := range descriptors {
+		if descriptor.Use != md.KeyTypesSigning {
+			continue
+		}
+		for _, data := range descriptor.KeyInfo.X509Data {
+			if data.X509Certificate == certificate {
+				return true
+			}
+		}
+	}
+	return false
+}
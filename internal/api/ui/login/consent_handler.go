@@ -0,0 +1,199 @@
+package login
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	http_mw "github.com/zitadel/zitadel/internal/api/http/middleware"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+const (
+	tmplConsent = "consent"
+)
+
+type consentFormData struct {
+	Deny bool `schema:"deny"`
+}
+
+type consentData struct {
+	userData
+	ApplicationName      string
+	Scopes               []*consentScope
+	AuthorizationDetails []*consentAuthorizationDetail
+}
+
+type consentScope struct {
+	Key         string
+	DisplayName string
+	Description string
+}
+
+type consentAuthorizationDetail struct {
+	Type        string
+	DisplayName string
+	Description string
+	Fields      []*consentAuthorizationDetailField
+}
+
+type consentAuthorizationDetailField struct {
+	Key   string
+	Value string
+}
+
+func (l *Login) handleConsentCheck(w http.ResponseWriter, r *http.Request) {
+	data := new(consentFormData)
+	authReq, err := l.getAuthRequestAndParseData(r, data)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
+	if data.Deny {
+		l.denyConsent(w, r, authReq, userAgentID)
+		return
+	}
+	err = l.authRepo.GrantConsent(setContext(r.Context(), authReq.UserOrgID), authReq.ID, authReq.UserID, userAgentID)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	l.renderNextStep(w, r, authReq)
+}
+
+// denyConsent records the denial on the auth request, so the client receives an access_denied error on the callback.
+// Device authorization and backchannel authentication (CIBA) requests are canceled instead.
+func (l *Login) denyConsent(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, userAgentID string) {
+	if authDev, ok := authReq.Request.(*domain.AuthRequestDevice); ok {
+		if _, err := l.command.CancelDeviceAuth(r.Context(), authDev.ID, domain.DeviceAuthCanceledDenied); err != nil {
+			l.renderError(w, r, authReq, err)
+			return
+		}
+		l.renderDeviceAuthDone(w, r, authReq, deviceAuthDenied)
+		return
+	}
+	err := l.authRepo.DenyConsent(setContext(r.Context(), authReq.UserOrgID), authReq.ID, authReq.UserID, userAgentID)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	l.redirectToCallback(w, r, authReq)
+}
+
+func (l *Login) renderConsent(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, step *domain.ConsentStep, err error) {
+	var errID, errMessage string
+	if err != nil {
+		errID, errMessage = l.getErrorMessage(r, err)
+	}
+	translator := l.getTranslator(r.Context(), authReq)
+	data := consentData{
+		userData:             l.getUserData(r, authReq, "Consent.Title", "Consent.Description", errID, errMessage),
+		Scopes:               l.consentScopes(r, step),
+		AuthorizationDetails: l.consentAuthorizationDetails(r, step),
+	}
+	if authReq != nil {
+		data.ApplicationName = authReq.ApplicationID
+		if app, appErr := l.query.AppByOIDCClientID(r.Context(), authReq.ApplicationID, false); appErr == nil {
+			data.ApplicationName = app.Name
+		}
+	}
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplConsent], data, nil)
+}
+
+// consentScopes returns the scopes of the consent step enriched with the display name and description
+// of the scopes defined on the project, all other scopes (e.g. profile or email) are shown by their key
+func (l *Login) consentScopes(r *http.Request, step *domain.ConsentStep) []*consentScope {
+	scopes := make([]*consentScope, len(step.Scopes))
+	for i, key := range step.Scopes {
+		scopes[i] = &consentScope{Key: key, DisplayName: key}
+	}
+	if step.ProjectID == "" {
+		return scopes
+	}
+	projectIDQuery, err := query.NewProjectScopeProjectIDSearchQuery(step.ProjectID)
+	if err != nil {
+		return scopes
+	}
+	projectScopes, err := l.query.SearchProjectScopes(r.Context(), false, &query.ProjectScopeSearchQueries{Queries: []query.SearchQuery{projectIDQuery}}, false)
+	if err != nil {
+		return scopes
+	}
+	defined := make(map[string]*query.ProjectScope, len(projectScopes.ProjectScopes))
+	for _, scope := range projectScopes.ProjectScopes {
+		defined[scope.Key] = scope
+	}
+	for _, scope := range scopes {
+		projectScope, ok := defined[scope.Key]
+		if !ok {
+			continue
+		}
+		if projectScope.DisplayName != "" {
+			scope.DisplayName = projectScope.DisplayName
+		}
+		scope.Description = projectScope.Description
+	}
+	return scopes
+}
+
+// consentAuthorizationDetails returns the authorization details of the consent step
+// enriched with the display name and description of their type registered on the project
+func (l *Login) consentAuthorizationDetails(r *http.Request, step *domain.ConsentStep) []*consentAuthorizationDetail {
+	if len(step.AuthorizationDetails) == 0 {
+		return nil
+	}
+	details := make([]*consentAuthorizationDetail, len(step.AuthorizationDetails))
+	for i, detail := range step.AuthorizationDetails {
+		details[i] = &consentAuthorizationDetail{
+			Type:        detail.Type(),
+			DisplayName: detail.Type(),
+			Fields:      consentAuthorizationDetailFields(detail),
+		}
+	}
+	projectIDQuery, err := query.NewProjectAuthorizationDetailTypeProjectIDSearchQuery(step.ProjectID)
+	if err != nil {
+		return details
+	}
+	detailTypes, err := l.query.SearchProjectAuthorizationDetailTypes(r.Context(), false, &query.ProjectAuthorizationDetailTypeSearchQueries{Queries: []query.SearchQuery{projectIDQuery}}, false)
+	if err != nil {
+		return details
+	}
+	defined := make(map[string]*query.ProjectAuthorizationDetailType, len(detailTypes.ProjectAuthorizationDetailTypes))
+	for _, detailType := range detailTypes.ProjectAuthorizationDetailTypes {
+		defined[detailType.Type] = detailType
+	}
+	for _, detail := range details {
+		detailType, ok := defined[detail.Type]
+		if !ok {
+			continue
+		}
+		if detailType.DisplayName != "" {
+			detail.DisplayName = detailType.DisplayName
+		}
+		detail.Description = detailType.Description
+	}
+	return details
+}
+
+// consentAuthorizationDetailFields returns all fields of the authorization detail except its type, sorted by their key.
+// Values other than strings are shown as JSON.
+func consentAuthorizationDetailFields(detail domain.AuthorizationDetail) []*consentAuthorizationDetailField {
+	fields := make([]*consentAuthorizationDetailField, 0, len(detail))
+	for key, value := range detail {
+		if key == "type" {
+			continue
+		}
+		field := &consentAuthorizationDetailField{Key: key}
+		if s, ok := value.(string); ok {
+			field.Value = s
+		} else if b, err := json.Marshal(value); err == nil {
+			field.Value = string(b)
+		}
+		fields = append(fields, field)
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Key < fields[j].Key
+	})
+	return fields
+}
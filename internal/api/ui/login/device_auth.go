@@ -34,19 +34,21 @@ func (l *Login) renderDeviceAuthUserCode(w http.ResponseWriter, r *http.Request,
 	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplDeviceAuthUserCode], data, nil)
 }
 
-func (l *Login) renderDeviceAuthAction(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, scopes []string) {
+func (l *Login) renderDeviceAuthAction(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, authDev *domain.AuthRequestDevice) {
 	data := &struct {
 		baseData
-		AuthRequestID string
-		Username      string
-		ClientID      string
-		Scopes        []string
+		AuthRequestID  string
+		Username       string
+		ClientID       string
+		Scopes         []string
+		BindingMessage string
 	}{
-		baseData:      l.getBaseData(r, authReq, "DeviceAuth.Title", "DeviceAuth.Action.Description", "", ""),
-		AuthRequestID: authReq.ID,
-		Username:      authReq.UserName,
-		ClientID:      authReq.ApplicationID,
-		Scopes:        scopes,
+		baseData:       l.getBaseData(r, authReq, "DeviceAuth.Title", "DeviceAuth.Action.Description", "", ""),
+		AuthRequestID:  authReq.ID,
+		Username:       authReq.UserName,
+		ClientID:       authReq.ApplicationID,
+		Scopes:         authDev.Scopes,
+		BindingMessage: authDev.BindingMessage,
 	}
 
 	translator := l.getTranslator(r.Context(), authReq)
@@ -78,6 +80,12 @@ func (l *Login) renderDeviceAuthDone(w http.ResponseWriter, r *http.Request, aut
 	}
 }
 
+// DeviceAuthUserCodeLink returns the link to the device authorization form with the user code already filled in.
+// It's sent to the user to approve a backchannel authentication request (CIBA).
+func DeviceAuthUserCodeLink(origin, userCode string) string {
+	return fmt.Sprintf("%s%s?user_code=%s", externalLink(origin), EndpointDeviceAuth, url.QueryEscape(userCode))
+}
+
 // handleDeviceUserCode serves the Device Authorization user code submission form.
 // The "user_code" may be submitted by URL (GET) or form (POST).
 // When a "user_code" is received and found through query,
@@ -86,6 +94,8 @@ func (l *Login) renderDeviceAuthDone(w http.ResponseWriter, r *http.Request, aut
 //
 // The agent ID from the context is set to the authentication request
 // to ensure the complete login flow is completed from the same browser.
+// For backchannel authentication requests (CIBA) the user is already known
+// and set to the authentication request, so only this user is able to log in.
 func (l *Login) handleDeviceAuthUserCode(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	err := r.ParseForm()
@@ -117,11 +127,13 @@ func (l *Login) handleDeviceAuthUserCode(w http.ResponseWriter, r *http.Request)
 		AgentID:       userAgentID,
 		ApplicationID: deviceAuth.ClientID,
 		InstanceID:    authz.GetInstance(ctx).InstanceID(),
+		UserID:        deviceAuth.UserID,
 		Request: &domain.AuthRequestDevice{
-			ID:         deviceAuth.AggregateID,
-			DeviceCode: deviceAuth.DeviceCode,
-			UserCode:   deviceAuth.UserCode,
-			Scopes:     deviceAuth.Scopes,
+			ID:             deviceAuth.AggregateID,
+			DeviceCode:     deviceAuth.DeviceCode,
+			UserCode:       deviceAuth.UserCode,
+			Scopes:         deviceAuth.Scopes,
+			BindingMessage: deviceAuth.BindingMessage,
 		},
 	})
 	if err != nil {
@@ -174,7 +186,7 @@ func (l *Login) handleDeviceAuthAction(w http.ResponseWriter, r *http.Request) {
 	case deviceAuthDenied:
 		_, err = l.command.CancelDeviceAuth(r.Context(), authDev.ID, domain.DeviceAuthCanceledDenied)
 	default:
-		l.renderDeviceAuthAction(w, r, authReq, authDev.Scopes)
+		l.renderDeviceAuthAction(w, r, authReq, authDev)
 		return
 	}
 	if err != nil {
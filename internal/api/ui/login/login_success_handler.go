@@ -14,7 +14,9 @@ const (
 
 type loginSuccessData struct {
 	userData
-	RedirectURI string `schema:"redirect-uri"`
+	RedirectURI         string `schema:"redirect-uri"`
+	SelfServiceAllowed  bool
+	SelfDeletionAllowed bool
 }
 
 func (l *Login) redirectToLoginSuccess(w http.ResponseWriter, r *http.Request, id string) {
@@ -45,6 +47,8 @@ func (l *Login) renderSuccessAndCallback(w http.ResponseWriter, r *http.Request,
 		userData: l.getUserData(r, authReq, "LoginSuccess.Title", "", errID, errMessage),
 	}
 	if authReq != nil {
+		data.SelfServiceAllowed = isLoginSucceeded(authReq)
+		data.SelfDeletionAllowed = data.SelfServiceAllowed && isSelfDeletionAllowed(authReq)
 		data.RedirectURI, err = l.authRequestCallback(r.Context(), authReq)
 		if err != nil {
 			l.renderInternalError(w, r, authReq, err)
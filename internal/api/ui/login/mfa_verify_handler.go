@@ -15,6 +15,7 @@ type mfaVerifyFormData struct {
 	MFAType          domain.MFAType `schema:"mfaType"`
 	Code             string         `schema:"code"`
 	SelectedProvider domain.MFAType `schema:"provider"`
+	TrustDevice      bool           `schema:"trustDevice"`
 }
 
 func (l *Login) handleMFAVerify(w http.ResponseWriter, r *http.Request) {
@@ -48,6 +49,12 @@ func (l *Login) handleMFAVerify(w http.ResponseWriter, r *http.Request) {
 			l.renderMFAVerifySelected(w, r, authReq, step, domain.MFATypeTOTP, err)
 			return
 		}
+		if data.TrustDevice {
+			if err = l.trustDevice(r, authReq); err != nil {
+				l.renderMFAVerifySelected(w, r, authReq, step, domain.MFATypeTOTP, err)
+				return
+			}
+		}
 	}
 	l.renderNextStep(w, r, authReq)
 }
@@ -84,6 +91,7 @@ func (l *Login) renderMFAVerifySelected(w http.ResponseWriter, r *http.Request,
 		data.SelectedMFAProvider = domain.MFATypeTOTP
 		data.Title = translator.LocalizeWithoutArgs("VerifyMFAOTP.Title")
 		data.Description = translator.LocalizeWithoutArgs("VerifyMFAOTP.Description")
+		data.TrustDeviceAllowed = trustDeviceAllowed(authReq)
 	default:
 		l.renderError(w, r, authReq, err)
 		return
@@ -91,6 +99,25 @@ func (l *Login) renderMFAVerifySelected(w http.ResponseWriter, r *http.Request,
 	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplMFAVerify], data, nil)
 }
 
+// trustDeviceAllowed checks if the user may choose to trust the current device,
+// which is only possible if enabled in the login policy and no multi-factor level of assurance was requested
+func trustDeviceAllowed(authReq *domain.AuthRequest) bool {
+	return authReq.LoginPolicy != nil &&
+		authReq.LoginPolicy.TrustedDeviceLifetime > 0 &&
+		authReq.RequestedLevelOfAssurance() < domain.LevelOfAssuranceMFA
+}
+
+func (l *Login) trustDevice(r *http.Request, authReq *domain.AuthRequest) error {
+	if !trustDeviceAllowed(authReq) {
+		return nil
+	}
+	userAgentID, ok := http_mw.UserAgentIDFromCtx(r.Context())
+	if !ok {
+		return nil
+	}
+	return l.command.HumanTrustDevice(setContext(r.Context(), authReq.UserOrgID), authReq.UserID, authReq.UserOrgID, domain.BrowserInfoFromRequest(r), userAgentID)
+}
+
 func removeSelectedProviderFromList(providers []domain.MFAType, selected domain.MFAType) []domain.MFAType {
 	for i := len(providers) - 1; i >= 0; i-- {
 		if providers[i] == selected {
@@ -15,13 +15,15 @@ const (
 
 type mfaU2FData struct {
 	webAuthNData
-	MFAProviders     []domain.MFAType
-	SelectedProvider domain.MFAType
+	MFAProviders       []domain.MFAType
+	SelectedProvider   domain.MFAType
+	TrustDeviceAllowed bool
 }
 
 type mfaU2FFormData struct {
 	webAuthNFormData
 	SelectedProvider domain.MFAType `schema:"provider"`
+	TrustDevice      bool           `schema:"trustDevice"`
 }
 
 func (l *Login) renderU2FVerification(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, providers []domain.MFAType, err error) {
@@ -42,8 +44,9 @@ func (l *Login) renderU2FVerification(w http.ResponseWriter, r *http.Request, au
 			userData:               l.getUserData(r, authReq, "VerifyMFAU2F.Title", "VerifyMFAU2F.Description", errID, errMessage),
 			CredentialCreationData: credentialData,
 		},
-		MFAProviders:     providers,
-		SelectedProvider: -1,
+		MFAProviders:       providers,
+		SelectedProvider:   -1,
+		TrustDeviceAllowed: trustDeviceAllowed(authReq),
 	}
 	l.renderer.RenderTemplate(w, r, l.getTranslator(r.Context(), authReq), l.renderer.Templates[tmplU2FVerification], data, nil)
 }
@@ -83,5 +86,11 @@ func (l *Login) handleU2FVerification(w http.ResponseWriter, r *http.Request) {
 		l.renderU2FVerification(w, r, authReq, step.MFAProviders, err)
 		return
 	}
+	if formData.TrustDevice {
+		if err = l.trustDevice(r, authReq); err != nil {
+			l.renderU2FVerification(w, r, authReq, step.MFAProviders, err)
+			return
+		}
+	}
 	l.renderNextStep(w, r, authReq)
 }
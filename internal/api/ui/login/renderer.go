@@ -81,6 +81,8 @@ func CreateRenderer(pathPrefix string, staticDir http.FileSystem, staticStorage
 		tmplLDAPLogin:                    "ldap_login.html",
 		tmplDeviceAuthUserCode:           "device_usercode.html",
 		tmplDeviceAuthAction:             "device_action.html",
+		tmplUserDeletion:                 "user_deletion.html",
+		tmplConsent:                      "consent.html",
 	}
 	funcs := map[string]interface{}{
 		"resourceUrl": func(file string) string {
@@ -227,6 +229,21 @@ func CreateRenderer(pathPrefix string, staticDir http.FileSystem, staticStorage
 		"ldapUrl": func() string {
 			return path.Join(r.pathPrefix, EndpointLDAPCallback)
 		},
+		"loginSuccessUrl": func(id string) string {
+			return path.Join(r.pathPrefix, fmt.Sprintf("%s?%s=%s", EndpointLoginSuccess, QueryAuthRequestID, id))
+		},
+		"userDeletionUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointUserDeletion)
+		},
+		"userDeletionPageUrl": func(id string) string {
+			return path.Join(r.pathPrefix, fmt.Sprintf("%s?%s=%s", EndpointUserDeletion, QueryAuthRequestID, id))
+		},
+		"userDataExportUrl": func(id string) string {
+			return path.Join(r.pathPrefix, fmt.Sprintf("%s?%s=%s", EndpointUserDataExport, QueryAuthRequestID, id))
+		},
+		"consentUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointConsent)
+		},
 	}
 	var err error
 	r.Renderer, err = renderer.NewRenderer(
@@ -315,6 +332,8 @@ func (l *Login) chooseNextStep(w http.ResponseWriter, r *http.Request, authReq *
 		l.renderExternalNotFoundOption(w, r, authReq, nil, nil, nil, err)
 	case *domain.ExternalLoginStep:
 		l.handleExternalLoginStep(w, r, authReq, step.SelectedIDPConfigID)
+	case *domain.ConsentStep:
+		l.renderConsent(w, r, authReq, step, err)
 	case *domain.GrantRequiredStep:
 		l.renderInternalError(w, r, authReq, caos_errs.ThrowPreconditionFailed(nil, "APP-asb43", "Errors.User.GrantRequired"))
 	case *domain.ProjectRequiredStep:
@@ -637,6 +656,7 @@ type userData struct {
 	MFAProviders        []domain.MFAType
 	SelectedMFAProvider domain.MFAType
 	Linking             bool
+	TrustDeviceAllowed  bool
 }
 
 type profileData struct {
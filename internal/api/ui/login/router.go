@@ -43,6 +43,9 @@ const (
 	EndpointLogoutDone               = "/logout/done"
 	EndpointLoginSuccess             = "/login/success"
 	EndpointExternalNotFoundOption   = "/externaluser/option"
+	EndpointUserDeletion             = "/user/deletion"
+	EndpointUserDataExport           = "/user/export"
+	EndpointConsent                  = "/consent"
 
 	EndpointResources        = "/resources"
 	EndpointDynamicResources = "/resources/dynamic"
@@ -109,6 +112,10 @@ func CreateRouter(login *Login, staticDir http.FileSystem, interceptors ...mux.M
 	router.HandleFunc(EndpointLoginSuccess, login.handleLoginSuccess).Methods(http.MethodGet)
 	router.HandleFunc(EndpointLDAPLogin, login.handleLDAP).Methods(http.MethodGet)
 	router.HandleFunc(EndpointLDAPCallback, login.handleLDAPCallback).Methods(http.MethodPost)
+	router.HandleFunc(EndpointUserDeletion, login.handleUserDeletion).Methods(http.MethodGet)
+	router.HandleFunc(EndpointUserDeletion, login.handleUserDeletionCheck).Methods(http.MethodPost)
+	router.HandleFunc(EndpointUserDataExport, login.handleUserDataExport).Methods(http.MethodGet)
+	router.HandleFunc(EndpointConsent, login.handleConsentCheck).Methods(http.MethodPost)
 	router.SkipClean(true).Handle("", http.RedirectHandler(HandlerPrefix+"/", http.StatusMovedPermanently))
 	router.HandleFunc(EndpointDeviceAuth, login.handleDeviceAuthUserCode).Methods(http.MethodGet, http.MethodPost)
 	router.HandleFunc(EndpointDeviceAuthAction, login.handleDeviceAuthAction).Methods(http.MethodGet, http.MethodPost)
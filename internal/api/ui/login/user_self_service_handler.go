@@ -0,0 +1,224 @@
+package login
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query"
+	usr_model "github.com/zitadel/zitadel/internal/user/model"
+)
+
+const (
+	tmplUserDeletion = "userdeletion"
+
+	userDataExportFileName = "user_data.json"
+)
+
+type userDeletionFormData struct {
+	Password string `schema:"password"`
+	Cancel   bool   `schema:"cancel"`
+}
+
+type userDeletionData struct {
+	userData
+	ScheduledAt      string
+	PasswordRequired bool
+}
+
+type userDataExport struct {
+	User           *query.User                  `json:"user"`
+	Metadata       []*query.UserMetadata        `json:"metadata"`
+	UserGrants     []*query.UserGrant           `json:"userGrants"`
+	Memberships    []*query.Membership          `json:"memberships"`
+	IDPLinks       []*query.IDPUserLink         `json:"idpLinks"`
+	AuthMethods    []*query.AuthMethod          `json:"authMethods"`
+	Sessions       []*usr_model.UserSessionView `json:"sessions"`
+	TrustedDevices []*query.TrustedDevice       `json:"trustedDevices"`
+}
+
+// isLoginSucceeded returns if the user of the auth request is fully authenticated,
+// which is required to access the self service pages
+func isLoginSucceeded(authReq *domain.AuthRequest) bool {
+	if authReq == nil || authReq.UserID == "" || len(authReq.PossibleSteps) == 0 {
+		return false
+	}
+	for _, step := range authReq.PossibleSteps {
+		if step.Type() != domain.NextStepLoginSucceeded && step.Type() != domain.NextStepRedirectToCallback {
+			return false
+		}
+	}
+	return true
+}
+
+func isSelfDeletionAllowed(authReq *domain.AuthRequest) bool {
+	return authReq != nil && authReq.PrivacyPolicy != nil && authReq.PrivacyPolicy.AllowSelfDeletion
+}
+
+func (l *Login) handleUserDeletion(w http.ResponseWriter, r *http.Request) {
+	authReq, err := l.getAuthRequest(r)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	if !isLoginSucceeded(authReq) {
+		l.renderNextStep(w, r, authReq)
+		return
+	}
+	l.renderUserDeletion(w, r, authReq, nil)
+}
+
+func (l *Login) handleUserDeletionCheck(w http.ResponseWriter, r *http.Request) {
+	data := new(userDeletionFormData)
+	authReq, err := l.getAuthRequestAndParseData(r, data)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	if !isLoginSucceeded(authReq) {
+		l.renderNextStep(w, r, authReq)
+		return
+	}
+	ctx := setContext(r.Context(), authReq.UserOrgID)
+	if data.Cancel {
+		_, err = l.command.CancelHumanSelfDeletion(ctx, authReq.UserID, authReq.UserOrgID)
+	} else if l.userHasPassword(ctx, authReq.UserID) {
+		_, _, err = l.command.RequestHumanSelfDeletion(ctx, authReq.UserID, authReq.UserOrgID, data.Password)
+	} else {
+		// users without password (passwordless or external login) have to be authenticated recently instead
+		_, _, err = l.command.RequestHumanSelfDeletionAfterAuthentication(ctx, authReq.UserID, authReq.UserOrgID, authReq.AuthTime)
+	}
+	l.renderUserDeletion(w, r, authReq, err)
+}
+
+func (l *Login) renderUserDeletion(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, err error) {
+	if err == nil && !isSelfDeletionAllowed(authReq) {
+		err = caos_errs.ThrowPreconditionFailed(nil, "LOGIN-Vq3sd", "Errors.User.SelfDeletion.NotAllowed")
+	}
+	var errID, errMessage string
+	if err != nil {
+		errID, errMessage = l.getErrorMessage(r, err)
+	}
+	translator := l.getTranslator(r.Context(), authReq)
+	data := userDeletionData{
+		userData:         l.getUserData(r, authReq, "UserDeletion.Title", "UserDeletion.Description", errID, errMessage),
+		PasswordRequired: l.userHasPassword(r.Context(), authReq.UserID),
+	}
+	deletion, deletionErr := l.query.UserSelfDeletionByUserID(r.Context(), true, authReq.UserID)
+	if deletionErr == nil {
+		data.ScheduledAt = deletion.ScheduledAt.Format("2006-01-02 15:04 MST")
+	}
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplUserDeletion], data, nil)
+}
+
+// userHasPassword returns if the user has to confirm the deletion with the password,
+// in case of an error the password is required
+func (l *Login) userHasPassword(ctx context.Context, userID string) bool {
+	user, err := l.query.GetNotifyUserByID(ctx, true, userID, false)
+	if err != nil {
+		return true
+	}
+	return user.PasswordSet
+}
+
+// handleUserDataExport returns all personal data of the authenticated user as JSON download
+func (l *Login) handleUserDataExport(w http.ResponseWriter, r *http.Request) {
+	authReq, err := l.getAuthRequest(r)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	if !isLoginSucceeded(authReq) {
+		l.renderNextStep(w, r, authReq)
+		return
+	}
+	export, err := l.userDataExport(r, authReq)
+	if err != nil {
+		l.renderInternalError(w, r, authReq, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", "attachment; filename=\""+userDataExportFileName+"\"")
+	err = json.NewEncoder(w).Encode(export)
+	if err != nil {
+		l.renderInternalError(w, r, authReq, err)
+	}
+}
+
+func (l *Login) userDataExport(r *http.Request, authReq *domain.AuthRequest) (_ *userDataExport, err error) {
+	ctx := authz.SetCtxData(r.Context(), authz.CtxData{UserID: authReq.UserID, OrgID: authReq.UserOrgID, AgentID: authReq.AgentID})
+	export := new(userDataExport)
+	export.User, err = l.query.GetUserByID(ctx, true, authReq.UserID, false)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := l.query.SearchUserMetadata(ctx, true, authReq.UserID, &query.UserMetadataSearchQueries{}, false)
+	if err != nil {
+		return nil, err
+	}
+	export.Metadata = metadata.Metadata
+
+	userGrantUserID, err := query.NewUserGrantUserIDSearchQuery(authReq.UserID)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := l.query.UserGrants(ctx, &query.UserGrantsQueries{Queries: []query.SearchQuery{userGrantUserID}, WithGroupGrants: true}, true, false)
+	if err != nil {
+		return nil, err
+	}
+	export.UserGrants = grants.UserGrants
+
+	membershipUserID, err := query.NewMembershipUserIDQuery(authReq.UserID)
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := l.query.Memberships(ctx, &query.MembershipSearchQuery{Queries: []query.SearchQuery{membershipUserID}}, false)
+	if err != nil {
+		return nil, err
+	}
+	export.Memberships = memberships.Memberships
+
+	idpLinkUserID, err := query.NewIDPUserLinksUserIDSearchQuery(authReq.UserID)
+	if err != nil {
+		return nil, err
+	}
+	idpLinks, err := l.query.IDPUserLinks(ctx, &query.IDPUserLinksSearchQuery{Queries: []query.SearchQuery{idpLinkUserID}}, false)
+	if err != nil {
+		return nil, err
+	}
+	export.IDPLinks = idpLinks.Links
+
+	authMethodQueries := new(query.UserAuthMethodSearchQueries)
+	if err = authMethodQueries.AppendUserIDQuery(authReq.UserID); err != nil {
+		return nil, err
+	}
+	authMethods, err := l.query.SearchUserAuthMethods(ctx, authMethodQueries, false)
+	if err != nil {
+		return nil, err
+	}
+	export.AuthMethods = authMethods.AuthMethods
+
+	sessions, err := l.authRepo.GetMyUserSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, session := range sessions {
+		if session.UserID == authReq.UserID {
+			export.Sessions = append(export.Sessions, session)
+		}
+	}
+
+	trustedDeviceUserID, err := query.NewTrustedDeviceUserIDSearchQuery(authReq.UserID)
+	if err != nil {
+		return nil, err
+	}
+	trustedDevices, err := l.query.SearchTrustedDevices(ctx, &query.TrustedDeviceSearchQueries{Queries: []query.SearchQuery{trustedDeviceUserID}}, false)
+	if err != nil {
+		return nil, err
+	}
+	export.TrustedDevices = trustedDevices.TrustedDevices
+	return export, nil
+}
@@ -50,6 +50,8 @@ type AuthRequestRepo struct {
 	UserGrantProvider         userGrantProvider
 	ProjectProvider           projectProvider
 	ApplicationProvider       applicationProvider
+	TrustedDeviceProvider     trustedDeviceProvider
+	ConsentProvider           consentProvider
 
 	IdGenerator id.Generator
 }
@@ -115,6 +117,14 @@ type applicationProvider interface {
 	AppByOIDCClientID(context.Context, string, bool) (*query.App, error)
 }
 
+type trustedDeviceProvider interface {
+	TrustedDeviceByUserAgentID(ctx context.Context, shouldTriggerBulk bool, userID, userAgentID string) (*query.TrustedDevice, error)
+}
+
+type consentProvider interface {
+	UserConsentByClientID(ctx context.Context, shouldTriggerBulk bool, userID, clientID string) (*query.UserConsent, error)
+}
+
 func (repo *AuthRequestRepo) Health(ctx context.Context) error {
 	return repo.AuthRequests.Health(ctx)
 }
@@ -489,6 +499,40 @@ func (repo *AuthRequestRepo) ResetSelectedIDP(ctx context.Context, authReqID, us
 	return repo.AuthRequests.UpdateAuthRequest(ctx, request)
 }
 
+func (repo *AuthRequestRepo) GrantConsent(ctx context.Context, authReqID, userID, userAgentID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	request, err := repo.getAuthRequestEnsureUser(ctx, authReqID, userAgentID, userID)
+	if err != nil {
+		return err
+	}
+	step, err := repo.consentRequired(ctx, request, userID)
+	if err != nil {
+		return err
+	}
+	if step != nil {
+		_, err = repo.Command.HumanGrantConsent(ctx, userID, request.UserOrgID, request.ApplicationID, step.ProjectID, step.Scopes)
+		if err != nil {
+			return err
+		}
+	}
+	request.ConsentGiven = true
+	return repo.AuthRequests.UpdateAuthRequest(ctx, request)
+}
+
+// DenyConsent records that the user denied the consent,
+// so the client receives an access_denied error on the callback
+func (repo *AuthRequestRepo) DenyConsent(ctx context.Context, authReqID, userID, userAgentID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	request, err := repo.getAuthRequestEnsureUser(ctx, authReqID, userAgentID, userID)
+	if err != nil {
+		return err
+	}
+	request.ConsentDenied = true
+	return repo.AuthRequests.UpdateAuthRequest(ctx, request)
+}
+
 func (repo *AuthRequestRepo) AutoRegisterExternalUser(ctx context.Context, registerUser *domain.Human, externalIDP *domain.UserIDPLink, orgMemberRoles []string, authReqID, userAgentID, resourceOwner string, metadatas []*domain.Metadata, info *domain.BrowserInfo) (err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -867,6 +911,7 @@ func queryLoginPolicyToDomain(policy *query.LoginPolicy) *domain.LoginPolicy {
 		MFAInitSkipLifetime:        policy.MFAInitSkipLifetime,
 		SecondFactorCheckLifetime:  policy.SecondFactorCheckLifetime,
 		MultiFactorCheckLifetime:   policy.MultiFactorCheckLifetime,
+		TrustedDeviceLifetime:      policy.TrustedDeviceLifetime,
 		DisableLoginWithEmail:      policy.DisableLoginWithEmail,
 		DisableLoginWithPhone:      policy.DisableLoginWithPhone,
 	}
@@ -971,7 +1016,7 @@ func (repo *AuthRequestRepo) nextSteps(ctx context.Context, request *domain.Auth
 		}
 	}
 
-	step, ok, err := repo.mfaChecked(userSession, request, user, isInternalLogin && len(request.LinkingUsers) == 0)
+	step, ok, err := repo.mfaChecked(ctx, userSession, request, user, isInternalLogin && len(request.LinkingUsers) == 0)
 	if err != nil {
 		return nil, err
 	}
@@ -996,7 +1041,13 @@ func (repo *AuthRequestRepo) nextSteps(ctx context.Context, request *domain.Auth
 	if request.LinkingUsers != nil && len(request.LinkingUsers) != 0 {
 		return append(steps, &domain.LinkUsersStep{}), nil
 	}
-	//PLANNED: consent step
+	consentStep, err := repo.consentRequired(ctx, request, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if consentStep != nil {
+		return append(steps, consentStep), nil
+	}
 
 	missing, err := projectRequired(ctx, request, repo.ProjectProvider)
 	if err != nil {
@@ -1104,6 +1155,7 @@ func (repo *AuthRequestRepo) firstFactorChecked(request *domain.AuthRequest, use
 	var step domain.NextStep
 	if request.LoginPolicy.PasswordlessType != domain.PasswordlessTypeNotAllowed && user.IsPasswordlessReady() {
 		if checkVerificationTimeMaxAge(userSession.PasswordlessVerification, request.LoginPolicy.MultiFactorCheckLifetime, request) {
+			request.MFAsVerified = append(request.MFAsVerified, domain.MFATypeU2FUserVerification)
 			request.AuthTime = userSession.PasswordlessVerification
 			return nil
 		}
@@ -1131,12 +1183,23 @@ func (repo *AuthRequestRepo) firstFactorChecked(request *domain.AuthRequest, use
 	return &domain.PasswordStep{}
 }
 
-func (repo *AuthRequestRepo) mfaChecked(userSession *user_model.UserSessionView, request *domain.AuthRequest, user *user_model.UserView, isInternalAuthentication bool) (domain.NextStep, bool, error) {
+func (repo *AuthRequestRepo) mfaChecked(ctx context.Context, userSession *user_model.UserSessionView, request *domain.AuthRequest, user *user_model.UserView, isInternalAuthentication bool) (domain.NextStep, bool, error) {
+	// multiple factors were already verified (passwordless), so only check for the requested level
+	if loa := request.LevelOfAssurance(); loa >= domain.LevelOfAssuranceMFA && loa >= request.RequestedLevelOfAssurance() {
+		return nil, true, nil
+	}
 	mfaLevel := request.MFALevel()
+	phishingResistant := request.RequestedLevelOfAssurance() == domain.LevelOfAssurancePhishingResistant
 	allowedProviders, required := user.MFATypesAllowed(mfaLevel, request.LoginPolicy, isInternalAuthentication)
+	if phishingResistant {
+		allowedProviders = domain.PhishingResistantMFATypes(allowedProviders)
+	}
 	promptRequired := (user.MFAMaxSetUp < mfaLevel) || (len(allowedProviders) == 0 && required)
 	if promptRequired || !repo.mfaSkippedOrSetUp(user, request) {
 		types := user.MFATypesSetupPossible(mfaLevel, request.LoginPolicy)
+		if phishingResistant {
+			types = domain.PhishingResistantMFATypes(types)
+		}
 		if promptRequired && len(types) == 0 {
 			return nil, false, errors.ThrowPreconditionFailed(nil, "LOGIN-5Hm8s", "Errors.Login.LoginPolicy.MFA.ForceAndNotConfigured")
 		}
@@ -1157,11 +1220,19 @@ func (repo *AuthRequestRepo) mfaChecked(userSession *user_model.UserSessionView,
 		}
 		fallthrough
 	case domain.MFALevelSecondFactor:
-		if checkVerificationTimeMaxAge(userSession.SecondFactorVerification, request.LoginPolicy.SecondFactorCheckLifetime, request) {
+		if checkVerificationTimeMaxAge(userSession.SecondFactorVerification, request.LoginPolicy.SecondFactorCheckLifetime, request) &&
+			(!phishingResistant || userSession.SecondFactorVerificationType.IsPhishingResistant()) {
 			request.MFAsVerified = append(request.MFAsVerified, userSession.SecondFactorVerificationType)
 			request.AuthTime = userSession.SecondFactorVerification
 			return nil, true, nil
 		}
+		trusted, err := repo.deviceTrusted(ctx, request, user)
+		if err != nil {
+			return nil, false, err
+		}
+		if trusted {
+			return nil, true, nil
+		}
 		fallthrough
 	case domain.MFALevelMultiFactor:
 		if checkVerificationTimeMaxAge(userSession.MultiFactorVerification, request.LoginPolicy.MultiFactorCheckLifetime, request) {
@@ -1175,6 +1246,24 @@ func (repo *AuthRequestRepo) mfaChecked(userSession *user_model.UserSessionView,
 	}, false, nil
 }
 
+// deviceTrusted checks if the user agent was trusted by the user to skip the second factor.
+// A trusted device never satisfies an explicitly requested multi-factor level of assurance.
+func (repo *AuthRequestRepo) deviceTrusted(ctx context.Context, request *domain.AuthRequest, user *user_model.UserView) (bool, error) {
+	if request.LoginPolicy.TrustedDeviceLifetime <= 0 || request.AgentID == "" ||
+		request.RequestedLevelOfAssurance() >= domain.LevelOfAssuranceMFA {
+		return false, nil
+	}
+	// the projection is triggered, so a device revoked just before is not trusted anymore
+	device, err := repo.TrustedDeviceProvider.TrustedDeviceByUserAgentID(ctx, true, user.ID, request.AgentID)
+	if errors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return device.IsTrusted(), nil
+}
+
 func (repo *AuthRequestRepo) mfaSkippedOrSetUp(user *user_model.UserView, request *domain.AuthRequest) bool {
 	if user.MFAMaxSetUp > domain.MFALevelNotSetUp {
 		return true
@@ -1205,12 +1294,13 @@ func privacyPolicyToDomain(p *query.PrivacyPolicy) *domain.PrivacyPolicy {
 			CreationDate:  p.CreationDate,
 			ChangeDate:    p.ChangeDate,
 		},
-		State:        p.State,
-		Default:      p.IsDefault,
-		TOSLink:      p.TOSLink,
-		PrivacyLink:  p.PrivacyLink,
-		HelpLink:     p.HelpLink,
-		SupportEmail: p.SupportEmail,
+		State:             p.State,
+		Default:           p.IsDefault,
+		TOSLink:           p.TOSLink,
+		PrivacyLink:       p.PrivacyLink,
+		HelpLink:          p.HelpLink,
+		SupportEmail:      p.SupportEmail,
+		AllowSelfDeletion: p.AllowSelfDeletion,
 	}
 }
 
@@ -1279,6 +1369,56 @@ func (repo *AuthRequestRepo) hasSucceededPage(ctx context.Context, request *doma
 	return app.OIDCConfig.AppType == domain.OIDCApplicationTypeNative && !app.OIDCConfig.SkipNativeAppSuccessPage, nil
 }
 
+// consentRequired returns the consent step, if the application requires the consent of the user
+// and the user did not consent to all requested scopes yet (or the consent was explicitly requested by prompt=consent).
+// Device authorization and backchannel authentication (CIBA) requests are checked the same way.
+func (repo *AuthRequestRepo) consentRequired(ctx context.Context, request *domain.AuthRequest, userID string) (*domain.ConsentStep, error) {
+	if request.ConsentGiven {
+		return nil, nil
+	}
+	var (
+		requestedScopes      []string
+		authorizationDetails domain.AuthorizationDetails
+	)
+	switch req := request.Request.(type) {
+	case *domain.AuthRequestOIDC:
+		requestedScopes = req.Scopes
+		authorizationDetails = req.AuthorizationDetails
+	case *domain.AuthRequestDevice:
+		requestedScopes = req.Scopes
+	default:
+		return nil, nil
+	}
+	app, err := repo.ApplicationProvider.AppByOIDCClientID(ctx, request.ApplicationID, false)
+	if err != nil {
+		return nil, err
+	}
+	scopes := domain.ConsentScopes(requestedScopes)
+	if len(authorizationDetails) > 0 {
+		return &domain.ConsentStep{
+			ProjectID:            app.ProjectID,
+			Scopes:               scopes,
+			AuthorizationDetails: authorizationDetails,
+		}, nil
+	}
+	if !app.OIDCConfig.ConsentRequired {
+		return nil, nil
+	}
+	if !domain.IsPrompt(request.Prompt, domain.PromptConsent) {
+		consent, err := repo.ConsentProvider.UserConsentByClientID(ctx, false, userID, request.ApplicationID)
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+		if consent.ContainsScopes(scopes) {
+			return nil, nil
+		}
+	}
+	return &domain.ConsentStep{
+		ProjectID: app.ProjectID,
+		Scopes:    scopes,
+	}, nil
+}
+
 func (repo *AuthRequestRepo) getDomainPolicy(ctx context.Context, orgID string) (*query.DomainPolicy, error) {
 	return repo.Query.DomainPolicyByOrg(ctx, false, orgID, false)
 }
@@ -1332,6 +1472,10 @@ func checkVerificationTimeMaxAge(verificationTime time.Time, lifetime time.Durat
 	if !checkVerificationTime(verificationTime, lifetime) {
 		return false
 	}
+	// prompt=login requires the user to re-authenticate, so only verifications of the current request are valid
+	if domain.IsPrompt(request.Prompt, domain.PromptLogin) && verificationTime.Before(request.CreationDate) {
+		return false
+	}
 	if request.MaxAuthAge == nil {
 		return true
 	}
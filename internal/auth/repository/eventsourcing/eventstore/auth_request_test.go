@@ -11,6 +11,7 @@ import (
 	"github.com/zitadel/zitadel/internal/auth/repository/eventsourcing/view"
 	"github.com/zitadel/zitadel/internal/auth_request/repository/cache"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/errors"
 	es_models "github.com/zitadel/zitadel/internal/eventstore/v1/models"
@@ -248,6 +249,23 @@ func (m *mockApp) AppByOIDCClientID(ctx context.Context, id string, _ bool) (*qu
 	return nil, errors.ThrowNotFound(nil, "ERROR", "error")
 }
 
+type mockTrustedDevice struct {
+	device *query.TrustedDevice
+	// outdated is returned if the projection is not triggered
+	outdated *query.TrustedDevice
+}
+
+func (m *mockTrustedDevice) TrustedDeviceByUserAgentID(_ context.Context, shouldTriggerBulk bool, _, _ string) (*query.TrustedDevice, error) {
+	device := m.device
+	if !shouldTriggerBulk && m.outdated != nil {
+		device = m.outdated
+	}
+	if device != nil {
+		return device, nil
+	}
+	return nil, errors.ThrowNotFound(nil, "ERROR", "error")
+}
+
 type mockIDPUserLinks struct {
 	idps []*query.IDPUserLink
 }
@@ -256,6 +274,17 @@ func (m *mockIDPUserLinks) IDPUserLinks(ctx context.Context, queries *query.IDPU
 	return &query.IDPUserLinks{Links: m.idps}, nil
 }
 
+type mockConsent struct {
+	consent *query.UserConsent
+}
+
+func (m *mockConsent) UserConsentByClientID(context.Context, bool, string, string) (*query.UserConsent, error) {
+	if m.consent != nil {
+		return m.consent, nil
+	}
+	return nil, errors.ThrowNotFound(nil, "ERROR", "error")
+}
+
 func TestAuthRequestRepo_nextSteps(t *testing.T) {
 	type fields struct {
 		AuthRequests            *cache.AuthRequestCache
@@ -267,6 +296,7 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 		userGrantProvider       userGrantProvider
 		projectProvider         projectProvider
 		applicationProvider     applicationProvider
+		consentProvider         consentProvider
 		loginPolicyProvider     loginPolicyViewProvider
 		lockoutPolicyProvider   lockoutPolicyViewProvider
 		idpUserLinksProvider    idpUserLinksProvider
@@ -948,6 +978,36 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 			[]domain.NextStep{&domain.PasswordStep{}},
 			nil,
 		},
+		{
+			"prompt login, password verified before request, password check step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet: true,
+				},
+				userEventProvider: &mockEventUser{},
+				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{
+				&domain.AuthRequest{
+					UserID:       "UserID",
+					CreationDate: testNow.Add(-time.Minute),
+					Prompt:       []domain.Prompt{domain.PromptLogin},
+					LoginPolicy: &domain.LoginPolicy{
+						PasswordCheckLifetime: 10 * 24 * time.Hour,
+					},
+				}, false},
+			[]domain.NextStep{&domain.PasswordStep{}},
+			nil,
+		},
 		{
 			"external user (no password check needed), callback",
 			fields{
@@ -1316,7 +1376,8 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 					roleCheck:  true,
 					userGrants: 0,
 				},
-				projectProvider: &mockProject{},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
 				lockoutPolicyProvider: &mockLockoutPolicy{
 					policy: &query.LockoutPolicy{
 						ShowFailures: true,
@@ -1397,6 +1458,7 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 					hasProject:    false,
 					resourceOwner: "other-org",
 				},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
 				lockoutPolicyProvider: &mockLockoutPolicy{
 					policy: &query.LockoutPolicy{
 						ShowFailures: true,
@@ -1458,6 +1520,164 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
 			nil,
 		},
+		{
+			"prompt none, checkLoggedIn true, authenticated and consent missing, consent step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider:   &mockEventUser{},
+				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:   &mockUserGrants{},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{ProjectID: "projectID", OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb, ConsentRequired: true}}},
+				consentProvider:     &mockConsent{},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:  "UserID",
+				Prompt:  []domain.Prompt{domain.PromptNone},
+				Request: &domain.AuthRequestOIDC{Scopes: []string{"openid", "urn:zitadel:iam:org:id:orgID", "invoices:read"}},
+				LoginPolicy: &domain.LoginPolicy{
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, true},
+			[]domain.NextStep{&domain.ConsentStep{ProjectID: "projectID", Scopes: []string{"invoices:read"}}},
+			nil,
+		},
+		{
+			"device authorization, authenticated and consent missing, consent step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider:   &mockEventUser{},
+				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:   &mockUserGrants{},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{ProjectID: "projectID", OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeNative, ConsentRequired: true}}},
+				consentProvider:     &mockConsent{},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:  "UserID",
+				Request: &domain.AuthRequestDevice{Scopes: []string{"openid", "invoices:read"}},
+				LoginPolicy: &domain.LoginPolicy{
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, true},
+			[]domain.NextStep{&domain.ConsentStep{ProjectID: "projectID", Scopes: []string{"invoices:read"}}},
+			nil,
+		},
+		{
+			"prompt none, checkLoggedIn true, authenticated and consent exists, redirect to callback step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider:   &mockEventUser{},
+				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:   &mockUserGrants{},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{ProjectID: "projectID", OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb, ConsentRequired: true}}},
+				consentProvider:     &mockConsent{consent: &query.UserConsent{Scopes: database.StringArray{"invoices:read"}}},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:  "UserID",
+				Prompt:  []domain.Prompt{domain.PromptNone},
+				Request: &domain.AuthRequestOIDC{Scopes: []string{"openid", "urn:zitadel:iam:org:id:orgID", "invoices:read"}},
+				LoginPolicy: &domain.LoginPolicy{
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, true},
+			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
+			nil,
+		},
+		{
+			"prompt none, checkLoggedIn true, authenticated and consent exists with authorization details, consent step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider:   &mockEventUser{},
+				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:   &mockUserGrants{},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{ProjectID: "projectID", OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
+				consentProvider:     &mockConsent{consent: &query.UserConsent{Scopes: database.StringArray{"invoices:read"}}},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID: "UserID",
+				Prompt: []domain.Prompt{domain.PromptNone},
+				Request: &domain.AuthRequestOIDC{
+					Scopes:               []string{"openid", "invoices:read"},
+					AuthorizationDetails: domain.AuthorizationDetails{{"type": "payment_initiation"}},
+				},
+				LoginPolicy: &domain.LoginPolicy{
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, true},
+			[]domain.NextStep{&domain.ConsentStep{
+				ProjectID:            "projectID",
+				Scopes:               []string{"invoices:read"},
+				AuthorizationDetails: domain.AuthorizationDetails{{"type": "payment_initiation"}},
+			}},
+			nil,
+		},
 		{
 			"linking users, password step",
 			fields{
@@ -1541,6 +1761,7 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 				UserGrantProvider:         tt.fields.userGrantProvider,
 				ProjectProvider:           tt.fields.projectProvider,
 				ApplicationProvider:       tt.fields.applicationProvider,
+				ConsentProvider:           tt.fields.consentProvider,
 				LoginPolicyViewProvider:   tt.fields.loginPolicyProvider,
 				LockoutPolicyViewProvider: tt.fields.lockoutPolicyProvider,
 				IDPUserLinksProvider:      tt.fields.idpUserLinksProvider,
@@ -1557,10 +1778,11 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 
 func TestAuthRequestRepo_mfaChecked(t *testing.T) {
 	type args struct {
-		userSession *user_model.UserSessionView
-		request     *domain.AuthRequest
-		user        *user_model.UserView
-		isInternal  bool
+		userSession   *user_model.UserSessionView
+		request       *domain.AuthRequest
+		user          *user_model.UserView
+		isInternal    bool
+		trustedDevice trustedDeviceProvider
 	}
 	tests := []struct {
 		name        string
@@ -1569,17 +1791,84 @@ func TestAuthRequestRepo_mfaChecked(t *testing.T) {
 		wantChecked bool
 		errFunc     func(err error) bool
 	}{
-		//{
-		//	"required, prompt and false", //TODO: enable when LevelsOfAssurance is checked
-		//	fields{},
-		//	args{
-		//		request: &domain.AuthRequest{PossibleLOAs: []model.LevelOfAssurance{}},
-		//		user: &user_model.UserView{
-		//			OTPState: user_model.MFAStateReady,
-		//		},
-		//	},
-		//	false,
-		//},
+		{
+			"mfa requested, not set up, prompt required",
+			args{
+				request: &domain.AuthRequest{
+					PossibleLOAs: []domain.LevelOfAssurance{domain.LevelOfAssuranceMFA},
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:       []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+						MFAInitSkipLifetime: 30 * 24 * time.Hour,
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp:    domain.MFALevelNotSetUp,
+						MFAInitSkipped: testNow,
+					},
+				},
+				isInternal: true,
+			},
+			&domain.MFAPromptStep{
+				Required: true,
+				MFAProviders: []domain.MFAType{
+					domain.MFATypeTOTP,
+				},
+			},
+			false,
+			nil,
+		},
+		{
+			"phishing resistant requested, otp checked, u2f step",
+			args{
+				request: &domain.AuthRequest{
+					PossibleLOAs: []domain.LevelOfAssurance{domain.LevelOfAssurancePhishingResistant},
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP, domain.SecondFactorTypeU2F},
+						SecondFactorCheckLifetime: 18 * time.Hour,
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelSecondFactor,
+						OTPState:    user_model.MFAStateReady,
+						U2FTokens:   []*user_model.WebAuthNView{{TokenID: "id", State: user_model.MFAStateReady}},
+					},
+				},
+				userSession: &user_model.UserSessionView{
+					SecondFactorVerification:     testNow.Add(-5 * time.Hour),
+					SecondFactorVerificationType: domain.MFATypeTOTP,
+				},
+				isInternal: true,
+			},
+			&domain.MFAVerificationStep{
+				MFAProviders: []domain.MFAType{domain.MFATypeU2F},
+			},
+			false,
+			nil,
+		},
+		{
+			"phishing resistant requested, passwordless checked, true",
+			args{
+				request: &domain.AuthRequest{
+					PossibleLOAs: []domain.LevelOfAssurance{domain.LevelOfAssurancePhishingResistant},
+					MFAsVerified: []domain.MFAType{domain.MFATypeU2FUserVerification},
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors: []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelMultiFactor,
+					},
+				},
+				userSession: &user_model.UserSessionView{},
+				isInternal:  true,
+			},
+			nil,
+			true,
+			nil,
+		},
 		{
 			"not set up, forced by policy, no mfas configured, error",
 			args{
@@ -1736,6 +2025,113 @@ func TestAuthRequestRepo_mfaChecked(t *testing.T) {
 			false,
 			nil,
 		},
+		{
+			"not checked, trusted device, true",
+			args{
+				request: &domain.AuthRequest{
+					AgentID: "agentID",
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+						SecondFactorCheckLifetime: 18 * time.Hour,
+						TrustedDeviceLifetime:     30 * 24 * time.Hour,
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelSecondFactor,
+						OTPState:    user_model.MFAStateReady,
+					},
+				},
+				userSession:   &user_model.UserSessionView{},
+				isInternal:    true,
+				trustedDevice: &mockTrustedDevice{device: &query.TrustedDevice{Expiration: time.Now().Add(time.Hour)}},
+			},
+			nil,
+			true,
+			nil,
+		},
+		{
+			"not checked, trusted device expired, check and false",
+			args{
+				request: &domain.AuthRequest{
+					AgentID: "agentID",
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+						SecondFactorCheckLifetime: 18 * time.Hour,
+						TrustedDeviceLifetime:     30 * 24 * time.Hour,
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelSecondFactor,
+						OTPState:    user_model.MFAStateReady,
+					},
+				},
+				userSession:   &user_model.UserSessionView{},
+				isInternal:    true,
+				trustedDevice: &mockTrustedDevice{device: &query.TrustedDevice{Expiration: time.Now().Add(-time.Hour)}},
+			},
+			&domain.MFAVerificationStep{
+				MFAProviders: []domain.MFAType{domain.MFATypeTOTP},
+			},
+			false,
+			nil,
+		},
+		{
+			"not checked, trusted device revoked but not yet projected, check and false",
+			args{
+				request: &domain.AuthRequest{
+					AgentID: "agentID",
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+						SecondFactorCheckLifetime: 18 * time.Hour,
+						TrustedDeviceLifetime:     30 * 24 * time.Hour,
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelSecondFactor,
+						OTPState:    user_model.MFAStateReady,
+					},
+				},
+				userSession:   &user_model.UserSessionView{},
+				isInternal:    true,
+				trustedDevice: &mockTrustedDevice{outdated: &query.TrustedDevice{Expiration: time.Now().Add(time.Hour)}},
+			},
+			&domain.MFAVerificationStep{
+				MFAProviders: []domain.MFAType{domain.MFATypeTOTP},
+			},
+			false,
+			nil,
+		},
+		{
+			"not checked, trusted device but mfa requested, check and false",
+			args{
+				request: &domain.AuthRequest{
+					AgentID:      "agentID",
+					PossibleLOAs: []domain.LevelOfAssurance{domain.LevelOfAssuranceMFA},
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+						SecondFactorCheckLifetime: 18 * time.Hour,
+						TrustedDeviceLifetime:     30 * 24 * time.Hour,
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelSecondFactor,
+						OTPState:    user_model.MFAStateReady,
+					},
+				},
+				userSession:   &user_model.UserSessionView{},
+				isInternal:    true,
+				trustedDevice: &mockTrustedDevice{device: &query.TrustedDevice{Expiration: time.Now().Add(time.Hour)}},
+			},
+			&domain.MFAVerificationStep{
+				MFAProviders: []domain.MFAType{domain.MFATypeTOTP},
+			},
+			false,
+			nil,
+		},
 		{
 			"external not checked or forced but set up, want step",
 			args{
@@ -1835,8 +2231,10 @@ func TestAuthRequestRepo_mfaChecked(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			repo := &AuthRequestRepo{}
-			got, ok, err := repo.mfaChecked(tt.args.userSession, tt.args.request, tt.args.user, tt.args.isInternal)
+			repo := &AuthRequestRepo{
+				TrustedDeviceProvider: tt.args.trustedDevice,
+			}
+			got, ok, err := repo.mfaChecked(context.Background(), tt.args.userSession, tt.args.request, tt.args.user, tt.args.isInternal)
 			if (tt.errFunc != nil && !tt.errFunc(err)) || (err != nil && tt.errFunc == nil) {
 				t.Errorf("got wrong err: %v ", err)
 				return
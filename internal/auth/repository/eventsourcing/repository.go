@@ -2,6 +2,7 @@ package eventsourcing
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/auth/repository/eventsourcing/eventstore"
 	"github.com/zitadel/zitadel/internal/auth/repository/eventsourcing/spooler"
@@ -35,7 +36,7 @@ type EsRepository struct {
 }
 
 func Start(ctx context.Context, conf Config, systemDefaults sd.SystemDefaults, command *command.Commands, queries *query.Queries, dbClient *database.DB, esV2 *eventstore2.Eventstore, oidcEncryption crypto.EncryptionAlgorithm, userEncryption crypto.EncryptionAlgorithm, allowOrderByCreationDate bool) (*EsRepository, error) {
-	es, err := v1.Start(dbClient, allowOrderByCreationDate)
+	es, err := v1.Start(dbClient, allowOrderByCreationDate, esV2)
 	if err != nil {
 		return nil, err
 	}
@@ -68,6 +69,8 @@ func Start(ctx context.Context, conf Config, systemDefaults sd.SystemDefaults, c
 		userRepo,
 		eventstore.AuthRequestRepo{
 			PrivacyPolicyProvider:     queries,
+			TrustedDeviceProvider:     queries,
+			ConsentProvider:           queries,
 			LabelPolicyProvider:       queries,
 			Command:                   command,
 			Query:                     queries,
@@ -126,12 +129,20 @@ func (q queryViewWrapper) UserGrantsByProjectAndUserID(ctx context.Context, proj
 	if err != nil {
 		return nil, err
 	}
-	queries := &query.UserGrantsQueries{Queries: []query.SearchQuery{userGrantUserID, userGrantProjectID}}
+	userGrantValid, err := query.NewUserGrantValidAtQuery(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	queries := &query.UserGrantsQueries{Queries: []query.SearchQuery{userGrantUserID, userGrantProjectID, userGrantValid}}
 	grants, err := q.Queries.UserGrants(ctx, queries, true, false)
 	if err != nil {
 		return nil, err
 	}
-	return grants.UserGrants, nil
+	groupGrants, err := q.Queries.UserGroupGrants(ctx, true, userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return append(grants.UserGrants, groupGrants...), nil
 }
 func (repo *EsRepository) Health(ctx context.Context) error {
 	if err := repo.UserRepo.Health(ctx); err != nil {
@@ -15,6 +15,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	http_util "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/authz/repository/eventsourcing/view"
+	"github.com/zitadel/zitadel/internal/cache"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -22,6 +23,7 @@ import (
 	v1 "github.com/zitadel/zitadel/internal/eventstore/v1"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	usr_model "github.com/zitadel/zitadel/internal/user/model"
 	usr_view "github.com/zitadel/zitadel/internal/user/repository/view"
@@ -34,6 +36,8 @@ type TokenVerifierRepo struct {
 	View                 *view.View
 	Query                *query.Queries
 	ExternalSecure       bool
+	// TokenCache caches the tokens, which are invalidated by the events of their user
+	TokenCache *cache.Query[*usr_model.TokenView]
 }
 
 func (repo *TokenVerifierRepo) Health() error {
@@ -45,6 +49,16 @@ func (repo *TokenVerifierRepo) tokenByID(ctx context.Context, tokenID, userID st
 	defer func() { span.EndWithError(err) }()
 
 	instanceID := authz.GetInstance(ctx).InstanceID()
+	return repo.TokenCache.Get(ctx, instanceID+":"+userID+":"+tokenID, func(ctx context.Context) (*usr_model.TokenView, []cache.Aggregate, error) {
+		token, err := repo.tokenByIDs(ctx, instanceID, tokenID, userID)
+		if err != nil {
+			return nil, nil, err
+		}
+		return token, []cache.Aggregate{{InstanceID: instanceID, Type: user.AggregateType, ID: userID}}, nil
+	})
+}
+
+func (repo *TokenVerifierRepo) tokenByIDs(ctx context.Context, instanceID, tokenID, userID string) (_ *usr_model.TokenView, err error) {
 	token, viewErr := repo.View.TokenByIDs(tokenID, userID, instanceID)
 	if viewErr != nil && !caos_errs.IsNotFound(viewErr) {
 		return nil, viewErr
@@ -281,7 +295,7 @@ func (repo *TokenVerifierRepo) getTokenIDAndSubject(ctx context.Context, accessT
 func (repo *TokenVerifierRepo) jwtTokenVerifier(ctx context.Context) op.AccessTokenVerifier {
 	keySet := &openIDKeySet{repo.Query}
 	issuer := http_util.BuildOrigin(authz.GetInstance(ctx).RequestedHost(), repo.ExternalSecure)
-	return op.NewAccessTokenVerifier(issuer, keySet)
+	return op.NewAccessTokenVerifier(issuer, keySet, op.WithSupportedAccessTokenSigningAlgorithms(crypto.SigningAlgorithms()...))
 }
 
 func (repo *TokenVerifierRepo) decryptAccessToken(token string) (string, error) {
@@ -22,6 +22,12 @@ func (repo *UserMembershipRepo) SearchMyMemberships(ctx context.Context, orgID s
 	return userMembershipsToMemberships(memberships), nil
 }
 
+func (repo *UserMembershipRepo) CustomRoleMappings(ctx context.Context) (_ []authz.RoleMapping, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	return repo.Queries.CustomRoleMappings(ctx)
+}
+
 func (repo *UserMembershipRepo) searchUserMemberships(ctx context.Context, orgID string) (_ []*query.Membership, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -30,7 +36,12 @@ func (repo *UserMembershipRepo) searchUserMemberships(ctx context.Context, orgID
 	if err != nil {
 		return nil, err
 	}
-	orgIDsQuery, err := query.NewMembershipResourceOwnersSearchQuery(orgID, authz.GetInstance(ctx).InstanceID())
+	// members of the ancestors of the organization administer it as well
+	orgIDs, err := repo.Queries.OrgHierarchy(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	orgIDsQuery, err := query.NewMembershipResourceOwnersSearchQuery(append(orgIDs, authz.GetInstance(ctx).InstanceID())...)
 	if err != nil {
 		return nil, err
 	}
@@ -6,11 +6,13 @@ import (
 	"github.com/zitadel/zitadel/internal/authz/repository"
 	"github.com/zitadel/zitadel/internal/authz/repository/eventsourcing/eventstore"
 	authz_view "github.com/zitadel/zitadel/internal/authz/repository/eventsourcing/view"
+	"github.com/zitadel/zitadel/internal/cache"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/database"
 	v1 "github.com/zitadel/zitadel/internal/eventstore/v1"
 	"github.com/zitadel/zitadel/internal/id"
 	"github.com/zitadel/zitadel/internal/query"
+	usr_model "github.com/zitadel/zitadel/internal/user/model"
 )
 
 type EsRepository struct {
@@ -19,7 +21,7 @@ type EsRepository struct {
 }
 
 func Start(queries *query.Queries, dbClient *database.DB, keyEncryptionAlgorithm crypto.EncryptionAlgorithm, externalSecure, allowOrderByCreationDate bool) (repository.Repository, error) {
-	es, err := v1.Start(dbClient, allowOrderByCreationDate)
+	es, err := v1.Start(dbClient, allowOrderByCreationDate, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -40,6 +42,7 @@ func Start(queries *query.Queries, dbClient *database.DB, keyEncryptionAlgorithm
 			View:                 view,
 			Query:                queries,
 			ExternalSecure:       externalSecure,
+			TokenCache:           cache.NewQuery[*usr_model.TokenView](queries.Caches(), cache.QueryAccessToken),
 		},
 	}, nil
 }
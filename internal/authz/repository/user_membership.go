@@ -8,4 +8,5 @@ import (
 
 type UserMembershipRepository interface {
 	SearchMyMemberships(ctx context.Context, orgID string) ([]*authz.Membership, error)
+	CustomRoleMappings(ctx context.Context) ([]authz.RoleMapping, error)
 }
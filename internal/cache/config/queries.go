@@ -0,0 +1,59 @@
+package config
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/cache"
+	"github.com/zitadel/zitadel/internal/cache/memory"
+	"github.com/zitadel/zitadel/internal/cache/redis"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	QueryCacheTypeMemory = "memory"
+	QueryCacheTypeRedis  = "redis"
+)
+
+// QueryCachesConfig configures the cache of frequently executed queries.
+// If no Type is set, the queries are not cached
+type QueryCachesConfig struct {
+	Type   string
+	Memory *memory.Config
+	Redis  *redis.Config
+	// InvalidationDelay is the delay after which the results are invalidated a second time
+	InvalidationDelay time.Duration
+	TTL               cache.QueryTTLs
+}
+
+// Start returns nil if the caches are disabled.
+// The cached results are invalidated by the events of the aggregates until ctx is done
+func (c *QueryCachesConfig) Start(ctx context.Context, aggregates ...eventstore.AggregateType) (*cache.QueryCaches, error) {
+	if c == nil || c.Type == "" {
+		return nil, nil
+	}
+	var backend cache.Tagged
+	switch c.Type {
+	case QueryCacheTypeMemory:
+		config := c.Memory
+		if config == nil {
+			config = new(memory.Config)
+		}
+		backend = memory.NewMemory(config)
+	case QueryCacheTypeRedis:
+		if c.Redis == nil {
+			return nil, errors.ThrowInvalidArgument(nil, "CONFI-Rd8sk", "redis config missing")
+		}
+		var err error
+		backend, err = redis.NewRedis(c.Redis)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "CONFI-Qc3sd", "unsupported cache type %q", c.Type)
+	}
+	caches := cache.NewQueryCaches(backend, c.TTL)
+	caches.StartInvalidation(ctx, c.InvalidationDelay, aggregates...)
+	return caches, nil
+}
@@ -0,0 +1,69 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const invalidationQueueSize = 1000
+
+// StartInvalidation invalidates the cached results depending on the aggregates of pushed events until ctx is done.
+//
+// Events pushed by this process invalidate the results of their aggregate.
+// If the backend is not shared, the notifications of events pushed by other processes
+// (see [eventstore.Config.Notifications]) invalidate the results of the whole aggregate type of the instance.
+//
+// As the projections might not yet have processed the events, the results are invalidated again after the delay,
+// so that results read from outdated projections are not cached until they expire.
+func (c *QueryCaches) StartInvalidation(ctx context.Context, delay time.Duration, aggregates ...eventstore.AggregateType) {
+	if c == nil {
+		return
+	}
+	events := make(chan eventstore.Event, invalidationQueueSize)
+	subscription := eventstore.SubscribeAggregates(events, aggregates...)
+	var (
+		notifications            chan *eventstore.Notification
+		notificationSubscription *eventstore.NotificationSubscription
+	)
+	if !c.backend.Shared() {
+		notifications = make(chan *eventstore.Notification, invalidationQueueSize)
+		notificationSubscription = eventstore.SubscribeNotifications(notifications, aggregates...)
+	}
+	go func() {
+		defer subscription.Unsubscribe()
+		if notificationSubscription != nil {
+			defer notificationSubscription.Unsubscribe()
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-events:
+				aggregate := event.Aggregate()
+				c.invalidate(ctx, delay, aggregateTag(aggregate.InstanceID, string(aggregate.Type), aggregate.ID))
+			case notification := <-notifications:
+				tags := make([]string, len(notification.AggregateTypes))
+				for i, aggregateType := range notification.AggregateTypes {
+					tags[i] = aggregateTypeTag(notification.InstanceID, string(aggregateType))
+				}
+				c.invalidate(ctx, delay, tags...)
+			}
+		}
+	}()
+}
+
+func (c *QueryCaches) invalidate(ctx context.Context, delay time.Duration, tags ...string) {
+	err := c.backend.Invalidate(ctx, tags...)
+	logging.WithFields("tags", tags).OnError(err).Warn("unable to invalidate cache")
+	if delay <= 0 {
+		return
+	}
+	time.AfterFunc(delay, func() {
+		err := c.backend.Invalidate(ctx, tags...)
+		logging.WithFields("tags", tags).OnError(err).Warn("unable to invalidate cache")
+	})
+}
@@ -0,0 +1,6 @@
+package memory
+
+type Config struct {
+	// MaxEntries limits the amount of cached values, 0 means unlimited
+	MaxEntries int
+}
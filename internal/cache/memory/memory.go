@@ -0,0 +1,128 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+// Memory is an in-memory implementation of [cache.Tagged],
+// the values are only cached in the current process
+type Memory struct {
+	mutex      sync.Mutex
+	maxEntries int
+	entries    map[string]*entry
+	tags       map[string]map[string]struct{}
+	now        func() time.Time
+}
+
+type entry struct {
+	value     []byte
+	expiresAt time.Time
+	tags      []string
+}
+
+func NewMemory(config *Config) *Memory {
+	return &Memory{
+		maxEntries: config.MaxEntries,
+		entries:    make(map[string]*entry),
+		tags:       make(map[string]map[string]struct{}),
+		now:        time.Now,
+	}
+}
+
+func (m *Memory) Get(_ context.Context, key string) ([]byte, bool, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	e, ok := m.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	if !e.expiresAt.After(m.now()) {
+		m.delete(key)
+		return nil, false, nil
+	}
+	return e.value, true, nil
+}
+
+func (m *Memory) Set(_ context.Context, key string, value []byte, ttl time.Duration, tags ...string) error {
+	if key == "" || ttl <= 0 {
+		return errors.ThrowInvalidArgument(nil, "MEMOR-Cx8sk", "key and ttl must be set")
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.delete(key)
+	if m.maxEntries > 0 && len(m.entries) >= m.maxEntries {
+		m.evict()
+	}
+	m.entries[key] = &entry{
+		value:     value,
+		expiresAt: m.now().Add(ttl),
+		tags:      tags,
+	}
+	for _, tag := range tags {
+		if m.tags[tag] == nil {
+			m.tags[tag] = make(map[string]struct{})
+		}
+		m.tags[tag][key] = struct{}{}
+	}
+	return nil
+}
+
+func (m *Memory) Invalidate(_ context.Context, tags ...string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, tag := range tags {
+		for key := range m.tags[tag] {
+			m.delete(key)
+		}
+		delete(m.tags, tag)
+	}
+	return nil
+}
+
+func (m *Memory) Shared() bool {
+	return false
+}
+
+// delete removes the entry and its references from the tags
+func (m *Memory) delete(key string) {
+	e, ok := m.entries[key]
+	if !ok {
+		return
+	}
+	delete(m.entries, key)
+	for _, tag := range e.tags {
+		delete(m.tags[tag], key)
+		if len(m.tags[tag]) == 0 {
+			delete(m.tags, tag)
+		}
+	}
+}
+
+// evict removes the expired entries
+// and the entry expiring first if the cache is still full
+func (m *Memory) evict() {
+	now := m.now()
+	var (
+		first    string
+		firstExp time.Time
+	)
+	for key, e := range m.entries {
+		if !e.expiresAt.After(now) {
+			m.delete(key)
+			continue
+		}
+		if first == "" || e.expiresAt.Before(firstExp) {
+			first, firstExp = key, e.expiresAt
+		}
+	}
+	if len(m.entries) >= m.maxEntries {
+		m.delete(first)
+	}
+}
@@ -0,0 +1,61 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemory(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	cache := NewMemory(&Config{MaxEntries: 2})
+	cache.now = func() time.Time { return now }
+
+	if err := cache.Set(ctx, "key1", []byte("value1"), time.Minute, "tag1", "tag2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := cache.Set(ctx, "key2", []byte("value2"), time.Second, "tag2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value, ok, _ := cache.Get(ctx, "key1"); !ok || string(value) != "value1" {
+		t.Errorf("key1 must be cached, got %q", value)
+	}
+
+	now = now.Add(2 * time.Second)
+	if _, ok, _ := cache.Get(ctx, "key2"); ok {
+		t.Error("key2 must be expired")
+	}
+
+	if err := cache.Invalidate(ctx, "tag1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok, _ := cache.Get(ctx, "key1"); ok {
+		t.Error("key1 must be invalidated")
+	}
+	if len(cache.tags) != 0 {
+		t.Errorf("tags must be removed, got %v", cache.tags)
+	}
+
+	if err := cache.Set(ctx, "", []byte("value"), time.Minute); err == nil {
+		t.Error("empty key must fail")
+	}
+}
+
+func TestMemory_evict(t *testing.T) {
+	ctx := context.Background()
+	cache := NewMemory(&Config{MaxEntries: 2})
+
+	_ = cache.Set(ctx, "key1", []byte("value1"), time.Minute)
+	_ = cache.Set(ctx, "key2", []byte("value2"), time.Hour)
+	_ = cache.Set(ctx, "key3", []byte("value3"), time.Hour)
+
+	if _, ok, _ := cache.Get(ctx, "key1"); ok {
+		t.Error("key1 expires first and must be evicted")
+	}
+	for _, key := range []string{"key2", "key3"} {
+		if _, ok, _ := cache.Get(ctx, key); !ok {
+			t.Errorf("%s must be cached", key)
+		}
+	}
+}
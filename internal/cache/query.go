@@ -0,0 +1,128 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/zitadel/logging"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/zitadel/zitadel/internal/telemetry/metrics"
+)
+
+const (
+	QueryInstanceByHost = "instance_by_host"
+	QueryAccessToken    = "access_token"
+	QueryLoginPolicy    = "login_policy"
+	QueryLabelPolicy    = "label_policy"
+)
+
+var registerMetrics sync.Once
+
+// QueryTTLs are the durations the results of the queries are cached.
+// 0 disables the cache of the query
+type QueryTTLs struct {
+	InstanceByHost time.Duration
+	AccessToken    time.Duration
+	LoginPolicy    time.Duration
+	LabelPolicy    time.Duration
+}
+
+func (t *QueryTTLs) ttl(name string) time.Duration {
+	switch name {
+	case QueryInstanceByHost:
+		return t.InstanceByHost
+	case QueryAccessToken:
+		return t.AccessToken
+	case QueryLoginPolicy:
+		return t.LoginPolicy
+	case QueryLabelPolicy:
+		return t.LabelPolicy
+	default:
+		return 0
+	}
+}
+
+// QueryCaches cache the results of frequently executed queries in a [Tagged] backend
+type QueryCaches struct {
+	backend Tagged
+	ttls    QueryTTLs
+}
+
+func NewQueryCaches(backend Tagged, ttls QueryTTLs) *QueryCaches {
+	registerMetrics.Do(func() {
+		err := metrics.RegisterCounter(metrics.CacheHits, metrics.CacheHitsDescription)
+		logging.WithFields("metric", metrics.CacheHits).OnError(err).Panic("unable to register counter")
+		err = metrics.RegisterCounter(metrics.CacheMisses, metrics.CacheMissesDescription)
+		logging.WithFields("metric", metrics.CacheMisses).OnError(err).Panic("unable to register counter")
+	})
+	return &QueryCaches{
+		backend: backend,
+		ttls:    ttls,
+	}
+}
+
+// Query caches the json encoded results of a query
+type Query[T any] struct {
+	name    string
+	backend Tagged
+	ttl     time.Duration
+}
+
+// NewQuery returns nil if the caches are disabled or the ttl of the query is 0.
+// A nil query executes the query on every call
+func NewQuery[T any](caches *QueryCaches, name string) *Query[T] {
+	if caches == nil || caches.ttls.ttl(name) <= 0 {
+		return nil
+	}
+	return &Query[T]{
+		name:    name,
+		backend: caches.backend,
+		ttl:     caches.ttls.ttl(name),
+	}
+}
+
+// Get returns the cached result of the key or executes the query by calling load.
+// The result returned by load is cached until it expires or an event of the returned aggregates is pushed.
+// Failures of the cache are logged and the query is executed instead
+func (q *Query[T]) Get(ctx context.Context, key string, load func(ctx context.Context) (T, []Aggregate, error)) (result T, err error) {
+	if q == nil {
+		result, _, err = load(ctx)
+		return result, err
+	}
+	key = q.name + ":" + key
+	value, ok, err := q.backend.Get(ctx, key)
+	logging.WithFields("query", q.name).OnError(err).Warn("unable to read cache")
+	if ok {
+		if err = json.Unmarshal(value, &result); err == nil {
+			q.count(ctx, metrics.CacheHits)
+			return result, nil
+		}
+		logging.WithFields("query", q.name).WithError(err).Warn("unable to unmarshal cached result")
+	}
+	q.count(ctx, metrics.CacheMisses)
+
+	result, aggregates, err := load(ctx)
+	if err != nil {
+		return result, err
+	}
+	value, err = json.Marshal(result)
+	if err != nil {
+		logging.WithFields("query", q.name).WithError(err).Warn("unable to marshal result")
+		return result, nil
+	}
+	tags := make([]string, 0, 2*len(aggregates))
+	for _, aggregate := range aggregates {
+		tags = append(tags, aggregate.tags()...)
+	}
+	err = q.backend.Set(ctx, key, value, q.ttl, tags...)
+	logging.WithFields("query", q.name).OnError(err).Warn("unable to write cache")
+	return result, nil
+}
+
+func (q *Query[T]) count(ctx context.Context, metric string) {
+	err := metrics.AddCount(ctx, metric, 1, map[string]attribute.Value{metrics.CacheQuery: attribute.StringValue(q.name)})
+	logging.WithFields("metric", metric).OnError(err).Debug("unable to add count")
+}
@@ -0,0 +1,68 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/cache/memory"
+)
+
+type queryTestResult struct {
+	Name string
+}
+
+func TestQuery_Get(t *testing.T) {
+	ctx := context.Background()
+	caches := NewQueryCaches(memory.NewMemory(&memory.Config{}), QueryTTLs{LoginPolicy: time.Minute})
+	query := NewQuery[*queryTestResult](caches, QueryLoginPolicy)
+
+	var loaded int
+	load := func(context.Context) (*queryTestResult, []Aggregate, error) {
+		loaded++
+		return &queryTestResult{Name: "result"}, []Aggregate{{InstanceID: "instance", Type: "org", ID: "org1"}}, nil
+	}
+	for i := 0; i < 2; i++ {
+		result, err := query.Get(ctx, "key", load)
+		if err != nil || result.Name != "result" {
+			t.Fatalf("unexpected result %v: %v", result, err)
+		}
+	}
+	if loaded != 1 {
+		t.Errorf("cached result must only be loaded once, loaded %d times", loaded)
+	}
+
+	caches.invalidate(ctx, 0, aggregateTag("instance", "org", "org2"))
+	if _, _ = query.Get(ctx, "key", load); loaded != 1 {
+		t.Error("result must not be invalidated by other aggregates")
+	}
+	caches.invalidate(ctx, 0, aggregateTypeTag("instance", "org"))
+	if _, _ = query.Get(ctx, "key", load); loaded != 2 {
+		t.Error("result must be invalidated by its aggregate type")
+	}
+	caches.invalidate(ctx, 0, aggregateTag("instance", "org", "org1"))
+	if _, _ = query.Get(ctx, "key", load); loaded != 3 {
+		t.Error("result must be invalidated by its aggregate")
+	}
+}
+
+func TestNewQuery_disabled(t *testing.T) {
+	if query := NewQuery[*queryTestResult](nil, QueryLoginPolicy); query != nil {
+		t.Error("query must be disabled without caches")
+	}
+	caches := NewQueryCaches(memory.NewMemory(&memory.Config{}), QueryTTLs{LoginPolicy: time.Minute})
+	query := NewQuery[*queryTestResult](caches, QueryLabelPolicy)
+	if query != nil {
+		t.Fatal("query without ttl must be disabled")
+	}
+	var loaded int
+	for i := 0; i < 2; i++ {
+		_, _ = query.Get(context.Background(), "key", func(context.Context) (*queryTestResult, []Aggregate, error) {
+			loaded++
+			return &queryTestResult{}, nil, nil
+		})
+	}
+	if loaded != 2 {
+		t.Errorf("disabled query must always be loaded, loaded %d times", loaded)
+	}
+}
@@ -0,0 +1,20 @@
+package redis
+
+import "time"
+
+type Config struct {
+	// Address of the server in the form host:port
+	Address  string
+	Username string
+	Password string
+	DB       int
+	// KeyPrefix is prepended to all keys, so that multiple deployments can share a server
+	KeyPrefix string
+	TLS       bool
+	// Timeout of the dial of a new connection and of the reads and writes of a command
+	Timeout time.Duration
+	// PoolSize is the maximum of open connections, 0 defaults to 10 connections per CPU
+	PoolSize int
+	// MaxIdleConns limits the connections kept open for reuse
+	MaxIdleConns int
+}
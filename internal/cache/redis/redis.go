@@ -0,0 +1,130 @@
+package redis
+
+import (
+	"context"
+	"crypto/tls"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+const defaultTimeout = 3 * time.Second
+
+var (
+	// setScript sets the value (KEYS[1]) and adds its key to the sets of the tags (KEYS[2..]).
+	// The set of a tag expires with the longest ttl of its values.
+	setScript = redis.NewScript(`
+redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+for i = 2, #KEYS do
+	redis.call('SADD', KEYS[i], KEYS[1])
+	if redis.call('PTTL', KEYS[i]) < tonumber(ARGV[2]) then
+		redis.call('PEXPIRE', KEYS[i], ARGV[2])
+	end
+end
+return 1
+`)
+	// invalidateScript removes the values of the tags (KEYS) and the sets of the tags.
+	// The values are removed in chunks, because the arguments of a call are limited.
+	invalidateScript = redis.NewScript(`
+for _, tag in ipairs(KEYS) do
+	local keys = redis.call('SMEMBERS', tag)
+	for i = 1, #keys, 1000 do
+		redis.call('DEL', unpack(keys, i, math.min(i + 999, #keys)))
+	end
+	redis.call('DEL', tag)
+end
+return 1
+`)
+)
+
+// Redis is an implementation of [cache.Tagged] for servers speaking the Redis protocol.
+// The values of a tag are stored in a set, values and tags are changed atomically by scripts.
+// The scripts access the keys of the values stored in the sets, so Redis Cluster is not supported.
+type Redis struct {
+	client    *redis.Client
+	keyPrefix string
+}
+
+func NewRedis(config *Config) (*Redis, error) {
+	timeout := config.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+	options := &redis.Options{
+		Addr:         config.Address,
+		Username:     config.Username,
+		Password:     config.Password,
+		DB:           config.DB,
+		DialTimeout:  timeout,
+		ReadTimeout:  timeout,
+		WriteTimeout: timeout,
+		PoolSize:     config.PoolSize,
+		MaxIdleConns: config.MaxIdleConns,
+	}
+	if config.TLS {
+		options.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	r := &Redis{
+		client:    redis.NewClient(options),
+		keyPrefix: config.KeyPrefix,
+	}
+	if err := r.client.Ping(context.Background()).Err(); err != nil {
+		_ = r.client.Close()
+		return nil, errors.ThrowUnavailable(err, "REDIS-Dl2sk", "unable to connect")
+	}
+	return r, nil
+}
+
+func (r *Redis) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := r.client.Get(ctx, r.key(key)).Bytes()
+	if err == redis.Nil {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, errors.ThrowInternal(err, "REDIS-Rd2sk", "unable to get value")
+	}
+	return value, true, nil
+}
+
+func (r *Redis) Set(ctx context.Context, key string, value []byte, ttl time.Duration, tags ...string) error {
+	if key == "" || ttl <= 0 {
+		return errors.ThrowInvalidArgument(nil, "REDIS-Vm3ds", "key and ttl must be set")
+	}
+	keys := make([]string, 0, len(tags)+1)
+	keys = append(keys, r.key(key))
+	for _, tag := range tags {
+		keys = append(keys, r.tagKey(tag))
+	}
+	if err := setScript.Run(ctx, r.client, keys, value, ttl.Milliseconds()).Err(); err != nil {
+		return errors.ThrowInternal(err, "REDIS-Wr3sk", "unable to set value")
+	}
+	return nil
+}
+
+func (r *Redis) Invalidate(ctx context.Context, tags ...string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	keys := make([]string, len(tags))
+	for i, tag := range tags {
+		keys[i] = r.tagKey(tag)
+	}
+	if err := invalidateScript.Run(ctx, r.client, keys).Err(); err != nil {
+		return errors.ThrowInternal(err, "REDIS-Wr4sk", "unable to invalidate tags")
+	}
+	return nil
+}
+
+func (r *Redis) Shared() bool {
+	return true
+}
+
+func (r *Redis) key(key string) string {
+	return r.keyPrefix + "cache:" + key
+}
+
+func (r *Redis) tagKey(tag string) string {
+	return r.keyPrefix + "tag:" + tag
+}
@@ -0,0 +1,111 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+)
+
+func TestRedis(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	server.RequireAuth("secret")
+	cache, err := NewRedis(&Config{
+		Address:      server.Addr(),
+		Password:     "secret",
+		KeyPrefix:    "zitadel:",
+		MaxIdleConns: 1,
+	})
+	if err != nil {
+		t.Fatalf("unable to connect: %v", err)
+	}
+
+	if err = cache.Set(ctx, "key1", []byte("value1"), time.Minute, "tag1", "tag2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err = cache.Set(ctx, "key2", []byte("value2"), time.Minute, "tag2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value, ok, err := cache.Get(ctx, "key1"); err != nil || !ok || string(value) != "value1" {
+		t.Errorf("key1 must be cached, got %q, %v", value, err)
+	}
+	if !server.Exists("zitadel:cache:key1") {
+		t.Error("key must be prefixed")
+	}
+	if _, ok, err := cache.Get(ctx, "unknown"); err != nil || ok {
+		t.Errorf("unknown key must not be cached, got %v", err)
+	}
+
+	if err = cache.Invalidate(ctx, "tag1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok, _ := cache.Get(ctx, "key1"); ok {
+		t.Error("key1 must be invalidated")
+	}
+	if server.Exists("zitadel:tag:tag1") {
+		t.Error("tag1 must be removed")
+	}
+	if _, ok, _ := cache.Get(ctx, "key2"); !ok {
+		t.Error("key2 must still be cached")
+	}
+	if err = cache.Invalidate(ctx, "tag2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok, _ := cache.Get(ctx, "key2"); ok {
+		t.Error("key2 must be invalidated")
+	}
+}
+
+func TestNewRedis_wrongPassword(t *testing.T) {
+	server := miniredis.RunT(t)
+	server.RequireAuth("secret")
+	if _, err := NewRedis(&Config{Address: server.Addr(), Password: "wrong"}); err == nil {
+		t.Error("connection with wrong password must fail")
+	}
+}
+
+func TestRedis_Set_ttl(t *testing.T) {
+	server := miniredis.RunT(t)
+	cache, err := NewRedis(&Config{Address: server.Addr()})
+	if err != nil {
+		t.Fatalf("unable to connect: %v", err)
+	}
+	if err = cache.Set(context.Background(), "key", []byte("value"), time.Hour, "tag"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err = cache.Set(context.Background(), "other", []byte("value"), time.Minute, "tag"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ttl := server.TTL("tag:tag"); ttl < 59*time.Minute {
+		t.Errorf("tag must not expire before the longest ttl, expires in %s", ttl)
+	}
+	if ttl := server.TTL("cache:other"); ttl != time.Minute {
+		t.Errorf("value must expire after its ttl, expires in %s", ttl)
+	}
+	if err = cache.Set(context.Background(), "", []byte("value"), time.Minute); err == nil {
+		t.Error("empty key must fail")
+	}
+}
+
+func TestRedis_Invalidate_manyValues(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	cache, err := NewRedis(&Config{Address: server.Addr()})
+	if err != nil {
+		t.Fatalf("unable to connect: %v", err)
+	}
+	for i := 0; i < 2500; i++ {
+		if err = cache.Set(ctx, "key"+strconv.Itoa(i), []byte("value"), time.Minute, "tag"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if err = cache.Invalidate(ctx, "tag"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if keys := server.Keys(); len(keys) != 0 {
+		t.Errorf("all values must be invalidated, %d keys remain", len(keys))
+	}
+}
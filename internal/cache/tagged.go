@@ -0,0 +1,40 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// Tagged caches byte values which expire after their ttl.
+// The values are tagged on set, so that all values of a tag can be invalidated at once.
+type Tagged interface {
+	// Get returns false if the key is not cached or expired
+	Get(ctx context.Context, key string) (value []byte, ok bool, err error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration, tags ...string) error
+	// Invalidate removes the values of the tags
+	Invalidate(ctx context.Context, tags ...string) error
+	// Shared returns true if the values are shared by all ZITADEL processes,
+	// so that an invalidation of one process is seen by all others
+	Shared() bool
+}
+
+// Aggregate identifies an aggregate whose events invalidate a cached value
+type Aggregate struct {
+	InstanceID string
+	Type       string
+	ID         string
+}
+
+// tags returns the tag of the aggregate and of its aggregate type,
+// events are either invalidated by their aggregate or by their aggregate type if the id is unknown
+func (a Aggregate) tags() []string {
+	return []string{aggregateTag(a.InstanceID, a.Type, a.ID), aggregateTypeTag(a.InstanceID, a.Type)}
+}
+
+func aggregateTag(instanceID, aggregateType, aggregateID string) string {
+	return aggregateTypeTag(instanceID, aggregateType) + "/" + aggregateID
+}
+
+func aggregateTypeTag(instanceID, aggregateType string) string {
+	return instanceID + "/" + aggregateType
+}
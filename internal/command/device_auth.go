@@ -12,6 +12,23 @@ import (
 )
 
 func (c *Commands) AddDeviceAuth(ctx context.Context, clientID, deviceCode, userCode string, expires time.Time, scopes []string) (string, *domain.ObjectDetails, error) {
+	return c.addDeviceAuth(ctx, clientID, deviceCode, userCode, expires, scopes, nil)
+}
+
+// AddBackchannelAuth adds a client initiated backchannel authentication request (CIBA) for the user of the backchannel information.
+// The request is tracked as device authorization, where the auth_req_id returned to the client is used as device code.
+// The user code allows the user to approve the request through the device authorization flow of the login UI.
+func (c *Commands) AddBackchannelAuth(ctx context.Context, clientID, authReqID, userCode string, expires time.Time, scopes []string, backchannel *domain.BackchannelAuth) (string, *domain.ObjectDetails, error) {
+	if backchannel == nil || backchannel.UserID == "" {
+		return "", nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Bc1ua", "Errors.User.UserIDMissing")
+	}
+	if backchannel.IsPing() && (backchannel.NotificationEndpoint == "" || backchannel.ClientNotificationToken == "") {
+		return "", nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Bc2pn", "Errors.DeviceAuth.Backchannel.NotificationMissing")
+	}
+	return c.addDeviceAuth(ctx, clientID, authReqID, userCode, expires, scopes, backchannel)
+}
+
+func (c *Commands) addDeviceAuth(ctx context.Context, clientID, deviceCode, userCode string, expires time.Time, scopes []string, backchannel *domain.BackchannelAuth) (string, *domain.ObjectDetails, error) {
 	aggrID, err := c.idGenerator.Next()
 	if err != nil {
 		return "", nil, err
@@ -20,7 +37,7 @@ func (c *Commands) AddDeviceAuth(ctx context.Context, clientID, deviceCode, user
 	aggr := deviceauth.NewAggregate(aggrID, authz.GetInstance(ctx).InstanceID())
 	model := NewDeviceAuthWriteModel(aggrID, aggr.ResourceOwner)
 
-	pushedEvents, err := c.eventstore.Push(ctx, deviceauth.NewAddedEvent(
+	addedEvent := deviceauth.NewAddedEvent(
 		ctx,
 		aggr,
 		clientID,
@@ -28,7 +45,9 @@ func (c *Commands) AddDeviceAuth(ctx context.Context, clientID, deviceCode, user
 		userCode,
 		expires,
 		scopes,
-	))
+	)
+	addedEvent.Backchannel = backchannel
+	pushedEvents, err := c.eventstore.Push(ctx, addedEvent)
 	if err != nil {
 		return "", nil, err
 	}
@@ -48,6 +67,10 @@ func (c *Commands) ApproveDeviceAuth(ctx context.Context, id, subject string) (*
 	if !model.State.Exists() {
 		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Hief9", "Errors.DeviceAuth.NotFound")
 	}
+	// backchannel authentication requests can only be approved by the user the client requested the authentication for
+	if model.Backchannel != nil && model.Backchannel.UserID != subject {
+		return nil, caos_errs.ThrowPermissionDenied(nil, "COMMAND-Bc4us", "Errors.DeviceAuth.Backchannel.WrongUser")
+	}
 	aggr := deviceauth.NewAggregate(model.AggregateID, model.InstanceID)
 
 	pushedEvents, err := c.eventstore.Push(ctx, deviceauth.NewApprovedEvent(ctx, aggr, subject))
@@ -103,6 +126,75 @@ func (c *Commands) RemoveDeviceAuth(ctx context.Context, id string) (*domain.Obj
 	return writeModelToObjectDetails(&model.WriteModel), nil
 }
 
+// ApproveBackchannelAuthWithSession approves a backchannel authentication request (CIBA)
+// for the user authenticated by the provided session.
+func (c *Commands) ApproveBackchannelAuthWithSession(ctx context.Context, id, sessionID, sessionToken string) (*domain.ObjectDetails, error) {
+	model, err := c.pendingBackchannelAuthForSession(ctx, id, sessionID, sessionToken)
+	if err != nil {
+		return nil, err
+	}
+	aggr := deviceauth.NewAggregate(model.AggregateID, model.InstanceID)
+	if err = c.pushAppendAndReduce(ctx, model, deviceauth.NewApprovedEvent(ctx, aggr, model.Backchannel.UserID)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&model.WriteModel), nil
+}
+
+// DenyBackchannelAuthWithSession denies a backchannel authentication request (CIBA)
+// in the name of the user authenticated by the provided session.
+func (c *Commands) DenyBackchannelAuthWithSession(ctx context.Context, id, sessionID, sessionToken string) (*domain.ObjectDetails, error) {
+	model, err := c.pendingBackchannelAuthForSession(ctx, id, sessionID, sessionToken)
+	if err != nil {
+		return nil, err
+	}
+	aggr := deviceauth.NewAggregate(model.AggregateID, model.InstanceID)
+	if err = c.pushAppendAndReduce(ctx, model, deviceauth.NewCanceledEvent(ctx, aggr, domain.DeviceAuthCanceledDenied)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&model.WriteModel), nil
+}
+
+// pendingBackchannelAuthForSession returns the backchannel authentication request, if it's still pending
+// and the provided session belongs to the user the client requested the authentication for.
+func (c *Commands) pendingBackchannelAuthForSession(ctx context.Context, id, sessionID, sessionToken string) (*DeviceAuthWriteModel, error) {
+	model, err := c.getDeviceAuthWriteModelByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if model.State != domain.DeviceAuthStateInitiated || model.Backchannel == nil {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Bc5nf", "Errors.DeviceAuth.NotFound")
+	}
+	sessionWriteModel := NewSessionWriteModel(sessionID, authz.GetCtxData(ctx).OrgID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, sessionWriteModel); err != nil {
+		return nil, err
+	}
+	if sessionWriteModel.State == domain.SessionStateUnspecified {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Bc6sn", "Errors.Session.NotExisting")
+	}
+	if err = c.sessionPermission(ctx, sessionWriteModel, sessionToken, domain.PermissionSessionWrite); err != nil {
+		return nil, err
+	}
+	if sessionWriteModel.UserID != model.Backchannel.UserID {
+		return nil, caos_errs.ThrowPermissionDenied(nil, "COMMAND-Bc7us", "Errors.DeviceAuth.Backchannel.WrongUser")
+	}
+	return model, nil
+}
+
+// BackchannelAuthNotificationSent marks the notification of the user (initiated state)
+// or the ping of the client (approved or denied state) as sent.
+func (c *Commands) BackchannelAuthNotificationSent(ctx context.Context, id string, state domain.DeviceAuthState) error {
+	model, err := c.getDeviceAuthWriteModelByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if model.Backchannel == nil {
+		return caos_errs.ThrowNotFound(nil, "COMMAND-Bc8nf", "Errors.DeviceAuth.NotFound")
+	}
+	aggr := deviceauth.NewAggregate(model.AggregateID, model.InstanceID)
+	_, err = c.eventstore.Push(ctx, deviceauth.NewNotificationSentEvent(ctx, aggr, state))
+	return err
+}
+
 func (c *Commands) getDeviceAuthWriteModelByID(ctx context.Context, id string) (*DeviceAuthWriteModel, error) {
 	model := &DeviceAuthWriteModel{WriteModel: eventstore.WriteModel{AggregateID: id}}
 	err := c.eventstore.FilterToQueryReducer(ctx, model)
@@ -18,6 +18,8 @@ type DeviceAuthWriteModel struct {
 	Scopes     []string
 	Subject    string
 	State      domain.DeviceAuthState
+
+	Backchannel *domain.BackchannelAuth
 }
 
 func NewDeviceAuthWriteModel(aggrID, resourceOwner string) *DeviceAuthWriteModel {
@@ -39,6 +41,7 @@ func (m *DeviceAuthWriteModel) Reduce() error {
 			m.Expires = e.Expires
 			m.Scopes = e.Scopes
 			m.State = e.State
+			m.Backchannel = e.Backchannel
 		case *deviceauth.ApprovedEvent:
 			m.Subject = e.Subject
 			m.State = domain.DeviceAuthStateApproved
@@ -18,6 +18,7 @@ import (
 	"github.com/zitadel/zitadel/internal/id"
 	id_mock "github.com/zitadel/zitadel/internal/id/mock"
 	"github.com/zitadel/zitadel/internal/repository/deviceauth"
+	"github.com/zitadel/zitadel/internal/repository/session"
 )
 
 func TestCommands_AddDeviceAuth(t *testing.T) {
@@ -187,6 +188,19 @@ func TestCommands_ApproveDeviceAuth(t *testing.T) {
 			args:    args{ctx, "1999", "subj"},
 			wantErr: caos_errs.ThrowNotFound(nil, "COMMAND-Hief9", "Errors.DeviceAuth.NotFound"),
 		},
+		{
+			name: "backchannel wrong user error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(eventFromEventPusherWithInstanceID(
+						"instance1",
+						newBackchannelAuthAddedEvent(ctx, now, &domain.BackchannelAuth{UserID: "user1"}),
+					)),
+				),
+			},
+			args:    args{ctx, "1999", "subj"},
+			wantErr: caos_errs.ThrowPermissionDenied(nil, "COMMAND-Bc4us", "Errors.DeviceAuth.Backchannel.WrongUser"),
+		},
 		{
 			name: "push error",
 			fields: fields{
@@ -479,3 +493,234 @@ func TestCommands_RemoveDeviceAuth(t *testing.T) {
 		})
 	}
 }
+
+func newBackchannelAuthAddedEvent(ctx context.Context, expires time.Time, backchannel *domain.BackchannelAuth) *deviceauth.AddedEvent {
+	event := deviceauth.NewAddedEvent(
+		ctx,
+		deviceauth.NewAggregate("1999", "instance1"),
+		"client_id", "123", "456", expires,
+		[]string{"openid"},
+	)
+	event.Backchannel = backchannel
+	return event
+}
+
+func TestCommands_AddBackchannelAuth(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	now := time.Now()
+
+	unique := deviceauth.NewAddUniqueConstraints("client_id", "123", "456")
+	require.Len(t, unique, 2)
+
+	type fields struct {
+		eventstore  *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx         context.Context
+		backchannel *domain.BackchannelAuth
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		args        args
+		wantID      string
+		wantDetails *domain.ObjectDetails
+		wantErr     error
+	}{
+		{
+			name: "missing user error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx:         ctx,
+				backchannel: &domain.BackchannelAuth{},
+			},
+			wantErr: caos_errs.ThrowInvalidArgument(nil, "COMMAND-Bc1ua", "Errors.User.UserIDMissing"),
+		},
+		{
+			name: "ping without client notification token error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: ctx,
+				backchannel: &domain.BackchannelAuth{
+					UserID:               "user1",
+					DeliveryMode:         domain.OIDCBackchannelTokenDeliveryModePing,
+					NotificationEndpoint: "https://client.com/ciba",
+				},
+			},
+			wantErr: caos_errs.ThrowInvalidArgument(nil, "COMMAND-Bc2pn", "Errors.DeviceAuth.Backchannel.NotificationMissing"),
+		},
+		{
+			name: "success",
+			fields: fields{
+				eventstore: eventstoreExpect(t, expectPush(
+					[]*repository.Event{
+						eventFromEventPusherWithInstanceID("instance1", newBackchannelAuthAddedEvent(ctx, now, &domain.BackchannelAuth{
+							UserID:                  "user1",
+							BindingMessage:          "message",
+							DeliveryMode:            domain.OIDCBackchannelTokenDeliveryModePing,
+							NotificationEndpoint:    "https://client.com/ciba",
+							ClientNotificationToken: "token",
+						})),
+					},
+					uniqueConstraintsFromEventConstraintWithInstanceID("instance1", unique[0]),
+					uniqueConstraintsFromEventConstraintWithInstanceID("instance1", unique[1]),
+				)),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "1999"),
+			},
+			args: args{
+				ctx: ctx,
+				backchannel: &domain.BackchannelAuth{
+					UserID:                  "user1",
+					BindingMessage:          "message",
+					DeliveryMode:            domain.OIDCBackchannelTokenDeliveryModePing,
+					NotificationEndpoint:    "https://client.com/ciba",
+					ClientNotificationToken: "token",
+				},
+			},
+			wantID: "1999",
+			wantDetails: &domain.ObjectDetails{
+				ResourceOwner: "instance1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore,
+				idGenerator: tt.fields.idGenerator,
+			}
+			gotID, gotDetails, err := c.AddBackchannelAuth(tt.args.ctx, "client_id", "123", "456", now, []string{"openid"}, tt.args.backchannel)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, gotID, tt.wantID)
+			assert.Equal(t, gotDetails, tt.wantDetails)
+		})
+	}
+}
+
+func TestCommands_ApproveBackchannelAuthWithSession(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "user1")
+	now := time.Now()
+
+	type fields struct {
+		eventstore      *eventstore.Eventstore
+		tokenVerifier   func(ctx context.Context, sessionToken, sessionID, tokenID string) (err error)
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx          context.Context
+		id           string
+		sessionID    string
+		sessionToken string
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		args        args
+		wantDetails *domain.ObjectDetails
+		wantErr     error
+	}{
+		{
+			name: "no backchannel request error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(eventFromEventPusherWithInstanceID(
+						"instance1",
+						newBackchannelAuthAddedEvent(ctx, now, nil),
+					)),
+				),
+			},
+			args:    args{ctx, "1999", "sessionID", "token"},
+			wantErr: caos_errs.ThrowNotFound(nil, "COMMAND-Bc5nf", "Errors.DeviceAuth.NotFound"),
+		},
+		{
+			name: "session not existing error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(eventFromEventPusherWithInstanceID(
+						"instance1",
+						newBackchannelAuthAddedEvent(ctx, now, &domain.BackchannelAuth{UserID: "user1"}),
+					)),
+					expectFilter(),
+				),
+			},
+			args:    args{ctx, "1999", "sessionID", "token"},
+			wantErr: caos_errs.ThrowNotFound(nil, "COMMAND-Bc6sn", "Errors.Session.NotExisting"),
+		},
+		{
+			name: "wrong user error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(eventFromEventPusherWithInstanceID(
+						"instance1",
+						newBackchannelAuthAddedEvent(ctx, now, &domain.BackchannelAuth{UserID: "user1"}),
+					)),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(ctx, &session.NewAggregate("sessionID", "org1").Aggregate),
+						),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(ctx, &session.NewAggregate("sessionID", "org1").Aggregate,
+								"user2", now),
+						),
+					),
+				),
+				tokenVerifier: func(ctx context.Context, sessionToken, sessionID, tokenID string) (err error) {
+					return nil
+				},
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args:    args{ctx, "1999", "sessionID", "token"},
+			wantErr: caos_errs.ThrowPermissionDenied(nil, "COMMAND-Bc7us", "Errors.DeviceAuth.Backchannel.WrongUser"),
+		},
+		{
+			name: "success",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(eventFromEventPusherWithInstanceID(
+						"instance1",
+						newBackchannelAuthAddedEvent(ctx, now, &domain.BackchannelAuth{UserID: "user1"}),
+					)),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(ctx, &session.NewAggregate("sessionID", "org1").Aggregate),
+						),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(ctx, &session.NewAggregate("sessionID", "org1").Aggregate,
+								"user1", now),
+						),
+					),
+					expectPush([]*repository.Event{eventFromEventPusherWithInstanceID(
+						"instance1", deviceauth.NewApprovedEvent(
+							ctx, deviceauth.NewAggregate("1999", "instance1"), "user1",
+						),
+					)}),
+				),
+				tokenVerifier: func(ctx context.Context, sessionToken, sessionID, tokenID string) (err error) {
+					return nil
+				},
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{ctx, "1999", "sessionID", "token"},
+			wantDetails: &domain.ObjectDetails{
+				ResourceOwner: "instance1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:           tt.fields.eventstore,
+				sessionTokenVerifier: tt.fields.tokenVerifier,
+				checkPermission:      tt.fields.checkPermission,
+			}
+			gotDetails, err := c.ApproveBackchannelAuthWithSession(tt.args.ctx, tt.args.id, tt.args.sessionID, tt.args.sessionToken)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, gotDetails, tt.wantDetails)
+		})
+	}
+}
@@ -0,0 +1,391 @@
+package command
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+func (c *Commands) AddGroup(ctx context.Context, addGroup *domain.Group, resourceOwner string) (_ string, _ *domain.ObjectDetails, err error) {
+	if !addGroup.IsValid() || resourceOwner == "" {
+		return "", nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Gr2ka", "Errors.Group.Invalid")
+	}
+	groupID, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+
+	groupModel := NewGroupWriteModel(groupID, resourceOwner)
+	groupAgg := GroupAggregateFromWriteModel(&groupModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, group.NewAddedEvent(
+		ctx,
+		groupAgg,
+		addGroup.Name,
+		addGroup.Description,
+	))
+	if err != nil {
+		return "", nil, err
+	}
+	err = AppendAndReduce(groupModel, pushedEvents...)
+	if err != nil {
+		return "", nil, err
+	}
+	return groupModel.AggregateID, writeModelToObjectDetails(&groupModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeGroup(ctx context.Context, groupChange *domain.Group, resourceOwner string) (*domain.ObjectDetails, error) {
+	if !groupChange.IsValid() || groupChange.AggregateID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Gr8sw", "Errors.Group.Invalid")
+	}
+
+	existingGroup, err := c.getGroupWriteModelByID(ctx, groupChange.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingGroup.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Gr5nb", "Errors.Group.NotFound")
+	}
+
+	groupAgg := GroupAggregateFromWriteModel(&existingGroup.WriteModel)
+	changedEvent, err := existingGroup.NewChangedEvent(ctx, groupAgg, groupChange.Name, groupChange.Description)
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingGroup, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingGroup.WriteModel), nil
+}
+
+func (c *Commands) RemoveGroup(ctx context.Context, groupID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if groupID == "" || resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Gr0lp", "Errors.IDMissing")
+	}
+
+	existingGroup, err := c.getGroupWriteModelByID(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingGroup.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Gr3vc", "Errors.Group.NotFound")
+	}
+	groupAgg := GroupAggregateFromWriteModel(&existingGroup.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, group.NewRemovedEvent(ctx, groupAgg, existingGroup.Name))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingGroup, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingGroup.WriteModel), nil
+}
+
+// AddGroupUser adds a user of the organization to the group
+func (c *Commands) AddGroupUser(ctx context.Context, groupID, userID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if groupID == "" || userID == "" || resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Gu4mw", "Errors.IDMissing")
+	}
+	existingGroup, err := c.getGroupWriteModelByID(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingGroup.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Gu8aq", "Errors.Group.NotFound")
+	}
+	if existingGroup.hasUser(userID) {
+		return nil, caos_errs.ThrowAlreadyExists(nil, "COMMAND-Gu2fd", "Errors.Group.User.AlreadyExists")
+	}
+	if err = c.checkUserExists(ctx, userID, resourceOwner); err != nil {
+		return nil, err
+	}
+	groupAgg := GroupAggregateFromWriteModel(&existingGroup.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, group.NewUserAddedEvent(ctx, groupAgg, userID))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingGroup, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingGroup.WriteModel), nil
+}
+
+func (c *Commands) RemoveGroupUser(ctx context.Context, groupID, userID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if groupID == "" || userID == "" || resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Gu6xn", "Errors.IDMissing")
+	}
+	existingGroup, err := c.getGroupWriteModelByID(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingGroup.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Gu1ks", "Errors.Group.NotFound")
+	}
+	if !existingGroup.hasUser(userID) {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Gu9ye", "Errors.Group.User.NotFound")
+	}
+	groupAgg := GroupAggregateFromWriteModel(&existingGroup.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, group.NewUserRemovedEvent(ctx, groupAgg, userID))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingGroup, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingGroup.WriteModel), nil
+}
+
+// AddGroupGrant grants roles of a project of the organization
+// or of a project granted to the organization to all users of the group
+func (c *Commands) AddGroupGrant(ctx context.Context, grant *domain.GroupGrant, resourceOwner string) (*domain.ObjectDetails, error) {
+	if !grant.IsValid() || resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Gg3nf", "Errors.Group.Grant.Invalid")
+	}
+	existingGroup, err := c.getGroupWriteModelByID(ctx, grant.GroupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingGroup.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Gg7xm", "Errors.Group.NotFound")
+	}
+	if _, ok := existingGroup.Grants[groupGrantKey(grant.ProjectID, grant.GrantID)]; ok {
+		return nil, caos_errs.ThrowAlreadyExists(nil, "COMMAND-Gg1vb", "Errors.Group.Grant.AlreadyExists")
+	}
+	if err = c.checkGroupGrantPreCondition(ctx, grant, resourceOwner); err != nil {
+		return nil, err
+	}
+	groupAgg := GroupAggregateFromWriteModel(&existingGroup.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, group.NewGrantAddedEvent(ctx, groupAgg, grant.ProjectID, grant.GrantID, grant.RoleKeys))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingGroup, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingGroup.WriteModel), nil
+}
+
+// ChangeGroupGrant replaces the roles granted to the group
+func (c *Commands) ChangeGroupGrant(ctx context.Context, grant *domain.GroupGrant, resourceOwner string) (*domain.ObjectDetails, error) {
+	if !grant.IsValid() || resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Gg5wq", "Errors.Group.Grant.Invalid")
+	}
+	existingGroup, err := c.getGroupWriteModelByID(ctx, grant.GroupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingGroup.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Gg2ol", "Errors.Group.NotFound")
+	}
+	existingRoles, ok := existingGroup.Grants[groupGrantKey(grant.ProjectID, grant.GrantID)]
+	if !ok {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Gg8dh", "Errors.Group.Grant.NotFound")
+	}
+	if reflect.DeepEqual(existingRoles, grant.RoleKeys) {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Gg4ra", "Errors.Group.Grant.NotChanged")
+	}
+	if err = c.checkGroupGrantPreCondition(ctx, grant, resourceOwner); err != nil {
+		return nil, err
+	}
+	groupAgg := GroupAggregateFromWriteModel(&existingGroup.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, group.NewGrantChangedEvent(ctx, groupAgg, grant.ProjectID, grant.GrantID, grant.RoleKeys))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingGroup, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingGroup.WriteModel), nil
+}
+
+func (c *Commands) RemoveGroupGrant(ctx context.Context, groupID, projectID, projectGrantID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if groupID == "" || projectID == "" || resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Gg6mc", "Errors.IDMissing")
+	}
+	existingGroup, err := c.getGroupWriteModelByID(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingGroup.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Gg9up", "Errors.Group.NotFound")
+	}
+	if _, ok := existingGroup.Grants[groupGrantKey(projectID, projectGrantID)]; !ok {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Gg0ei", "Errors.Group.Grant.NotFound")
+	}
+	groupAgg := GroupAggregateFromWriteModel(&existingGroup.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, group.NewGrantRemovedEvent(ctx, groupAgg, projectID, projectGrantID))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingGroup, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingGroup.WriteModel), nil
+}
+
+// AddGroupMembership makes all users of the group members of the organization
+// or, if the project id is set, of the project.
+// The caller must be allowed to manage the members of the organization or project.
+func (c *Commands) AddGroupMembership(ctx context.Context, membership *domain.GroupMembership, resourceOwner string) (*domain.ObjectDetails, error) {
+	if !membership.IsValid() || resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Gm2ks", "Errors.Group.Membership.Invalid")
+	}
+	if err := c.checkGroupMembershipPermission(ctx, membership.ProjectID, resourceOwner); err != nil {
+		return nil, err
+	}
+	existingGroup, err := c.getGroupWriteModelByID(ctx, membership.GroupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingGroup.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Gm6fa", "Errors.Group.NotFound")
+	}
+	if _, ok := existingGroup.Memberships[membership.ProjectID]; ok {
+		return nil, caos_errs.ThrowAlreadyExists(nil, "COMMAND-Gm9wd", "Errors.Group.Membership.AlreadyExists")
+	}
+	if err = c.checkGroupMembershipPreCondition(ctx, membership, resourceOwner); err != nil {
+		return nil, err
+	}
+	groupAgg := GroupAggregateFromWriteModel(&existingGroup.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, group.NewMembershipAddedEvent(ctx, groupAgg, membership.ProjectID, membership.Roles))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingGroup, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingGroup.WriteModel), nil
+}
+
+func (c *Commands) ChangeGroupMembership(ctx context.Context, membership *domain.GroupMembership, resourceOwner string) (*domain.ObjectDetails, error) {
+	if !membership.IsValid() || resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Gm4ox", "Errors.Group.Membership.Invalid")
+	}
+	if err := c.checkGroupMembershipPermission(ctx, membership.ProjectID, resourceOwner); err != nil {
+		return nil, err
+	}
+	existingGroup, err := c.getGroupWriteModelByID(ctx, membership.GroupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingGroup.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Gm1cv", "Errors.Group.NotFound")
+	}
+	existingRoles, ok := existingGroup.Memberships[membership.ProjectID]
+	if !ok {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Gm8jq", "Errors.Group.Membership.NotFound")
+	}
+	if reflect.DeepEqual(existingRoles, membership.Roles) {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Gm3zt", "Errors.Group.Membership.NotChanged")
+	}
+	if err = c.checkGroupMembershipPreCondition(ctx, membership, resourceOwner); err != nil {
+		return nil, err
+	}
+	groupAgg := GroupAggregateFromWriteModel(&existingGroup.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, group.NewMembershipChangedEvent(ctx, groupAgg, membership.ProjectID, membership.Roles))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingGroup, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingGroup.WriteModel), nil
+}
+
+func (c *Commands) RemoveGroupMembership(ctx context.Context, groupID, projectID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if groupID == "" || resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Gm5rb", "Errors.IDMissing")
+	}
+	if err := c.checkGroupMembershipPermission(ctx, projectID, resourceOwner); err != nil {
+		return nil, err
+	}
+	existingGroup, err := c.getGroupWriteModelByID(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingGroup.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Gm7pe", "Errors.Group.NotFound")
+	}
+	if _, ok := existingGroup.Memberships[projectID]; !ok {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Gm0ny", "Errors.Group.Membership.NotFound")
+	}
+	groupAgg := GroupAggregateFromWriteModel(&existingGroup.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, group.NewMembershipRemovedEvent(ctx, groupAgg, projectID))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingGroup, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingGroup.WriteModel), nil
+}
+
+func (c *Commands) checkGroupGrantPreCondition(ctx context.Context, grant *domain.GroupGrant, resourceOwner string) error {
+	preConditions := NewGroupGrantPreConditionReadModel(grant.ProjectID, grant.GrantID, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, preConditions)
+	if err != nil {
+		return err
+	}
+	if grant.GrantID == "" && !preConditions.ProjectExists {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Gg4hy", "Errors.Project.NotFound")
+	}
+	if grant.GrantID != "" && !preConditions.ProjectGrantExists {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Gg3ec", "Errors.Project.Grant.NotFound")
+	}
+	if grant.HasInvalidRoles(preConditions.ExistingRoleKeys) {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Gg5bn", "Errors.Project.Role.NotFound")
+	}
+	return nil
+}
+
+// checkGroupMembershipPermission prevents that the roles of a membership are granted
+// by a caller who is only allowed to manage groups, but not the members of the organization or project
+func (c *Commands) checkGroupMembershipPermission(ctx context.Context, projectID, resourceOwner string) error {
+	if projectID == "" {
+		return c.checkPermission(ctx, domain.PermissionOrgMemberWrite, resourceOwner, "")
+	}
+	return c.checkPermission(ctx, domain.PermissionProjectMemberWrite, resourceOwner, projectID)
+}
+
+func (c *Commands) checkGroupMembershipPreCondition(ctx context.Context, membership *domain.GroupMembership, resourceOwner string) error {
+	rolePrefix := domain.OrgRolePrefix
+	if membership.ProjectID != "" {
+		rolePrefix = domain.ProjectRolePrefix
+	}
+	if invalidRoles, err := c.checkForInvalidMemberRoles(ctx, c.eventstore.Filter, rolePrefix, membership.Roles); err != nil || len(invalidRoles) > 0 {
+		return caos_errs.ThrowInvalidArgument(err, "COMMAND-Gm2hu", "Errors.Group.Membership.Invalid")
+	}
+	if membership.ProjectID == "" {
+		return nil
+	}
+	return c.checkProjectExists(ctx, membership.ProjectID, resourceOwner)
+}
+
+func (c *Commands) getGroupWriteModelByID(ctx context.Context, groupID, resourceOwner string) (_ *GroupWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	groupWriteModel := NewGroupWriteModel(groupID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, groupWriteModel)
+	if err != nil {
+		return nil, err
+	}
+	return groupWriteModel, nil
+}
@@ -0,0 +1,214 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+type GroupWriteModel struct {
+	eventstore.WriteModel
+
+	Name        string
+	Description string
+	State       domain.GroupState
+
+	UserIDs []string
+	// Grants contains the granted role keys by project (grant), see groupGrantKey
+	Grants map[string][]string
+	// Memberships contains the member roles by project, the organization is stored with an empty project id
+	Memberships map[string][]string
+}
+
+func NewGroupWriteModel(groupID string, resourceOwner string) *GroupWriteModel {
+	return &GroupWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   groupID,
+			ResourceOwner: resourceOwner,
+		},
+		Grants:      make(map[string][]string),
+		Memberships: make(map[string][]string),
+	}
+}
+
+func (wm *GroupWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *group.AddedEvent:
+			wm.Name = e.Name
+			wm.Description = e.Description
+			wm.State = domain.GroupStateActive
+		case *group.ChangedEvent:
+			if e.Name != nil {
+				wm.Name = *e.Name
+			}
+			if e.Description != nil {
+				wm.Description = *e.Description
+			}
+		case *group.RemovedEvent:
+			wm.State = domain.GroupStateRemoved
+			wm.UserIDs = nil
+			wm.Grants = make(map[string][]string)
+			wm.Memberships = make(map[string][]string)
+		case *group.UserAddedEvent:
+			wm.UserIDs = append(wm.UserIDs, e.UserID)
+		case *group.UserRemovedEvent:
+			for i, userID := range wm.UserIDs {
+				if userID == e.UserID {
+					wm.UserIDs = append(wm.UserIDs[:i], wm.UserIDs[i+1:]...)
+					break
+				}
+			}
+		case *group.GrantAddedEvent:
+			wm.Grants[groupGrantKey(e.ProjectID, e.ProjectGrantID)] = e.RoleKeys
+		case *group.GrantChangedEvent:
+			wm.Grants[groupGrantKey(e.ProjectID, e.ProjectGrantID)] = e.RoleKeys
+		case *group.GrantRemovedEvent:
+			delete(wm.Grants, groupGrantKey(e.ProjectID, e.ProjectGrantID))
+		case *group.MembershipAddedEvent:
+			wm.Memberships[e.ProjectID] = e.Roles
+		case *group.MembershipChangedEvent:
+			wm.Memberships[e.ProjectID] = e.Roles
+		case *group.MembershipRemovedEvent:
+			delete(wm.Memberships, e.ProjectID)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *GroupWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(group.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(group.AddedEventType,
+			group.ChangedEventType,
+			group.RemovedEventType,
+			group.UserAddedEventType,
+			group.UserRemovedEventType,
+			group.GrantAddedEventType,
+			group.GrantChangedEventType,
+			group.GrantRemovedEventType,
+			group.MembershipAddedEventType,
+			group.MembershipChangedEventType,
+			group.MembershipRemovedEventType).
+		Builder()
+}
+
+func (wm *GroupWriteModel) NewChangedEvent(
+	ctx context.Context,
+	agg *eventstore.Aggregate,
+	name,
+	description string,
+) (*group.ChangedEvent, error) {
+	changes := make([]group.GroupChanges, 0)
+	if wm.Name != name {
+		changes = append(changes, group.ChangeName(name, wm.Name))
+	}
+	if wm.Description != description {
+		changes = append(changes, group.ChangeDescription(description))
+	}
+	return group.NewChangedEvent(ctx, agg, changes)
+}
+
+func (wm *GroupWriteModel) hasUser(userID string) bool {
+	for _, id := range wm.UserIDs {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+func groupGrantKey(projectID, projectGrantID string) string {
+	return projectID + ":" + projectGrantID
+}
+
+func GroupAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return eventstore.AggregateFromWriteModel(wm, group.AggregateType, group.AggregateVersion)
+}
+
+// GroupGrantPreConditionReadModel checks if the project (grant) and the roles
+// can be granted to a group of the organization
+type GroupGrantPreConditionReadModel struct {
+	eventstore.WriteModel
+
+	ProjectID          string
+	ProjectGrantID     string
+	ResourceOwner      string
+	ProjectExists      bool
+	ProjectGrantExists bool
+	ExistingRoleKeys   []string
+}
+
+func NewGroupGrantPreConditionReadModel(projectID, projectGrantID, resourceOwner string) *GroupGrantPreConditionReadModel {
+	return &GroupGrantPreConditionReadModel{
+		ProjectID:      projectID,
+		ProjectGrantID: projectGrantID,
+		ResourceOwner:  resourceOwner,
+	}
+}
+
+func (wm *GroupGrantPreConditionReadModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *project.ProjectAddedEvent:
+			if wm.ProjectGrantID == "" && wm.ResourceOwner == e.Aggregate().ResourceOwner {
+				wm.ProjectExists = true
+			}
+		case *project.ProjectRemovedEvent:
+			wm.ProjectExists = false
+			wm.ProjectGrantExists = false
+		case *project.GrantAddedEvent:
+			if wm.ProjectGrantID == e.GrantID && wm.ResourceOwner == e.GrantedOrgID {
+				wm.ProjectGrantExists = true
+				wm.ExistingRoleKeys = e.RoleKeys
+			}
+		case *project.GrantChangedEvent:
+			if wm.ProjectGrantID == e.GrantID {
+				wm.ExistingRoleKeys = e.RoleKeys
+			}
+		case *project.GrantRemovedEvent:
+			if wm.ProjectGrantID == e.GrantID {
+				wm.ProjectGrantExists = false
+				wm.ExistingRoleKeys = []string{}
+			}
+		case *project.RoleAddedEvent:
+			if wm.ProjectGrantID != "" {
+				continue
+			}
+			wm.ExistingRoleKeys = append(wm.ExistingRoleKeys, e.Key)
+		case *project.RoleRemovedEvent:
+			if wm.ProjectGrantID != "" {
+				continue
+			}
+			for i, key := range wm.ExistingRoleKeys {
+				if key == e.Key {
+					wm.ExistingRoleKeys = append(wm.ExistingRoleKeys[:i], wm.ExistingRoleKeys[i+1:]...)
+					break
+				}
+			}
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *GroupGrantPreConditionReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(wm.ProjectID).
+		EventTypes(
+			project.ProjectAddedType,
+			project.ProjectRemovedType,
+			project.GrantAddedType,
+			project.GrantChangedType,
+			project.GrantRemovedType,
+			project.RoleAddedType,
+			project.RoleRemovedType).
+		Builder()
+}
@@ -0,0 +1,875 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+func TestCommands_AddGroup(t *testing.T) {
+	type fields struct {
+		eventstore  *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx           context.Context
+		addGroup      *domain.Group
+		resourceOwner string
+	}
+	type res struct {
+		id      string
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no name, error",
+			fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args{
+				ctx: context.Background(),
+				addGroup: &domain.Group{
+					Description: "description",
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"unique constraint failed, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectPushFailed(
+						errors.ThrowPreconditionFailed(nil, "id", "name already exists"),
+						[]*repository.Event{
+							eventFromEventPusher(
+								group.NewAddedEvent(context.Background(),
+									&group.NewAggregate("group1", "org1").Aggregate,
+									"name",
+									"description",
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraint(group.NewAddGroupNameUniqueConstraint("name", "org1")),
+					),
+				),
+				idGenerator: mock.ExpectID(t, "group1"),
+			},
+			args{
+				ctx: context.Background(),
+				addGroup: &domain.Group{
+					Name:        "name",
+					Description: "description",
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
+		{
+			"push ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								group.NewAddedEvent(context.Background(),
+									&group.NewAggregate("group1", "org1").Aggregate,
+									"name",
+									"description",
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraint(group.NewAddGroupNameUniqueConstraint("name", "org1")),
+					),
+				),
+				idGenerator: mock.ExpectID(t, "group1"),
+			},
+			args{
+				ctx: context.Background(),
+				addGroup: &domain.Group{
+					Name:        "name",
+					Description: "description",
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				id: "group1",
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore,
+				idGenerator: tt.fields.idGenerator,
+			}
+			id, details, err := c.AddGroup(tt.args.ctx, tt.args.addGroup, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.id, id)
+				assert.Equal(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func TestCommands_ChangeGroup(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		changeGroup   *domain.Group
+		resourceOwner string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"not found, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				changeGroup: &domain.Group{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "group1",
+					},
+					Name: "name",
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsNotFound,
+			},
+		},
+		{
+			"no changes, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							group.NewAddedEvent(context.Background(),
+								&group.NewAggregate("group1", "org1").Aggregate,
+								"name",
+								"description",
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				changeGroup: &domain.Group{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "group1",
+					},
+					Name:        "name",
+					Description: "description",
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
+		{
+			"push ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							group.NewAddedEvent(context.Background(),
+								&group.NewAggregate("group1", "org1").Aggregate,
+								"name",
+								"description",
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								func() eventstore.Command {
+									event, _ := group.NewChangedEvent(context.Background(),
+										&group.NewAggregate("group1", "org1").Aggregate,
+										[]group.GroupChanges{
+											group.ChangeName("name2", "name"),
+										},
+									)
+									return event
+								}(),
+							),
+						},
+						uniqueConstraintsFromEventConstraint(group.NewRemoveGroupNameUniqueConstraint("name", "org1")),
+						uniqueConstraintsFromEventConstraint(group.NewAddGroupNameUniqueConstraint("name2", "org1")),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				changeGroup: &domain.Group{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "group1",
+					},
+					Name:        "name2",
+					Description: "description",
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			details, err := c.ChangeGroup(tt.args.ctx, tt.args.changeGroup, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func TestCommands_AddGroupUser(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		groupID       string
+		userID        string
+		resourceOwner string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"group not found, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				groupID:       "group1",
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsNotFound,
+			},
+		},
+		{
+			"user already added, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							group.NewAddedEvent(context.Background(),
+								&group.NewAggregate("group1", "org1").Aggregate,
+								"name",
+								"",
+							),
+						),
+						eventFromEventPusher(
+							group.NewUserAddedEvent(context.Background(),
+								&group.NewAggregate("group1", "org1").Aggregate,
+								"user1",
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				groupID:       "group1",
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsErrorAlreadyExists,
+			},
+		},
+		{
+			"user not found, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							group.NewAddedEvent(context.Background(),
+								&group.NewAggregate("group1", "org1").Aggregate,
+								"name",
+								"",
+							),
+						),
+					),
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				groupID:       "group1",
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
+		{
+			"push ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							group.NewAddedEvent(context.Background(),
+								&group.NewAggregate("group1", "org1").Aggregate,
+								"name",
+								"",
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username1",
+								"firstname1",
+								"lastname1",
+								"nickname1",
+								"displayname1",
+								language.German,
+								domain.GenderMale,
+								"email1",
+								true,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								group.NewUserAddedEvent(context.Background(),
+									&group.NewAggregate("group1", "org1").Aggregate,
+									"user1",
+								),
+							),
+						},
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				groupID:       "group1",
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			details, err := c.AddGroupUser(tt.args.ctx, tt.args.groupID, tt.args.userID, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func TestCommands_AddGroupGrant(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		grant         *domain.GroupGrant
+		resourceOwner string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"invalid grant, error",
+			fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args{
+				ctx: context.Background(),
+				grant: &domain.GroupGrant{
+					GroupID:   "group1",
+					ProjectID: "project1",
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"grant already exists, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							group.NewAddedEvent(context.Background(),
+								&group.NewAggregate("group1", "org1").Aggregate,
+								"name",
+								"",
+							),
+						),
+						eventFromEventPusher(
+							group.NewGrantAddedEvent(context.Background(),
+								&group.NewAggregate("group1", "org1").Aggregate,
+								"project1",
+								"",
+								[]string{"role1"},
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				grant: &domain.GroupGrant{
+					GroupID:   "group1",
+					ProjectID: "project1",
+					RoleKeys:  []string{"role1"},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsErrorAlreadyExists,
+			},
+		},
+		{
+			"role not found, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							group.NewAddedEvent(context.Background(),
+								&group.NewAggregate("group1", "org1").Aggregate,
+								"name",
+								"",
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project",
+								false,
+								false,
+								false,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				grant: &domain.GroupGrant{
+					GroupID:   "group1",
+					ProjectID: "project1",
+					RoleKeys:  []string{"role1"},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
+		{
+			"push ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							group.NewAddedEvent(context.Background(),
+								&group.NewAggregate("group1", "org1").Aggregate,
+								"name",
+								"",
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project",
+								false,
+								false,
+								false,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"role1",
+								"role",
+								"",
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								group.NewGrantAddedEvent(context.Background(),
+									&group.NewAggregate("group1", "org1").Aggregate,
+									"project1",
+									"",
+									[]string{"role1"},
+								),
+							),
+						},
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				grant: &domain.GroupGrant{
+					GroupID:   "group1",
+					ProjectID: "project1",
+					RoleKeys:  []string{"role1"},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			details, err := c.AddGroupGrant(tt.args.ctx, tt.args.grant, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func TestCommands_AddGroupMembership(t *testing.T) {
+	type fields struct {
+		eventstore      *eventstore.Eventstore
+		zitadelRoles    []authz.RoleMapping
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx           context.Context
+		membership    *domain.GroupMembership
+		resourceOwner string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"org membership without permission on members, error",
+			fields{
+				eventstore:      eventstoreExpect(t),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args{
+				ctx: context.Background(),
+				membership: &domain.GroupMembership{
+					GroupID: "group1",
+					Roles:   []string{domain.RoleOrgOwner},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsPermissionDenied,
+			},
+		},
+		{
+			"project membership without permission on members of the project, error",
+			fields{
+				eventstore:      eventstoreExpect(t),
+				checkPermission: newMockPermissionCheckOnResource(domain.PermissionProjectMemberWrite, "project2"),
+			},
+			args{
+				ctx: context.Background(),
+				membership: &domain.GroupMembership{
+					GroupID:   "group1",
+					ProjectID: "project1",
+					Roles:     []string{domain.RoleProjectOwner},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsPermissionDenied,
+			},
+		},
+		{
+			"invalid roles, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							group.NewAddedEvent(context.Background(),
+								&group.NewAggregate("group1", "org1").Aggregate,
+								"name",
+								"",
+							),
+						),
+					),
+					expectFilter(),
+				),
+				zitadelRoles: []authz.RoleMapping{
+					{
+						Role: domain.RoleOrgOwner,
+					},
+				},
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: context.Background(),
+				membership: &domain.GroupMembership{
+					GroupID: "group1",
+					Roles:   []string{domain.RoleProjectOwner},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"org membership, ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							group.NewAddedEvent(context.Background(),
+								&group.NewAggregate("group1", "org1").Aggregate,
+								"name",
+								"",
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								group.NewMembershipAddedEvent(context.Background(),
+									&group.NewAggregate("group1", "org1").Aggregate,
+									"",
+									[]string{domain.RoleOrgOwner},
+								),
+							),
+						},
+					),
+				),
+				zitadelRoles: []authz.RoleMapping{
+					{
+						Role: domain.RoleOrgOwner,
+					},
+				},
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: context.Background(),
+				membership: &domain.GroupMembership{
+					GroupID: "group1",
+					Roles:   []string{domain.RoleOrgOwner},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			"project membership, ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							group.NewAddedEvent(context.Background(),
+								&group.NewAggregate("group1", "org1").Aggregate,
+								"name",
+								"",
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project",
+								false,
+								false,
+								false,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								group.NewMembershipAddedEvent(context.Background(),
+									&group.NewAggregate("group1", "org1").Aggregate,
+									"project1",
+									[]string{domain.RoleProjectOwner},
+								),
+							),
+						},
+					),
+				),
+				zitadelRoles: []authz.RoleMapping{
+					{
+						Role: domain.RoleProjectOwner,
+					},
+				},
+				checkPermission: newMockPermissionCheckOnResource(domain.PermissionProjectMemberWrite, "project1"),
+			},
+			args{
+				ctx: context.Background(),
+				membership: &domain.GroupMembership{
+					GroupID:   "group1",
+					ProjectID: "project1",
+					Roles:     []string{domain.RoleProjectOwner},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore,
+				zitadelRoles:    tt.fields.zitadelRoles,
+				checkPermission: tt.fields.checkPermission,
+			}
+			details, err := c.AddGroupMembership(tt.args.ctx, tt.args.membership, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+// newMockPermissionCheckOnResource only allows the permission on the resource
+func newMockPermissionCheckOnResource(permission, resourceID string) domain.PermissionCheck {
+	return func(_ context.Context, p, _, r string) error {
+		if p == permission && r == resourceID {
+			return nil
+		}
+		return errors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied")
+	}
+}
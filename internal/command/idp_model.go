@@ -52,6 +52,8 @@ func (wm *OAuthIDPWriteModel) Reduce() error {
 			wm.reduceAddedEvent(e)
 		case *idp.OAuthIDPChangedEvent:
 			wm.reduceChangedEvent(e)
+		case *idp.SecretReencryptedEvent:
+			wm.ClientSecret = e.Secret
 		case *idp.RemovedEvent:
 			wm.State = domain.IDPStateRemoved
 		}
@@ -215,6 +217,8 @@ func (wm *OIDCIDPWriteModel) Reduce() error {
 			wm.State = domain.IDPStateMigrated
 		case *idp.OIDCIDPMigratedGoogleEvent:
 			wm.State = domain.IDPStateMigrated
+		case *idp.SecretReencryptedEvent:
+			wm.ClientSecret = e.Secret
 		case *idp.RemovedEvent:
 			wm.State = domain.IDPStateRemoved
 		case *idpconfig.IDPConfigAddedEvent:
@@ -570,6 +574,8 @@ func (wm *AzureADIDPWriteModel) Reduce() error {
 			wm.reduceAddedEvent(&e.AzureADIDPAddedEvent)
 		case *idp.AzureADIDPChangedEvent:
 			wm.reduceChangedEvent(e)
+		case *idp.SecretReencryptedEvent:
+			wm.ClientSecret = e.Secret
 		case *idp.RemovedEvent:
 			wm.State = domain.IDPStateRemoved
 		}
@@ -710,6 +716,8 @@ func (wm *GitHubIDPWriteModel) Reduce() error {
 			wm.reduceAddedEvent(e)
 		case *idp.GitHubIDPChangedEvent:
 			wm.reduceChangedEvent(e)
+		case *idp.SecretReencryptedEvent:
+			wm.ClientSecret = e.Secret
 		case *idp.RemovedEvent:
 			wm.State = domain.IDPStateRemoved
 		}
@@ -826,6 +834,8 @@ func (wm *GitHubEnterpriseIDPWriteModel) Reduce() error {
 			wm.reduceAddedEvent(e)
 		case *idp.GitHubEnterpriseIDPChangedEvent:
 			wm.reduceChangedEvent(e)
+		case *idp.SecretReencryptedEvent:
+			wm.ClientSecret = e.Secret
 		case *idp.RemovedEvent:
 			wm.State = domain.IDPStateRemoved
 		}
@@ -967,6 +977,8 @@ func (wm *GitLabIDPWriteModel) Reduce() error {
 			wm.reduceAddedEvent(e)
 		case *idp.GitLabIDPChangedEvent:
 			wm.reduceChangedEvent(e)
+		case *idp.SecretReencryptedEvent:
+			wm.ClientSecret = e.Secret
 		case *idp.RemovedEvent:
 			wm.State = domain.IDPStateRemoved
 		}
@@ -1082,6 +1094,8 @@ func (wm *GitLabSelfHostedIDPWriteModel) Reduce() error {
 			wm.reduceAddedEvent(e)
 		case *idp.GitLabSelfHostedIDPChangedEvent:
 			wm.reduceChangedEvent(e)
+		case *idp.SecretReencryptedEvent:
+			wm.ClientSecret = e.Secret
 		case *idp.RemovedEvent:
 			wm.State = domain.IDPStateRemoved
 		}
@@ -1207,6 +1221,8 @@ func (wm *GoogleIDPWriteModel) Reduce() error {
 			wm.reduceChangedEvent(e)
 		case *idp.OIDCIDPMigratedGoogleEvent:
 			wm.reduceAddedEvent(&e.GoogleIDPAddedEvent)
+		case *idp.SecretReencryptedEvent:
+			wm.ClientSecret = e.Secret
 		case *idp.RemovedEvent:
 			wm.State = domain.IDPStateRemoved
 		}
@@ -1339,6 +1355,11 @@ func (wm *LDAPIDPWriteModel) Reduce() error {
 				continue
 			}
 			wm.reduceChangedEvent(e)
+		case *idp.SecretReencryptedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.BindPassword = e.Secret
 		case *idp.RemovedEvent:
 			if wm.ID != e.ID {
 				continue
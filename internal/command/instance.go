@@ -85,15 +85,17 @@ type InstanceSetup struct {
 		MfaInitSkipLifetime        time.Duration
 		SecondFactorCheckLifetime  time.Duration
 		MultiFactorCheckLifetime   time.Duration
+		TrustedDeviceLifetime      time.Duration
 	}
 	NotificationPolicy struct {
 		PasswordChange bool
 	}
 	PrivacyPolicy struct {
-		TOSLink      string
-		PrivacyLink  string
-		HelpLink     string
-		SupportEmail domain.EmailAddress
+		TOSLink           string
+		PrivacyLink       string
+		HelpLink          string
+		SupportEmail      domain.EmailAddress
+		AllowSelfDeletion bool
 	}
 	LabelPolicy struct {
 		PrimaryColor        string
@@ -112,6 +114,11 @@ type InstanceSetup struct {
 		MaxAttempts              uint64
 		ShouldShowLockoutFailure bool
 	}
+	UserLifecyclePolicy struct {
+		InactivityDeactivation time.Duration
+		DeactivatedRemoval     time.Duration
+		NotificationPeriod     time.Duration
+	}
 	EmailTemplate     []byte
 	MessageTexts      []*domain.CustomMessageText
 	SMTPConfiguration *smtp.Config
@@ -120,6 +127,7 @@ type InstanceSetup struct {
 		IdTokenLifetime            time.Duration
 		RefreshTokenIdleExpiration time.Duration
 		RefreshTokenExpiration     time.Duration
+		SigningAlgorithm           string
 	}
 	Quotas *struct {
 		Items []*AddQuota
@@ -244,6 +252,7 @@ func (c *Commands) SetUpInstance(ctx context.Context, setup *InstanceSetup) (str
 			setup.LoginPolicy.MfaInitSkipLifetime,
 			setup.LoginPolicy.SecondFactorCheckLifetime,
 			setup.LoginPolicy.MultiFactorCheckLifetime,
+			setup.LoginPolicy.TrustedDeviceLifetime,
 		),
 		prepareAddSecondFactorToDefaultLoginPolicy(instanceAgg, domain.SecondFactorTypeTOTP),
 		prepareAddSecondFactorToDefaultLoginPolicy(instanceAgg, domain.SecondFactorTypeU2F),
@@ -253,9 +262,10 @@ func (c *Commands) SetUpInstance(ctx context.Context, setup *InstanceSetup) (str
 		*/
 		prepareAddMultiFactorToDefaultLoginPolicy(instanceAgg, domain.MultiFactorTypeU2FWithPIN),
 
-		prepareAddDefaultPrivacyPolicy(instanceAgg, setup.PrivacyPolicy.TOSLink, setup.PrivacyPolicy.PrivacyLink, setup.PrivacyPolicy.HelpLink, setup.PrivacyPolicy.SupportEmail),
+		prepareAddDefaultPrivacyPolicy(instanceAgg, setup.PrivacyPolicy.TOSLink, setup.PrivacyPolicy.PrivacyLink, setup.PrivacyPolicy.HelpLink, setup.PrivacyPolicy.SupportEmail, setup.PrivacyPolicy.AllowSelfDeletion),
 		prepareAddDefaultNotificationPolicy(instanceAgg, setup.NotificationPolicy.PasswordChange),
 		prepareAddDefaultLockoutPolicy(instanceAgg, setup.LockoutPolicy.MaxAttempts, setup.LockoutPolicy.ShouldShowLockoutFailure),
+		prepareAddDefaultUserLifecyclePolicy(instanceAgg, setup.UserLifecyclePolicy.InactivityDeactivation, setup.UserLifecyclePolicy.DeactivatedRemoval, setup.UserLifecyclePolicy.NotificationPeriod),
 
 		prepareAddDefaultLabelPolicy(
 			instanceAgg,
@@ -429,6 +439,7 @@ func (c *Commands) SetUpInstance(ctx context.Context, setup *InstanceSetup) (str
 				setup.OIDCSettings.IdTokenLifetime,
 				setup.OIDCSettings.RefreshTokenIdleExpiration,
 				setup.OIDCSettings.RefreshTokenExpiration,
+				setup.OIDCSettings.SigningAlgorithm,
 			),
 		)
 	}
@@ -42,6 +42,7 @@ func writeModelToLoginPolicy(wm *LoginPolicyWriteModel) *domain.LoginPolicy {
 		MFAInitSkipLifetime:        wm.MFAInitSkipLifetime,
 		SecondFactorCheckLifetime:  wm.SecondFactorCheckLifetime,
 		MultiFactorCheckLifetime:   wm.MultiFactorCheckLifetime,
+		TrustedDeviceLifetime:      wm.TrustedDeviceLifetime,
 	}
 }
 
@@ -122,13 +123,23 @@ func writeModelToLockoutPolicy(wm *LockoutPolicyWriteModel) *domain.LockoutPolic
 	}
 }
 
+func writeModelToUserLifecyclePolicy(wm *UserLifecyclePolicyWriteModel) *domain.UserLifecyclePolicy {
+	return &domain.UserLifecyclePolicy{
+		ObjectRoot:             writeModelToObjectRoot(wm.WriteModel),
+		InactivityDeactivation: wm.InactivityDeactivation,
+		DeactivatedRemoval:     wm.DeactivatedRemoval,
+		NotificationPeriod:     wm.NotificationPeriod,
+	}
+}
+
 func writeModelToPrivacyPolicy(wm *PrivacyPolicyWriteModel) *domain.PrivacyPolicy {
 	return &domain.PrivacyPolicy{
-		ObjectRoot:   writeModelToObjectRoot(wm.WriteModel),
-		TOSLink:      wm.TOSLink,
-		PrivacyLink:  wm.PrivacyLink,
-		HelpLink:     wm.HelpLink,
-		SupportEmail: wm.SupportEmail,
+		ObjectRoot:        writeModelToObjectRoot(wm.WriteModel),
+		TOSLink:           wm.TOSLink,
+		PrivacyLink:       wm.PrivacyLink,
+		HelpLink:          wm.HelpLink,
+		SupportEmail:      wm.SupportEmail,
+		AllowSelfDeletion: wm.AllowSelfDeletion,
 	}
 }
 
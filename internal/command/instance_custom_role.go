@@ -0,0 +1,133 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+func (c *Commands) AddCustomRole(ctx context.Context, role *domain.CustomRole) (*domain.ObjectDetails, error) {
+	if err := c.checkCustomRole(role); err != nil {
+		return nil, err
+	}
+	for _, staticRole := range c.zitadelRoles {
+		if staticRole.Role == role.Role {
+			return nil, errors.ThrowAlreadyExists(nil, "COMMAND-Cr8ae", "Errors.CustomRole.AlreadyExists")
+		}
+	}
+	rolesWriteModel, err := c.getCustomRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := rolesWriteModel.Roles[role.Role]; ok {
+		return nil, errors.ThrowAlreadyExists(nil, "COMMAND-Cr9ae", "Errors.CustomRole.AlreadyExists")
+	}
+	instanceAgg := InstanceAggregateFromWriteModel(&rolesWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewCustomRoleAddedEvent(ctx, instanceAgg, role.Role, role.DisplayName, role.Permissions))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(rolesWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&rolesWriteModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeCustomRole(ctx context.Context, role *domain.CustomRole) (*domain.ObjectDetails, error) {
+	if err := c.checkCustomRole(role); err != nil {
+		return nil, err
+	}
+	rolesWriteModel, err := c.getCustomRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := rolesWriteModel.Roles[role.Role]; !ok {
+		return nil, errors.ThrowNotFound(nil, "COMMAND-Cr2nf", "Errors.CustomRole.NotFound")
+	}
+	instanceAgg := InstanceAggregateFromWriteModel(&rolesWriteModel.WriteModel)
+	changedEvent, hasChanged, err := rolesWriteModel.NewChangedEvent(ctx, instanceAgg, role)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, errors.ThrowPreconditionFailed(nil, "COMMAND-Cr3nc", "Errors.NoChangesFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(rolesWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&rolesWriteModel.WriteModel), nil
+}
+
+// RemoveCustomRole removes the role definition,
+// members which still have the role assigned no longer get any permissions from it
+func (c *Commands) RemoveCustomRole(ctx context.Context, role string) (*domain.ObjectDetails, error) {
+	if role == "" {
+		return nil, errors.ThrowInvalidArgument(nil, "COMMAND-Cr4ia", "Errors.CustomRole.Invalid")
+	}
+	rolesWriteModel, err := c.getCustomRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := rolesWriteModel.Roles[role]; !ok {
+		return nil, errors.ThrowNotFound(nil, "COMMAND-Cr5nf", "Errors.CustomRole.NotFound")
+	}
+	instanceAgg := InstanceAggregateFromWriteModel(&rolesWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewCustomRoleRemovedEvent(ctx, instanceAgg, role))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(rolesWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&rolesWriteModel.WriteModel), nil
+}
+
+// checkCustomRole ensures a custom role only grants permissions
+// which are already granted by a static role of the same member type
+func (c *Commands) checkCustomRole(role *domain.CustomRole) error {
+	if !role.IsValid() {
+		return errors.ThrowInvalidArgument(nil, "COMMAND-Cr1ia", "Errors.CustomRole.Invalid")
+	}
+	if len(domain.CheckForInvalidPermissions(role.Permissions, domain.RolePrefix(role.Role), c.zitadelRoles)) > 0 {
+		return errors.ThrowInvalidArgument(nil, "COMMAND-Cr2ip", "Errors.CustomRole.InvalidPermissions")
+	}
+	return nil
+}
+
+func (c *Commands) getCustomRoles(ctx context.Context) (*InstanceCustomRolesWriteModel, error) {
+	writeModel := NewInstanceCustomRolesWriteModel(ctx)
+	err := c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+// checkForInvalidMemberRoles returns the roles which are neither a static role
+// nor a custom role of the instance with the prefix
+func (c *Commands) checkForInvalidMemberRoles(ctx context.Context, filter preparation.FilterToQueryReducer, rolePrefix string, roles []string) ([]string, error) {
+	invalidRoles := domain.CheckForInvalidRoles(roles, rolePrefix, c.zitadelRoles)
+	if len(invalidRoles) == 0 {
+		return invalidRoles, nil
+	}
+	wm := NewInstanceCustomRolesWriteModel(ctx)
+	events, err := filter(ctx, wm.Query())
+	if err != nil {
+		return nil, err
+	}
+	wm.AppendEvents(events...)
+	if err := wm.Reduce(); err != nil {
+		return nil, err
+	}
+	return domain.CheckForInvalidRoles(invalidRoles, rolePrefix, wm.RoleMappings()), nil
+}
@@ -0,0 +1,102 @@
+package command
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+type InstanceCustomRolesWriteModel struct {
+	eventstore.WriteModel
+
+	Roles map[string]*domain.CustomRole
+}
+
+func NewInstanceCustomRolesWriteModel(ctx context.Context) *InstanceCustomRolesWriteModel {
+	return &InstanceCustomRolesWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   authz.GetInstance(ctx).InstanceID(),
+			ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+		},
+		Roles: make(map[string]*domain.CustomRole),
+	}
+}
+
+func (wm *InstanceCustomRolesWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *instance.CustomRoleAddedEvent:
+			wm.Roles[e.Role] = &domain.CustomRole{
+				Role:        e.Role,
+				DisplayName: e.DisplayName,
+				Permissions: e.Permissions,
+			}
+		case *instance.CustomRoleChangedEvent:
+			role, ok := wm.Roles[e.Role]
+			if !ok {
+				continue
+			}
+			if e.DisplayName != nil {
+				role.DisplayName = *e.DisplayName
+			}
+			if e.Permissions != nil {
+				role.Permissions = e.Permissions
+			}
+		case *instance.CustomRoleRemovedEvent:
+			delete(wm.Roles, e.Role)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *InstanceCustomRolesWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.CustomRoleAddedEventType,
+			instance.CustomRoleChangedEventType,
+			instance.CustomRoleRemovedEventType).
+		Builder()
+}
+
+// RoleMappings returns the custom roles in the same form as the static role mappings of the configuration
+func (wm *InstanceCustomRolesWriteModel) RoleMappings() []authz.RoleMapping {
+	mappings := make([]authz.RoleMapping, 0, len(wm.Roles))
+	for _, role := range wm.Roles {
+		mappings = append(mappings, authz.RoleMapping{
+			Role:        role.Role,
+			Permissions: role.Permissions,
+		})
+	}
+	return mappings
+}
+
+func (wm *InstanceCustomRolesWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	role *domain.CustomRole,
+) (*instance.CustomRoleChangedEvent, bool, error) {
+	existing := wm.Roles[role.Role]
+	changes := make([]instance.CustomRoleChanges, 0, 2)
+	if existing.DisplayName != role.DisplayName {
+		changes = append(changes, instance.ChangeCustomRoleDisplayName(role.DisplayName))
+	}
+	if !reflect.DeepEqual(existing.Permissions, role.Permissions) {
+		changes = append(changes, instance.ChangeCustomRolePermissions(role.Permissions))
+	}
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := instance.NewCustomRoleChangedEvent(ctx, aggregate, role.Role, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
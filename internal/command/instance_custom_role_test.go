@@ -0,0 +1,449 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+var customRoleTestZitadelRoles = []authz.RoleMapping{
+	{
+		Role:        "ORG_OWNER",
+		Permissions: []string{"org.read", "org.member.read", "org.member.write"},
+	},
+	{
+		Role:        "IAM_OWNER",
+		Permissions: []string{"iam.read", "iam.write"},
+	},
+}
+
+func TestCommandSide_AddCustomRole(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx  context.Context
+		role *domain.CustomRole
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid name, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: &domain.CustomRole{
+					Role:        "helpdesk",
+					Permissions: []string{"org.read"},
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "permission of other member type, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: &domain.CustomRole{
+					Role:        "ORG_HELPDESK",
+					Permissions: []string{"org.read", "iam.write"},
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "static role, already exists error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: &domain.CustomRole{
+					Role:        "ORG_OWNER",
+					Permissions: []string{"org.read"},
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorAlreadyExists,
+			},
+		},
+		{
+			name: "custom role, already exists error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewCustomRoleAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"ORG_HELPDESK",
+								"Helpdesk",
+								[]string{"org.read"},
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: &domain.CustomRole{
+					Role:        "ORG_HELPDESK",
+					Permissions: []string{"org.read"},
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorAlreadyExists,
+			},
+		},
+		{
+			name: "add custom role, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewCustomRoleAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"ORG_AUDITOR",
+								"Auditor",
+								[]string{"org.read"},
+							),
+						),
+						eventFromEventPusher(
+							instance.NewCustomRoleRemovedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"ORG_AUDITOR",
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID(
+								"INSTANCE",
+								instance.NewCustomRoleAddedEvent(context.Background(),
+									&instance.NewAggregate("INSTANCE").Aggregate,
+									"ORG_HELPDESK",
+									"Helpdesk",
+									[]string{"org.read", "org.member.read"},
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: &domain.CustomRole{
+					Role:        "ORG_HELPDESK",
+					DisplayName: "Helpdesk",
+					Permissions: []string{"org.read", "org.member.read"},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:   tt.fields.eventstore,
+				zitadelRoles: customRoleTestZitadelRoles,
+			}
+			got, err := r.AddCustomRole(tt.args.ctx, tt.args.role)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeCustomRole(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx  context.Context
+		role *domain.CustomRole
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: &domain.CustomRole{
+					Role:        "ORG_HELPDESK",
+					Permissions: []string{"org.read"},
+				},
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewCustomRoleAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"ORG_HELPDESK",
+								"Helpdesk",
+								[]string{"org.read"},
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: &domain.CustomRole{
+					Role:        "ORG_HELPDESK",
+					DisplayName: "Helpdesk",
+					Permissions: []string{"org.read"},
+				},
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "change permissions, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewCustomRoleAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"ORG_HELPDESK",
+								"Helpdesk",
+								[]string{"org.read"},
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID(
+								"INSTANCE",
+								newCustomRoleChangedEvent(context.Background(),
+									"ORG_HELPDESK",
+									instance.ChangeCustomRolePermissions([]string{"org.read", "org.member.write"}),
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: &domain.CustomRole{
+					Role:        "ORG_HELPDESK",
+					DisplayName: "Helpdesk",
+					Permissions: []string{"org.read", "org.member.write"},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:   tt.fields.eventstore,
+				zitadelRoles: customRoleTestZitadelRoles,
+			}
+			got, err := r.ChangeCustomRole(tt.args.ctx, tt.args.role)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RemoveCustomRole(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx  context.Context
+		role string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "empty role, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "already removed, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewCustomRoleAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"ORG_HELPDESK",
+								"Helpdesk",
+								[]string{"org.read"},
+							),
+						),
+						eventFromEventPusher(
+							instance.NewCustomRoleRemovedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"ORG_HELPDESK",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:  authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: "ORG_HELPDESK",
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "remove custom role, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewCustomRoleAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"ORG_HELPDESK",
+								"Helpdesk",
+								[]string{"org.read"},
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID(
+								"INSTANCE",
+								instance.NewCustomRoleRemovedEvent(context.Background(),
+									&instance.NewAggregate("INSTANCE").Aggregate,
+									"ORG_HELPDESK",
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx:  authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: "ORG_HELPDESK",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:   tt.fields.eventstore,
+				zitadelRoles: customRoleTestZitadelRoles,
+			}
+			got, err := r.RemoveCustomRole(tt.args.ctx, tt.args.role)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func newCustomRoleChangedEvent(ctx context.Context, role string, changes ...instance.CustomRoleChanges) *instance.CustomRoleChangedEvent {
+	event, _ := instance.NewCustomRoleChangedEvent(ctx,
+		&instance.NewAggregate("INSTANCE").Aggregate,
+		role,
+		changes,
+	)
+	return event
+}
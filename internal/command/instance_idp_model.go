@@ -33,6 +33,8 @@ func (wm *InstanceOAuthIDPWriteModel) AppendEvents(events ...eventstore.Event) {
 			wm.OAuthIDPWriteModel.AppendEvents(&e.OAuthIDPAddedEvent)
 		case *instance.OAuthIDPChangedEvent:
 			wm.OAuthIDPWriteModel.AppendEvents(&e.OAuthIDPChangedEvent)
+		case *instance.IDPSecretReencryptedEvent:
+			wm.OAuthIDPWriteModel.AppendEvents(&e.SecretReencryptedEvent)
 		case *instance.IDPRemovedEvent:
 			wm.OAuthIDPWriteModel.AppendEvents(&e.RemovedEvent)
 		}
@@ -48,6 +50,7 @@ func (wm *InstanceOAuthIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
 		EventTypes(
 			instance.OAuthIDPAddedEventType,
 			instance.OAuthIDPChangedEventType,
+			instance.IDPSecretReencryptedEventType,
 			instance.IDPRemovedEventType,
 		).
 		EventData(map[string]interface{}{"id": wm.ID}).
@@ -111,6 +114,8 @@ func (wm *InstanceOIDCIDPWriteModel) AppendEvents(events ...eventstore.Event) {
 			wm.OIDCIDPWriteModel.AppendEvents(&e.OIDCIDPAddedEvent)
 		case *instance.OIDCIDPChangedEvent:
 			wm.OIDCIDPWriteModel.AppendEvents(&e.OIDCIDPChangedEvent)
+		case *instance.IDPSecretReencryptedEvent:
+			wm.OIDCIDPWriteModel.AppendEvents(&e.SecretReencryptedEvent)
 		case *instance.IDPRemovedEvent:
 			wm.OIDCIDPWriteModel.AppendEvents(&e.RemovedEvent)
 		case *instance.OIDCIDPMigratedAzureADEvent:
@@ -144,6 +149,7 @@ func (wm *InstanceOIDCIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
 		EventTypes(
 			instance.OIDCIDPAddedEventType,
 			instance.OIDCIDPChangedEventType,
+			instance.IDPSecretReencryptedEventType,
 			instance.IDPRemovedEventType,
 			instance.OIDCIDPMigratedAzureADEventType,
 			instance.OIDCIDPMigratedGoogleEventType,
@@ -313,6 +319,8 @@ func (wm *InstanceAzureADIDPWriteModel) AppendEvents(events ...eventstore.Event)
 			wm.AzureADIDPWriteModel.AppendEvents(&e.AzureADIDPChangedEvent)
 		case *instance.OIDCIDPMigratedAzureADEvent:
 			wm.AzureADIDPWriteModel.AppendEvents(&e.OIDCIDPMigratedAzureADEvent)
+		case *instance.IDPSecretReencryptedEvent:
+			wm.AzureADIDPWriteModel.AppendEvents(&e.SecretReencryptedEvent)
 		case *instance.IDPRemovedEvent:
 			wm.AzureADIDPWriteModel.AppendEvents(&e.RemovedEvent)
 		default:
@@ -331,6 +339,7 @@ func (wm *InstanceAzureADIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
 			instance.AzureADIDPAddedEventType,
 			instance.AzureADIDPChangedEventType,
 			instance.OIDCIDPMigratedAzureADEventType,
+			instance.IDPSecretReencryptedEventType,
 			instance.IDPRemovedEventType,
 		).
 		EventData(map[string]interface{}{"id": wm.ID}).
@@ -390,6 +399,8 @@ func (wm *InstanceGitHubIDPWriteModel) AppendEvents(events ...eventstore.Event)
 			wm.GitHubIDPWriteModel.AppendEvents(&e.GitHubIDPAddedEvent)
 		case *instance.GitHubIDPChangedEvent:
 			wm.GitHubIDPWriteModel.AppendEvents(&e.GitHubIDPChangedEvent)
+		case *instance.IDPSecretReencryptedEvent:
+			wm.GitHubIDPWriteModel.AppendEvents(&e.SecretReencryptedEvent)
 		case *instance.IDPRemovedEvent:
 			wm.GitHubIDPWriteModel.AppendEvents(&e.RemovedEvent)
 		default:
@@ -407,6 +418,7 @@ func (wm *InstanceGitHubIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
 		EventTypes(
 			instance.GitHubIDPAddedEventType,
 			instance.GitHubIDPChangedEventType,
+			instance.IDPSecretReencryptedEventType,
 			instance.IDPRemovedEventType,
 		).
 		EventData(map[string]interface{}{"id": wm.ID}).
@@ -455,6 +467,8 @@ func (wm *InstanceGitHubEnterpriseIDPWriteModel) AppendEvents(events ...eventsto
 			wm.GitHubEnterpriseIDPWriteModel.AppendEvents(&e.GitHubEnterpriseIDPAddedEvent)
 		case *instance.GitHubEnterpriseIDPChangedEvent:
 			wm.GitHubEnterpriseIDPWriteModel.AppendEvents(&e.GitHubEnterpriseIDPChangedEvent)
+		case *instance.IDPSecretReencryptedEvent:
+			wm.GitHubEnterpriseIDPWriteModel.AppendEvents(&e.SecretReencryptedEvent)
 		case *instance.IDPRemovedEvent:
 			wm.GitHubEnterpriseIDPWriteModel.AppendEvents(&e.RemovedEvent)
 		default:
@@ -472,6 +486,7 @@ func (wm *InstanceGitHubEnterpriseIDPWriteModel) Query() *eventstore.SearchQuery
 		EventTypes(
 			instance.GitHubEnterpriseIDPAddedEventType,
 			instance.GitHubEnterpriseIDPChangedEventType,
+			instance.IDPSecretReencryptedEventType,
 			instance.IDPRemovedEventType,
 		).
 		EventData(map[string]interface{}{"id": wm.ID}).
@@ -533,6 +548,8 @@ func (wm *InstanceGitLabIDPWriteModel) AppendEvents(events ...eventstore.Event)
 			wm.GitLabIDPWriteModel.AppendEvents(&e.GitLabIDPAddedEvent)
 		case *instance.GitLabIDPChangedEvent:
 			wm.GitLabIDPWriteModel.AppendEvents(&e.GitLabIDPChangedEvent)
+		case *instance.IDPSecretReencryptedEvent:
+			wm.GitLabIDPWriteModel.AppendEvents(&e.SecretReencryptedEvent)
 		case *instance.IDPRemovedEvent:
 			wm.GitLabIDPWriteModel.AppendEvents(&e.RemovedEvent)
 		default:
@@ -550,6 +567,7 @@ func (wm *InstanceGitLabIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
 		EventTypes(
 			instance.GitLabIDPAddedEventType,
 			instance.GitLabIDPChangedEventType,
+			instance.IDPSecretReencryptedEventType,
 			instance.IDPRemovedEventType,
 		).
 		EventData(map[string]interface{}{"id": wm.ID}).
@@ -598,6 +616,8 @@ func (wm *InstanceGitLabSelfHostedIDPWriteModel) AppendEvents(events ...eventsto
 			wm.GitLabSelfHostedIDPWriteModel.AppendEvents(&e.GitLabSelfHostedIDPAddedEvent)
 		case *instance.GitLabSelfHostedIDPChangedEvent:
 			wm.GitLabSelfHostedIDPWriteModel.AppendEvents(&e.GitLabSelfHostedIDPChangedEvent)
+		case *instance.IDPSecretReencryptedEvent:
+			wm.GitLabSelfHostedIDPWriteModel.AppendEvents(&e.SecretReencryptedEvent)
 		case *instance.IDPRemovedEvent:
 			wm.GitLabSelfHostedIDPWriteModel.AppendEvents(&e.RemovedEvent)
 		default:
@@ -615,6 +635,7 @@ func (wm *InstanceGitLabSelfHostedIDPWriteModel) Query() *eventstore.SearchQuery
 		EventTypes(
 			instance.GitLabSelfHostedIDPAddedEventType,
 			instance.GitLabSelfHostedIDPChangedEventType,
+			instance.IDPSecretReencryptedEventType,
 			instance.IDPRemovedEventType,
 		).
 		EventData(map[string]interface{}{"id": wm.ID}).
@@ -666,6 +687,8 @@ func (wm *InstanceGoogleIDPWriteModel) AppendEvents(events ...eventstore.Event)
 			wm.GoogleIDPWriteModel.AppendEvents(&e.GoogleIDPChangedEvent)
 		case *instance.OIDCIDPMigratedGoogleEvent:
 			wm.GoogleIDPWriteModel.AppendEvents(&e.OIDCIDPMigratedGoogleEvent)
+		case *instance.IDPSecretReencryptedEvent:
+			wm.GoogleIDPWriteModel.AppendEvents(&e.SecretReencryptedEvent)
 		case *instance.IDPRemovedEvent:
 			wm.GoogleIDPWriteModel.AppendEvents(&e.RemovedEvent)
 		}
@@ -682,6 +705,7 @@ func (wm *InstanceGoogleIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
 			instance.GoogleIDPAddedEventType,
 			instance.GoogleIDPChangedEventType,
 			instance.OIDCIDPMigratedGoogleEventType,
+			instance.IDPSecretReencryptedEventType,
 			instance.IDPRemovedEventType,
 		).
 		EventData(map[string]interface{}{"id": wm.ID}).
@@ -730,6 +754,8 @@ func (wm *InstanceLDAPIDPWriteModel) AppendEvents(events ...eventstore.Event) {
 			wm.LDAPIDPWriteModel.AppendEvents(&e.LDAPIDPAddedEvent)
 		case *instance.LDAPIDPChangedEvent:
 			wm.LDAPIDPWriteModel.AppendEvents(&e.LDAPIDPChangedEvent)
+		case *instance.IDPSecretReencryptedEvent:
+			wm.LDAPIDPWriteModel.AppendEvents(&e.SecretReencryptedEvent)
 		case *instance.IDPRemovedEvent:
 			wm.LDAPIDPWriteModel.AppendEvents(&e.RemovedEvent)
 		default:
@@ -747,6 +773,7 @@ func (wm *InstanceLDAPIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
 		EventTypes(
 			instance.LDAPIDPAddedEventType,
 			instance.LDAPIDPChangedEventType,
+			instance.IDPSecretReencryptedEventType,
 			instance.IDPRemovedEventType,
 		).
 		EventData(map[string]interface{}{"id": wm.ID}).
@@ -47,6 +47,7 @@ func TestCommandSide_AddOIDCConfig(t *testing.T) {
 								time.Hour*1,
 								time.Hour*1,
 								time.Hour*1,
+								"",
 							),
 						),
 					),
@@ -82,6 +83,7 @@ func TestCommandSide_AddOIDCConfig(t *testing.T) {
 									time.Hour*1,
 									time.Hour*1,
 									time.Hour*1,
+									"",
 								),
 							),
 						},
@@ -183,6 +185,27 @@ func TestCommandSide_AddOIDCConfig(t *testing.T) {
 				err: caos_errs.IsErrorInvalidArgument,
 			},
 		},
+		{
+			name: "add oidc settings, unsupported signing algorithm",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				oidcConfig: &domain.OIDCSettings{
+					AccessTokenLifetime:        1 * time.Hour,
+					IdTokenLifetime:            1 * time.Hour,
+					RefreshTokenIdleExpiration: 1 * time.Hour,
+					RefreshTokenExpiration:     1 * time.Hour,
+					SigningAlgorithm:           "HS256",
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -336,6 +359,7 @@ func TestCommandSide_ChangeOIDCConfig(t *testing.T) {
 								time.Hour*1,
 								time.Hour*1,
 								time.Hour*1,
+								"",
 							),
 						),
 					),
@@ -368,6 +392,7 @@ func TestCommandSide_ChangeOIDCConfig(t *testing.T) {
 								time.Hour*1,
 								time.Hour*1,
 								time.Hour*1,
+								"",
 							),
 						),
 					),
@@ -400,6 +425,58 @@ func TestCommandSide_ChangeOIDCConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "oidc settings change signing algorithm, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewOIDCSettingsAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								time.Hour*1,
+								time.Hour*1,
+								time.Hour*1,
+								time.Hour*1,
+								"",
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID("INSTANCE",
+								func() eventstore.Command {
+									event, _ := instance.NewOIDCSettingsChangeEvent(
+										context.Background(),
+										&instance.NewAggregate("INSTANCE").Aggregate,
+										[]instance.OIDCSettingsChanges{
+											instance.ChangeOIDCSettingsSigningAlgorithm("ES256"),
+										},
+									)
+									return event
+								}(),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				oidcConfig: &domain.OIDCSettings{
+					AccessTokenLifetime:        1 * time.Hour,
+					IdTokenLifetime:            1 * time.Hour,
+					RefreshTokenIdleExpiration: 1 * time.Hour,
+					RefreshTokenExpiration:     1 * time.Hour,
+					SigningAlgorithm:           "ES256",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -77,7 +77,8 @@ func (wm *InstanceLoginPolicyWriteModel) NewChangedEvent(
 	externalLoginCheckLifetime,
 	mfaInitSkipLifetime,
 	secondFactorCheckLifetime,
-	multiFactorCheckLifetime time.Duration,
+	multiFactorCheckLifetime,
+	trustedDeviceLifetime time.Duration,
 ) (*instance.LoginPolicyChangedEvent, bool) {
 
 	changes := make([]policy.LoginPolicyChanges, 0)
@@ -126,6 +127,9 @@ func (wm *InstanceLoginPolicyWriteModel) NewChangedEvent(
 	if wm.MultiFactorCheckLifetime != multiFactorCheckLifetime {
 		changes = append(changes, policy.ChangeMultiFactorCheckLifetime(multiFactorCheckLifetime))
 	}
+	if wm.TrustedDeviceLifetime != trustedDeviceLifetime {
+		changes = append(changes, policy.ChangeTrustedDeviceLifetime(trustedDeviceLifetime))
+	}
 	if wm.DisableLoginWithEmail != disableLoginWithEmail {
 		changes = append(changes, policy.ChangeDisableLoginWithEmail(disableLoginWithEmail))
 	}
@@ -59,6 +59,7 @@ func (wm *InstancePrivacyPolicyWriteModel) NewChangedEvent(
 	privacyLink,
 	helpLink string,
 	supportEmail domain.EmailAddress,
+	allowSelfDeletion bool,
 ) (*instance.PrivacyPolicyChangedEvent, bool) {
 
 	changes := make([]policy.PrivacyPolicyChanges, 0)
@@ -74,6 +75,9 @@ func (wm *InstancePrivacyPolicyWriteModel) NewChangedEvent(
 	if wm.SupportEmail != supportEmail {
 		changes = append(changes, policy.ChangeSupportEmail(supportEmail))
 	}
+	if wm.AllowSelfDeletion != allowSelfDeletion {
+		changes = append(changes, policy.ChangeAllowSelfDeletion(allowSelfDeletion))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
@@ -51,6 +51,7 @@ func TestCommandSide_AddDefaultPrivacyPolicy(t *testing.T) {
 								"PrivacyLink",
 								"HelpLink",
 								"support@example.com",
+								false,
 							),
 						),
 					),
@@ -83,6 +84,7 @@ func TestCommandSide_AddDefaultPrivacyPolicy(t *testing.T) {
 									"PrivacyLink",
 									"HelpLink",
 									"support@example.com",
+									false,
 								),
 							),
 						},
@@ -136,6 +138,7 @@ func TestCommandSide_AddDefaultPrivacyPolicy(t *testing.T) {
 									"",
 									"",
 									"",
+									false,
 								),
 							),
 						},
@@ -161,7 +164,7 @@ func TestCommandSide_AddDefaultPrivacyPolicy(t *testing.T) {
 			r := &Commands{
 				eventstore: tt.fields.eventstore,
 			}
-			got, err := r.AddDefaultPrivacyPolicy(tt.args.ctx, tt.args.tosLink, tt.args.privacyLink, tt.args.helpLink, tt.args.supportEmail)
+			got, err := r.AddDefaultPrivacyPolicy(tt.args.ctx, tt.args.tosLink, tt.args.privacyLink, tt.args.helpLink, tt.args.supportEmail, false)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -227,6 +230,7 @@ func TestCommandSide_ChangeDefaultPrivacyPolicy(t *testing.T) {
 								"PrivacyLink",
 								"HelpLink",
 								"support@example.com",
+								false,
 							),
 						),
 					),
@@ -278,6 +282,7 @@ func TestCommandSide_ChangeDefaultPrivacyPolicy(t *testing.T) {
 								"PrivacyLink",
 								"HelpLink",
 								"support@example.com",
+								false,
 							),
 						),
 					),
@@ -0,0 +1,110 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+func (c *Commands) AddDefaultUserLifecyclePolicy(ctx context.Context, inactivityDeactivation, deactivatedRemoval, notificationPeriod time.Duration) (*domain.ObjectDetails, error) {
+	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, prepareAddDefaultUserLifecyclePolicy(instanceAgg, inactivityDeactivation, deactivatedRemoval, notificationPeriod))
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(pushedEvents), nil
+}
+
+func (c *Commands) ChangeDefaultUserLifecyclePolicy(ctx context.Context, policy *domain.UserLifecyclePolicy) (*domain.UserLifecyclePolicy, error) {
+	if err := validateUserLifecyclePolicy(policy.InactivityDeactivation, policy.DeactivatedRemoval, policy.NotificationPeriod); err != nil {
+		return nil, err
+	}
+	existingPolicy, err := c.defaultUserLifecyclePolicyWriteModelByID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	instanceAgg := InstanceAggregateFromWriteModel(&existingPolicy.UserLifecyclePolicyWriteModel.WriteModel)
+	var event eventstore.Command
+	// instances set up before the user lifecycle policy existed have no default policy yet
+	if existingPolicy.State == domain.PolicyStateUnspecified || existingPolicy.State == domain.PolicyStateRemoved {
+		event = instance.NewUserLifecyclePolicyAddedEvent(ctx, instanceAgg, policy.InactivityDeactivation, policy.DeactivatedRemoval, policy.NotificationPeriod)
+	} else {
+		changedEvent, hasChanged := existingPolicy.NewChangedEvent(ctx, instanceAgg, policy.InactivityDeactivation, policy.DeactivatedRemoval, policy.NotificationPeriod)
+		if !hasChanged {
+			return nil, caos_errs.ThrowPreconditionFailed(nil, "INSTANCE-Ul3nc", "Errors.IAM.UserLifecyclePolicy.NotChanged")
+		}
+		event = changedEvent
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToUserLifecyclePolicy(&existingPolicy.UserLifecyclePolicyWriteModel), nil
+}
+
+func (c *Commands) defaultUserLifecyclePolicyWriteModelByID(ctx context.Context) (policy *InstanceUserLifecyclePolicyWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewInstanceUserLifecyclePolicyWriteModel(ctx)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+func prepareAddDefaultUserLifecyclePolicy(
+	a *instance.Aggregate,
+	inactivityDeactivation,
+	deactivatedRemoval,
+	notificationPeriod time.Duration,
+) preparation.Validation {
+	return func() (preparation.CreateCommands, error) {
+		if err := validateUserLifecyclePolicy(inactivityDeactivation, deactivatedRemoval, notificationPeriod); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
+			writeModel := NewInstanceUserLifecyclePolicyWriteModel(ctx)
+			events, err := filter(ctx, writeModel.Query())
+			if err != nil {
+				return nil, err
+			}
+			writeModel.AppendEvents(events...)
+			if err = writeModel.Reduce(); err != nil {
+				return nil, err
+			}
+			if writeModel.State == domain.PolicyStateActive {
+				return nil, caos_errs.ThrowAlreadyExists(nil, "INSTANCE-Ul9fe", "Errors.Instance.UserLifecyclePolicy.AlreadyExists")
+			}
+			return []eventstore.Command{
+				instance.NewUserLifecyclePolicyAddedEvent(ctx, &a.Aggregate, inactivityDeactivation, deactivatedRemoval, notificationPeriod),
+			}, nil
+		}, nil
+	}
+}
+
+// validateUserLifecyclePolicy ensures the durations are not negative,
+// a duration of 0 disables the corresponding step
+func validateUserLifecyclePolicy(inactivityDeactivation, deactivatedRemoval, notificationPeriod time.Duration) error {
+	if inactivityDeactivation < 0 || deactivatedRemoval < 0 || notificationPeriod < 0 {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Ul0ds", "Errors.UserLifecyclePolicy.Invalid")
+	}
+	return nil
+}
@@ -0,0 +1,291 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+func TestCommandSide_AddDefaultUserLifecyclePolicy(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx                    context.Context
+		inactivityDeactivation time.Duration
+		deactivatedRemoval     time.Duration
+		notificationPeriod     time.Duration
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "negative duration, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:                    context.Background(),
+				inactivityDeactivation: -time.Hour,
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "user lifecycle policy already existing, already exists error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewUserLifecyclePolicyAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								time.Hour,
+								time.Hour,
+								time.Minute,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:                    context.Background(),
+				inactivityDeactivation: time.Hour,
+				deactivatedRemoval:     time.Hour,
+				notificationPeriod:     time.Minute,
+			},
+			res: res{
+				err: caos_errs.IsErrorAlreadyExists,
+			},
+		},
+		{
+			name: "add policy,ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID(
+								"INSTANCE",
+								instance.NewUserLifecyclePolicyAddedEvent(context.Background(),
+									&instance.NewAggregate("INSTANCE").Aggregate,
+									time.Hour,
+									time.Hour,
+									time.Minute,
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx:                    authz.WithInstanceID(context.Background(), "INSTANCE"),
+				inactivityDeactivation: time.Hour,
+				deactivatedRemoval:     time.Hour,
+				notificationPeriod:     time.Minute,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.AddDefaultUserLifecyclePolicy(tt.args.ctx, tt.args.inactivityDeactivation, tt.args.deactivatedRemoval, tt.args.notificationPeriod)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeDefaultUserLifecyclePolicy(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx    context.Context
+		policy *domain.UserLifecyclePolicy
+	}
+	type res struct {
+		want *domain.UserLifecyclePolicy
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "user lifecycle policy not existing, added",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID(
+								"INSTANCE",
+								instance.NewUserLifecyclePolicyAddedEvent(context.Background(),
+									&instance.NewAggregate("INSTANCE").Aggregate,
+									time.Hour,
+									0,
+									0,
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				policy: &domain.UserLifecyclePolicy{
+					InactivityDeactivation: time.Hour,
+				},
+			},
+			res: res{
+				want: &domain.UserLifecyclePolicy{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "INSTANCE",
+						ResourceOwner: "INSTANCE",
+						InstanceID:    "INSTANCE",
+					},
+					InactivityDeactivation: time.Hour,
+				},
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewUserLifecyclePolicyAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								time.Hour,
+								time.Hour,
+								time.Minute,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				policy: &domain.UserLifecyclePolicy{
+					InactivityDeactivation: time.Hour,
+					DeactivatedRemoval:     time.Hour,
+					NotificationPeriod:     time.Minute,
+				},
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "change, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewUserLifecyclePolicyAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								time.Hour,
+								time.Hour,
+								time.Minute,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								newDefaultUserLifecyclePolicyChangedEvent(context.Background(), 2*time.Hour, 0),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				policy: &domain.UserLifecyclePolicy{
+					InactivityDeactivation: 2 * time.Hour,
+					NotificationPeriod:     time.Minute,
+				},
+			},
+			res: res{
+				want: &domain.UserLifecyclePolicy{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "INSTANCE",
+						ResourceOwner: "INSTANCE",
+					},
+					InactivityDeactivation: 2 * time.Hour,
+					NotificationPeriod:     time.Minute,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.ChangeDefaultUserLifecyclePolicy(tt.args.ctx, tt.args.policy)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func newDefaultUserLifecyclePolicyChangedEvent(ctx context.Context, inactivityDeactivation, deactivatedRemoval time.Duration) *instance.UserLifecyclePolicyChangedEvent {
+	event, _ := instance.NewUserLifecyclePolicyChangedEvent(ctx,
+		&instance.NewAggregate("INSTANCE").Aggregate,
+		[]policy.UserLifecyclePolicyChanges{
+			policy.ChangeInactivityDeactivation(inactivityDeactivation),
+			policy.ChangeDeactivatedRemoval(deactivatedRemoval),
+		},
+	)
+	return event
+}
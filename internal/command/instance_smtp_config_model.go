@@ -82,6 +82,8 @@ func (wm *InstanceSMTPConfigWriteModel) Reduce() error {
 			if e.User != nil {
 				wm.User = *e.User
 			}
+		case *instance.SMTPConfigPasswordReencryptedEvent:
+			wm.Password = e.Password
 		case *instance.SMTPConfigRemovedEvent:
 			wm.State = domain.SMTPConfigStateRemoved
 			wm.TLS = false
@@ -115,6 +117,7 @@ func (wm *InstanceSMTPConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
 			instance.SMTPConfigAddedEventType,
 			instance.SMTPConfigChangedEventType,
 			instance.SMTPConfigPasswordChangedEventType,
+			instance.SMTPConfigPasswordReencryptedEventType,
 			instance.InstanceDomainAddedEventType,
 			instance.InstanceDomainRemovedEventType,
 			instance.DomainPolicyAddedEventType,
@@ -11,32 +11,131 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
 	"github.com/zitadel/zitadel/internal/repository/keypair"
 )
 
 func (c *Commands) GenerateSigningKeyPair(ctx context.Context, algorithm string) error {
-	privateCrypto, publicCrypto, err := crypto.GenerateEncryptedKeyPair(c.keySize, c.keyAlgorithm)
-	if err != nil {
-		return err
-	}
-	keyID, err := c.idGenerator.Next()
+	_, _, err := c.AddSigningKeyPair(ctx, algorithm, false)
+	return err
+}
+
+// AddSigningKeyPair generates a new key pair to sign tokens.
+// Staged key pairs are published, but not used for signing until they are activated.
+func (c *Commands) AddSigningKeyPair(ctx context.Context, algorithm string, staged bool) (keyID string, _ *domain.ObjectDetails, err error) {
+	privateCrypto, publicCrypto, err := crypto.GenerateEncryptedSigningKeyPair(algorithm, c.keySize, c.keyAlgorithm)
 	if err != nil {
-		return err
+		return "", nil, err
 	}
-
 	privateKeyExp := time.Now().UTC().Add(c.privateKeyLifetime)
 	publicKeyExp := time.Now().UTC().Add(c.publicKeyLifetime)
+	return c.pushSigningKeyPair(ctx, algorithm, privateCrypto, publicCrypto, privateKeyExp, publicKeyExp, staged, false)
+}
 
+// ImportSigningKeyPair adds the PEM encoded private key to sign tokens with the algorithm until the expiration.
+// Staged key pairs are published, but not used for signing until they are activated.
+func (c *Commands) ImportSigningKeyPair(ctx context.Context, algorithm string, privateKeyPEM []byte, expiration time.Time, staged bool) (keyID string, _ *domain.ObjectDetails, err error) {
+	if !expiration.After(time.Now()) {
+		return "", nil, errors.ThrowInvalidArgument(nil, "COMMA-Kp3vs", "Errors.KeyPair.Expired")
+	}
+	privateKey, err := crypto.BytesToSigningPrivateKey(privateKeyPEM)
+	if err != nil {
+		return "", nil, errors.ThrowInvalidArgument(err, "COMMA-Kp3vt", "Errors.KeyPair.Invalid")
+	}
+	publicKey, err := crypto.SigningPublicKey(algorithm, privateKey)
+	if err != nil {
+		return "", nil, errors.ThrowInvalidArgument(err, "COMMA-Kp3vu", "Errors.KeyPair.Invalid")
+	}
+	privateCrypto, publicCrypto, err := crypto.EncryptSigningKeys(privateKey, publicKey, c.keyAlgorithm)
+	if err != nil {
+		return "", nil, err
+	}
+	expiration = expiration.UTC()
+	return c.pushSigningKeyPair(ctx, algorithm, privateCrypto, publicCrypto, expiration, expiration, staged, true)
+}
+
+func (c *Commands) pushSigningKeyPair(ctx context.Context, algorithm string, privateCrypto, publicCrypto *crypto.CryptoValue, privateKeyExp, publicKeyExp time.Time, staged, imported bool) (string, *domain.ObjectDetails, error) {
+	keyID, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
 	keyPairWriteModel := NewKeyPairWriteModel(keyID, authz.GetInstance(ctx).InstanceID())
 	keyAgg := KeyPairAggregateFromWriteModel(&keyPairWriteModel.WriteModel)
-	_, err = c.eventstore.Push(ctx, keypair.NewAddedEvent(
+	added := keypair.NewAddedEvent(
 		ctx,
 		keyAgg,
 		domain.KeyUsageSigning,
 		algorithm,
 		privateCrypto, publicCrypto,
-		privateKeyExp, publicKeyExp))
-	return err
+		privateKeyExp, publicKeyExp)
+	added.Staged = staged
+	added.Imported = imported
+	pushedEvents, err := c.eventstore.Push(ctx, added)
+	if err != nil {
+		return "", nil, err
+	}
+	return keyID, pushedEventsToObjectDetails(pushedEvents), nil
+}
+
+// ActivateKeyPair starts signing with a staged (published) key pair
+func (c *Commands) ActivateKeyPair(ctx context.Context, keyID string) (*domain.ObjectDetails, error) {
+	keyPairWriteModel, err := c.getKeyPairWriteModelByID(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	if keyPairWriteModel.State != domain.KeyPairStatePublished {
+		return nil, errors.ThrowPreconditionFailed(nil, "COMMA-Kp4va", "Errors.KeyPair.NotPublished")
+	}
+	if !keyPairWriteModel.PrivateKey.Expiry.After(time.Now()) {
+		return nil, errors.ThrowPreconditionFailed(nil, "COMMA-Kp4vb", "Errors.KeyPair.Expired")
+	}
+	keyAgg := KeyPairAggregateFromWriteModel(&keyPairWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, keypair.NewActivatedEvent(ctx, keyAgg))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(keyPairWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&keyPairWriteModel.WriteModel), nil
+}
+
+// DeactivateKeyPair stops signing with the key pair and removes it from the published keys,
+// so tokens signed with it can no longer be verified (e.g. after a key was leaked)
+func (c *Commands) DeactivateKeyPair(ctx context.Context, keyID string) (*domain.ObjectDetails, error) {
+	keyPairWriteModel, err := c.getKeyPairWriteModelByID(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	if keyPairWriteModel.State == domain.KeyPairStateInactive {
+		return nil, errors.ThrowPreconditionFailed(nil, "COMMA-Kp4vc", "Errors.KeyPair.AlreadyInactive")
+	}
+	keyAgg := KeyPairAggregateFromWriteModel(&keyPairWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, keypair.NewDeactivatedEvent(ctx, keyAgg))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(keyPairWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&keyPairWriteModel.WriteModel), nil
+}
+
+func (c *Commands) getKeyPairWriteModelByID(ctx context.Context, keyID string) (*KeyPairWriteModel, error) {
+	if keyID == "" {
+		return nil, errors.ThrowInvalidArgument(nil, "COMMA-Kp4vd", "Errors.IDMissing")
+	}
+	keyPairWriteModel := NewKeyPairWriteModel(keyID, authz.GetInstance(ctx).InstanceID())
+	err := c.eventstore.FilterToQueryReducer(ctx, keyPairWriteModel)
+	if err != nil {
+		return nil, err
+	}
+	if !keyPairWriteModel.State.Exists() {
+		return nil, errors.ThrowNotFound(nil, "COMMA-Kp4ve", "Errors.KeyPair.NotFound")
+	}
+	return keyPairWriteModel, nil
 }
 
 func (c *Commands) GenerateSAMLCACertificate(ctx context.Context, algorithm string) error {
@@ -85,11 +184,80 @@ func (c *Commands) GenerateSAMLCACertificate(ctx context.Context, algorithm stri
 }
 
 func (c *Commands) GenerateSAMLResponseCertificate(ctx context.Context, algorithm string, caPrivateKey *rsa.PrivateKey, caCertificate []byte) error {
+	_, _, err := c.generateSAMLResponseCertificate(ctx, algorithm, caPrivateKey, caCertificate, false)
+	return err
+}
+
+// AddSAMLResponseCertificate generates a new certificate to sign SAML responses, issued by the current SAML CA of the instance.
+// Staged certificates are not used for signing until they are activated.
+func (c *Commands) AddSAMLResponseCertificate(ctx context.Context, staged bool) (keyID string, _ *domain.ObjectDetails, err error) {
+	caWriteModel := newSAMLCAWriteModel(authz.GetInstance(ctx).InstanceID())
+	err = c.eventstore.FilterToQueryReducer(ctx, caWriteModel)
+	if err != nil {
+		return "", nil, err
+	}
+	ca := caWriteModel.currentCA()
+	if ca == nil {
+		return "", nil, errors.ThrowPreconditionFailed(nil, "COMMA-Kp5va", "Errors.KeyPair.SAMLCANotFound")
+	}
+	caKeyData, err := crypto.Decrypt(ca.PrivateKey.Key, c.keyAlgorithm)
+	if err != nil {
+		return "", nil, err
+	}
+	caPrivateKey, err := crypto.BytesToPrivateKey(caKeyData)
+	if err != nil {
+		return "", nil, err
+	}
+	caCertificateData, err := crypto.Decrypt(ca.Certificate.Key, c.certificateAlgorithm)
+	if err != nil {
+		return "", nil, err
+	}
+	caCertificate, err := crypto.BytesToCertificate(caCertificateData)
+	if err != nil {
+		return "", nil, err
+	}
+	return c.generateSAMLResponseCertificate(ctx, ca.Algorithm, caPrivateKey, caCertificate, staged)
+}
+
+// ImportSAMLResponseCertificate adds the PEM encoded RSA private key and certificate to sign SAML responses.
+// The key pair expires with the certificate.
+// Staged certificates are not used for signing until they are activated.
+func (c *Commands) ImportSAMLResponseCertificate(ctx context.Context, privateKeyPEM, certificatePEM []byte, staged bool) (keyID string, _ *domain.ObjectDetails, err error) {
+	privateKey, err := crypto.BytesToPrivateKey(privateKeyPEM)
+	if err != nil {
+		return "", nil, errors.ThrowInvalidArgument(err, "COMMA-Kp5vb", "Errors.KeyPair.Invalid")
+	}
+	certificateDER, err := crypto.BytesToCertificate(certificatePEM)
+	if err != nil {
+		return "", nil, errors.ThrowInvalidArgument(err, "COMMA-Kp5vc", "Errors.KeyPair.Invalid")
+	}
+	certificate, err := x509.ParseCertificate(certificateDER)
+	if err != nil {
+		return "", nil, errors.ThrowInvalidArgument(err, "COMMA-Kp5vd", "Errors.KeyPair.Invalid")
+	}
+	if !privateKey.PublicKey.Equal(certificate.PublicKey) {
+		return "", nil, errors.ThrowInvalidArgument(nil, "COMMA-Kp5ve", "Errors.KeyPair.Invalid")
+	}
+	if !certificate.NotAfter.After(time.Now()) {
+		return "", nil, errors.ThrowInvalidArgument(nil, "COMMA-Kp5vf", "Errors.KeyPair.Expired")
+	}
+	certificatePEM, err = crypto.CertificateToBytes(certificate)
+	if err != nil {
+		return "", nil, err
+	}
+	privateCrypto, publicCrypto, certificateCrypto, err := crypto.EncryptKeysAndCert(privateKey, &privateKey.PublicKey, certificatePEM, c.keyAlgorithm, c.certificateAlgorithm)
+	if err != nil {
+		return "", nil, err
+	}
+	return c.pushSAMLResponseCertificate(ctx, crypto.SigningAlgorithmRS256, privateCrypto, publicCrypto, certificateCrypto, certificate.NotAfter.UTC(), staged, true)
+}
+
+func (c *Commands) generateSAMLResponseCertificate(ctx context.Context, algorithm string, caPrivateKey *rsa.PrivateKey, caCertificate []byte, staged bool) (string, *domain.ObjectDetails, error) {
 	now := time.Now().UTC()
 	after := now.Add(c.certificateLifetime)
 	randInt, err := rand.Int(rand.Reader, big.NewInt(1000))
 	if err != nil {
-		return err
+		return "", nil, err
 	}
 
 	privateCrypto, publicCrypto, certificateCrypto, err := crypto.GenerateEncryptedKeyPairWithCertificate(c.certKeySize, c.keyAlgorithm, c.certificateAlgorithm, caPrivateKey, caCertificate, &crypto.CertificateInformations{
@@ -102,32 +270,42 @@ func (c *Commands) GenerateSAMLResponseCertificate(ctx context.Context, algorith
 		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
 	})
 	if err != nil {
-		return err
+		return "", nil, err
 	}
+	return c.pushSAMLResponseCertificate(ctx, algorithm, privateCrypto, publicCrypto, certificateCrypto, after, staged, false)
+}
+
+func (c *Commands) pushSAMLResponseCertificate(ctx context.Context, algorithm string, privateCrypto, publicCrypto, certificateCrypto *crypto.CryptoValue, expiration time.Time, staged, imported bool) (string, *domain.ObjectDetails, error) {
 	keyID, err := c.idGenerator.Next()
 	if err != nil {
-		return err
+		return "", nil, err
 	}
 
 	keyPairWriteModel := NewKeyPairWriteModel(keyID, authz.GetInstance(ctx).InstanceID())
 	keyAgg := KeyPairAggregateFromWriteModel(&keyPairWriteModel.WriteModel)
-	_, err = c.eventstore.Push(ctx,
-		keypair.NewAddedEvent(
-			ctx,
-			keyAgg,
-			domain.KeyUsageSAMLResponseSinging,
-			algorithm,
-			privateCrypto, publicCrypto,
-			after, after,
-		),
+	added := keypair.NewAddedEvent(
+		ctx,
+		keyAgg,
+		domain.KeyUsageSAMLResponseSinging,
+		algorithm,
+		privateCrypto, publicCrypto,
+		expiration, expiration,
+	)
+	added.Staged = staged
+	added.Imported = imported
+	pushedEvents, err := c.eventstore.Push(ctx,
+		added,
 		keypair.NewAddedCertificateEvent(
 			ctx,
 			keyAgg,
 			certificateCrypto,
-			after,
+			expiration,
 		),
 	)
-	return err
+	if err != nil {
+		return "", nil, err
+	}
+	return keyID, pushedEventsToObjectDetails(pushedEvents), nil
 }
 
 func (c *Commands) GenerateSAMLMetadataCertificate(ctx context.Context, algorithm string, caPrivateKey *rsa.PrivateKey, caCertificate []byte) error {
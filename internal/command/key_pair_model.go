@@ -1,6 +1,8 @@
 package command
 
 import (
+	"time"
+
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/keypair"
@@ -11,6 +13,7 @@ type KeyPairWriteModel struct {
 
 	Usage       domain.KeyUsage
 	Algorithm   string
+	State       domain.KeyPairState
 	PrivateKey  *domain.Key
 	PublicKey   *domain.Key
 	Certificate *domain.Key
@@ -35,6 +38,10 @@ func (wm *KeyPairWriteModel) Reduce() error {
 		case *keypair.AddedEvent:
 			wm.Usage = e.Usage
 			wm.Algorithm = e.Algorithm
+			wm.State = domain.KeyPairStateActive
+			if e.Staged {
+				wm.State = domain.KeyPairStatePublished
+			}
 			wm.PrivateKey = &domain.Key{
 				Key:    e.PrivateKey.Key,
 				Expiry: e.PrivateKey.Expiry,
@@ -48,6 +55,10 @@ func (wm *KeyPairWriteModel) Reduce() error {
 				Key:    e.Certificate.Key,
 				Expiry: e.Certificate.Expiry,
 			}
+		case *keypair.ActivatedEvent:
+			wm.State = domain.KeyPairStateActive
+		case *keypair.DeactivatedEvent:
+			wm.State = domain.KeyPairStateInactive
 		}
 	}
 	return wm.WriteModel.Reduce()
@@ -59,10 +70,85 @@ func (wm *KeyPairWriteModel) Query() *eventstore.SearchQueryBuilder {
 		AddQuery().
 		AggregateTypes(keypair.AggregateType).
 		AggregateIDs(wm.AggregateID).
-		EventTypes(keypair.AddedEventType, keypair.AddedCertificateEventType).
+		EventTypes(
+			keypair.AddedEventType,
+			keypair.AddedCertificateEventType,
+			keypair.ActivatedEventType,
+			keypair.DeactivatedEventType,
+		).
 		Builder()
 }
 
 func KeyPairAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
 	return eventstore.AggregateFromWriteModel(wm, keypair.AggregateType, keypair.AggregateVersion)
 }
+
+// samlCAWriteModel collects the SAML CA key pairs of an instance
+// to issue new SAML certificates outside of the SAML provider
+type samlCAWriteModel struct {
+	eventstore.WriteModel
+
+	keyPairs map[string]*KeyPairWriteModel
+}
+
+func newSAMLCAWriteModel(instanceID string) *samlCAWriteModel {
+	return &samlCAWriteModel{
+		WriteModel: eventstore.WriteModel{
+			ResourceOwner: instanceID,
+		},
+		keyPairs: make(map[string]*KeyPairWriteModel),
+	}
+}
+
+func (wm *samlCAWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		id := event.Aggregate().ID
+		keyPair, ok := wm.keyPairs[id]
+		if !ok {
+			keyPair = NewKeyPairWriteModel(id, wm.ResourceOwner)
+			wm.keyPairs[id] = keyPair
+		}
+		keyPair.AppendEvents(event)
+	}
+	wm.WriteModel.AppendEvents(events...)
+}
+
+func (wm *samlCAWriteModel) Reduce() error {
+	for _, keyPair := range wm.keyPairs {
+		if err := keyPair.Reduce(); err != nil {
+			return err
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *samlCAWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(keypair.AggregateType).
+		EventTypes(
+			keypair.AddedEventType,
+			keypair.AddedCertificateEventType,
+			keypair.ActivatedEventType,
+			keypair.DeactivatedEventType,
+		).
+		Builder()
+}
+
+// currentCA returns the active CA with the latest expiry, the same one the SAML provider uses
+func (wm *samlCAWriteModel) currentCA() *KeyPairWriteModel {
+	var current *KeyPairWriteModel
+	for _, keyPair := range wm.keyPairs {
+		if keyPair.Usage != domain.KeyUsageSAMLCA ||
+			keyPair.State != domain.KeyPairStateActive ||
+			keyPair.Certificate == nil ||
+			!keyPair.PrivateKey.Expiry.After(time.Now()) {
+			continue
+		}
+		if current == nil || keyPair.PrivateKey.Expiry.After(current.PrivateKey.Expiry) {
+			current = keyPair
+		}
+	}
+	return current
+}
@@ -0,0 +1,413 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/keypair"
+)
+
+func keyPairAddedEvent(ctx context.Context, keyID string, staged bool, expiry time.Time) *keypair.AddedEvent {
+	event := keypair.NewAddedEvent(ctx,
+		&keypair.NewAggregate(keyID, "instance1").Aggregate,
+		domain.KeyUsageSigning,
+		crypto.SigningAlgorithmES256,
+		&crypto.CryptoValue{
+			CryptoType: crypto.TypeEncryption,
+			Algorithm:  "enc",
+			KeyID:      "id",
+			Crypted:    []byte("private"),
+		},
+		&crypto.CryptoValue{
+			CryptoType: crypto.TypeEncryption,
+			Algorithm:  "enc",
+			KeyID:      "id",
+			Crypted:    []byte("public"),
+		},
+		expiry,
+		expiry,
+	)
+	event.Staged = staged
+	return event
+}
+
+func TestCommandSide_ActivateKeyPair(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		keyID string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing id, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{},
+			res: res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "not found, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				keyID: "key1",
+			},
+			res: res{
+				err: errors.IsNotFound,
+			},
+		},
+		{
+			name: "already active, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							keyPairAddedEvent(ctx, "key1", false, time.Now().Add(time.Hour)),
+						),
+					),
+				),
+			},
+			args: args{
+				keyID: "key1",
+			},
+			res: res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "expired, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							keyPairAddedEvent(ctx, "key1", true, time.Now().Add(-time.Hour)),
+						),
+					),
+				),
+			},
+			args: args{
+				keyID: "key1",
+			},
+			res: res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "activate staged key pair, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							keyPairAddedEvent(ctx, "key1", true, time.Now().Add(time.Hour)),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID("instance1",
+								keypair.NewActivatedEvent(ctx, &keypair.NewAggregate("key1", "instance1").Aggregate),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				keyID: "key1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.ActivateKeyPair(ctx, tt.args.keyID)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_DeactivateKeyPair(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		keyID string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "not found, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				keyID: "key1",
+			},
+			res: res{
+				err: errors.IsNotFound,
+			},
+		},
+		{
+			name: "already inactive, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							keyPairAddedEvent(ctx, "key1", false, time.Now().Add(time.Hour)),
+						),
+						eventFromEventPusher(
+							keypair.NewDeactivatedEvent(ctx, &keypair.NewAggregate("key1", "instance1").Aggregate),
+						),
+					),
+				),
+			},
+			args: args{
+				keyID: "key1",
+			},
+			res: res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "deactivate active key pair, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							keyPairAddedEvent(ctx, "key1", false, time.Now().Add(time.Hour)),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID("instance1",
+								keypair.NewDeactivatedEvent(ctx, &keypair.NewAggregate("key1", "instance1").Aggregate),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				keyID: "key1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.DeactivateKeyPair(ctx, tt.args.keyID)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ImportSigningKeyPair(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	privateKey, publicKey, err := crypto.GenerateSigningKeyPair(crypto.SigningAlgorithmES256, 0)
+	require.NoError(t, err)
+	privateKeyPEM, err := crypto.SigningPrivateKeyToBytes(privateKey)
+	require.NoError(t, err)
+	publicKeyPEM, err := crypto.SigningPublicKeyToBytes(publicKey)
+	require.NoError(t, err)
+	expiration := time.Now().Add(time.Hour).UTC()
+
+	type fields struct {
+		eventstore   *eventstore.Eventstore
+		idGenerator  id.Generator
+		keyAlgorithm crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		algorithm  string
+		privateKey []byte
+		expiration time.Time
+		staged     bool
+	}
+	type res struct {
+		keyID string
+		err   func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "expired, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				algorithm:  crypto.SigningAlgorithmES256,
+				privateKey: privateKeyPEM,
+				expiration: time.Now().Add(-time.Hour),
+			},
+			res: res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid private key, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				algorithm:  crypto.SigningAlgorithmES256,
+				privateKey: []byte("key"),
+				expiration: expiration,
+			},
+			res: res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "algorithm not matching key, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				algorithm:  crypto.SigningAlgorithmRS256,
+				privateKey: privateKeyPEM,
+				expiration: expiration,
+			},
+			res: res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "import staged key pair, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID("instance1",
+								func() eventstore.Command {
+									event := keypair.NewAddedEvent(ctx,
+										&keypair.NewAggregate("key1", "instance1").Aggregate,
+										domain.KeyUsageSigning,
+										crypto.SigningAlgorithmES256,
+										&crypto.CryptoValue{
+											CryptoType: crypto.TypeEncryption,
+											Algorithm:  "enc",
+											KeyID:      "id",
+											Crypted:    privateKeyPEM,
+										},
+										&crypto.CryptoValue{
+											CryptoType: crypto.TypeEncryption,
+											Algorithm:  "enc",
+											KeyID:      "id",
+											Crypted:    publicKeyPEM,
+										},
+										expiration,
+										expiration,
+									)
+									event.Staged = true
+									event.Imported = true
+									return event
+								}(),
+							),
+						},
+					),
+				),
+				idGenerator:  id_mock.NewIDGeneratorExpectIDs(t, "key1"),
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				algorithm:  crypto.SigningAlgorithmES256,
+				privateKey: privateKeyPEM,
+				expiration: expiration,
+				staged:     true,
+			},
+			res: res{
+				keyID: "key1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:   tt.fields.eventstore,
+				idGenerator:  tt.fields.idGenerator,
+				keyAlgorithm: tt.fields.keyAlgorithm,
+			}
+			keyID, _, err := r.ImportSigningKeyPair(ctx, tt.args.algorithm, tt.args.privateKey, tt.args.expiration, tt.args.staged)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.keyID, keyID)
+			}
+		})
+	}
+}
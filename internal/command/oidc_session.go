@@ -63,6 +63,49 @@ func (c *Commands) AddOIDCSessionRefreshAndAccessToken(ctx context.Context, auth
 	return cmd.PushEvents(ctx)
 }
 
+// AddApplicationAccessToken creates a new OIDC Session for an API application authenticating itself (client credentials or private key JWT)
+// and creates an access token for it. It returns the access token id and expiration.
+// As there is no user involved, the application (clientID) is used as the subject of the session.
+func (c *Commands) AddApplicationAccessToken(ctx context.Context, resourceOwner, clientID string, audience, scope []string, authorizationDetails domain.AuthorizationDetails, authTime time.Time) (string, time.Time, error) {
+	if resourceOwner == "" || clientID == "" {
+		return "", time.Time{}, caos_errs.ThrowInvalidArgument(nil, "OIDCS-Ap2ls", "Errors.Internal")
+	}
+	accessTokenLifetime, _, _, err := c.tokenTokenLifetimes(ctx)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	sessionID, err := c.idGenerator.Next()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	sessionID = IDPrefixV2 + sessionID
+	cmd := &OIDCSessionEvents{
+		eventstore:            c.eventstore,
+		idGenerator:           c.idGenerator,
+		encryptionAlg:         c.keyAlgorithm,
+		oidcSessionWriteModel: NewOIDCSessionWriteModel(sessionID, resourceOwner),
+		accessTokenLifetime:   accessTokenLifetime,
+	}
+	added := oidcsession.NewAddedEvent(
+		ctx,
+		cmd.oidcSessionWriteModel.aggregate,
+		clientID,
+		"",
+		clientID,
+		audience,
+		scope,
+		nil,
+		authTime,
+	)
+	added.AuthorizationDetails = authorizationDetails
+	cmd.events = append(cmd.events, added)
+	if err = cmd.AddAccessToken(ctx, scope); err != nil {
+		return "", time.Time{}, err
+	}
+	accessTokenID, _, accessTokenExpiration, err := cmd.PushEvents(ctx)
+	return accessTokenID, accessTokenExpiration, err
+}
+
 // ExchangeOIDCSessionRefreshAndAccessToken updates an existing OIDC Session, creates a new access and refresh token.
 // It returns the access token id and expiration and the new refresh token.
 func (c *Commands) ExchangeOIDCSessionRefreshAndAccessToken(ctx context.Context, oidcSessionID, refreshToken string, scope []string) (tokenID, newRefreshToken string, tokenExpiration time.Time, err error) {
@@ -440,6 +440,134 @@ func TestCommands_AddOIDCSessionRefreshAndAccessToken(t *testing.T) {
 	}
 }
 
+func TestCommands_AddApplicationAccessToken(t *testing.T) {
+	type fields struct {
+		eventstore                 *eventstore.Eventstore
+		idGenerator                id.Generator
+		defaultAccessTokenLifetime time.Duration
+	}
+	type args struct {
+		ctx           context.Context
+		resourceOwner string
+		clientID      string
+		audience      []string
+		scope         []string
+		details       domain.AuthorizationDetails
+		authTime      time.Time
+	}
+	type res struct {
+		id         string
+		expiration time.Time
+		err        error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"missing client error",
+			fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instanceID"),
+				resourceOwner: "org1",
+			},
+			res{
+				err: caos_errs.ThrowInvalidArgument(nil, "OIDCS-Ap2ls", "Errors.Internal"),
+			},
+		},
+		{
+			"add application access token",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(), // token lifetime
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID("instanceID",
+								oidcsession.NewAddedEvent(context.Background(), &oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
+									"clientID", "", "clientID", []string{"projectID"}, []string{"invoices:read"}, nil, testNow),
+							),
+							eventFromEventPusherWithInstanceID("instanceID",
+								oidcsession.NewAccessTokenAddedEvent(context.Background(), &oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
+									"at_accessTokenID", []string{"invoices:read"}, time.Hour),
+							),
+						},
+					),
+				),
+				idGenerator:                mock.NewIDGeneratorExpectIDs(t, "oidcSessionID", "accessTokenID"),
+				defaultAccessTokenLifetime: time.Hour,
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instanceID"),
+				resourceOwner: "org1",
+				clientID:      "clientID",
+				audience:      []string{"projectID"},
+				scope:         []string{"invoices:read"},
+				authTime:      testNow,
+			},
+			res{
+				id:         "V2_oidcSessionID-at_accessTokenID",
+				expiration: tokenCreationNow.Add(time.Hour),
+			},
+		},
+		{
+			"add application access token with authorization details",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(), // token lifetime
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID("instanceID",
+								func() eventstore.Command {
+									e := oidcsession.NewAddedEvent(context.Background(), &oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
+										"clientID", "", "clientID", []string{"projectID"}, []string{"invoices:read"}, nil, testNow)
+									e.AuthorizationDetails = domain.AuthorizationDetails{{"type": "payment_initiation", "amount": "10.00"}}
+									return e
+								}(),
+							),
+							eventFromEventPusherWithInstanceID("instanceID",
+								oidcsession.NewAccessTokenAddedEvent(context.Background(), &oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
+									"at_accessTokenID", []string{"invoices:read"}, time.Hour),
+							),
+						},
+					),
+				),
+				idGenerator:                mock.NewIDGeneratorExpectIDs(t, "oidcSessionID", "accessTokenID"),
+				defaultAccessTokenLifetime: time.Hour,
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instanceID"),
+				resourceOwner: "org1",
+				clientID:      "clientID",
+				audience:      []string{"projectID"},
+				scope:         []string{"invoices:read"},
+				details:       domain.AuthorizationDetails{{"type": "payment_initiation", "amount": "10.00"}},
+				authTime:      testNow,
+			},
+			res{
+				id:         "V2_oidcSessionID-at_accessTokenID",
+				expiration: tokenCreationNow.Add(time.Hour),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:                 tt.fields.eventstore,
+				idGenerator:                tt.fields.idGenerator,
+				defaultAccessTokenLifetime: tt.fields.defaultAccessTokenLifetime,
+			}
+			gotID, gotExpiration, err := c.AddApplicationAccessToken(tt.args.ctx, tt.args.resourceOwner, tt.args.clientID, tt.args.audience, tt.args.scope, tt.args.details, tt.args.authTime)
+			assert.Equal(t, tt.res.id, gotID)
+			assert.Equal(t, tt.res.expiration, gotExpiration)
+			assert.ErrorIs(t, err, tt.res.err)
+		})
+	}
+}
+
 func TestCommands_ExchangeOIDCSessionRefreshAndAccessToken(t *testing.T) {
 	type fields struct {
 		eventstore                      *eventstore.Eventstore
@@ -281,7 +281,7 @@ func (c *Commands) AddOrgWithID(ctx context.Context, name, userID, resourceOwner
 		return nil, errors.ThrowNotFound(nil, "ORG-lapo2m", "Errors.Org.AlreadyExisting")
 	}
 
-	return c.addOrgWithIDAndMember(ctx, name, userID, resourceOwner, orgID, claimedUserIDs)
+	return c.addOrgWithIDAndMember(ctx, name, userID, resourceOwner, orgID, "", claimedUserIDs)
 }
 
 func (c *Commands) AddOrg(ctx context.Context, name, userID, resourceOwner string, claimedUserIDs []string) (*domain.Org, error) {
@@ -294,14 +294,34 @@ func (c *Commands) AddOrg(ctx context.Context, name, userID, resourceOwner strin
 		return nil, errors.ThrowInternal(err, "COMMA-OwciI", "Errors.Internal")
 	}
 
-	return c.addOrgWithIDAndMember(ctx, name, userID, resourceOwner, orgID, claimedUserIDs)
+	return c.addOrgWithIDAndMember(ctx, name, userID, resourceOwner, orgID, "", claimedUserIDs)
 }
 
-func (c *Commands) addOrgWithIDAndMember(ctx context.Context, name, userID, resourceOwner, orgID string, claimedUserIDs []string) (*domain.Org, error) {
+// AddChildOrg adds an organization below the parent organization in the hierarchy
+func (c *Commands) AddChildOrg(ctx context.Context, parentOrgID, name, userID, resourceOwner string, claimedUserIDs []string) (*domain.Org, error) {
+	if name = strings.TrimSpace(name); name == "" || parentOrgID == "" {
+		return nil, errors.ThrowInvalidArgument(nil, "COMMAND-Ph4ma", "Errors.Org.Invalid")
+	}
+
+	orgID, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "COMMAND-Ph9xw", "Errors.Internal")
+	}
+
+	return c.addOrgWithIDAndMember(ctx, name, userID, resourceOwner, orgID, parentOrgID, claimedUserIDs)
+}
+
+func (c *Commands) addOrgWithIDAndMember(ctx context.Context, name, userID, resourceOwner, orgID, parentOrgID string, claimedUserIDs []string) (*domain.Org, error) {
 	orgAgg, addedOrg, events, err := c.addOrgWithID(ctx, &domain.Org{Name: name}, orgID, claimedUserIDs)
 	if err != nil {
 		return nil, err
 	}
+	if parentOrgID != "" {
+		if err = c.checkOrgParent(ctx, orgID, parentOrgID, 0); err != nil {
+			return nil, err
+		}
+		events = append(events, org.NewOrgParentSetEvent(ctx, orgAgg, parentOrgID))
+	}
 	err = c.checkUserExists(ctx, userID, resourceOwner)
 	if err != nil {
 		return nil, err
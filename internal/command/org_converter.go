@@ -10,6 +10,7 @@ func orgWriteModelToOrg(wm *OrgWriteModel) *domain.Org {
 		Name:          wm.Name,
 		State:         wm.State,
 		PrimaryDomain: wm.PrimaryDomain,
+		ParentOrgID:   wm.ParentOrgID,
 	}
 }
 
@@ -46,10 +47,11 @@ func orgDomainWriteModelToOrgDomain(wm *OrgDomainWriteModel) *domain.OrgDomain {
 
 func orgWriteModelToPrivacyPolicy(wm *OrgPrivacyPolicyWriteModel) *domain.PrivacyPolicy {
 	return &domain.PrivacyPolicy{
-		ObjectRoot:  writeModelToObjectRoot(wm.PrivacyPolicyWriteModel.WriteModel),
-		TOSLink:     wm.TOSLink,
-		PrivacyLink: wm.PrivacyLink,
-		HelpLink:    wm.HelpLink,
-		SupportEmail: wm.SupportEmail,
+		ObjectRoot:        writeModelToObjectRoot(wm.PrivacyPolicyWriteModel.WriteModel),
+		TOSLink:           wm.TOSLink,
+		PrivacyLink:       wm.PrivacyLink,
+		HelpLink:          wm.HelpLink,
+		SupportEmail:      wm.SupportEmail,
+		AllowSelfDeletion: wm.AllowSelfDeletion,
 	}
 }
@@ -0,0 +1,100 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+// SetOrgParent places the organization below the parent organization in the hierarchy
+func (c *Commands) SetOrgParent(ctx context.Context, orgID, parentOrgID string) (*domain.ObjectDetails, error) {
+	if orgID == "" || parentOrgID == "" {
+		return nil, errors.ThrowInvalidArgument(nil, "COMMAND-Ph2lq", "Errors.Org.Hierarchy.Invalid")
+	}
+	orgWriteModel, err := c.getOrgWriteModelByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if !isOrgStateExists(orgWriteModel.State) {
+		return nil, errors.ThrowNotFound(nil, "COMMAND-Ph5wn", "Errors.Org.NotFound")
+	}
+	if orgWriteModel.ParentOrgID == parentOrgID {
+		return nil, errors.ThrowPreconditionFailed(nil, "COMMAND-Ph8sm", "Errors.Org.NotChanged")
+	}
+	hierarchy := NewOrgHierarchyWriteModel()
+	if err = c.eventstore.FilterToQueryReducer(ctx, hierarchy); err != nil {
+		return nil, err
+	}
+	if err = c.checkOrgParent(ctx, orgID, parentOrgID, hierarchy.SubtreeHeight(orgID)); err != nil {
+		return nil, err
+	}
+	orgAgg := OrgAggregateFromWriteModel(&orgWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewOrgParentSetEvent(ctx, orgAgg, parentOrgID))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(orgWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&orgWriteModel.WriteModel), nil
+}
+
+// RemoveOrgParent detaches the organization from its parent organization
+func (c *Commands) RemoveOrgParent(ctx context.Context, orgID string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, errors.ThrowInvalidArgument(nil, "COMMAND-Ph3ve", "Errors.Org.Hierarchy.Invalid")
+	}
+	orgWriteModel, err := c.getOrgWriteModelByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if !isOrgStateExists(orgWriteModel.State) {
+		return nil, errors.ThrowNotFound(nil, "COMMAND-Ph6ko", "Errors.Org.NotFound")
+	}
+	if orgWriteModel.ParentOrgID == "" {
+		return nil, errors.ThrowPreconditionFailed(nil, "COMMAND-Ph1dr", "Errors.Org.Hierarchy.NoParent")
+	}
+	orgAgg := OrgAggregateFromWriteModel(&orgWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewOrgParentRemovedEvent(ctx, orgAgg, orgWriteModel.ParentOrgID))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(orgWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&orgWriteModel.WriteModel), nil
+}
+
+// checkOrgParent ensures the parent organization exists
+// and that setting it would neither create a cycle nor exceed the maximum depth of the hierarchy.
+// The subtree height of the organization is taken into account, as its descendants move along with it
+func (c *Commands) checkOrgParent(ctx context.Context, orgID, parentOrgID string, subtreeHeight int) error {
+	if orgID == parentOrgID {
+		return errors.ThrowInvalidArgument(nil, "COMMAND-Ph7yt", "Errors.Org.Hierarchy.Cycle")
+	}
+	ancestorID := parentOrgID
+	for depth := 1 + subtreeHeight; ancestorID != ""; depth++ {
+		if depth > domain.OrgHierarchyMaxDepth {
+			return errors.ThrowPreconditionFailed(nil, "COMMAND-Ph4gk", "Errors.Org.Hierarchy.TooDeep")
+		}
+		ancestor, err := c.getOrgWriteModelByID(ctx, ancestorID)
+		if err != nil {
+			return err
+		}
+		if !isOrgStateExists(ancestor.State) {
+			if ancestorID == parentOrgID {
+				return errors.ThrowPreconditionFailed(nil, "COMMAND-Ph9bq", "Errors.Org.Hierarchy.ParentNotFound")
+			}
+			return nil
+		}
+		if ancestor.ParentOrgID == orgID {
+			return errors.ThrowPreconditionFailed(nil, "COMMAND-Ph2ux", "Errors.Org.Hierarchy.Cycle")
+		}
+		ancestorID = ancestor.ParentOrgID
+	}
+	return nil
+}
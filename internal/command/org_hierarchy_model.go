@@ -0,0 +1,64 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+// OrgHierarchyWriteModel holds the parent organization of every organization of the instance placed in the hierarchy
+type OrgHierarchyWriteModel struct {
+	eventstore.WriteModel
+
+	ParentOrgIDs map[string]string
+}
+
+func NewOrgHierarchyWriteModel() *OrgHierarchyWriteModel {
+	return &OrgHierarchyWriteModel{
+		ParentOrgIDs: make(map[string]string),
+	}
+}
+
+func (wm *OrgHierarchyWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.OrgParentSetEvent:
+			wm.ParentOrgIDs[e.Aggregate().ID] = e.ParentOrgID
+		case *org.OrgParentRemovedEvent:
+			delete(wm.ParentOrgIDs, e.Aggregate().ID)
+		case *org.OrgRemovedEvent:
+			delete(wm.ParentOrgIDs, e.Aggregate().ID)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgHierarchyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		EventTypes(
+			org.OrgParentSetEventType,
+			org.OrgParentRemovedEventType,
+			org.OrgRemovedEventType).
+		Builder()
+}
+
+// SubtreeHeight returns the number of levels of descendants below the organization,
+// 0 if the organization has no children
+func (wm *OrgHierarchyWriteModel) SubtreeHeight(orgID string) int {
+	children := make(map[string][]string, len(wm.ParentOrgIDs))
+	for childID, parentID := range wm.ParentOrgIDs {
+		children[parentID] = append(children[parentID], childID)
+	}
+	height := 0
+	// the depth limit guards against cycles
+	for level := children[orgID]; len(level) > 0 && height <= domain.OrgHierarchyMaxDepth; height++ {
+		next := make([]string, 0)
+		for _, childID := range level {
+			next = append(next, children[childID]...)
+		}
+		level = next
+	}
+	return height
+}
@@ -33,6 +33,8 @@ func (wm *OrgOAuthIDPWriteModel) AppendEvents(events ...eventstore.Event) {
 			wm.OAuthIDPWriteModel.AppendEvents(&e.OAuthIDPAddedEvent)
 		case *org.OAuthIDPChangedEvent:
 			wm.OAuthIDPWriteModel.AppendEvents(&e.OAuthIDPChangedEvent)
+		case *org.IDPSecretReencryptedEvent:
+			wm.OAuthIDPWriteModel.AppendEvents(&e.SecretReencryptedEvent)
 		case *org.IDPRemovedEvent:
 			wm.OAuthIDPWriteModel.AppendEvents(&e.RemovedEvent)
 		default:
@@ -50,6 +52,7 @@ func (wm *OrgOAuthIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
 		EventTypes(
 			org.OAuthIDPAddedEventType,
 			org.OAuthIDPChangedEventType,
+			org.IDPSecretReencryptedEventType,
 			org.IDPRemovedEventType,
 		).
 		EventData(map[string]interface{}{"id": wm.ID}).
@@ -117,6 +120,8 @@ func (wm *OrgOIDCIDPWriteModel) AppendEvents(events ...eventstore.Event) {
 			wm.OIDCIDPWriteModel.AppendEvents(&e.OIDCIDPMigratedAzureADEvent)
 		case *org.OIDCIDPMigratedGoogleEvent:
 			wm.OIDCIDPWriteModel.AppendEvents(&e.OIDCIDPMigratedGoogleEvent)
+		case *org.IDPSecretReencryptedEvent:
+			wm.OIDCIDPWriteModel.AppendEvents(&e.SecretReencryptedEvent)
 		case *org.IDPRemovedEvent:
 			wm.OIDCIDPWriteModel.AppendEvents(&e.RemovedEvent)
 
@@ -148,6 +153,7 @@ func (wm *OrgOIDCIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
 			org.OIDCIDPChangedEventType,
 			org.OIDCIDPMigratedAzureADEventType,
 			org.OIDCIDPMigratedGoogleEventType,
+			org.IDPSecretReencryptedEventType,
 			org.IDPRemovedEventType,
 		).
 		EventData(map[string]interface{}{"id": wm.ID}).
@@ -319,6 +325,8 @@ func (wm *OrgAzureADIDPWriteModel) AppendEvents(events ...eventstore.Event) {
 			wm.AzureADIDPWriteModel.AppendEvents(&e.AzureADIDPChangedEvent)
 		case *org.OIDCIDPMigratedAzureADEvent:
 			wm.AzureADIDPWriteModel.AppendEvents(&e.OIDCIDPMigratedAzureADEvent)
+		case *org.IDPSecretReencryptedEvent:
+			wm.AzureADIDPWriteModel.AppendEvents(&e.SecretReencryptedEvent)
 		case *org.IDPRemovedEvent:
 			wm.AzureADIDPWriteModel.AppendEvents(&e.RemovedEvent)
 		default:
@@ -337,6 +345,7 @@ func (wm *OrgAzureADIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
 			org.AzureADIDPAddedEventType,
 			org.AzureADIDPChangedEventType,
 			org.OIDCIDPMigratedAzureADEventType,
+			org.IDPSecretReencryptedEventType,
 			org.IDPRemovedEventType,
 		).
 		EventData(map[string]interface{}{"id": wm.ID}).
@@ -396,6 +405,8 @@ func (wm *OrgGitHubIDPWriteModel) AppendEvents(events ...eventstore.Event) {
 			wm.GitHubIDPWriteModel.AppendEvents(&e.GitHubIDPAddedEvent)
 		case *org.GitHubIDPChangedEvent:
 			wm.GitHubIDPWriteModel.AppendEvents(&e.GitHubIDPChangedEvent)
+		case *org.IDPSecretReencryptedEvent:
+			wm.GitHubIDPWriteModel.AppendEvents(&e.SecretReencryptedEvent)
 		case *org.IDPRemovedEvent:
 			wm.GitHubIDPWriteModel.AppendEvents(&e.RemovedEvent)
 		default:
@@ -413,6 +424,7 @@ func (wm *OrgGitHubIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
 		EventTypes(
 			org.GitHubIDPAddedEventType,
 			org.GitHubIDPChangedEventType,
+			org.IDPSecretReencryptedEventType,
 			org.IDPRemovedEventType,
 		).
 		EventData(map[string]interface{}{"id": wm.ID}).
@@ -462,6 +474,8 @@ func (wm *OrgGitHubEnterpriseIDPWriteModel) AppendEvents(events ...eventstore.Ev
 			wm.GitHubEnterpriseIDPWriteModel.AppendEvents(&e.GitHubEnterpriseIDPAddedEvent)
 		case *org.GitHubEnterpriseIDPChangedEvent:
 			wm.GitHubEnterpriseIDPWriteModel.AppendEvents(&e.GitHubEnterpriseIDPChangedEvent)
+		case *org.IDPSecretReencryptedEvent:
+			wm.GitHubEnterpriseIDPWriteModel.AppendEvents(&e.SecretReencryptedEvent)
 		case *org.IDPRemovedEvent:
 			wm.GitHubEnterpriseIDPWriteModel.AppendEvents(&e.RemovedEvent)
 		default:
@@ -479,6 +493,7 @@ func (wm *OrgGitHubEnterpriseIDPWriteModel) Query() *eventstore.SearchQueryBuild
 		EventTypes(
 			org.GitHubEnterpriseIDPAddedEventType,
 			org.GitHubEnterpriseIDPChangedEventType,
+			org.IDPSecretReencryptedEventType,
 			org.IDPRemovedEventType,
 		).
 		EventData(map[string]interface{}{"id": wm.ID}).
@@ -541,6 +556,8 @@ func (wm *OrgGitLabIDPWriteModel) AppendEvents(events ...eventstore.Event) {
 			wm.GitLabIDPWriteModel.AppendEvents(&e.GitLabIDPAddedEvent)
 		case *org.GitLabIDPChangedEvent:
 			wm.GitLabIDPWriteModel.AppendEvents(&e.GitLabIDPChangedEvent)
+		case *org.IDPSecretReencryptedEvent:
+			wm.GitLabIDPWriteModel.AppendEvents(&e.SecretReencryptedEvent)
 		case *org.IDPRemovedEvent:
 			wm.GitLabIDPWriteModel.AppendEvents(&e.RemovedEvent)
 		default:
@@ -558,6 +575,7 @@ func (wm *OrgGitLabIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
 		EventTypes(
 			org.GitLabIDPAddedEventType,
 			org.GitLabIDPChangedEventType,
+			org.IDPSecretReencryptedEventType,
 			org.IDPRemovedEventType,
 		).
 		EventData(map[string]interface{}{"id": wm.ID}).
@@ -606,6 +624,8 @@ func (wm *OrgGitLabSelfHostedIDPWriteModel) AppendEvents(events ...eventstore.Ev
 			wm.GitLabSelfHostedIDPWriteModel.AppendEvents(&e.GitLabSelfHostedIDPAddedEvent)
 		case *org.GitLabSelfHostedIDPChangedEvent:
 			wm.GitLabSelfHostedIDPWriteModel.AppendEvents(&e.GitLabSelfHostedIDPChangedEvent)
+		case *org.IDPSecretReencryptedEvent:
+			wm.GitLabSelfHostedIDPWriteModel.AppendEvents(&e.SecretReencryptedEvent)
 		case *org.IDPRemovedEvent:
 			wm.GitLabSelfHostedIDPWriteModel.AppendEvents(&e.RemovedEvent)
 		default:
@@ -623,6 +643,7 @@ func (wm *OrgGitLabSelfHostedIDPWriteModel) Query() *eventstore.SearchQueryBuild
 		EventTypes(
 			org.GitLabSelfHostedIDPAddedEventType,
 			org.GitLabSelfHostedIDPChangedEventType,
+			org.IDPSecretReencryptedEventType,
 			org.IDPRemovedEventType,
 		).
 		EventData(map[string]interface{}{"id": wm.ID}).
@@ -674,6 +695,8 @@ func (wm *OrgGoogleIDPWriteModel) AppendEvents(events ...eventstore.Event) {
 			wm.GoogleIDPWriteModel.AppendEvents(&e.GoogleIDPChangedEvent)
 		case *org.OIDCIDPMigratedGoogleEvent:
 			wm.GoogleIDPWriteModel.AppendEvents(&e.OIDCIDPMigratedGoogleEvent)
+		case *org.IDPSecretReencryptedEvent:
+			wm.GoogleIDPWriteModel.AppendEvents(&e.SecretReencryptedEvent)
 		case *org.IDPRemovedEvent:
 			wm.GoogleIDPWriteModel.AppendEvents(&e.RemovedEvent)
 		default:
@@ -692,6 +715,7 @@ func (wm *OrgGoogleIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
 			org.GoogleIDPAddedEventType,
 			org.GoogleIDPChangedEventType,
 			org.OIDCIDPMigratedGoogleEventType,
+			org.IDPSecretReencryptedEventType,
 			org.IDPRemovedEventType,
 		).
 		EventData(map[string]interface{}{"id": wm.ID}).
@@ -740,6 +764,8 @@ func (wm *OrgLDAPIDPWriteModel) AppendEvents(events ...eventstore.Event) {
 			wm.LDAPIDPWriteModel.AppendEvents(&e.LDAPIDPAddedEvent)
 		case *org.LDAPIDPChangedEvent:
 			wm.LDAPIDPWriteModel.AppendEvents(&e.LDAPIDPChangedEvent)
+		case *org.IDPSecretReencryptedEvent:
+			wm.LDAPIDPWriteModel.AppendEvents(&e.SecretReencryptedEvent)
 		case *org.IDPRemovedEvent:
 			wm.LDAPIDPWriteModel.AppendEvents(&e.RemovedEvent)
 		default:
@@ -757,6 +783,7 @@ func (wm *OrgLDAPIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
 		EventTypes(
 			org.LDAPIDPAddedEventType,
 			org.LDAPIDPChangedEventType,
+			org.IDPSecretReencryptedEventType,
 			org.IDPRemovedEventType,
 		).
 		EventData(map[string]interface{}{"id": wm.ID}).
@@ -12,6 +12,7 @@ type OrgWriteModel struct {
 	Name          string
 	State         domain.OrgState
 	PrimaryDomain string
+	ParentOrgID   string
 }
 
 func NewOrgWriteModel(orgID string) *OrgWriteModel {
@@ -39,6 +40,10 @@ func (wm *OrgWriteModel) Reduce() error {
 			wm.Name = e.Name
 		case *org.DomainPrimarySetEvent:
 			wm.PrimaryDomain = e.Domain
+		case *org.OrgParentSetEvent:
+			wm.ParentOrgID = e.ParentOrgID
+		case *org.OrgParentRemovedEvent:
+			wm.ParentOrgID = ""
 		}
 	}
 	return wm.WriteModel.Reduce()
@@ -56,7 +61,9 @@ func (wm *OrgWriteModel) Query() *eventstore.SearchQueryBuilder {
 			org.OrgDeactivatedEventType,
 			org.OrgReactivatedEventType,
 			org.OrgRemovedEventType,
-			org.OrgDomainPrimarySetEventType).
+			org.OrgDomainPrimarySetEventType,
+			org.OrgParentSetEventType,
+			org.OrgParentRemovedEventType).
 		Builder()
 }
 
@@ -78,7 +78,8 @@ func (wm *OrgLoginPolicyWriteModel) NewChangedEvent(
 	externalLoginCheckLifetime,
 	mfaInitSkipLifetime,
 	secondFactorCheckLifetime,
-	multiFactorCheckLifetime time.Duration,
+	multiFactorCheckLifetime,
+	trustedDeviceLifetime time.Duration,
 ) (*org.LoginPolicyChangedEvent, bool) {
 
 	changes := make([]policy.LoginPolicyChanges, 0)
@@ -118,6 +119,9 @@ func (wm *OrgLoginPolicyWriteModel) NewChangedEvent(
 	if wm.MultiFactorCheckLifetime != multiFactorCheckLifetime {
 		changes = append(changes, policy.ChangeMultiFactorCheckLifetime(multiFactorCheckLifetime))
 	}
+	if wm.TrustedDeviceLifetime != trustedDeviceLifetime {
+		changes = append(changes, policy.ChangeTrustedDeviceLifetime(trustedDeviceLifetime))
+	}
 	if passwordlessType.Valid() && wm.PasswordlessType != passwordlessType {
 		changes = append(changes, policy.ChangePasswordlessType(passwordlessType))
 	}
@@ -71,6 +71,7 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -128,6 +129,7 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 									time.Hour*3,
 									time.Hour*4,
 									time.Hour*5,
+									0,
 								),
 							),
 						},
@@ -226,6 +228,7 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 									time.Hour*3,
 									time.Hour*4,
 									time.Hour*5,
+									0,
 								),
 							),
 							eventFromEventPusher(
@@ -357,6 +360,7 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 									time.Hour*3,
 									time.Hour*4,
 									time.Hour*5,
+									0,
 								),
 							),
 							eventFromEventPusher(
@@ -499,6 +503,7 @@ func TestCommandSide_ChangeLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -557,6 +562,7 @@ func TestCommandSide_ChangeLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -709,6 +715,7 @@ func TestCommandSide_RemoveLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -853,6 +860,7 @@ func TestCommandSide_AddIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -898,6 +906,7 @@ func TestCommandSide_AddIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -963,6 +972,7 @@ func TestCommandSide_AddIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -1131,6 +1141,7 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -1176,6 +1187,7 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -1233,6 +1245,7 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -1297,6 +1310,7 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -1369,6 +1383,7 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -60,6 +60,7 @@ func (wm *OrgPrivacyPolicyWriteModel) NewChangedEvent(
 	privacyLink,
 	helpLink string,
 	supportEmail domain.EmailAddress,
+	allowSelfDeletion bool,
 ) (*org.PrivacyPolicyChangedEvent, bool) {
 
 	changes := make([]policy.PrivacyPolicyChanges, 0)
@@ -75,6 +76,9 @@ func (wm *OrgPrivacyPolicyWriteModel) NewChangedEvent(
 	if wm.SupportEmail != supportEmail {
 		changes = append(changes, policy.ChangeSupportEmail(supportEmail))
 	}
+	if wm.AllowSelfDeletion != allowSelfDeletion {
+		changes = append(changes, policy.ChangeAllowSelfDeletion(allowSelfDeletion))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
@@ -67,6 +67,7 @@ func TestCommandSide_AddPrivacyPolicy(t *testing.T) {
 								"PrivacyLink",
 								"HelpLink",
 								"support@example.com",
+								false,
 							),
 						),
 					),
@@ -101,6 +102,7 @@ func TestCommandSide_AddPrivacyPolicy(t *testing.T) {
 									"PrivacyLink",
 									"HelpLink",
 									"support@example.com",
+									false,
 								),
 							),
 						},
@@ -166,6 +168,7 @@ func TestCommandSide_AddPrivacyPolicy(t *testing.T) {
 									"",
 									"",
 									"",
+									false,
 								),
 							),
 						},
@@ -289,6 +292,7 @@ func TestCommandSide_ChangePrivacyPolicy(t *testing.T) {
 								"PrivacyLink",
 								"HelpLink",
 								"support@example.com",
+								false,
 							),
 						),
 					),
@@ -337,6 +341,7 @@ func TestCommandSide_ChangePrivacyPolicy(t *testing.T) {
 								"PrivacyLink",
 								"HelpLink",
 								"support@example.com",
+								false,
 							),
 						),
 					),
@@ -385,6 +390,7 @@ func TestCommandSide_ChangePrivacyPolicy(t *testing.T) {
 								"PrivacyLink",
 								"HelpLink",
 								"support@example.com",
+								false,
 							),
 						),
 					),
@@ -501,6 +507,7 @@ func TestCommandSide_RemovePrivacyPolicy(t *testing.T) {
 								"PrivacyLink",
 								"HelpLink",
 								"support@example.com",
+								false,
 							),
 						),
 					),
@@ -0,0 +1,101 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+func (c *Commands) AddUserLifecyclePolicy(ctx context.Context, resourceOwner string, policy *domain.UserLifecyclePolicy) (*domain.UserLifecyclePolicy, error) {
+	if resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "Org-Ul8ds", "Errors.ResourceOwnerMissing")
+	}
+	if err := validateUserLifecyclePolicy(policy.InactivityDeactivation, policy.DeactivatedRemoval, policy.NotificationPeriod); err != nil {
+		return nil, err
+	}
+	addedPolicy, err := c.orgUserLifecyclePolicyWriteModelByID(ctx, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if addedPolicy.State == domain.PolicyStateActive {
+		return nil, caos_errs.ThrowAlreadyExists(nil, "ORG-Ul2ae", "Errors.Org.UserLifecyclePolicy.AlreadyExists")
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&addedPolicy.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewUserLifecyclePolicyAddedEvent(ctx, orgAgg, policy.InactivityDeactivation, policy.DeactivatedRemoval, policy.NotificationPeriod))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(addedPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToUserLifecyclePolicy(&addedPolicy.UserLifecyclePolicyWriteModel), nil
+}
+
+func (c *Commands) ChangeUserLifecyclePolicy(ctx context.Context, resourceOwner string, policy *domain.UserLifecyclePolicy) (*domain.UserLifecyclePolicy, error) {
+	if resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "Org-Ul4cs", "Errors.ResourceOwnerMissing")
+	}
+	if err := validateUserLifecyclePolicy(policy.InactivityDeactivation, policy.DeactivatedRemoval, policy.NotificationPeriod); err != nil {
+		return nil, err
+	}
+	existingPolicy, err := c.orgUserLifecyclePolicyWriteModelByID(ctx, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingPolicy.State == domain.PolicyStateUnspecified || existingPolicy.State == domain.PolicyStateRemoved {
+		return nil, caos_errs.ThrowNotFound(nil, "ORG-Ul5nf", "Errors.Org.UserLifecyclePolicy.NotFound")
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&existingPolicy.UserLifecyclePolicyWriteModel.WriteModel)
+	changedEvent, hasChanged := existingPolicy.NewChangedEvent(ctx, orgAgg, policy.InactivityDeactivation, policy.DeactivatedRemoval, policy.NotificationPeriod)
+	if !hasChanged {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "ORG-Ul6nc", "Errors.Org.UserLifecyclePolicy.NotChanged")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToUserLifecyclePolicy(&existingPolicy.UserLifecyclePolicyWriteModel), nil
+}
+
+func (c *Commands) RemoveUserLifecyclePolicy(ctx context.Context, orgID string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "Org-Ul7rs", "Errors.ResourceOwnerMissing")
+	}
+	existingPolicy, err := c.orgUserLifecyclePolicyWriteModelByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if existingPolicy.State == domain.PolicyStateUnspecified || existingPolicy.State == domain.PolicyStateRemoved {
+		return nil, caos_errs.ThrowNotFound(nil, "ORG-Ul8nf", "Errors.Org.UserLifecyclePolicy.NotFound")
+	}
+	orgAgg := OrgAggregateFromWriteModel(&existingPolicy.WriteModel)
+
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewUserLifecyclePolicyRemovedEvent(ctx, orgAgg))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingPolicy.UserLifecyclePolicyWriteModel.WriteModel), nil
+}
+
+func (c *Commands) orgUserLifecyclePolicyWriteModelByID(ctx context.Context, orgID string) (*OrgUserLifecyclePolicyWriteModel, error) {
+	policy := NewOrgUserLifecyclePolicyWriteModel(orgID)
+	err := c.eventstore.FilterToQueryReducer(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
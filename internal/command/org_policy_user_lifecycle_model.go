@@ -0,0 +1,71 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type OrgUserLifecyclePolicyWriteModel struct {
+	UserLifecyclePolicyWriteModel
+}
+
+func NewOrgUserLifecyclePolicyWriteModel(orgID string) *OrgUserLifecyclePolicyWriteModel {
+	return &OrgUserLifecyclePolicyWriteModel{
+		UserLifecyclePolicyWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   orgID,
+				ResourceOwner: orgID,
+			},
+		},
+	}
+}
+
+func (wm *OrgUserLifecyclePolicyWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.UserLifecyclePolicyAddedEvent:
+			wm.UserLifecyclePolicyWriteModel.AppendEvents(&e.UserLifecyclePolicyAddedEvent)
+		case *org.UserLifecyclePolicyChangedEvent:
+			wm.UserLifecyclePolicyWriteModel.AppendEvents(&e.UserLifecyclePolicyChangedEvent)
+		case *org.UserLifecyclePolicyRemovedEvent:
+			wm.UserLifecyclePolicyWriteModel.AppendEvents(&e.UserLifecyclePolicyRemovedEvent)
+		}
+	}
+}
+
+func (wm *OrgUserLifecyclePolicyWriteModel) Reduce() error {
+	return wm.UserLifecyclePolicyWriteModel.Reduce()
+}
+
+func (wm *OrgUserLifecyclePolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.UserLifecyclePolicyWriteModel.AggregateID).
+		EventTypes(org.UserLifecyclePolicyAddedEventType,
+			org.UserLifecyclePolicyChangedEventType,
+			org.UserLifecyclePolicyRemovedEventType).
+		Builder()
+}
+
+func (wm *OrgUserLifecyclePolicyWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	inactivityDeactivation,
+	deactivatedRemoval,
+	notificationPeriod time.Duration,
+) (*org.UserLifecyclePolicyChangedEvent, bool) {
+	changes := wm.changes(inactivityDeactivation, deactivatedRemoval, notificationPeriod)
+	if len(changes) == 0 {
+		return nil, false
+	}
+	changedEvent, err := org.NewUserLifecyclePolicyChangedEvent(ctx, aggregate, changes)
+	if err != nil {
+		return nil, false
+	}
+	return changedEvent, true
+}
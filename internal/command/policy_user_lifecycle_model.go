@@ -0,0 +1,57 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+type UserLifecyclePolicyWriteModel struct {
+	eventstore.WriteModel
+
+	InactivityDeactivation time.Duration
+	DeactivatedRemoval     time.Duration
+	NotificationPeriod     time.Duration
+	State                  domain.PolicyState
+}
+
+func (wm *UserLifecyclePolicyWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *policy.UserLifecyclePolicyAddedEvent:
+			wm.InactivityDeactivation = e.InactivityDeactivation
+			wm.DeactivatedRemoval = e.DeactivatedRemoval
+			wm.NotificationPeriod = e.NotificationPeriod
+			wm.State = domain.PolicyStateActive
+		case *policy.UserLifecyclePolicyChangedEvent:
+			if e.InactivityDeactivation != nil {
+				wm.InactivityDeactivation = *e.InactivityDeactivation
+			}
+			if e.DeactivatedRemoval != nil {
+				wm.DeactivatedRemoval = *e.DeactivatedRemoval
+			}
+			if e.NotificationPeriod != nil {
+				wm.NotificationPeriod = *e.NotificationPeriod
+			}
+		case *policy.UserLifecyclePolicyRemovedEvent:
+			wm.State = domain.PolicyStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *UserLifecyclePolicyWriteModel) changes(inactivityDeactivation, deactivatedRemoval, notificationPeriod time.Duration) []policy.UserLifecyclePolicyChanges {
+	changes := make([]policy.UserLifecyclePolicyChanges, 0)
+	if wm.InactivityDeactivation != inactivityDeactivation {
+		changes = append(changes, policy.ChangeInactivityDeactivation(inactivityDeactivation))
+	}
+	if wm.DeactivatedRemoval != deactivatedRemoval {
+		changes = append(changes, policy.ChangeDeactivatedRemoval(deactivatedRemoval))
+	}
+	if wm.NotificationPeriod != notificationPeriod {
+		changes = append(changes, policy.ChangeNotificationPeriod(notificationPeriod))
+	}
+	return changes
+}
@@ -34,6 +34,10 @@ type addOIDCApp struct {
 	ClockSkew                   time.Duration
 	AdditionalOrigins           []string
 	SkipSuccessPageForNativeApp bool
+	IDTokenSigningAlgorithm     string
+	ConsentRequired             bool
+	BackchannelDeliveryMode     domain.OIDCBackchannelTokenDeliveryMode
+	BackchannelEndpoint         string
 
 	ClientID          string
 	ClientSecret      *crypto.CryptoValue
@@ -65,6 +69,14 @@ func (c *Commands) AddOIDCAppCommand(app *addOIDCApp, clientSecretAlg crypto.Has
 			return nil, errors.ThrowInvalidArgument(nil, "V2-sLpW1", "Errors.Invalid.Argument")
 		}
 
+		if app.IDTokenSigningAlgorithm != "" && !crypto.IsSigningAlgorithmSupported(app.IDTokenSigningAlgorithm) {
+			return nil, errors.ThrowInvalidArgument(nil, "V2-Sig4l", "Errors.Invalid.Argument")
+		}
+
+		if !domain.BackchannelNotificationEndpointValid(app.BackchannelDeliveryMode, app.BackchannelEndpoint, app.DevMode) {
+			return nil, errors.ThrowInvalidArgument(nil, "V2-Bc3ep", "Errors.Invalid.Argument")
+		}
+
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) (_ []eventstore.Command, err error) {
 			project, err := projectWriteModel(ctx, filter, app.Aggregate.ID, app.Aggregate.ResourceOwner)
 			if err != nil || !project.State.Valid() {
@@ -112,6 +124,10 @@ func (c *Commands) AddOIDCAppCommand(app *addOIDCApp, clientSecretAlg crypto.Has
 					app.ClockSkew,
 					app.AdditionalOrigins,
 					app.SkipSuccessPageForNativeApp,
+					app.IDTokenSigningAlgorithm,
+					app.ConsentRequired,
+					app.BackchannelDeliveryMode,
+					app.BackchannelEndpoint,
 				),
 			}, nil
 		}, nil
@@ -196,6 +212,10 @@ func (c *Commands) addOIDCApplicationWithID(ctx context.Context, oidcApp *domain
 		oidcApp.ClockSkew,
 		oidcApp.AdditionalOrigins,
 		oidcApp.SkipNativeAppSuccessPage,
+		oidcApp.IDTokenSigningAlgorithm,
+		oidcApp.ConsentRequired,
+		oidcApp.BackchannelTokenDeliveryMode,
+		oidcApp.BackchannelClientNotificationEndpoint,
 	))
 
 	addedApplication.AppID = oidcApp.AppID
@@ -248,6 +268,10 @@ func (c *Commands) ChangeOIDCApplication(ctx context.Context, oidc *domain.OIDCA
 		oidc.ClockSkew,
 		oidc.AdditionalOrigins,
 		oidc.SkipNativeAppSuccessPage,
+		oidc.IDTokenSigningAlgorithm,
+		oidc.ConsentRequired,
+		oidc.BackchannelTokenDeliveryMode,
+		oidc.BackchannelClientNotificationEndpoint,
 	)
 	if err != nil {
 		return nil, err
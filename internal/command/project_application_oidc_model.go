@@ -36,6 +36,10 @@ type OIDCApplicationWriteModel struct {
 	State                    domain.AppState
 	AdditionalOrigins        []string
 	SkipNativeAppSuccessPage bool
+	IDTokenSigningAlgorithm  string
+	ConsentRequired          bool
+	BackchannelDeliveryMode  domain.OIDCBackchannelTokenDeliveryMode
+	BackchannelEndpoint      string
 	oidc                     bool
 }
 
@@ -158,6 +162,10 @@ func (wm *OIDCApplicationWriteModel) appendAddOIDCEvent(e *project.OIDCConfigAdd
 	wm.ClockSkew = e.ClockSkew
 	wm.AdditionalOrigins = e.AdditionalOrigins
 	wm.SkipNativeAppSuccessPage = e.SkipNativeAppSuccessPage
+	wm.IDTokenSigningAlgorithm = e.IDTokenSigningAlgorithm
+	wm.ConsentRequired = e.ConsentRequired
+	wm.BackchannelDeliveryMode = e.BackchannelTokenDeliveryMode
+	wm.BackchannelEndpoint = e.BackchannelClientNotificationEndpoint
 }
 
 func (wm *OIDCApplicationWriteModel) appendChangeOIDCEvent(e *project.OIDCConfigChangedEvent) {
@@ -206,6 +214,18 @@ func (wm *OIDCApplicationWriteModel) appendChangeOIDCEvent(e *project.OIDCConfig
 	if e.SkipNativeAppSuccessPage != nil {
 		wm.SkipNativeAppSuccessPage = *e.SkipNativeAppSuccessPage
 	}
+	if e.IDTokenSigningAlgorithm != nil {
+		wm.IDTokenSigningAlgorithm = *e.IDTokenSigningAlgorithm
+	}
+	if e.ConsentRequired != nil {
+		wm.ConsentRequired = *e.ConsentRequired
+	}
+	if e.BackchannelTokenDeliveryMode != nil {
+		wm.BackchannelDeliveryMode = *e.BackchannelTokenDeliveryMode
+	}
+	if e.BackchannelClientNotificationEndpoint != nil {
+		wm.BackchannelEndpoint = *e.BackchannelClientNotificationEndpoint
+	}
 }
 
 func (wm *OIDCApplicationWriteModel) Query() *eventstore.SearchQueryBuilder {
@@ -246,6 +266,10 @@ func (wm *OIDCApplicationWriteModel) NewChangedEvent(
 	clockSkew time.Duration,
 	additionalOrigins []string,
 	skipNativeAppSuccessPage bool,
+	idTokenSigningAlgorithm string,
+	consentRequired bool,
+	backchannelDeliveryMode domain.OIDCBackchannelTokenDeliveryMode,
+	backchannelEndpoint string,
 ) (*project.OIDCConfigChangedEvent, bool, error) {
 	changes := make([]project.OIDCConfigChanges, 0)
 	var err error
@@ -295,6 +319,18 @@ func (wm *OIDCApplicationWriteModel) NewChangedEvent(
 	if wm.SkipNativeAppSuccessPage != skipNativeAppSuccessPage {
 		changes = append(changes, project.ChangeSkipNativeAppSuccessPage(skipNativeAppSuccessPage))
 	}
+	if wm.IDTokenSigningAlgorithm != idTokenSigningAlgorithm {
+		changes = append(changes, project.ChangeIDTokenSigningAlgorithm(idTokenSigningAlgorithm))
+	}
+	if wm.ConsentRequired != consentRequired {
+		changes = append(changes, project.ChangeConsentRequired(consentRequired))
+	}
+	if wm.BackchannelDeliveryMode != backchannelDeliveryMode {
+		changes = append(changes, project.ChangeBackchannelTokenDeliveryMode(backchannelDeliveryMode))
+	}
+	if wm.BackchannelEndpoint != backchannelEndpoint {
+		changes = append(changes, project.ChangeBackchannelClientNotificationEndpoint(backchannelEndpoint))
+	}
 
 	if len(changes) == 0 {
 		return nil, false, nil
@@ -82,6 +82,29 @@ func TestAddOIDCApp(t *testing.T) {
 				ValidationErr: errors.ThrowInvalidArgument(nil, "PROJE-Fef31", "Errors.Invalid.Argument"),
 			},
 		},
+		{
+			name:   "unsupported id token signing algorithm",
+			fields: fields{},
+			args: args{
+				app: &addOIDCApp{
+					AddApp: AddApp{
+						Aggregate: *agg,
+						ID:        "id",
+						Name:      "name",
+					},
+					GrantTypes:              []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					ResponseTypes:           []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					Version:                 domain.OIDCVersionV1,
+					ApplicationType:         domain.OIDCApplicationTypeWeb,
+					AuthMethodType:          domain.OIDCAuthMethodTypeNone,
+					AccessTokenType:         domain.OIDCTokenTypeBearer,
+					IDTokenSigningAlgorithm: "HS256",
+				},
+			},
+			want: Want{
+				ValidationErr: errors.ThrowInvalidArgument(nil, "V2-Sig4l", "Errors.Invalid.Argument"),
+			},
+		},
 		{
 			name:   "project not exists",
 			fields: fields{},
@@ -170,6 +193,10 @@ func TestAddOIDCApp(t *testing.T) {
 						0,
 						nil,
 						false,
+						"",
+						false,
+						domain.OIDCBackchannelTokenDeliveryModePoll,
+						"",
 					),
 				},
 			},
@@ -328,6 +355,10 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 									time.Second*1,
 									[]string{"https://sub.test.ch"},
 									true,
+									"",
+									false,
+									domain.OIDCBackchannelTokenDeliveryModePoll,
+									"",
 								),
 							),
 						},
@@ -565,6 +596,10 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								time.Second*1,
 								[]string{"https://sub.test.ch"},
 								true,
+								"",
+								false,
+								domain.OIDCBackchannelTokenDeliveryModePoll,
+								"",
 							),
 						),
 					),
@@ -639,6 +674,10 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								time.Second*1,
 								[]string{"https://sub.test.ch"},
 								true,
+								"",
+								false,
+								domain.OIDCBackchannelTokenDeliveryModePoll,
+								"",
 							),
 						),
 					),
@@ -677,6 +716,7 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 					ClockSkew:                time.Second * 2,
 					AdditionalOrigins:        []string{"https://sub.test.ch"},
 					SkipNativeAppSuccessPage: true,
+					ConsentRequired:          true,
 				},
 				resourceOwner: "org1",
 			},
@@ -704,6 +744,7 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 					ClockSkew:                time.Second * 2,
 					AdditionalOrigins:        []string{"https://sub.test.ch"},
 					SkipNativeAppSuccessPage: true,
+					ConsentRequired:          true,
 					Compliance:               &domain.Compliance{},
 					State:                    domain.AppStateActive,
 				},
@@ -840,6 +881,10 @@ func TestCommandSide_ChangeOIDCApplicationSecret(t *testing.T) {
 								time.Second*1,
 								[]string{"https://sub.test.ch"},
 								false,
+								"",
+								false,
+								domain.OIDCBackchannelTokenDeliveryModePoll,
+								"",
 							),
 						),
 					),
@@ -926,6 +971,7 @@ func newOIDCAppChangedEvent(ctx context.Context, appID, projectID, resourceOwner
 		project.ChangeIDTokenRoleAssertion(false),
 		project.ChangeIDTokenUserinfoAssertion(false),
 		project.ChangeClockSkew(time.Second * 2),
+		project.ChangeConsentRequired(true),
 	}
 	event, _ := project.NewOIDCConfigChangedEvent(ctx,
 		&project.NewAggregate(projectID, resourceOwner).Aggregate,
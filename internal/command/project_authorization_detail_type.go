@@ -0,0 +1,109 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+// AddProjectAuthorizationDetailType registers a type of authorization details (RFC 9396) on the project,
+// which the applications of the project can request in the authorization_details parameter
+func (c *Commands) AddProjectAuthorizationDetailType(ctx context.Context, detailType *domain.ProjectAuthorizationDetailType, resourceOwner string) (_ *domain.ProjectAuthorizationDetailType, err error) {
+	if !detailType.IsValid() {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Ad3nf", "Errors.Project.AuthorizationDetailType.Invalid")
+	}
+	err = c.checkProjectExists(ctx, detailType.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	writeModel := NewProjectAuthorizationDetailTypeWriteModelWithType(detailType.Type, detailType.AggregateID, resourceOwner)
+	projectAgg := ProjectAggregateFromWriteModel(&writeModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, project.NewAuthorizationDetailTypeAddedEvent(
+		ctx,
+		projectAgg,
+		detailType.Type,
+		detailType.DisplayName,
+		detailType.Description,
+		string(detailType.Schema),
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return authorizationDetailTypeWriteModelToDetailType(writeModel), nil
+}
+
+func (c *Commands) ChangeProjectAuthorizationDetailType(ctx context.Context, detailType *domain.ProjectAuthorizationDetailType, resourceOwner string) (_ *domain.ProjectAuthorizationDetailType, err error) {
+	if detailType.AggregateID == "" || detailType.Type == "" || !detailType.IsSchemaValid() {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Ad7ps", "Errors.Project.AuthorizationDetailType.Invalid")
+	}
+	err = c.checkProjectExists(ctx, detailType.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := c.getProjectAuthorizationDetailTypeWriteModel(ctx, detailType.Type, detailType.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existing.State == domain.ProjectAuthorizationDetailTypeStateUnspecified || existing.State == domain.ProjectAuthorizationDetailTypeStateRemoved {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Ad2md", "Errors.Project.AuthorizationDetailType.NotExisting")
+	}
+
+	projectAgg := ProjectAggregateFromWriteModel(&existing.WriteModel)
+	changeEvent, changed, err := existing.NewProjectAuthorizationDetailTypeChangedEvent(ctx, projectAgg, detailType.DisplayName, detailType.Description, string(detailType.Schema))
+	if err != nil {
+		return nil, err
+	}
+	if !changed {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Ad9xe", "Errors.NoChangesFound")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, changeEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existing, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return authorizationDetailTypeWriteModelToDetailType(existing), nil
+}
+
+func (c *Commands) RemoveProjectAuthorizationDetailType(ctx context.Context, projectID, detailType, resourceOwner string) (details *domain.ObjectDetails, err error) {
+	if projectID == "" || detailType == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Ad5tb", "Errors.Project.AuthorizationDetailType.Invalid")
+	}
+	existing, err := c.getProjectAuthorizationDetailTypeWriteModel(ctx, detailType, projectID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existing.State == domain.ProjectAuthorizationDetailTypeStateUnspecified || existing.State == domain.ProjectAuthorizationDetailTypeStateRemoved {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Ad1kw", "Errors.Project.AuthorizationDetailType.NotExisting")
+	}
+	projectAgg := ProjectAggregateFromWriteModel(&existing.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, project.NewAuthorizationDetailTypeRemovedEvent(ctx, projectAgg, detailType))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existing, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+func (c *Commands) getProjectAuthorizationDetailTypeWriteModel(ctx context.Context, detailType, projectID, resourceOwner string) (*ProjectAuthorizationDetailTypeWriteModel, error) {
+	writeModel := NewProjectAuthorizationDetailTypeWriteModelWithType(detailType, projectID, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,120 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+type ProjectAuthorizationDetailTypeWriteModel struct {
+	eventstore.WriteModel
+
+	DetailType  string
+	DisplayName string
+	Description string
+	Schema      string
+	State       domain.ProjectAuthorizationDetailTypeState
+}
+
+func NewProjectAuthorizationDetailTypeWriteModelWithType(detailType, projectID, resourceOwner string) *ProjectAuthorizationDetailTypeWriteModel {
+	return &ProjectAuthorizationDetailTypeWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   projectID,
+			ResourceOwner: resourceOwner,
+		},
+		DetailType: detailType,
+	}
+}
+
+func (wm *ProjectAuthorizationDetailTypeWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *project.AuthorizationDetailTypeAddedEvent:
+			if e.DetailType == wm.DetailType {
+				wm.WriteModel.AppendEvents(e)
+			}
+		case *project.AuthorizationDetailTypeChangedEvent:
+			if e.DetailType == wm.DetailType {
+				wm.WriteModel.AppendEvents(e)
+			}
+		case *project.AuthorizationDetailTypeRemovedEvent:
+			if e.DetailType == wm.DetailType {
+				wm.WriteModel.AppendEvents(e)
+			}
+		case *project.ProjectRemovedEvent:
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *ProjectAuthorizationDetailTypeWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *project.AuthorizationDetailTypeAddedEvent:
+			wm.DetailType = e.DetailType
+			wm.DisplayName = e.DisplayName
+			wm.Description = e.Description
+			wm.Schema = e.Schema
+			wm.State = domain.ProjectAuthorizationDetailTypeStateActive
+		case *project.AuthorizationDetailTypeChangedEvent:
+			if e.DisplayName != nil {
+				wm.DisplayName = *e.DisplayName
+			}
+			if e.Description != nil {
+				wm.Description = *e.Description
+			}
+			if e.Schema != nil {
+				wm.Schema = *e.Schema
+			}
+		case *project.AuthorizationDetailTypeRemovedEvent:
+			wm.State = domain.ProjectAuthorizationDetailTypeStateRemoved
+		case *project.ProjectRemovedEvent:
+			wm.State = domain.ProjectAuthorizationDetailTypeStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *ProjectAuthorizationDetailTypeWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			project.AuthorizationDetailTypeAddedType,
+			project.AuthorizationDetailTypeChangedType,
+			project.AuthorizationDetailTypeRemovedType,
+			project.ProjectRemovedType).
+		Builder()
+}
+
+func (wm *ProjectAuthorizationDetailTypeWriteModel) NewProjectAuthorizationDetailTypeChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	displayName,
+	description,
+	schema string,
+) (*project.AuthorizationDetailTypeChangedEvent, bool, error) {
+	changes := make([]project.AuthorizationDetailTypeChanges, 0)
+
+	if wm.DisplayName != displayName {
+		changes = append(changes, project.ChangeAuthorizationDetailTypeDisplayName(displayName))
+	}
+	if wm.Description != description {
+		changes = append(changes, project.ChangeAuthorizationDetailTypeDescription(description))
+	}
+	if wm.Schema != schema {
+		changes = append(changes, project.ChangeAuthorizationDetailTypeSchema(schema))
+	}
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := project.NewAuthorizationDetailTypeChangedEvent(ctx, aggregate, wm.DetailType, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
@@ -0,0 +1,567 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+func TestCommandSide_AddProjectAuthorizationDetailType(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		detailType    *domain.ProjectAuthorizationDetailType
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ProjectAuthorizationDetailType
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid detail type, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				detailType: &domain.ProjectAuthorizationDetailType{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					Type: "payment initiation",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid schema, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				detailType: &domain.ProjectAuthorizationDetailType{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					Type:   "payment_initiation",
+					Schema: []byte(`{"type": "decimal"}`),
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "project not existing, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+						eventFromEventPusher(
+							project.NewProjectRemovedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1",
+								nil,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				detailType: &domain.ProjectAuthorizationDetailType{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					Type: "payment_initiation",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "detail type already exists, already exists error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+					expectPushFailed(caos_errs.ThrowAlreadyExists(nil, "id", "internal"),
+						[]*repository.Event{
+							eventFromEventPusher(project.NewAuthorizationDetailTypeAddedEvent(
+								context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"payment_initiation",
+								"Payment initiation",
+								"description",
+								"",
+							),
+							),
+						},
+						uniqueConstraintsFromEventConstraint(project.NewAddProjectAuthorizationDetailTypeUniqueConstraint("payment_initiation", "project1")),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				detailType: &domain.ProjectAuthorizationDetailType{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					Type:        "payment_initiation",
+					DisplayName: "Payment initiation",
+					Description: "description",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsErrorAlreadyExists,
+			},
+		},
+		{
+			name: "add detail type, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(project.NewAuthorizationDetailTypeAddedEvent(
+								context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"payment_initiation",
+								"Payment initiation",
+								"description",
+								"",
+							),
+							),
+						},
+						uniqueConstraintsFromEventConstraint(project.NewAddProjectAuthorizationDetailTypeUniqueConstraint("payment_initiation", "project1")),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				detailType: &domain.ProjectAuthorizationDetailType{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					Type:        "payment_initiation",
+					DisplayName: "Payment initiation",
+					Description: "description",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ProjectAuthorizationDetailType{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "project1",
+						ResourceOwner: "org1",
+					},
+					Type:        "payment_initiation",
+					DisplayName: "Payment initiation",
+					Description: "description",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.AddProjectAuthorizationDetailType(tt.args.ctx, tt.args.detailType, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeProjectAuthorizationDetailType(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		detailType    *domain.ProjectAuthorizationDetailType
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ProjectAuthorizationDetailType
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid detail type, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				detailType: &domain.ProjectAuthorizationDetailType{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "detail type removed, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewAuthorizationDetailTypeAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"payment_initiation",
+								"Payment initiation",
+								"description",
+								"",
+							),
+						),
+						eventFromEventPusher(
+							project.NewAuthorizationDetailTypeRemovedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"payment_initiation",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				detailType: &domain.ProjectAuthorizationDetailType{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					Type: "payment_initiation",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewAuthorizationDetailTypeAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"payment_initiation",
+								"Payment initiation",
+								"description",
+								"",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				detailType: &domain.ProjectAuthorizationDetailType{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					Type:        "payment_initiation",
+					DisplayName: "Payment initiation",
+					Description: "description",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "change detail type, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewAuthorizationDetailTypeAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"payment_initiation",
+								"Payment initiation",
+								"description",
+								"",
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								newAuthorizationDetailTypeChangedEvent(context.Background(), "project1", "org1", "payment_initiation", "Initiate payments", "description changed"),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				detailType: &domain.ProjectAuthorizationDetailType{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					Type:        "payment_initiation",
+					DisplayName: "Initiate payments",
+					Description: "description changed",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ProjectAuthorizationDetailType{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "project1",
+						ResourceOwner: "org1",
+					},
+					Type:        "payment_initiation",
+					DisplayName: "Initiate payments",
+					Description: "description changed",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.ChangeProjectAuthorizationDetailType(tt.args.ctx, tt.args.detailType, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RemoveProjectAuthorizationDetailType(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		projectID     string
+		detailType    string
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid type, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "detail type not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				detailType:    "payment_initiation",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "remove detail type, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewAuthorizationDetailTypeAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"payment_initiation",
+								"Payment initiation",
+								"description",
+								"",
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(project.NewAuthorizationDetailTypeRemovedEvent(
+								context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"payment_initiation",
+							)),
+						},
+						uniqueConstraintsFromEventConstraint(project.NewRemoveProjectAuthorizationDetailTypeUniqueConstraint("payment_initiation", "project1")),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				detailType:    "payment_initiation",
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.RemoveProjectAuthorizationDetailType(tt.args.ctx, tt.args.projectID, tt.args.detailType, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func newAuthorizationDetailTypeChangedEvent(ctx context.Context, projectID, resourceOwner, detailType, displayName, description string) *project.AuthorizationDetailTypeChangedEvent {
+	event, _ := project.NewAuthorizationDetailTypeChangedEvent(ctx,
+		&project.NewAggregate(projectID, resourceOwner).Aggregate,
+		detailType,
+		[]project.AuthorizationDetailTypeChanges{
+			project.ChangeAuthorizationDetailTypeDisplayName(displayName),
+			project.ChangeAuthorizationDetailTypeDescription(description),
+		},
+	)
+	return event
+}
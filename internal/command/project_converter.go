@@ -47,6 +47,11 @@ func oidcWriteModelToOIDCConfig(writeModel *OIDCApplicationWriteModel) *domain.O
 		ClockSkew:                writeModel.ClockSkew,
 		AdditionalOrigins:        writeModel.AdditionalOrigins,
 		SkipNativeAppSuccessPage: writeModel.SkipNativeAppSuccessPage,
+		IDTokenSigningAlgorithm:  writeModel.IDTokenSigningAlgorithm,
+		ConsentRequired:          writeModel.ConsentRequired,
+
+		BackchannelTokenDeliveryMode:          writeModel.BackchannelDeliveryMode,
+		BackchannelClientNotificationEndpoint: writeModel.BackchannelEndpoint,
 	}
 }
 
@@ -82,6 +87,28 @@ func roleWriteModelToRole(writeModel *ProjectRoleWriteModel) *domain.ProjectRole
 	}
 }
 
+func scopeWriteModelToScope(writeModel *ProjectScopeWriteModel) *domain.ProjectScope {
+	return &domain.ProjectScope{
+		ObjectRoot:  writeModelToObjectRoot(writeModel.WriteModel),
+		Key:         writeModel.Key,
+		DisplayName: writeModel.DisplayName,
+		Description: writeModel.Description,
+	}
+}
+
+func authorizationDetailTypeWriteModelToDetailType(writeModel *ProjectAuthorizationDetailTypeWriteModel) *domain.ProjectAuthorizationDetailType {
+	detailType := &domain.ProjectAuthorizationDetailType{
+		ObjectRoot:  writeModelToObjectRoot(writeModel.WriteModel),
+		Type:        writeModel.DetailType,
+		DisplayName: writeModel.DisplayName,
+		Description: writeModel.Description,
+	}
+	if writeModel.Schema != "" {
+		detailType.Schema = []byte(writeModel.Schema)
+	}
+	return detailType
+}
+
 func memberWriteModelToProjectGrantMember(writeModel *ProjectGrantMemberWriteModel) *domain.ProjectGrantMember {
 	return &domain.ProjectGrantMember{
 		ObjectRoot: writeModelToObjectRoot(writeModel.WriteModel),
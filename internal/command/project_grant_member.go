@@ -15,8 +15,8 @@ func (c *Commands) AddProjectGrantMember(ctx context.Context, member *domain.Pro
 	if !member.IsValid() {
 		return nil, errors.ThrowInvalidArgument(nil, "PROJECT-8fi7G", "Errors.Project.Grant.Member.Invalid")
 	}
-	if len(domain.CheckForInvalidRoles(member.Roles, domain.ProjectGrantRolePrefix, c.zitadelRoles)) > 0 {
-		return nil, errors.ThrowInvalidArgument(nil, "PROJECT-m9gKK", "Errors.Project.Grant.Member.Invalid")
+	if invalidRoles, err := c.checkForInvalidMemberRoles(ctx, c.eventstore.Filter, domain.ProjectGrantRolePrefix, member.Roles); err != nil || len(invalidRoles) > 0 {
+		return nil, errors.ThrowInvalidArgument(err, "PROJECT-m9gKK", "Errors.Project.Grant.Member.Invalid")
 	}
 	err := c.checkUserExists(ctx, member.UserID, "")
 	if err != nil {
@@ -50,8 +50,8 @@ func (c *Commands) ChangeProjectGrantMember(ctx context.Context, member *domain.
 	if !member.IsValid() {
 		return nil, errors.ThrowInvalidArgument(nil, "PROJECT-109fs", "Errors.Project.Member.Invalid")
 	}
-	if len(domain.CheckForInvalidRoles(member.Roles, domain.ProjectGrantRolePrefix, c.zitadelRoles)) > 0 {
-		return nil, errors.ThrowInvalidArgument(nil, "PROJECT-m0sDf", "Errors.Project.Member.Invalid")
+	if invalidRoles, err := c.checkForInvalidMemberRoles(ctx, c.eventstore.Filter, domain.ProjectGrantRolePrefix, member.Roles); err != nil || len(invalidRoles) > 0 {
+		return nil, errors.ThrowInvalidArgument(err, "PROJECT-m0sDf", "Errors.Project.Member.Invalid")
 	}
 
 	existingMember, err := c.projectGrantMemberWriteModelByID(ctx, member.AggregateID, member.UserID, member.GrantID)
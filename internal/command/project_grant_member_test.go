@@ -59,6 +59,7 @@ func TestCommandSide_AddProjectGrantMember(t *testing.T) {
 			fields: fields{
 				eventstore: eventstoreExpect(
 					t,
+					expectFilter(),
 				),
 			},
 			args: args{
@@ -337,6 +338,7 @@ func TestCommandSide_ChangeProjectGrantMember(t *testing.T) {
 			fields: fields{
 				eventstore: eventstoreExpect(
 					t,
+					expectFilter(),
 				),
 			},
 			args: args{
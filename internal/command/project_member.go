@@ -35,8 +35,8 @@ func (c *Commands) addProjectMember(ctx context.Context, projectAgg *eventstore.
 	if !member.IsValid() {
 		return nil, errors.ThrowInvalidArgument(nil, "PROJECT-W8m4l", "Errors.Project.Member.Invalid")
 	}
-	if len(domain.CheckForInvalidRoles(member.Roles, domain.ProjectRolePrefix, c.zitadelRoles)) > 0 {
-		return nil, errors.ThrowInvalidArgument(nil, "PROJECT-3m9ds", "Errors.Project.Member.Invalid")
+	if invalidRoles, err := c.checkForInvalidMemberRoles(ctx, c.eventstore.Filter, domain.ProjectRolePrefix, member.Roles); err != nil || len(invalidRoles) > 0 {
+		return nil, errors.ThrowInvalidArgument(err, "PROJECT-3m9ds", "Errors.Project.Member.Invalid")
 	}
 
 	err := c.checkUserExists(ctx, addedMember.UserID, "")
@@ -59,8 +59,8 @@ func (c *Commands) ChangeProjectMember(ctx context.Context, member *domain.Membe
 	if !member.IsValid() {
 		return nil, errors.ThrowInvalidArgument(nil, "PROJECT-LiaZi", "Errors.Project.Member.Invalid")
 	}
-	if len(domain.CheckForInvalidRoles(member.Roles, domain.ProjectRolePrefix, c.zitadelRoles)) > 0 {
-		return nil, errors.ThrowInvalidArgument(nil, "PROJECT-3m9d", "Errors.Project.Member.Invalid")
+	if invalidRoles, err := c.checkForInvalidMemberRoles(ctx, c.eventstore.Filter, domain.ProjectRolePrefix, member.Roles); err != nil || len(invalidRoles) > 0 {
+		return nil, errors.ThrowInvalidArgument(err, "PROJECT-3m9d", "Errors.Project.Member.Invalid")
 	}
 
 	existingMember, err := c.projectMemberWriteModelByID(ctx, member.AggregateID, member.UserID, resourceOwner)
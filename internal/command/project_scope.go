@@ -0,0 +1,108 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+// AddProjectScope defines a custom scope on the project,
+// which API applications of the project can request through client credentials or private key JWT
+func (c *Commands) AddProjectScope(ctx context.Context, projectScope *domain.ProjectScope, resourceOwner string) (_ *domain.ProjectScope, err error) {
+	if !projectScope.IsValid() {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Sc3nf", "Errors.Project.Scope.Invalid")
+	}
+	err = c.checkProjectExists(ctx, projectScope.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	scopeWriteModel := NewProjectScopeWriteModelWithKey(projectScope.Key, projectScope.AggregateID, resourceOwner)
+	projectAgg := ProjectAggregateFromWriteModel(&scopeWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, project.NewScopeAddedEvent(
+		ctx,
+		projectAgg,
+		projectScope.Key,
+		projectScope.DisplayName,
+		projectScope.Description,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(scopeWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return scopeWriteModelToScope(scopeWriteModel), nil
+}
+
+func (c *Commands) ChangeProjectScope(ctx context.Context, projectScope *domain.ProjectScope, resourceOwner string) (_ *domain.ProjectScope, err error) {
+	if projectScope.AggregateID == "" || projectScope.Key == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Sc7ps", "Errors.Project.Scope.Invalid")
+	}
+	err = c.checkProjectExists(ctx, projectScope.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	existingScope, err := c.getProjectScopeWriteModelByID(ctx, projectScope.Key, projectScope.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingScope.State == domain.ProjectScopeStateUnspecified || existingScope.State == domain.ProjectScopeStateRemoved {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Sc2md", "Errors.Project.Scope.NotExisting")
+	}
+
+	projectAgg := ProjectAggregateFromWriteModel(&existingScope.WriteModel)
+	changeEvent, changed, err := existingScope.NewProjectScopeChangedEvent(ctx, projectAgg, projectScope.DisplayName, projectScope.Description)
+	if err != nil {
+		return nil, err
+	}
+	if !changed {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Sc9xe", "Errors.NoChangesFound")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, changeEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingScope, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return scopeWriteModelToScope(existingScope), nil
+}
+
+func (c *Commands) RemoveProjectScope(ctx context.Context, projectID, key, resourceOwner string) (details *domain.ObjectDetails, err error) {
+	if projectID == "" || key == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Sc5tb", "Errors.Project.Scope.Invalid")
+	}
+	existingScope, err := c.getProjectScopeWriteModelByID(ctx, key, projectID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingScope.State == domain.ProjectScopeStateUnspecified || existingScope.State == domain.ProjectScopeStateRemoved {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Sc1kw", "Errors.Project.Scope.NotExisting")
+	}
+	projectAgg := ProjectAggregateFromWriteModel(&existingScope.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, project.NewScopeRemovedEvent(ctx, projectAgg, key))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingScope, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingScope.WriteModel), nil
+}
+
+func (c *Commands) getProjectScopeWriteModelByID(ctx context.Context, key, projectID, resourceOwner string) (*ProjectScopeWriteModel, error) {
+	projectScopeWriteModel := NewProjectScopeWriteModelWithKey(key, projectID, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, projectScopeWriteModel)
+	if err != nil {
+		return nil, err
+	}
+	return projectScopeWriteModel, nil
+}
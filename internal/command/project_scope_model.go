@@ -0,0 +1,111 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+type ProjectScopeWriteModel struct {
+	eventstore.WriteModel
+
+	Key         string
+	DisplayName string
+	Description string
+	State       domain.ProjectScopeState
+}
+
+func NewProjectScopeWriteModelWithKey(key, projectID, resourceOwner string) *ProjectScopeWriteModel {
+	return &ProjectScopeWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   projectID,
+			ResourceOwner: resourceOwner,
+		},
+		Key: key,
+	}
+}
+
+func (wm *ProjectScopeWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *project.ScopeAddedEvent:
+			if e.Key == wm.Key {
+				wm.WriteModel.AppendEvents(e)
+			}
+		case *project.ScopeChangedEvent:
+			if e.Key == wm.Key {
+				wm.WriteModel.AppendEvents(e)
+			}
+		case *project.ScopeRemovedEvent:
+			if e.Key == wm.Key {
+				wm.WriteModel.AppendEvents(e)
+			}
+		case *project.ProjectRemovedEvent:
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *ProjectScopeWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *project.ScopeAddedEvent:
+			wm.Key = e.Key
+			wm.DisplayName = e.DisplayName
+			wm.Description = e.Description
+			wm.State = domain.ProjectScopeStateActive
+		case *project.ScopeChangedEvent:
+			if e.DisplayName != nil {
+				wm.DisplayName = *e.DisplayName
+			}
+			if e.Description != nil {
+				wm.Description = *e.Description
+			}
+		case *project.ScopeRemovedEvent:
+			wm.State = domain.ProjectScopeStateRemoved
+		case *project.ProjectRemovedEvent:
+			wm.State = domain.ProjectScopeStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *ProjectScopeWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			project.ScopeAddedType,
+			project.ScopeChangedType,
+			project.ScopeRemovedType,
+			project.ProjectRemovedType).
+		Builder()
+}
+
+func (wm *ProjectScopeWriteModel) NewProjectScopeChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	displayName,
+	description string,
+) (*project.ScopeChangedEvent, bool, error) {
+	changes := make([]project.ScopeChanges, 0)
+
+	if wm.DisplayName != displayName {
+		changes = append(changes, project.ChangeScopeDisplayName(displayName))
+	}
+	if wm.Description != description {
+		changes = append(changes, project.ChangeScopeDescription(description))
+	}
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := project.NewScopeChangedEvent(ctx, aggregate, wm.Key, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
@@ -0,0 +1,539 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+func TestCommandSide_AddProjectScope(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		scope         *domain.ProjectScope
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ProjectScope
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid scope, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				scope: &domain.ProjectScope{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					Key: "openid",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "project not existing, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+						eventFromEventPusher(
+							project.NewProjectRemovedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1",
+								nil,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				scope: &domain.ProjectScope{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					Key: "invoices:read",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "scope key already exists, already exists error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+					expectPushFailed(caos_errs.ThrowAlreadyExists(nil, "id", "internal"),
+						[]*repository.Event{
+							eventFromEventPusher(project.NewScopeAddedEvent(
+								context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"invoices:read",
+								"Read invoices",
+								"description",
+							),
+							),
+						},
+						uniqueConstraintsFromEventConstraint(project.NewAddProjectScopeUniqueConstraint("invoices:read", "project1")),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				scope: &domain.ProjectScope{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					Key:         "invoices:read",
+					DisplayName: "Read invoices",
+					Description: "description",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsErrorAlreadyExists,
+			},
+		},
+		{
+			name: "add scope, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(project.NewScopeAddedEvent(
+								context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"invoices:read",
+								"Read invoices",
+								"description",
+							),
+							),
+						},
+						uniqueConstraintsFromEventConstraint(project.NewAddProjectScopeUniqueConstraint("invoices:read", "project1")),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				scope: &domain.ProjectScope{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					Key:         "invoices:read",
+					DisplayName: "Read invoices",
+					Description: "description",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ProjectScope{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "project1",
+						ResourceOwner: "org1",
+					},
+					Key:         "invoices:read",
+					DisplayName: "Read invoices",
+					Description: "description",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.AddProjectScope(tt.args.ctx, tt.args.scope, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeProjectScope(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		scope         *domain.ProjectScope
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ProjectScope
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid scope, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				scope: &domain.ProjectScope{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "scope removed, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewScopeAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"invoices:read",
+								"Read invoices",
+								"description",
+							),
+						),
+						eventFromEventPusher(
+							project.NewScopeRemovedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"invoices:read",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				scope: &domain.ProjectScope{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					Key: "invoices:read",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewScopeAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"invoices:read",
+								"Read invoices",
+								"description",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				scope: &domain.ProjectScope{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					Key:         "invoices:read",
+					DisplayName: "Read invoices",
+					Description: "description",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "change scope, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewScopeAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"invoices:read",
+								"Read invoices",
+								"description",
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								newScopeChangedEvent(context.Background(), "project1", "org1", "invoices:read", "Read all invoices", "description changed"),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				scope: &domain.ProjectScope{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					Key:         "invoices:read",
+					DisplayName: "Read all invoices",
+					Description: "description changed",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ProjectScope{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "project1",
+						ResourceOwner: "org1",
+					},
+					Key:         "invoices:read",
+					DisplayName: "Read all invoices",
+					Description: "description changed",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.ChangeProjectScope(tt.args.ctx, tt.args.scope, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RemoveProjectScope(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		projectID     string
+		key           string
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid key, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "scope not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				key:           "invoices:read",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "remove scope, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewScopeAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"invoices:read",
+								"Read invoices",
+								"description",
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(project.NewScopeRemovedEvent(
+								context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"invoices:read",
+							)),
+						},
+						uniqueConstraintsFromEventConstraint(project.NewRemoveProjectScopeUniqueConstraint("invoices:read", "project1")),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				key:           "invoices:read",
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.RemoveProjectScope(tt.args.ctx, tt.args.projectID, tt.args.key, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func newScopeChangedEvent(ctx context.Context, projectID, resourceOwner, key, displayName, description string) *project.ScopeChangedEvent {
+	event, _ := project.NewScopeChangedEvent(ctx,
+		&project.NewAggregate(projectID, resourceOwner).Aggregate,
+		key,
+		[]project.ScopeChanges{
+			project.ChangeScopeDisplayName(displayName),
+			project.ChangeScopeDescription(description),
+		},
+	)
+	return event
+}
@@ -0,0 +1,354 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+const (
+	// secretReencryptionMaxAttempts limits how often the secrets of an aggregate are re-encrypted again,
+	// because they were changed concurrently (see reencryptAggregateSecrets)
+	secretReencryptionMaxAttempts = 5
+)
+
+// EncryptedSecretType identifies the kind of secrets, which are re-encrypted with the same encryption algorithm
+type EncryptedSecretType string
+
+const (
+	EncryptedSecretTypeSMTPPassword EncryptedSecretType = "smtp_password"
+	EncryptedSecretTypeSMSToken     EncryptedSecretType = "sms_token"
+	EncryptedSecretTypeIDPSecret    EncryptedSecretType = "idp_secret"
+	EncryptedSecretTypeOTPSecret    EncryptedSecretType = "otp_secret"
+	// EncryptedSecretTypePersonalDataKey are the keys encrypting the personal data in the events of the users,
+	// they are stored in the eventstore and encrypted with the user encryption key
+	EncryptedSecretTypePersonalDataKey EncryptedSecretType = "personal_data_key"
+)
+
+// SecretReencryptionProgress reports the re-encryption of a type of secrets.
+// As long as Outdated is greater than Reencrypted, the key ids in OutdatedKeyIDs are still in use
+// and must not be removed from the decryption keys.
+type SecretReencryptionProgress struct {
+	// InstanceID is empty for the summary over all instances
+	InstanceID string
+	Type       EncryptedSecretType
+	// Total is the amount of encrypted secrets
+	Total int
+	// Outdated is the amount of secrets not encrypted with the current encryption key
+	Outdated int
+	// Reencrypted is the amount of outdated secrets, which are now encrypted with the current encryption key
+	Reencrypted int
+	// Failed is the amount of outdated secrets, which could not be decrypted
+	Failed int
+	// Skipped is the amount of outdated secrets, which were re-encrypted by a concurrent run
+	Skipped int
+	// OutdatedKeyIDs counts the outdated secrets by their key id
+	OutdatedKeyIDs map[string]int
+}
+
+func (p *SecretReencryptionProgress) add(progress *SecretReencryptionProgress) {
+	p.Total += progress.Total
+	p.Outdated += progress.Outdated
+	p.Reencrypted += progress.Reencrypted
+	p.Failed += progress.Failed
+	p.Skipped += progress.Skipped
+	for keyID, count := range progress.OutdatedKeyIDs {
+		p.OutdatedKeyIDs[keyID] += count
+	}
+}
+
+type secretsWriteModel interface {
+	eventstore.QueryReducer
+	encryptedSecret(aggregateID, id string) *encryptedSecret
+	encryptedSecrets() []*encryptedSecret
+}
+
+type secretReencryption struct {
+	secretType EncryptedSecretType
+	alg        crypto.EncryptionAlgorithm
+	writeModel func(aggregateID string, sequenceLess uint64) secretsWriteModel
+	// event replaces the value of the secret set by the event of its sequence
+	event func(ctx context.Context, secret *encryptedSecret, value *crypto.CryptoValue) eventstore.Command
+	// reencrypt replaces the write model and events for secrets not stored in events
+	reencrypt func(ctx context.Context, instanceID string, dryRun bool) (*SecretReencryptionProgress, error)
+}
+
+func (c *Commands) secretReencryptions() []*secretReencryption {
+	return []*secretReencryption{
+		{
+			secretType: EncryptedSecretTypeSMTPPassword,
+			alg:        c.smtpEncryption,
+			writeModel: func(aggregateID string, sequenceLess uint64) secretsWriteModel {
+				return newSMTPPasswordsWriteModel(aggregateID, sequenceLess)
+			},
+			event: func(ctx context.Context, secret *encryptedSecret, value *crypto.CryptoValue) eventstore.Command {
+				return instance.NewSMTPConfigPasswordReencryptedEvent(ctx, &instance.NewAggregate(secret.aggregateID).Aggregate, value, secret.sequence)
+			},
+		},
+		{
+			secretType: EncryptedSecretTypeSMSToken,
+			alg:        c.smsEncryption,
+			writeModel: func(aggregateID string, sequenceLess uint64) secretsWriteModel {
+				return newSMSTokensWriteModel(aggregateID, sequenceLess)
+			},
+			event: func(ctx context.Context, secret *encryptedSecret, value *crypto.CryptoValue) eventstore.Command {
+				return instance.NewSMSConfigTokenReencryptedEvent(ctx, &instance.NewAggregate(secret.aggregateID).Aggregate, secret.id, value, secret.sequence)
+			},
+		},
+		{
+			secretType: EncryptedSecretTypeIDPSecret,
+			alg:        c.idpConfigEncryption,
+			writeModel: func(aggregateID string, sequenceLess uint64) secretsWriteModel {
+				return newIDPSecretsWriteModel(aggregateID, sequenceLess)
+			},
+			event: func(ctx context.Context, secret *encryptedSecret, value *crypto.CryptoValue) eventstore.Command {
+				if secret.aggregateType == org.AggregateType {
+					return org.NewIDPSecretReencryptedEvent(ctx, &org.NewAggregate(secret.aggregateID).Aggregate, secret.id, secret.idpType, value, secret.sequence)
+				}
+				return instance.NewIDPSecretReencryptedEvent(ctx, &instance.NewAggregate(secret.aggregateID).Aggregate, secret.id, secret.idpType, value, secret.sequence)
+			},
+		},
+		{
+			secretType: EncryptedSecretTypeOTPSecret,
+			alg:        c.multifactors.OTP.CryptoMFA,
+			writeModel: func(aggregateID string, sequenceLess uint64) secretsWriteModel {
+				return newOTPSecretsWriteModel(aggregateID, sequenceLess)
+			},
+			event: func(ctx context.Context, secret *encryptedSecret, value *crypto.CryptoValue) eventstore.Command {
+				return user.NewHumanOTPSecretReencryptedEvent(ctx, &user.NewAggregate(secret.aggregateID, secret.resourceOwner).Aggregate, value, secret.sequence)
+			},
+		},
+		{
+			secretType: EncryptedSecretTypePersonalDataKey,
+			reencrypt:  c.reencryptPersonalDataKeys,
+		},
+	}
+}
+
+// ReencryptSecrets re-encrypts all secrets of the provided instances (or all instances if none are provided),
+// which are not encrypted with the current encryption key of their algorithm.
+// The progress is reported per instance and type of secrets, the returned summary contains the totals per type.
+// If dryRun is set, the outdated secrets are only counted.
+//
+// The secrets are pushed per aggregate. A secret is only re-encrypted once per value, so concurrent runs skip the secrets
+// already re-encrypted by another run. If a secret was changed after it was read, the changed value is re-encrypted again
+// (see reencryptAggregateSecrets).
+func (c *Commands) ReencryptSecrets(ctx context.Context, instanceIDs []string, dryRun bool, progress func(*SecretReencryptionProgress)) (_ []*SecretReencryptionProgress, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if len(instanceIDs) == 0 {
+		instanceIDs, err = c.activeInstanceIDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	reencryptions := c.secretReencryptions()
+	summary := make([]*SecretReencryptionProgress, len(reencryptions))
+	for i, reencryption := range reencryptions {
+		summary[i] = &SecretReencryptionProgress{
+			Type:           reencryption.secretType,
+			OutdatedKeyIDs: make(map[string]int),
+		}
+	}
+	for _, instanceID := range instanceIDs {
+		instanceCtx := authz.WithInstanceID(ctx, instanceID)
+		for i, reencryption := range reencryptions {
+			instanceProgress, err := c.reencryptSecrets(instanceCtx, instanceID, reencryption, dryRun)
+			if err != nil {
+				return nil, err
+			}
+			summary[i].add(instanceProgress)
+			if progress != nil {
+				progress(instanceProgress)
+			}
+		}
+	}
+	return summary, nil
+}
+
+func (c *Commands) activeInstanceIDs(ctx context.Context) ([]string, error) {
+	ctx = authz.WithInstanceID(ctx, "")
+	instanceIDs, err := c.eventstore.InstanceIDs(ctx, eventstore.NewSearchQueryBuilder(eventstore.ColumnsInstanceIDs).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		EventTypes(instance.InstanceAddedEventType).
+		Builder(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	removedInstanceIDs, err := c.eventstore.InstanceIDs(ctx, eventstore.NewSearchQueryBuilder(eventstore.ColumnsInstanceIDs).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		EventTypes(instance.InstanceRemovedEventType).
+		Builder(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	removed := make(map[string]bool, len(removedInstanceIDs))
+	for _, instanceID := range removedInstanceIDs {
+		removed[instanceID] = true
+	}
+	activeInstanceIDs := make([]string, 0, len(instanceIDs))
+	for _, instanceID := range instanceIDs {
+		if !removed[instanceID] {
+			activeInstanceIDs = append(activeInstanceIDs, instanceID)
+		}
+	}
+	return activeInstanceIDs, nil
+}
+
+func (c *Commands) reencryptSecrets(ctx context.Context, instanceID string, reencryption *secretReencryption, dryRun bool) (*SecretReencryptionProgress, error) {
+	if reencryption.reencrypt != nil {
+		return reencryption.reencrypt(ctx, instanceID, dryRun)
+	}
+	progress := &SecretReencryptionProgress{
+		InstanceID:     instanceID,
+		Type:           reencryption.secretType,
+		OutdatedKeyIDs: make(map[string]int),
+	}
+	if reencryption.alg == nil {
+		return progress, nil
+	}
+	writeModel := reencryption.writeModel("", 0)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+
+	outdated := make([]*encryptedSecret, 0)
+	for _, secret := range writeModel.encryptedSecrets() {
+		if secret.value == nil || secret.value.CryptoType != crypto.TypeEncryption {
+			continue
+		}
+		progress.Total++
+		if secret.value.KeyID == reencryption.alg.EncryptionKeyID() {
+			continue
+		}
+		progress.Outdated++
+		progress.OutdatedKeyIDs[secret.value.KeyID]++
+		if !dryRun {
+			outdated = append(outdated, secret)
+		}
+	}
+	// the secrets are sorted by their aggregate, so the secrets of an aggregate are next to each other
+	for len(outdated) > 0 {
+		end := 1
+		for end < len(outdated) && outdated[end].aggregateID == outdated[0].aggregateID {
+			end++
+		}
+		if err := c.reencryptAggregateSecrets(ctx, reencryption, outdated[:end], progress); err != nil {
+			return nil, err
+		}
+		outdated = outdated[end:]
+	}
+	return progress, nil
+}
+
+// reencryptAggregateSecrets pushes the re-encrypted secrets of a single aggregate.
+// The events are unique per replaced value, if another run already re-encrypted the secrets, they are skipped.
+//
+// As the eventstore does not check if the aggregate changed since the secrets were read,
+// the secrets are read again up to the pushed events afterwards.
+// If a secret was changed in the meantime, the change was overwritten by the re-encrypted old value,
+// so the changed value is re-encrypted and pushed again.
+func (c *Commands) reencryptAggregateSecrets(ctx context.Context, reencryption *secretReencryption, secrets []*encryptedSecret, progress *SecretReencryptionProgress) error {
+	for attempt := 0; len(secrets) > 0; attempt++ {
+		if attempt == secretReencryptionMaxAttempts {
+			return errors.ThrowInternal(nil, "COMMAND-Rc3nf", "Errors.SecretReencryption.Conflict")
+		}
+		reencrypted := make([]*encryptedSecret, 0, len(secrets))
+		cmds := make([]eventstore.Command, 0, len(secrets))
+		for _, secret := range secrets {
+			value, err := reencryptSecret(secret.value, reencryption.alg)
+			if err != nil {
+				logging.WithFields("instance", progress.InstanceID, "type", reencryption.secretType, "aggregate", secret.aggregateID, "id", secret.id, "keyID", secret.value.KeyID).
+					WithError(err).Warn("unable to re-encrypt secret")
+				if attempt > 0 {
+					return err
+				}
+				progress.Failed++
+				continue
+			}
+			reencrypted = append(reencrypted, secret)
+			cmds = append(cmds, reencryption.event(ctx, secret, value))
+		}
+		if len(cmds) == 0 {
+			return nil
+		}
+		events, err := c.eventstore.Push(ctx, cmds...)
+		if errors.IsErrorAlreadyExists(err) && attempt == 0 {
+			progress.Skipped += len(cmds)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if attempt == 0 {
+			progress.Reencrypted += len(cmds)
+		}
+		secrets, err = c.concurrentlyChangedSecrets(ctx, reencryption, reencrypted, events)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// concurrentlyChangedSecrets returns the secrets, which were changed after they were read and before the events were pushed.
+// The returned secrets contain the changed value and the sequence of the pushed event, which replaced it.
+func (c *Commands) concurrentlyChangedSecrets(ctx context.Context, reencryption *secretReencryption, reencrypted []*encryptedSecret, events []eventstore.Event) ([]*encryptedSecret, error) {
+	writeModel := reencryption.writeModel(reencrypted[0].aggregateID, events[0].Sequence())
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	changed := make([]*encryptedSecret, 0)
+	for i, secret := range reencrypted {
+		current := writeModel.encryptedSecret(secret.aggregateID, secret.id)
+		// a removed secret is not set again by the re-encrypted event
+		if current == nil || current.sequence == secret.sequence {
+			continue
+		}
+		current.sequence = events[i].Sequence()
+		changed = append(changed, current)
+	}
+	return changed, nil
+}
+
+// reencryptPersonalDataKeys re-encrypts the keys of the personal data with the encryption of the eventstore,
+// the keys are replaced only if they were not changed (e.g. destroyed) concurrently
+func (c *Commands) reencryptPersonalDataKeys(ctx context.Context, instanceID string, dryRun bool) (*SecretReencryptionProgress, error) {
+	reencryption, err := c.eventstore.ReencryptPersonalDataKeys(ctx, instanceID, dryRun)
+	if err != nil {
+		return nil, err
+	}
+	return &SecretReencryptionProgress{
+		InstanceID:     instanceID,
+		Type:           EncryptedSecretTypePersonalDataKey,
+		Total:          reencryption.Total,
+		Outdated:       reencryption.Outdated,
+		Reencrypted:    reencryption.Reencrypted,
+		Failed:         reencryption.Failed,
+		Skipped:        reencryption.Skipped,
+		OutdatedKeyIDs: reencryption.OutdatedKeyIDs,
+	}, nil
+}
+
+func reencryptSecret(value *crypto.CryptoValue, alg crypto.EncryptionAlgorithm) (*crypto.CryptoValue, error) {
+	decrypted, err := crypto.Decrypt(value, alg)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Encrypt(decrypted, alg)
+}
@@ -0,0 +1,433 @@
+package command
+
+import (
+	"sort"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+// encryptedSecret is the current encrypted value of a secret
+// identified by its aggregate and an optional id inside the aggregate (e.g. the id of an idp)
+type encryptedSecret struct {
+	aggregateType eventstore.AggregateType
+	aggregateID   string
+	resourceOwner string
+	id            string
+	idpType       domain.IDPType
+	value         *crypto.CryptoValue
+	// sequence of the event which set the value
+	sequence uint64
+}
+
+// encryptedSecretsWriteModel collects the encrypted secrets of the instance of the context.
+// If aggregateID is set, only the secrets of the aggregate are collected
+// and if sequenceLess is set, only the events before the sequence are reduced.
+type encryptedSecretsWriteModel struct {
+	eventstore.WriteModel
+
+	aggregateID  string
+	sequenceLess uint64
+	secrets      map[string]*encryptedSecret
+}
+
+func newEncryptedSecretsWriteModel(aggregateID string, sequenceLess uint64) encryptedSecretsWriteModel {
+	return encryptedSecretsWriteModel{
+		aggregateID:  aggregateID,
+		sequenceLess: sequenceLess,
+		secrets:      make(map[string]*encryptedSecret),
+	}
+}
+
+func (wm *encryptedSecretsWriteModel) aggregateIDs() []string {
+	if wm.aggregateID == "" {
+		return nil
+	}
+	return []string{wm.aggregateID}
+}
+
+func encryptedSecretKey(aggregateID, id string) string {
+	return aggregateID + ":" + id
+}
+
+func (wm *encryptedSecretsWriteModel) setSecret(event eventstore.Event, id string, idpType domain.IDPType, value *crypto.CryptoValue) {
+	aggregate := event.Aggregate()
+	wm.secrets[encryptedSecretKey(aggregate.ID, id)] = &encryptedSecret{
+		aggregateType: aggregate.Type,
+		aggregateID:   aggregate.ID,
+		resourceOwner: aggregate.ResourceOwner,
+		id:            id,
+		idpType:       idpType,
+		value:         value,
+		sequence:      event.Sequence(),
+	}
+}
+
+// changeSecret updates the value of an existing secret, a nil value means the secret was not changed
+func (wm *encryptedSecretsWriteModel) changeSecret(event eventstore.Event, id string, value *crypto.CryptoValue) {
+	secret, ok := wm.secrets[encryptedSecretKey(event.Aggregate().ID, id)]
+	if !ok || value == nil {
+		return
+	}
+	secret.value = value
+	secret.sequence = event.Sequence()
+}
+
+func (wm *encryptedSecretsWriteModel) removeSecret(event eventstore.Event, id string) {
+	delete(wm.secrets, encryptedSecretKey(event.Aggregate().ID, id))
+}
+
+func (wm *encryptedSecretsWriteModel) removeAggregate(event eventstore.Event) {
+	for key, secret := range wm.secrets {
+		if secret.aggregateID == event.Aggregate().ID {
+			delete(wm.secrets, key)
+		}
+	}
+}
+
+func (wm *encryptedSecretsWriteModel) encryptedSecret(aggregateID, id string) *encryptedSecret {
+	return wm.secrets[encryptedSecretKey(aggregateID, id)]
+}
+
+// encryptedSecrets returns the secrets in a stable order
+func (wm *encryptedSecretsWriteModel) encryptedSecrets() []*encryptedSecret {
+	keys := make([]string, 0, len(wm.secrets))
+	for key := range wm.secrets {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	secrets := make([]*encryptedSecret, len(keys))
+	for i, key := range keys {
+		secrets[i] = wm.secrets[key]
+	}
+	return secrets
+}
+
+type smtpPasswordsWriteModel struct {
+	encryptedSecretsWriteModel
+}
+
+func newSMTPPasswordsWriteModel(aggregateID string, sequenceLess uint64) *smtpPasswordsWriteModel {
+	return &smtpPasswordsWriteModel{
+		encryptedSecretsWriteModel: newEncryptedSecretsWriteModel(aggregateID, sequenceLess),
+	}
+}
+
+func (wm *smtpPasswordsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *instance.SMTPConfigAddedEvent:
+			wm.setSecret(e, "", domain.IDPTypeUnspecified, e.Password)
+		case *instance.SMTPConfigPasswordChangedEvent:
+			wm.changeSecret(e, "", e.Password)
+		case *instance.SMTPConfigPasswordReencryptedEvent:
+			wm.changeSecret(e, "", e.Password)
+		case *instance.SMTPConfigRemovedEvent:
+			wm.removeSecret(e, "")
+		case *instance.InstanceRemovedEvent:
+			wm.removeAggregate(e)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *smtpPasswordsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.aggregateIDs()...).
+		SequenceLess(wm.sequenceLess).
+		EventTypes(
+			instance.SMTPConfigAddedEventType,
+			instance.SMTPConfigPasswordChangedEventType,
+			instance.SMTPConfigPasswordReencryptedEventType,
+			instance.SMTPConfigRemovedEventType,
+			instance.InstanceRemovedEventType,
+		).
+		Builder()
+}
+
+type smsTokensWriteModel struct {
+	encryptedSecretsWriteModel
+}
+
+func newSMSTokensWriteModel(aggregateID string, sequenceLess uint64) *smsTokensWriteModel {
+	return &smsTokensWriteModel{
+		encryptedSecretsWriteModel: newEncryptedSecretsWriteModel(aggregateID, sequenceLess),
+	}
+}
+
+func (wm *smsTokensWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *instance.SMSConfigTwilioAddedEvent:
+			wm.setSecret(e, e.ID, domain.IDPTypeUnspecified, e.Token)
+		case *instance.SMSConfigTwilioTokenChangedEvent:
+			wm.changeSecret(e, e.ID, e.Token)
+		case *instance.SMSConfigTwilioTokenReencryptedEvent:
+			wm.changeSecret(e, e.ID, e.Token)
+		case *instance.SMSConfigRemovedEvent:
+			wm.removeSecret(e, e.ID)
+		case *instance.InstanceRemovedEvent:
+			wm.removeAggregate(e)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *smsTokensWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.aggregateIDs()...).
+		SequenceLess(wm.sequenceLess).
+		EventTypes(
+			instance.SMSConfigTwilioAddedEventType,
+			instance.SMSConfigTwilioTokenChangedEventType,
+			instance.SMSConfigTwilioTokenReencryptedEventType,
+			instance.SMSConfigRemovedEventType,
+			instance.InstanceRemovedEventType,
+		).
+		Builder()
+}
+
+// idpSecretsWriteModel collects the client secrets and ldap bind passwords
+// of the identity providers of the instance and all its organizations
+type idpSecretsWriteModel struct {
+	encryptedSecretsWriteModel
+}
+
+func newIDPSecretsWriteModel(aggregateID string, sequenceLess uint64) *idpSecretsWriteModel {
+	return &idpSecretsWriteModel{
+		encryptedSecretsWriteModel: newEncryptedSecretsWriteModel(aggregateID, sequenceLess),
+	}
+}
+
+func (wm *idpSecretsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *instance.OAuthIDPAddedEvent:
+			wm.setSecret(e, e.ID, domain.IDPTypeOAuth, e.ClientSecret)
+		case *instance.OAuthIDPChangedEvent:
+			wm.changeSecret(e, e.ID, e.ClientSecret)
+		case *instance.OIDCIDPAddedEvent:
+			wm.setSecret(e, e.ID, domain.IDPTypeOIDC, e.ClientSecret)
+		case *instance.OIDCIDPChangedEvent:
+			wm.changeSecret(e, e.ID, e.ClientSecret)
+		case *instance.OIDCIDPMigratedAzureADEvent:
+			wm.setSecret(e, e.ID, domain.IDPTypeAzureAD, e.ClientSecret)
+		case *instance.OIDCIDPMigratedGoogleEvent:
+			wm.setSecret(e, e.ID, domain.IDPTypeGoogle, e.ClientSecret)
+		case *instance.AzureADIDPAddedEvent:
+			wm.setSecret(e, e.ID, domain.IDPTypeAzureAD, e.ClientSecret)
+		case *instance.AzureADIDPChangedEvent:
+			wm.changeSecret(e, e.ID, e.ClientSecret)
+		case *instance.GitHubIDPAddedEvent:
+			wm.setSecret(e, e.ID, domain.IDPTypeGitHub, e.ClientSecret)
+		case *instance.GitHubIDPChangedEvent:
+			wm.changeSecret(e, e.ID, e.ClientSecret)
+		case *instance.GitHubEnterpriseIDPAddedEvent:
+			wm.setSecret(e, e.ID, domain.IDPTypeGitHubEnterprise, e.ClientSecret)
+		case *instance.GitHubEnterpriseIDPChangedEvent:
+			wm.changeSecret(e, e.ID, e.ClientSecret)
+		case *instance.GitLabIDPAddedEvent:
+			wm.setSecret(e, e.ID, domain.IDPTypeGitLab, e.ClientSecret)
+		case *instance.GitLabIDPChangedEvent:
+			wm.changeSecret(e, e.ID, e.ClientSecret)
+		case *instance.GitLabSelfHostedIDPAddedEvent:
+			wm.setSecret(e, e.ID, domain.IDPTypeGitLabSelfHosted, e.ClientSecret)
+		case *instance.GitLabSelfHostedIDPChangedEvent:
+			wm.changeSecret(e, e.ID, e.ClientSecret)
+		case *instance.GoogleIDPAddedEvent:
+			wm.setSecret(e, e.ID, domain.IDPTypeGoogle, e.ClientSecret)
+		case *instance.GoogleIDPChangedEvent:
+			wm.changeSecret(e, e.ID, e.ClientSecret)
+		case *instance.LDAPIDPAddedEvent:
+			wm.setSecret(e, e.ID, domain.IDPTypeLDAP, e.BindPassword)
+		case *instance.LDAPIDPChangedEvent:
+			wm.changeSecret(e, e.ID, e.BindPassword)
+		case *instance.IDPSecretReencryptedEvent:
+			wm.changeSecret(e, e.ID, e.Secret)
+		case *instance.IDPRemovedEvent:
+			wm.removeSecret(e, e.ID)
+		case *instance.IDPOIDCConfigAddedEvent:
+			wm.setSecret(e, e.IDPConfigID, domain.IDPTypeOIDC, e.ClientSecret)
+		case *instance.IDPOIDCConfigChangedEvent:
+			wm.changeSecret(e, e.IDPConfigID, e.ClientSecret)
+		case *instance.IDPConfigRemovedEvent:
+			wm.removeSecret(e, e.ConfigID)
+		case *instance.InstanceRemovedEvent:
+			wm.removeAggregate(e)
+		case *org.OAuthIDPAddedEvent:
+			wm.setSecret(e, e.ID, domain.IDPTypeOAuth, e.ClientSecret)
+		case *org.OAuthIDPChangedEvent:
+			wm.changeSecret(e, e.ID, e.ClientSecret)
+		case *org.OIDCIDPAddedEvent:
+			wm.setSecret(e, e.ID, domain.IDPTypeOIDC, e.ClientSecret)
+		case *org.OIDCIDPChangedEvent:
+			wm.changeSecret(e, e.ID, e.ClientSecret)
+		case *org.OIDCIDPMigratedAzureADEvent:
+			wm.setSecret(e, e.ID, domain.IDPTypeAzureAD, e.ClientSecret)
+		case *org.OIDCIDPMigratedGoogleEvent:
+			wm.setSecret(e, e.ID, domain.IDPTypeGoogle, e.ClientSecret)
+		case *org.AzureADIDPAddedEvent:
+			wm.setSecret(e, e.ID, domain.IDPTypeAzureAD, e.ClientSecret)
+		case *org.AzureADIDPChangedEvent:
+			wm.changeSecret(e, e.ID, e.ClientSecret)
+		case *org.GitHubIDPAddedEvent:
+			wm.setSecret(e, e.ID, domain.IDPTypeGitHub, e.ClientSecret)
+		case *org.GitHubIDPChangedEvent:
+			wm.changeSecret(e, e.ID, e.ClientSecret)
+		case *org.GitHubEnterpriseIDPAddedEvent:
+			wm.setSecret(e, e.ID, domain.IDPTypeGitHubEnterprise, e.ClientSecret)
+		case *org.GitHubEnterpriseIDPChangedEvent:
+			wm.changeSecret(e, e.ID, e.ClientSecret)
+		case *org.GitLabIDPAddedEvent:
+			wm.setSecret(e, e.ID, domain.IDPTypeGitLab, e.ClientSecret)
+		case *org.GitLabIDPChangedEvent:
+			wm.changeSecret(e, e.ID, e.ClientSecret)
+		case *org.GitLabSelfHostedIDPAddedEvent:
+			wm.setSecret(e, e.ID, domain.IDPTypeGitLabSelfHosted, e.ClientSecret)
+		case *org.GitLabSelfHostedIDPChangedEvent:
+			wm.changeSecret(e, e.ID, e.ClientSecret)
+		case *org.GoogleIDPAddedEvent:
+			wm.setSecret(e, e.ID, domain.IDPTypeGoogle, e.ClientSecret)
+		case *org.GoogleIDPChangedEvent:
+			wm.changeSecret(e, e.ID, e.ClientSecret)
+		case *org.LDAPIDPAddedEvent:
+			wm.setSecret(e, e.ID, domain.IDPTypeLDAP, e.BindPassword)
+		case *org.LDAPIDPChangedEvent:
+			wm.changeSecret(e, e.ID, e.BindPassword)
+		case *org.IDPSecretReencryptedEvent:
+			wm.changeSecret(e, e.ID, e.Secret)
+		case *org.IDPRemovedEvent:
+			wm.removeSecret(e, e.ID)
+		case *org.IDPOIDCConfigAddedEvent:
+			wm.setSecret(e, e.IDPConfigID, domain.IDPTypeOIDC, e.ClientSecret)
+		case *org.IDPOIDCConfigChangedEvent:
+			wm.changeSecret(e, e.IDPConfigID, e.ClientSecret)
+		case *org.IDPConfigRemovedEvent:
+			wm.removeSecret(e, e.ConfigID)
+		case *org.OrgRemovedEvent:
+			wm.removeAggregate(e)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *idpSecretsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.aggregateIDs()...).
+		SequenceLess(wm.sequenceLess).
+		EventTypes(
+			instance.OAuthIDPAddedEventType,
+			instance.OAuthIDPChangedEventType,
+			instance.OIDCIDPAddedEventType,
+			instance.OIDCIDPChangedEventType,
+			instance.OIDCIDPMigratedAzureADEventType,
+			instance.OIDCIDPMigratedGoogleEventType,
+			instance.AzureADIDPAddedEventType,
+			instance.AzureADIDPChangedEventType,
+			instance.GitHubIDPAddedEventType,
+			instance.GitHubIDPChangedEventType,
+			instance.GitHubEnterpriseIDPAddedEventType,
+			instance.GitHubEnterpriseIDPChangedEventType,
+			instance.GitLabIDPAddedEventType,
+			instance.GitLabIDPChangedEventType,
+			instance.GitLabSelfHostedIDPAddedEventType,
+			instance.GitLabSelfHostedIDPChangedEventType,
+			instance.GoogleIDPAddedEventType,
+			instance.GoogleIDPChangedEventType,
+			instance.LDAPIDPAddedEventType,
+			instance.LDAPIDPChangedEventType,
+			instance.IDPSecretReencryptedEventType,
+			instance.IDPRemovedEventType,
+			instance.IDPOIDCConfigAddedEventType,
+			instance.IDPOIDCConfigChangedEventType,
+			instance.IDPConfigRemovedEventType,
+			instance.InstanceRemovedEventType,
+		).
+		Or().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.aggregateIDs()...).
+		SequenceLess(wm.sequenceLess).
+		EventTypes(
+			org.OAuthIDPAddedEventType,
+			org.OAuthIDPChangedEventType,
+			org.OIDCIDPAddedEventType,
+			org.OIDCIDPChangedEventType,
+			org.OIDCIDPMigratedAzureADEventType,
+			org.OIDCIDPMigratedGoogleEventType,
+			org.AzureADIDPAddedEventType,
+			org.AzureADIDPChangedEventType,
+			org.GitHubIDPAddedEventType,
+			org.GitHubIDPChangedEventType,
+			org.GitHubEnterpriseIDPAddedEventType,
+			org.GitHubEnterpriseIDPChangedEventType,
+			org.GitLabIDPAddedEventType,
+			org.GitLabIDPChangedEventType,
+			org.GitLabSelfHostedIDPAddedEventType,
+			org.GitLabSelfHostedIDPChangedEventType,
+			org.GoogleIDPAddedEventType,
+			org.GoogleIDPChangedEventType,
+			org.LDAPIDPAddedEventType,
+			org.LDAPIDPChangedEventType,
+			org.IDPSecretReencryptedEventType,
+			org.IDPRemovedEventType,
+			org.IDPOIDCConfigAddedEventType,
+			org.IDPOIDCConfigChangedEventType,
+			org.IDPConfigRemovedEventType,
+			org.OrgRemovedEventType,
+		).
+		Builder()
+}
+
+// otpSecretsWriteModel collects the TOTP secrets of all users
+type otpSecretsWriteModel struct {
+	encryptedSecretsWriteModel
+}
+
+func newOTPSecretsWriteModel(aggregateID string, sequenceLess uint64) *otpSecretsWriteModel {
+	return &otpSecretsWriteModel{
+		encryptedSecretsWriteModel: newEncryptedSecretsWriteModel(aggregateID, sequenceLess),
+	}
+}
+
+func (wm *otpSecretsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanOTPAddedEvent:
+			wm.setSecret(e, "", domain.IDPTypeUnspecified, e.Secret)
+		case *user.HumanOTPSecretReencryptedEvent:
+			wm.changeSecret(e, "", e.Secret)
+		case *user.HumanOTPRemovedEvent:
+			wm.removeSecret(e, "")
+		case *user.UserRemovedEvent:
+			wm.removeSecret(e, "")
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *otpSecretsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.aggregateIDs()...).
+		SequenceLess(wm.sequenceLess).
+		EventTypes(
+			user.HumanMFAOTPAddedType,
+			user.HumanMFAOTPSecretReencryptedType,
+			user.HumanMFAOTPRemovedType,
+			user.UserV1MFAOTPAddedType,
+			user.UserV1MFAOTPRemovedType,
+			user.UserRemovedType,
+		).
+		Builder()
+}
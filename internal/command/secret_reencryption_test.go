@@ -0,0 +1,607 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+// rotatedEncryptionAlg encrypts with the key "new" and is still able to decrypt values of the key "old"
+func rotatedEncryptionAlg(ctrl *gomock.Controller) crypto.EncryptionAlgorithm {
+	mCrypto := crypto.NewMockEncryptionAlgorithm(ctrl)
+	mCrypto.EXPECT().Algorithm().AnyTimes().Return("enc")
+	mCrypto.EXPECT().EncryptionKeyID().AnyTimes().Return("new")
+	mCrypto.EXPECT().DecryptionKeyIDs().AnyTimes().Return([]string{"new", "old"})
+	mCrypto.EXPECT().Encrypt(gomock.Any()).AnyTimes().DoAndReturn(
+		func(value []byte) ([]byte, error) {
+			return value, nil
+		},
+	)
+	mCrypto.EXPECT().Decrypt(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+		func(value []byte, keyID string) ([]byte, error) {
+			return value, nil
+		},
+	)
+	return mCrypto
+}
+
+func encryptedWithKey(keyID, value string) *crypto.CryptoValue {
+	return &crypto.CryptoValue{
+		CryptoType: crypto.TypeEncryption,
+		Algorithm:  "enc",
+		KeyID:      keyID,
+		Crypted:    []byte(value),
+	}
+}
+
+func TestCommands_ReencryptSecrets(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		smtpAlg    bool
+		idpAlg     bool
+		otpAlg     bool
+	}
+	type args struct {
+		instanceIDs []string
+		dryRun      bool
+	}
+	type res struct {
+		summary  []*SecretReencryptionProgress
+		progress []*SecretReencryptionProgress
+		err      func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "filter error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilterError(caos_errs.ThrowInternal(nil, "id", "filter failed")),
+				),
+				smtpAlg: true,
+			},
+			args: args{
+				instanceIDs: []string{"instance1"},
+			},
+			res: res{
+				err: caos_errs.IsInternal,
+			},
+		},
+		{
+			name: "no outdated secrets, no events",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusherWithInstanceID("instance1",
+							instance.NewSMTPConfigAddedEvent(context.Background(),
+								&instance.NewAggregate("instance1").Aggregate,
+								true,
+								"from@domain.ch",
+								"name",
+								"host:587",
+								"user",
+								encryptedWithKey("new", "password"),
+							),
+						),
+					),
+				),
+				smtpAlg: true,
+			},
+			args: args{
+				instanceIDs: []string{"instance1"},
+			},
+			res: res{
+				summary: []*SecretReencryptionProgress{
+					{Type: EncryptedSecretTypeSMTPPassword, Total: 1, OutdatedKeyIDs: map[string]int{}},
+					{Type: EncryptedSecretTypeSMSToken, OutdatedKeyIDs: map[string]int{}},
+					{Type: EncryptedSecretTypeIDPSecret, OutdatedKeyIDs: map[string]int{}},
+					{Type: EncryptedSecretTypeOTPSecret, OutdatedKeyIDs: map[string]int{}},
+					{Type: EncryptedSecretTypePersonalDataKey, OutdatedKeyIDs: map[string]int{}},
+				},
+				progress: []*SecretReencryptionProgress{
+					{InstanceID: "instance1", Type: EncryptedSecretTypeSMTPPassword, Total: 1, OutdatedKeyIDs: map[string]int{}},
+					{InstanceID: "instance1", Type: EncryptedSecretTypeSMSToken, OutdatedKeyIDs: map[string]int{}},
+					{InstanceID: "instance1", Type: EncryptedSecretTypeIDPSecret, OutdatedKeyIDs: map[string]int{}},
+					{InstanceID: "instance1", Type: EncryptedSecretTypeOTPSecret, OutdatedKeyIDs: map[string]int{}},
+					{InstanceID: "instance1", Type: EncryptedSecretTypePersonalDataKey, OutdatedKeyIDs: map[string]int{}},
+				},
+			},
+		},
+		{
+			name: "dry run, outdated secrets counted",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusherWithInstanceID("instance1",
+							instance.NewSMTPConfigAddedEvent(context.Background(),
+								&instance.NewAggregate("instance1").Aggregate,
+								true,
+								"from@domain.ch",
+								"name",
+								"host:587",
+								"user",
+								encryptedWithKey("old", "password"),
+							),
+						),
+					),
+				),
+				smtpAlg: true,
+			},
+			args: args{
+				instanceIDs: []string{"instance1"},
+				dryRun:      true,
+			},
+			res: res{
+				summary: []*SecretReencryptionProgress{
+					{Type: EncryptedSecretTypeSMTPPassword, Total: 1, Outdated: 1, OutdatedKeyIDs: map[string]int{"old": 1}},
+					{Type: EncryptedSecretTypeSMSToken, OutdatedKeyIDs: map[string]int{}},
+					{Type: EncryptedSecretTypeIDPSecret, OutdatedKeyIDs: map[string]int{}},
+					{Type: EncryptedSecretTypeOTPSecret, OutdatedKeyIDs: map[string]int{}},
+					{Type: EncryptedSecretTypePersonalDataKey, OutdatedKeyIDs: map[string]int{}},
+				},
+				progress: []*SecretReencryptionProgress{
+					{InstanceID: "instance1", Type: EncryptedSecretTypeSMTPPassword, Total: 1, Outdated: 1, OutdatedKeyIDs: map[string]int{"old": 1}},
+					{InstanceID: "instance1", Type: EncryptedSecretTypeSMSToken, OutdatedKeyIDs: map[string]int{}},
+					{InstanceID: "instance1", Type: EncryptedSecretTypeIDPSecret, OutdatedKeyIDs: map[string]int{}},
+					{InstanceID: "instance1", Type: EncryptedSecretTypeOTPSecret, OutdatedKeyIDs: map[string]int{}},
+					{InstanceID: "instance1", Type: EncryptedSecretTypePersonalDataKey, OutdatedKeyIDs: map[string]int{}},
+				},
+			},
+		},
+		{
+			name: "unknown key, failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusherWithInstanceID("instance1",
+							instance.NewSMTPConfigAddedEvent(context.Background(),
+								&instance.NewAggregate("instance1").Aggregate,
+								true,
+								"from@domain.ch",
+								"name",
+								"host:587",
+								"user",
+								encryptedWithKey("removed", "password"),
+							),
+						),
+					),
+				),
+				smtpAlg: true,
+			},
+			args: args{
+				instanceIDs: []string{"instance1"},
+			},
+			res: res{
+				summary: []*SecretReencryptionProgress{
+					{Type: EncryptedSecretTypeSMTPPassword, Total: 1, Outdated: 1, Failed: 1, OutdatedKeyIDs: map[string]int{"removed": 1}},
+					{Type: EncryptedSecretTypeSMSToken, OutdatedKeyIDs: map[string]int{}},
+					{Type: EncryptedSecretTypeIDPSecret, OutdatedKeyIDs: map[string]int{}},
+					{Type: EncryptedSecretTypeOTPSecret, OutdatedKeyIDs: map[string]int{}},
+					{Type: EncryptedSecretTypePersonalDataKey, OutdatedKeyIDs: map[string]int{}},
+				},
+				progress: []*SecretReencryptionProgress{
+					{InstanceID: "instance1", Type: EncryptedSecretTypeSMTPPassword, Total: 1, Outdated: 1, Failed: 1, OutdatedKeyIDs: map[string]int{"removed": 1}},
+					{InstanceID: "instance1", Type: EncryptedSecretTypeSMSToken, OutdatedKeyIDs: map[string]int{}},
+					{InstanceID: "instance1", Type: EncryptedSecretTypeIDPSecret, OutdatedKeyIDs: map[string]int{}},
+					{InstanceID: "instance1", Type: EncryptedSecretTypeOTPSecret, OutdatedKeyIDs: map[string]int{}},
+					{InstanceID: "instance1", Type: EncryptedSecretTypePersonalDataKey, OutdatedKeyIDs: map[string]int{}},
+				},
+			},
+		},
+		{
+			name: "outdated secrets, reencrypted",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventWithSequence(1, eventFromEventPusherWithInstanceID("instance1",
+							instance.NewSMTPConfigAddedEvent(context.Background(),
+								&instance.NewAggregate("instance1").Aggregate,
+								true,
+								"from@domain.ch",
+								"name",
+								"host:587",
+								"user",
+								encryptedWithKey("old", "password"),
+							),
+						)),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID("instance1",
+								instance.NewSMTPConfigPasswordReencryptedEvent(context.Background(),
+									&instance.NewAggregate("instance1").Aggregate,
+									encryptedWithKey("new", "password"),
+									1,
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraintWithInstanceID("instance1", instance.NewAddSecretReencryptionUniqueConstraint("instance1", "", 1)),
+					),
+					expectFilter(
+						eventWithSequence(1, eventFromEventPusherWithInstanceID("instance1",
+							instance.NewSMTPConfigAddedEvent(context.Background(),
+								&instance.NewAggregate("instance1").Aggregate,
+								true,
+								"from@domain.ch",
+								"name",
+								"host:587",
+								"user",
+								encryptedWithKey("old", "password"),
+							),
+						)),
+					),
+					expectFilter(
+						eventWithSequence(2, eventFromEventPusherWithInstanceID("instance1",
+							org.NewGoogleIDPAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"idp1",
+								"google",
+								"clientID",
+								encryptedWithKey("old", "secret1"),
+								nil,
+								idp.Options{},
+							),
+						)),
+						eventWithSequence(3, eventFromEventPusherWithInstanceID("instance1",
+							org.NewGoogleIDPAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"idp2",
+								"google",
+								"clientID",
+								encryptedWithKey("old", "secret2"),
+								nil,
+								idp.Options{},
+							),
+						)),
+						eventWithSequence(4, eventFromEventPusherWithInstanceID("instance1",
+							org.NewIDPRemovedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"idp2",
+							),
+						)),
+						eventWithSequence(5, eventFromEventPusherWithInstanceID("instance1",
+							org.NewGoogleIDPAddedEvent(context.Background(),
+								&org.NewAggregate("org2").Aggregate,
+								"idp3",
+								"google",
+								"clientID",
+								encryptedWithKey("old", "secret3"),
+								nil,
+								idp.Options{},
+							),
+						)),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID("instance1",
+								org.NewIDPSecretReencryptedEvent(context.Background(),
+									&org.NewAggregate("org1").Aggregate,
+									"idp1",
+									domain.IDPTypeGoogle,
+									encryptedWithKey("new", "secret1"),
+									2,
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraintWithInstanceID("instance1", idp.NewAddSecretReencryptionUniqueConstraint("org1", "idp1", 2)),
+					),
+					expectFilter(
+						eventWithSequence(2, eventFromEventPusherWithInstanceID("instance1",
+							org.NewGoogleIDPAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"idp1",
+								"google",
+								"clientID",
+								encryptedWithKey("old", "secret1"),
+								nil,
+								idp.Options{},
+							),
+						)),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID("instance1",
+								org.NewIDPSecretReencryptedEvent(context.Background(),
+									&org.NewAggregate("org2").Aggregate,
+									"idp3",
+									domain.IDPTypeGoogle,
+									encryptedWithKey("new", "secret3"),
+									5,
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraintWithInstanceID("instance1", idp.NewAddSecretReencryptionUniqueConstraint("org2", "idp3", 5)),
+					),
+					expectFilter(
+						eventWithSequence(5, eventFromEventPusherWithInstanceID("instance1",
+							org.NewGoogleIDPAddedEvent(context.Background(),
+								&org.NewAggregate("org2").Aggregate,
+								"idp3",
+								"google",
+								"clientID",
+								encryptedWithKey("old", "secret3"),
+								nil,
+								idp.Options{},
+							),
+						)),
+					),
+					expectFilter(
+						eventFromEventPusherWithInstanceID("instance1",
+							user.NewHumanOTPAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								encryptedWithKey("old", "otp1"),
+							),
+						),
+						eventFromEventPusherWithInstanceID("instance1",
+							user.NewHumanOTPSecretReencryptedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								encryptedWithKey("new", "otp1"),
+								0,
+							),
+						),
+					),
+				),
+				smtpAlg: true,
+				idpAlg:  true,
+				otpAlg:  true,
+			},
+			args: args{
+				instanceIDs: []string{"instance1"},
+			},
+			res: res{
+				summary: []*SecretReencryptionProgress{
+					{Type: EncryptedSecretTypeSMTPPassword, Total: 1, Outdated: 1, Reencrypted: 1, OutdatedKeyIDs: map[string]int{"old": 1}},
+					{Type: EncryptedSecretTypeSMSToken, OutdatedKeyIDs: map[string]int{}},
+					{Type: EncryptedSecretTypeIDPSecret, Total: 2, Outdated: 2, Reencrypted: 2, OutdatedKeyIDs: map[string]int{"old": 2}},
+					{Type: EncryptedSecretTypeOTPSecret, Total: 1, OutdatedKeyIDs: map[string]int{}},
+					{Type: EncryptedSecretTypePersonalDataKey, OutdatedKeyIDs: map[string]int{}},
+				},
+				progress: []*SecretReencryptionProgress{
+					{InstanceID: "instance1", Type: EncryptedSecretTypeSMTPPassword, Total: 1, Outdated: 1, Reencrypted: 1, OutdatedKeyIDs: map[string]int{"old": 1}},
+					{InstanceID: "instance1", Type: EncryptedSecretTypeSMSToken, OutdatedKeyIDs: map[string]int{}},
+					{InstanceID: "instance1", Type: EncryptedSecretTypeIDPSecret, Total: 2, Outdated: 2, Reencrypted: 2, OutdatedKeyIDs: map[string]int{"old": 2}},
+					{InstanceID: "instance1", Type: EncryptedSecretTypeOTPSecret, Total: 1, OutdatedKeyIDs: map[string]int{}},
+					{InstanceID: "instance1", Type: EncryptedSecretTypePersonalDataKey, OutdatedKeyIDs: map[string]int{}},
+				},
+			},
+		},
+		{
+			name: "reencrypted by concurrent run, skipped",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventWithSequence(1, eventFromEventPusherWithInstanceID("instance1",
+							instance.NewSMTPConfigAddedEvent(context.Background(),
+								&instance.NewAggregate("instance1").Aggregate,
+								true,
+								"from@domain.ch",
+								"name",
+								"host:587",
+								"user",
+								encryptedWithKey("old", "password"),
+							),
+						)),
+					),
+					expectPushFailed(
+						caos_errs.ThrowAlreadyExists(nil, "id", "Errors.SecretReencryption.AlreadyReencrypted"),
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID("instance1",
+								instance.NewSMTPConfigPasswordReencryptedEvent(context.Background(),
+									&instance.NewAggregate("instance1").Aggregate,
+									encryptedWithKey("new", "password"),
+									1,
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraintWithInstanceID("instance1", instance.NewAddSecretReencryptionUniqueConstraint("instance1", "", 1)),
+					),
+				),
+				smtpAlg: true,
+			},
+			args: args{
+				instanceIDs: []string{"instance1"},
+			},
+			res: res{
+				summary: []*SecretReencryptionProgress{
+					{Type: EncryptedSecretTypeSMTPPassword, Total: 1, Outdated: 1, Skipped: 1, OutdatedKeyIDs: map[string]int{"old": 1}},
+					{Type: EncryptedSecretTypeSMSToken, OutdatedKeyIDs: map[string]int{}},
+					{Type: EncryptedSecretTypeIDPSecret, OutdatedKeyIDs: map[string]int{}},
+					{Type: EncryptedSecretTypeOTPSecret, OutdatedKeyIDs: map[string]int{}},
+					{Type: EncryptedSecretTypePersonalDataKey, OutdatedKeyIDs: map[string]int{}},
+				},
+				progress: []*SecretReencryptionProgress{
+					{InstanceID: "instance1", Type: EncryptedSecretTypeSMTPPassword, Total: 1, Outdated: 1, Skipped: 1, OutdatedKeyIDs: map[string]int{"old": 1}},
+					{InstanceID: "instance1", Type: EncryptedSecretTypeSMSToken, OutdatedKeyIDs: map[string]int{}},
+					{InstanceID: "instance1", Type: EncryptedSecretTypeIDPSecret, OutdatedKeyIDs: map[string]int{}},
+					{InstanceID: "instance1", Type: EncryptedSecretTypeOTPSecret, OutdatedKeyIDs: map[string]int{}},
+					{InstanceID: "instance1", Type: EncryptedSecretTypePersonalDataKey, OutdatedKeyIDs: map[string]int{}},
+				},
+			},
+		},
+		{
+			name: "changed concurrently, changed value reencrypted again",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventWithSequence(1, eventFromEventPusherWithInstanceID("instance1",
+							instance.NewSMTPConfigAddedEvent(context.Background(),
+								&instance.NewAggregate("instance1").Aggregate,
+								true,
+								"from@domain.ch",
+								"name",
+								"host:587",
+								"user",
+								encryptedWithKey("old", "password"),
+							),
+						)),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID("instance1",
+								instance.NewSMTPConfigPasswordReencryptedEvent(context.Background(),
+									&instance.NewAggregate("instance1").Aggregate,
+									encryptedWithKey("new", "password"),
+									1,
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraintWithInstanceID("instance1", instance.NewAddSecretReencryptionUniqueConstraint("instance1", "", 1)),
+					),
+					expectFilter(
+						eventWithSequence(1, eventFromEventPusherWithInstanceID("instance1",
+							instance.NewSMTPConfigAddedEvent(context.Background(),
+								&instance.NewAggregate("instance1").Aggregate,
+								true,
+								"from@domain.ch",
+								"name",
+								"host:587",
+								"user",
+								encryptedWithKey("old", "password"),
+							),
+						)),
+						eventWithSequence(2, eventFromEventPusherWithInstanceID("instance1",
+							instance.NewSMTPConfigPasswordChangedEvent(context.Background(),
+								&instance.NewAggregate("instance1").Aggregate,
+								encryptedWithKey("new", "changed"),
+							),
+						)),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID("instance1",
+								instance.NewSMTPConfigPasswordReencryptedEvent(context.Background(),
+									&instance.NewAggregate("instance1").Aggregate,
+									encryptedWithKey("new", "changed"),
+									0,
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraintWithInstanceID("instance1", instance.NewAddSecretReencryptionUniqueConstraint("instance1", "", 0)),
+					),
+					expectFilter(
+						eventWithSequence(1, eventFromEventPusherWithInstanceID("instance1",
+							instance.NewSMTPConfigAddedEvent(context.Background(),
+								&instance.NewAggregate("instance1").Aggregate,
+								true,
+								"from@domain.ch",
+								"name",
+								"host:587",
+								"user",
+								encryptedWithKey("old", "password"),
+							),
+						)),
+						eventWithSequence(2, eventFromEventPusherWithInstanceID("instance1",
+							instance.NewSMTPConfigPasswordChangedEvent(context.Background(),
+								&instance.NewAggregate("instance1").Aggregate,
+								encryptedWithKey("new", "changed"),
+							),
+						)),
+						eventFromEventPusherWithInstanceID("instance1",
+							instance.NewSMTPConfigPasswordReencryptedEvent(context.Background(),
+								&instance.NewAggregate("instance1").Aggregate,
+								encryptedWithKey("new", "password"),
+								1,
+							),
+						),
+					),
+				),
+				smtpAlg: true,
+			},
+			args: args{
+				instanceIDs: []string{"instance1"},
+			},
+			res: res{
+				summary: []*SecretReencryptionProgress{
+					{Type: EncryptedSecretTypeSMTPPassword, Total: 1, Outdated: 1, Reencrypted: 1, OutdatedKeyIDs: map[string]int{"old": 1}},
+					{Type: EncryptedSecretTypeSMSToken, OutdatedKeyIDs: map[string]int{}},
+					{Type: EncryptedSecretTypeIDPSecret, OutdatedKeyIDs: map[string]int{}},
+					{Type: EncryptedSecretTypeOTPSecret, OutdatedKeyIDs: map[string]int{}},
+					{Type: EncryptedSecretTypePersonalDataKey, OutdatedKeyIDs: map[string]int{}},
+				},
+				progress: []*SecretReencryptionProgress{
+					{InstanceID: "instance1", Type: EncryptedSecretTypeSMTPPassword, Total: 1, Outdated: 1, Reencrypted: 1, OutdatedKeyIDs: map[string]int{"old": 1}},
+					{InstanceID: "instance1", Type: EncryptedSecretTypeSMSToken, OutdatedKeyIDs: map[string]int{}},
+					{InstanceID: "instance1", Type: EncryptedSecretTypeIDPSecret, OutdatedKeyIDs: map[string]int{}},
+					{InstanceID: "instance1", Type: EncryptedSecretTypeOTPSecret, OutdatedKeyIDs: map[string]int{}},
+					{InstanceID: "instance1", Type: EncryptedSecretTypePersonalDataKey, OutdatedKeyIDs: map[string]int{}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			if tt.fields.smtpAlg {
+				c.smtpEncryption = rotatedEncryptionAlg(ctrl)
+			}
+			if tt.fields.idpAlg {
+				c.idpConfigEncryption = rotatedEncryptionAlg(ctrl)
+			}
+			if tt.fields.otpAlg {
+				c.multifactors.OTP.CryptoMFA = rotatedEncryptionAlg(ctrl)
+			}
+			progress := make([]*SecretReencryptionProgress, 0)
+			summary, err := c.ReencryptSecrets(context.Background(), tt.args.instanceIDs, tt.args.dryRun, func(p *SecretReencryptionProgress) {
+				progress = append(progress, p)
+			})
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.summary, summary)
+				assert.Equal(t, tt.res.progress, progress)
+			}
+		})
+	}
+}
+
+func Test_idpSecretsWriteModel(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	wm := newIDPSecretsWriteModel("", 0)
+	wm.AppendEvents(
+		instance.NewOIDCIDPAddedEvent(ctx, &instance.NewAggregate("instance1").Aggregate, "idp1", "oidc", "issuer", "clientID", encryptedWithKey("old", "secret"), nil, false, idp.Options{}),
+		instance.NewOIDCIDPMigratedGoogleEvent(ctx, &instance.NewAggregate("instance1").Aggregate, "idp1", "google", "clientID", encryptedWithKey("old", "migrated"), nil, idp.Options{}),
+		org.NewLDAPIDPAddedEvent(ctx, &org.NewAggregate("org1").Aggregate, "idp2", "ldap", nil, false, "", "", encryptedWithKey("old", "bind"), "", nil, nil, time.Duration(0), idp.LDAPAttributes{}, idp.Options{}),
+		org.NewOrgRemovedEvent(ctx, &org.NewAggregate("org1").Aggregate, "org", nil, false, nil, nil, nil),
+	)
+	assert.NoError(t, wm.Reduce())
+	assert.Equal(t, []*encryptedSecret{
+		{
+			aggregateType: instance.AggregateType,
+			aggregateID:   "instance1",
+			resourceOwner: "instance1",
+			id:            "idp1",
+			idpType:       domain.IDPTypeGoogle,
+			value:         encryptedWithKey("old", "migrated"),
+		},
+	}, wm.encryptedSecrets())
+}
+
+func eventWithSequence(sequence uint64, event *repository.Event) *repository.Event {
+	event.Sequence = sequence
+	return event
+}
@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"reflect"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/eventstore"
 
@@ -13,11 +14,11 @@ import (
 )
 
 func (c *Commands) AddUserGrant(ctx context.Context, usergrant *domain.UserGrant, resourceOwner string) (_ *domain.UserGrant, err error) {
-	event, addedUserGrant, err := c.addUserGrant(ctx, usergrant, resourceOwner)
+	events, addedUserGrant, err := c.addUserGrant(ctx, usergrant, resourceOwner)
 	if err != nil {
 		return nil, err
 	}
-	pushedEvents, err := c.eventstore.Push(ctx, event)
+	pushedEvents, err := c.eventstore.Push(ctx, events...)
 	if err != nil {
 		return nil, err
 	}
@@ -29,10 +30,13 @@ func (c *Commands) AddUserGrant(ctx context.Context, usergrant *domain.UserGrant
 	return userGrantWriteModelToUserGrant(addedUserGrant), nil
 }
 
-func (c *Commands) addUserGrant(ctx context.Context, userGrant *domain.UserGrant, resourceOwner string) (command eventstore.Command, _ *UserGrantWriteModel, err error) {
+func (c *Commands) addUserGrant(ctx context.Context, userGrant *domain.UserGrant, resourceOwner string) (_ []eventstore.Command, _ *UserGrantWriteModel, err error) {
 	if !userGrant.IsValid() {
 		return nil, nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-kVfMa", "Errors.UserGrant.Invalid")
 	}
+	if !userGrant.IsValidityValid() {
+		return nil, nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Vl4ds", "Errors.UserGrant.ValidityInvalid")
+	}
 	err = c.checkUserGrantPreCondition(ctx, userGrant, resourceOwner)
 	if err != nil {
 		return nil, nil, err
@@ -44,15 +48,54 @@ func (c *Commands) addUserGrant(ctx context.Context, userGrant *domain.UserGrant
 
 	addedUserGrant := NewUserGrantWriteModel(userGrant.AggregateID, resourceOwner)
 	userGrantAgg := UserGrantAggregateFromWriteModel(&addedUserGrant.WriteModel)
-	command = usergrant.NewUserGrantAddedEvent(
-		ctx,
-		userGrantAgg,
-		userGrant.UserID,
-		userGrant.ProjectID,
-		userGrant.ProjectGrantID,
-		userGrant.RoleKeys,
-	)
-	return command, addedUserGrant, nil
+	events := []eventstore.Command{
+		usergrant.NewUserGrantAddedEvent(
+			ctx,
+			userGrantAgg,
+			userGrant.UserID,
+			userGrant.ProjectID,
+			userGrant.ProjectGrantID,
+			userGrant.RoleKeys,
+		),
+	}
+	if userGrant.HasValidity() {
+		events = append(events, usergrant.NewUserGrantValiditySetEvent(ctx, userGrantAgg, userGrant.ValidFrom, userGrant.ValidUntil))
+	}
+	return events, addedUserGrant, nil
+}
+
+// SetUserGrantValidity limits the grant to the provided time range, zero values remove the corresponding limit
+func (c *Commands) SetUserGrantValidity(ctx context.Context, grantID, resourceOwner string, validFrom, validUntil time.Time) (_ *domain.ObjectDetails, err error) {
+	if grantID == "" || resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Vm2lf", "Errors.UserGrant.IDMissing")
+	}
+	if !domain.IsGrantValidityValid(validFrom, validUntil) {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Vk9wq", "Errors.UserGrant.ValidityInvalid")
+	}
+	existingUserGrant, err := c.userGrantWriteModelByID(ctx, grantID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingUserGrant.State == domain.UserGrantStateUnspecified || existingUserGrant.State == domain.UserGrantStateRemoved {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Vs0pe", "Errors.UserGrant.NotFound")
+	}
+	err = checkExplicitProjectPermission(ctx, existingUserGrant.ProjectGrantID, existingUserGrant.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if existingUserGrant.ValidFrom.Equal(validFrom) && existingUserGrant.ValidUntil.Equal(validUntil) {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Vn6ha", "Errors.UserGrant.NotChanged")
+	}
+	userGrantAgg := UserGrantAggregateFromWriteModel(&existingUserGrant.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, usergrant.NewUserGrantValiditySetEvent(ctx, userGrantAgg, validFrom, validUntil))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingUserGrant, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingUserGrant.WriteModel), nil
 }
 
 func (c *Commands) ChangeUserGrant(ctx context.Context, userGrant *domain.UserGrant, resourceOwner string) (_ *domain.UserGrant, err error) {
@@ -9,6 +9,8 @@ func userGrantWriteModelToUserGrant(writeModel *UserGrantWriteModel) *domain.Use
 		ProjectID:      writeModel.ProjectID,
 		ProjectGrantID: writeModel.ProjectGrantID,
 		RoleKeys:       writeModel.RoleKeys,
+		ValidFrom:      writeModel.ValidFrom,
+		ValidUntil:     writeModel.ValidUntil,
 		State:          writeModel.State,
 	}
 }
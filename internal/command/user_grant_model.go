@@ -1,6 +1,8 @@
 package command
 
 import (
+	"time"
+
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/project"
@@ -15,6 +17,8 @@ type UserGrantWriteModel struct {
 	ProjectID      string
 	ProjectGrantID string
 	RoleKeys       []string
+	ValidFrom      time.Time
+	ValidUntil     time.Time
 	State          domain.UserGrantState
 }
 
@@ -36,6 +40,15 @@ func (wm *UserGrantWriteModel) Reduce() error {
 			wm.ProjectGrantID = e.ProjectGrantID
 			wm.RoleKeys = e.RoleKeys
 			wm.State = domain.UserGrantStateActive
+		case *usergrant.UserGrantRequestApprovedEvent:
+			wm.UserID = e.UserID
+			wm.ProjectID = e.ProjectID
+			wm.ProjectGrantID = e.ProjectGrantID
+			wm.RoleKeys = e.RoleKeys
+			wm.State = domain.UserGrantStateActive
+		case *usergrant.UserGrantValiditySetEvent:
+			wm.ValidFrom = e.ValidFrom
+			wm.ValidUntil = e.ValidUntil
 		case *usergrant.UserGrantChangedEvent:
 			wm.RoleKeys = e.RoleKeys
 		case *usergrant.UserGrantCascadeChangedEvent:
@@ -70,7 +83,9 @@ func (wm *UserGrantWriteModel) Query() *eventstore.SearchQueryBuilder {
 			usergrant.UserGrantDeactivatedType,
 			usergrant.UserGrantReactivatedType,
 			usergrant.UserGrantRemovedType,
-			usergrant.UserGrantCascadeRemovedType).
+			usergrant.UserGrantCascadeRemovedType,
+			usergrant.UserGrantRequestApprovedType,
+			usergrant.UserGrantValiditySetType).
 		Builder()
 
 	if wm.ResourceOwner != "" {
@@ -0,0 +1,158 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// RequestUserGrant requests the roles of a project (or project grant) for a user.
+// The user grant is only created after the request is approved.
+func (c *Commands) RequestUserGrant(ctx context.Context, request *domain.UserGrant, reason, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	if !request.IsValid() || len(request.RoleKeys) == 0 {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Rq8sd", "Errors.UserGrant.Request.Invalid")
+	}
+	err = c.checkUserGrantPreCondition(ctx, request, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	request.AggregateID, err = c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	writeModel := NewUserGrantRequestWriteModel(request.AggregateID, resourceOwner)
+	pushedEvents, err := c.eventstore.Push(ctx, usergrant.NewUserGrantRequestedEvent(
+		ctx,
+		UserGrantAggregateFromWriteModel(&writeModel.WriteModel),
+		request.UserID,
+		request.ProjectID,
+		request.ProjectGrantID,
+		request.RoleKeys,
+		reason,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// ApproveUserGrantRequest creates the user grant of the request, optionally limited to the provided validity
+func (c *Commands) ApproveUserGrantRequest(ctx context.Context, requestID, resourceOwner string, validFrom, validUntil time.Time) (_ *domain.ObjectDetails, err error) {
+	if !domain.IsGrantValidityValid(validFrom, validUntil) {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Ap2ks", "Errors.UserGrant.ValidityInvalid")
+	}
+	existing, err := c.pendingUserGrantRequest(ctx, requestID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	// the roles might have been removed from the project (grant) in the meantime
+	err = c.checkUserGrantPreCondition(ctx, &domain.UserGrant{
+		UserID:         existing.UserID,
+		ProjectID:      existing.ProjectID,
+		ProjectGrantID: existing.ProjectGrantID,
+		RoleKeys:       existing.RoleKeys,
+	}, existing.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	userGrantAgg := UserGrantAggregateFromWriteModel(&existing.WriteModel)
+	events := []eventstore.Command{
+		usergrant.NewUserGrantRequestApprovedEvent(
+			ctx,
+			userGrantAgg,
+			existing.UserID,
+			existing.ProjectID,
+			existing.ProjectGrantID,
+			existing.RoleKeys,
+		),
+	}
+	if !validFrom.IsZero() || !validUntil.IsZero() {
+		events = append(events, usergrant.NewUserGrantValiditySetEvent(ctx, userGrantAgg, validFrom, validUntil))
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, events...)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existing, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+func (c *Commands) DenyUserGrantRequest(ctx context.Context, requestID, resourceOwner, reason string) (_ *domain.ObjectDetails, err error) {
+	existing, err := c.pendingUserGrantRequest(ctx, requestID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, usergrant.NewUserGrantRequestDeniedEvent(
+		ctx,
+		UserGrantAggregateFromWriteModel(&existing.WriteModel),
+		existing.UserID,
+		existing.ProjectID,
+		existing.ProjectGrantID,
+		reason,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existing, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+func (c *Commands) UserGrantRequestNotificationSent(ctx context.Context, requestID, resourceOwner string, state domain.UserGrantRequestState) (err error) {
+	existing, err := c.userGrantRequestWriteModelByID(ctx, requestID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if !existing.State.Exists() {
+		return caos_errs.ThrowNotFound(nil, "COMMAND-Ns2ob", "Errors.UserGrant.Request.NotFound")
+	}
+	_, err = c.eventstore.Push(ctx, usergrant.NewUserGrantRequestNotificationSentEvent(ctx, UserGrantAggregateFromWriteModel(&existing.WriteModel), state))
+	return err
+}
+
+func (c *Commands) pendingUserGrantRequest(ctx context.Context, requestID, resourceOwner string) (_ *UserGrantRequestWriteModel, err error) {
+	if requestID == "" || resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Pe3md", "Errors.IDMissing")
+	}
+	existing, err := c.userGrantRequestWriteModelByID(ctx, requestID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existing.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Pe8wl", "Errors.UserGrant.Request.NotFound")
+	}
+	if existing.State != domain.UserGrantRequestStateRequested {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Pe0vs", "Errors.UserGrant.Request.NotPending")
+	}
+	err = checkExplicitProjectPermission(ctx, existing.ProjectGrantID, existing.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+func (c *Commands) userGrantRequestWriteModelByID(ctx context.Context, requestID, resourceOwner string) (writeModel *UserGrantRequestWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = NewUserGrantRequestWriteModel(requestID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,63 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+)
+
+type UserGrantRequestWriteModel struct {
+	eventstore.WriteModel
+
+	UserID         string
+	ProjectID      string
+	ProjectGrantID string
+	RoleKeys       []string
+	Reason         string
+	State          domain.UserGrantRequestState
+}
+
+func NewUserGrantRequestWriteModel(requestID string, resourceOwner string) *UserGrantRequestWriteModel {
+	return &UserGrantRequestWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   requestID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *UserGrantRequestWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *usergrant.UserGrantRequestedEvent:
+			wm.UserID = e.UserID
+			wm.ProjectID = e.ProjectID
+			wm.ProjectGrantID = e.ProjectGrantID
+			wm.RoleKeys = e.RoleKeys
+			wm.Reason = e.Reason
+			wm.State = domain.UserGrantRequestStateRequested
+		case *usergrant.UserGrantRequestApprovedEvent:
+			wm.State = domain.UserGrantRequestStateApproved
+		case *usergrant.UserGrantRequestDeniedEvent:
+			wm.State = domain.UserGrantRequestStateDenied
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *UserGrantRequestWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(usergrant.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			usergrant.UserGrantRequestedType,
+			usergrant.UserGrantRequestApprovedType,
+			usergrant.UserGrantRequestDeniedType).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
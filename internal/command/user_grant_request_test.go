@@ -0,0 +1,493 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+)
+
+func userGrantRequestPreConditionEvents() []*repository.Event {
+	return []*repository.Event{
+		eventFromEventPusher(
+			user.NewHumanAddedEvent(context.Background(),
+				&user.NewAggregate("user1", "org1").Aggregate,
+				"username1",
+				"firstname1",
+				"lastname1",
+				"nickname1",
+				"displayname1",
+				language.German,
+				domain.GenderMale,
+				"email1",
+				true,
+			),
+		),
+		eventFromEventPusher(
+			project.NewProjectAddedEvent(context.Background(),
+				&project.NewAggregate("project1", "org1").Aggregate,
+				"projectname1", true, true, true,
+				domain.PrivateLabelingSettingUnspecified,
+			),
+		),
+		eventFromEventPusher(
+			project.NewRoleAddedEvent(context.Background(),
+				&project.NewAggregate("project1", "org1").Aggregate,
+				"rolekey1",
+				"rolekey",
+				"",
+			),
+		),
+	}
+}
+
+func TestCommandSide_RequestUserGrant(t *testing.T) {
+	type fields struct {
+		eventstore  *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx           context.Context
+		request       *domain.UserGrant
+		reason        string
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "without roles, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				request: &domain.UserGrant{
+					UserID:    "user1",
+					ProjectID: "project1",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "role not existing, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						userGrantRequestPreConditionEvents()...,
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				request: &domain.UserGrant{
+					UserID:    "user1",
+					ProjectID: "project1",
+					RoleKeys:  []string{"rolekey2"},
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "request, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						userGrantRequestPreConditionEvents()...,
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(usergrant.NewUserGrantRequestedEvent(context.Background(),
+								&usergrant.NewAggregate("request1", "org1").Aggregate,
+								"user1",
+								"project1",
+								"",
+								[]string{"rolekey1"},
+								"contract",
+							)),
+						},
+						uniqueConstraintsFromEventConstraint(usergrant.NewAddUserGrantRequestUniqueConstraint("org1", "user1", "project1", "")),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "request1"),
+			},
+			args: args{
+				ctx: context.Background(),
+				request: &domain.UserGrant{
+					UserID:    "user1",
+					ProjectID: "project1",
+					RoleKeys:  []string{"rolekey1"},
+				},
+				reason:        "contract",
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:  tt.fields.eventstore,
+				idGenerator: tt.fields.idGenerator,
+			}
+			got, err := r.RequestUserGrant(tt.args.ctx, tt.args.request, tt.args.reason, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ApproveUserGrantRequest(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		requestID     string
+		resourceOwner string
+		validFrom     time.Time
+		validUntil    time.Time
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid validity, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:           authz.NewMockContextWithPermissions("", "", "", []string{domain.RoleProjectOwner}),
+				requestID:     "request1",
+				resourceOwner: "org1",
+				validFrom:     time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+				validUntil:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "request not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           authz.NewMockContextWithPermissions("", "", "", []string{domain.RoleProjectOwner}),
+				requestID:     "request1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "request already denied, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(usergrant.NewUserGrantRequestedEvent(context.Background(),
+							&usergrant.NewAggregate("request1", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+							[]string{"rolekey1"},
+							"",
+						)),
+						eventFromEventPusher(usergrant.NewUserGrantRequestDeniedEvent(context.Background(),
+							&usergrant.NewAggregate("request1", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+							"",
+						)),
+					),
+				),
+			},
+			args: args{
+				ctx:           authz.NewMockContextWithPermissions("", "", "", []string{domain.RoleProjectOwner}),
+				requestID:     "request1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "no permissions, permission denied error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(usergrant.NewUserGrantRequestedEvent(context.Background(),
+							&usergrant.NewAggregate("request1", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+							[]string{"rolekey1"},
+							"",
+						)),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				requestID:     "request1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsPermissionDenied,
+			},
+		},
+		{
+			name: "approve with validity, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(usergrant.NewUserGrantRequestedEvent(context.Background(),
+							&usergrant.NewAggregate("request1", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+							[]string{"rolekey1"},
+							"",
+						)),
+					),
+					expectFilter(
+						userGrantRequestPreConditionEvents()...,
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(usergrant.NewUserGrantRequestApprovedEvent(context.Background(),
+								&usergrant.NewAggregate("request1", "org1").Aggregate,
+								"user1",
+								"project1",
+								"",
+								[]string{"rolekey1"},
+							)),
+							eventFromEventPusher(usergrant.NewUserGrantValiditySetEvent(context.Background(),
+								&usergrant.NewAggregate("request1", "org1").Aggregate,
+								time.Time{},
+								time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+							)),
+						},
+						uniqueConstraintsFromEventConstraint(usergrant.NewRemoveUserGrantRequestUniqueConstraint("org1", "user1", "project1", "")),
+						uniqueConstraintsFromEventConstraint(usergrant.NewAddUserGrantUniqueConstraint("org1", "user1", "project1", "")),
+					),
+				),
+			},
+			args: args{
+				ctx:           authz.NewMockContextWithPermissions("", "", "", []string{domain.RoleProjectOwner}),
+				requestID:     "request1",
+				resourceOwner: "org1",
+				validUntil:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.ApproveUserGrantRequest(tt.args.ctx, tt.args.requestID, tt.args.resourceOwner, tt.args.validFrom, tt.args.validUntil)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_DenyUserGrantRequest(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		requestID     string
+		resourceOwner string
+		reason        string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing id, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:           authz.NewMockContextWithPermissions("", "", "", []string{domain.RoleProjectOwner}),
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "request already approved, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(usergrant.NewUserGrantRequestedEvent(context.Background(),
+							&usergrant.NewAggregate("request1", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+							[]string{"rolekey1"},
+							"",
+						)),
+						eventFromEventPusher(usergrant.NewUserGrantRequestApprovedEvent(context.Background(),
+							&usergrant.NewAggregate("request1", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+							[]string{"rolekey1"},
+						)),
+					),
+				),
+			},
+			args: args{
+				ctx:           authz.NewMockContextWithPermissions("", "", "", []string{domain.RoleProjectOwner}),
+				requestID:     "request1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "deny, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(usergrant.NewUserGrantRequestedEvent(context.Background(),
+							&usergrant.NewAggregate("request1", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+							[]string{"rolekey1"},
+							"",
+						)),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(usergrant.NewUserGrantRequestDeniedEvent(context.Background(),
+								&usergrant.NewAggregate("request1", "org1").Aggregate,
+								"user1",
+								"project1",
+								"",
+								"not needed",
+							)),
+						},
+						uniqueConstraintsFromEventConstraint(usergrant.NewRemoveUserGrantRequestUniqueConstraint("org1", "user1", "project1", "")),
+					),
+				),
+			},
+			args: args{
+				ctx:           authz.NewMockContextWithPermissions("", "", "", []string{domain.RoleProjectOwner}),
+				requestID:     "request1",
+				resourceOwner: "org1",
+				reason:        "not needed",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.DenyUserGrantRequest(tt.args.ctx, tt.args.requestID, tt.args.resourceOwner, tt.args.reason)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// HumanGrantConsent remembers the consent of the user to the scopes requested by the client,
+// so the consent page is only shown again for scopes the user did not consent to yet
+func (c *Commands) HumanGrantConsent(ctx context.Context, userID, resourceOwner, clientID, projectID string, scopes []string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || clientID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Cn2la", "Errors.IDMissing")
+	}
+	writeModel, err := c.consentWriteModel(ctx, userID, resourceOwner, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(writeModel.UserState) {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Cn4sd", "Errors.User.NotFound")
+	}
+	missing := writeModel.missingScopes(scopes)
+	if writeModel.Granted && len(missing) == 0 {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	granted := make([]string, 0, len(writeModel.Scopes)+len(missing))
+	granted = append(granted, writeModel.Scopes...)
+	granted = append(granted, missing...)
+	pushedEvents, err := c.eventstore.Push(ctx, user.NewHumanConsentGrantedEvent(
+		ctx,
+		UserAggregateFromWriteModel(&writeModel.WriteModel),
+		clientID,
+		projectID,
+		granted,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// HumanRevokeConsent removes the consent of the user for the client,
+// so the consent page is shown again on the next authorization of the client
+func (c *Commands) HumanRevokeConsent(ctx context.Context, userID, resourceOwner, clientID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || clientID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Cn7vb", "Errors.IDMissing")
+	}
+	writeModel, err := c.consentWriteModel(ctx, userID, resourceOwner, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.Granted {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Cn9kx", "Errors.User.Consent.NotFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, user.NewHumanConsentRevokedEvent(
+		ctx,
+		UserAggregateFromWriteModel(&writeModel.WriteModel),
+		clientID,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) consentWriteModel(ctx context.Context, userID, resourceOwner, clientID string) (_ *HumanConsentWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewHumanConsentWriteModel(userID, resourceOwner, clientID)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
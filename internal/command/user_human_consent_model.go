@@ -0,0 +1,102 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type HumanConsentWriteModel struct {
+	eventstore.WriteModel
+
+	ClientID string
+	Scopes   []string
+	Granted  bool
+
+	UserState domain.UserState
+}
+
+func NewHumanConsentWriteModel(userID, resourceOwner, clientID string) *HumanConsentWriteModel {
+	return &HumanConsentWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+		ClientID: clientID,
+	}
+}
+
+func (wm *HumanConsentWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *user.HumanConsentGrantedEvent:
+			if wm.ClientID != e.ClientID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *user.HumanConsentRevokedEvent:
+			if wm.ClientID != e.ClientID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		default:
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *HumanConsentWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanAddedEvent, *user.HumanRegisteredEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.HumanConsentGrantedEvent:
+			wm.Granted = true
+			wm.Scopes = e.Scopes
+		case *user.HumanConsentRevokedEvent:
+			wm.Granted = false
+			wm.Scopes = nil
+		case *user.UserRemovedEvent:
+			wm.UserState = domain.UserStateDeleted
+			wm.Granted = false
+			wm.Scopes = nil
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanConsentWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.UserV1AddedType,
+			user.UserV1RegisteredType,
+			user.HumanAddedType,
+			user.HumanRegisteredType,
+			user.HumanConsentGrantedType,
+			user.HumanConsentRevokedType,
+			user.UserRemovedType).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+// missingScopes returns the scopes which were not consented yet
+func (wm *HumanConsentWriteModel) missingScopes(scopes []string) []string {
+	granted := make(map[string]struct{}, len(wm.Scopes))
+	for _, scope := range wm.Scopes {
+		granted[scope] = struct{}{}
+	}
+	missing := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if _, ok := granted[scope]; !ok {
+			missing = append(missing, scope)
+		}
+	}
+	return missing
+}
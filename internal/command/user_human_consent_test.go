@@ -0,0 +1,315 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+func TestCommandSide_HumanGrantConsent(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		userID        string
+		resourceOwner string
+		clientID      string
+		projectID     string
+		scopes        []string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "client id missing, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				scopes:        []string{"openid"},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "user not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				clientID:      "client1",
+				projectID:     "project1",
+				scopes:        []string{"openid"},
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "all scopes already granted, no push",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							newAddHumanEvent("", false, ""),
+						),
+						eventFromEventPusher(
+							user.NewHumanConsentGrantedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"client1",
+								"project1",
+								[]string{"openid", "invoices:read"},
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				clientID:      "client1",
+				projectID:     "project1",
+				scopes:        []string{"invoices:read"},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "grant additional scopes, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							newAddHumanEvent("", false, ""),
+						),
+						eventFromEventPusher(
+							user.NewHumanConsentGrantedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"client1",
+								"project1",
+								[]string{"openid"},
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanConsentGrantedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"client2",
+								"project1",
+								[]string{"invoices:write"},
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								user.NewHumanConsentGrantedEvent(context.Background(),
+									&user.NewAggregate("user1", "org1").Aggregate,
+									"client1",
+									"project1",
+									[]string{"openid", "invoices:read"},
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				clientID:      "client1",
+				projectID:     "project1",
+				scopes:        []string{"openid", "invoices:read"},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.HumanGrantConsent(tt.args.ctx, tt.args.userID, tt.args.resourceOwner, tt.args.clientID, tt.args.projectID, tt.args.scopes)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_HumanRevokeConsent(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		userID        string
+		resourceOwner string
+		clientID      string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "userid missing, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				clientID:      "client1",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "consent not granted, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							newAddHumanEvent("", false, ""),
+						),
+						eventFromEventPusher(
+							user.NewHumanConsentGrantedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"client1",
+								"project1",
+								[]string{"openid"},
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanConsentRevokedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"client1",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				clientID:      "client1",
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "revoke consent, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							newAddHumanEvent("", false, ""),
+						),
+						eventFromEventPusher(
+							user.NewHumanConsentGrantedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"client1",
+								"project1",
+								[]string{"openid"},
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								user.NewHumanConsentRevokedEvent(context.Background(),
+									&user.NewAggregate("user1", "org1").Aggregate,
+									"client1",
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				clientID:      "client1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.HumanRevokeConsent(tt.args.ctx, tt.args.userID, tt.args.resourceOwner, tt.args.clientID)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// AddHumanLifecycleNotification records that the user has to be notified about the upcoming
+// deactivation or removal defined by the user lifecycle policy
+func (c *Commands) AddHumanLifecycleNotification(ctx context.Context, userID, resourceOwner string, action domain.UserLifecycleAction, scheduledAt time.Time) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Lf2qa", "Errors.IDMissing")
+	}
+	if action != domain.UserLifecycleActionNotifyDeactivation && action != domain.UserLifecycleActionNotifyRemoval {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Lf3ia", "Errors.User.Lifecycle.InvalidAction")
+	}
+	writeModel, err := c.lifecycleNotificationWriteModel(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if !isUserStateExists(writeModel.UserState) {
+		return caos_errs.ThrowNotFound(nil, "COMMAND-Lf4nf", "Errors.User.NotFound")
+	}
+	_, err = c.eventstore.Push(ctx, user.NewHumanLifecycleNotificationAddedEvent(
+		ctx,
+		UserAggregateFromWriteModel(&writeModel.WriteModel),
+		action,
+		scheduledAt,
+	))
+	return err
+}
+
+func (c *Commands) HumanLifecycleNotificationSent(ctx context.Context, userID, resourceOwner string, action domain.UserLifecycleAction) (err error) {
+	if userID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Lf5qs", "Errors.IDMissing")
+	}
+	writeModel, err := c.lifecycleNotificationWriteModel(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if writeModel.PendingAction != action {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Lf6np", "Errors.User.Lifecycle.NotificationNotPending")
+	}
+	_, err = c.eventstore.Push(ctx, user.NewHumanLifecycleNotificationSentEvent(
+		ctx,
+		UserAggregateFromWriteModel(&writeModel.WriteModel),
+		action,
+	))
+	return err
+}
+
+func (c *Commands) lifecycleNotificationWriteModel(ctx context.Context, userID, resourceOwner string) (_ *HumanLifecycleNotificationWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewHumanLifecycleNotificationWriteModel(userID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
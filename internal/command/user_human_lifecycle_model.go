@@ -0,0 +1,65 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type HumanLifecycleNotificationWriteModel struct {
+	eventstore.WriteModel
+
+	// PendingAction is the action the user has to be notified about, until the notification is sent
+	PendingAction domain.UserLifecycleAction
+
+	UserState domain.UserState
+}
+
+func NewHumanLifecycleNotificationWriteModel(userID, resourceOwner string) *HumanLifecycleNotificationWriteModel {
+	return &HumanLifecycleNotificationWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *HumanLifecycleNotificationWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanAddedEvent, *user.HumanRegisteredEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.HumanLifecycleNotificationAddedEvent:
+			wm.PendingAction = e.Action
+		case *user.HumanLifecycleNotificationSentEvent:
+			if wm.PendingAction == e.Action {
+				wm.PendingAction = domain.UserLifecycleActionUnspecified
+			}
+		case *user.UserRemovedEvent:
+			wm.UserState = domain.UserStateDeleted
+			wm.PendingAction = domain.UserLifecycleActionUnspecified
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanLifecycleNotificationWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.UserV1AddedType,
+			user.UserV1RegisteredType,
+			user.HumanAddedType,
+			user.HumanRegisteredType,
+			user.HumanLifecycleNotificationAddedType,
+			user.HumanLifecycleNotificationSentType,
+			user.UserRemovedType).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
@@ -103,6 +103,11 @@ func (wm *HumanWriteModel) Reduce() error {
 	return wm.WriteModel.Reduce()
 }
 
+// SnapshotType must be changed if the reduction of the events changes
+func (wm *HumanWriteModel) SnapshotType() string {
+	return "user.human.v1"
+}
+
 func (wm *HumanWriteModel) Query() *eventstore.SearchQueryBuilder {
 	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
 		ResourceOwner(wm.ResourceOwner).
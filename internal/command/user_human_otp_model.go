@@ -29,6 +29,8 @@ func (wm *HumanTOTPWriteModel) Reduce() error {
 		case *user.HumanOTPAddedEvent:
 			wm.Secret = e.Secret
 			wm.State = domain.MFAStateNotReady
+		case *user.HumanOTPSecretReencryptedEvent:
+			wm.Secret = e.Secret
 		case *user.HumanOTPVerifiedEvent:
 			wm.State = domain.MFAStateReady
 		case *user.HumanOTPRemovedEvent:
@@ -47,6 +49,7 @@ func (wm *HumanTOTPWriteModel) Query() *eventstore.SearchQueryBuilder {
 		AggregateIDs(wm.AggregateID).
 		EventTypes(user.HumanMFAOTPAddedType,
 			user.HumanMFAOTPVerifiedType,
+			user.HumanMFAOTPSecretReencryptedType,
 			user.HumanMFAOTPRemovedType,
 			user.UserRemovedType,
 			user.UserV1MFAOTPAddedType,
@@ -1190,6 +1190,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -1231,6 +1232,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -1273,6 +1275,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -1331,6 +1334,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -1418,6 +1422,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -1512,6 +1517,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -1596,6 +1602,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -1686,6 +1693,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
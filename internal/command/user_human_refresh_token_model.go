@@ -19,6 +19,9 @@ type HumanRefreshTokenWriteModel struct {
 	IdleExpiration time.Time
 	Expiration     time.Time
 	UserAgentID    string
+
+	AuthorizationDetails domain.AuthorizationDetails
+	LevelOfAssurance     domain.LevelOfAssurance
 }
 
 func NewHumanRefreshTokenWriteModel(userID, resourceOwner, tokenID string) *HumanRefreshTokenWriteModel {
@@ -65,6 +68,8 @@ func (wm *HumanRefreshTokenWriteModel) Reduce() error {
 			wm.Expiration = e.CreationDate().Add(e.Expiration)
 			wm.UserState = domain.UserStateActive
 			wm.UserAgentID = e.UserAgentID
+			wm.AuthorizationDetails = e.AuthorizationDetails
+			wm.LevelOfAssurance = e.LevelOfAssurance
 		case *user.HumanRefreshTokenRenewedEvent:
 			if wm.UserState == domain.UserStateActive {
 				wm.RefreshToken = e.RefreshToken
@@ -133,6 +133,8 @@ func TestCommands_AddAccessAndRefreshToken(t *testing.T) {
 							[]string{"clientID1"},
 							[]string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopeOfflineAccess},
 							[]string{"password"},
+							nil,
+							domain.LevelOfAssuranceNone,
 							time.Now(),
 							1*time.Hour,
 							24*time.Hour,
@@ -171,6 +173,8 @@ func TestCommands_AddAccessAndRefreshToken(t *testing.T) {
 							[]string{"clientID1"},
 							[]string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopeOfflineAccess},
 							[]string{"password"},
+							nil,
+							domain.LevelOfAssuranceNone,
 							time.Now(),
 							-1*time.Hour,
 							24*time.Hour,
@@ -236,6 +240,7 @@ func TestCommands_AddAccessAndRefreshToken(t *testing.T) {
 		//					[]string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopeOfflineAccess},
 		//					[]string{"password"},
 		//					time.Now(),
+		//					domain.LevelOfAssuranceNone,
 		//					1*time.Hour,
 		//					24*time.Hour,
 		//				)),
@@ -293,7 +298,7 @@ func TestCommands_AddAccessAndRefreshToken(t *testing.T) {
 				keyAlgorithm: tt.fields.keyAlgorithm,
 			}
 			got, gotRefresh, err := c.AddAccessAndRefreshToken(tt.args.ctx, tt.args.orgID, tt.args.agentID, tt.args.clientID, tt.args.userID, tt.args.refreshToken,
-				tt.args.audience, tt.args.scopes, tt.args.authMethodsReferences, tt.args.lifetime, tt.args.refreshIdleExpiration, tt.args.refreshExpiration, tt.args.authTime)
+				tt.args.audience, tt.args.scopes, tt.args.authMethodsReferences, nil, domain.LevelOfAssuranceNone, tt.args.lifetime, tt.args.refreshIdleExpiration, tt.args.refreshExpiration, tt.args.authTime)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -370,6 +375,8 @@ func TestCommands_RevokeRefreshToken(t *testing.T) {
 							[]string{"clientID1"},
 							[]string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopeOfflineAccess},
 							[]string{"password"},
+							nil,
+							domain.LevelOfAssuranceNone,
 							time.Now(),
 							1*time.Hour,
 							10*time.Hour,
@@ -411,6 +418,8 @@ func TestCommands_RevokeRefreshToken(t *testing.T) {
 							[]string{"clientID1"},
 							[]string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopeOfflineAccess},
 							[]string{"password"},
+							nil,
+							domain.LevelOfAssuranceNone,
 							time.Now(),
 							1*time.Hour,
 							10*time.Hour,
@@ -508,6 +517,8 @@ func TestCommands_RevokeRefreshTokens(t *testing.T) {
 							[]string{"clientID1"},
 							[]string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopeOfflineAccess},
 							[]string{"password"},
+							nil,
+							domain.LevelOfAssuranceNone,
 							time.Now(),
 							1*time.Hour,
 							10*time.Hour,
@@ -541,6 +552,8 @@ func TestCommands_RevokeRefreshTokens(t *testing.T) {
 							[]string{"clientID"},
 							[]string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopeOfflineAccess},
 							[]string{"password"},
+							nil,
+							domain.LevelOfAssuranceNone,
 							time.Now(),
 							1*time.Hour,
 							10*time.Hour,
@@ -557,6 +570,8 @@ func TestCommands_RevokeRefreshTokens(t *testing.T) {
 							[]string{"clientID2"},
 							[]string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopeOfflineAccess},
 							[]string{"password"},
+							nil,
+							domain.LevelOfAssuranceNone,
 							time.Now(),
 							1*time.Hour,
 							10*time.Hour,
@@ -603,6 +618,8 @@ func TestCommands_RevokeRefreshTokens(t *testing.T) {
 							[]string{"clientID1"},
 							[]string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopeOfflineAccess},
 							[]string{"password"},
+							nil,
+							domain.LevelOfAssuranceNone,
 							time.Now(),
 							1*time.Hour,
 							10*time.Hour,
@@ -619,6 +636,8 @@ func TestCommands_RevokeRefreshTokens(t *testing.T) {
 							[]string{"clientID2"},
 							[]string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopeOfflineAccess},
 							[]string{"password"},
+							nil,
+							domain.LevelOfAssuranceNone,
 							time.Now(),
 							1*time.Hour,
 							10*time.Hour,
@@ -714,7 +733,7 @@ func TestCommands_addRefreshToken(t *testing.T) {
 	}{
 
 		{
-			name: "add refresh Token",
+			name: "add refresh Token, with authorization details and level of assurance",
 			fields: fields{
 				eventstore:   eventstoreExpect(t),
 				keyAlgorithm: refreshTokenEncryptionAlgorithm(gomock.NewController(t)),
@@ -734,6 +753,9 @@ func TestCommands_addRefreshToken(t *testing.T) {
 					Expiration:        time.Now().Add(5 * time.Minute),
 					Scopes:            []string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopeOfflineAccess},
 					PreferredLanguage: "de",
+
+					AuthorizationDetails: domain.AuthorizationDetails{{"type": "payment_initiation"}},
+					LevelOfAssurance:     domain.LevelOfAssuranceMFA,
 				},
 				authMethodsReferences: []string{"password"},
 				authTime:              authTime,
@@ -751,6 +773,8 @@ func TestCommands_addRefreshToken(t *testing.T) {
 					[]string{"clientID1"},
 					[]string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopeOfflineAccess},
 					[]string{"password"},
+					domain.AuthorizationDetails{{"type": "payment_initiation"}},
+					domain.LevelOfAssuranceMFA,
 					authTime,
 					1*time.Hour,
 					10*time.Hour,
@@ -794,10 +818,12 @@ func TestCommands_renewRefreshToken(t *testing.T) {
 		idleExpiration time.Duration
 	}
 	type res struct {
-		event           *user.HumanRefreshTokenRenewedEvent
-		refreshTokenID  string
-		newRefreshToken string
-		err             func(error) bool
+		event                *user.HumanRefreshTokenRenewedEvent
+		refreshTokenID       string
+		authorizationDetails domain.AuthorizationDetails
+		levelOfAssurance     domain.LevelOfAssurance
+		newRefreshToken      string
+		err                  func(error) bool
 	}
 	tests := []struct {
 		name   string
@@ -862,6 +888,8 @@ func TestCommands_renewRefreshToken(t *testing.T) {
 							[]string{"clientID1"},
 							[]string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopeOfflineAccess},
 							[]string{"password"},
+							nil,
+							domain.LevelOfAssuranceNone,
 							time.Now(),
 							1*time.Hour,
 							24*time.Hour,
@@ -900,6 +928,8 @@ func TestCommands_renewRefreshToken(t *testing.T) {
 							[]string{"clientID1"},
 							[]string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopeOfflineAccess},
 							[]string{"password"},
+							nil,
+							domain.LevelOfAssuranceNone,
 							time.Now(),
 							1*time.Hour,
 							24*time.Hour,
@@ -933,6 +963,8 @@ func TestCommands_renewRefreshToken(t *testing.T) {
 							[]string{"clientID1"},
 							[]string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopeOfflineAccess},
 							[]string{"password"},
+							nil,
+							domain.LevelOfAssuranceNone,
 							time.Now(),
 							1*time.Hour,
 							24*time.Hour,
@@ -973,6 +1005,8 @@ func TestCommands_renewRefreshToken(t *testing.T) {
 							[]string{"clientID1"},
 							[]string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopeOfflineAccess},
 							[]string{"password"},
+							nil,
+							domain.LevelOfAssuranceNone,
 							time.Now(),
 							1*time.Hour,
 							24*time.Hour,
@@ -1014,6 +1048,8 @@ func TestCommands_renewRefreshToken(t *testing.T) {
 							[]string{"clientID1"},
 							[]string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopeOfflineAccess},
 							[]string{"password"},
+							domain.AuthorizationDetails{{"type": "payment_initiation"}},
+							domain.LevelOfAssuranceMFA,
 							time.Now(),
 							1*time.Hour,
 							24*time.Hour,
@@ -1038,8 +1074,10 @@ func TestCommands_renewRefreshToken(t *testing.T) {
 					"refreshToken1",
 					1*time.Hour,
 				),
-				refreshTokenID:  "tokenID",
-				newRefreshToken: base64.RawURLEncoding.EncodeToString([]byte("userID:tokenID:refreshToken1")),
+				refreshTokenID:       "tokenID",
+				authorizationDetails: domain.AuthorizationDetails{{"type": "payment_initiation"}},
+				levelOfAssurance:     domain.LevelOfAssuranceMFA,
+				newRefreshToken:      base64.RawURLEncoding.EncodeToString([]byte("userID:tokenID:refreshToken1")),
 			},
 		},
 	}
@@ -1050,7 +1088,7 @@ func TestCommands_renewRefreshToken(t *testing.T) {
 				idGenerator:  tt.fields.idGenerator,
 				keyAlgorithm: tt.fields.keyAlgorithm,
 			}
-			gotEvent, gotRefreshTokenID, gotNewRefreshToken, err := c.renewRefreshToken(tt.args.ctx, tt.args.userID, tt.args.orgID, tt.args.refreshToken, tt.args.idleExpiration)
+			gotEvent, gotRefreshTokenWriteModel, gotNewRefreshToken, err := c.renewRefreshToken(tt.args.ctx, tt.args.userID, tt.args.orgID, tt.args.refreshToken, tt.args.idleExpiration)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -1059,7 +1097,9 @@ func TestCommands_renewRefreshToken(t *testing.T) {
 			}
 			if tt.res.err == nil {
 				assert.Equal(t, tt.res.event, gotEvent)
-				assert.Equal(t, tt.res.refreshTokenID, gotRefreshTokenID)
+				assert.Equal(t, tt.res.refreshTokenID, gotRefreshTokenWriteModel.TokenID)
+				assert.Equal(t, tt.res.authorizationDetails, gotRefreshTokenWriteModel.AuthorizationDetails)
+				assert.Equal(t, tt.res.levelOfAssurance, gotRefreshTokenWriteModel.LevelOfAssurance)
 				assert.Equal(t, tt.res.newRefreshToken, gotNewRefreshToken)
 			}
 		})
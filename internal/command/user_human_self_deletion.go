@@ -0,0 +1,186 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// selfDeletionMaxAuthAge is the maximum time since the last authentication of the user,
+// to request the self deletion without the password (e.g. after a passwordless or external login)
+const selfDeletionMaxAuthAge = 5 * time.Minute
+
+// RequestHumanSelfDeletion schedules the removal of the user after the configured grace period.
+// The user has to re-authenticate with the current password and the privacy policy has to allow self deletion.
+// The password is checked the same way as on login, including the lockout policy.
+func (c *Commands) RequestHumanSelfDeletion(ctx context.Context, userID, resourceOwner, password string) (_ *domain.ObjectDetails, scheduledAt time.Time, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if password == "" {
+		return nil, scheduledAt, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Wm3ds", "Errors.User.Password.Empty")
+	}
+	return c.requestHumanSelfDeletion(ctx, userID, resourceOwner, func(ctx context.Context) error {
+		lockoutPolicy, err := c.getOrgLockoutPolicy(ctx, resourceOwner)
+		if err != nil {
+			return err
+		}
+		return c.HumanCheckPassword(ctx, resourceOwner, userID, password, nil, lockoutPolicy)
+	})
+}
+
+// RequestHumanSelfDeletionWithSession schedules the removal of the user after the configured grace period.
+// Instead of the password, the user has to be authenticated recently by the provided session,
+// which allows users without password (passwordless or external identity providers) to delete themselves.
+func (c *Commands) RequestHumanSelfDeletionWithSession(ctx context.Context, userID, resourceOwner, sessionID, sessionToken string) (_ *domain.ObjectDetails, scheduledAt time.Time, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if sessionID == "" || sessionToken == "" {
+		return nil, scheduledAt, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Sd3sx", "Errors.Session.Token.Invalid")
+	}
+	return c.requestHumanSelfDeletion(ctx, userID, resourceOwner, func(ctx context.Context) error {
+		sessionWriteModel := NewSessionWriteModel(sessionID, authz.GetCtxData(ctx).OrgID)
+		if err := c.eventstore.FilterToQueryReducer(ctx, sessionWriteModel); err != nil {
+			return err
+		}
+		if sessionWriteModel.State != domain.SessionStateActive {
+			return caos_errs.ThrowNotFound(nil, "COMMAND-Sd4nf", "Errors.Session.NotExisting")
+		}
+		if err := c.sessionTokenVerifier(ctx, sessionToken, sessionWriteModel.AggregateID, sessionWriteModel.TokenID); err != nil {
+			return err
+		}
+		if sessionWriteModel.UserID != userID {
+			return caos_errs.ThrowPermissionDenied(nil, "COMMAND-Sd5us", "Errors.User.SelfDeletion.ReauthenticationRequired")
+		}
+		return checkSelfDeletionAuthTime(sessionWriteModel.AuthenticationTime())
+	})
+}
+
+// RequestHumanSelfDeletionAfterAuthentication schedules the removal of the user after the configured grace period.
+// It's used by the login UI for users without password, which have to be authenticated recently by the auth request.
+func (c *Commands) RequestHumanSelfDeletionAfterAuthentication(ctx context.Context, userID, resourceOwner string, authTime time.Time) (_ *domain.ObjectDetails, scheduledAt time.Time, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	return c.requestHumanSelfDeletion(ctx, userID, resourceOwner, func(context.Context) error {
+		return checkSelfDeletionAuthTime(authTime)
+	})
+}
+
+func checkSelfDeletionAuthTime(authTime time.Time) error {
+	if authTime.IsZero() || time.Since(authTime) > selfDeletionMaxAuthAge {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Sd6ra", "Errors.User.SelfDeletion.ReauthenticationRequired")
+	}
+	return nil
+}
+
+func (c *Commands) requestHumanSelfDeletion(ctx context.Context, userID, resourceOwner string, verify func(ctx context.Context) error) (_ *domain.ObjectDetails, scheduledAt time.Time, err error) {
+	if userID == "" {
+		return nil, scheduledAt, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Rq8fz", "Errors.IDMissing")
+	}
+	privacyPolicy, err := c.getOrgPrivacyPolicy(ctx, resourceOwner)
+	if err != nil {
+		return nil, scheduledAt, err
+	}
+	if !privacyPolicy.AllowSelfDeletion {
+		return nil, scheduledAt, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Ty2nc", "Errors.User.SelfDeletion.NotAllowed")
+	}
+	writeModel, err := c.selfDeletionWriteModel(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, scheduledAt, err
+	}
+	if !isUserStateExists(writeModel.UserState) {
+		return nil, scheduledAt, caos_errs.ThrowNotFound(nil, "COMMAND-Ck4lw", "Errors.User.NotFound")
+	}
+	if writeModel.UserState == domain.UserStateLocked {
+		return nil, scheduledAt, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Lk3sd", "Errors.User.Locked")
+	}
+	if writeModel.Requested {
+		return nil, scheduledAt, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Gw8aq", "Errors.User.SelfDeletion.AlreadyRequested")
+	}
+	if err = verify(ctx); err != nil {
+		return nil, scheduledAt, err
+	}
+	scheduledAt = time.Now().Add(c.selfDeletionGracePeriod)
+	pushedEvents, err := c.eventstore.Push(ctx, user.NewHumanSelfDeletionRequestedEvent(
+		ctx,
+		UserAggregateFromWriteModel(&writeModel.WriteModel),
+		scheduledAt,
+	))
+	if err != nil {
+		return nil, scheduledAt, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, scheduledAt, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), writeModel.ScheduledAt, nil
+}
+
+// CancelHumanSelfDeletion revokes a requested deletion during the grace period
+func (c *Commands) CancelHumanSelfDeletion(ctx context.Context, userID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Nv0sk", "Errors.IDMissing")
+	}
+	writeModel, err := c.selfDeletionWriteModel(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.Requested {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Hu3qp", "Errors.User.SelfDeletion.NotRequested")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, user.NewHumanSelfDeletionCanceledEvent(
+		ctx,
+		UserAggregateFromWriteModel(&writeModel.WriteModel),
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveHumanAfterSelfDeletion removes the user, if the deletion was requested and the grace period is over
+func (c *Commands) RemoveHumanAfterSelfDeletion(ctx context.Context, userID, resourceOwner string, cascadingUserMemberships []*CascadingMembership, cascadingGrantIDs ...string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Zq1mf", "Errors.IDMissing")
+	}
+	writeModel, err := c.selfDeletionWriteModel(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.Requested {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Lx8va", "Errors.User.SelfDeletion.NotRequested")
+	}
+	if !writeModel.IsDue(time.Now()) {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Pe4sb", "Errors.User.SelfDeletion.NotDue")
+	}
+	return c.RemoveUser(ctx, userID, resourceOwner, cascadingUserMemberships, cascadingGrantIDs...)
+}
+
+func (c *Commands) selfDeletionWriteModel(ctx context.Context, userID, resourceOwner string) (_ *HumanSelfDeletionWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewHumanSelfDeletionWriteModel(userID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,78 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type HumanSelfDeletionWriteModel struct {
+	eventstore.WriteModel
+
+	Requested   bool
+	ScheduledAt time.Time
+
+	UserState domain.UserState
+}
+
+func NewHumanSelfDeletionWriteModel(userID, resourceOwner string) *HumanSelfDeletionWriteModel {
+	return &HumanSelfDeletionWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *HumanSelfDeletionWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanAddedEvent, *user.HumanRegisteredEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.HumanSelfDeletionRequestedEvent:
+			wm.Requested = true
+			wm.ScheduledAt = e.ScheduledAt
+		case *user.HumanSelfDeletionCanceledEvent:
+			wm.Requested = false
+			wm.ScheduledAt = time.Time{}
+		case *user.UserLockedEvent:
+			wm.UserState = domain.UserStateLocked
+		case *user.UserUnlockedEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.UserRemovedEvent:
+			wm.UserState = domain.UserStateDeleted
+			wm.Requested = false
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanSelfDeletionWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.UserV1AddedType,
+			user.UserV1RegisteredType,
+			user.HumanAddedType,
+			user.HumanRegisteredType,
+			user.HumanSelfDeletionRequestedType,
+			user.HumanSelfDeletionCanceledType,
+			user.UserLockedType,
+			user.UserUnlockedType,
+			user.UserRemovedType).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+// IsDue returns whether the deletion was requested and the grace period is over
+func (wm *HumanSelfDeletionWriteModel) IsDue(now time.Time) bool {
+	return wm.Requested && !now.Before(wm.ScheduledAt)
+}
@@ -2340,6 +2340,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -2410,6 +2411,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -2480,6 +2482,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -2567,6 +2570,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -2712,6 +2716,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -2825,6 +2830,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -2938,6 +2944,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -3045,6 +3052,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -3174,6 +3182,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -3298,6 +3307,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
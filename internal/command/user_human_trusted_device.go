@@ -0,0 +1,113 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// HumanTrustDevice marks the user agent as trusted for the lifetime defined in the login policy,
+// so the second factor can be skipped on later logins from it
+func (c *Commands) HumanTrustDevice(ctx context.Context, userID, resourceOwner string, browserInfo *domain.BrowserInfo, userAgentID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || userAgentID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Gh3qa", "Errors.IDMissing")
+	}
+	loginPolicy, err := c.getOrgLoginPolicy(ctx, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if loginPolicy.TrustedDeviceLifetime <= 0 {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Bq2xk", "Errors.User.TrustedDevice.NotAllowed")
+	}
+	writeModel, err := c.trustedDeviceWriteModel(ctx, userID, resourceOwner, userAgentID)
+	if err != nil {
+		return err
+	}
+	if !isUserStateExists(writeModel.UserState) {
+		return caos_errs.ThrowNotFound(nil, "COMMAND-Jd9wq", "Errors.User.NotFound")
+	}
+	var name, remoteIP string
+	if browserInfo != nil {
+		name = browserInfo.UserAgent
+		if browserInfo.RemoteIP != nil {
+			remoteIP = browserInfo.RemoteIP.String()
+		}
+	}
+	_, err = c.eventstore.Push(ctx, user.NewHumanDeviceTrustedEvent(
+		ctx,
+		UserAggregateFromWriteModel(&writeModel.WriteModel),
+		userAgentID,
+		name,
+		remoteIP,
+		time.Now().Add(loginPolicy.TrustedDeviceLifetime),
+	))
+	return err
+}
+
+// HumanRevokeTrustedDevice removes the trust of the user agent, so the second factor is required again
+func (c *Commands) HumanRevokeTrustedDevice(ctx context.Context, userID, resourceOwner, userAgentID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || userAgentID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Kd92n", "Errors.IDMissing")
+	}
+	writeModel, err := c.trustedDeviceWriteModel(ctx, userID, resourceOwner, userAgentID)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.Trusted {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Hw2fq", "Errors.User.TrustedDevice.NotFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, user.NewHumanDeviceTrustRevokedEvent(
+		ctx,
+		UserAggregateFromWriteModel(&writeModel.WriteModel),
+		userAgentID,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) HumanDeviceTrustedSent(ctx context.Context, userID, resourceOwner, userAgentID string) error {
+	if userID == "" || userAgentID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Pq0dx", "Errors.IDMissing")
+	}
+	writeModel, err := c.trustedDeviceWriteModel(ctx, userID, resourceOwner, userAgentID)
+	if err != nil {
+		return err
+	}
+	if !writeModel.Trusted {
+		return caos_errs.ThrowNotFound(nil, "COMMAND-Lp3ws", "Errors.User.TrustedDevice.NotFound")
+	}
+	_, err = c.eventstore.Push(ctx, user.NewHumanDeviceTrustedSentEvent(
+		ctx,
+		UserAggregateFromWriteModel(&writeModel.WriteModel),
+		userAgentID,
+	))
+	return err
+}
+
+func (c *Commands) trustedDeviceWriteModel(ctx context.Context, userID, resourceOwner, userAgentID string) (_ *HumanTrustedDeviceWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewHumanTrustedDeviceWriteModel(userID, resourceOwner, userAgentID)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
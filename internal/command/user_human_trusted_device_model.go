@@ -0,0 +1,93 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type HumanTrustedDeviceWriteModel struct {
+	eventstore.WriteModel
+
+	UserAgentID string
+	Expiration  time.Time
+	Trusted     bool
+
+	UserState domain.UserState
+}
+
+func NewHumanTrustedDeviceWriteModel(userID, resourceOwner, userAgentID string) *HumanTrustedDeviceWriteModel {
+	return &HumanTrustedDeviceWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+		UserAgentID: userAgentID,
+	}
+}
+
+func (wm *HumanTrustedDeviceWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *user.HumanDeviceTrustedEvent:
+			if wm.UserAgentID != e.UserAgentID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *user.HumanDeviceTrustRevokedEvent:
+			if wm.UserAgentID != e.UserAgentID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		default:
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *HumanTrustedDeviceWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanAddedEvent, *user.HumanRegisteredEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.HumanDeviceTrustedEvent:
+			wm.Trusted = true
+			wm.Expiration = e.Expiration
+		case *user.HumanDeviceTrustRevokedEvent:
+			wm.Trusted = false
+			wm.Expiration = time.Time{}
+		case *user.UserRemovedEvent:
+			wm.UserState = domain.UserStateDeleted
+			wm.Trusted = false
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanTrustedDeviceWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.UserV1AddedType,
+			user.UserV1RegisteredType,
+			user.HumanAddedType,
+			user.HumanRegisteredType,
+			user.HumanDeviceTrustedType,
+			user.HumanDeviceTrustRevokedType,
+			user.UserRemovedType).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+// IsTrusted returns whether the device is trusted and the trust did not expire yet
+func (wm *HumanTrustedDeviceWriteModel) IsTrusted() bool {
+	return wm.Trusted && time.Now().Before(wm.Expiration)
+}
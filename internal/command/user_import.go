@@ -0,0 +1,469 @@
+package command
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/userimport"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+const (
+	// userImportBatchSize is the amount of rows stored and processed together
+	userImportBatchSize = 100
+	// userImportMaxRows is the maximum amount of rows of a single import job
+	userImportMaxRows = 100000
+	// userImportMaxLineSize is the maximum size of a single line of a JSON Lines import
+	userImportMaxLineSize = 1 << 20
+
+	userImportInvalidRow = "Errors.UserImport.InvalidRow"
+)
+
+// AddUserImportJob parses the users of the import and stores them in batches.
+// The users are created or updated asynchronously, see [Commands.ProcessUserImportBatch]
+func (c *Commands) AddUserImportJob(ctx context.Context, resourceOwner string, format domain.UserImportFormat, data []byte) (jobID string, _ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if resourceOwner == "" {
+		return "", nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Ui1ro", "Errors.ResourceOwnerMissing")
+	}
+	if err = c.checkPermission(ctx, domain.PermissionUserWrite, resourceOwner, ""); err != nil {
+		return "", nil, err
+	}
+	rows, err := parseUserImport(format, data)
+	if err != nil {
+		return "", nil, err
+	}
+	jobID, err = c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	// the IDs of new users are generated upfront,
+	// so a batch processed again updates the users instead of creating them twice
+	for _, row := range rows {
+		if row.Error != "" || row.UserID != "" {
+			continue
+		}
+		if row.UserID, err = c.idGenerator.Next(); err != nil {
+			return "", nil, err
+		}
+	}
+	writeModel := NewUserImportWriteModel(jobID, resourceOwner)
+	agg := userimport.NewAggregate(ctx, jobID, resourceOwner)
+
+	batchCount := (uint64(len(rows)) + userImportBatchSize - 1) / userImportBatchSize
+	cmds := make([]eventstore.Command, 0, batchCount+1)
+	cmds = append(cmds, userimport.NewAddedEvent(ctx, &agg.Aggregate, format, uint64(len(rows)), batchCount))
+	for batch := uint64(0); batch < batchCount; batch++ {
+		end := (batch + 1) * userImportBatchSize
+		if end > uint64(len(rows)) {
+			end = uint64(len(rows))
+		}
+		cmds = append(cmds, userimport.NewRowsAddedEvent(ctx, &agg.Aggregate, batch, rows[batch*userImportBatchSize:end]))
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return "", nil, err
+	}
+	if err = AppendAndReduce(writeModel, pushedEvents...); err != nil {
+		return "", nil, err
+	}
+	return jobID, writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// ProcessUserImportBatch creates or updates the users of the next unprocessed batch of the import job
+// and stores the result of each row.
+// If all batches are processed, the job is marked as done, which erases the rows of the job.
+// Processing a batch again (e.g. after a failed or concurrent execution) is safe,
+// as the users of the rows are identified by their ID and each batch result is only stored once.
+func (c *Commands) ProcessUserImportBatch(ctx context.Context, jobID, resourceOwner string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if jobID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Ui2id", "Errors.IDMissing")
+	}
+	writeModel := NewUserImportWriteModel(jobID, resourceOwner)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return err
+	}
+	if writeModel.State != domain.UserImportJobStateRunning {
+		return nil
+	}
+	agg := userimport.NewAggregate(ctx, jobID, writeModel.ResourceOwner)
+	if writeModel.ProcessedBatches >= writeModel.BatchCount {
+		_, err = c.eventstore.Push(ctx, userimport.NewDoneEvent(ctx, &agg.Aggregate))
+		return err
+	}
+
+	batch := newUserImportBatchWriteModel(jobID, writeModel.ResourceOwner, writeModel.ProcessedBatches)
+	if err = c.eventstore.FilterToQueryReducer(ctx, batch); err != nil {
+		return err
+	}
+	results := c.importUserRows(ctx, writeModel.ResourceOwner, batch.Rows)
+	_, err = c.eventstore.Push(ctx, userimport.NewBatchProcessedEvent(ctx, &agg.Aggregate, batch.batch, results))
+	if caos_errs.IsErrorAlreadyExists(err) {
+		// the batch was already processed by another execution
+		return nil
+	}
+	return err
+}
+
+type userImportRowCommands struct {
+	row    *userimport.Row
+	result *userimport.RowResult
+	cmds   []eventstore.Command
+}
+
+// importUserRows pushes the events of all valid rows together.
+// If the push fails, the rows are pushed one by one, so the failing rows can be determined.
+func (c *Commands) importUserRows(ctx context.Context, resourceOwner string, rows []*userimport.Row) []*userimport.RowResult {
+	results := make([]*userimport.RowResult, len(rows))
+	prepared := make([]*userImportRowCommands, 0, len(rows))
+	cmds := make([]eventstore.Command, 0, len(rows))
+	for i, row := range rows {
+		results[i] = &userimport.RowResult{Row: row.Row, UserID: row.UserID}
+		if row.Error != "" {
+			results[i].State = domain.UserImportRowStateFailed
+			results[i].Error = row.Error
+			continue
+		}
+		rowCmds, err := c.prepareUserImportRow(ctx, resourceOwner, row, results[i])
+		if err != nil {
+			results[i].State = domain.UserImportRowStateFailed
+			results[i].Error = userImportErrorMessage(err)
+			continue
+		}
+		prepared = append(prepared, &userImportRowCommands{row: row, result: results[i], cmds: rowCmds})
+		cmds = append(cmds, rowCmds...)
+	}
+
+	if len(cmds) > 0 {
+		if _, err := c.eventstore.Push(ctx, cmds...); err != nil {
+			for _, p := range prepared {
+				if len(p.cmds) == 0 {
+					continue
+				}
+				if _, err := c.eventstore.Push(ctx, p.cmds...); err != nil {
+					p.result.State = domain.UserImportRowStateFailed
+					p.result.Error = userImportErrorMessage(err)
+				}
+			}
+		}
+	}
+
+	for _, p := range prepared {
+		if p.result.State == domain.UserImportRowStateFailed {
+			continue
+		}
+		for _, grant := range p.row.Grants {
+			_, err := c.AddUserGrant(ctx, &domain.UserGrant{
+				UserID:         p.result.UserID,
+				ProjectID:      grant.ProjectID,
+				ProjectGrantID: grant.ProjectGrantID,
+				RoleKeys:       grant.RoleKeys,
+			}, resourceOwner)
+			if err != nil && !caos_errs.IsErrorAlreadyExists(err) {
+				// the user is kept, but the row is reported as failed, so the grants can be fixed
+				p.result.State = domain.UserImportRowStateFailed
+				p.result.Error = userImportErrorMessage(err)
+				break
+			}
+		}
+	}
+	return results
+}
+
+// prepareUserImportRow returns the events to update the user if it already exists, otherwise to create it
+func (c *Commands) prepareUserImportRow(ctx context.Context, resourceOwner string, row *userimport.Row, result *userimport.RowResult) ([]eventstore.Command, error) {
+	if row.UserID != "" {
+		existing, err := c.getHumanWriteModelByID(ctx, row.UserID, resourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		if isUserStateExists(existing.UserState) {
+			result.State = domain.UserImportRowStateUpdated
+			return c.userImportUpdateCommands(ctx, existing, row)
+		}
+	}
+
+	human := &AddHuman{
+		ID:                     row.UserID,
+		Username:               row.UserName,
+		FirstName:              row.FirstName,
+		LastName:               row.LastName,
+		NickName:               row.NickName,
+		DisplayName:            row.DisplayName,
+		PreferredLanguage:      language.Make(row.PreferredLanguage),
+		Email:                  Email{Address: domain.EmailAddress(row.Email), Verified: row.EmailVerified},
+		Phone:                  Phone{Number: domain.PhoneNumber(row.Phone), Verified: row.PhoneVerified},
+		EncodedPasswordHash:    row.PasswordHash,
+		PasswordChangeRequired: row.PasswordChangeRequired,
+	}
+	for _, metadata := range row.Metadata {
+		human.Metadata = append(human.Metadata, &AddMetadataEntry{Key: metadata.Key, Value: metadata.Value})
+	}
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter,
+		c.AddHumanCommand(human, resourceOwner, c.userPasswordHasher, c.userEncryption, false),
+	)
+	if err != nil {
+		return nil, err
+	}
+	result.UserID = human.ID
+	result.State = domain.UserImportRowStateCreated
+	return cmds, nil
+}
+
+// userImportUpdateCommands only changes the attributes which are set in the row and differ from the existing user
+func (c *Commands) userImportUpdateCommands(ctx context.Context, existing *HumanWriteModel, row *userimport.Row) (_ []eventstore.Command, err error) {
+	agg := UserAggregateFromWriteModel(&existing.WriteModel)
+	cmds := make([]eventstore.Command, 0, 4)
+
+	if userName := strings.TrimSpace(row.UserName); userName != "" && userName != existing.UserName {
+		domainPolicy, err := c.getOrgDomainPolicy(ctx, existing.ResourceOwner)
+		if err != nil {
+			return nil, caos_errs.ThrowPreconditionFailed(err, "COMMAND-Ui3dp", "Errors.Org.DomainPolicy.NotExisting")
+		}
+		if err := CheckDomainPolicyForUserName(userName, domainPolicy); err != nil {
+			return nil, err
+		}
+		cmds = append(cmds, user.NewUsernameChangedEvent(ctx, agg, existing.UserName, userName, domainPolicy.UserLoginMustBeDomain))
+	}
+
+	changes := make([]user.ProfileChanges, 0, 5)
+	if row.FirstName != "" && row.FirstName != existing.FirstName {
+		changes = append(changes, user.ChangeFirstName(row.FirstName))
+	}
+	if row.LastName != "" && row.LastName != existing.LastName {
+		changes = append(changes, user.ChangeLastName(row.LastName))
+	}
+	if row.NickName != "" && row.NickName != existing.NickName {
+		changes = append(changes, user.ChangeNickName(row.NickName))
+	}
+	if row.DisplayName != "" && row.DisplayName != existing.DisplayName {
+		changes = append(changes, user.ChangeDisplayName(row.DisplayName))
+	}
+	if preferredLanguage := language.Make(row.PreferredLanguage); row.PreferredLanguage != "" && preferredLanguage != existing.PreferredLanguage {
+		changes = append(changes, user.ChangePreferredLanguage(preferredLanguage))
+	}
+	if len(changes) > 0 {
+		profileChanged, err := user.NewHumanProfileChangedEvent(ctx, agg, changes)
+		if err != nil {
+			return nil, err
+		}
+		cmds = append(cmds, profileChanged)
+	}
+
+	if row.Email != "" {
+		email := domain.EmailAddress(row.Email).Normalize()
+		if err = email.Validate(); err != nil {
+			return nil, err
+		}
+		if email != existing.Email {
+			cmds = append(cmds, user.NewHumanEmailChangedEvent(ctx, agg, email))
+		}
+		if row.EmailVerified && (email != existing.Email || !existing.IsEmailVerified) {
+			cmds = append(cmds, user.NewHumanEmailVerifiedEvent(ctx, agg))
+		}
+	}
+
+	if row.Phone != "" {
+		phone, err := domain.PhoneNumber(row.Phone).Normalize()
+		if err != nil {
+			return nil, err
+		}
+		if phone != existing.Phone {
+			cmds = append(cmds, user.NewHumanPhoneChangedEvent(ctx, agg, phone))
+		}
+		if row.PhoneVerified && (phone != existing.Phone || !existing.IsPhoneVerified) {
+			cmds = append(cmds, user.NewHumanPhoneVerifiedEvent(ctx, agg))
+		}
+	}
+
+	if row.PasswordHash != "" {
+		if !c.userPasswordHasher.EncodingSupported(row.PasswordHash) {
+			return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Ui4pw", "Errors.User.Password.NotSupported")
+		}
+		cmds = append(cmds, user.NewHumanPasswordChangedEvent(ctx, agg, row.PasswordHash, row.PasswordChangeRequired, ""))
+	}
+
+	for _, metadata := range row.Metadata {
+		entry := &AddMetadataEntry{Key: metadata.Key, Value: metadata.Value}
+		if err = entry.Valid(); err != nil {
+			return nil, err
+		}
+		cmds = append(cmds, user.NewMetadataSetEvent(ctx, agg, entry.Key, entry.Value))
+	}
+	return cmds, nil
+}
+
+func userImportErrorMessage(err error) string {
+	caosErr := new(caos_errs.CaosError)
+	if errors.As(err, &caosErr) {
+		return caosErr.GetMessage()
+	}
+	return "Errors.Internal"
+}
+
+// userImportCSVColumns are the supported columns of a CSV import.
+// The first line of the file must contain the names of the used columns.
+var userImportCSVColumns = map[string]func(row *userimport.Row, value string) error{
+	"user_id":            func(row *userimport.Row, value string) error { row.UserID = value; return nil },
+	"user_name":          func(row *userimport.Row, value string) error { row.UserName = value; return nil },
+	"first_name":         func(row *userimport.Row, value string) error { row.FirstName = value; return nil },
+	"last_name":          func(row *userimport.Row, value string) error { row.LastName = value; return nil },
+	"nick_name":          func(row *userimport.Row, value string) error { row.NickName = value; return nil },
+	"display_name":       func(row *userimport.Row, value string) error { row.DisplayName = value; return nil },
+	"preferred_language": func(row *userimport.Row, value string) error { row.PreferredLanguage = value; return nil },
+	"email":              func(row *userimport.Row, value string) error { row.Email = value; return nil },
+	"email_verified": func(row *userimport.Row, value string) (err error) {
+		row.EmailVerified, err = parseUserImportBool(value)
+		return err
+	},
+	"phone": func(row *userimport.Row, value string) error { row.Phone = value; return nil },
+	"phone_verified": func(row *userimport.Row, value string) (err error) {
+		row.PhoneVerified, err = parseUserImportBool(value)
+		return err
+	},
+	"password_hash": func(row *userimport.Row, value string) error { row.PasswordHash = value; return nil },
+	"password_change_required": func(row *userimport.Row, value string) (err error) {
+		row.PasswordChangeRequired, err = parseUserImportBool(value)
+		return err
+	},
+	"metadata": func(row *userimport.Row, value string) error {
+		if value == "" {
+			return nil
+		}
+		return json.Unmarshal([]byte(value), &row.Metadata)
+	},
+	"grants": func(row *userimport.Row, value string) error {
+		if value == "" {
+			return nil
+		}
+		return json.Unmarshal([]byte(value), &row.Grants)
+	},
+}
+
+func parseUserImportBool(value string) (bool, error) {
+	if value == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(value)
+}
+
+// parseUserImport returns the rows of the import.
+// Rows which cannot be parsed are returned with an error, so they are reported in the result of the job.
+func parseUserImport(format domain.UserImportFormat, data []byte) (rows []*userimport.Row, err error) {
+	switch format {
+	case domain.UserImportFormatJSONLines:
+		rows, err = parseUserImportJSONLines(data)
+	case domain.UserImportFormatCSV:
+		rows, err = parseUserImportCSV(data)
+	default:
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Ui5fm", "Errors.UserImport.InvalidFormat")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Ui6em", "Errors.UserImport.Empty")
+	}
+	return rows, nil
+}
+
+func parseUserImportJSONLines(data []byte) ([]*userimport.Row, error) {
+	rows := make([]*userimport.Row, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), userImportMaxLineSize)
+	for line := uint64(1); scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		if len(rows) >= userImportMaxRows {
+			return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Ui7tm", "Errors.UserImport.TooManyRows")
+		}
+		row := new(userimport.Row)
+		if err := json.Unmarshal(scanner.Bytes(), row); err != nil {
+			rows = append(rows, &userimport.Row{Row: line, Error: userImportInvalidRow})
+			continue
+		}
+		// the row number and error are always set by the import itself
+		row.Row = line
+		row.Error = ""
+		rows = append(rows, row)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, caos_errs.ThrowInvalidArgument(err, "COMMAND-Ui8jl", "Errors.UserImport.InvalidFormat")
+	}
+	return rows, nil
+}
+
+func parseUserImportCSV(data []byte) ([]*userimport.Row, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Ui9eh", "Errors.UserImport.Empty")
+	}
+	if err != nil {
+		return nil, caos_errs.ThrowInvalidArgument(err, "COMMAND-Ui0ch", "Errors.UserImport.InvalidFormat")
+	}
+	columns := make([]func(*userimport.Row, string) error, len(header))
+	for i, name := range header {
+		column, ok := userImportCSVColumns[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Uiauc", "Errors.UserImport.UnknownColumn")
+		}
+		columns[i] = column
+	}
+
+	rows := make([]*userimport.Row, 0)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if len(rows) >= userImportMaxRows {
+			return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Uictm", "Errors.UserImport.TooManyRows")
+		}
+		if err != nil {
+			parseErr := new(csv.ParseError)
+			if !errors.As(err, &parseErr) {
+				return nil, caos_errs.ThrowInvalidArgument(err, "COMMAND-Uibcr", "Errors.UserImport.InvalidFormat")
+			}
+			rows = append(rows, &userimport.Row{Row: uint64(parseErr.StartLine), Error: userImportInvalidRow})
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+		row := &userimport.Row{Row: uint64(line)}
+		if len(record) != len(columns) {
+			row.Error = userImportInvalidRow
+			rows = append(rows, row)
+			continue
+		}
+		for i, value := range record {
+			if err := columns[i](row, strings.TrimSpace(value)); err != nil {
+				row = &userimport.Row{Row: row.Row, Error: userImportInvalidRow}
+				break
+			}
+		}
+		rows = append(rows, row)
+	}
+}
@@ -0,0 +1,95 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/userimport"
+)
+
+type UserImportWriteModel struct {
+	eventstore.WriteModel
+
+	State            domain.UserImportJobState
+	Format           domain.UserImportFormat
+	TotalRows        uint64
+	BatchCount       uint64
+	ProcessedBatches uint64
+}
+
+func NewUserImportWriteModel(jobID, resourceOwner string) *UserImportWriteModel {
+	return &UserImportWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   jobID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *UserImportWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *userimport.AddedEvent:
+			wm.State = domain.UserImportJobStateRunning
+			wm.Format = e.Format
+			wm.TotalRows = e.TotalRows
+			wm.BatchCount = e.BatchCount
+		case *userimport.BatchProcessedEvent:
+			wm.ProcessedBatches++
+		case *userimport.DoneEvent:
+			wm.State = domain.UserImportJobStateDone
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *UserImportWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(userimport.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			userimport.AddedType,
+			userimport.BatchProcessedType,
+			userimport.DoneType,
+		).
+		Builder()
+}
+
+// userImportBatchWriteModel only reduces the rows of a single batch of the import job
+type userImportBatchWriteModel struct {
+	eventstore.WriteModel
+
+	batch uint64
+	Rows  []*userimport.Row
+}
+
+func newUserImportBatchWriteModel(jobID, resourceOwner string, batch uint64) *userImportBatchWriteModel {
+	return &userImportBatchWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   jobID,
+			ResourceOwner: resourceOwner,
+		},
+		batch: batch,
+	}
+}
+
+func (wm *userImportBatchWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		if e, ok := event.(*userimport.RowsAddedEvent); ok && e.Batch == wm.batch {
+			wm.Rows = e.Rows
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *userImportBatchWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(userimport.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(userimport.RowsAddedType).
+		EventData(map[string]interface{}{"batch": wm.batch}).
+		Builder()
+}
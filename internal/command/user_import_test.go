@@ -0,0 +1,499 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/userimport"
+)
+
+func Test_parseUserImport(t *testing.T) {
+	type args struct {
+		format domain.UserImportFormat
+		data   string
+	}
+	type res struct {
+		rows []*userimport.Row
+		err  func(error) bool
+	}
+	tests := []struct {
+		name string
+		args args
+		res  res
+	}{
+		{
+			name: "unspecified format, invalid argument error",
+			args: args{
+				format: domain.UserImportFormatUnspecified,
+				data:   `{"userName":"user"}`,
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "json lines empty, invalid argument error",
+			args: args{
+				format: domain.UserImportFormatJSONLines,
+				data:   "\n\n",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "json lines, ok",
+			args: args{
+				format: domain.UserImportFormatJSONLines,
+				data: `{"userName":"user1","firstName":"first","lastName":"last","email":"user1@example.com","emailVerified":true,"passwordHash":"$2a$14$hash","metadata":[{"key":"key","value":"dmFsdWU="}],"grants":[{"projectId":"project1","roleKeys":["role"]}]}
+
+not json
+{"userId":"user2","error":"ignored"}`,
+			},
+			res: res{
+				rows: []*userimport.Row{
+					{
+						Row:           1,
+						UserName:      "user1",
+						FirstName:     "first",
+						LastName:      "last",
+						Email:         "user1@example.com",
+						EmailVerified: true,
+						PasswordHash:  "$2a$14$hash",
+						Metadata:      []*userimport.Metadata{{Key: "key", Value: []byte("value")}},
+						Grants:        []*userimport.Grant{{ProjectID: "project1", RoleKeys: []string{"role"}}},
+					},
+					{
+						Row:   3,
+						Error: userImportInvalidRow,
+					},
+					{
+						Row:    4,
+						UserID: "user2",
+					},
+				},
+			},
+		},
+		{
+			name: "csv empty, invalid argument error",
+			args: args{
+				format: domain.UserImportFormatCSV,
+				data:   "",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "csv unknown column, invalid argument error",
+			args: args{
+				format: domain.UserImportFormatCSV,
+				data:   "user_name,unknown\nuser1,value",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "csv, ok",
+			args: args{
+				format: domain.UserImportFormatCSV,
+				data: `user_name,first_name,last_name,email,email_verified,password_change_required,metadata
+user1,first,last,user1@example.com,true,,"[{""key"":""key"",""value"":""dmFsdWU=""}]"
+user2,first
+user3,first,last,user3@example.com,maybe,,
+`,
+			},
+			res: res{
+				rows: []*userimport.Row{
+					{
+						Row:           2,
+						UserName:      "user1",
+						FirstName:     "first",
+						LastName:      "last",
+						Email:         "user1@example.com",
+						EmailVerified: true,
+						Metadata:      []*userimport.Metadata{{Key: "key", Value: []byte("value")}},
+					},
+					{
+						Row:   3,
+						Error: userImportInvalidRow,
+					},
+					{
+						Row:   4,
+						Error: userImportInvalidRow,
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseUserImport(tt.args.format, []byte(tt.args.data))
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			assert.Equal(t, tt.res.rows, got)
+		})
+	}
+}
+
+func TestCommandSide_AddUserImportJob(t *testing.T) {
+	type fields struct {
+		eventstore      *eventstore.Eventstore
+		idGenerator     id.Generator
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx           context.Context
+		resourceOwner string
+		format        domain.UserImportFormat
+		data          string
+	}
+	type res struct {
+		jobID string
+		want  *domain.ObjectDetails
+		err   func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "resource owner missing, invalid argument error",
+			fields: fields{
+				eventstore:      eventstoreExpect(t),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				format: domain.UserImportFormatJSONLines,
+				data:   `{"userName":"user1"}`,
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "missing permission, permission denied error",
+			fields: fields{
+				eventstore:      eventstoreExpect(t),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				format:        domain.UserImportFormatJSONLines,
+				data:          `{"userName":"user1"}`,
+			},
+			res: res{
+				err: caos_errs.IsPermissionDenied,
+			},
+		},
+		{
+			name: "invalid format, invalid argument error",
+			fields: fields{
+				eventstore:      eventstoreExpect(t),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				format:        domain.UserImportFormatUnspecified,
+				data:          `{"userName":"user1"}`,
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "add job, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID("instance1",
+								userimport.NewAddedEvent(context.Background(),
+									&userimport.NewAggregate(authz.WithInstanceID(context.Background(), "instance1"), "job1", "org1").Aggregate,
+									domain.UserImportFormatJSONLines,
+									1,
+									1,
+								),
+							),
+							eventFromEventPusherWithInstanceID("instance1",
+								userimport.NewRowsAddedEvent(context.Background(),
+									&userimport.NewAggregate(authz.WithInstanceID(context.Background(), "instance1"), "job1", "org1").Aggregate,
+									0,
+									[]*userimport.Row{{Row: 1, UserID: "user1", UserName: "user1"}},
+								),
+							),
+						},
+					),
+				),
+				idGenerator:     id_mock.NewIDGeneratorExpectIDs(t, "job1", "user1"),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance1"),
+				resourceOwner: "org1",
+				format:        domain.UserImportFormatJSONLines,
+				data:          `{"userName":"user1"}`,
+			},
+			res: res{
+				jobID: "job1",
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:      tt.fields.eventstore,
+				idGenerator:     tt.fields.idGenerator,
+				checkPermission: tt.fields.checkPermission,
+			}
+			jobID, got, err := r.AddUserImportJob(tt.args.ctx, tt.args.resourceOwner, tt.args.format, []byte(tt.args.data))
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.jobID, jobID)
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ProcessUserImportBatch(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	agg := &userimport.NewAggregate(ctx, "job1", "org1").Aggregate
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		jobID         string
+		resourceOwner string
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "job id missing, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx:           ctx,
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "job done, no changes",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							userimport.NewAddedEvent(ctx, agg, domain.UserImportFormatJSONLines, 1, 1),
+						),
+						eventFromEventPusher(
+							userimport.NewBatchProcessedEvent(ctx, agg, 0, nil),
+						),
+						eventFromEventPusher(
+							userimport.NewDoneEvent(ctx, agg),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           ctx,
+				jobID:         "job1",
+				resourceOwner: "org1",
+			},
+		},
+		{
+			name: "all batches processed, done",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							userimport.NewAddedEvent(ctx, agg, domain.UserImportFormatJSONLines, 1, 1),
+						),
+						eventFromEventPusher(
+							userimport.NewBatchProcessedEvent(ctx, agg, 0, nil),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID("instance1",
+								userimport.NewDoneEvent(ctx, agg),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx:           ctx,
+				jobID:         "job1",
+				resourceOwner: "org1",
+			},
+		},
+		{
+			name: "invalid rows, failed results",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							userimport.NewAddedEvent(ctx, agg, domain.UserImportFormatJSONLines, 1, 1),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							userimport.NewRowsAddedEvent(ctx, agg, 0, []*userimport.Row{{Row: 1, Error: userImportInvalidRow}}),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID("instance1",
+								userimport.NewBatchProcessedEvent(ctx, agg, 0, []*userimport.RowResult{
+									{
+										Row:   1,
+										State: domain.UserImportRowStateFailed,
+										Error: userImportInvalidRow,
+									},
+								}),
+							),
+						},
+						uniqueConstraintsFromEventConstraintWithInstanceID("instance1", userimport.NewAddBatchUniqueConstraint("job1", 0)),
+					),
+				),
+			},
+			args: args{
+				ctx:           ctx,
+				jobID:         "job1",
+				resourceOwner: "org1",
+			},
+		},
+		{
+			name: "grant of existing user failed, failed result",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							userimport.NewAddedEvent(ctx, agg, domain.UserImportFormatJSONLines, 1, 1),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							userimport.NewRowsAddedEvent(ctx, agg, 0, []*userimport.Row{{Row: 1, UserID: "user1", Grants: []*userimport.Grant{{}}}}),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							newAddHumanEvent("", false, ""),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID("instance1",
+								userimport.NewBatchProcessedEvent(ctx, agg, 0, []*userimport.RowResult{
+									{
+										Row:    1,
+										UserID: "user1",
+										State:  domain.UserImportRowStateFailed,
+										Error:  "Errors.UserGrant.Invalid",
+									},
+								}),
+							),
+						},
+						uniqueConstraintsFromEventConstraintWithInstanceID("instance1", userimport.NewAddBatchUniqueConstraint("job1", 0)),
+					),
+				),
+			},
+			args: args{
+				ctx:           ctx,
+				jobID:         "job1",
+				resourceOwner: "org1",
+			},
+		},
+		{
+			name: "batch processed concurrently, no error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							userimport.NewAddedEvent(ctx, agg, domain.UserImportFormatJSONLines, 1, 1),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							userimport.NewRowsAddedEvent(ctx, agg, 0, []*userimport.Row{{Row: 1, Error: userImportInvalidRow}}),
+						),
+					),
+					expectPushFailed(
+						caos_errs.ThrowAlreadyExists(nil, "ID", "Errors.UserImport.BatchAlreadyProcessed"),
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID("instance1",
+								userimport.NewBatchProcessedEvent(ctx, agg, 0, []*userimport.RowResult{
+									{
+										Row:   1,
+										State: domain.UserImportRowStateFailed,
+										Error: userImportInvalidRow,
+									},
+								}),
+							),
+						},
+						uniqueConstraintsFromEventConstraintWithInstanceID("instance1", userimport.NewAddBatchUniqueConstraint("job1", 0)),
+					),
+				),
+			},
+			args: args{
+				ctx:           ctx,
+				jobID:         "job1",
+				resourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			err := r.ProcessUserImportBatch(tt.args.ctx, tt.args.jobID, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
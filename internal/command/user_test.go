@@ -1448,7 +1448,7 @@ func TestCommandSide_AddUserToken(t *testing.T) {
 				eventstore:  tt.fields.eventstore,
 				idGenerator: tt.fields.idGenerator,
 			}
-			got, err := r.AddUserToken(tt.args.ctx, tt.args.orgID, tt.args.agentID, tt.args.clientID, tt.args.userID, tt.args.audience, tt.args.scopes, tt.args.lifetime)
+			got, err := r.AddUserToken(tt.args.ctx, tt.args.orgID, tt.args.agentID, tt.args.clientID, tt.args.userID, tt.args.audience, tt.args.scopes, nil, domain.LevelOfAssuranceNone, tt.args.lifetime)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -1513,6 +1513,8 @@ func TestCommands_RevokeAccessToken(t *testing.T) {
 								"refreshTokenID",
 								[]string{"clientID"},
 								[]string{"openid"},
+								nil,
+								domain.LevelOfAssuranceNone,
 								time.Now(),
 							),
 						),
@@ -1545,6 +1547,8 @@ func TestCommands_RevokeAccessToken(t *testing.T) {
 								"refreshTokenID",
 								[]string{"clientID"},
 								[]string{"openid"},
+								nil,
+								domain.LevelOfAssuranceNone,
 								time.Now().Add(5*time.Hour),
 							),
 						),
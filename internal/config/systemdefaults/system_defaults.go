@@ -13,6 +13,7 @@ type SystemDefaults struct {
 	DomainVerification DomainVerification
 	Notifications      Notifications
 	KeyConfig          KeyConfig
+	SelfDeletion       SelfDeletion
 }
 
 type SecretGenerators struct {
@@ -44,3 +45,7 @@ type KeyConfig struct {
 	CertificateSize     int
 	CertificateLifetime time.Duration
 }
+
+type SelfDeletion struct {
+	GracePeriod time.Duration
+}
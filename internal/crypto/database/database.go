@@ -34,6 +34,23 @@ func NewKeyStorage(client *sql.DB, masterKey string) (*database, error) {
 	}, nil
 }
 
+// NewKMSKeyStorage stores the encryption keys encrypted by the provided [crypto.KMS] instead of a masterkey
+func NewKMSKeyStorage(client *sql.DB, kms crypto.KMS) (*database, error) {
+	if kms == nil {
+		return nil, caos_errs.ThrowInternal(nil, "CRYPT-a8Dw2", "kms must not be nil")
+	}
+	return &database{
+		client: client,
+		encrypt: func(key, _ string) (string, error) {
+			return kms.Encrypt([]byte(key))
+		},
+		decrypt: func(encryptedKey, _ string) (string, error) {
+			key, err := kms.Decrypt(encryptedKey)
+			return string(key), err
+		},
+	}, nil
+}
+
 func (d *database) ReadKeys() (crypto.Keys, error) {
 	keys := make(map[string]string)
 	stmt, args, err := sq.Select(encryptionKeysIDCol, encryptionKeysKeyCol).
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -443,6 +444,47 @@ func Test_checkMasterKeyLength(t *testing.T) {
 	}
 }
 
+func TestNewKMSKeyStorage(t *testing.T) {
+	client := dbMock(t,
+		expectBegin(nil),
+		expectExec("INSERT INTO system.encryption_keys (id,key) VALUES ($1,$2)", nil, "id1", "kms:key1"),
+		expectCommit(nil),
+		expectQuery(
+			"SELECT key FROM system.encryption_keys WHERE id = $1",
+			[]string{"key"},
+			[][]driver.Value{
+				{
+					"kms:key1",
+				},
+			},
+			"id1",
+		),
+	)
+	_, err := NewKMSKeyStorage(client.db, nil)
+	assert.Error(t, err)
+
+	d, err := NewKMSKeyStorage(client.db, &kmsMock{})
+	assert.NoError(t, err)
+	err = d.CreateKeys(&crypto.Key{ID: "id1", Value: "key1"})
+	assert.NoError(t, err)
+	got, err := d.ReadKey("id1")
+	assert.NoError(t, err)
+	assert.Equal(t, &crypto.Key{ID: "id1", Value: "key1"}, got)
+	if err := client.mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+type kmsMock struct{}
+
+func (k *kmsMock) Encrypt(plaintext []byte) (string, error) {
+	return "kms:" + string(plaintext), nil
+}
+
+func (k *kmsMock) Decrypt(ciphertext string) ([]byte, error) {
+	return []byte(strings.TrimPrefix(ciphertext, "kms:")), nil
+}
+
 type db struct {
 	mock sqlmock.Sqlmock
 	db   *sql.DB
@@ -5,3 +5,18 @@ type KeyStorage interface {
 	ReadKey(id string) (*Key, error)
 	CreateKeys(...*Key) error
 }
+
+// EncryptionAlgorithmStorage is implemented by key storages, which keep the encryption keys themselves
+// (e.g. in an HSM) instead of providing them to the [AESCrypto]
+type EncryptionAlgorithmStorage interface {
+	EncryptionAlgorithm(config *KeyConfig) (EncryptionAlgorithm, error)
+}
+
+// NewEncryptionAlgorithm returns the encryption algorithm of the key storage if it provides one,
+// otherwise the [AESCrypto] with the keys of the key storage
+func NewEncryptionAlgorithm(config *KeyConfig, keyStorage KeyStorage) (EncryptionAlgorithm, error) {
+	if storage, ok := keyStorage.(EncryptionAlgorithmStorage); ok {
+		return storage.EncryptionAlgorithm(config)
+	}
+	return NewAESCrypto(config, keyStorage)
+}
@@ -0,0 +1,11 @@
+package crypto
+
+// KMS encrypts and decrypts key material with a key encryption key,
+// which never leaves the external key management system.
+// The encryption keys of the [KeyStorage] are protected by the KMS instead of the masterkey (envelope encryption).
+type KMS interface {
+	// Encrypt returns the ciphertext of the provided key material
+	Encrypt(plaintext []byte) (string, error)
+	// Decrypt returns the key material of the provided ciphertext
+	Decrypt(ciphertext string) ([]byte, error)
+}
@@ -0,0 +1,124 @@
+//go:build cgo
+
+package pkcs11
+
+import (
+	"crypto/cipher"
+	"sync"
+
+	"github.com/ThalesIgnite/crypto11"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+var _ crypto.KMS = (*HSM)(nil)
+
+// HSM encrypts with AES-GCM keys and signs with key pairs stored on a token of the HSM
+type HSM struct {
+	context  *crypto11.Context
+	keyLabel string
+	// mutex prevents that concurrent calls generate the same key twice
+	mutex sync.Mutex
+}
+
+func New(config *Config) (*HSM, error) {
+	if config == nil || config.Module == "" || config.TokenLabel == "" {
+		return nil, errors.ThrowInvalidArgument(nil, "PKCS11-m2Xsk", "module and token label of pkcs11 must be set")
+	}
+	context, err := crypto11.Configure(&crypto11.Config{
+		Path:        config.Module,
+		TokenLabel:  config.TokenLabel,
+		Pin:         config.Pin,
+		GCMIVLength: gcmNonceSize,
+	})
+	if err != nil {
+		return nil, errors.ThrowUnavailable(err, "PKCS11-Tn4fa", "unable to connect to hsm")
+	}
+	keyLabel := config.KeyLabel
+	if keyLabel == "" {
+		keyLabel = defaultKeyLabel
+	}
+	return &HSM{
+		context:  context,
+		keyLabel: keyLabel,
+	}, nil
+}
+
+// Encrypt implements [crypto.KMS]
+func (h *HSM) Encrypt(plaintext []byte) (string, error) {
+	key, err := h.aead(h.keyLabel, true)
+	if err != nil {
+		return "", err
+	}
+	sealed, err := seal(key, plaintext)
+	if err != nil {
+		return "", err
+	}
+	return encodeSealed(sealed), nil
+}
+
+// Decrypt implements [crypto.KMS]
+func (h *HSM) Decrypt(ciphertext string) ([]byte, error) {
+	key, err := h.aead(h.keyLabel, false)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, errors.ThrowNotFoundf(nil, "PKCS11-Ld9wq", "key %s not found", h.keyLabel)
+	}
+	sealed, err := decodeSealed(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	return open(key, sealed)
+}
+
+func (h *HSM) Close() error {
+	return h.context.Close()
+}
+
+// aead returns the AES-GCM cipher of the key with the label.
+// If the key doesn't exist, it's generated if create is set, otherwise nil is returned.
+func (h *HSM) aead(label string, create bool) (cipher.AEAD, error) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	key, err := h.context.FindKey(nil, []byte(label))
+	if err != nil {
+		return nil, errors.ThrowInternalf(err, "PKCS11-Rj3nc", "unable to find key %s", label)
+	}
+	if key == nil && !create {
+		return nil, nil
+	}
+	if key == nil {
+		key, err = h.context.GenerateSecretKeyWithLabel([]byte(label), []byte(label), aesKeyBits, crypto11.CipherAES)
+		if err != nil {
+			return nil, errors.ThrowInternalf(err, "PKCS11-Wf8xb", "unable to generate key %s", label)
+		}
+	}
+	aead, err := key.NewGCM()
+	if err != nil {
+		return nil, errors.ThrowInternalf(err, "PKCS11-Hy2cq", "key %s does not support aes-gcm", label)
+	}
+	return aead, nil
+}
+
+// signingKey returns the key pair with the label, the signature algorithm is derived from its public key
+func (h *HSM) signingKey(label string) (*SigningKey, error) {
+	signer, err := h.context.FindKeyPair(nil, []byte(label))
+	if err != nil {
+		return nil, errors.ThrowInternalf(err, "PKCS11-Pz6vm", "unable to find key pair %s", label)
+	}
+	if signer == nil {
+		return nil, errors.ThrowNotFoundf(nil, "PKCS11-Bn3ws", "key pair %s not found", label)
+	}
+	algorithms := crypto.VerificationAlgorithms(signer.Public())
+	if len(algorithms) == 0 {
+		return nil, errors.ThrowInvalidArgumentf(nil, "PKCS11-Qc7re", "type of key pair %s not supported", label)
+	}
+	return &SigningKey{
+		id:        label,
+		algorithm: algorithms[0],
+		signer:    signer,
+	}, nil
+}
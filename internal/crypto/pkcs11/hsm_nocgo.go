@@ -0,0 +1,36 @@
+//go:build !cgo
+
+package pkcs11
+
+import (
+	"crypto/cipher"
+
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+// HSM is not available in binaries built without cgo
+type HSM struct{}
+
+func New(*Config) (*HSM, error) {
+	return nil, errors.ThrowUnimplemented(nil, "PKCS11-o3Nfs", "pkcs11 requires a binary built with cgo")
+}
+
+func (h *HSM) Encrypt([]byte) (string, error) {
+	return "", errors.ThrowUnimplemented(nil, "PKCS11-x8Vnq", "pkcs11 requires a binary built with cgo")
+}
+
+func (h *HSM) Decrypt(string) ([]byte, error) {
+	return nil, errors.ThrowUnimplemented(nil, "PKCS11-Ew3mb", "pkcs11 requires a binary built with cgo")
+}
+
+func (h *HSM) Close() error {
+	return nil
+}
+
+func (h *HSM) aead(string, bool) (cipher.AEAD, error) {
+	return nil, errors.ThrowUnimplemented(nil, "PKCS11-Ju5pa", "pkcs11 requires a binary built with cgo")
+}
+
+func (h *HSM) signingKey(string) (*SigningKey, error) {
+	return nil, errors.ThrowUnimplemented(nil, "PKCS11-Kd4zq", "pkcs11 requires a binary built with cgo")
+}
@@ -0,0 +1,153 @@
+//go:build cgo
+
+package pkcs11
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/sha256"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/cryptosigner"
+
+	zcrypto "github.com/zitadel/zitadel/internal/crypto"
+)
+
+// testHSM connects to the token of a SoftHSM (or any other HSM), e.g.:
+//
+//	softhsm2-util --init-token --free --label zitadel --pin 1234 --so-pin 1234
+//	ZITADEL_TEST_PKCS11_MODULE=/usr/lib/softhsm/libsofthsm2.so ZITADEL_TEST_PKCS11_TOKEN_LABEL=zitadel ZITADEL_TEST_PKCS11_PIN=1234 go test ./internal/crypto/pkcs11/
+//
+// The signing tests require an ECDSA key pair labeled zitadel-signing:
+//
+//	pkcs11-tool --module /usr/lib/softhsm/libsofthsm2.so --token-label zitadel --pin 1234 --keypairgen --key-type EC:prime256v1 --label zitadel-signing --id 01
+func testHSM(t *testing.T) *HSM {
+	module := os.Getenv("ZITADEL_TEST_PKCS11_MODULE")
+	if module == "" {
+		t.Skip("ZITADEL_TEST_PKCS11_MODULE not set")
+	}
+	hsm, err := New(&Config{
+		Module:     module,
+		TokenLabel: os.Getenv("ZITADEL_TEST_PKCS11_TOKEN_LABEL"),
+		Pin:        os.Getenv("ZITADEL_TEST_PKCS11_PIN"),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = hsm.Close() })
+	return hsm
+}
+
+// keyStorageMock stores the keys in memory, encrypted by the kms
+type keyStorageMock struct {
+	kms  zcrypto.KMS
+	keys map[string]string
+}
+
+func (s *keyStorageMock) ReadKeys() (zcrypto.Keys, error) {
+	keys := make(zcrypto.Keys, len(s.keys))
+	for id := range s.keys {
+		key, err := s.ReadKey(id)
+		if err != nil {
+			return nil, err
+		}
+		keys[id] = key.Value
+	}
+	return keys, nil
+}
+
+func (s *keyStorageMock) ReadKey(id string) (*zcrypto.Key, error) {
+	value, err := s.kms.Decrypt(s.keys[id])
+	if err != nil {
+		return nil, err
+	}
+	return &zcrypto.Key{ID: id, Value: string(value)}, nil
+}
+
+func (s *keyStorageMock) CreateKeys(keys ...*zcrypto.Key) error {
+	for _, key := range keys {
+		encrypted, err := s.kms.Encrypt([]byte(key.Value))
+		if err != nil {
+			return err
+		}
+		s.keys[key.ID] = encrypted
+	}
+	return nil
+}
+
+func TestHSM_KMS(t *testing.T) {
+	hsm := testHSM(t)
+
+	ciphertext, err := hsm.Encrypt([]byte("key material"))
+	require.NoError(t, err)
+	assert.NotContains(t, ciphertext, "key material")
+	plaintext, err := hsm.Decrypt(ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("key material"), plaintext)
+
+	_, err = hsm.Decrypt(ciphertext[:len(ciphertext)-4] + "AAAA")
+	assert.Error(t, err)
+}
+
+func TestKeyStorage_EncryptionAlgorithm(t *testing.T) {
+	hsm := testHSM(t)
+	keyStorage := hsm.KeyStorage(&keyStorageMock{kms: hsm, keys: make(map[string]string)})
+	databaseKey := "databasekeywhichneedstobe32byte!"
+	require.NoError(t, keyStorage.CreateKeys(&zcrypto.Key{ID: "test-encryption", Value: databaseKey}))
+	databaseEncrypted, err := zcrypto.EncryptAES([]byte("database"), databaseKey)
+	require.NoError(t, err)
+
+	alg, err := keyStorage.EncryptionAlgorithm(&zcrypto.KeyConfig{EncryptionKeyID: "test-encryption"})
+	require.NoError(t, err)
+	assert.Equal(t, keyIDPrefix+"test-encryption", alg.EncryptionKeyID())
+	assert.ElementsMatch(t, []string{keyIDPrefix + "test-encryption", "test-encryption"}, alg.DecryptionKeyIDs())
+
+	encrypted, err := zcrypto.Encrypt([]byte("hsm"), alg)
+	require.NoError(t, err)
+	decrypted, err := zcrypto.DecryptString(encrypted, alg)
+	require.NoError(t, err)
+	assert.Equal(t, "hsm", decrypted)
+
+	// values encrypted with the key of the database are still decrypted
+	decrypted, err = alg.DecryptString(databaseEncrypted, "test-encryption")
+	require.NoError(t, err)
+	assert.Equal(t, "database", decrypted)
+
+	// the generated key is found again
+	alg, err = keyStorage.EncryptionAlgorithm(&zcrypto.KeyConfig{EncryptionKeyID: "test-encryption"})
+	require.NoError(t, err)
+	decrypted, err = zcrypto.DecryptString(encrypted, alg)
+	require.NoError(t, err)
+	assert.Equal(t, "hsm", decrypted)
+}
+
+func TestKeyStorage_SigningKey(t *testing.T) {
+	hsm := testHSM(t)
+	keyStorage := hsm.KeyStorage(&keyStorageMock{kms: hsm, keys: make(map[string]string)})
+
+	_, err := keyStorage.SigningKey("unknown")
+	assert.Error(t, err)
+
+	key, err := keyStorage.SigningKey("zitadel-signing")
+	require.NoError(t, err)
+	assert.Equal(t, "zitadel-signing", key.ID())
+	assert.Equal(t, zcrypto.SigningAlgorithmES256, key.Algorithm())
+
+	digest := sha256.Sum256([]byte("payload"))
+	signature, err := key.Signer().Sign(rand.Reader, digest[:], crypto.SHA256)
+	require.NoError(t, err)
+	assert.NotEmpty(t, signature)
+
+	signer, err := jose.NewSigner(jose.SigningKey{
+		Algorithm: jose.SignatureAlgorithm(key.Algorithm()),
+		Key:       cryptosigner.Opaque(key.Signer()),
+	}, nil)
+	require.NoError(t, err)
+	signed, err := signer.Sign([]byte("payload"))
+	require.NoError(t, err)
+	payload, err := signed.Verify(key.PublicKey())
+	require.NoError(t, err)
+	assert.Equal(t, []byte("payload"), payload)
+}
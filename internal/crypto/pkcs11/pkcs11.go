@@ -0,0 +1,215 @@
+// Package pkcs11 keeps the encryption and token signing keys in a hardware security module (HSM),
+// which is accessed through its PKCS#11 library (e.g. SoftHSM).
+//
+// The PKCS#11 library is loaded with cgo, a binary built without cgo returns an error on [New].
+package pkcs11
+
+import (
+	stdcrypto "crypto"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+const (
+	defaultKeyLabel = "zitadel"
+
+	// keyIDPrefix marks the key ids of values encrypted by the HSM,
+	// so they are distinguishable from values encrypted with a key stored in the database
+	keyIDPrefix  = "pkcs11:"
+	aesKeyBits   = 256
+	gcmNonceSize = 12
+)
+
+type Config struct {
+	// Module is the path of the PKCS#11 library of the HSM, e.g. /usr/lib/softhsm/libsofthsm2.so
+	Module string
+	// TokenLabel of the token the keys are stored on
+	TokenLabel string
+	// Pin of the user of the token
+	Pin string
+	// KeyLabel of the AES key, which encrypts the key material stored in the database (e.g. the cookie keys),
+	// defaults to zitadel
+	KeyLabel string
+	// SigningKeyLabel of the key pair, which signs the OIDC tokens.
+	// If empty, the signing keys are generated and stored in the database.
+	SigningKeyLabel string
+}
+
+var _ crypto.KeyStorage = (*KeyStorage)(nil)
+
+// KeyStorage stores the key material in the wrapped key storage (encrypted by the HSM)
+// and provides the encryption algorithms and signing keys, whose keys never leave the HSM.
+type KeyStorage struct {
+	crypto.KeyStorage
+	hsm *HSM
+}
+
+// KeyStorage wraps the key storage, which must encrypt the key material with the HSM as [crypto.KMS]
+func (h *HSM) KeyStorage(keyStorage crypto.KeyStorage) *KeyStorage {
+	return &KeyStorage{
+		KeyStorage: keyStorage,
+		hsm:        h,
+	}
+}
+
+// EncryptionAlgorithm encrypts with the AES key of the HSM labeled with the encryption key id of the config,
+// which is generated if it doesn't exist yet.
+// Values encrypted with the keys of the database are still decrypted, so they can be re-encrypted.
+func (s *KeyStorage) EncryptionAlgorithm(config *crypto.KeyConfig) (crypto.EncryptionAlgorithm, error) {
+	return newEncryptionAlgorithm(s.hsm, s.KeyStorage, config)
+}
+
+// SigningKey returns the key pair of the HSM with the label
+func (s *KeyStorage) SigningKey(label string) (*SigningKey, error) {
+	return s.hsm.signingKey(label)
+}
+
+// SigningKey is a key pair of the HSM, only the public key can be read
+type SigningKey struct {
+	id        string
+	algorithm string
+	signer    stdcrypto.Signer
+}
+
+// ID is the label of the key pair
+func (k *SigningKey) ID() string {
+	return k.id
+}
+
+// Algorithm is the JWS algorithm derived from the type of the key
+func (k *SigningKey) Algorithm() string {
+	return k.algorithm
+}
+
+func (k *SigningKey) Signer() stdcrypto.Signer {
+	return k.signer
+}
+
+func (k *SigningKey) PublicKey() stdcrypto.PublicKey {
+	return k.signer.Public()
+}
+
+var _ crypto.EncryptionAlgorithm = (*encryptionAlgorithm)(nil)
+
+type encryptionAlgorithm struct {
+	encryptionKeyID string
+	hsmKeys         map[string]cipher.AEAD
+	keys            crypto.Keys
+	keyIDs          []string
+}
+
+func newEncryptionAlgorithm(hsm *HSM, keyStorage crypto.KeyStorage, config *crypto.KeyConfig) (*encryptionAlgorithm, error) {
+	if config == nil || config.EncryptionKeyID == "" {
+		return nil, errors.ThrowInvalidArgument(nil, "PKCS11-Vb3nq", "encryption key id must be set")
+	}
+	encryptionKey, err := hsm.aead(config.EncryptionKeyID, true)
+	if err != nil {
+		return nil, err
+	}
+	readKeys, err := keyStorage.ReadKeys()
+	if err != nil {
+		return nil, err
+	}
+	a := &encryptionAlgorithm{
+		encryptionKeyID: keyIDPrefix + config.EncryptionKeyID,
+		hsmKeys:         map[string]cipher.AEAD{keyIDPrefix + config.EncryptionKeyID: encryptionKey},
+		keys:            make(crypto.Keys),
+		keyIDs:          []string{keyIDPrefix + config.EncryptionKeyID},
+	}
+	for _, id := range append([]string{config.EncryptionKeyID}, config.DecryptionKeyIDs...) {
+		if id != config.EncryptionKeyID {
+			key, err := hsm.aead(id, false)
+			if err != nil {
+				return nil, err
+			}
+			if key != nil {
+				a.hsmKeys[keyIDPrefix+id] = key
+				a.keyIDs = append(a.keyIDs, keyIDPrefix+id)
+			}
+		}
+		if key, ok := readKeys[id]; ok {
+			a.keys[id] = key
+			a.keyIDs = append(a.keyIDs, id)
+		}
+	}
+	return a, nil
+}
+
+// Algorithm is the same as of the [crypto.AESCrypto], so values encrypted with the keys of the database can still be decrypted.
+// The key id defines if the key is stored in the HSM or the database.
+func (a *encryptionAlgorithm) Algorithm() string {
+	return "aes"
+}
+
+func (a *encryptionAlgorithm) Encrypt(value []byte) ([]byte, error) {
+	return seal(a.hsmKeys[a.encryptionKeyID], value)
+}
+
+func (a *encryptionAlgorithm) Decrypt(value []byte, keyID string) ([]byte, error) {
+	if key, ok := a.hsmKeys[keyID]; ok {
+		return open(key, value)
+	}
+	if key, ok := a.keys[keyID]; ok {
+		return crypto.DecryptAES(value, key)
+	}
+	return nil, errors.ThrowNotFound(nil, "PKCS11-Ks8vn", "unknown key id")
+}
+
+func (a *encryptionAlgorithm) DecryptString(value []byte, keyID string) (string, error) {
+	decrypted, err := a.Decrypt(value, keyID)
+	if err != nil {
+		return "", err
+	}
+	return string(decrypted), nil
+}
+
+func (a *encryptionAlgorithm) EncryptionKeyID() string {
+	return a.encryptionKeyID
+}
+
+func (a *encryptionAlgorithm) DecryptionKeyIDs() []string {
+	return a.keyIDs
+}
+
+// seal encrypts the value with a random nonce, which is prepended to the ciphertext
+func seal(key cipher.AEAD, value []byte) (sealed []byte, err error) {
+	nonce := make([]byte, key.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, errors.ThrowInternal(err, "PKCS11-n8Fhe", "unable to create nonce")
+	}
+	// the AEAD of the HSM panics if the encryption fails
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.ThrowInternalf(nil, "PKCS11-Pq4mx", "unable to encrypt: %v", r)
+		}
+	}()
+	return key.Seal(nonce, nonce, value, nil), nil
+}
+
+func open(key cipher.AEAD, sealed []byte) ([]byte, error) {
+	if len(sealed) < key.NonceSize() {
+		return nil, errors.ThrowInvalidArgument(nil, "PKCS11-Uw2kd", "ciphertext too short")
+	}
+	opened, err := key.Open(nil, sealed[:key.NonceSize()], sealed[key.NonceSize():], nil)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "PKCS11-Zb7xh", "unable to decrypt")
+	}
+	return opened, nil
+}
+
+func encodeSealed(sealed []byte) string {
+	return base64.StdEncoding.EncodeToString(sealed)
+}
+
+func decodeSealed(ciphertext string) ([]byte, error) {
+	sealed, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "PKCS11-h3Mvw", "invalid ciphertext")
+	}
+	return sealed, nil
+}
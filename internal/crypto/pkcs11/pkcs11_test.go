@@ -0,0 +1,63 @@
+package pkcs11
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+)
+
+// softwareAEAD replaces the AES-GCM key of the HSM
+func softwareAEAD(t *testing.T) cipher.AEAD {
+	block, err := aes.NewCipher([]byte("passphrasewhichneedstobe32bytes!"))
+	require.NoError(t, err)
+	aead, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+	return aead
+}
+
+func Test_encryptionAlgorithm(t *testing.T) {
+	databaseKey := "databasekeywhichneedstobe32byte!"
+	databaseEncrypted, err := crypto.EncryptAES([]byte("database"), databaseKey)
+	require.NoError(t, err)
+
+	alg := &encryptionAlgorithm{
+		encryptionKeyID: keyIDPrefix + "key",
+		hsmKeys:         map[string]cipher.AEAD{keyIDPrefix + "key": softwareAEAD(t)},
+		keys:            crypto.Keys{"key": databaseKey},
+		keyIDs:          []string{keyIDPrefix + "key", "key"},
+	}
+
+	encrypted, err := crypto.Encrypt([]byte("hsm"), alg)
+	require.NoError(t, err)
+	assert.Equal(t, keyIDPrefix+"key", encrypted.KeyID)
+	decrypted, err := crypto.DecryptString(encrypted, alg)
+	require.NoError(t, err)
+	assert.Equal(t, "hsm", decrypted)
+
+	decrypted, err = alg.DecryptString(databaseEncrypted, "key")
+	require.NoError(t, err)
+	assert.Equal(t, "database", decrypted)
+
+	_, err = alg.Decrypt(databaseEncrypted, "unknown")
+	assert.Error(t, err)
+}
+
+func Test_sealOpen(t *testing.T) {
+	key := softwareAEAD(t)
+	sealed, err := seal(key, []byte("value"))
+	require.NoError(t, err)
+	opened, err := open(key, sealed)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), opened)
+
+	sealed[len(sealed)-1] ^= 1
+	_, err = open(key, sealed)
+	assert.Error(t, err)
+	_, err = open(key, []byte("short"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,228 @@
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+// signature algorithms (as defined by JWA, RFC 7518 and RFC 8037) supported for token signing keys
+const (
+	SigningAlgorithmRS256 = "RS256"
+	SigningAlgorithmRS384 = "RS384"
+	SigningAlgorithmRS512 = "RS512"
+	SigningAlgorithmES256 = "ES256"
+	SigningAlgorithmES384 = "ES384"
+	SigningAlgorithmEdDSA = "EdDSA"
+)
+
+// signature algorithms (as defined by JWA, RFC 7518), which are only supported to verify signatures
+const (
+	SigningAlgorithmPS256 = "PS256"
+	SigningAlgorithmPS384 = "PS384"
+	SigningAlgorithmPS512 = "PS512"
+	SigningAlgorithmES512 = "ES512"
+)
+
+var signingAlgorithms = []string{
+	SigningAlgorithmRS256,
+	SigningAlgorithmRS384,
+	SigningAlgorithmRS512,
+	SigningAlgorithmES256,
+	SigningAlgorithmES384,
+	SigningAlgorithmEdDSA,
+}
+
+// SigningAlgorithms returns all algorithms supported for token signing keys
+func SigningAlgorithms() []string {
+	algorithms := make([]string, len(signingAlgorithms))
+	copy(algorithms, signingAlgorithms)
+	return algorithms
+}
+
+func IsSigningAlgorithmSupported(algorithm string) bool {
+	for _, supported := range signingAlgorithms {
+		if supported == algorithm {
+			return true
+		}
+	}
+	return false
+}
+
+// VerificationAlgorithms returns the signature algorithms, which can be verified with the public key
+func VerificationAlgorithms(publicKey interface{}) []string {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		return []string{
+			SigningAlgorithmRS256,
+			SigningAlgorithmRS384,
+			SigningAlgorithmRS512,
+			SigningAlgorithmPS256,
+			SigningAlgorithmPS384,
+			SigningAlgorithmPS512,
+		}
+	case *ecdsa.PublicKey:
+		switch key.Curve {
+		case elliptic.P256():
+			return []string{SigningAlgorithmES256}
+		case elliptic.P384():
+			return []string{SigningAlgorithmES384}
+		case elliptic.P521():
+			return []string{SigningAlgorithmES512}
+		}
+	case ed25519.PublicKey:
+		return []string{SigningAlgorithmEdDSA}
+	}
+	return nil
+}
+
+// GenerateSigningKeyPair generates a key pair matching the signature algorithm.
+// The bits are only used for RSA based algorithms.
+func GenerateSigningKeyPair(algorithm string, bits int) (privateKey, publicKey interface{}, err error) {
+	switch algorithm {
+	case SigningAlgorithmRS256, SigningAlgorithmRS384, SigningAlgorithmRS512:
+		return GenerateKeyPair(bits)
+	case SigningAlgorithmES256:
+		return generateECKeyPair(elliptic.P256())
+	case SigningAlgorithmES384:
+		return generateECKeyPair(elliptic.P384())
+	case SigningAlgorithmEdDSA:
+		public, private, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, nil, err
+		}
+		return private, public, nil
+	default:
+		return nil, nil, errors.ThrowInvalidArgumentf(nil, "CRYPT-Ksi3s", "signing algorithm %s is not supported", algorithm)
+	}
+}
+
+// SigningPublicKey returns the public key of the private key,
+// if the private key can be used to sign with the algorithm
+func SigningPublicKey(algorithm string, privateKey interface{}) (interface{}, error) {
+	switch key := privateKey.(type) {
+	case *rsa.PrivateKey:
+		if algorithm == SigningAlgorithmRS256 || algorithm == SigningAlgorithmRS384 || algorithm == SigningAlgorithmRS512 {
+			return &key.PublicKey, nil
+		}
+	case *ecdsa.PrivateKey:
+		if algorithm == SigningAlgorithmES256 && key.Curve == elliptic.P256() ||
+			algorithm == SigningAlgorithmES384 && key.Curve == elliptic.P384() {
+			return &key.PublicKey, nil
+		}
+	case ed25519.PrivateKey:
+		if algorithm == SigningAlgorithmEdDSA {
+			return key.Public(), nil
+		}
+	}
+	return nil, errors.ThrowInvalidArgumentf(nil, "CRYPT-Kdi3l", "private key cannot be used for signing algorithm %s", algorithm)
+}
+
+func generateECKeyPair(curve elliptic.Curve) (*ecdsa.PrivateKey, *ecdsa.PublicKey, error) {
+	privateKey, err := ecdsa.GenerateKey(curve, rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	return privateKey, &privateKey.PublicKey, nil
+}
+
+func GenerateEncryptedSigningKeyPair(algorithm string, bits int, alg EncryptionAlgorithm) (*CryptoValue, *CryptoValue, error) {
+	privateKey, publicKey, err := GenerateSigningKeyPair(algorithm, bits)
+	if err != nil {
+		return nil, nil, err
+	}
+	return EncryptSigningKeys(privateKey, publicKey, alg)
+}
+
+func EncryptSigningKeys(privateKey, publicKey interface{}, alg EncryptionAlgorithm) (*CryptoValue, *CryptoValue, error) {
+	privateKeyBytes, err := SigningPrivateKeyToBytes(privateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	encryptedPrivateKey, err := Encrypt(privateKeyBytes, alg)
+	if err != nil {
+		return nil, nil, err
+	}
+	publicKeyBytes, err := SigningPublicKeyToBytes(publicKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	encryptedPublicKey, err := Encrypt(publicKeyBytes, alg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return encryptedPrivateKey, encryptedPublicKey, nil
+}
+
+// SigningPrivateKeyToBytes encodes the private key as PEM.
+// RSA keys are still PKCS #1 encoded to stay compatible with existing keys, all others are PKCS #8 encoded.
+func SigningPrivateKeyToBytes(privateKey interface{}) ([]byte, error) {
+	if rsaKey, ok := privateKey.(*rsa.PrivateKey); ok {
+		return PrivateKeyToBytes(rsaKey), nil
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "PRIVATE KEY",
+		Bytes: der,
+	}), nil
+}
+
+func SigningPublicKeyToBytes(publicKey interface{}) ([]byte, error) {
+	if rsaKey, ok := publicKey.(*rsa.PublicKey); ok {
+		return PublicKeyToBytes(rsaKey)
+	}
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "PUBLIC KEY",
+		Bytes: der,
+	}), nil
+}
+
+// BytesToSigningPrivateKey parses a PEM encoded RSA, ECDSA or Ed25519 private key
+func BytesToSigningPrivateKey(priv []byte) (interface{}, error) {
+	block, _ := pem.Decode(priv)
+	if block == nil {
+		return nil, ErrEmpty
+	}
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return BytesToPrivateKey(priv)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	default:
+		return x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+}
+
+// BytesToSigningPublicKey parses a PEM encoded RSA, ECDSA or Ed25519 public key
+func BytesToSigningPublicKey(pub []byte) (interface{}, error) {
+	if pub == nil {
+		return nil, ErrEmpty
+	}
+	block, _ := pem.Decode(pub)
+	if block == nil {
+		return nil, ErrEmpty
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	switch key.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey, ed25519.PublicKey:
+		return key, nil
+	default:
+		return nil, errors.ThrowInvalidArgument(nil, "CRYPT-Pk3ls", "public key type is not supported")
+	}
+}
@@ -0,0 +1,191 @@
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+func TestGenerateSigningKeyPair(t *testing.T) {
+	tests := []struct {
+		algorithm  string
+		assertKeys func(t *testing.T, privateKey, publicKey interface{})
+		wantErr    func(error) bool
+	}{
+		{
+			algorithm: SigningAlgorithmRS256,
+			assertKeys: func(t *testing.T, privateKey, publicKey interface{}) {
+				require.IsType(t, &rsa.PrivateKey{}, privateKey)
+				require.IsType(t, &rsa.PublicKey{}, publicKey)
+				assert.Equal(t, 2048, privateKey.(*rsa.PrivateKey).N.BitLen())
+			},
+		},
+		{
+			algorithm: SigningAlgorithmES256,
+			assertKeys: func(t *testing.T, privateKey, publicKey interface{}) {
+				require.IsType(t, &ecdsa.PrivateKey{}, privateKey)
+				require.IsType(t, &ecdsa.PublicKey{}, publicKey)
+				assert.Equal(t, elliptic.P256(), privateKey.(*ecdsa.PrivateKey).Curve)
+			},
+		},
+		{
+			algorithm: SigningAlgorithmES384,
+			assertKeys: func(t *testing.T, privateKey, publicKey interface{}) {
+				require.IsType(t, &ecdsa.PrivateKey{}, privateKey)
+				require.IsType(t, &ecdsa.PublicKey{}, publicKey)
+				assert.Equal(t, elliptic.P384(), privateKey.(*ecdsa.PrivateKey).Curve)
+			},
+		},
+		{
+			algorithm: SigningAlgorithmEdDSA,
+			assertKeys: func(t *testing.T, privateKey, publicKey interface{}) {
+				require.IsType(t, ed25519.PrivateKey{}, privateKey)
+				require.IsType(t, ed25519.PublicKey{}, publicKey)
+			},
+		},
+		{
+			algorithm: "HS256",
+			wantErr:   errors.IsErrorInvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.algorithm, func(t *testing.T) {
+			privateKey, publicKey, err := GenerateSigningKeyPair(tt.algorithm, 2048)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err))
+				return
+			}
+			require.NoError(t, err)
+			tt.assertKeys(t, privateKey, publicKey)
+		})
+	}
+}
+
+func TestSigningKeyBytes(t *testing.T) {
+	for _, algorithm := range SigningAlgorithms() {
+		t.Run(algorithm, func(t *testing.T) {
+			privateKey, publicKey, err := GenerateSigningKeyPair(algorithm, 2048)
+			require.NoError(t, err)
+
+			privateKeyBytes, err := SigningPrivateKeyToBytes(privateKey)
+			require.NoError(t, err)
+			parsedPrivateKey, err := BytesToSigningPrivateKey(privateKeyBytes)
+			require.NoError(t, err)
+			assert.Equal(t, privateKey, parsedPrivateKey)
+
+			publicKeyBytes, err := SigningPublicKeyToBytes(publicKey)
+			require.NoError(t, err)
+			parsedPublicKey, err := BytesToSigningPublicKey(publicKeyBytes)
+			require.NoError(t, err)
+			assert.Equal(t, publicKey, parsedPublicKey)
+		})
+	}
+}
+
+func TestSigningPublicKey(t *testing.T) {
+	ecPrivateKey, ecPublicKey, err := GenerateSigningKeyPair(SigningAlgorithmES256, 0)
+	require.NoError(t, err)
+	rsaPrivateKey, rsaPublicKey, err := GenerateSigningKeyPair(SigningAlgorithmRS256, 2048)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		algorithm  string
+		privateKey interface{}
+		want       interface{}
+		wantErr    bool
+	}{
+		{
+			name:       "ec key",
+			algorithm:  SigningAlgorithmES256,
+			privateKey: ecPrivateKey,
+			want:       ecPublicKey,
+		},
+		{
+			name:       "ec key, wrong curve",
+			algorithm:  SigningAlgorithmES384,
+			privateKey: ecPrivateKey,
+			wantErr:    true,
+		},
+		{
+			name:       "rsa key",
+			algorithm:  SigningAlgorithmRS512,
+			privateKey: rsaPrivateKey,
+			want:       rsaPublicKey,
+		},
+		{
+			name:       "rsa key, ec algorithm",
+			algorithm:  SigningAlgorithmES256,
+			privateKey: rsaPrivateKey,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SigningPublicKey(tt.algorithm, tt.privateKey)
+			if tt.wantErr {
+				assert.True(t, errors.IsErrorInvalidArgument(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBytesToSigningPrivateKey_RSACompatibility(t *testing.T) {
+	privateKey, _, err := GenerateKeyPair(2048)
+	require.NoError(t, err)
+
+	parsed, err := BytesToSigningPrivateKey(PrivateKeyToBytes(privateKey))
+	require.NoError(t, err)
+	assert.Equal(t, privateKey, parsed)
+}
+
+func TestVerificationAlgorithms(t *testing.T) {
+	_, rsaPublicKey, err := GenerateSigningKeyPair(SigningAlgorithmRS256, 2048)
+	require.NoError(t, err)
+	_, ecPublicKey, err := GenerateSigningKeyPair(SigningAlgorithmES384, 0)
+	require.NoError(t, err)
+	_, edPublicKey, err := GenerateSigningKeyPair(SigningAlgorithmEdDSA, 0)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		publicKey interface{}
+		want      []string
+	}{
+		{
+			name:      "rsa key",
+			publicKey: rsaPublicKey,
+			want:      []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512"},
+		},
+		{
+			name:      "ec key",
+			publicKey: ecPublicKey,
+			want:      []string{"ES384"},
+		},
+		{
+			name:      "ed25519 key",
+			publicKey: edPublicKey,
+			want:      []string{"EdDSA"},
+		},
+		{
+			name:      "unsupported key",
+			publicKey: "key",
+			want:      nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, VerificationAlgorithms(tt.publicKey))
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package vault
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+const (
+	defaultMountPath = "transit"
+	defaultTimeout   = 10 * time.Second
+
+	headerToken     = "X-Vault-Token"
+	headerNamespace = "X-Vault-Namespace"
+)
+
+type Config struct {
+	// Address of the Vault server, e.g. https://vault.example.com:8200
+	Address string
+	// Token used to authenticate against Vault, it needs the encrypt and decrypt capabilities on the transit key
+	Token string
+	// Namespace is only required for Vault Enterprise namespaces
+	Namespace string
+	// MountPath of the transit secrets engine, defaults to transit
+	MountPath string
+	// KeyName of the transit key used to encrypt the encryption keys
+	KeyName string
+	// Timeout of a single request, defaults to 10s
+	Timeout time.Duration
+}
+
+var _ crypto.KMS = (*Transit)(nil)
+
+// Transit encrypts key material with a key of the Vault Transit secrets engine (or any compatible API)
+type Transit struct {
+	client    *http.Client
+	endpoint  string
+	token     string
+	namespace string
+}
+
+func NewTransit(config *Config) (*Transit, error) {
+	if config == nil || config.Address == "" || config.KeyName == "" {
+		return nil, errors.ThrowInvalidArgument(nil, "VAULT-2nGs8", "address and key name of vault must be set")
+	}
+	if config.Token == "" {
+		return nil, errors.ThrowInvalidArgument(nil, "VAULT-Ue7b3", "token of vault must be set")
+	}
+	mountPath := strings.Trim(config.MountPath, "/")
+	if mountPath == "" {
+		mountPath = defaultMountPath
+	}
+	timeout := config.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+	return &Transit{
+		client:    &http.Client{Timeout: timeout},
+		endpoint:  strings.TrimSuffix(config.Address, "/") + "/v1/" + mountPath + "/%s/" + url.PathEscape(config.KeyName),
+		token:     config.Token,
+		namespace: config.Namespace,
+	}, nil
+}
+
+type transitRequest struct {
+	Plaintext  string `json:"plaintext,omitempty"`
+	Ciphertext string `json:"ciphertext,omitempty"`
+}
+
+type transitResponse struct {
+	Data struct {
+		Plaintext  string `json:"plaintext"`
+		Ciphertext string `json:"ciphertext"`
+	} `json:"data"`
+	Errors []string `json:"errors"`
+}
+
+func (t *Transit) Encrypt(plaintext []byte) (string, error) {
+	resp, err := t.call("encrypt", &transitRequest{Plaintext: base64.StdEncoding.EncodeToString(plaintext)})
+	if err != nil {
+		return "", err
+	}
+	if resp.Data.Ciphertext == "" {
+		return "", errors.ThrowInternal(nil, "VAULT-Wq3mf", "vault returned no ciphertext")
+	}
+	return resp.Data.Ciphertext, nil
+}
+
+func (t *Transit) Decrypt(ciphertext string) ([]byte, error) {
+	resp, err := t.call("decrypt", &transitRequest{Ciphertext: ciphertext})
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := base64.StdEncoding.DecodeString(resp.Data.Plaintext)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "VAULT-Hk2xo", "vault returned invalid plaintext")
+	}
+	return plaintext, nil
+}
+
+func (t *Transit) call(operation string, body *transitRequest) (*transitResponse, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "VAULT-Pz8v1", "unable to marshal request")
+	}
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf(t.endpoint, operation), bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "VAULT-c9Lwe", "unable to create request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(headerToken, t.token)
+	if t.namespace != "" {
+		req.Header.Set(headerNamespace, t.namespace)
+	}
+	res, err := t.client.Do(req)
+	if err != nil {
+		return nil, errors.ThrowUnavailable(err, "VAULT-0fJq2", "unable to reach vault")
+	}
+	defer res.Body.Close()
+	resp := new(transitResponse)
+	if err = json.NewDecoder(res.Body).Decode(resp); err != nil && res.StatusCode == http.StatusOK {
+		return nil, errors.ThrowInternal(err, "VAULT-Rm4dS", "unable to unmarshal response")
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.ThrowInternalf(nil, "VAULT-x7Bn1", "vault %s failed with status %d: %s", operation, res.StatusCode, strings.Join(resp.Errors, ", "))
+	}
+	return resp, nil
+}
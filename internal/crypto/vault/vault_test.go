@@ -0,0 +1,114 @@
+package vault
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+// transitMock reverses the base64 plaintext as "encryption"
+func transitMock(t *testing.T, token string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(headerToken) != token {
+			w.WriteHeader(http.StatusForbidden)
+			_ = json.NewEncoder(w).Encode(map[string][]string{"errors": {"permission denied"}})
+			return
+		}
+		req := new(transitRequest)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+		resp := new(transitResponse)
+		switch r.URL.Path {
+		case "/v1/transit/encrypt/zitadel":
+			resp.Data.Ciphertext = "vault:v1:" + reverse(req.Plaintext)
+		case "/v1/transit/decrypt/zitadel":
+			resp.Data.Plaintext = reverse(strings.TrimPrefix(req.Ciphertext, "vault:v1:"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+}
+
+func reverse(s string) string {
+	r := []rune(s)
+	for i, j := 0, len(r)-1; i < j; i, j = i+1, j-1 {
+		r[i], r[j] = r[j], r[i]
+	}
+	return string(r)
+}
+
+func TestNewTransit(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *Config
+		wantErr func(error) bool
+	}{
+		{
+			name:    "missing config",
+			wantErr: errors.IsErrorInvalidArgument,
+		},
+		{
+			name:    "missing key name",
+			config:  &Config{Address: "http://localhost:8200", Token: "token"},
+			wantErr: errors.IsErrorInvalidArgument,
+		},
+		{
+			name:    "missing token",
+			config:  &Config{Address: "http://localhost:8200", KeyName: "zitadel"},
+			wantErr: errors.IsErrorInvalidArgument,
+		},
+		{
+			name:   "ok",
+			config: &Config{Address: "http://localhost:8200/", Token: "token", KeyName: "zitadel"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewTransit(tt.config)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "http://localhost:8200/v1/transit/%s/zitadel", got.endpoint)
+		})
+	}
+}
+
+func TestTransit_EncryptDecrypt(t *testing.T) {
+	server := transitMock(t, "token")
+	defer server.Close()
+
+	transit, err := NewTransit(&Config{Address: server.URL, Token: "token", KeyName: "zitadel"})
+	require.NoError(t, err)
+
+	ciphertext, err := transit.Encrypt([]byte("key"))
+	require.NoError(t, err)
+	assert.Equal(t, "vault:v1:"+reverse(base64.StdEncoding.EncodeToString([]byte("key"))), ciphertext)
+
+	plaintext, err := transit.Decrypt(ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("key"), plaintext)
+}
+
+func TestTransit_PermissionDenied(t *testing.T) {
+	server := transitMock(t, "token")
+	defer server.Close()
+
+	transit, err := NewTransit(&Config{Address: server.URL, Token: "wrong", KeyName: "zitadel"})
+	require.NoError(t, err)
+
+	_, err = transit.Encrypt([]byte("key"))
+	require.Error(t, err)
+	assert.True(t, errors.IsInternal(err))
+	assert.Contains(t, err.Error(), "permission denied")
+}
@@ -46,6 +46,14 @@ type OIDCApp struct {
 	ClockSkew                time.Duration
 	AdditionalOrigins        []string
 	SkipNativeAppSuccessPage bool
+	// IDTokenSigningAlgorithm overwrites the signing algorithm of the instance for id tokens
+	IDTokenSigningAlgorithm string
+	// ConsentRequired shows the consent page to the user for the requested scopes (e.g. for third-party applications)
+	ConsentRequired bool
+	// BackchannelTokenDeliveryMode defines how the client is informed about the result of a backchannel authentication request (CIBA)
+	BackchannelTokenDeliveryMode OIDCBackchannelTokenDeliveryMode
+	// BackchannelClientNotificationEndpoint is called in ping mode, as soon as the backchannel authentication request was resolved
+	BackchannelClientNotificationEndpoint string
 
 	State AppState
 }
@@ -91,6 +99,14 @@ const (
 	OIDCGrantTypeImplicit
 	OIDCGrantTypeRefreshToken
 	OIDCGrantTypeDeviceCode
+	OIDCGrantTypeCIBA
+)
+
+type OIDCBackchannelTokenDeliveryMode int32
+
+const (
+	OIDCBackchannelTokenDeliveryModePoll OIDCBackchannelTokenDeliveryMode = iota
+	OIDCBackchannelTokenDeliveryModePing
 )
 
 type OIDCApplicationType int32
@@ -126,6 +142,12 @@ func (a *OIDCApp) IsValid() bool {
 	if a.ClockSkew > time.Second*5 || a.ClockSkew < time.Second*0 || !a.OriginsValid() {
 		return false
 	}
+	if a.IDTokenSigningAlgorithm != "" && !crypto.IsSigningAlgorithmSupported(a.IDTokenSigningAlgorithm) {
+		return false
+	}
+	if !BackchannelNotificationEndpointValid(a.BackchannelTokenDeliveryMode, a.BackchannelClientNotificationEndpoint, a.DevMode) {
+		return false
+	}
 	grantTypes := a.getRequiredGrantTypes()
 	if len(grantTypes) == 0 {
 		return false
@@ -148,6 +170,19 @@ func (a *OIDCApp) OriginsValid() bool {
 	return true
 }
 
+// BackchannelNotificationEndpointValid checks that an endpoint is set, if the client wants to be pinged
+// about the result of backchannel authentication requests.
+// Except for apps in dev mode, the endpoint must use https.
+func BackchannelNotificationEndpointValid(deliveryMode OIDCBackchannelTokenDeliveryMode, endpoint string, devMode bool) bool {
+	if deliveryMode != OIDCBackchannelTokenDeliveryModePing {
+		return true
+	}
+	if devMode {
+		return strings.HasPrefix(endpoint, https) || strings.HasPrefix(endpoint, http)
+	}
+	return strings.HasPrefix(endpoint, https)
+}
+
 func ContainsRequiredGrantTypes(responseTypes []OIDCResponseType, grantTypes []OIDCGrantType) bool {
 	required := RequiredOIDCGrantTypes(responseTypes)
 	return ContainsOIDCGrantTypes(required, grantTypes)
@@ -177,6 +177,66 @@ func TestApplicationValid(t *testing.T) {
 			},
 			result: false,
 		},
+		{
+			name: "invalid oidc application: ping mode without notification endpoint",
+			args: args{
+				app: &OIDCApp{
+					ObjectRoot:                   models.ObjectRoot{AggregateID: "AggregateID"},
+					AppID:                        "AppID",
+					AppName:                      "Name",
+					ResponseTypes:                []OIDCResponseType{OIDCResponseTypeCode},
+					GrantTypes:                   []OIDCGrantType{OIDCGrantTypeAuthorizationCode, OIDCGrantTypeCIBA},
+					BackchannelTokenDeliveryMode: OIDCBackchannelTokenDeliveryModePing,
+				},
+			},
+			result: false,
+		},
+		{
+			name: "invalid oidc application: ping mode with http notification endpoint",
+			args: args{
+				app: &OIDCApp{
+					ObjectRoot:                            models.ObjectRoot{AggregateID: "AggregateID"},
+					AppID:                                 "AppID",
+					AppName:                               "Name",
+					ResponseTypes:                         []OIDCResponseType{OIDCResponseTypeCode},
+					GrantTypes:                            []OIDCGrantType{OIDCGrantTypeAuthorizationCode, OIDCGrantTypeCIBA},
+					BackchannelTokenDeliveryMode:          OIDCBackchannelTokenDeliveryModePing,
+					BackchannelClientNotificationEndpoint: "http://client.com/ciba",
+				},
+			},
+			result: false,
+		},
+		{
+			name: "valid oidc application: ping mode with http notification endpoint in dev mode",
+			args: args{
+				app: &OIDCApp{
+					ObjectRoot:                            models.ObjectRoot{AggregateID: "AggregateID"},
+					AppID:                                 "AppID",
+					AppName:                               "Name",
+					ResponseTypes:                         []OIDCResponseType{OIDCResponseTypeCode},
+					GrantTypes:                            []OIDCGrantType{OIDCGrantTypeAuthorizationCode, OIDCGrantTypeCIBA},
+					DevMode:                               true,
+					BackchannelTokenDeliveryMode:          OIDCBackchannelTokenDeliveryModePing,
+					BackchannelClientNotificationEndpoint: "http://localhost:8080/ciba",
+				},
+			},
+			result: true,
+		},
+		{
+			name: "valid oidc application: ping mode with https notification endpoint",
+			args: args{
+				app: &OIDCApp{
+					ObjectRoot:                            models.ObjectRoot{AggregateID: "AggregateID"},
+					AppID:                                 "AppID",
+					AppName:                               "Name",
+					ResponseTypes:                         []OIDCResponseType{OIDCResponseTypeCode},
+					GrantTypes:                            []OIDCGrantType{OIDCGrantTypeAuthorizationCode, OIDCGrantTypeCIBA},
+					BackchannelTokenDeliveryMode:          OIDCBackchannelTokenDeliveryModePing,
+					BackchannelClientNotificationEndpoint: "https://client.com/ciba",
+				},
+			},
+			result: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -26,7 +26,6 @@ type AuthRequest struct {
 	InstanceID    string
 	Request       Request
 
-	levelOfAssurance         LevelOfAssurance
 	UserID                   string
 	UserName                 string
 	LoginName                string
@@ -45,6 +44,8 @@ type AuthRequest struct {
 	PossibleSteps            []NextStep `json:"-"`
 	PasswordVerified         bool
 	MFAsVerified             []MFAType
+	ConsentGiven             bool
+	ConsentDenied            bool
 	Audience                 []string
 	AuthTime                 time.Time
 	Code                     string
@@ -93,12 +94,60 @@ func IsPrompt(prompt []Prompt, requestedPrompt Prompt) bool {
 	return false
 }
 
+// LevelOfAssurance describes the strength of an authentication.
+// The levels are ordered, so a higher level always satisfies a lower one.
 type LevelOfAssurance int
 
 const (
 	LevelOfAssuranceNone LevelOfAssurance = iota
+	// LevelOfAssurancePassword is reached by a single factor (e.g. password)
+	LevelOfAssurancePassword
+	// LevelOfAssuranceMFA is reached by multiple factors (e.g. password and otp)
+	LevelOfAssuranceMFA
+	// LevelOfAssurancePhishingResistant is reached by multiple factors,
+	// where at least one is bound to the origin (e.g. passkey or password and u2f)
+	LevelOfAssurancePhishingResistant
 )
 
+// LevelOfAssuranceFromAuthMethods returns the level reached by the provided auth methods.
+func LevelOfAssuranceFromAuthMethods(methods []UserAuthMethodType) LevelOfAssurance {
+	var factors int
+	var phishingResistant bool
+	for _, method := range methods {
+		switch method {
+		case UserAuthMethodTypePasswordless:
+			factors += 2
+			phishingResistant = true
+		case UserAuthMethodTypeU2F:
+			factors++
+			phishingResistant = true
+		case UserAuthMethodTypePassword,
+			UserAuthMethodTypeTOTP,
+			UserAuthMethodTypeOTPSMS,
+			UserAuthMethodTypeOTPEmail,
+			UserAuthMethodTypeIDP:
+			factors++
+		case UserAuthMethodTypeUnspecified,
+			userAuthMethodTypeCount:
+			// ignore
+		}
+	}
+	return levelOfAssuranceFromFactors(factors, phishingResistant)
+}
+
+func levelOfAssuranceFromFactors(factors int, phishingResistant bool) LevelOfAssurance {
+	switch {
+	case factors > 1 && phishingResistant:
+		return LevelOfAssurancePhishingResistant
+	case factors > 1:
+		return LevelOfAssuranceMFA
+	case factors == 1:
+		return LevelOfAssurancePassword
+	default:
+		return LevelOfAssuranceNone
+	}
+}
+
 type MFAType int
 
 const (
@@ -107,6 +156,22 @@ const (
 	MFATypeU2FUserVerification
 )
 
+// IsPhishingResistant returns true if the factor is bound to the origin (webauthn)
+func (m MFAType) IsPhishingResistant() bool {
+	return m == MFATypeU2F || m == MFATypeU2FUserVerification
+}
+
+// PhishingResistantMFATypes returns only the phishing resistant types of the provided list
+func PhishingResistantMFATypes(types []MFAType) []MFAType {
+	filtered := make([]MFAType, 0, len(types))
+	for _, mfaType := range types {
+		if mfaType.IsPhishingResistant() {
+			filtered = append(filtered, mfaType)
+		}
+	}
+	return filtered
+}
+
 type MFALevel int
 
 const (
@@ -160,9 +225,41 @@ func (a *AuthRequest) SetOrgInformation(id, name, primaryDomain string, requeste
 	a.RequestedOrgDomain = requestedByDomain
 }
 
+// MFALevel returns the mfa level required by the requested level of assurance.
+// If no multiple factors are requested, -1 is returned and the login policy decides.
 func (a *AuthRequest) MFALevel() MFALevel {
+	if a.RequestedLevelOfAssurance() >= LevelOfAssuranceMFA {
+		return MFALevelSecondFactor
+	}
 	return -1
-	//PLANNED: check a.PossibleLOAs (and Prompt Login?)
+}
+
+// RequestedLevelOfAssurance returns the level of the most preferred acr value of the request
+func (a *AuthRequest) RequestedLevelOfAssurance() LevelOfAssurance {
+	if len(a.PossibleLOAs) == 0 {
+		return LevelOfAssuranceNone
+	}
+	return a.PossibleLOAs[0]
+}
+
+// LevelOfAssurance returns the level reached by the factors verified during the request
+func (a *AuthRequest) LevelOfAssurance() LevelOfAssurance {
+	var factors int
+	var phishingResistant bool
+	if a.PasswordVerified {
+		factors++
+	}
+	for _, mfa := range a.MFAsVerified {
+		switch mfa {
+		case MFATypeU2FUserVerification:
+			factors += 2
+		case MFATypeTOTP,
+			MFATypeU2F:
+			factors++
+		}
+		phishingResistant = phishingResistant || mfa.IsPhishingResistant()
+	}
+	return levelOfAssuranceFromFactors(factors, phishingResistant)
 }
 
 func (a *AuthRequest) AppendAudIfNotExisting(aud string) {
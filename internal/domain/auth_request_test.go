@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLevelOfAssuranceFromAuthMethods(t *testing.T) {
+	tests := []struct {
+		name    string
+		methods []UserAuthMethodType
+		want    LevelOfAssurance
+	}{
+		{
+			"no methods",
+			nil,
+			LevelOfAssuranceNone,
+		},
+		{
+			"password",
+			[]UserAuthMethodType{UserAuthMethodTypePassword},
+			LevelOfAssurancePassword,
+		},
+		{
+			"password and otp",
+			[]UserAuthMethodType{UserAuthMethodTypePassword, UserAuthMethodTypeTOTP},
+			LevelOfAssuranceMFA,
+		},
+		{
+			"idp and otp email",
+			[]UserAuthMethodType{UserAuthMethodTypeIDP, UserAuthMethodTypeOTPEmail},
+			LevelOfAssuranceMFA,
+		},
+		{
+			"u2f only",
+			[]UserAuthMethodType{UserAuthMethodTypeU2F},
+			LevelOfAssurancePassword,
+		},
+		{
+			"password and u2f",
+			[]UserAuthMethodType{UserAuthMethodTypePassword, UserAuthMethodTypeU2F},
+			LevelOfAssurancePhishingResistant,
+		},
+		{
+			"passkey",
+			[]UserAuthMethodType{UserAuthMethodTypePasswordless},
+			LevelOfAssurancePhishingResistant,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, LevelOfAssuranceFromAuthMethods(tt.methods))
+		})
+	}
+}
+
+func TestAuthRequest_LevelOfAssurance(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *AuthRequest
+		want    LevelOfAssurance
+	}{
+		{
+			"nothing verified",
+			&AuthRequest{},
+			LevelOfAssuranceNone,
+		},
+		{
+			"password verified",
+			&AuthRequest{PasswordVerified: true},
+			LevelOfAssurancePassword,
+		},
+		{
+			"password and otp verified",
+			&AuthRequest{PasswordVerified: true, MFAsVerified: []MFAType{MFATypeTOTP}},
+			LevelOfAssuranceMFA,
+		},
+		{
+			"password and u2f verified",
+			&AuthRequest{PasswordVerified: true, MFAsVerified: []MFAType{MFATypeU2F}},
+			LevelOfAssurancePhishingResistant,
+		},
+		{
+			"passwordless verified",
+			&AuthRequest{MFAsVerified: []MFAType{MFATypeU2FUserVerification}},
+			LevelOfAssurancePhishingResistant,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.request.LevelOfAssurance())
+		})
+	}
+}
+
+func TestAuthRequest_MFALevel(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *AuthRequest
+		want    MFALevel
+	}{
+		{
+			"nothing requested, decided by policy",
+			&AuthRequest{},
+			-1,
+		},
+		{
+			"password requested, decided by policy",
+			&AuthRequest{PossibleLOAs: []LevelOfAssurance{LevelOfAssurancePassword}},
+			-1,
+		},
+		{
+			"mfa requested",
+			&AuthRequest{PossibleLOAs: []LevelOfAssurance{LevelOfAssuranceMFA, LevelOfAssurancePassword}},
+			MFALevelSecondFactor,
+		},
+		{
+			"phishing resistant requested",
+			&AuthRequest{PossibleLOAs: []LevelOfAssurance{LevelOfAssurancePhishingResistant}},
+			MFALevelSecondFactor,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.request.MFALevel())
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	// AuthorizationDetailsParameter is the parameter of the authorization and token request
+	// as well as the claim of the access token and introspection response defined by RFC 9396
+	AuthorizationDetailsParameter = "authorization_details"
+	// AuthorizationDetailsErrorType is returned to the client if the authorization details are invalid
+	AuthorizationDetailsErrorType = "invalid_authorization_details"
+)
+
+// AuthorizationDetail is a single object of the authorization details (RFC 9396).
+// Only the type field is defined by the specification, all other fields depend on the type.
+type AuthorizationDetail map[string]any
+
+func (d AuthorizationDetail) Type() string {
+	t, _ := d["type"].(string)
+	return t
+}
+
+type AuthorizationDetails []AuthorizationDetail
+
+// ParseAuthorizationDetails parses the JSON array of the authorization_details parameter
+func ParseAuthorizationDetails(raw string) (AuthorizationDetails, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	var details AuthorizationDetails
+	if err := json.Unmarshal([]byte(raw), &details); err != nil {
+		return nil, fmt.Errorf("authorization_details must be a JSON array of objects: %w", err)
+	}
+	for i, detail := range details {
+		if detail.Type() == "" {
+			return nil, fmt.Errorf("authorization_details[%d] has no type", i)
+		}
+	}
+	return details, nil
+}
+
+// Validate checks that all details are of a type registered on the project
+// and match the schema of the type, if the type defines one
+func (d AuthorizationDetails) Validate(types map[string]*JSONSchema) error {
+	for i, detail := range d {
+		schema, ok := types[detail.Type()]
+		if !ok {
+			return fmt.Errorf("authorization_details[%d] type %q is not supported", i, detail.Type())
+		}
+		if schema == nil {
+			continue
+		}
+		if err := schema.Validate(map[string]any(detail)); err != nil {
+			return fmt.Errorf("authorization_details[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
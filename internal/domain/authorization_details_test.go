@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAuthorizationDetails(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    AuthorizationDetails
+		wantErr bool
+	}{
+		{
+			name: "empty, nil",
+			raw:  "",
+			want: nil,
+		},
+		{
+			name:    "no array, error",
+			raw:     `{"type": "payment_initiation"}`,
+			wantErr: true,
+		},
+		{
+			name:    "type missing, error",
+			raw:     `[{"actions": ["initiate"]}]`,
+			wantErr: true,
+		},
+		{
+			name: "details, ok",
+			raw:  `[{"type": "payment_initiation", "actions": ["initiate"]}]`,
+			want: AuthorizationDetails{
+				{"type": "payment_initiation", "actions": []any{"initiate"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAuthorizationDetails(tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseAuthorizationDetails() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAuthorizationDetails_Validate(t *testing.T) {
+	schema, err := ParseJSONSchema([]byte(`{"required": ["locations"], "properties": {"locations": {"type": "array", "items": {"type": "string"}}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	types := map[string]*JSONSchema{
+		"payment_initiation":  nil,
+		"account_information": schema,
+	}
+	tests := []struct {
+		name    string
+		details AuthorizationDetails
+		wantErr bool
+	}{
+		{
+			name:    "unknown type, error",
+			details: AuthorizationDetails{{"type": "customer_information"}},
+			wantErr: true,
+		},
+		{
+			name:    "schema mismatch, error",
+			details: AuthorizationDetails{{"type": "account_information"}},
+			wantErr: true,
+		},
+		{
+			name:    "type without schema, ok",
+			details: AuthorizationDetails{{"type": "payment_initiation", "amount": 10.0}},
+		},
+		{
+			name: "type with schema, ok",
+			details: AuthorizationDetails{
+				{"type": "payment_initiation"},
+				{"type": "account_information", "locations": []any{"https://example.com/accounts"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.details.Validate(types)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -16,6 +16,13 @@ const (
 	DomainClaimedMessageType            = "DomainClaimed"
 	PasswordlessRegistrationMessageType = "PasswordlessRegistration"
 	PasswordChangeMessageType           = "PasswordChange"
+	DeviceTrustedMessageType            = "DeviceTrusted"
+	UserDeactivationMessageType         = "UserDeactivation"
+	UserRemovalMessageType              = "UserRemoval"
+	UserGrantRequestedMessageType       = "UserGrantRequested"
+	UserGrantApprovedMessageType        = "UserGrantApproved"
+	UserGrantDeniedMessageType          = "UserGrantDenied"
+	BackchannelAuthRequestedMessageType = "BackchannelAuthRequested"
 	MessageTitle                        = "Title"
 	MessagePreHeader                    = "PreHeader"
 	MessageSubject                      = "Subject"
@@ -33,6 +40,13 @@ type MessageTexts struct {
 	DomainClaimed            CustomMessageText
 	PasswordlessRegistration CustomMessageText
 	PasswordChange           CustomMessageText
+	DeviceTrusted            CustomMessageText
+	UserDeactivation         CustomMessageText
+	UserRemoval              CustomMessageText
+	UserGrantRequested       CustomMessageText
+	UserGrantApproved        CustomMessageText
+	UserGrantDenied          CustomMessageText
+	BackchannelAuthRequested CustomMessageText
 }
 
 type CustomMessageText struct {
@@ -64,5 +78,12 @@ func IsMessageTextType(textType string) bool {
 		textType == VerifyEmailOTPMessageType ||
 		textType == DomainClaimedMessageType ||
 		textType == PasswordlessRegistrationMessageType ||
-		textType == PasswordChangeMessageType
+		textType == PasswordChangeMessageType ||
+		textType == DeviceTrustedMessageType ||
+		textType == UserDeactivationMessageType ||
+		textType == UserRemovalMessageType ||
+		textType == UserGrantRequestedMessageType ||
+		textType == UserGrantApprovedMessageType ||
+		textType == UserGrantDeniedMessageType ||
+		textType == BackchannelAuthRequestedMessageType
 }
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+)
+
+// customRoleRegexp requires the prefix of the member type the role is assignable to,
+// e.g. ORG_HELPDESK can be assigned to organization members
+var customRoleRegexp = regexp.MustCompile(`^(IAM|ORG|PROJECT|PROJECT_GRANT)_[A-Z0-9_]+$`)
+
+type CustomRoleState int32
+
+const (
+	CustomRoleStateUnspecified CustomRoleState = iota
+	CustomRoleStateActive
+	CustomRoleStateRemoved
+)
+
+// CustomRole is a member role of an instance with a configurable set of permissions
+type CustomRole struct {
+	Role        string
+	DisplayName string
+	Permissions []string
+}
+
+func (r *CustomRole) IsValid() bool {
+	return customRoleRegexp.MatchString(r.Role) && len(r.Permissions) > 0
+}
+
+// RolePrefix returns the prefix of the member type the role is assignable to
+func RolePrefix(role string) string {
+	for _, prefix := range []string{ProjectGrantRolePrefix, ProjectRolePrefix, OrgRolePrefix, IAMRolePrefix} {
+		if strings.HasPrefix(role, prefix+"_") {
+			return prefix
+		}
+	}
+	return ""
+}
+
+// CheckForInvalidPermissions returns the permissions which are not granted by any of the roles with the prefix,
+// so that a custom role never grants more than the roles of its member type
+func CheckForInvalidPermissions(permissions []string, rolePrefix string, roleMappings []authz.RoleMapping) []string {
+	invalidPermissions := make([]string, 0)
+	for _, permission := range permissions {
+		if !containsPermission(permission, rolePrefix, roleMappings) {
+			invalidPermissions = append(invalidPermissions, permission)
+		}
+	}
+	return invalidPermissions
+}
+
+func containsPermission(permission, rolePrefix string, roleMappings []authz.RoleMapping) bool {
+	for _, roleMapping := range roleMappings {
+		if RolePrefix(roleMapping.Role) != rolePrefix {
+			continue
+		}
+		for _, p := range roleMapping.Permissions {
+			if p == permission {
+				return true
+			}
+		}
+	}
+	return false
+}
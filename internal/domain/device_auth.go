@@ -19,6 +19,27 @@ type DeviceAuth struct {
 	Scopes     []string
 	Subject    string
 	State      DeviceAuthState
+
+	// UserID and BindingMessage are only set for backchannel authentication requests (CIBA)
+	UserID         string
+	BindingMessage string
+}
+
+// BackchannelAuth describes the additional information of a Client Initiated Backchannel Authentication (CIBA) request.
+// Instead of the user entering a user code on a device, the client requests the authentication of a known user,
+// which is informed through a notification. Its state is tracked as a DeviceAuth.
+type BackchannelAuth struct {
+	UserID                  string
+	BindingMessage          string
+	DeliveryMode            OIDCBackchannelTokenDeliveryMode
+	NotificationEndpoint    string
+	ClientNotificationToken string
+}
+
+// IsPing returns true when the client needs to be notified
+// as soon as the request has been approved or denied.
+func (b *BackchannelAuth) IsPing() bool {
+	return b != nil && b.DeliveryMode == OIDCBackchannelTokenDeliveryModePing
 }
 
 // DeviceAuthState describes the step the
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+)
+
+// Group is a set of users of an organization,
+// which can hold project roles and memberships on behalf of its users
+type Group struct {
+	models.ObjectRoot
+
+	Name        string
+	Description string
+	State       GroupState
+}
+
+func (g *Group) IsValid() bool {
+	return g.Name != ""
+}
+
+type GroupState int32
+
+const (
+	GroupStateUnspecified GroupState = iota
+	GroupStateActive
+	GroupStateRemoved
+	groupStateCount
+)
+
+func (s GroupState) Valid() bool {
+	return s >= 0 && s < groupStateCount
+}
+
+func (s GroupState) Exists() bool {
+	return s != GroupStateUnspecified && s != GroupStateRemoved
+}
+
+// GroupGrant grants roles of a project (grant) to all users of a group
+type GroupGrant struct {
+	GroupID   string
+	ProjectID string
+	GrantID   string
+	RoleKeys  []string
+}
+
+func (g *GroupGrant) IsValid() bool {
+	return g.GroupID != "" && g.ProjectID != "" && len(g.RoleKeys) > 0
+}
+
+func (g *GroupGrant) HasInvalidRoles(validRoles []string) bool {
+	for _, roleKey := range g.RoleKeys {
+		if !containsRoleKey(roleKey, validRoles) {
+			return true
+		}
+	}
+	return false
+}
+
+// GroupMembership grants administrative roles on the organization
+// or, if the ProjectID is set, on the project to all users of a group
+type GroupMembership struct {
+	GroupID   string
+	ProjectID string
+	Roles     []string
+}
+
+func (m *GroupMembership) IsValid() bool {
+	return m.GroupID != "" && len(m.Roles) > 0
+}
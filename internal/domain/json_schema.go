@@ -0,0 +1,339 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"unicode/utf8"
+)
+
+// JSONSchema is a parsed JSON schema supporting the subset of keywords (draft 2020-12) needed
+// to describe the structure of authorization details:
+// type, enum, const, properties, required, additionalProperties, items,
+// minimum, maximum, exclusiveMinimum, exclusiveMaximum, minLength, maxLength, pattern, minItems and maxItems.
+// Annotations (e.g. $schema, title or description) are ignored, other keywords (e.g. allOf or $ref) are rejected,
+// so a schema never silently accepts values it was meant to restrict.
+type JSONSchema struct {
+	schema map[string]any
+}
+
+var jsonSchemaTypes = map[string]struct{}{
+	"object":  {},
+	"array":   {},
+	"string":  {},
+	"number":  {},
+	"integer": {},
+	"boolean": {},
+	"null":    {},
+}
+
+var jsonSchemaKeywords = map[string]struct{}{
+	"type":                 {},
+	"enum":                 {},
+	"const":                {},
+	"properties":           {},
+	"required":             {},
+	"additionalProperties": {},
+	"items":                {},
+	"minimum":              {},
+	"maximum":              {},
+	"exclusiveMinimum":     {},
+	"exclusiveMaximum":     {},
+	"minLength":            {},
+	"maxLength":            {},
+	"pattern":              {},
+	"minItems":             {},
+	"maxItems":             {},
+	// annotations
+	"$schema":     {},
+	"$id":         {},
+	"$comment":    {},
+	"title":       {},
+	"description": {},
+	"default":     {},
+	"examples":    {},
+	"deprecated":  {},
+	"readOnly":    {},
+	"writeOnly":   {},
+	"format":      {},
+}
+
+// ParseJSONSchema parses the schema and checks that the supported keywords are used correctly
+func ParseJSONSchema(schema []byte) (*JSONSchema, error) {
+	var parsed map[string]any
+	if err := json.Unmarshal(schema, &parsed); err != nil {
+		return nil, fmt.Errorf("schema must be a JSON object: %w", err)
+	}
+	if err := checkJSONSchema(parsed, "#"); err != nil {
+		return nil, err
+	}
+	return &JSONSchema{schema: parsed}, nil
+}
+
+// Validate checks the value (as returned by json.Unmarshal into an interface) against the schema
+func (s *JSONSchema) Validate(value any) error {
+	return validateJSONSchema(s.schema, value, "")
+}
+
+func checkJSONSchema(schema map[string]any, path string) error {
+	for keyword := range schema {
+		if _, ok := jsonSchemaKeywords[keyword]; !ok {
+			return fmt.Errorf("%s/%s is not supported", path, keyword)
+		}
+	}
+	if t, ok := schema["type"]; ok {
+		types, ok := jsonSchemaTypeList(t)
+		if !ok {
+			return fmt.Errorf("%s/type must be a string or an array of strings", path)
+		}
+		for _, typ := range types {
+			if _, ok := jsonSchemaTypes[typ]; !ok {
+				return fmt.Errorf("%s/type %q is not supported", path, typ)
+			}
+		}
+	}
+	if enum, ok := schema["enum"]; ok {
+		if _, ok := enum.([]any); !ok {
+			return fmt.Errorf("%s/enum must be an array", path)
+		}
+	}
+	if properties, ok := schema["properties"]; ok {
+		props, ok := properties.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s/properties must be an object", path)
+		}
+		for name, property := range props {
+			propertySchema, ok := property.(map[string]any)
+			if !ok {
+				return fmt.Errorf("%s/properties/%s must be an object", path, name)
+			}
+			if err := checkJSONSchema(propertySchema, path+"/properties/"+name); err != nil {
+				return err
+			}
+		}
+	}
+	if required, ok := schema["required"]; ok {
+		if _, ok := jsonSchemaTypeList(required); !ok {
+			return fmt.Errorf("%s/required must be an array of strings", path)
+		}
+	}
+	if additional, ok := schema["additionalProperties"]; ok {
+		switch a := additional.(type) {
+		case bool:
+		case map[string]any:
+			if err := checkJSONSchema(a, path+"/additionalProperties"); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("%s/additionalProperties must be a boolean or an object", path)
+		}
+	}
+	if items, ok := schema["items"]; ok {
+		itemSchema, ok := items.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s/items must be an object", path)
+		}
+		if err := checkJSONSchema(itemSchema, path+"/items"); err != nil {
+			return err
+		}
+	}
+	for _, keyword := range []string{"minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum"} {
+		if v, ok := schema[keyword]; ok {
+			if _, ok := v.(float64); !ok {
+				return fmt.Errorf("%s/%s must be a number", path, keyword)
+			}
+		}
+	}
+	for _, keyword := range []string{"minLength", "maxLength", "minItems", "maxItems"} {
+		if v, ok := schema[keyword]; ok {
+			if n, ok := v.(float64); !ok || n < 0 || n != math.Trunc(n) {
+				return fmt.Errorf("%s/%s must be a non-negative integer", path, keyword)
+			}
+		}
+	}
+	if pattern, ok := schema["pattern"]; ok {
+		p, ok := pattern.(string)
+		if !ok {
+			return fmt.Errorf("%s/pattern must be a string", path)
+		}
+		if _, err := regexp.Compile(p); err != nil {
+			return fmt.Errorf("%s/pattern is not a valid regular expression: %w", path, err)
+		}
+	}
+	return nil
+}
+
+func validateJSONSchema(schema map[string]any, value any, path string) error {
+	if t, ok := schema["type"]; ok {
+		types, _ := jsonSchemaTypeList(t)
+		if !isJSONSchemaType(value, types) {
+			return fmt.Errorf("%s must be of type %v", jsonSchemaPath(path), types)
+		}
+	}
+	if c, ok := schema["const"]; ok && !reflect.DeepEqual(c, value) {
+		return fmt.Errorf("%s must be %v", jsonSchemaPath(path), c)
+	}
+	if enum, ok := schema["enum"].([]any); ok && !jsonSchemaEnumContains(enum, value) {
+		return fmt.Errorf("%s must be one of %v", jsonSchemaPath(path), enum)
+	}
+	switch v := value.(type) {
+	case map[string]any:
+		return validateJSONSchemaObject(schema, v, path)
+	case []any:
+		return validateJSONSchemaArray(schema, v, path)
+	case string:
+		return validateJSONSchemaString(schema, v, path)
+	case float64:
+		return validateJSONSchemaNumber(schema, v, path)
+	}
+	return nil
+}
+
+func validateJSONSchemaObject(schema map[string]any, object map[string]any, path string) error {
+	required, _ := jsonSchemaTypeList(schema["required"])
+	for _, name := range required {
+		if _, ok := object[name]; !ok {
+			return fmt.Errorf("%s is required", jsonSchemaPath(path+"."+name))
+		}
+	}
+	properties, _ := schema["properties"].(map[string]any)
+	for name, value := range object {
+		if property, ok := properties[name].(map[string]any); ok {
+			if err := validateJSONSchema(property, value, path+"."+name); err != nil {
+				return err
+			}
+			continue
+		}
+		switch additional := schema["additionalProperties"].(type) {
+		case bool:
+			if !additional {
+				return fmt.Errorf("%s is not allowed", jsonSchemaPath(path+"."+name))
+			}
+		case map[string]any:
+			if err := validateJSONSchema(additional, value, path+"."+name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateJSONSchemaArray(schema map[string]any, array []any, path string) error {
+	if min, ok := schema["minItems"].(float64); ok && float64(len(array)) < min {
+		return fmt.Errorf("%s must contain at least %v items", jsonSchemaPath(path), min)
+	}
+	if max, ok := schema["maxItems"].(float64); ok && float64(len(array)) > max {
+		return fmt.Errorf("%s must contain at most %v items", jsonSchemaPath(path), max)
+	}
+	items, ok := schema["items"].(map[string]any)
+	if !ok {
+		return nil
+	}
+	for i, item := range array {
+		if err := validateJSONSchema(items, item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateJSONSchemaString(schema map[string]any, s string, path string) error {
+	length := float64(utf8.RuneCountInString(s))
+	if min, ok := schema["minLength"].(float64); ok && length < min {
+		return fmt.Errorf("%s must be at least %v characters long", jsonSchemaPath(path), min)
+	}
+	if max, ok := schema["maxLength"].(float64); ok && length > max {
+		return fmt.Errorf("%s must be at most %v characters long", jsonSchemaPath(path), max)
+	}
+	if pattern, ok := schema["pattern"].(string); ok {
+		matched, err := regexp.MatchString(pattern, s)
+		if err != nil || !matched {
+			return fmt.Errorf("%s must match %q", jsonSchemaPath(path), pattern)
+		}
+	}
+	return nil
+}
+
+func validateJSONSchemaNumber(schema map[string]any, n float64, path string) error {
+	if min, ok := schema["minimum"].(float64); ok && n < min {
+		return fmt.Errorf("%s must be greater than or equal to %v", jsonSchemaPath(path), min)
+	}
+	if max, ok := schema["maximum"].(float64); ok && n > max {
+		return fmt.Errorf("%s must be less than or equal to %v", jsonSchemaPath(path), max)
+	}
+	if min, ok := schema["exclusiveMinimum"].(float64); ok && n <= min {
+		return fmt.Errorf("%s must be greater than %v", jsonSchemaPath(path), min)
+	}
+	if max, ok := schema["exclusiveMaximum"].(float64); ok && n >= max {
+		return fmt.Errorf("%s must be less than %v", jsonSchemaPath(path), max)
+	}
+	return nil
+}
+
+func jsonSchemaTypeList(value any) ([]string, bool) {
+	switch v := value.(type) {
+	case string:
+		return []string{v}, true
+	case []any:
+		list := make([]string, len(v))
+		for i, entry := range v {
+			s, ok := entry.(string)
+			if !ok {
+				return nil, false
+			}
+			list[i] = s
+		}
+		return list, true
+	}
+	return nil, false
+}
+
+func isJSONSchemaType(value any, types []string) bool {
+	for _, typ := range types {
+		switch v := value.(type) {
+		case map[string]any:
+			if typ == "object" {
+				return true
+			}
+		case []any:
+			if typ == "array" {
+				return true
+			}
+		case string:
+			if typ == "string" {
+				return true
+			}
+		case float64:
+			if typ == "number" || typ == "integer" && v == math.Trunc(v) {
+				return true
+			}
+		case bool:
+			if typ == "boolean" {
+				return true
+			}
+		case nil:
+			if typ == "null" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func jsonSchemaEnumContains(enum []any, value any) bool {
+	for _, e := range enum {
+		if reflect.DeepEqual(e, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonSchemaPath(path string) string {
+	if path == "" {
+		return "value"
+	}
+	return path[1:]
+}
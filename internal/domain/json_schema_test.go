@@ -0,0 +1,169 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const paymentSchema = `{
+	"$schema": "https://json-schema.org/draft/2020-12/schema",
+	"type": "object",
+	"required": ["type", "instructedAmount"],
+	"properties": {
+		"type": {"const": "payment_initiation"},
+		"actions": {"type": "array", "items": {"enum": ["initiate", "status", "cancel"]}, "minItems": 1},
+		"instructedAmount": {
+			"type": "object",
+			"required": ["currency", "amount"],
+			"properties": {
+				"currency": {"type": "string", "pattern": "^[A-Z]{3}$"},
+				"amount": {"type": "number", "exclusiveMinimum": 0, "maximum": 10000}
+			},
+			"additionalProperties": false
+		},
+		"creditorAccount": {"type": "object", "properties": {"iban": {"type": "string", "minLength": 15, "maxLength": 34}}}
+	}
+}`
+
+func TestParseJSONSchema(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  string
+		wantErr bool
+	}{
+		{
+			name:    "no object, error",
+			schema:  `["type"]`,
+			wantErr: true,
+		},
+		{
+			name:    "unsupported type, error",
+			schema:  `{"type": "decimal"}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid property, error",
+			schema:  `{"properties": {"amount": "number"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid pattern, error",
+			schema:  `{"type": "string", "pattern": "["}`,
+			wantErr: true,
+		},
+		{
+			name:    "negative length, error",
+			schema:  `{"type": "string", "minLength": -1}`,
+			wantErr: true,
+		},
+		{
+			name:    "unsupported keyword, error",
+			schema:  `{"allOf": [{"type": "string"}, {"maxLength": 3}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "unsupported keyword in property, error",
+			schema:  `{"type": "object", "properties": {"account": {"$ref": "#/$defs/account"}}}`,
+			wantErr: true,
+		},
+		{
+			name:   "annotations, ok",
+			schema: `{"$schema": "https://json-schema.org/draft/2020-12/schema", "title": "payment", "description": "payment initiation", "type": "object"}`,
+		},
+		{
+			name:   "empty schema, ok",
+			schema: `{}`,
+		},
+		{
+			name:   "payment schema, ok",
+			schema: paymentSchema,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseJSONSchema([]byte(tt.schema))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseJSONSchema() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJSONSchema_Validate(t *testing.T) {
+	schema, err := ParseJSONSchema([]byte(paymentSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{
+			name:    "wrong type, error",
+			value:   `[]`,
+			wantErr: true,
+		},
+		{
+			name:    "required property missing, error",
+			value:   `{"type": "payment_initiation"}`,
+			wantErr: true,
+		},
+		{
+			name:    "wrong const, error",
+			value:   `{"type": "account_information", "instructedAmount": {"currency": "EUR", "amount": 1}}`,
+			wantErr: true,
+		},
+		{
+			name:    "amount too high, error",
+			value:   `{"type": "payment_initiation", "instructedAmount": {"currency": "EUR", "amount": 10000.5}}`,
+			wantErr: true,
+		},
+		{
+			name:    "amount zero, error",
+			value:   `{"type": "payment_initiation", "instructedAmount": {"currency": "EUR", "amount": 0}}`,
+			wantErr: true,
+		},
+		{
+			name:    "currency pattern mismatch, error",
+			value:   `{"type": "payment_initiation", "instructedAmount": {"currency": "euro", "amount": 1}}`,
+			wantErr: true,
+		},
+		{
+			name:    "additional property not allowed, error",
+			value:   `{"type": "payment_initiation", "instructedAmount": {"currency": "EUR", "amount": 1, "fee": 2}}`,
+			wantErr: true,
+		},
+		{
+			name:    "action not in enum, error",
+			value:   `{"type": "payment_initiation", "actions": ["refund"], "instructedAmount": {"currency": "EUR", "amount": 1}}`,
+			wantErr: true,
+		},
+		{
+			name:    "too few items, error",
+			value:   `{"type": "payment_initiation", "actions": [], "instructedAmount": {"currency": "EUR", "amount": 1}}`,
+			wantErr: true,
+		},
+		{
+			name:    "iban too short, error",
+			value:   `{"type": "payment_initiation", "instructedAmount": {"currency": "EUR", "amount": 1}, "creditorAccount": {"iban": "DE02"}}`,
+			wantErr: true,
+		},
+		{
+			name:  "valid, ok",
+			value: `{"type": "payment_initiation", "actions": ["initiate", "status"], "instructedAmount": {"currency": "EUR", "amount": 123.5}, "creditorAccount": {"iban": "DE02100100109307118603"}, "remittanceInformation": "Ref Number Merchant"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var value any
+			if err := json.Unmarshal([]byte(tt.value), &value); err != nil {
+				t.Fatal(err)
+			}
+			err := schema.Validate(value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -40,6 +40,22 @@ func (u KeyUsage) String() string {
 	return ""
 }
 
+type KeyPairState int32
+
+const (
+	KeyPairStateUnspecified KeyPairState = iota
+	// KeyPairStateActive keys are published and used for signing
+	KeyPairStateActive
+	// KeyPairStatePublished keys are published, but not yet used for signing
+	KeyPairStatePublished
+	// KeyPairStateInactive keys are neither published nor used for signing
+	KeyPairStateInactive
+)
+
+func (s KeyPairState) Exists() bool {
+	return s != KeyPairStateUnspecified
+}
+
 type Key struct {
 	Key    *crypto.CryptoValue
 	Expiry time.Time
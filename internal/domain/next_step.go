@@ -29,6 +29,7 @@ const (
 	NextStepProjectRequired
 	NextStepRedirectToExternalIDP
 	NextStepLoginSucceeded
+	NextStepConsent
 )
 
 type LoginStep struct{}
@@ -187,3 +188,14 @@ type LoginSucceededStep struct{}
 func (s *LoginSucceededStep) Type() NextStepType {
 	return NextStepLoginSucceeded
 }
+
+// ConsentStep asks the user to consent to the scopes and authorization details requested by the client
+type ConsentStep struct {
+	ProjectID            string
+	Scopes               []string
+	AuthorizationDetails AuthorizationDetails
+}
+
+func (s *ConsentStep) Type() NextStepType {
+	return NextStepConsent
+}
@@ -16,6 +16,8 @@ type OIDCSettings struct {
 	IdTokenLifetime            time.Duration
 	RefreshTokenIdleExpiration time.Duration
 	RefreshTokenExpiration     time.Duration
+	// SigningAlgorithm of the keys used to sign tokens, empty for the system default
+	SigningAlgorithm string
 }
 
 type OIDCSettingsState int32
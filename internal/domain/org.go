@@ -14,6 +14,7 @@ type Org struct {
 
 	PrimaryDomain string
 	Domains       []*OrgDomain
+	ParentOrgID   string
 }
 
 func (o *Org) IsValid() bool {
@@ -28,6 +29,9 @@ func (o *Org) AddIAMDomain(iamDomain string) {
 	o.Domains = append(o.Domains, &OrgDomain{Domain: NewIAMDomainName(o.Name, iamDomain), Verified: true, Primary: true})
 }
 
+// OrgHierarchyMaxDepth is the maximum number of ancestors an organization can have
+const OrgHierarchyMaxDepth = 10
+
 type OrgState int32
 
 const (
@@ -31,4 +31,7 @@ const (
 	PermissionUserRead      = "user.read"
 	PermissionSessionWrite  = "session.write"
 	PermissionSessionDelete = "session.delete"
+
+	PermissionOrgMemberWrite     = "org.member.write"
+	PermissionProjectMemberWrite = "project.member.write"
 )
@@ -10,8 +10,9 @@ type PrivacyPolicy struct {
 	State   PolicyState
 	Default bool
 
-	TOSLink      string
-	PrivacyLink  string
-	HelpLink     string
-	SupportEmail EmailAddress
+	TOSLink           string
+	PrivacyLink       string
+	HelpLink          string
+	SupportEmail      EmailAddress
+	AllowSelfDeletion bool
 }
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+)
+
+type UserLifecyclePolicy struct {
+	models.ObjectRoot
+
+	Default bool
+	// InactivityDeactivation is the duration without successful login after which a user is deactivated, 0 disables the deactivation
+	InactivityDeactivation time.Duration
+	// DeactivatedRemoval is the duration after the deactivation after which a user is removed, 0 disables the removal
+	DeactivatedRemoval time.Duration
+	// NotificationPeriod is the duration before the deactivation or removal in which the user is notified, 0 disables the notifications
+	NotificationPeriod time.Duration
+}
+
+type UserLifecycleAction int32
+
+const (
+	UserLifecycleActionUnspecified UserLifecycleAction = iota
+	UserLifecycleActionNotifyDeactivation
+	UserLifecycleActionDeactivate
+	UserLifecycleActionNotifyRemoval
+	UserLifecycleActionRemove
+)
+
+// NextAction returns the action which is due at the given time and the date the deactivation or removal is (or was) due.
+// The deactivation is based on the last activity (login, creation or reactivation) of active users,
+// the removal on the deactivation date of inactive users.
+// Initial users are ignored, as they can't be deactivated.
+// A notification is only returned, if the user was not yet notified about the same action.
+func (p *UserLifecyclePolicy) NextAction(now time.Time, state UserState, lastActivity, deactivationDate time.Time, notified UserLifecycleAction) (UserLifecycleAction, time.Time) {
+	if p == nil {
+		return UserLifecycleActionUnspecified, time.Time{}
+	}
+	switch state {
+	case UserStateInactive:
+		if p.DeactivatedRemoval <= 0 || deactivationDate.IsZero() {
+			return UserLifecycleActionUnspecified, time.Time{}
+		}
+		return p.nextAction(now, deactivationDate.Add(p.DeactivatedRemoval), notified, UserLifecycleActionNotifyRemoval, UserLifecycleActionRemove)
+	case UserStateUnspecified, UserStateDeleted, UserStateInitial:
+		return UserLifecycleActionUnspecified, time.Time{}
+	default:
+		if p.InactivityDeactivation <= 0 || lastActivity.IsZero() {
+			return UserLifecycleActionUnspecified, time.Time{}
+		}
+		return p.nextAction(now, lastActivity.Add(p.InactivityDeactivation), notified, UserLifecycleActionNotifyDeactivation, UserLifecycleActionDeactivate)
+	}
+}
+
+func (p *UserLifecyclePolicy) nextAction(now, due time.Time, notified, notify, execute UserLifecycleAction) (UserLifecycleAction, time.Time) {
+	if !now.Before(due) {
+		return execute, due
+	}
+	if p.NotificationPeriod > 0 && notified != notify && !now.Before(due.Add(-p.NotificationPeriod)) {
+		return notify, due
+	}
+	return UserLifecycleActionUnspecified, due
+}
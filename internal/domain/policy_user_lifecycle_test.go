@@ -0,0 +1,174 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserLifecyclePolicy_NextAction(t *testing.T) {
+	now := time.Date(2023, 8, 1, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	policy := &UserLifecyclePolicy{
+		InactivityDeactivation: 90 * day,
+		DeactivatedRemoval:     30 * day,
+		NotificationPeriod:     7 * day,
+	}
+	type args struct {
+		policy           *UserLifecyclePolicy
+		state            UserState
+		lastActivity     time.Time
+		deactivationDate time.Time
+		notified         UserLifecycleAction
+	}
+	type want struct {
+		action UserLifecycleAction
+		due    time.Time
+	}
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "no policy, unspecified",
+			args: args{
+				state:        UserStateActive,
+				lastActivity: now.Add(-365 * day),
+			},
+			want: want{
+				action: UserLifecycleActionUnspecified,
+			},
+		},
+		{
+			name: "deactivation disabled, unspecified",
+			args: args{
+				policy:       &UserLifecyclePolicy{},
+				state:        UserStateActive,
+				lastActivity: now.Add(-365 * day),
+			},
+			want: want{
+				action: UserLifecycleActionUnspecified,
+			},
+		},
+		{
+			name: "recently active, unspecified",
+			args: args{
+				policy:       policy,
+				state:        UserStateActive,
+				lastActivity: now.Add(-day),
+			},
+			want: want{
+				action: UserLifecycleActionUnspecified,
+				due:    now.Add(89 * day),
+			},
+		},
+		{
+			name: "within notification period, notify deactivation",
+			args: args{
+				policy:       policy,
+				state:        UserStateActive,
+				lastActivity: now.Add(-85 * day),
+			},
+			want: want{
+				action: UserLifecycleActionNotifyDeactivation,
+				due:    now.Add(5 * day),
+			},
+		},
+		{
+			name: "within notification period already notified, unspecified",
+			args: args{
+				policy:       policy,
+				state:        UserStateActive,
+				lastActivity: now.Add(-85 * day),
+				notified:     UserLifecycleActionNotifyDeactivation,
+			},
+			want: want{
+				action: UserLifecycleActionUnspecified,
+				due:    now.Add(5 * day),
+			},
+		},
+		{
+			name: "inactive too long, deactivate",
+			args: args{
+				policy:       policy,
+				state:        UserStateActive,
+				lastActivity: now.Add(-90 * day),
+				notified:     UserLifecycleActionNotifyDeactivation,
+			},
+			want: want{
+				action: UserLifecycleActionDeactivate,
+				due:    now,
+			},
+		},
+		{
+			name: "initial user inactive too long, unspecified",
+			args: args{
+				policy:       policy,
+				state:        UserStateInitial,
+				lastActivity: now.Add(-100 * day),
+			},
+			want: want{
+				action: UserLifecycleActionUnspecified,
+			},
+		},
+		{
+			name: "deactivated within notification period, notify removal",
+			args: args{
+				policy:           policy,
+				state:            UserStateInactive,
+				lastActivity:     now.Add(-200 * day),
+				deactivationDate: now.Add(-25 * day),
+			},
+			want: want{
+				action: UserLifecycleActionNotifyRemoval,
+				due:    now.Add(5 * day),
+			},
+		},
+		{
+			name: "deactivated too long, remove",
+			args: args{
+				policy:           policy,
+				state:            UserStateInactive,
+				deactivationDate: now.Add(-31 * day),
+				notified:         UserLifecycleActionNotifyRemoval,
+			},
+			want: want{
+				action: UserLifecycleActionRemove,
+				due:    now.Add(-day),
+			},
+		},
+		{
+			name: "removal disabled, unspecified",
+			args: args{
+				policy: &UserLifecyclePolicy{
+					InactivityDeactivation: 90 * day,
+				},
+				state:            UserStateInactive,
+				deactivationDate: now.Add(-365 * day),
+			},
+			want: want{
+				action: UserLifecycleActionUnspecified,
+			},
+		},
+		{
+			name: "deleted user, unspecified",
+			args: args{
+				policy:       policy,
+				state:        UserStateDeleted,
+				lastActivity: now.Add(-365 * day),
+			},
+			want: want{
+				action: UserLifecycleActionUnspecified,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action, due := tt.args.policy.NextAction(now, tt.args.state, tt.args.lastActivity, tt.args.deactivationDate, tt.args.notified)
+			assert.Equal(t, tt.want.action, action)
+			assert.Equal(t, tt.want.due, due)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+)
+
+// ProjectAuthorizationDetailType registers a type of authorization details (RFC 9396)
+// the applications of the project are allowed to request.
+// If a schema is set, the requested authorization details of the type are validated against it.
+type ProjectAuthorizationDetailType struct {
+	models.ObjectRoot
+
+	Type        string
+	DisplayName string
+	Description string
+	Schema      []byte
+}
+
+type ProjectAuthorizationDetailTypeState int32
+
+const (
+	ProjectAuthorizationDetailTypeStateUnspecified ProjectAuthorizationDetailTypeState = iota
+	ProjectAuthorizationDetailTypeStateActive
+	ProjectAuthorizationDetailTypeStateRemoved
+)
+
+func (p *ProjectAuthorizationDetailType) IsValid() bool {
+	if p.AggregateID == "" || p.Type == "" || strings.ContainsAny(p.Type, " \t\n\r") {
+		return false
+	}
+	return p.IsSchemaValid()
+}
+
+func (p *ProjectAuthorizationDetailType) IsSchemaValid() bool {
+	if len(p.Schema) == 0 {
+		return true
+	}
+	_, err := ParseJSONSchema(p.Schema)
+	return err == nil
+}
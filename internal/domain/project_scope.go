@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+)
+
+// reservedScopePrefix is used by the scopes ZITADEL interprets itself
+// and can therefore not be defined on a project
+const reservedScopePrefix = "urn:zitadel:"
+
+var reservedScopes = []string{"openid", "profile", "email", "phone", "address", "offline_access"}
+
+type ProjectScope struct {
+	models.ObjectRoot
+
+	Key         string
+	DisplayName string
+	Description string
+}
+
+type ProjectScopeState int32
+
+const (
+	ProjectScopeStateUnspecified ProjectScopeState = iota
+	ProjectScopeStateActive
+	ProjectScopeStateRemoved
+)
+
+func (p *ProjectScope) IsValid() bool {
+	if p.AggregateID == "" || p.Key == "" {
+		return false
+	}
+	if strings.ContainsAny(p.Key, " \t\n\r\"\\") || strings.HasPrefix(p.Key, reservedScopePrefix) {
+		return false
+	}
+	for _, reserved := range reservedScopes {
+		if p.Key == reserved {
+			return false
+		}
+	}
+	return true
+}
+
+// ConsentScopes returns the requested scopes the user has to consent to.
+// The openid scope and the scopes ZITADEL interprets itself are required for the login and therefore omitted.
+func ConsentScopes(requested []string) []string {
+	scopes := make([]string, 0, len(requested))
+	for _, scope := range requested {
+		if scope == "openid" || strings.HasPrefix(scope, reservedScopePrefix) {
+			continue
+		}
+		scopes = append(scopes, scope)
+	}
+	return scopes
+}
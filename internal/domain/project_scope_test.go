@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+)
+
+func TestProjectScope_IsValid(t *testing.T) {
+	tests := []struct {
+		name   string
+		key    string
+		result bool
+	}{
+		{
+			name:   "empty key, invalid",
+			key:    "",
+			result: false,
+		},
+		{
+			name:   "key with whitespace, invalid",
+			key:    "invoices read",
+			result: false,
+		},
+		{
+			name:   "standard scope, invalid",
+			key:    "openid",
+			result: false,
+		},
+		{
+			name:   "reserved prefix, invalid",
+			key:    "urn:zitadel:iam:org:id:123",
+			result: false,
+		},
+		{
+			name:   "custom scope, valid",
+			key:    "invoices:read",
+			result: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope := &ProjectScope{ObjectRoot: models.ObjectRoot{AggregateID: "project1"}, Key: tt.key}
+			if got := scope.IsValid(); got != tt.result {
+				t.Errorf("got wrong result: expected: %v, actual: %v ", tt.result, got)
+			}
+		})
+	}
+}
+
+func TestConsentScopes(t *testing.T) {
+	tests := []struct {
+		name      string
+		requested []string
+		result    []string
+	}{
+		{
+			name:      "no scopes",
+			requested: nil,
+			result:    []string{},
+		},
+		{
+			name:      "openid and reserved scopes, omitted",
+			requested: []string{"openid", "urn:zitadel:iam:org:id:123", "urn:zitadel:iam:org:project:id:zitadel:aud"},
+			result:    []string{},
+		},
+		{
+			name:      "standard and custom scopes",
+			requested: []string{"openid", "profile", "invoices:read"},
+			result:    []string{"profile", "invoices:read"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.result, ConsentScopes(tt.requested))
+		})
+	}
+}
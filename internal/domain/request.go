@@ -30,6 +30,8 @@ type AuthRequestOIDC struct {
 	ResponseType  OIDCResponseType
 	Nonce         string
 	CodeChallenge *OIDCCodeChallenge
+	// AuthorizationDetails are the validated authorization details (RFC 9396) requested by the client
+	AuthorizationDetails AuthorizationDetails
 }
 
 func (a *AuthRequestOIDC) Type() AuthRequestType {
@@ -63,6 +65,8 @@ type AuthRequestDevice struct {
 	DeviceCode string
 	UserCode   string
 	Scopes     []string
+	// BindingMessage is only set for backchannel authentication requests (CIBA)
+	BindingMessage string
 }
 
 func (*AuthRequestDevice) Type() AuthRequestType {
@@ -20,6 +20,10 @@ type Token struct {
 	Expiration        time.Time
 	Scopes            []string
 	PreferredLanguage string
+	// AuthorizationDetails (RFC 9396) the user consented to for this token
+	AuthorizationDetails AuthorizationDetails
+	// LevelOfAssurance reached by the authentication the token was issued for
+	LevelOfAssurance LevelOfAssurance
 }
 
 func AddAudScopeToAudience(ctx context.Context, audience, scopes []string) []string {
@@ -1,6 +1,10 @@
 package domain
 
-import es_models "github.com/zitadel/zitadel/internal/eventstore/v1/models"
+import (
+	"time"
+
+	es_models "github.com/zitadel/zitadel/internal/eventstore/v1/models"
+)
 
 type UserGrant struct {
 	es_models.ObjectRoot
@@ -10,6 +14,8 @@ type UserGrant struct {
 	ProjectID      string
 	ProjectGrantID string
 	RoleKeys       []string
+	ValidFrom      time.Time
+	ValidUntil     time.Time
 }
 
 type UserGrantState int32
@@ -19,12 +25,33 @@ const (
 	UserGrantStateActive
 	UserGrantStateInactive
 	UserGrantStateRemoved
+	// UserGrantStateExpired is never stored, it's computed for active grants outside of their validity
+	UserGrantStateExpired
 )
 
 func (u *UserGrant) IsValid() bool {
 	return u.ProjectID != "" && u.UserID != ""
 }
 
+func (u *UserGrant) HasValidity() bool {
+	return !u.ValidFrom.IsZero() || !u.ValidUntil.IsZero()
+}
+
+func (u *UserGrant) IsValidityValid() bool {
+	return IsGrantValidityValid(u.ValidFrom, u.ValidUntil)
+}
+
+// IsGrantValidityValid checks that the end of the validity (if any) is after its start
+func IsGrantValidityValid(validFrom, validUntil time.Time) bool {
+	return validUntil.IsZero() || validUntil.After(validFrom)
+}
+
+// IsGrantValidAt checks if the point in time is within the (optional) validity of a grant
+func IsGrantValidAt(validFrom, validUntil, t time.Time) bool {
+	return (validFrom.IsZero() || !t.Before(validFrom)) &&
+		(validUntil.IsZero() || t.Before(validUntil))
+}
+
 func (g *UserGrant) HasInvalidRoles(validRoles []string) bool {
 	for _, roleKey := range g.RoleKeys {
 		if !containsRoleKey(roleKey, validRoles) {
@@ -0,0 +1,14 @@
+package domain
+
+type UserGrantRequestState int32
+
+const (
+	UserGrantRequestStateUnspecified UserGrantRequestState = iota
+	UserGrantRequestStateRequested
+	UserGrantRequestStateApproved
+	UserGrantRequestStateDenied
+)
+
+func (s UserGrantRequestState) Exists() bool {
+	return s != UserGrantRequestStateUnspecified
+}
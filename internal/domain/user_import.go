@@ -0,0 +1,34 @@
+package domain
+
+type UserImportJobState int32
+
+const (
+	UserImportJobStateUnspecified UserImportJobState = iota
+	UserImportJobStateRunning
+	UserImportJobStateDone
+)
+
+func (s UserImportJobState) Exists() bool {
+	return s != UserImportJobStateUnspecified
+}
+
+type UserImportFormat int32
+
+const (
+	UserImportFormatUnspecified UserImportFormat = iota
+	UserImportFormatJSONLines
+	UserImportFormatCSV
+)
+
+func (f UserImportFormat) Valid() bool {
+	return f == UserImportFormatJSONLines || f == UserImportFormatCSV
+}
+
+type UserImportRowState int32
+
+const (
+	UserImportRowStateUnspecified UserImportRowState = iota
+	UserImportRowStateCreated
+	UserImportRowStateUpdated
+	UserImportRowStateFailed
+)
@@ -0,0 +1,64 @@
+package eventstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+func (es *Eventstore) archiveRepository() (repository.ArchiveRepository, error) {
+	repo, ok := es.repo.(repository.ArchiveRepository)
+	if !ok {
+		return nil, errors.ThrowUnimplemented(nil, "V2-Ar1bs", "repository does not support archives")
+	}
+	return repo, nil
+}
+
+// ArchiveEvents moves at most limit events of the instance created before the given time
+// from the events to the archive.
+// Only events of aggregates, which have a snapshot newer than the event, are moved.
+// Events not yet processed by all views of the auth and admin api are kept, because these views don't read the archive.
+// It returns nil if no more events can be archived.
+func (es *Eventstore) ArchiveEvents(ctx context.Context, archiveID, instanceID string, before time.Time, limit uint64) (*repository.Archive, error) {
+	repo, err := es.archiveRepository()
+	if err != nil {
+		return nil, err
+	}
+	return repo.ArchiveEvents(ctx, archiveID, instanceID, before, limit)
+}
+
+// Archives returns the archives of the instance or of all instances if instanceID is empty
+func (es *Eventstore) Archives(ctx context.Context, instanceID string) ([]*repository.Archive, error) {
+	repo, err := es.archiveRepository()
+	if err != nil {
+		return nil, err
+	}
+	return repo.Archives(ctx, instanceID)
+}
+
+// ArchivedEvents returns the events of the archive
+// and verifies them against the count and checksum computed while archiving
+func (es *Eventstore) ArchivedEvents(ctx context.Context, archive *repository.Archive) ([]*repository.Event, error) {
+	repo, err := es.archiveRepository()
+	if err != nil {
+		return nil, err
+	}
+	events, err := repo.ArchivedEvents(ctx, archive.ID)
+	if err != nil {
+		return nil, err
+	}
+	return events, VerifyArchive(archive, events)
+}
+
+// VerifyArchive checks if the events match the count and checksum of the archive
+func VerifyArchive(archive *repository.Archive, events []*repository.Event) error {
+	if uint64(len(events)) != archive.EventCount {
+		return errors.ThrowInternalf(nil, "V2-Ar2cn", "archive %s contains %d events instead of %d", archive.ID, len(events), archive.EventCount)
+	}
+	if checksum := repository.ArchiveChecksum(events); checksum != archive.Checksum {
+		return errors.ThrowInternalf(nil, "V2-Ar3cs", "checksum of archive %s does not match", archive.ID)
+	}
+	return nil
+}
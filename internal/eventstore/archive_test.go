@@ -0,0 +1,29 @@
+package eventstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+func TestVerifyArchive(t *testing.T) {
+	creationDate := time.Now()
+	events := snapshotTestEvents(creationDate, 1, 2)
+	archive := &repository.Archive{
+		ID:         "archive",
+		EventCount: 2,
+		Checksum:   repository.ArchiveChecksum(events),
+	}
+	if err := VerifyArchive(archive, events); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := VerifyArchive(archive, events[:1]); err == nil {
+		t.Error("missing event not detected")
+	}
+	changed := snapshotTestEvents(creationDate, 1, 2)
+	changed[1].Data = []byte(`{"changed": true}`)
+	if err := VerifyArchive(archive, changed); err == nil {
+		t.Error("changed event not detected")
+	}
+}
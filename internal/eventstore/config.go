@@ -1,8 +1,10 @@
 package eventstore
 
 import (
+	"context"
 	"time"
 
+	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/eventstore/repository"
 	z_sql "github.com/zitadel/zitadel/internal/eventstore/repository/sql"
@@ -12,6 +14,18 @@ type Config struct {
 	PushTimeout              time.Duration
 	Client                   *database.DB
 	AllowOrderByCreationDate bool
+	// Notifications emits a database notification for every push and listens for the notifications of all ZITADEL processes,
+	// so the projections are triggered immediately instead of on their next schedule
+	Notifications bool
+	// Archive includes the events moved to the archive in all filters.
+	// It must be enabled on all processes before events are archived
+	Archive bool
+	// SnapshotThreshold is the amount of events a write model supporting snapshots reduces
+	// before its state is stored as snapshot. 0 disables the snapshots
+	SnapshotThreshold uint64
+	// PersonalDataEncryption encrypts the keys used to encrypt the personal data in the events (e.g. of users).
+	// The personal data is stored in plain text if it is not set
+	PersonalDataEncryption crypto.EncryptionAlgorithm
 
 	repo repository.Repository
 }
@@ -21,6 +35,11 @@ func TestConfig(repo repository.Repository) *Config {
 }
 
 func Start(config *Config) (*Eventstore, error) {
-	config.repo = z_sql.NewCRDB(config.Client, config.AllowOrderByCreationDate)
+	repo := z_sql.NewCRDB(config.Client, config.AllowOrderByCreationDate, config.Notifications)
+	repo.Archive = config.Archive
+	config.repo = repo
+	if config.Notifications {
+		go listen(context.Background(), config.Client)
+	}
 	return NewEventstore(config), nil
 }
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/errors"
 	"github.com/zitadel/zitadel/internal/eventstore/repository"
 )
@@ -23,10 +24,16 @@ type Eventstore struct {
 	eventTypes        []string
 	aggregateTypes    []string
 	PushTimeout       time.Duration
+	snapshotThreshold uint64
+	// personalDataEncryption encrypts the keys of the personal data,
+	// the personal data is neither encrypted nor decrypted if it is nil
+	personalDataEncryption crypto.EncryptionAlgorithm
 }
 
 type eventTypeInterceptors struct {
-	eventMapper func(*repository.Event) (Event, error)
+	eventMapper        func(*repository.Event) (Event, error)
+	personalDataFields []string
+	erasesPersonalData bool
 }
 
 func NewEventstore(config *Config) *Eventstore {
@@ -35,6 +42,9 @@ func NewEventstore(config *Config) *Eventstore {
 		eventInterceptors: map[EventType]eventTypeInterceptors{},
 		interceptorMutex:  sync.Mutex{},
 		PushTimeout:       config.PushTimeout,
+		snapshotThreshold: config.SnapshotThreshold,
+
+		personalDataEncryption: config.PersonalDataEncryption,
 	}
 }
 
@@ -58,10 +68,15 @@ func (es *Eventstore) Push(ctx context.Context, cmds ...Command) ([]Event, error
 		defer cancel()
 	}
 
-	err = es.repo.Push(ctx, events, constraints...)
+	plainData, err := es.encryptPersonalData(ctx, events)
+	if err != nil {
+		return nil, err
+	}
+	err = es.push(ctx, events, constraints)
 	if err != nil {
 		return nil, err
 	}
+	restorePersonalData(events, plainData)
 
 	eventReaders, err := es.mapEvents(events)
 	if err != nil {
@@ -151,6 +166,9 @@ func (es *Eventstore) Filter(ctx context.Context, queryFactory *SearchQueryBuild
 	if err != nil {
 		return nil, err
 	}
+	if err = es.DecryptPersonalData(ctx, events); err != nil {
+		return nil, err
+	}
 
 	return es.mapEvents(events)
 }
@@ -188,6 +206,9 @@ type reducer interface {
 
 // FilterToReducer filters the events based on the search query, appends all events to the reducer and calls it's reduce function
 func (es *Eventstore) FilterToReducer(ctx context.Context, searchQuery *SearchQueryBuilder, r reducer) error {
+	if snapshotReducer, ok := r.(SnapshotReducer); ok && es.snapshotsEnabled() {
+		return es.filterToSnapshotReducer(ctx, searchQuery, snapshotReducer)
+	}
 	events, err := es.Filter(ctx, searchQuery)
 	if err != nil {
 		return err
@@ -225,6 +246,9 @@ type QueryReducer interface {
 // FilterToQueryReducer filters the events based on the search query of the query function,
 // appends all events to the reducer and calls it's reduce function
 func (es *Eventstore) FilterToQueryReducer(ctx context.Context, r QueryReducer) error {
+	if snapshotReducer, ok := r.(SnapshotReducer); ok && es.snapshotsEnabled() {
+		return es.filterToSnapshotReducer(ctx, r.Query(), snapshotReducer)
+	}
 	events, err := es.Filter(ctx, r.Query())
 	if err != nil {
 		return err
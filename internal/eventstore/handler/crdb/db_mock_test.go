@@ -17,22 +17,49 @@ type mockExpectation func(sqlmock.Sqlmock)
 
 func expectFailureCount(tableName string, projectionName, instanceID string, failedSeq, failureCount uint64) func(sqlmock.Sqlmock) {
 	return func(m sqlmock.Sqlmock) {
-		m.ExpectQuery(`WITH failures AS \(SELECT failure_count FROM `+tableName+` WHERE projection_name = \$1 AND failed_sequence = \$2 AND instance_id = \$3\) SELECT COALESCE\(\(SELECT failure_count FROM failures\), 0\) AS failure_count`).
+		m.ExpectQuery(`WITH failures AS \(SELECT failure_count, skip_reason FROM `+tableName+` WHERE projection_name = \$1 AND failed_sequence = \$2 AND instance_id = \$3\) SELECT COALESCE\(\(SELECT failure_count FROM failures\), 0\) AS failure_count, EXISTS \(SELECT 1 FROM failures WHERE skip_reason IS NOT NULL\) AS skipped`).
 			WithArgs(projectionName, failedSeq, instanceID).
 			WillReturnRows(
-				sqlmock.NewRows([]string{"failure_count"}).
-					AddRow(failureCount),
+				sqlmock.NewRows([]string{"failure_count", "skipped"}).
+					AddRow(failureCount, false),
 			)
 	}
 }
 
 func expectUpdateFailureCount(tableName string, projectionName, instanceID string, seq, failureCount uint64) func(sqlmock.Sqlmock) {
 	return func(m sqlmock.Sqlmock) {
-		m.ExpectExec(`INSERT INTO `+tableName+` \(projection_name, failed_sequence, failure_count, error, instance_id, last_failed\) VALUES \(\$1, \$2, \$3, \$4\, \$5\, \$6\) ON CONFLICT \(projection_name, failed_sequence, instance_id\) DO UPDATE SET failure_count = EXCLUDED\.failure_count, error = EXCLUDED\.error, last_failed = EXCLUDED\.last_failed`).
+		m.ExpectExec(`INSERT INTO `+tableName+` AS f \(projection_name, failed_sequence, failure_count, error, instance_id, last_failed, error_history\) VALUES \(\$1, \$2, \$3, \$4\, \$5\, \$6\, jsonb_build_array\(jsonb_build_object\('error', \$4::TEXT, 'failedAt', NOW\(\)\)\)\) ON CONFLICT \(projection_name, failed_sequence, instance_id\) DO UPDATE SET failure_count = EXCLUDED\.failure_count, error = EXCLUDED\.error, last_failed = EXCLUDED\.last_failed, error_history = COALESCE\(f\.error_history, '\[\]'::JSONB\) \|\| EXCLUDED\.error_history`).
 			WithArgs(projectionName, seq, failureCount, sqlmock.AnyArg(), instanceID, "NOW()").WillReturnResult(sqlmock.NewResult(1, 1))
 	}
 }
 
+func expectSkippedFailureCount(tableName string, projectionName, instanceID string, failedSeq, failureCount uint64) func(sqlmock.Sqlmock) {
+	return func(m sqlmock.Sqlmock) {
+		m.ExpectQuery(`WITH failures AS \(SELECT failure_count, skip_reason FROM `+tableName+` WHERE projection_name = \$1 AND failed_sequence = \$2 AND instance_id = \$3\)`).
+			WithArgs(projectionName, failedSeq, instanceID).
+			WillReturnRows(
+				sqlmock.NewRows([]string{"failure_count", "skipped"}).
+					AddRow(failureCount, true),
+			)
+	}
+}
+
+func expectSkipFailedEvent(tableName string, projectionName, instanceID string, seq uint64, reason string, rowsAffected int64) func(sqlmock.Sqlmock) {
+	return func(m sqlmock.Sqlmock) {
+		m.ExpectExec(`UPDATE `+tableName+` SET skip_reason = \$4, skipped_at = NOW\(\) WHERE projection_name = \$1 AND failed_sequence = \$2 AND instance_id = \$3`).
+			WithArgs(projectionName, seq, instanceID, reason).
+			WillReturnResult(sqlmock.NewResult(0, rowsAffected))
+	}
+}
+
+func expectRemoveFailedEvent(tableName string, projectionName, instanceID string, seq uint64) func(sqlmock.Sqlmock) {
+	return func(m sqlmock.Sqlmock) {
+		m.ExpectExec(`DELETE FROM `+tableName+` WHERE projection_name = \$1 AND failed_sequence = \$2 AND instance_id = \$3`).
+			WithArgs(projectionName, seq, instanceID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+}
+
 func expectCreate(projectionName string, columnNames, placeholders []string) func(sqlmock.Sqlmock) {
 	return func(m sqlmock.Sqlmock) {
 		args := make([]driver.Value, len(columnNames))
@@ -1,25 +1,41 @@
 package crdb
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/zitadel/logging"
+	"go.opentelemetry.io/otel/attribute"
 
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/telemetry/metrics"
 )
 
 const (
-	setFailureCountStmtFormat = "INSERT INTO %s" +
-		" (projection_name, failed_sequence, failure_count, error, instance_id, last_failed)" +
-		" VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (projection_name, failed_sequence, instance_id)" +
-		" DO UPDATE SET failure_count = EXCLUDED.failure_count, error = EXCLUDED.error, last_failed = EXCLUDED.last_failed"
-	failureCountStmtFormat = "WITH failures AS (SELECT failure_count FROM %s WHERE projection_name = $1 AND failed_sequence = $2 AND instance_id = $3)" +
-		" SELECT COALESCE((SELECT failure_count FROM failures), 0) AS failure_count"
+	FailedEventsCounter                      = "zitadel.projections.failed_events"
+	FailedEventsCounterDescription           = "Failed executions of statements of projected events"
+	FailedEventsThresholdExceeded            = "zitadel.projections.failed_events_threshold_exceeded"
+	FailedEventsThresholdExceededDescription = "Failures of projections which already exceeded the threshold of failed events"
+	ProjectionLabel                          = "projection"
+	setFailureCountStmtFormat                = "INSERT INTO %s AS f" +
+		" (projection_name, failed_sequence, failure_count, error, instance_id, last_failed, error_history)" +
+		" VALUES ($1, $2, $3, $4, $5, $6, jsonb_build_array(jsonb_build_object('error', $4::TEXT, 'failedAt', NOW())))" +
+		" ON CONFLICT (projection_name, failed_sequence, instance_id)" +
+		" DO UPDATE SET failure_count = EXCLUDED.failure_count, error = EXCLUDED.error, last_failed = EXCLUDED.last_failed," +
+		" error_history = COALESCE(f.error_history, '[]'::JSONB) || EXCLUDED.error_history"
+	failureCountStmtFormat = "WITH failures AS (SELECT failure_count, skip_reason FROM %s WHERE projection_name = $1 AND failed_sequence = $2 AND instance_id = $3)" +
+		" SELECT COALESCE((SELECT failure_count FROM failures), 0) AS failure_count, EXISTS (SELECT 1 FROM failures WHERE skip_reason IS NOT NULL) AS skipped"
+	failedEventsCountStmtFormat   = "SELECT COUNT(*) FROM %s WHERE projection_name = $1 AND instance_id = $2"
+	skipFailedEventStmtFormat     = "UPDATE %s SET skip_reason = $4, skipped_at = NOW() WHERE projection_name = $1 AND failed_sequence = $2 AND instance_id = $3"
+	removeFailedEventStmtFormat   = "DELETE FROM %s WHERE projection_name = $1 AND failed_sequence = $2 AND instance_id = $3"
+	skippedFailedEventsStmtFormat = "SELECT instance_id, failed_sequence FROM %s WHERE projection_name = $1 AND (failure_count >= $2 OR skip_reason IS NOT NULL) ORDER BY failed_sequence"
 )
 
 func (h *StatementHandler) handleFailedStmt(tx *sql.Tx, stmt *handler.Statement, execErr error) (shouldContinue bool) {
-	failureCount, err := h.failureCount(tx, stmt.Sequence, stmt.InstanceID)
+	failureCount, skipped, err := h.failureCount(tx, stmt.Sequence, stmt.InstanceID)
 	if err != nil {
 		logging.WithFields("projection", h.ProjectionName, "sequence", stmt.Sequence).WithError(err).Warn("unable to get failure count")
 		return false
@@ -27,19 +43,20 @@ func (h *StatementHandler) handleFailedStmt(tx *sql.Tx, stmt *handler.Statement,
 	failureCount += 1
 	err = h.setFailureCount(tx, stmt.Sequence, failureCount, execErr, stmt.InstanceID)
 	logging.WithFields("projection", h.ProjectionName, "sequence", stmt.Sequence).OnError(err).Warn("unable to update failure count")
+	h.countFailure(tx, stmt.InstanceID)
 
-	return failureCount >= h.maxFailureCount
+	return skipped || failureCount >= h.maxFailureCount
 }
 
-func (h *StatementHandler) failureCount(tx *sql.Tx, seq uint64, instanceID string) (count uint, err error) {
+func (h *StatementHandler) failureCount(tx *sql.Tx, seq uint64, instanceID string) (count uint, skipped bool, err error) {
 	row := tx.QueryRow(h.failureCountStmt, h.ProjectionName, seq, instanceID)
 	if err = row.Err(); err != nil {
-		return 0, errors.ThrowInternal(err, "CRDB-Unnex", "unable to update failure count")
+		return 0, false, errors.ThrowInternal(err, "CRDB-Unnex", "unable to update failure count")
 	}
-	if err = row.Scan(&count); err != nil {
-		return 0, errors.ThrowInternal(err, "CRDB-RwSMV", "unable to scan count")
+	if err = row.Scan(&count, &skipped); err != nil {
+		return 0, false, errors.ThrowInternal(err, "CRDB-RwSMV", "unable to scan count")
 	}
-	return count, nil
+	return count, skipped, nil
 }
 
 func (h *StatementHandler) setFailureCount(tx *sql.Tx, seq uint64, count uint, err error, instanceID string) error {
@@ -49,3 +66,204 @@ func (h *StatementHandler) setFailureCount(tx *sql.Tx, seq uint64, count uint, e
 	}
 	return nil
 }
+
+// countFailure adds the failure to the metrics
+// and alerts if the failed events of the instance exceed the configured threshold
+func (h *StatementHandler) countFailure(tx *sql.Tx, instanceID string) {
+	labels := map[string]attribute.Value{ProjectionLabel: attribute.StringValue(h.ProjectionName)}
+	err := metrics.AddCount(context.Background(), FailedEventsCounter, 1, labels)
+	logging.WithFields("metric", FailedEventsCounter).OnError(err).Debug("unable to add count")
+	if h.config.FailedEventsThreshold == 0 {
+		return
+	}
+	var count uint
+	if err = tx.QueryRow(h.failedEventsCountStmt, h.ProjectionName, instanceID).Scan(&count); err != nil {
+		logging.WithFields("projection", h.ProjectionName).WithError(err).Warn("unable to count failed events")
+		return
+	}
+	if count <= h.config.FailedEventsThreshold {
+		return
+	}
+	logging.WithFields("projection", h.ProjectionName, "instance", instanceID, "failedEvents", count, "threshold", h.config.FailedEventsThreshold).
+		Error("failed events of projection exceed threshold")
+	err = metrics.AddCount(context.Background(), FailedEventsThresholdExceeded, 1, labels)
+	logging.WithFields("metric", FailedEventsThresholdExceeded).OnError(err).Debug("unable to add count")
+}
+
+// SkipFailedEvent stores the reason why the failed event is skipped.
+// The projection continues with the next event as soon as it retries the failed event
+func (h *StatementHandler) SkipFailedEvent(ctx context.Context, instanceID string, sequence uint64, reason string) error {
+	if reason == "" {
+		return errors.ThrowInvalidArgument(nil, "CRDB-Wq8sd", "Errors.FailedEvent.SkipReasonMissing")
+	}
+	result, err := h.client.ExecContext(ctx, h.skipFailedEventStmt, h.ProjectionName, sequence, instanceID, reason)
+	if err != nil {
+		return errors.ThrowInternal(err, "CRDB-Ks9fe", "unable to skip failed event")
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return errors.ThrowNotFound(nil, "CRDB-Oe2fk", "Errors.FailedEvent.NotFound")
+	}
+	return nil
+}
+
+// RetryFailedEvent reduces the failed event again and executes its statement.
+// The failed event is removed if the statement succeeds, otherwise the failure is added to its history.
+//
+// Only skipped events can be retried, failed events which are not skipped yet are retried by the projection itself.
+// As the projection already continued with later events, the events of the same aggregate processed since
+// are reduced and executed again after the failed event, so its statement doesn't overwrite their changes.
+func (h *StatementHandler) RetryFailedEvent(ctx context.Context, instanceID string, sequence uint64) error {
+	tx, err := h.client.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.ThrowInternal(err, "CRDB-Mx8d2", "begin failed")
+	}
+	execErr, err := h.retryFailedEvent(ctx, tx, instanceID, sequence)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		logging.OnError(rollbackErr).Debug("rollback failed")
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return errors.ThrowInternal(err, "CRDB-Ql2xe", "commit failed")
+	}
+	if execErr != nil {
+		return errors.ThrowInternal(execErr, "CRDB-Nd8sw", "Errors.FailedEvent.RetryFailed")
+	}
+	return nil
+}
+
+// retryFailedEvent returns the error of the statements if they failed again,
+// which must not roll back the transaction as the failure is added to the history
+func (h *StatementHandler) retryFailedEvent(ctx context.Context, tx *sql.Tx, instanceID string, sequence uint64) (execErr, err error) {
+	failureCount, skipped, err := h.failureCount(tx, sequence, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	if failureCount == 0 {
+		return nil, errors.ThrowNotFound(nil, "CRDB-Vb3sk", "Errors.FailedEvent.NotFound")
+	}
+	if !skipped && failureCount < h.maxFailureCount {
+		return nil, errors.ThrowPreconditionFailed(nil, "CRDB-Pq0dk", "Errors.FailedEvent.NotSkipped")
+	}
+	events, err := h.aggregateEventsSinceFailure(ctx, tx, instanceID, sequence)
+	if err != nil {
+		return nil, err
+	}
+	stmts := make([]*handler.Statement, len(events))
+	for i, event := range events {
+		if stmts[i], err = h.reduce(event); err != nil {
+			return nil, err
+		}
+	}
+	if execErr = h.executeRetryStmts(tx, stmts); execErr != nil {
+		if err = h.setFailureCount(tx, sequence, failureCount+1, execErr, instanceID); err != nil {
+			return nil, err
+		}
+		h.countFailure(tx, instanceID)
+		return execErr, nil
+	}
+	if _, err = tx.ExecContext(ctx, h.removeFailedEventStmt, h.ProjectionName, sequence, instanceID); err != nil {
+		return nil, errors.ThrowInternal(err, "CRDB-Xs7ek", "unable to remove failed event")
+	}
+	return nil, nil
+}
+
+// aggregateEventsSinceFailure returns the failed event
+// followed by the events of its aggregate, which the projection already processed.
+// The current sequences of the projection are locked, so it can't process further events in the meantime.
+func (h *StatementHandler) aggregateEventsSinceFailure(ctx context.Context, tx *sql.Tx, instanceID string, sequence uint64) ([]eventstore.Event, error) {
+	failed, err := h.Eventstore.Filter(ctx, eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(h.aggregates...).
+		SequenceGreater(sequence-1).
+		SequenceLess(sequence+1).
+		InstanceID(instanceID).
+		Builder(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(failed) != 1 {
+		return nil, errors.ThrowNotFound(nil, "CRDB-Ht5sd", "Errors.FailedEvent.NotFound")
+	}
+	aggregate := failed[0].Aggregate()
+	sequences, err := h.currentSequences(ctx, tx.QueryContext, database.StringArray{instanceID})
+	if err != nil {
+		return nil, err
+	}
+	var currentSequence uint64
+	for _, current := range sequences[aggregate.Type] {
+		if current.instanceID == instanceID {
+			currentSequence = current.sequence
+		}
+	}
+	if currentSequence <= sequence {
+		return failed, nil
+	}
+	processed, err := h.Eventstore.Filter(ctx, eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(aggregate.Type).
+		AggregateIDs(aggregate.ID).
+		SequenceGreater(sequence).
+		SequenceLess(currentSequence+1).
+		InstanceID(instanceID).
+		Builder(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return append(failed, processed...), nil
+}
+
+// executeRetryStmts executes all statements or none of them
+func (h *StatementHandler) executeRetryStmts(tx *sql.Tx, stmts []*handler.Statement) error {
+	if _, err := tx.Exec("SAVEPOINT retry_failed_event"); err != nil {
+		return errors.ThrowInternal(err, "CRDB-Rk2va", "unable to create savepoint")
+	}
+	for _, stmt := range stmts {
+		execErr := h.executeStmt(tx, stmt)
+		if execErr == nil {
+			continue
+		}
+		if _, err := tx.Exec("ROLLBACK TO SAVEPOINT retry_failed_event"); err != nil {
+			return errors.ThrowInternal(err, "CRDB-Yq5nb", "rollback to savepoint failed")
+		}
+		return execErr
+	}
+	if _, err := tx.Exec("RELEASE retry_failed_event"); err != nil {
+		return errors.ThrowInternal(err, "CRDB-Wz8pd", "unable to release savepoint")
+	}
+	return nil
+}
+
+// RetryFailedEvents retries all skipped failed events of the projection in the order of their sequence.
+// It returns the amount of events which failed again
+func (h *StatementHandler) RetryFailedEvents(ctx context.Context) (failed int, err error) {
+	rows, err := h.client.QueryContext(ctx, h.skippedFailedEventsStmt, h.ProjectionName, h.maxFailureCount)
+	if err != nil {
+		return 0, errors.ThrowInternal(err, "CRDB-Yw2sl", "unable to query failed events")
+	}
+	type failedEvent struct {
+		instanceID string
+		sequence   uint64
+	}
+	var failedEvents []failedEvent
+	for rows.Next() {
+		var event failedEvent
+		if err = rows.Scan(&event.instanceID, &event.sequence); err != nil {
+			rows.Close()
+			return 0, errors.ThrowInternal(err, "CRDB-Ct6pw", "unable to scan failed event")
+		}
+		failedEvents = append(failedEvents, event)
+	}
+	if err = rows.Close(); err != nil {
+		return 0, errors.ThrowInternal(err, "CRDB-Lq8vx", "unable to close rows")
+	}
+	for _, event := range failedEvents {
+		if err = h.RetryFailedEvent(ctx, event.instanceID, event.sequence); err != nil {
+			logging.WithFields("projection", h.ProjectionName, "instance", event.instanceID, "sequence", event.sequence).WithError(err).Info("retry of failed event failed")
+			failed++
+		}
+	}
+	return failed, nil
+}
@@ -0,0 +1,329 @@
+package crdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	es_repo_mock "github.com/zitadel/zitadel/internal/eventstore/repository/mock"
+)
+
+func failedEventsTestHandler(t *testing.T, client *database.DB, es *eventstore.Eventstore) *StatementHandler {
+	t.Helper()
+	return &StatementHandler{
+		ProjectionHandler: &handler.ProjectionHandler{
+			Handler: handler.Handler{
+				Eventstore: es,
+			},
+			ProjectionName: "my_projection",
+		},
+		client:                client,
+		maxFailureCount:       5,
+		failureCountStmt:      fmt.Sprintf(failureCountStmtFormat, "failed_events"),
+		setFailureCountStmt:   fmt.Sprintf(setFailureCountStmtFormat, "failed_events"),
+		skipFailedEventStmt:   fmt.Sprintf(skipFailedEventStmtFormat, "failed_events"),
+		removeFailedEventStmt: fmt.Sprintf(removeFailedEventStmtFormat, "failed_events"),
+		currentSequenceStmt:   fmt.Sprintf(currentSequenceStmtFormat, "current_sequences"),
+		aggregates:            []eventstore.AggregateType{"testAgg"},
+		reduces: map[eventstore.EventType]handler.Reduce{
+			"testAgg.changed": func(event eventstore.Event) (*handler.Statement, error) {
+				return NewUpdateStatement(event,
+					[]handler.Column{handler.NewCol("sequence", event.Sequence())},
+					[]handler.Condition{handler.NewCond("id", event.Aggregate().ID)},
+				), nil
+			},
+		},
+	}
+}
+
+func failedTestEvent(sequence uint64) *repository.Event {
+	return &repository.Event{
+		AggregateType: "testAgg",
+		AggregateID:   "agg",
+		Type:          "testAgg.changed",
+		Sequence:      sequence,
+		InstanceID:    "instanceID",
+		Data:          []byte("{}"),
+	}
+}
+
+func expectRetrySavePoint() func(sqlmock.Sqlmock) {
+	return func(m sqlmock.Sqlmock) {
+		m.ExpectExec("SAVEPOINT retry_failed_event").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+}
+
+func expectRetrySavePointRollback() func(sqlmock.Sqlmock) {
+	return func(m sqlmock.Sqlmock) {
+		m.ExpectExec("ROLLBACK TO SAVEPOINT retry_failed_event").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+}
+
+func expectRetrySavePointRelease() func(sqlmock.Sqlmock) {
+	return func(m sqlmock.Sqlmock) {
+		m.ExpectExec("RELEASE retry_failed_event").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+}
+
+func expectUpdateSequence(sequence uint64, err error) func(sqlmock.Sqlmock) {
+	return func(m sqlmock.Sqlmock) {
+		exec := m.ExpectExec(`UPDATE my_projection SET sequence = \$1 WHERE \(id = \$2\)`).
+			WithArgs(sequence, "agg")
+		if err != nil {
+			exec.WillReturnError(err)
+			return
+		}
+		exec.WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+}
+
+func TestStatementHandler_SkipFailedEvent(t *testing.T) {
+	type args struct {
+		reason string
+	}
+	type want struct {
+		expectations []mockExpectation
+		isErr        func(error) bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "reason missing",
+			args: args{},
+			want: want{
+				isErr: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "not found",
+			args: args{reason: "user already removed"},
+			want: want{
+				expectations: []mockExpectation{
+					expectSkipFailedEvent("failed_events", "my_projection", "instanceID", 6, "user already removed", 0),
+				},
+				isErr: errors.IsNotFound,
+			},
+		},
+		{
+			name: "skipped",
+			args: args{reason: "user already removed"},
+			want: want{
+				expectations: []mockExpectation{
+					expectSkipFailedEvent("failed_events", "my_projection", "instanceID", 6, "user already removed", 1),
+				},
+				isErr: func(err error) bool { return err == nil },
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer client.Close()
+
+			h := failedEventsTestHandler(t, &database.DB{DB: client}, nil)
+			for _, expectation := range tt.want.expectations {
+				expectation(mock)
+			}
+
+			err = h.SkipFailedEvent(context.Background(), "instanceID", 6, tt.args.reason)
+			if !tt.want.isErr(err) {
+				t.Errorf("StatementHandler.SkipFailedEvent() unexpected error = %v", err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("expectations not met: %v", err)
+			}
+		})
+	}
+}
+
+func TestStatementHandler_RetryFailedEvent(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type want struct {
+		expectations []mockExpectation
+		isErr        func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   want
+	}{
+		{
+			name: "not found",
+			fields: fields{
+				eventstore: eventstore.NewEventstore(eventstore.TestConfig(es_repo_mock.NewRepo(t))),
+			},
+			want: want{
+				expectations: []mockExpectation{
+					expectBegin(),
+					expectFailureCount("failed_events", "my_projection", "instanceID", 6, 0),
+					expectRollback(),
+				},
+				isErr: errors.IsNotFound,
+			},
+		},
+		{
+			name: "not skipped",
+			fields: fields{
+				eventstore: eventstore.NewEventstore(eventstore.TestConfig(es_repo_mock.NewRepo(t))),
+			},
+			want: want{
+				expectations: []mockExpectation{
+					expectBegin(),
+					expectFailureCount("failed_events", "my_projection", "instanceID", 6, 2),
+					expectRollback(),
+				},
+				isErr: errors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "skipped by reason",
+			fields: fields{
+				eventstore: eventstore.NewEventstore(eventstore.TestConfig(
+					es_repo_mock.NewRepo(t).ExpectFilterEvents(
+						&repository.Event{
+							AggregateType: "testAgg",
+							Sequence:      6,
+							InstanceID:    "instanceID",
+						},
+					),
+				)),
+			},
+			want: want{
+				expectations: []mockExpectation{
+					expectBegin(),
+					expectSkippedFailureCount("failed_events", "my_projection", "instanceID", 6, 2),
+					expectCurrentSequence("current_sequences", "my_projection", 6, "testAgg", []string{"instanceID"}),
+					expectRetrySavePoint(),
+					expectRetrySavePointRelease(),
+					expectRemoveFailedEvent("failed_events", "my_projection", "instanceID", 6),
+					expectCommit(),
+				},
+				isErr: func(err error) bool { return err == nil },
+			},
+		},
+		{
+			name: "max failure count reached",
+			fields: fields{
+				eventstore: eventstore.NewEventstore(eventstore.TestConfig(
+					es_repo_mock.NewRepo(t).ExpectFilterEvents(
+						&repository.Event{
+							AggregateType: "testAgg",
+							Sequence:      6,
+							InstanceID:    "instanceID",
+						},
+					),
+				)),
+			},
+			want: want{
+				expectations: []mockExpectation{
+					expectBegin(),
+					expectFailureCount("failed_events", "my_projection", "instanceID", 6, 5),
+					expectCurrentSequence("current_sequences", "my_projection", 6, "testAgg", []string{"instanceID"}),
+					expectRetrySavePoint(),
+					expectRetrySavePointRelease(),
+					expectRemoveFailedEvent("failed_events", "my_projection", "instanceID", 6),
+					expectCommit(),
+				},
+				isErr: func(err error) bool { return err == nil },
+			},
+		},
+		{
+			name: "processed events of aggregate reduced again",
+			fields: fields{
+				eventstore: eventstore.NewEventstore(eventstore.TestConfig(
+					es_repo_mock.NewRepo(t).
+						ExpectFilterEvents(failedTestEvent(6)).
+						ExpectFilterEvents(failedTestEvent(8)),
+				)),
+			},
+			want: want{
+				expectations: []mockExpectation{
+					expectBegin(),
+					expectSkippedFailureCount("failed_events", "my_projection", "instanceID", 6, 2),
+					expectCurrentSequence("current_sequences", "my_projection", 10, "testAgg", []string{"instanceID"}),
+					expectRetrySavePoint(),
+					expectSavePoint(),
+					expectUpdateSequence(6, nil),
+					expectSavePointRelease(),
+					expectSavePoint(),
+					expectUpdateSequence(8, nil),
+					expectSavePointRelease(),
+					expectRetrySavePointRelease(),
+					expectRemoveFailedEvent("failed_events", "my_projection", "instanceID", 6),
+					expectCommit(),
+				},
+				isErr: func(err error) bool { return err == nil },
+			},
+		},
+		{
+			name: "failed again, no statement executed",
+			fields: fields{
+				eventstore: eventstore.NewEventstore(eventstore.TestConfig(
+					es_repo_mock.NewRepo(t).
+						ExpectFilterEvents(failedTestEvent(6)).
+						ExpectFilterEvents(failedTestEvent(8)),
+				)),
+			},
+			want: want{
+				expectations: []mockExpectation{
+					expectBegin(),
+					expectSkippedFailureCount("failed_events", "my_projection", "instanceID", 6, 2),
+					expectCurrentSequence("current_sequences", "my_projection", 10, "testAgg", []string{"instanceID"}),
+					expectRetrySavePoint(),
+					expectSavePoint(),
+					expectUpdateSequence(6, nil),
+					expectSavePointRelease(),
+					expectSavePoint(),
+					expectUpdateSequence(8, sql.ErrConnDone),
+					expectSavePointRollback(),
+					expectRetrySavePointRollback(),
+					expectUpdateFailureCount("failed_events", "my_projection", "instanceID", 6, 3),
+					expectCommit(),
+				},
+				isErr: errors.IsInternal,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer client.Close()
+
+			h := failedEventsTestHandler(t, &database.DB{DB: client}, tt.fields.eventstore)
+			for _, expectation := range tt.want.expectations {
+				expectation(mock)
+			}
+
+			err = h.RetryFailedEvent(context.Background(), "instanceID", 6)
+			if !tt.want.isErr(err) {
+				t.Errorf("StatementHandler.RetryFailedEvent() unexpected error = %v", err)
+			}
+			mock.MatchExpectationsInOrder(true)
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("expectations not met: %v", err)
+			}
+		})
+	}
+}
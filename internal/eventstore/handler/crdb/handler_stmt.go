@@ -27,6 +27,9 @@ type StatementHandlerConfig struct {
 	FailedEventsTable string
 	MaxFailureCount   uint
 	BulkLimit         uint64
+	// FailedEventsThreshold is the amount of failed events of an instance
+	// above which each further failure of the projection is alerted, 0 disables the alert
+	FailedEventsThreshold uint
 
 	Reducers  []handler.AggregateReducer
 	InitCheck *handler.Check
@@ -36,6 +39,7 @@ type StatementHandler struct {
 	*handler.ProjectionHandler
 	Locker
 
+	config                  StatementHandlerConfig
 	client                  *database.DB
 	sequenceTable           string
 	currentSequenceStmt     string
@@ -43,6 +47,10 @@ type StatementHandler struct {
 	maxFailureCount         uint
 	failureCountStmt        string
 	setFailureCountStmt     string
+	failedEventsCountStmt   string
+	skipFailedEventStmt     string
+	removeFailedEventStmt   string
+	skippedFailedEventsStmt string
 
 	aggregates  []eventstore.AggregateType
 	reduces     map[eventstore.EventType]handler.Reduce
@@ -77,6 +85,7 @@ func NewStatementHandler(
 	}
 
 	h := StatementHandler{
+		config:                     config,
 		client:                     config.Client,
 		sequenceTable:              config.SequenceTable,
 		maxFailureCount:            config.MaxFailureCount,
@@ -84,6 +93,10 @@ func NewStatementHandler(
 		updateSequencesBaseStmt:    fmt.Sprintf(updateCurrentSequencesStmtFormat, config.SequenceTable),
 		failureCountStmt:           fmt.Sprintf(failureCountStmtFormat, config.FailedEventsTable),
 		setFailureCountStmt:        fmt.Sprintf(setFailureCountStmtFormat, config.FailedEventsTable),
+		failedEventsCountStmt:      fmt.Sprintf(failedEventsCountStmtFormat, config.FailedEventsTable),
+		skipFailedEventStmt:        fmt.Sprintf(skipFailedEventStmtFormat, config.FailedEventsTable),
+		removeFailedEventStmt:      fmt.Sprintf(removeFailedEventStmtFormat, config.FailedEventsTable),
+		skippedFailedEventsStmt:    fmt.Sprintf(skippedFailedEventsStmtFormat, config.FailedEventsTable),
 		aggregates:                 aggregateTypes,
 		reduces:                    reduces,
 		bulkLimit:                  config.BulkLimit,
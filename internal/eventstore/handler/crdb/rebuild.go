@@ -0,0 +1,406 @@
+package crdb
+
+import (
+	"context"
+	"database/sql"
+	errs "errors"
+	"fmt"
+	"strings"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	rebuildSuffix  = "_rebuild"
+	replacedSuffix = "_replaced"
+	// maxSwapAttempts is the amount of catch ups of the shadow projection
+	// before the swap gives up because the live projection is always ahead
+	maxSwapAttempts = 10
+
+	tablesByPrefixStmt           = "SELECT table_name, table_type FROM information_schema.tables WHERE table_schema = $1 AND table_name LIKE $2"
+	indexesOfTableStmt           = "SELECT indexname FROM pg_catalog.pg_indexes WHERE schemaname = $1 AND tablename = $2"
+	rebuildSequencesStmtFormat   = "SELECT projection_name, aggregate_type, instance_id, current_sequence FROM %s WHERE projection_name = ANY ($1) FOR UPDATE"
+	moveSequencesStmtFormat      = "INSERT INTO %[1]s (projection_name, aggregate_type, current_sequence, instance_id, timestamp) SELECT $1, aggregate_type, current_sequence, instance_id, timestamp FROM %[1]s WHERE projection_name = $2" + updateCurrentSequencesConflictStmt
+	deleteByProjectionStmtFormat = "DELETE FROM %s WHERE projection_name = $1"
+	moveFailedEventsStmtFormat   = "UPDATE %s SET projection_name = $1 WHERE projection_name = $2"
+)
+
+var (
+	ErrRebuildAborted = errors.ThrowPreconditionFailed(nil, "CRDB-Nq8cS", "rebuild aborted")
+)
+
+// RebuildProgress is called after each chunk of instances is projected into the shadow tables
+type RebuildProgress func(done, total int)
+
+// Rebuild projects all events into shadow tables and replaces the tables of the projection
+// as soon as the shadow tables caught up with the current sequences of the projection.
+// The projection keeps serving its current state until the replacement,
+// which is done in a single transaction.
+//
+// The rebuild must be started using StartRebuild before, its result is stored using EndRebuild.
+func (h *StatementHandler) Rebuild(ctx context.Context, rebuildID string, progress RebuildProgress) (err error) {
+	defer func() {
+		if errs.Is(err, ErrRebuildAborted) {
+			return
+		}
+		endErr := h.EndRebuild(ctx, rebuildID, err)
+		logging.WithFields("projection", h.ProjectionName, "rebuild", rebuildID).OnError(endErr).Error("unable to store result of rebuild")
+	}()
+	// the shadow handler is never started,
+	// canceling its context stops the goroutine waiting for the start
+	shadowCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	shadow := h.newShadow(shadowCtx)
+	if err = h.dropShadow(ctx, shadow.ProjectionName); err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			return
+		}
+		dropErr := h.dropShadow(context.Background(), shadow.ProjectionName)
+		logging.WithFields("projection", h.ProjectionName, "rebuild", rebuildID).OnError(dropErr).Error("unable to drop shadow tables")
+	}()
+	if err = shadow.Init(ctx); err != nil {
+		return err
+	}
+
+	instanceIDs, err := h.Eventstore.InstanceIDs(ctx, eventstore.NewSearchQueryBuilder(eventstore.ColumnsInstanceIDs).AddQuery().ExcludedInstanceID("").Builder())
+	if err != nil {
+		return err
+	}
+	if err = h.rebuildInstances(ctx, &shadow, rebuildID, instanceIDs, progress); err != nil {
+		return err
+	}
+
+	for attempt := 0; attempt < maxSwapAttempts; attempt++ {
+		if err = h.checkRebuildAborted(ctx, rebuildID); err != nil {
+			return err
+		}
+		var behind []string
+		behind, err = h.swap(ctx, &shadow)
+		if err != nil {
+			return err
+		}
+		if len(behind) == 0 {
+			return nil
+		}
+		if err = h.rebuildInstances(ctx, &shadow, rebuildID, behind, nil); err != nil {
+			return err
+		}
+	}
+	return errors.ThrowInternal(nil, "CRDB-x8Fh1", "shadow projection was not able to catch up")
+}
+
+func (h *StatementHandler) newShadow(ctx context.Context) StatementHandler {
+	config := h.config
+	config.ProjectionName = h.ProjectionName + rebuildSuffix
+	return NewStatementHandler(ctx, config)
+}
+
+func (h *StatementHandler) rebuildInstances(ctx context.Context, shadow *StatementHandler, rebuildID string, instanceIDs []string, progress RebuildProgress) error {
+	bulk := int(h.config.ConcurrentInstances)
+	if bulk < 1 {
+		bulk = 1
+	}
+	for i := 0; i < len(instanceIDs); i += bulk {
+		if err := h.checkRebuildAborted(ctx, rebuildID); err != nil {
+			return err
+		}
+		max := i + bulk
+		if max > len(instanceIDs) {
+			max = len(instanceIDs)
+		}
+		if _, err := shadow.TriggerErr(ctx, instanceIDs[i:max]...); err != nil {
+			return err
+		}
+		if progress != nil {
+			progress(max, len(instanceIDs))
+		}
+	}
+	return nil
+}
+
+func (h *StatementHandler) checkRebuildAborted(ctx context.Context, rebuildID string) error {
+	aborted, err := h.IsRebuildAborted(ctx, rebuildID)
+	if err != nil {
+		return err
+	}
+	if aborted {
+		return ErrRebuildAborted
+	}
+	return nil
+}
+
+type sequenceKey struct {
+	aggregateType string
+	instanceID    string
+}
+
+// swap replaces the tables of the projection with the shadow tables
+// if the shadow projection is not behind the projection
+// otherwise the instances which are behind are returned
+func (h *StatementHandler) swap(ctx context.Context, shadow *StatementHandler) (behind []string, err error) {
+	tx, err := h.client.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "CRDB-Ra2kd", "begin failed")
+	}
+	defer func() {
+		if err != nil || len(behind) > 0 {
+			rollbackErr := tx.Rollback()
+			logging.OnError(rollbackErr).Debug("rollback failed")
+		}
+	}()
+
+	behind, err = h.shadowBehind(ctx, tx, shadow.ProjectionName)
+	if err != nil || len(behind) > 0 {
+		return behind, err
+	}
+
+	tables, err := tablesByPrefix(ctx, tx, shadow.ProjectionName)
+	if err != nil {
+		return nil, err
+	}
+	liveTables, err := tablesByPrefix(ctx, tx, h.ProjectionName)
+	if err != nil {
+		return nil, err
+	}
+	replaced := make(map[string]string, len(tables))
+	for table := range tables {
+		suffix := strings.TrimPrefix(table, shadow.ProjectionName)
+		tableType, ok := liveTables[h.ProjectionName+suffix]
+		if !ok {
+			continue
+		}
+		if err = h.renameTable(ctx, tx, h.ProjectionName+suffix, h.ProjectionName+replacedSuffix+suffix, tableType); err != nil {
+			return nil, err
+		}
+		replaced[h.ProjectionName+replacedSuffix+suffix] = tableType
+	}
+	for table, tableType := range tables {
+		if err = h.renameTable(ctx, tx, table, h.ProjectionName+strings.TrimPrefix(table, shadow.ProjectionName), tableType); err != nil {
+			return nil, err
+		}
+	}
+
+	stmts := []struct {
+		stmt string
+		args []interface{}
+	}{
+		{fmt.Sprintf(moveSequencesStmtFormat, h.sequenceTable), []interface{}{h.ProjectionName, shadow.ProjectionName}},
+		{fmt.Sprintf(deleteByProjectionStmtFormat, h.sequenceTable), []interface{}{shadow.ProjectionName}},
+		{fmt.Sprintf(deleteByProjectionStmtFormat, h.config.FailedEventsTable), []interface{}{h.ProjectionName}},
+		{fmt.Sprintf(moveFailedEventsStmtFormat, h.config.FailedEventsTable), []interface{}{h.ProjectionName, shadow.ProjectionName}},
+		{fmt.Sprintf(deleteByProjectionStmtFormat, h.config.LockTable), []interface{}{shadow.ProjectionName}},
+	}
+	for _, stmt := range stmts {
+		if _, err = tx.ExecContext(ctx, stmt.stmt, stmt.args...); err != nil {
+			return nil, errors.ThrowInternal(err, "CRDB-Lk2nS", "unable to move sequences")
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, errors.ThrowInternal(err, "CRDB-Ue3wq", "commit failed")
+	}
+
+	for table, tableType := range replaced {
+		dropErr := dropTable(ctx, h.client, table, tableType)
+		logging.WithFields("projection", h.ProjectionName, "table", table).OnError(dropErr).Warn("unable to drop replaced table")
+	}
+	return nil, nil
+}
+
+// shadowBehind locks the current sequences of the projection and the shadow projection
+// and returns the instances where the shadow projection did not catch up
+func (h *StatementHandler) shadowBehind(ctx context.Context, tx *sql.Tx, shadowName string) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf(rebuildSequencesStmtFormat, h.sequenceTable), database.StringArray{h.ProjectionName, shadowName})
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "CRDB-Fk2ps", "unable to lock current sequences")
+	}
+	defer rows.Close()
+
+	live := make(map[sequenceKey]uint64)
+	shadow := make(map[sequenceKey]uint64)
+	for rows.Next() {
+		var (
+			projectionName string
+			key            sequenceKey
+			sequence       uint64
+		)
+		if err = rows.Scan(&projectionName, &key.aggregateType, &key.instanceID, &sequence); err != nil {
+			return nil, errors.ThrowInternal(err, "CRDB-Bm4aX", "scan failed")
+		}
+		if projectionName == shadowName {
+			shadow[key] = sequence
+			continue
+		}
+		live[key] = sequence
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.ThrowInternal(err, "CRDB-Nf9x2", "errors in scanning rows")
+	}
+	return instancesBehind(live, shadow), nil
+}
+
+func instancesBehind(live, shadow map[sequenceKey]uint64) []string {
+	var behind []string
+	seen := make(map[string]bool)
+	for key, sequence := range live {
+		if shadow[key] >= sequence || seen[key.instanceID] {
+			continue
+		}
+		seen[key.instanceID] = true
+		behind = append(behind, key.instanceID)
+	}
+	return behind
+}
+
+// dropShadow removes the shadow tables and their state of a previous or failed rebuild
+func (h *StatementHandler) dropShadow(ctx context.Context, shadowName string) error {
+	tx, err := h.client.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.ThrowInternal(err, "CRDB-Wz3mf", "begin failed")
+	}
+	tables, err := tablesByPrefix(ctx, tx, shadowName)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		logging.OnError(rollbackErr).Debug("rollback failed")
+		return err
+	}
+	replaced, err := tablesByPrefix(ctx, tx, h.ProjectionName+replacedSuffix)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		logging.OnError(rollbackErr).Debug("rollback failed")
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return errors.ThrowInternal(err, "CRDB-P1xbd", "commit failed")
+	}
+	for table, tableType := range replaced {
+		tables[table] = tableType
+	}
+	for table, tableType := range tables {
+		if err = dropTable(ctx, h.client, table, tableType); err != nil {
+			return err
+		}
+	}
+	for _, table := range []string{h.sequenceTable, h.config.FailedEventsTable, h.config.LockTable} {
+		if _, err = h.client.ExecContext(ctx, fmt.Sprintf(deleteByProjectionStmtFormat, table), shadowName); err != nil {
+			return errors.ThrowInternal(err, "CRDB-Gq0vd", "unable to remove state of shadow projection")
+		}
+	}
+	return nil
+}
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// tablesByPrefix returns the tables and views (including the suffixed tables) of the given projection name
+func tablesByPrefix(ctx context.Context, tx queryer, projectionName string) (map[string]string, error) {
+	schema, name := splitTableName(projectionName)
+	rows, err := tx.QueryContext(ctx, tablesByPrefixStmt, schema, name+"%")
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "CRDB-Vd3ae", "unable to query tables")
+	}
+	defer rows.Close()
+
+	tables := make(map[string]string)
+	for rows.Next() {
+		var tableName, tableType string
+		if err = rows.Scan(&tableName, &tableType); err != nil {
+			return nil, errors.ThrowInternal(err, "CRDB-aP3sq", "scan failed")
+		}
+		// the underscore of the name is a wildcard in the like clause
+		if !strings.HasPrefix(tableName, name) {
+			continue
+		}
+		tables[schema+"."+tableName] = tableType
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.ThrowInternal(err, "CRDB-Qw3Pz", "errors in scanning rows")
+	}
+	return tables, nil
+}
+
+// renameTable renames the table and its indexes, which are prefixed with the table name
+func (h *StatementHandler) renameTable(ctx context.Context, tx *sql.Tx, from, to, tableType string) error {
+	schema, fromName := splitTableName(from)
+	_, toName := splitTableName(to)
+	indexes, err := indexesOfTable(ctx, tx, schema, fromName)
+	if err != nil {
+		return err
+	}
+	for _, index := range indexes {
+		if !strings.HasPrefix(index, fromName+"_") {
+			continue
+		}
+		stmt := fmt.Sprintf("ALTER INDEX %s.%s RENAME TO %s", schema, index, toName+strings.TrimPrefix(index, fromName))
+		if h.client.Type() == "cockroach" {
+			stmt = fmt.Sprintf("ALTER INDEX %s@%s RENAME TO %s", from, index, toName+strings.TrimPrefix(index, fromName))
+		}
+		if _, err = tx.ExecContext(ctx, stmt); err != nil {
+			return errors.ThrowInternal(err, "CRDB-M2nfs", "unable to rename index")
+		}
+	}
+	stmt := fmt.Sprintf("ALTER %s %s RENAME TO %s", relationKind(tableType), from, toName)
+	if h.client.Type() == "cockroach" {
+		stmt = fmt.Sprintf("ALTER %s %s RENAME TO %s", relationKind(tableType), from, to)
+	}
+	if _, err = tx.ExecContext(ctx, stmt); err != nil {
+		return errors.ThrowInternal(err, "CRDB-x0Dwe", "unable to rename table")
+	}
+	return nil
+}
+
+func indexesOfTable(ctx context.Context, tx queryer, schema, table string) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, indexesOfTableStmt, schema, table)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "CRDB-Tq2bn", "unable to query indexes")
+	}
+	defer rows.Close()
+
+	var indexes []string
+	for rows.Next() {
+		var index string
+		if err = rows.Scan(&index); err != nil {
+			return nil, errors.ThrowInternal(err, "CRDB-m3Gsx", "scan failed")
+		}
+		indexes = append(indexes, index)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.ThrowInternal(err, "CRDB-Ks8dn", "errors in scanning rows")
+	}
+	return indexes, nil
+}
+
+func dropTable(ctx context.Context, ex execer, table, tableType string) error {
+	_, err := ex.ExecContext(ctx, fmt.Sprintf("DROP %s IF EXISTS %s CASCADE", relationKind(tableType), table))
+	if err != nil {
+		return errors.ThrowInternal(err, "CRDB-Rk4vl", "unable to drop table")
+	}
+	return nil
+}
+
+func relationKind(tableType string) string {
+	if tableType == "VIEW" {
+		return "VIEW"
+	}
+	return "TABLE"
+}
+
+func splitTableName(name string) (schema, table string) {
+	i := strings.LastIndex(name, ".")
+	if i < 0 {
+		return "public", name
+	}
+	return name[:i], name[i+1:]
+}
@@ -0,0 +1,95 @@
+package crdb
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func Test_instancesBehind(t *testing.T) {
+	type args struct {
+		live   map[sequenceKey]uint64
+		shadow map[sequenceKey]uint64
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "caught up",
+			args: args{
+				live: map[sequenceKey]uint64{
+					{aggregateType: "user", instanceID: "instance1"}: 5,
+					{aggregateType: "org", instanceID: "instance1"}:  3,
+				},
+				shadow: map[sequenceKey]uint64{
+					{aggregateType: "user", instanceID: "instance1"}: 5,
+					{aggregateType: "org", instanceID: "instance1"}:  4,
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "behind",
+			args: args{
+				live: map[sequenceKey]uint64{
+					{aggregateType: "user", instanceID: "instance1"}: 5,
+					{aggregateType: "org", instanceID: "instance1"}:  3,
+					{aggregateType: "user", instanceID: "instance2"}: 7,
+					{aggregateType: "user", instanceID: "instance3"}: 1,
+				},
+				shadow: map[sequenceKey]uint64{
+					{aggregateType: "user", instanceID: "instance1"}: 4,
+					{aggregateType: "org", instanceID: "instance1"}:  2,
+					{aggregateType: "user", instanceID: "instance2"}: 7,
+				},
+			},
+			want: []string{"instance1", "instance3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := instancesBehind(tt.args.live, tt.args.shadow)
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("instancesBehind() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_tablesByPrefix(t *testing.T) {
+	client, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	mock.ExpectQuery(`SELECT table_name, table_type FROM information_schema.tables WHERE table_schema = \$1 AND table_name LIKE \$2`).
+		WithArgs("projections", "users_rebuild%").
+		WillReturnRows(
+			sqlmock.NewRows([]string{"table_name", "table_type"}).
+				AddRow("users_rebuild", "BASE TABLE").
+				AddRow("users_rebuild_humans", "BASE TABLE").
+				AddRow("usersXrebuild", "BASE TABLE"),
+		)
+
+	got, err := tablesByPrefix(context.Background(), client, "projections.users_rebuild")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]string{
+		"projections.users_rebuild":        "BASE TABLE",
+		"projections.users_rebuild_humans": "BASE TABLE",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("tablesByPrefix() = %v, want %v", got, want)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations not met: %v", err)
+	}
+}
@@ -255,6 +255,16 @@ func NewArrayRemoveCol(column string, value interface{}) handler.Column {
 	}
 }
 
+func NewIncrementCol(column string, value interface{}) handler.Column {
+	return handler.Column{
+		Name:  column,
+		Value: value,
+		ParameterOpt: func(placeholder string) string {
+			return column + " + " + placeholder
+		},
+	}
+}
+
 func NewArrayIntersectCol(column string, value interface{}) handler.Column {
 	var arrayType string
 	switch value.(type) {
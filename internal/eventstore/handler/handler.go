@@ -8,20 +8,24 @@ type HandlerConfig struct {
 	Eventstore *eventstore.Eventstore
 }
 type Handler struct {
-	Eventstore *eventstore.Eventstore
-	Sub        *eventstore.Subscription
-	EventQueue chan eventstore.Event
+	Eventstore        *eventstore.Eventstore
+	Sub               *eventstore.Subscription
+	EventQueue        chan eventstore.Event
+	NotificationSub   *eventstore.NotificationSubscription
+	NotificationQueue chan *eventstore.Notification
 }
 
 func NewHandler(config HandlerConfig) Handler {
 	return Handler{
-		Eventstore: config.Eventstore,
-		EventQueue: make(chan eventstore.Event, 100),
+		Eventstore:        config.Eventstore,
+		EventQueue:        make(chan eventstore.Event, 100),
+		NotificationQueue: make(chan *eventstore.Notification, 100),
 	}
 }
 
 func (h *Handler) Subscribe(aggregates ...eventstore.AggregateType) {
 	h.Sub = eventstore.SubscribeAggregates(h.EventQueue, aggregates...)
+	h.NotificationSub = eventstore.SubscribeNotifications(h.NotificationQueue, aggregates...)
 }
 
 func (h *Handler) SubscribeEvents(types map[eventstore.AggregateType][]eventstore.EventType) {
@@ -29,6 +33,9 @@ func (h *Handler) SubscribeEvents(types map[eventstore.AggregateType][]eventstor
 }
 
 func (h *Handler) Unsubscribe() {
+	if h.NotificationSub != nil {
+		h.NotificationSub.Unsubscribe()
+	}
 	if h.Sub == nil {
 		return
 	}
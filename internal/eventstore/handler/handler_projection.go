@@ -102,9 +102,14 @@ func NewProjectionHandler(
 	}
 
 	go func() {
-		<-initialized
+		select {
+		case <-initialized:
+		case <-ctx.Done():
+			return
+		}
 		if !h.reduceScheduledPseudoEvent {
 			go h.subscribe(ctx)
+			go h.listen(ctx)
 		}
 		go h.schedule(ctx)
 	}()
@@ -233,6 +238,23 @@ func (h *ProjectionHandler) subscribe(ctx context.Context) {
 	}
 }
 
+// listen triggers the projection for the instances of the events pushed by other ZITADEL processes
+func (h *ProjectionHandler) listen(ctx context.Context) {
+	defer func() {
+		err := recover()
+		if err != nil {
+			logging.WithFields("projection", h.ProjectionName, "cause", err, "stack", string(debug.Stack())).Error("listen panicked")
+		}
+	}()
+	for firstNotification := range h.NotificationQueue {
+		instances := checkAdditionalNotifications(h.NotificationQueue, firstNotification)
+		if len(instances) == 0 {
+			continue
+		}
+		h.Trigger(ctx, instances...)
+	}
+}
+
 func (h *ProjectionHandler) schedule(ctx context.Context) {
 	ctx, cancel := context.WithCancel(ctx)
 	defer func() {
@@ -394,3 +416,21 @@ func checkAdditionalEvents(eventQueue chan eventstore.Event, event eventstore.Ev
 		}
 	}
 }
+
+// checkAdditionalNotifications returns the distinct instances of all queued notifications
+func checkAdditionalNotifications(queue chan *eventstore.Notification, notification *eventstore.Notification) []string {
+	instances := make([]string, 0, 1)
+	seen := make(map[string]bool)
+	for {
+		// events of the system (empty instance) are not projected
+		if notification.InstanceID != "" && !seen[notification.InstanceID] {
+			seen[notification.InstanceID] = true
+			instances = append(instances, notification.InstanceID)
+		}
+		select {
+		case notification = <-queue:
+		default:
+			return instances
+		}
+	}
+}
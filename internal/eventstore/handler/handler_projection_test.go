@@ -1061,6 +1061,49 @@ func Test_cancelOnErr(t *testing.T) {
 	}
 }
 
+func Test_checkAdditionalNotifications(t *testing.T) {
+	tests := []struct {
+		name   string
+		first  *eventstore.Notification
+		queued []*eventstore.Notification
+		want   []string
+	}{
+		{
+			name:  "single notification",
+			first: &eventstore.Notification{InstanceID: "instance1"},
+			want:  []string{"instance1"},
+		},
+		{
+			name:  "distinct instances of queued notifications",
+			first: &eventstore.Notification{InstanceID: "instance1"},
+			queued: []*eventstore.Notification{
+				{InstanceID: "instance2"},
+				{InstanceID: "instance1"},
+				{InstanceID: "instance3"},
+			},
+			want: []string{"instance1", "instance2", "instance3"},
+		},
+		{
+			name:  "system events ignored",
+			first: &eventstore.Notification{InstanceID: ""},
+			queued: []*eventstore.Notification{
+				{InstanceID: ""},
+			},
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := make(chan *eventstore.Notification, len(tt.queued))
+			for _, notification := range tt.queued {
+				queue <- notification
+			}
+			assert.Equal(t, tt.want, checkAdditionalNotifications(queue, tt.first))
+			assert.Len(t, queue, 0)
+		})
+	}
+}
+
 func newTestStatement(aggType eventstore.AggregateType, seq, previousSeq uint64) *Statement {
 	return &Statement{
 		AggregateType:    aggType,
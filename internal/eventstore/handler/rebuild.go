@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	rebuildStarted   = eventstore.EventType("system.projections.rebuild.started")
+	rebuildSucceeded = eventstore.EventType("system.projections.rebuild.succeeded")
+	rebuildFailed    = eventstore.EventType("system.projections.rebuild.failed")
+	rebuildAborted   = eventstore.EventType("system.projections.rebuild.aborted")
+)
+
+type RebuildState int32
+
+const (
+	RebuildStateUnspecified RebuildState = iota
+	RebuildStateRunning
+	RebuildStateSucceeded
+	RebuildStateFailed
+	RebuildStateAborted
+)
+
+// Rebuild is the state of the latest rebuild of a projection
+type Rebuild struct {
+	ID             string
+	ProjectionName string
+	State          RebuildState
+	Error          string
+	CreationDate   time.Time
+	ChangeDate     time.Time
+}
+
+// RebuildStatus returns the state of the latest rebuild of the projection
+// it returns a rebuild with RebuildStateUnspecified if the projection was never rebuilt
+func (h *ProjectionHandler) RebuildStatus(ctx context.Context) (*Rebuild, error) {
+	events, err := h.Eventstore.Filter(ctx, eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(aggregateType).
+		AggregateIDs(aggregateID).
+		EventTypes(rebuildStarted, rebuildSucceeded, rebuildFailed, rebuildAborted).
+		EventData(map[string]interface{}{
+			"name": h.ProjectionName,
+		}).
+		Builder(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	rebuild := &Rebuild{ProjectionName: h.ProjectionName}
+	for _, event := range events {
+		e := new(ProjectionRebuildEvent)
+		if err = json.Unmarshal(event.DataAsBytes(), e); err != nil {
+			return nil, errors.ThrowInternal(err, "HANDL-Wq2vb", "unable to unmarshal rebuild event")
+		}
+		if event.Type() == rebuildStarted {
+			rebuild.ID = e.RebuildID
+			rebuild.CreationDate = event.CreationDate()
+			rebuild.Error = ""
+		}
+		if e.RebuildID != rebuild.ID {
+			continue
+		}
+		rebuild.ChangeDate = event.CreationDate()
+		switch event.Type() {
+		case rebuildStarted:
+			rebuild.State = RebuildStateRunning
+		case rebuildSucceeded:
+			rebuild.State = RebuildStateSucceeded
+		case rebuildFailed:
+			rebuild.State = RebuildStateFailed
+			rebuild.Error = e.Error
+		case rebuildAborted:
+			rebuild.State = RebuildStateAborted
+		}
+	}
+	return rebuild, nil
+}
+
+// Name returns the name of the projection
+func (h *ProjectionHandler) Name() string {
+	return h.ProjectionName
+}
+
+// StartRebuild marks a new rebuild of the projection as running
+// it fails if another rebuild of the projection is still running
+// or the projection does not reduce events from the eventstore
+func (h *ProjectionHandler) StartRebuild(ctx context.Context, rebuildID string) error {
+	if h.reduceScheduledPseudoEvent {
+		return errors.ThrowPreconditionFailed(nil, "HANDL-Nc2mx", "Errors.Projection.RebuildNotSupported")
+	}
+	rebuild, err := h.RebuildStatus(ctx)
+	if err != nil {
+		return err
+	}
+	if rebuild.State == RebuildStateRunning {
+		return errors.ThrowPreconditionFailed(nil, "HANDL-s8Gv2", "Errors.Projection.RebuildRunning")
+	}
+	return h.pushRebuildEvent(ctx, rebuildStarted, rebuildID, nil)
+}
+
+// AbortRebuild marks the running rebuild of the projection as aborted
+// the rebuild stops and removes its shadow tables as soon as it notices the abortion
+func (h *ProjectionHandler) AbortRebuild(ctx context.Context) error {
+	rebuild, err := h.RebuildStatus(ctx)
+	if err != nil {
+		return err
+	}
+	if rebuild.State != RebuildStateRunning {
+		return errors.ThrowPreconditionFailed(nil, "HANDL-Pv0sx", "Errors.Projection.RebuildNotRunning")
+	}
+	return h.pushRebuildEvent(ctx, rebuildAborted, rebuild.ID, nil)
+}
+
+// IsRebuildAborted checks if the rebuild with the given id is no longer running
+func (h *ProjectionHandler) IsRebuildAborted(ctx context.Context, rebuildID string) (bool, error) {
+	rebuild, err := h.RebuildStatus(ctx)
+	if err != nil {
+		return false, err
+	}
+	return rebuild.ID != rebuildID || rebuild.State != RebuildStateRunning, nil
+}
+
+// EndRebuild marks the rebuild as succeeded or failed if an error is provided
+func (h *ProjectionHandler) EndRebuild(ctx context.Context, rebuildID string, rebuildErr error) error {
+	if rebuildErr != nil {
+		return h.pushRebuildEvent(ctx, rebuildFailed, rebuildID, rebuildErr)
+	}
+	return h.pushRebuildEvent(ctx, rebuildSucceeded, rebuildID, nil)
+}
+
+func (h *ProjectionHandler) pushRebuildEvent(ctx context.Context, typ eventstore.EventType, rebuildID string, rebuildErr error) error {
+	event := &ProjectionRebuildEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx,
+			eventstore.NewAggregate(ctx, aggregateID, aggregateType, "v1"),
+			typ,
+		),
+		Name:      h.ProjectionName,
+		RebuildID: rebuildID,
+	}
+	if rebuildErr != nil {
+		event.Error = rebuildErr.Error()
+	}
+	_, err := h.Eventstore.Push(ctx, event)
+	return err
+}
+
+type ProjectionRebuildEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	Name                 string `json:"name"`
+	RebuildID            string `json:"rebuildID"`
+	Error                string `json:"error,omitempty"`
+}
+
+func (p *ProjectionRebuildEvent) Data() interface{} {
+	return p
+}
+
+func (p *ProjectionRebuildEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
@@ -0,0 +1,114 @@
+package eventstore
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/database"
+	z_sql "github.com/zitadel/zitadel/internal/eventstore/repository/sql"
+)
+
+const (
+	// listenRetryAfter is the delay before a failed listener reconnects
+	listenRetryAfter = 5 * time.Second
+)
+
+var (
+	notificationSubscriptions = map[AggregateType][]*NotificationSubscription{}
+	notificationSubsMutex     sync.Mutex
+)
+
+// Notification informs about events of the aggregate types pushed to an instance by any ZITADEL process
+type Notification struct {
+	InstanceID     string
+	AggregateTypes []AggregateType
+}
+
+type NotificationSubscription struct {
+	Notifications chan *Notification
+	aggregates    []AggregateType
+}
+
+// SubscribeNotifications subscribes for notifications of pushed events on the given aggregates.
+// Notifications are only sent if the eventstore is configured to listen for notifications
+// and are dropped if the queue is full.
+func SubscribeNotifications(queue chan *Notification, aggregates ...AggregateType) *NotificationSubscription {
+	sub := &NotificationSubscription{
+		Notifications: queue,
+		aggregates:    aggregates,
+	}
+
+	notificationSubsMutex.Lock()
+	defer notificationSubsMutex.Unlock()
+
+	for _, aggregate := range aggregates {
+		notificationSubscriptions[aggregate] = append(notificationSubscriptions[aggregate], sub)
+	}
+	return sub
+}
+
+func (s *NotificationSubscription) Unsubscribe() {
+	notificationSubsMutex.Lock()
+	defer notificationSubsMutex.Unlock()
+	for _, aggregate := range s.aggregates {
+		subs := notificationSubscriptions[aggregate]
+		for i := len(subs) - 1; i >= 0; i-- {
+			if subs[i] == s {
+				subs = append(subs[:i], subs[i+1:]...)
+			}
+		}
+		notificationSubscriptions[aggregate] = subs
+	}
+}
+
+func notifySubscriptions(notification *z_sql.Notification) {
+	notificationSubsMutex.Lock()
+	defer notificationSubsMutex.Unlock()
+
+	notified := make(map[*NotificationSubscription]bool)
+	for _, aggregateType := range notification.AggregateTypes {
+		for _, sub := range notificationSubscriptions[AggregateType(aggregateType)] {
+			if notified[sub] {
+				continue
+			}
+			notified[sub] = true
+			select {
+			case sub.Notifications <- mapNotification(notification):
+			default:
+				// the subscriber is still busy and will fetch all new events anyway
+			}
+		}
+	}
+}
+
+func mapNotification(notification *z_sql.Notification) *Notification {
+	aggregateTypes := make([]AggregateType, len(notification.AggregateTypes))
+	for i, aggregateType := range notification.AggregateTypes {
+		aggregateTypes[i] = AggregateType(aggregateType)
+	}
+	return &Notification{
+		InstanceID:     notification.InstanceID,
+		AggregateTypes: aggregateTypes,
+	}
+}
+
+// listen receives the notifications of pushed events until ctx is done.
+// If the listener fails, it reconnects after listenRetryAfter,
+// in the meantime the projections are still triggered by their schedule.
+func listen(ctx context.Context, client *database.DB) {
+	for {
+		err := z_sql.Listen(ctx, client, notifySubscriptions)
+		if ctx.Err() != nil {
+			return
+		}
+		logging.WithError(err).Warn("listening for event notifications failed")
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(listenRetryAfter):
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package eventstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	z_sql "github.com/zitadel/zitadel/internal/eventstore/repository/sql"
+)
+
+func Test_notifySubscriptions(t *testing.T) {
+	userQueue := make(chan *Notification, 1)
+	userSub := SubscribeNotifications(userQueue, "user", "org")
+	defer userSub.Unsubscribe()
+	projectQueue := make(chan *Notification, 1)
+	projectSub := SubscribeNotifications(projectQueue, "project")
+	defer projectSub.Unsubscribe()
+
+	notifySubscriptions(&z_sql.Notification{
+		InstanceID:     "instance",
+		AggregateTypes: []repository.AggregateType{"user", "org"},
+	})
+	// a full queue must not block
+	notifySubscriptions(&z_sql.Notification{
+		InstanceID:     "instance2",
+		AggregateTypes: []repository.AggregateType{"org"},
+	})
+
+	assert.Len(t, userQueue, 1)
+	assert.Equal(t, &Notification{InstanceID: "instance", AggregateTypes: []AggregateType{"user", "org"}}, <-userQueue)
+	assert.Len(t, projectQueue, 0)
+
+	userSub.Unsubscribe()
+	notifySubscriptions(&z_sql.Notification{
+		InstanceID:     "instance",
+		AggregateTypes: []repository.AggregateType{"user"},
+	})
+	assert.Len(t, userQueue, 0)
+}
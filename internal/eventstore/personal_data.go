@@ -0,0 +1,365 @@
+package eventstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+// personalDataPrefix marks the encrypted values in the event payload,
+// values without the prefix were pushed before the encryption was enabled
+const personalDataPrefix = "zitadel.pd.v1:"
+
+// RegisterPersonalData registers the fields of the payload of the event type which contain personal data.
+// The fields are encrypted with the key of the aggregate (e.g. the user) on push
+// and decrypted on filter as long as the key was not destroyed.
+// The encrypted value is stored as string and restored to its original json type on filter,
+// so registered fields cannot be used to filter events by their data
+func (es *Eventstore) RegisterPersonalData(eventType EventType, fields ...string) *Eventstore {
+	es.interceptorMutex.Lock()
+	defer es.interceptorMutex.Unlock()
+
+	interceptor := es.eventInterceptors[eventType]
+	interceptor.personalDataFields = fields
+	es.eventInterceptors[eventType] = interceptor
+
+	return es
+}
+
+// RegisterPersonalDataErasure registers the event type which erases the personal data of its aggregate.
+// The key of the aggregate is destroyed in the transaction pushing the event,
+// so that the personal data in its events cannot be decrypted anymore
+func (es *Eventstore) RegisterPersonalDataErasure(eventType EventType) *Eventstore {
+	es.interceptorMutex.Lock()
+	defer es.interceptorMutex.Unlock()
+
+	interceptor := es.eventInterceptors[eventType]
+	interceptor.erasesPersonalData = true
+	es.eventInterceptors[eventType] = interceptor
+
+	return es
+}
+
+func (es *Eventstore) personalDataRepository() (repository.PersonalDataRepository, bool) {
+	if es.personalDataEncryption == nil {
+		return nil, false
+	}
+	repo, ok := es.repo.(repository.PersonalDataRepository)
+	return repo, ok
+}
+
+func (es *Eventstore) personalDataFields(eventType repository.EventType) []string {
+	es.interceptorMutex.Lock()
+	defer es.interceptorMutex.Unlock()
+	return es.eventInterceptors[EventType(eventType)].personalDataFields
+}
+
+// push pushes the events and destroys the keys of the aggregates erasing their personal data
+func (es *Eventstore) push(ctx context.Context, events []*repository.Event, constraints []*repository.UniqueConstraint) error {
+	subjects := es.personalDataErasures(events)
+	repo, ok := es.repo.(repository.PersonalDataRepository)
+	if len(subjects) == 0 || !ok {
+		return es.repo.Push(ctx, events, constraints...)
+	}
+	return repo.PushAndDestroyPersonalData(ctx, events, subjects, constraints...)
+}
+
+func (es *Eventstore) personalDataErasures(events []*repository.Event) []*repository.PersonalDataSubject {
+	es.interceptorMutex.Lock()
+	defer es.interceptorMutex.Unlock()
+
+	var subjects []*repository.PersonalDataSubject
+	for _, event := range events {
+		if !es.eventInterceptors[EventType(event.Type)].erasesPersonalData {
+			continue
+		}
+		subjects = append(subjects, &repository.PersonalDataSubject{
+			InstanceID: event.InstanceID,
+			SubjectID:  event.AggregateID,
+		})
+	}
+	return subjects
+}
+
+// encryptPersonalData replaces the personal data in the payload of the events by the encrypted values.
+// The personal data of aggregates whose key was destroyed is removed.
+// It returns the plain payloads, which replace the encrypted ones after the push
+func (es *Eventstore) encryptPersonalData(ctx context.Context, events []*repository.Event) ([][]byte, error) {
+	repo, ok := es.personalDataRepository()
+	if !ok {
+		return nil, nil
+	}
+	plain := make([][]byte, len(events))
+	keys := make(map[repository.PersonalDataSubject]string)
+	for i, event := range events {
+		fields := es.personalDataFields(event.Type)
+		if len(fields) == 0 || len(event.Data) == 0 {
+			continue
+		}
+		subject := repository.PersonalDataSubject{InstanceID: event.InstanceID, SubjectID: event.AggregateID}
+		key, ok := keys[subject]
+		if !ok {
+			var err error
+			key, err = es.ensurePersonalDataKey(ctx, repo, &subject)
+			if err != nil {
+				return nil, err
+			}
+			keys[subject] = key
+		}
+		data, err := transformPersonalData(event.Data, fields, func(value json.RawMessage) (json.RawMessage, error) {
+			if key == "" {
+				return nil, nil
+			}
+			encrypted, err := crypto.EncryptAES(value, key)
+			if err != nil {
+				return nil, errors.ThrowInternal(err, "V2-Pd2en", "unable to encrypt personal data")
+			}
+			return json.Marshal(personalDataPrefix + base64.RawURLEncoding.EncodeToString(encrypted))
+		})
+		if err != nil {
+			return nil, err
+		}
+		plain[i] = event.Data
+		event.Data = data
+	}
+	return plain, nil
+}
+
+func restorePersonalData(events []*repository.Event, plain [][]byte) {
+	for i, data := range plain {
+		if data != nil {
+			events[i].Data = data
+		}
+	}
+}
+
+// ensurePersonalDataKey returns the key of the subject and creates it if the subject has none.
+// It returns an empty key if the key of the subject was destroyed
+func (es *Eventstore) ensurePersonalDataKey(ctx context.Context, repo repository.PersonalDataRepository, subject *repository.PersonalDataSubject) (string, error) {
+	value := make([]byte, 32)
+	if _, err := rand.Read(value); err != nil {
+		return "", errors.ThrowInternal(err, "V2-Pd3ky", "unable to generate personal data key")
+	}
+	encrypted, err := crypto.Encrypt(value, es.personalDataEncryption)
+	if err != nil {
+		return "", err
+	}
+	key, err := json.Marshal(encrypted)
+	if err != nil {
+		return "", errors.ThrowInternal(err, "V2-Pd4ky", "unable to marshal personal data key")
+	}
+	stored, err := repo.EnsurePersonalDataKey(ctx, subject, key)
+	if err != nil {
+		return "", err
+	}
+	return es.decryptPersonalDataKey(stored)
+}
+
+func (es *Eventstore) decryptPersonalDataKey(key []byte) (string, error) {
+	if key == nil {
+		return "", nil
+	}
+	encrypted := new(crypto.CryptoValue)
+	if err := json.Unmarshal(key, encrypted); err != nil {
+		return "", errors.ThrowInternal(err, "V2-Pd5ky", "unable to unmarshal personal data key")
+	}
+	return crypto.DecryptString(encrypted, es.personalDataEncryption)
+}
+
+// PersonalDataKeysReencryption reports the re-encryption of the personal data keys of an instance.
+// As long as Outdated is greater than Reencrypted, the key ids in OutdatedKeyIDs are still in use.
+type PersonalDataKeysReencryption struct {
+	Total       int
+	Outdated    int
+	Reencrypted int
+	// Failed is the amount of outdated keys, which could not be decrypted
+	Failed int
+	// Skipped is the amount of outdated keys, which were re-encrypted or destroyed concurrently
+	Skipped        int
+	OutdatedKeyIDs map[string]int
+}
+
+// ReencryptPersonalDataKeys re-encrypts the personal data keys of the instance,
+// which are not encrypted with the current encryption key of the personal data encryption.
+// If dryRun is set, the outdated keys are only counted.
+func (es *Eventstore) ReencryptPersonalDataKeys(ctx context.Context, instanceID string, dryRun bool) (*PersonalDataKeysReencryption, error) {
+	progress := &PersonalDataKeysReencryption{OutdatedKeyIDs: make(map[string]int)}
+	repo, ok := es.personalDataRepository()
+	if !ok {
+		return progress, nil
+	}
+	keys, err := repo.InstancePersonalDataKeys(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	for subjectID, key := range keys {
+		encrypted := new(crypto.CryptoValue)
+		if err := json.Unmarshal(key, encrypted); err != nil {
+			return nil, errors.ThrowInternal(err, "V2-Pd8re", "unable to unmarshal personal data key")
+		}
+		progress.Total++
+		if encrypted.KeyID == es.personalDataEncryption.EncryptionKeyID() {
+			continue
+		}
+		progress.Outdated++
+		progress.OutdatedKeyIDs[encrypted.KeyID]++
+		if dryRun {
+			continue
+		}
+		reencrypted, err := es.reencryptPersonalDataKey(encrypted)
+		if err != nil {
+			logging.WithFields("instance", instanceID, "subject", subjectID, "keyID", encrypted.KeyID).WithError(err).Warn("unable to re-encrypt personal data key")
+			progress.Failed++
+			continue
+		}
+		replaced, err := repo.ReplacePersonalDataKey(ctx, &repository.PersonalDataSubject{InstanceID: instanceID, SubjectID: subjectID}, key, reencrypted)
+		if err != nil {
+			return nil, err
+		}
+		if !replaced {
+			progress.Skipped++
+			continue
+		}
+		progress.Reencrypted++
+	}
+	return progress, nil
+}
+
+func (es *Eventstore) reencryptPersonalDataKey(encrypted *crypto.CryptoValue) ([]byte, error) {
+	value, err := crypto.Decrypt(encrypted, es.personalDataEncryption)
+	if err != nil {
+		return nil, err
+	}
+	reencrypted, err := crypto.Encrypt(value, es.personalDataEncryption)
+	if err != nil {
+		return nil, err
+	}
+	key, err := json.Marshal(reencrypted)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "V2-Pd9re", "unable to marshal personal data key")
+	}
+	return key, nil
+}
+
+// DecryptPersonalData replaces the encrypted personal data in the payload of the events by the plain values.
+// The personal data of aggregates whose key was destroyed is removed from the payload
+func (es *Eventstore) DecryptPersonalData(ctx context.Context, events []*repository.Event) error {
+	repo, ok := es.personalDataRepository()
+	if !ok {
+		return nil
+	}
+	subjects := make(map[string]map[string]struct{})
+	for _, event := range events {
+		if !bytes.Contains(event.Data, []byte(personalDataPrefix)) || len(es.personalDataFields(event.Type)) == 0 {
+			continue
+		}
+		if subjects[event.InstanceID] == nil {
+			subjects[event.InstanceID] = make(map[string]struct{})
+		}
+		subjects[event.InstanceID][event.AggregateID] = struct{}{}
+	}
+	if len(subjects) == 0 {
+		return nil
+	}
+	keys, err := es.personalDataKeys(ctx, repo, subjects)
+	if err != nil {
+		return err
+	}
+	for _, event := range events {
+		fields := es.personalDataFields(event.Type)
+		if len(fields) == 0 || !bytes.Contains(event.Data, []byte(personalDataPrefix)) {
+			continue
+		}
+		key := keys[repository.PersonalDataSubject{InstanceID: event.InstanceID, SubjectID: event.AggregateID}]
+		event.Data, err = transformPersonalData(event.Data, fields, func(value json.RawMessage) (json.RawMessage, error) {
+			var encoded string
+			if err := json.Unmarshal(value, &encoded); err != nil || !strings.HasPrefix(encoded, personalDataPrefix) {
+				return value, nil
+			}
+			if key == "" {
+				return nil, nil
+			}
+			encrypted, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(encoded, personalDataPrefix))
+			if err != nil {
+				return nil, errors.ThrowInternal(err, "V2-Pd6de", "unable to decode personal data")
+			}
+			decrypted, err := crypto.DecryptAES(encrypted, key)
+			if err != nil {
+				return nil, errors.ThrowInternal(err, "V2-Pd7de", "unable to decrypt personal data")
+			}
+			return decrypted, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// personalDataKeys returns the decrypted keys of the subjects grouped by instance,
+// destroyed keys are missing
+func (es *Eventstore) personalDataKeys(ctx context.Context, repo repository.PersonalDataRepository, subjects map[string]map[string]struct{}) (map[repository.PersonalDataSubject]string, error) {
+	keys := make(map[repository.PersonalDataSubject]string)
+	for instanceID, subjectIDs := range subjects {
+		ids := make([]string, 0, len(subjectIDs))
+		for id := range subjectIDs {
+			ids = append(ids, id)
+		}
+		encryptedKeys, err := repo.PersonalDataKeys(ctx, instanceID, ids)
+		if err != nil {
+			return nil, err
+		}
+		for subjectID, encryptedKey := range encryptedKeys {
+			key, err := es.decryptPersonalDataKey(encryptedKey)
+			if err != nil {
+				return nil, err
+			}
+			if key != "" {
+				keys[repository.PersonalDataSubject{InstanceID: instanceID, SubjectID: subjectID}] = key
+			}
+		}
+	}
+	return keys, nil
+}
+
+// transformPersonalData replaces the values of the fields in the json payload by the transformed values
+// fields are removed from the payload if the transformed value is nil
+func transformPersonalData(data []byte, fields []string, transform func(json.RawMessage) (json.RawMessage, error)) ([]byte, error) {
+	payload := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, errors.ThrowInternal(err, "V2-Pd1tr", "unable to unmarshal event data")
+	}
+	var changed bool
+	for _, field := range fields {
+		value, ok := payload[field]
+		if !ok || bytes.Equal(value, []byte("null")) {
+			continue
+		}
+		transformed, err := transform(value)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(transformed, value) {
+			continue
+		}
+		changed = true
+		if transformed == nil {
+			delete(payload, field)
+			continue
+		}
+		payload[field] = transformed
+	}
+	if !changed {
+		return data, nil
+	}
+	return json.Marshal(payload)
+}
@@ -0,0 +1,232 @@
+package eventstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+type personalDataTestRepo struct {
+	testRepo
+	keys      map[repository.PersonalDataSubject][]byte
+	destroyed []*repository.PersonalDataSubject
+}
+
+func (repo *personalDataTestRepo) Push(_ context.Context, events []*repository.Event, _ ...*repository.UniqueConstraint) error {
+	for _, event := range events {
+		stored := *event
+		stored.Data = append([]byte(nil), event.Data...)
+		repo.events = append(repo.events, &stored)
+	}
+	return nil
+}
+
+func (repo *personalDataTestRepo) Filter(context.Context, *repository.SearchQuery) ([]*repository.Event, error) {
+	events := make([]*repository.Event, len(repo.events))
+	for i, event := range repo.events {
+		filtered := *event
+		filtered.Data = append([]byte(nil), event.Data...)
+		events[i] = &filtered
+	}
+	return events, nil
+}
+
+func (repo *personalDataTestRepo) PersonalDataKeys(_ context.Context, instanceID string, subjectIDs []string) (map[string][]byte, error) {
+	keys := make(map[string][]byte)
+	for _, subjectID := range subjectIDs {
+		if key, ok := repo.keys[repository.PersonalDataSubject{InstanceID: instanceID, SubjectID: subjectID}]; ok {
+			keys[subjectID] = key
+		}
+	}
+	return keys, nil
+}
+
+func (repo *personalDataTestRepo) EnsurePersonalDataKey(_ context.Context, subject *repository.PersonalDataSubject, key []byte) ([]byte, error) {
+	if stored, ok := repo.keys[*subject]; ok {
+		return stored, nil
+	}
+	repo.keys[*subject] = key
+	return key, nil
+}
+
+func (repo *personalDataTestRepo) InstancePersonalDataKeys(_ context.Context, instanceID string) (map[string][]byte, error) {
+	keys := make(map[string][]byte)
+	for subject, key := range repo.keys {
+		if subject.InstanceID == instanceID && key != nil {
+			keys[subject.SubjectID] = key
+		}
+	}
+	return keys, nil
+}
+
+func (repo *personalDataTestRepo) ReplacePersonalDataKey(_ context.Context, subject *repository.PersonalDataSubject, oldKey, newKey []byte) (bool, error) {
+	if !bytes.Equal(repo.keys[*subject], oldKey) {
+		return false, nil
+	}
+	repo.keys[*subject] = newKey
+	return true, nil
+}
+
+func (repo *personalDataTestRepo) PushAndDestroyPersonalData(ctx context.Context, events []*repository.Event, subjects []*repository.PersonalDataSubject, constraints ...*repository.UniqueConstraint) error {
+	for _, subject := range subjects {
+		repo.keys[*subject] = nil
+	}
+	repo.destroyed = append(repo.destroyed, subjects...)
+	return repo.Push(ctx, events, constraints...)
+}
+
+func newPersonalDataTestEvent(typ EventType, data map[string]interface{}) *testEvent {
+	payload, _ := json.Marshal(data)
+	event := newTestEvent("user1", "", func() interface{} { return payload }, false)
+	event.EventType = typ
+	return event
+}
+
+func personalDataPayload(t *testing.T, data []byte) map[string]interface{} {
+	t.Helper()
+	payload := make(map[string]interface{})
+	if err := json.Unmarshal(data, &payload); err != nil {
+		t.Fatalf("unable to unmarshal payload: %v", err)
+	}
+	return payload
+}
+
+func TestEventstore_PersonalData(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance")
+	repo := &personalDataTestRepo{
+		testRepo: testRepo{t: t},
+		keys:     make(map[repository.PersonalDataSubject][]byte),
+	}
+	es := &Eventstore{
+		repo:                   repo,
+		eventInterceptors:      map[EventType]eventTypeInterceptors{},
+		personalDataEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+	}
+	es.RegisterPersonalData("test.added", "name", "nullable").
+		RegisterPersonalDataErasure("test.removed")
+
+	pushed, err := es.Push(ctx, newPersonalDataTestEvent("test.added", map[string]interface{}{
+		"name":     "hodor",
+		"nullable": nil,
+		"other":    "plain",
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error on push: %v", err)
+	}
+	if payload := personalDataPayload(t, pushed[0].DataAsBytes()); payload["name"] != "hodor" {
+		t.Errorf("pushed event must contain the plain data, got %v", payload)
+	}
+	stored := personalDataPayload(t, repo.events[0].Data)
+	if name, _ := stored["name"].(string); !strings.HasPrefix(name, personalDataPrefix) {
+		t.Errorf("stored name must be encrypted, got %v", stored["name"])
+	}
+	if stored["other"] != "plain" || stored["nullable"] != nil {
+		t.Errorf("unregistered and null fields must not be encrypted, got %v", stored)
+	}
+
+	filtered, err := es.Filter(ctx, NewSearchQueryBuilder(ColumnsEvent).AddQuery().AggregateTypes("test.aggregate").Builder())
+	if err != nil {
+		t.Fatalf("unexpected error on filter: %v", err)
+	}
+	if payload := personalDataPayload(t, filtered[0].DataAsBytes()); payload["name"] != "hodor" || payload["other"] != "plain" {
+		t.Errorf("filtered event must contain the plain data, got %v", payload)
+	}
+
+	if _, err = es.Push(ctx, newPersonalDataTestEvent("test.removed", map[string]interface{}{})); err != nil {
+		t.Fatalf("unexpected error on erasure: %v", err)
+	}
+	if len(repo.destroyed) != 1 || repo.destroyed[0].SubjectID != "user1" || repo.destroyed[0].InstanceID != "instance" {
+		t.Errorf("key of the user must be destroyed, got %v", repo.destroyed)
+	}
+
+	filtered, err = es.Filter(ctx, NewSearchQueryBuilder(ColumnsEvent).AddQuery().AggregateTypes("test.aggregate").Builder())
+	if err != nil {
+		t.Fatalf("unexpected error on filter: %v", err)
+	}
+	payload := personalDataPayload(t, filtered[0].DataAsBytes())
+	if _, ok := payload["name"]; ok || payload["other"] != "plain" {
+		t.Errorf("personal data must be removed after erasure, got %v", payload)
+	}
+
+	if _, err = es.Push(ctx, newPersonalDataTestEvent("test.added", map[string]interface{}{"name": "hodor"})); err != nil {
+		t.Fatalf("unexpected error on push: %v", err)
+	}
+	if _, ok := personalDataPayload(t, repo.events[2].Data)["name"]; ok {
+		t.Error("personal data of an erased user must not be stored")
+	}
+}
+
+func personalDataKey(t *testing.T, keyID, value string) []byte {
+	t.Helper()
+	key, err := json.Marshal(&crypto.CryptoValue{
+		CryptoType: crypto.TypeEncryption,
+		Algorithm:  "enc",
+		KeyID:      keyID,
+		Crypted:    []byte(value),
+	})
+	require.NoError(t, err)
+	return key
+}
+
+func TestEventstore_ReencryptPersonalDataKeys(t *testing.T) {
+	alg := crypto.NewMockEncryptionAlgorithm(gomock.NewController(t))
+	alg.EXPECT().Algorithm().AnyTimes().Return("enc")
+	alg.EXPECT().EncryptionKeyID().AnyTimes().Return("new")
+	alg.EXPECT().DecryptionKeyIDs().AnyTimes().Return([]string{"new", "old"})
+	alg.EXPECT().Encrypt(gomock.Any()).AnyTimes().DoAndReturn(func(value []byte) ([]byte, error) {
+		return value, nil
+	})
+	alg.EXPECT().Decrypt(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(value []byte, _ string) ([]byte, error) {
+		return value, nil
+	})
+	alg.EXPECT().DecryptString(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(value []byte, _ string) (string, error) {
+		return string(value), nil
+	})
+	repo := &personalDataTestRepo{
+		testRepo: testRepo{t: t},
+		keys: map[repository.PersonalDataSubject][]byte{
+			{InstanceID: "instance", SubjectID: "outdated"}: personalDataKey(t, "old", "outdated key"),
+			{InstanceID: "instance", SubjectID: "current"}:  personalDataKey(t, "new", "current key"),
+			{InstanceID: "instance", SubjectID: "removed"}:  personalDataKey(t, "removed", "removed key"),
+			{InstanceID: "instance", SubjectID: "erased"}:   nil,
+			{InstanceID: "other", SubjectID: "outdated"}:    personalDataKey(t, "old", "other key"),
+		},
+	}
+	es := &Eventstore{
+		repo:                   repo,
+		eventInterceptors:      map[EventType]eventTypeInterceptors{},
+		personalDataEncryption: alg,
+	}
+	ctx := context.Background()
+
+	progress, err := es.ReencryptPersonalDataKeys(ctx, "instance", true)
+	require.NoError(t, err)
+	assert.Equal(t, &PersonalDataKeysReencryption{Total: 3, Outdated: 2, OutdatedKeyIDs: map[string]int{"old": 1, "removed": 1}}, progress)
+	assert.Equal(t, personalDataKey(t, "old", "outdated key"), repo.keys[repository.PersonalDataSubject{InstanceID: "instance", SubjectID: "outdated"}])
+
+	progress, err = es.ReencryptPersonalDataKeys(ctx, "instance", false)
+	require.NoError(t, err)
+	assert.Equal(t, &PersonalDataKeysReencryption{Total: 3, Outdated: 2, Reencrypted: 1, Failed: 1, OutdatedKeyIDs: map[string]int{"old": 1, "removed": 1}}, progress)
+	assert.Equal(t, personalDataKey(t, "new", "outdated key"), repo.keys[repository.PersonalDataSubject{InstanceID: "instance", SubjectID: "outdated"}])
+	assert.Equal(t, personalDataKey(t, "old", "other key"), repo.keys[repository.PersonalDataSubject{InstanceID: "other", SubjectID: "outdated"}])
+	assert.Nil(t, repo.keys[repository.PersonalDataSubject{InstanceID: "instance", SubjectID: "erased"}])
+
+	// the re-encrypted key still decrypts the personal data
+	key, err := es.decryptPersonalDataKey(repo.keys[repository.PersonalDataSubject{InstanceID: "instance", SubjectID: "outdated"}])
+	require.NoError(t, err)
+	assert.Equal(t, "outdated key", key)
+
+	progress, err = es.ReencryptPersonalDataKeys(ctx, "instance", false)
+	require.NoError(t, err)
+	assert.Equal(t, &PersonalDataKeysReencryption{Total: 3, Outdated: 1, Failed: 1, OutdatedKeyIDs: map[string]int{"removed": 1}}, progress)
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strconv"
+	"time"
+)
+
+//Archive describes a bulk of events moved from the events to the archive
+type Archive struct {
+	ID           string
+	InstanceID   string
+	CreationDate time.Time
+	EventCount   uint64
+	MinSequence  uint64
+	MaxSequence  uint64
+	//Checksum is computed by ArchiveChecksum over the archived events
+	Checksum string
+}
+
+//ArchiveRepository moves old events into the archive
+type ArchiveRepository interface {
+	//ArchiveEvents moves at most limit events of the instance created before the given time into the archive.
+	// Only events covered by a snapshot of their aggregate and processed by all views of the auth and admin api are moved,
+	// the latest event of every aggregate always remains.
+	// It returns nil if no event was archived
+	ArchiveEvents(ctx context.Context, archiveID, instanceID string, before time.Time, limit uint64) (*Archive, error)
+	//Archives returns all archives of the instance or of all instances if instanceID is empty
+	Archives(ctx context.Context, instanceID string) ([]*Archive, error)
+	//ArchivedEvents returns the events of the archive ordered by sequence
+	ArchivedEvents(ctx context.Context, archiveID string) ([]*Event, error)
+}
+
+//ArchiveChecksum computes the checksum of the events, which must be ordered by sequence
+func ArchiveChecksum(events []*Event) string {
+	hash := sha256.New()
+	for _, event := range events {
+		for _, field := range []string{
+			event.InstanceID,
+			strconv.FormatUint(event.Sequence, 10),
+			string(event.AggregateType),
+			event.AggregateID,
+			string(event.Type),
+			event.CreationDate.UTC().Format(time.RFC3339Nano),
+			base64.StdEncoding.EncodeToString(event.Data),
+		} {
+			hash.Write([]byte(field))
+			hash.Write([]byte{0})
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+)
+
+//PersonalDataSubject identifies the owner of personal data (e.g. a user)
+type PersonalDataSubject struct {
+	InstanceID string
+	SubjectID  string
+}
+
+//PersonalDataRepository stores the keys used to encrypt the personal data in the events of a subject
+type PersonalDataRepository interface {
+	//PersonalDataKeys returns the encrypted keys of the subjects of the instance mapped by subject id.
+	// Destroyed keys are returned as nil, subjects without key are missing
+	PersonalDataKeys(ctx context.Context, instanceID string, subjectIDs []string) (map[string][]byte, error)
+	//EnsurePersonalDataKey stores the encrypted key if the subject has no key yet and returns the stored key.
+	// It returns nil if the key of the subject was destroyed
+	EnsurePersonalDataKey(ctx context.Context, subject *PersonalDataSubject, key []byte) ([]byte, error)
+	//InstancePersonalDataKeys returns the encrypted keys of all subjects of the instance mapped by subject id.
+	// Destroyed keys are not returned
+	InstancePersonalDataKeys(ctx context.Context, instanceID string) (map[string][]byte, error)
+	//ReplacePersonalDataKey replaces the encrypted key of the subject (e.g. re-encrypted with another key)
+	// if it is still the old key. It returns false if the key was changed or destroyed in the meantime
+	ReplacePersonalDataKey(ctx context.Context, subject *PersonalDataSubject, oldKey, newKey []byte) (bool, error)
+	//PushAndDestroyPersonalData pushes the events like Push
+	// and destroys the keys and snapshots of the subjects in the same transaction
+	PushAndDestroyPersonalData(ctx context.Context, events []*Event, subjects []*PersonalDataSubject, uniqueConstraints ...*UniqueConstraint) error
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+//Snapshot is the stored state of a reducer after reducing all events up to Sequence
+type Snapshot struct {
+	InstanceID string
+	//Type describes the structure of the payload (e.g. user.human.v1)
+	Type string
+	//QueryKey identifies the search query used to filter the reduced events
+	QueryKey string
+	//AggregateType and AggregateID are only set if the query filters a single aggregate
+	AggregateType AggregateType
+	AggregateID   string
+	ResourceOwner string
+	//Sequence is the sequence of the last reduced event
+	Sequence   uint64
+	ChangeDate time.Time
+	Payload    []byte
+}
+
+//SnapshotRepository stores and loads the snapshots of reducers
+type SnapshotRepository interface {
+	//Snapshot returns the snapshot of the given type and query or nil if none exists
+	Snapshot(ctx context.Context, instanceID, snapshotType, queryKey string) (*Snapshot, error)
+	//StoreSnapshot creates or replaces the snapshot
+	StoreSnapshot(ctx context.Context, snapshot *Snapshot) error
+}
@@ -0,0 +1,175 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/cockroachdb/cockroach-go/v2/crdb"
+	"github.com/lib/pq"
+
+	z_errors "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+const (
+	eventColumns = "creation_date" +
+		", event_type" +
+		", event_sequence" +
+		", previous_aggregate_sequence" +
+		", previous_aggregate_type_sequence" +
+		", event_data" +
+		", editor_service" +
+		", editor_user" +
+		", resource_owner" +
+		", instance_id" +
+		", aggregate_type" +
+		", aggregate_id" +
+		", aggregate_version"
+
+	// archivableEventsQuery selects the events which are older than the given date,
+	// covered by a snapshot of their aggregate and not the latest event of their aggregate.
+	// The latest event must remain because it is used to compute the previous sequences of new events.
+	// The views of the auth and admin api (eventstore v1) only read the events table,
+	// so only events already processed by all of their views are selected.
+	archivableEventsQuery = "SELECT " + eventColumns +
+		" FROM eventstore.events e" +
+		" WHERE e.instance_id = $1" +
+		" AND e.creation_date < $2" +
+		" AND EXISTS (SELECT 1 FROM eventstore.snapshots s" +
+		" WHERE s.instance_id = e.instance_id" +
+		" AND s.aggregate_type = e.aggregate_type" +
+		" AND s.aggregate_id = e.aggregate_id" +
+		" AND s.event_sequence >= e.event_sequence)" +
+		" AND EXISTS (SELECT 1 FROM eventstore.events n" +
+		" WHERE n.instance_id = e.instance_id" +
+		" AND n.aggregate_type = e.aggregate_type" +
+		" AND n.aggregate_id = e.aggregate_id" +
+		" AND n.event_sequence > e.event_sequence)" +
+		" AND e.event_sequence <= (SELECT COALESCE(MIN(v.current_sequence), 0) FROM (" +
+		" SELECT current_sequence FROM auth.current_sequences WHERE instance_id = $1" +
+		" UNION ALL SELECT current_sequence FROM adminapi.current_sequences WHERE instance_id = $1) v)" +
+		" ORDER BY e.event_sequence" +
+		" LIMIT $3" +
+		" FOR UPDATE"
+	archiveEventsStmt = "INSERT INTO eventstore.events_archive" +
+		" (id, event_type, aggregate_type, aggregate_id, aggregate_version, event_sequence, previous_aggregate_sequence, previous_aggregate_type_sequence," +
+		" creation_date, event_data, editor_user, editor_service, resource_owner, instance_id, created_at, archive_id)" +
+		" SELECT id, event_type, aggregate_type, aggregate_id, aggregate_version, event_sequence, previous_aggregate_sequence, previous_aggregate_type_sequence," +
+		" creation_date, event_data, editor_user, editor_service, resource_owner, instance_id, created_at, $3" +
+		" FROM eventstore.events WHERE instance_id = $1 AND event_sequence = ANY($2)"
+	deleteArchivedEventsStmt = "DELETE FROM eventstore.events WHERE instance_id = $1 AND event_sequence = ANY($2)"
+	insertArchiveStmt        = "INSERT INTO eventstore.archives" +
+		" (id, instance_id, creation_date, event_count, min_sequence, max_sequence, checksum)" +
+		" VALUES ($1, $2, $3, $4, $5, $6, $7)"
+	archivesQuery = "SELECT id, instance_id, creation_date, event_count, min_sequence, max_sequence, checksum" +
+		" FROM eventstore.archives"
+	archivedEventsQuery = "SELECT " + eventColumns +
+		" FROM eventstore.events_archive WHERE archive_id = $1 ORDER BY event_sequence"
+)
+
+var _ repository.ArchiveRepository = (*CRDB)(nil)
+
+// ArchiveEvents moves at most limit events of the instance created before the given time into the archive
+func (db *CRDB) ArchiveEvents(ctx context.Context, archiveID, instanceID string, before time.Time, limit uint64) (archive *repository.Archive, err error) {
+	err = crdb.ExecuteTx(ctx, db.DB.DB, nil, func(tx *sql.Tx) error {
+		archive = nil
+		events, err := scanEvents(tx.QueryContext(ctx, archivableEventsQuery, instanceID, before, limit))
+		if err != nil || len(events) == 0 {
+			return err
+		}
+		sequences := make([]int64, len(events))
+		for i, event := range events {
+			sequences[i] = int64(event.Sequence)
+		}
+		if _, err = tx.ExecContext(ctx, archiveEventsStmt, instanceID, pq.Array(sequences), archiveID); err != nil {
+			return z_errors.ThrowInternal(err, "SQL-Ar2cv", "unable to archive events")
+		}
+		if _, err = tx.ExecContext(ctx, deleteArchivedEventsStmt, instanceID, pq.Array(sequences)); err != nil {
+			return z_errors.ThrowInternal(err, "SQL-Ar3dl", "unable to remove archived events")
+		}
+		archive = &repository.Archive{
+			ID:           archiveID,
+			InstanceID:   instanceID,
+			CreationDate: time.Now(),
+			EventCount:   uint64(len(events)),
+			MinSequence:  events[0].Sequence,
+			MaxSequence:  events[len(events)-1].Sequence,
+			Checksum:     repository.ArchiveChecksum(events),
+		}
+		_, err = tx.ExecContext(ctx, insertArchiveStmt,
+			archive.ID,
+			archive.InstanceID,
+			archive.CreationDate,
+			archive.EventCount,
+			archive.MinSequence,
+			archive.MaxSequence,
+			archive.Checksum,
+		)
+		if err != nil {
+			return z_errors.ThrowInternal(err, "SQL-Ar4ws", "unable to store archive")
+		}
+		return nil
+	})
+	return archive, err
+}
+
+// Archives returns all archives of the instance or of all instances if instanceID is empty
+func (db *CRDB) Archives(ctx context.Context, instanceID string) ([]*repository.Archive, error) {
+	query := archivesQuery
+	args := make([]interface{}, 0, 1)
+	if instanceID != "" {
+		query += " WHERE instance_id = $1"
+		args = append(args, instanceID)
+	}
+	rows, err := db.DB.QueryContext(ctx, query+" ORDER BY instance_id, min_sequence", args...)
+	if err != nil {
+		return nil, z_errors.ThrowInternal(err, "SQL-Ar5qa", "unable to query archives")
+	}
+	defer rows.Close()
+
+	archives := make([]*repository.Archive, 0)
+	for rows.Next() {
+		archive := new(repository.Archive)
+		err = rows.Scan(
+			&archive.ID,
+			&archive.InstanceID,
+			&archive.CreationDate,
+			&archive.EventCount,
+			&archive.MinSequence,
+			&archive.MaxSequence,
+			&archive.Checksum,
+		)
+		if err != nil {
+			return nil, z_errors.ThrowInternal(err, "SQL-Ar6sc", "unable to scan archive")
+		}
+		archives = append(archives, archive)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, z_errors.ThrowInternal(err, "SQL-Ar7rw", "unable to scan archives")
+	}
+	return archives, nil
+}
+
+// ArchivedEvents returns the events of the archive ordered by sequence
+func (db *CRDB) ArchivedEvents(ctx context.Context, archiveID string) ([]*repository.Event, error) {
+	return scanEvents(db.DB.QueryContext(ctx, archivedEventsQuery, archiveID))
+}
+
+func scanEvents(rows *sql.Rows, err error) ([]*repository.Event, error) {
+	if err != nil {
+		return nil, z_errors.ThrowInternal(err, "SQL-Ar8qe", "unable to query events")
+	}
+	defer rows.Close()
+
+	events := make([]*repository.Event, 0)
+	for rows.Next() {
+		if err = eventsScanner(rows.Scan, &events); err != nil {
+			return nil, err
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return nil, z_errors.ThrowInternal(err, "SQL-Ar9sd", "unable to scan events")
+	}
+	return events, nil
+}
@@ -100,10 +100,14 @@ const (
 type CRDB struct {
 	*database.DB
 	AllowOrderByCreationDate bool
+	// Notify emits a database notification for the pushed events (postgres only)
+	Notify bool
+	// Archive includes the archived events in all queries
+	Archive bool
 }
 
-func NewCRDB(client *database.DB, allowOrderByCreationDate bool) *CRDB {
-	return &CRDB{client, allowOrderByCreationDate}
+func NewCRDB(client *database.DB, allowOrderByCreationDate, notify bool) *CRDB {
+	return &CRDB{DB: client, AllowOrderByCreationDate: allowOrderByCreationDate, Notify: notify}
 }
 
 func (db *CRDB) Health(ctx context.Context) error { return db.Ping() }
@@ -111,6 +115,10 @@ func (db *CRDB) Health(ctx context.Context) error { return db.Ping() }
 // Push adds all events to the eventstreams of the aggregates.
 // This call is transaction save. The transaction will be rolled back if one event fails
 func (db *CRDB) Push(ctx context.Context, events []*repository.Event, uniqueConstraints ...*repository.UniqueConstraint) error {
+	return db.push(ctx, events, nil, uniqueConstraints...)
+}
+
+func (db *CRDB) push(ctx context.Context, events []*repository.Event, personalDataSubjects []*repository.PersonalDataSubject, uniqueConstraints ...*repository.UniqueConstraint) error {
 	err := crdb.ExecuteTx(ctx, db.DB.DB, nil, func(tx *sql.Tx) error {
 
 		var (
@@ -149,7 +157,10 @@ func (db *CRDB) Push(ctx context.Context, events []*repository.Event, uniqueCons
 		if err != nil {
 			return err
 		}
-		return nil
+		if err = db.destroyPersonalData(ctx, tx, personalDataSubjects); err != nil {
+			return err
+		}
+		return db.notify(ctx, tx, events)
 	})
 	if err != nil && !errors.Is(err, &caos_errs.CaosError{}) {
 		err = caos_errs.ThrowInternal(err, "SQL-DjgtG", "unable to store events")
@@ -285,15 +296,22 @@ func (db *CRDB) eventQuery() string {
 		", aggregate_type" +
 		", aggregate_id" +
 		", aggregate_version" +
-		" FROM eventstore.events"
+		" FROM " + db.eventsTable()
 }
 
 func (db *CRDB) maxSequenceQuery() string {
-	return "SELECT MAX(event_sequence) FROM eventstore.events"
+	return "SELECT MAX(event_sequence) FROM " + db.eventsTable()
 }
 
 func (db *CRDB) instanceIDsQuery() string {
-	return "SELECT DISTINCT instance_id FROM eventstore.events"
+	return "SELECT DISTINCT instance_id FROM " + db.eventsTable()
+}
+
+func (db *CRDB) eventsTable() string {
+	if db.Archive {
+		return "eventstore.events_with_archive"
+	}
+	return "eventstore.events"
 }
 
 func (db *CRDB) columnName(col repository.Field) string {
@@ -0,0 +1,149 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/jackc/pgx/v4/stdlib"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/database"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+const (
+	// NotificationChannel is the postgres channel the pushed events are notified on
+	NotificationChannel = "zitadel_events"
+
+	notifyStmt = "SELECT pg_notify($1, $2)"
+	listenStmt = "LISTEN " + NotificationChannel
+	// changefeedStmt streams every inserted event (cockroach only),
+	// it requires the cluster setting kv.rangefeed.enabled
+	changefeedStmt = "EXPERIMENTAL CHANGEFEED FOR eventstore.events"
+)
+
+// Notification informs about events pushed to an instance
+type Notification struct {
+	InstanceID     string                     `json:"instanceID"`
+	AggregateTypes []repository.AggregateType `json:"aggregateTypes"`
+}
+
+// notify emits a notification per instance of the events,
+// postgres delivers the notifications as soon as the transaction is committed
+func (db *CRDB) notify(ctx context.Context, tx *sql.Tx, events []*repository.Event) error {
+	if !db.Notify || db.DB.Database == nil || db.Type() != "postgres" {
+		return nil
+	}
+	for _, notification := range eventNotifications(events) {
+		payload, err := json.Marshal(notification)
+		if err != nil {
+			return caos_errs.ThrowInternal(err, "SQL-Ub4mA", "unable to marshal notification")
+		}
+		if _, err = tx.ExecContext(ctx, notifyStmt, NotificationChannel, string(payload)); err != nil {
+			return caos_errs.ThrowInternal(err, "SQL-Fo2sj", "unable to notify events")
+		}
+	}
+	return nil
+}
+
+func eventNotifications(events []*repository.Event) []*Notification {
+	notifications := make([]*Notification, 0, 1)
+	byInstance := make(map[string]*Notification)
+	for _, event := range events {
+		notification, ok := byInstance[event.InstanceID]
+		if !ok {
+			notification = &Notification{InstanceID: event.InstanceID}
+			byInstance[event.InstanceID] = notification
+			notifications = append(notifications, notification)
+		}
+		if !containsAggregateType(notification.AggregateTypes, event.AggregateType) {
+			notification.AggregateTypes = append(notification.AggregateTypes, event.AggregateType)
+		}
+	}
+	return notifications
+}
+
+func containsAggregateType(types []repository.AggregateType, aggregateType repository.AggregateType) bool {
+	for _, typ := range types {
+		if typ == aggregateType {
+			return true
+		}
+	}
+	return false
+}
+
+// Listen blocks and calls notify for every notification of pushed events until ctx is done or the connection fails.
+// Postgres uses LISTEN / NOTIFY, cockroach a core changefeed on the events table.
+func Listen(ctx context.Context, client *database.DB, notify func(*Notification)) error {
+	if client.Type() == "postgres" {
+		return listenPostgres(ctx, client.DB, notify)
+	}
+	return listenChangefeed(ctx, client.DB, notify)
+}
+
+func listenPostgres(ctx context.Context, client *sql.DB, notify func(*Notification)) error {
+	conn, err := client.Conn(ctx)
+	if err != nil {
+		return caos_errs.ThrowInternal(err, "SQL-Bv2qz", "unable to acquire connection")
+	}
+	defer conn.Close()
+	return conn.Raw(func(driverConn any) error {
+		pgxConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return caos_errs.ThrowInternal(nil, "SQL-Lp9wH", "connection does not support listen")
+		}
+		if _, err := pgxConn.Conn().Exec(ctx, listenStmt); err != nil {
+			return caos_errs.ThrowInternal(err, "SQL-mW3ke", "unable to listen")
+		}
+		for {
+			pgNotification, err := pgxConn.Conn().WaitForNotification(ctx)
+			if err != nil {
+				return err
+			}
+			notification := new(Notification)
+			if err = json.Unmarshal([]byte(pgNotification.Payload), notification); err != nil {
+				logging.WithError(err).Warn("invalid event notification")
+				continue
+			}
+			notify(notification)
+		}
+	})
+}
+
+type changefeedRow struct {
+	After *struct {
+		InstanceID    string                   `json:"instance_id"`
+		AggregateType repository.AggregateType `json:"aggregate_type"`
+	} `json:"after"`
+}
+
+func listenChangefeed(ctx context.Context, client *sql.DB, notify func(*Notification)) error {
+	rows, err := client.QueryContext(ctx, changefeedStmt)
+	if err != nil {
+		return caos_errs.ThrowInternal(err, "SQL-Yk8rt", "unable to start changefeed")
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			table    sql.NullString
+			key, val []byte
+		)
+		if err = rows.Scan(&table, &key, &val); err != nil {
+			return caos_errs.ThrowInternal(err, "SQL-W7ndq", "unable to scan changefeed")
+		}
+		row := new(changefeedRow)
+		if err = json.Unmarshal(val, row); err != nil || row.After == nil {
+			continue
+		}
+		notify(&Notification{
+			InstanceID:     row.After.InstanceID,
+			AggregateTypes: []repository.AggregateType{row.After.AggregateType},
+		})
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,41 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+func Test_eventNotifications(t *testing.T) {
+	tests := []struct {
+		name   string
+		events []*repository.Event
+		want   []*Notification
+	}{
+		{
+			name:   "no events",
+			events: []*repository.Event{},
+			want:   []*Notification{},
+		},
+		{
+			name: "grouped by instance",
+			events: []*repository.Event{
+				{InstanceID: "instance1", AggregateType: "user"},
+				{InstanceID: "instance2", AggregateType: "org"},
+				{InstanceID: "instance1", AggregateType: "user"},
+				{InstanceID: "instance1", AggregateType: "project"},
+			},
+			want: []*Notification{
+				{InstanceID: "instance1", AggregateTypes: []repository.AggregateType{"user", "project"}},
+				{InstanceID: "instance2", AggregateTypes: []repository.AggregateType{"org"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, eventNotifications(tt.events))
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+
+	z_errors "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+const (
+	personalDataKeysQuery = "SELECT subject_id, key FROM eventstore.personal_data_keys" +
+		" WHERE instance_id = $1 AND subject_id = ANY($2)"
+	personalDataKeyQuery = "SELECT key FROM eventstore.personal_data_keys" +
+		" WHERE instance_id = $1 AND subject_id = $2"
+	instancePersonalDataKeysQuery = "SELECT subject_id, key FROM eventstore.personal_data_keys" +
+		" WHERE instance_id = $1 AND key IS NOT NULL"
+	replacePersonalDataKeyStmt = "UPDATE eventstore.personal_data_keys SET key = $4" +
+		" WHERE instance_id = $1 AND subject_id = $2 AND key = $3::JSONB"
+	insertPersonalDataKeyStmt = "INSERT INTO eventstore.personal_data_keys (instance_id, subject_id, key)" +
+		" VALUES ($1, $2, $3)" +
+		" ON CONFLICT (instance_id, subject_id) DO NOTHING"
+	// the row of a destroyed key remains, so that no new key is created for the subject
+	destroyPersonalDataKeyStmt = "INSERT INTO eventstore.personal_data_keys (instance_id, subject_id, key, destruction_date)" +
+		" VALUES ($1, $2, NULL, now())" +
+		" ON CONFLICT (instance_id, subject_id) DO UPDATE SET key = NULL, destruction_date = now()"
+	deletePersonalDataSnapshotsStmt = "DELETE FROM eventstore.snapshots WHERE instance_id = $1 AND aggregate_id = $2"
+)
+
+var _ repository.PersonalDataRepository = (*CRDB)(nil)
+
+func (db *CRDB) PersonalDataKeys(ctx context.Context, instanceID string, subjectIDs []string) (map[string][]byte, error) {
+	rows, err := db.DB.QueryContext(ctx, personalDataKeysQuery, instanceID, pq.StringArray(subjectIDs))
+	if err != nil {
+		return nil, z_errors.ThrowInternal(err, "SQL-Pd1qk", "unable to query personal data keys")
+	}
+	defer rows.Close()
+
+	keys := make(map[string][]byte, len(subjectIDs))
+	for rows.Next() {
+		var (
+			subjectID string
+			key       Data
+		)
+		if err = rows.Scan(&subjectID, &key); err != nil {
+			return nil, z_errors.ThrowInternal(err, "SQL-Pd2sc", "unable to scan personal data key")
+		}
+		keys[subjectID] = key
+	}
+	if err = rows.Err(); err != nil {
+		return nil, z_errors.ThrowInternal(err, "SQL-Pd3rw", "unable to scan personal data keys")
+	}
+	return keys, nil
+}
+
+func (db *CRDB) EnsurePersonalDataKey(ctx context.Context, subject *repository.PersonalDataSubject, key []byte) ([]byte, error) {
+	if _, err := db.DB.ExecContext(ctx, insertPersonalDataKeyStmt, subject.InstanceID, subject.SubjectID, Data(key)); err != nil {
+		return nil, z_errors.ThrowInternal(err, "SQL-Pd4in", "unable to store personal data key")
+	}
+	var stored Data
+	err := db.DB.QueryRowContext(ctx, personalDataKeyQuery, subject.InstanceID, subject.SubjectID).Scan(&stored)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, z_errors.ThrowInternal(err, "SQL-Pd5nf", "personal data key not stored")
+	}
+	if err != nil {
+		return nil, z_errors.ThrowInternal(err, "SQL-Pd6qk", "unable to query personal data key")
+	}
+	return stored, nil
+}
+
+func (db *CRDB) InstancePersonalDataKeys(ctx context.Context, instanceID string) (map[string][]byte, error) {
+	rows, err := db.DB.QueryContext(ctx, instancePersonalDataKeysQuery, instanceID)
+	if err != nil {
+		return nil, z_errors.ThrowInternal(err, "SQL-Pd9qk", "unable to query personal data keys")
+	}
+	defer rows.Close()
+
+	keys := make(map[string][]byte)
+	for rows.Next() {
+		var (
+			subjectID string
+			key       Data
+		)
+		if err = rows.Scan(&subjectID, &key); err != nil {
+			return nil, z_errors.ThrowInternal(err, "SQL-Pe1sc", "unable to scan personal data key")
+		}
+		keys[subjectID] = key
+	}
+	if err = rows.Err(); err != nil {
+		return nil, z_errors.ThrowInternal(err, "SQL-Pe2rw", "unable to scan personal data keys")
+	}
+	return keys, nil
+}
+
+func (db *CRDB) ReplacePersonalDataKey(ctx context.Context, subject *repository.PersonalDataSubject, oldKey, newKey []byte) (bool, error) {
+	result, err := db.DB.ExecContext(ctx, replacePersonalDataKeyStmt, subject.InstanceID, subject.SubjectID, Data(oldKey), Data(newKey))
+	if err != nil {
+		return false, z_errors.ThrowInternal(err, "SQL-Pe3up", "unable to replace personal data key")
+	}
+	replaced, err := result.RowsAffected()
+	if err != nil {
+		return false, z_errors.ThrowInternal(err, "SQL-Pe4up", "unable to replace personal data key")
+	}
+	return replaced == 1, nil
+}
+
+func (db *CRDB) PushAndDestroyPersonalData(ctx context.Context, events []*repository.Event, subjects []*repository.PersonalDataSubject, uniqueConstraints ...*repository.UniqueConstraint) error {
+	return db.push(ctx, events, subjects, uniqueConstraints...)
+}
+
+// destroyPersonalData removes the keys of the subjects,
+// so that their personal data in the events cannot be decrypted anymore,
+// and the snapshots of their aggregates which contain the personal data in plain text
+func (db *CRDB) destroyPersonalData(ctx context.Context, tx *sql.Tx, subjects []*repository.PersonalDataSubject) error {
+	for _, subject := range subjects {
+		if _, err := tx.ExecContext(ctx, destroyPersonalDataKeyStmt, subject.InstanceID, subject.SubjectID); err != nil {
+			return z_errors.ThrowInternal(err, "SQL-Pd7ds", "unable to destroy personal data key")
+		}
+		if _, err := tx.ExecContext(ctx, deletePersonalDataSnapshotsStmt, subject.InstanceID, subject.SubjectID); err != nil {
+			return z_errors.ThrowInternal(err, "SQL-Pd8ds", "unable to remove snapshots")
+		}
+	}
+	return nil
+}
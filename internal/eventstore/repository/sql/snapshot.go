@@ -0,0 +1,82 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	z_errors "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+const (
+	snapshotQuery = "SELECT aggregate_type, aggregate_id, resource_owner, event_sequence, change_date, payload" +
+		" FROM eventstore.snapshots" +
+		" WHERE instance_id = $1 AND snapshot_type = $2 AND query_key = $3"
+	snapshotUpsert = "INSERT INTO eventstore.snapshots" +
+		" (instance_id, snapshot_type, query_key, aggregate_type, aggregate_id, resource_owner, event_sequence, change_date, payload)" +
+		" VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)" +
+		" ON CONFLICT (instance_id, snapshot_type, query_key) DO UPDATE SET" +
+		" aggregate_type = EXCLUDED.aggregate_type" +
+		", aggregate_id = EXCLUDED.aggregate_id" +
+		", resource_owner = EXCLUDED.resource_owner" +
+		", event_sequence = EXCLUDED.event_sequence" +
+		", change_date = EXCLUDED.change_date" +
+		", payload = EXCLUDED.payload" +
+		", creation_date = now()" +
+		" WHERE eventstore.snapshots.event_sequence < EXCLUDED.event_sequence"
+)
+
+var _ repository.SnapshotRepository = (*CRDB)(nil)
+
+// Snapshot returns the snapshot of the given type and query or nil if none exists
+func (db *CRDB) Snapshot(ctx context.Context, instanceID, snapshotType, queryKey string) (*repository.Snapshot, error) {
+	snapshot := &repository.Snapshot{
+		InstanceID: instanceID,
+		Type:       snapshotType,
+		QueryKey:   queryKey,
+	}
+	var (
+		aggregateType sql.NullString
+		aggregateID   sql.NullString
+		payload       Data
+	)
+	err := db.DB.QueryRowContext(ctx, snapshotQuery, instanceID, snapshotType, queryKey).Scan(
+		&aggregateType,
+		&aggregateID,
+		&snapshot.ResourceOwner,
+		&snapshot.Sequence,
+		&snapshot.ChangeDate,
+		&payload,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, z_errors.ThrowInternal(err, "SQL-Sn4pq", "unable to query snapshot")
+	}
+	snapshot.AggregateType = repository.AggregateType(aggregateType.String)
+	snapshot.AggregateID = aggregateID.String
+	snapshot.Payload = make([]byte, len(payload))
+	copy(snapshot.Payload, payload)
+	return snapshot, nil
+}
+
+// StoreSnapshot creates or replaces the snapshot if it is newer than the stored one
+func (db *CRDB) StoreSnapshot(ctx context.Context, snapshot *repository.Snapshot) error {
+	_, err := db.DB.ExecContext(ctx, snapshotUpsert,
+		snapshot.InstanceID,
+		snapshot.Type,
+		snapshot.QueryKey,
+		sql.NullString{String: string(snapshot.AggregateType), Valid: snapshot.AggregateType != ""},
+		sql.NullString{String: snapshot.AggregateID, Valid: snapshot.AggregateID != ""},
+		snapshot.ResourceOwner,
+		snapshot.Sequence,
+		snapshot.ChangeDate,
+		Data(snapshot.Payload),
+	)
+	if err != nil {
+		return z_errors.ThrowInternal(err, "SQL-Wq3nd", "unable to store snapshot")
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+package eventstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+// SnapshotReducer is a write model whose state can be stored as snapshot,
+// so that only the events newer than the snapshot are filtered and reduced.
+// The state is stored as json, therefore all fields needed to continue the reduction
+// must be exported and must not be ignored by json.
+type SnapshotReducer interface {
+	reducer
+	// SnapshotType describes the structure of the state (e.g. user.human.v1)
+	// it must be changed as soon as the reduction changes, so that existing snapshots are ignored
+	SnapshotType() string
+	writeModel() *WriteModel
+}
+
+func (wm *WriteModel) writeModel() *WriteModel {
+	return wm
+}
+
+func (es *Eventstore) snapshotsEnabled() bool {
+	if es.snapshotThreshold == 0 {
+		return false
+	}
+	_, ok := es.repo.(repository.SnapshotRepository)
+	return ok
+}
+
+// filterToSnapshotReducer restores the state of the reducer from the snapshot matching the search query,
+// filters only the newer events and stores a new snapshot if at least the threshold of events was reduced
+func (es *Eventstore) filterToSnapshotReducer(ctx context.Context, searchQuery *SearchQueryBuilder, r SnapshotReducer) error {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	key, ok := searchQuery.snapshotKey(instanceID)
+	if !ok {
+		events, err := es.Filter(ctx, searchQuery)
+		if err != nil {
+			return err
+		}
+		r.AppendEvents(events...)
+		return r.Reduce()
+	}
+	repo := es.repo.(repository.SnapshotRepository)
+	snapshot, err := repo.Snapshot(ctx, instanceID, r.SnapshotType(), key)
+	if err != nil {
+		return err
+	}
+	query := searchQuery
+	if snapshot != nil {
+		if err = restoreSnapshot(snapshot, r); err != nil {
+			return err
+		}
+		query = searchQuery.sequenceGreater(snapshot.Sequence)
+	}
+	events, err := es.Filter(ctx, query)
+	if err != nil {
+		return err
+	}
+	r.AppendEvents(events...)
+	if err = r.Reduce(); err != nil {
+		return err
+	}
+	if uint64(len(events)) < es.snapshotThreshold {
+		return nil
+	}
+	err = es.storeSnapshot(ctx, repo, searchQuery, instanceID, key, r)
+	logging.WithFields("type", r.SnapshotType()).OnError(err).Warn("unable to store snapshot")
+	return nil
+}
+
+func restoreSnapshot(snapshot *repository.Snapshot, r SnapshotReducer) error {
+	if err := json.Unmarshal(snapshot.Payload, r); err != nil {
+		return errors.ThrowInternal(err, "V2-Sn8qa", "unable to unmarshal snapshot")
+	}
+	wm := r.writeModel()
+	wm.AggregateID = snapshot.AggregateID
+	wm.ResourceOwner = snapshot.ResourceOwner
+	wm.InstanceID = snapshot.InstanceID
+	wm.ProcessedSequence = snapshot.Sequence
+	wm.ChangeDate = snapshot.ChangeDate
+	return nil
+}
+
+func (es *Eventstore) storeSnapshot(ctx context.Context, repo repository.SnapshotRepository, searchQuery *SearchQueryBuilder, instanceID, key string, r SnapshotReducer) error {
+	wm := r.writeModel()
+	// events with a lower sequence could still be pushed by running transactions,
+	// only states which cannot miss any events are stored
+	if time.Since(wm.ChangeDate) < es.snapshotMinAge() {
+		return nil
+	}
+	payload, err := json.Marshal(r)
+	if err != nil {
+		return errors.ThrowInternal(err, "V2-Sn9wd", "unable to marshal snapshot")
+	}
+	// only snapshots of a single aggregate allow to archive the events of the aggregate
+	aggregateType, aggregateID := searchQuery.singleAggregate()
+	return repo.StoreSnapshot(ctx, &repository.Snapshot{
+		InstanceID:    instanceID,
+		Type:          r.SnapshotType(),
+		QueryKey:      key,
+		AggregateType: repository.AggregateType(aggregateType),
+		AggregateID:   aggregateID,
+		ResourceOwner: wm.ResourceOwner,
+		Sequence:      wm.ProcessedSequence,
+		ChangeDate:    wm.ChangeDate,
+		Payload:       payload,
+	})
+}
+
+func (es *Eventstore) snapshotMinAge() time.Duration {
+	if es.PushTimeout > 0 {
+		return es.PushTimeout
+	}
+	return time.Minute
+}
+
+// snapshotKey identifies the events filtered by the builder
+// it returns false if the builder cannot be used for snapshots,
+// because it restricts the filtered events by sequence, date, limit or order
+// or runs inside a transaction
+func (builder *SearchQueryBuilder) snapshotKey(instanceID string) (string, bool) {
+	if builder == nil ||
+		len(builder.queries) == 0 ||
+		builder.columns != repository.ColumnsEvent ||
+		builder.limit > 0 ||
+		builder.desc ||
+		builder.tx != nil {
+		return "", false
+	}
+	var key strings.Builder
+	key.WriteString(instanceID + "|" + builder.resourceOwner + "|" + builder.editorUser)
+	for _, query := range builder.queries {
+		if query.eventSequenceGreater > 0 ||
+			query.eventSequenceLess > 0 ||
+			!query.creationDateAfter.IsZero() {
+			return "", false
+		}
+		data, err := json.Marshal(query.eventData)
+		if err != nil {
+			return "", false
+		}
+		key.WriteString("|" + strconv.Itoa(len(query.aggregateTypes)))
+		for _, typ := range query.aggregateTypes {
+			key.WriteString("," + string(typ))
+		}
+		key.WriteString("|" + strings.Join(query.aggregateIDs, ","))
+		key.WriteString("|" + query.instanceID)
+		key.WriteString("|" + strings.Join(query.excludedInstanceIDs, ","))
+		key.WriteString("|" + strconv.Itoa(len(query.eventTypes)))
+		for _, typ := range query.eventTypes {
+			key.WriteString("," + string(typ))
+		}
+		key.WriteString("|")
+		key.Write(data)
+	}
+	hash := sha256.Sum256([]byte(key.String()))
+	return hex.EncodeToString(hash[:]), true
+}
+
+// singleAggregate returns the aggregate if all sub queries filter the same single aggregate
+func (builder *SearchQueryBuilder) singleAggregate() (aggregateType AggregateType, aggregateID string) {
+	for _, query := range builder.queries {
+		if len(query.aggregateTypes) != 1 || len(query.aggregateIDs) != 1 {
+			return "", ""
+		}
+		if aggregateID != "" && (aggregateType != query.aggregateTypes[0] || aggregateID != query.aggregateIDs[0]) {
+			return "", ""
+		}
+		aggregateType, aggregateID = query.aggregateTypes[0], query.aggregateIDs[0]
+	}
+	return aggregateType, aggregateID
+}
+
+// sequenceGreater returns a copy of the builder
+// where all sub queries only filter events newer than the given sequence
+func (builder *SearchQueryBuilder) sequenceGreater(sequence uint64) *SearchQueryBuilder {
+	copied := *builder
+	copied.queries = make([]*SearchQuery, len(builder.queries))
+	for i, query := range builder.queries {
+		q := *query
+		q.builder = &copied
+		q.eventSequenceGreater = sequence
+		copied.queries[i] = &q
+	}
+	return &copied
+}
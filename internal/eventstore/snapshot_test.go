@@ -0,0 +1,206 @@
+package eventstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+type snapshotTestRepo struct {
+	testRepo
+	snapshot *repository.Snapshot
+	stored   *repository.Snapshot
+	filters  [][]*repository.Filter
+}
+
+func (repo *snapshotTestRepo) Filter(ctx context.Context, searchQuery *repository.SearchQuery) ([]*repository.Event, error) {
+	repo.filters = searchQuery.Filters
+	return repo.testRepo.Filter(ctx, searchQuery)
+}
+
+func (repo *snapshotTestRepo) Snapshot(_ context.Context, _, _, _ string) (*repository.Snapshot, error) {
+	return repo.snapshot, nil
+}
+
+func (repo *snapshotTestRepo) StoreSnapshot(_ context.Context, snapshot *repository.Snapshot) error {
+	repo.stored = snapshot
+	return nil
+}
+
+type snapshotTestWriteModel struct {
+	WriteModel
+	Count int
+}
+
+func (wm *snapshotTestWriteModel) Reduce() error {
+	wm.Count += len(wm.Events)
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *snapshotTestWriteModel) SnapshotType() string {
+	return "test.v1"
+}
+
+func snapshotTestEvents(creationDate time.Time, sequences ...uint64) []*repository.Event {
+	events := make([]*repository.Event, len(sequences))
+	for i, sequence := range sequences {
+		events[i] = &repository.Event{
+			AggregateID:   "agg1",
+			AggregateType: "test.agg",
+			Sequence:      sequence,
+			CreationDate:  creationDate,
+			Type:          "test.event",
+			Version:       "v1",
+			ResourceOwner: sql.NullString{String: "ro", Valid: true},
+		}
+	}
+	return events
+}
+
+func snapshotTestQuery() *SearchQueryBuilder {
+	return NewSearchQueryBuilder(ColumnsEvent).
+		AddQuery().
+		AggregateTypes("test.agg").
+		AggregateIDs("agg1").
+		Builder()
+}
+
+func TestEventstore_filterToSnapshotReducer(t *testing.T) {
+	type fields struct {
+		snapshot *repository.Snapshot
+		events   []*repository.Event
+	}
+	type res struct {
+		count            int
+		sequence         uint64
+		sequenceGreater  uint64
+		storedSequence   uint64
+		storedCount      int
+		snapshotNotStore bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			name: "no snapshot, threshold reached",
+			fields: fields{
+				events: snapshotTestEvents(time.Now().Add(-time.Hour), 1, 2, 3),
+			},
+			res: res{
+				count:          3,
+				sequence:       3,
+				storedSequence: 3,
+				storedCount:    3,
+			},
+		},
+		{
+			name: "no snapshot, events too new",
+			fields: fields{
+				events: snapshotTestEvents(time.Now(), 1, 2, 3),
+			},
+			res: res{
+				count:            3,
+				sequence:         3,
+				snapshotNotStore: true,
+			},
+		},
+		{
+			name: "snapshot, threshold not reached",
+			fields: fields{
+				snapshot: &repository.Snapshot{
+					AggregateID:   "agg1",
+					ResourceOwner: "ro",
+					Sequence:      3,
+					ChangeDate:    time.Now().Add(-time.Hour),
+					Payload:       []byte(`{"Count": 3}`),
+				},
+				events: snapshotTestEvents(time.Now().Add(-time.Hour), 4),
+			},
+			res: res{
+				count:            4,
+				sequence:         4,
+				sequenceGreater:  3,
+				snapshotNotStore: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &snapshotTestRepo{
+				testRepo: testRepo{
+					t:      t,
+					events: tt.fields.events,
+				},
+				snapshot: tt.fields.snapshot,
+			}
+			es := &Eventstore{
+				repo:              repo,
+				eventInterceptors: map[EventType]eventTypeInterceptors{},
+				snapshotThreshold: 2,
+			}
+			wm := new(snapshotTestWriteModel)
+			if err := es.FilterToReducer(context.Background(), snapshotTestQuery(), wm); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if wm.Count != tt.res.count {
+				t.Errorf("wrong count: want %d, got %d", tt.res.count, wm.Count)
+			}
+			if wm.ProcessedSequence != tt.res.sequence {
+				t.Errorf("wrong sequence: want %d, got %d", tt.res.sequence, wm.ProcessedSequence)
+			}
+			var sequenceGreater uint64
+			for _, filter := range repo.filters[0] {
+				if filter.Field == repository.FieldSequence && filter.Operation == repository.OperationGreater {
+					sequenceGreater = filter.Value.(uint64)
+				}
+			}
+			if sequenceGreater != tt.res.sequenceGreater {
+				t.Errorf("wrong sequence filter: want %d, got %d", tt.res.sequenceGreater, sequenceGreater)
+			}
+			if tt.res.snapshotNotStore {
+				if repo.stored != nil {
+					t.Errorf("snapshot must not be stored")
+				}
+				return
+			}
+			if repo.stored == nil {
+				t.Fatal("snapshot not stored")
+			}
+			if repo.stored.Sequence != tt.res.storedSequence || repo.stored.AggregateID != "agg1" || repo.stored.AggregateType != "test.agg" {
+				t.Errorf("wrong snapshot stored: %+v", repo.stored)
+			}
+			stored := new(snapshotTestWriteModel)
+			if err := json.Unmarshal(repo.stored.Payload, stored); err != nil {
+				t.Fatalf("unable to unmarshal payload: %v", err)
+			}
+			if stored.Count != tt.res.storedCount {
+				t.Errorf("wrong stored count: want %d, got %d", tt.res.storedCount, stored.Count)
+			}
+		})
+	}
+}
+
+func TestSearchQueryBuilder_snapshotKey(t *testing.T) {
+	key, ok := snapshotTestQuery().snapshotKey("instance")
+	if !ok || key == "" {
+		t.Fatal("query must be usable for snapshots")
+	}
+	if other, _ := snapshotTestQuery().snapshotKey("instance"); other != key {
+		t.Error("key of equal queries must be equal")
+	}
+	if other, _ := snapshotTestQuery().snapshotKey("other"); other == key {
+		t.Error("key of different instances must differ")
+	}
+	if _, ok := snapshotTestQuery().Limit(1).snapshotKey("instance"); ok {
+		t.Error("limited query must not be usable for snapshots")
+	}
+	if _, ok := NewSearchQueryBuilder(ColumnsEvent).AddQuery().AggregateTypes("test.agg").SequenceGreater(1).Builder().snapshotKey("instance"); ok {
+		t.Error("query with sequence must not be usable for snapshots")
+	}
+}
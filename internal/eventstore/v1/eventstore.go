@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/zitadel/zitadel/internal/database"
+	v2_repository "github.com/zitadel/zitadel/internal/eventstore/repository"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/internal/repository"
 	z_sql "github.com/zitadel/zitadel/internal/eventstore/v1/internal/repository/sql"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
@@ -18,13 +19,22 @@ type Eventstore interface {
 
 var _ Eventstore = (*eventstore)(nil)
 
+// PersonalDataDecrypter decrypts the personal data in the payload of the events
+type PersonalDataDecrypter interface {
+	DecryptPersonalData(ctx context.Context, events []*v2_repository.Event) error
+}
+
 type eventstore struct {
-	repo repository.Repository
+	repo         repository.Repository
+	personalData PersonalDataDecrypter
 }
 
-func Start(db *database.DB, allowOrderByCreationDate bool) (Eventstore, error) {
+// Start starts the eventstore
+// the personal data of the events is only decrypted if personalData is set
+func Start(db *database.DB, allowOrderByCreationDate bool, personalData PersonalDataDecrypter) (Eventstore, error) {
 	return &eventstore{
-		repo: z_sql.Start(db, allowOrderByCreationDate),
+		repo:         z_sql.Start(db, allowOrderByCreationDate),
+		personalData: personalData,
 	}, nil
 }
 
@@ -32,7 +42,37 @@ func (es *eventstore) FilterEvents(ctx context.Context, searchQuery *models.Sear
 	if err := searchQuery.Validate(); err != nil {
 		return nil, err
 	}
-	return es.repo.Filter(ctx, models.FactoryFromSearchQuery(searchQuery))
+	events, err := es.repo.Filter(ctx, models.FactoryFromSearchQuery(searchQuery))
+	if err != nil {
+		return nil, err
+	}
+	if err = es.decryptPersonalData(ctx, events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (es *eventstore) decryptPersonalData(ctx context.Context, events []*models.Event) error {
+	if es.personalData == nil || len(events) == 0 {
+		return nil
+	}
+	v2Events := make([]*v2_repository.Event, len(events))
+	for i, event := range events {
+		v2Events[i] = &v2_repository.Event{
+			InstanceID:    event.InstanceID,
+			AggregateID:   event.AggregateID,
+			AggregateType: v2_repository.AggregateType(event.AggregateType),
+			Type:          v2_repository.EventType(event.Type),
+			Data:          event.Data,
+		}
+	}
+	if err := es.personalData.DecryptPersonalData(ctx, v2Events); err != nil {
+		return err
+	}
+	for i, event := range v2Events {
+		events[i].Data = event.Data
+	}
+	return nil
 }
 
 func (es *eventstore) Health(ctx context.Context) error {
@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	"github.com/zitadel/zitadel/internal/notification/types"
+	"github.com/zitadel/zitadel/internal/repository/deviceauth"
+)
+
+// backchannelAuthPing is the payload sent to the client notification endpoint in ping mode
+type backchannelAuthPing struct {
+	AuthReqID string `json:"auth_req_id"`
+}
+
+func (u *userNotifier) reduceBackchannelAuthAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*deviceauth.AddedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Bc1ad", "reduce.wrong.event.type %s", deviceauth.AddedEventType)
+	}
+	if e.Backchannel == nil {
+		return crdb.NewNoOpStatement(e), nil
+	}
+	ctx := HandlerContext(event.Aggregate())
+	alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, map[string]interface{}{"state": domain.DeviceAuthStateInitiated}, deviceauth.AggregateType, deviceauth.NotificationSentEventType)
+	if err != nil {
+		return nil, err
+	}
+	if alreadyHandled {
+		return crdb.NewNoOpStatement(e), nil
+	}
+	notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Backchannel.UserID, false)
+	if err != nil {
+		return nil, err
+	}
+	app, err := u.queries.AppByOIDCClientID(ctx, e.ClientID, false)
+	if err != nil {
+		return nil, err
+	}
+	colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, notifyUser.ResourceOwner, false)
+	if err != nil {
+		return nil, err
+	}
+	template, err := u.queries.MailTemplateByOrg(ctx, notifyUser.ResourceOwner, false)
+	if err != nil {
+		return nil, err
+	}
+	translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.BackchannelAuthRequestedMessageType)
+	if err != nil {
+		return nil, err
+	}
+	ctx, origin, err := u.queries.Origin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = types.SendEmail(
+		ctx,
+		string(template.Template),
+		translator,
+		notifyUser,
+		u.queries.GetSMTPConfig,
+		u.queries.GetFileSystemProvider,
+		u.queries.GetLogProvider,
+		colors,
+		u.assetsPrefix(ctx),
+		e,
+		u.metricSuccessfulDeliveriesEmail,
+		u.metricFailedDeliveriesEmail,
+	).SendBackchannelAuthRequested(notifyUser, origin, e.UserCode, app.Name, e.Backchannel.BindingMessage)
+	if err != nil {
+		return nil, err
+	}
+	err = u.commands.BackchannelAuthNotificationSent(ctx, e.Aggregate().ID, domain.DeviceAuthStateInitiated)
+	if err != nil {
+		return nil, err
+	}
+	return crdb.NewNoOpStatement(e), nil
+}
+
+func (u *userNotifier) reduceBackchannelAuthApproved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*deviceauth.ApprovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Bc2ap", "reduce.wrong.event.type %s", deviceauth.ApprovedEventType)
+	}
+	return u.pingBackchannelClient(e, domain.DeviceAuthStateApproved)
+}
+
+func (u *userNotifier) reduceBackchannelAuthCanceled(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*deviceauth.CanceledEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Bc3ca", "reduce.wrong.event.type %s", deviceauth.CanceledEventType)
+	}
+	return u.pingBackchannelClient(e, e.Reason.State())
+}
+
+// pingBackchannelClient informs the client of a backchannel authentication request in ping mode
+// that the result can be retrieved from the token endpoint
+func (u *userNotifier) pingBackchannelClient(event eventstore.Event, state domain.DeviceAuthState) (*handler.Statement, error) {
+	ctx := HandlerContext(event.Aggregate())
+	added, err := u.queries.deviceAuthAdded(ctx, event.Aggregate())
+	if err != nil {
+		return nil, err
+	}
+	if !added.Backchannel.IsPing() {
+		return crdb.NewNoOpStatement(event), nil
+	}
+	alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, map[string]interface{}{"state": state}, deviceauth.AggregateType, deviceauth.NotificationSentEventType)
+	if err != nil {
+		return nil, err
+	}
+	if alreadyHandled {
+		return crdb.NewNoOpStatement(event), nil
+	}
+	err = types.SendJSON(
+		ctx,
+		webhook.Config{
+			CallURL: added.Backchannel.NotificationEndpoint,
+			Method:  http.MethodPost,
+			Headers: http.Header{
+				"Authorization": []string{"Bearer " + added.Backchannel.ClientNotificationToken},
+			},
+		},
+		u.queries.GetFileSystemProvider,
+		u.queries.GetLogProvider,
+		&backchannelAuthPing{AuthReqID: added.DeviceCode},
+		event,
+		u.metricSuccessfulDeliveriesJSON,
+		u.metricFailedDeliveriesJSON,
+	).WithoutTemplate()
+	if err != nil {
+		return nil, err
+	}
+	err = u.commands.BackchannelAuthNotificationSent(ctx, event.Aggregate().ID, state)
+	if err != nil {
+		return nil, err
+	}
+	return crdb.NewNoOpStatement(event), nil
+}
+
+// deviceAuthAdded returns the event which created the device authorization,
+// as the projection might already have removed it
+func (n *NotificationQueries) deviceAuthAdded(ctx context.Context, aggregate eventstore.Aggregate) (*deviceauth.AddedEvent, error) {
+	events, err := n.es.Filter(
+		ctx,
+		eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+			InstanceID(aggregate.InstanceID).
+			AddQuery().
+			AggregateTypes(deviceauth.AggregateType).
+			AggregateIDs(aggregate.ID).
+			EventTypes(deviceauth.AddedEventType).
+			Builder(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range events {
+		if added, ok := event.(*deviceauth.AddedEvent); ok {
+			return added, nil
+		}
+	}
+	return nil, errors.ThrowNotFound(nil, "HANDL-Bc4nf", "Errors.DeviceAuth.NotFound")
+}
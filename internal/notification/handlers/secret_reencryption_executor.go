@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"context"
+	"math"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/repository/pseudo"
+)
+
+const (
+	SecretReencryptionExecutorProjectionTable = "projections.secret_reencryption_executor"
+)
+
+// secretReencryptionExecutor re-encrypts the secrets of the active instances,
+// which are still encrypted with an old key of the encryption key configuration
+type secretReencryptionExecutor struct {
+	crdb.StatementHandler
+	commands *command.Commands
+}
+
+func NewSecretReencryptionExecutor(
+	ctx context.Context,
+	handlerCfg crdb.StatementHandlerConfig,
+	commands *command.Commands,
+) *secretReencryptionExecutor {
+	p := new(secretReencryptionExecutor)
+	handlerCfg.ProjectionName = SecretReencryptionExecutorProjectionTable
+	handlerCfg.Reducers = p.reducers()
+	handlerCfg.ConcurrentInstances = math.MaxInt
+	p.StatementHandler = crdb.NewStatementHandler(ctx, handlerCfg)
+	p.commands = commands
+	return p
+}
+
+func (s *secretReencryptionExecutor) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{{
+		Aggregate: pseudo.AggregateType,
+		EventRedusers: []handler.EventReducer{{
+			Event:  pseudo.ScheduledEventType,
+			Reduce: s.reencryptSecrets,
+		}},
+	}}
+}
+
+func (s *secretReencryptionExecutor) reencryptSecrets(event eventstore.Event) (*handler.Statement, error) {
+	ctx := call.WithTimestamp(context.Background())
+	scheduledEvent, ok := event.(*pseudo.ScheduledEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Re7nc", "reduce.wrong.event.type %s", event.Type())
+	}
+
+	summary, err := s.commands.ReencryptSecrets(ctx, scheduledEvent.InstanceIDs, false, func(progress *command.SecretReencryptionProgress) {
+		if progress.Outdated == 0 {
+			return
+		}
+		logging.WithFields(
+			"instance", progress.InstanceID,
+			"type", progress.Type,
+			"outdated", progress.Outdated,
+			"reencrypted", progress.Reencrypted,
+			"failed", progress.Failed,
+			"skipped", progress.Skipped,
+		).Info("secrets re-encrypted")
+	})
+	if err != nil {
+		return nil, err
+	}
+	// secrets which cannot be decrypted won't succeed on a retry,
+	// their key id must be added to the decryption keys again
+	for _, progress := range summary {
+		if progress.Failed > 0 {
+			logging.WithFields("type", progress.Type, "failed", progress.Failed, "keyIDs", progress.OutdatedKeyIDs).Warn("secrets could not be re-encrypted")
+		}
+	}
+	return crdb.NewNoOpStatement(scheduledEvent), nil
+}
@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/notification/types"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+)
+
+func (u *userNotifier) reduceUserGrantRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*usergrant.UserGrantRequestedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gq2lf", "reduce.wrong.event.type %s", usergrant.UserGrantRequestedType)
+	}
+	return u.notifyUserGrantRequest(e, domain.UserGrantRequestStateRequested)
+}
+
+func (u *userNotifier) reduceUserGrantRequestApproved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*usergrant.UserGrantRequestApprovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gq8ma", "reduce.wrong.event.type %s", usergrant.UserGrantRequestApprovedType)
+	}
+	return u.notifyUserGrantRequest(e, domain.UserGrantRequestStateApproved)
+}
+
+func (u *userNotifier) reduceUserGrantRequestDenied(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*usergrant.UserGrantRequestDeniedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gq4vd", "reduce.wrong.event.type %s", usergrant.UserGrantRequestDeniedType)
+	}
+	return u.notifyUserGrantRequest(e, domain.UserGrantRequestStateDenied)
+}
+
+// notifyUserGrantRequest informs the owners of the project (grant) about a new request
+// and the requesting user about the decision
+func (u *userNotifier) notifyUserGrantRequest(event eventstore.Event, state domain.UserGrantRequestState) (*handler.Statement, error) {
+	ctx := HandlerContext(event.Aggregate())
+	alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, map[string]interface{}{"state": state}, usergrant.AggregateType, usergrant.UserGrantRequestNotificationSentType)
+	if err != nil {
+		return nil, err
+	}
+	if alreadyHandled {
+		return crdb.NewNoOpStatement(event), nil
+	}
+	request, err := u.queries.UserGrantRequestByID(ctx, true, event.Aggregate().ID)
+	if err != nil {
+		return nil, err
+	}
+	project, err := u.queries.ProjectByID(ctx, true, request.ProjectID, false)
+	if err != nil {
+		return nil, err
+	}
+	requester, err := u.queries.GetNotifyUserByID(ctx, true, request.UserID, false)
+	if err != nil {
+		return nil, err
+	}
+	ctx, origin, err := u.queries.Origin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch state {
+	case domain.UserGrantRequestStateRequested:
+		approverIDs, err := u.userGrantRequestApprovers(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, approverID := range approverIDs {
+			approver, err := u.queries.GetNotifyUserByID(ctx, true, approverID, false)
+			if err != nil {
+				return nil, err
+			}
+			notify, err := u.userGrantRequestNotify(ctx, event, approver, domain.UserGrantRequestedMessageType)
+			if err != nil {
+				return nil, err
+			}
+			err = notify.SendUserGrantRequested(approver, origin, requester.PreferredLoginName, project.Name, request.Roles, request.Reason)
+			if err != nil {
+				return nil, err
+			}
+		}
+	case domain.UserGrantRequestStateApproved:
+		notify, err := u.userGrantRequestNotify(ctx, event, requester, domain.UserGrantApprovedMessageType)
+		if err != nil {
+			return nil, err
+		}
+		if err = notify.SendUserGrantApproved(requester, origin, project.Name, request.Roles); err != nil {
+			return nil, err
+		}
+	case domain.UserGrantRequestStateDenied:
+		notify, err := u.userGrantRequestNotify(ctx, event, requester, domain.UserGrantDeniedMessageType)
+		if err != nil {
+			return nil, err
+		}
+		if err = notify.SendUserGrantDenied(requester, origin, project.Name, request.Roles, request.DenialReason); err != nil {
+			return nil, err
+		}
+	}
+
+	err = u.commands.UserGrantRequestNotificationSent(ctx, event.Aggregate().ID, event.Aggregate().ResourceOwner, state)
+	if err != nil {
+		return nil, err
+	}
+	return crdb.NewNoOpStatement(event), nil
+}
+
+// userGrantRequestApprovers returns the owners of the project,
+// or the owners of the project grant if the roles were requested on a granted project
+func (u *userNotifier) userGrantRequestApprovers(ctx context.Context, request *query.UserGrantRequest) ([]string, error) {
+	var (
+		members   *query.Members
+		ownerRole = domain.RoleProjectOwner
+		err       error
+	)
+	if request.GrantID == "" {
+		members, err = u.queries.ProjectMembers(ctx, &query.ProjectMembersQuery{ProjectID: request.ProjectID}, false)
+	} else {
+		ownerRole = domain.RoleProjectGrantOwner
+		members, err = u.queries.ProjectGrantMembers(ctx, &query.ProjectGrantMembersQuery{
+			ProjectID: request.ProjectID,
+			GrantID:   request.GrantID,
+			OrgID:     request.ResourceOwner,
+		}, false)
+	}
+	if err != nil {
+		return nil, err
+	}
+	approverIDs := make([]string, 0, len(members.Members))
+	for _, member := range members.Members {
+		if member.UserType != domain.UserTypeHuman {
+			continue
+		}
+		for _, role := range member.Roles {
+			if role == ownerRole {
+				approverIDs = append(approverIDs, member.UserID)
+				break
+			}
+		}
+	}
+	return approverIDs, nil
+}
+
+func (u *userNotifier) userGrantRequestNotify(ctx context.Context, event eventstore.Event, notifyUser *query.NotifyUser, messageType string) (types.Notify, error) {
+	colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, notifyUser.ResourceOwner, false)
+	if err != nil {
+		return nil, err
+	}
+	template, err := u.queries.MailTemplateByOrg(ctx, notifyUser.ResourceOwner, false)
+	if err != nil {
+		return nil, err
+	}
+	translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, messageType)
+	if err != nil {
+		return nil, err
+	}
+	return types.SendEmail(
+		ctx,
+		string(template.Template),
+		translator,
+		notifyUser,
+		u.queries.GetSMTPConfig,
+		u.queries.GetFileSystemProvider,
+		u.queries.GetLogProvider,
+		colors,
+		u.assetsPrefix(ctx),
+		event,
+		u.metricSuccessfulDeliveriesEmail,
+		u.metricFailedDeliveriesEmail,
+	), nil
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"context"
+	"math"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/repository/userimport"
+)
+
+const (
+	UserImportExecutorProjectionTable = "projections.user_import_executor"
+)
+
+// userImportExecutor processes the batches of the user import jobs one after another:
+// each processed batch triggers the processing of the next one until the job is done
+type userImportExecutor struct {
+	crdb.StatementHandler
+	commands *command.Commands
+}
+
+func NewUserImportExecutor(
+	ctx context.Context,
+	handlerCfg crdb.StatementHandlerConfig,
+	commands *command.Commands,
+) *userImportExecutor {
+	p := new(userImportExecutor)
+	handlerCfg.ProjectionName = UserImportExecutorProjectionTable
+	handlerCfg.Reducers = p.reducers()
+	handlerCfg.ConcurrentInstances = math.MaxInt
+	p.StatementHandler = crdb.NewStatementHandler(ctx, handlerCfg)
+	p.commands = commands
+	return p
+}
+
+func (u *userImportExecutor) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{{
+		Aggregate: userimport.AggregateType,
+		EventRedusers: []handler.EventReducer{
+			{
+				Event:  userimport.AddedType,
+				Reduce: u.processNextBatch,
+			},
+			{
+				Event:  userimport.BatchProcessedType,
+				Reduce: u.processNextBatch,
+			},
+		},
+	}}
+}
+
+func (u *userImportExecutor) processNextBatch(event eventstore.Event) (*handler.Statement, error) {
+	ctx := call.WithTimestamp(context.Background())
+	ctx = authz.WithInstanceID(ctx, event.Aggregate().InstanceID)
+	// the users are imported on behalf of the creator of the job
+	ctx = authz.SetCtxData(ctx, authz.CtxData{UserID: event.EditorUser(), OrgID: event.Aggregate().ResourceOwner})
+
+	if err := u.commands.ProcessUserImportBatch(ctx, event.Aggregate().ID, event.Aggregate().ResourceOwner); err != nil {
+		return nil, err
+	}
+	return crdb.NewNoOpStatement(event), nil
+}
@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/pseudo"
+)
+
+const (
+	UserLifecycleExecutorProjectionTable = "projections.user_lifecycle_executor"
+)
+
+// userLifecycleExecutor applies the user lifecycle policies:
+// it deactivates inactive users, removes deactivated users and schedules the notifications before either happens
+type userLifecycleExecutor struct {
+	crdb.StatementHandler
+	commands *command.Commands
+	queries  *NotificationQueries
+}
+
+func NewUserLifecycleExecutor(
+	ctx context.Context,
+	handlerCfg crdb.StatementHandlerConfig,
+	commands *command.Commands,
+	queries *NotificationQueries,
+) *userLifecycleExecutor {
+	p := new(userLifecycleExecutor)
+	handlerCfg.ProjectionName = UserLifecycleExecutorProjectionTable
+	handlerCfg.Reducers = p.reducers()
+	handlerCfg.ConcurrentInstances = math.MaxInt
+	p.StatementHandler = crdb.NewStatementHandler(ctx, handlerCfg)
+	p.commands = commands
+	p.queries = queries
+	return p
+}
+
+func (u *userLifecycleExecutor) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{{
+		Aggregate: pseudo.AggregateType,
+		EventRedusers: []handler.EventReducer{{
+			Event:  pseudo.ScheduledEventType,
+			Reduce: u.applyLifecycleActions,
+		}},
+	}}
+}
+
+func (u *userLifecycleExecutor) applyLifecycleActions(event eventstore.Event) (*handler.Statement, error) {
+	ctx := call.WithTimestamp(context.Background())
+	scheduledEvent, ok := event.(*pseudo.ScheduledEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Lx2mv", "reduce.wrong.event.type %s", event.Type())
+	}
+
+	actions, err := u.queries.Queries.UserLifecycleActions(ctx, scheduledEvent.InstanceIDs, time.Now(), nil)
+	if err != nil {
+		return nil, err
+	}
+	var errs int
+	for _, action := range actions.Actions {
+		if err = u.applyAction(ctx, action); err != nil {
+			errs++
+			logging.WithFields("instance", action.InstanceID, "user", action.UserID, "action", action.Action).WithError(err).Warn("applying user lifecycle action failed")
+		}
+	}
+	if errs > 0 {
+		return nil, fmt.Errorf("applying %d of %d user lifecycle actions failed", errs, actions.Count)
+	}
+
+	return crdb.NewNoOpStatement(scheduledEvent), nil
+}
+
+func (u *userLifecycleExecutor) applyAction(ctx context.Context, action *query.UserLifecycleAction) (err error) {
+	ctx = authz.WithInstanceID(ctx, action.InstanceID)
+	ctx = authz.SetCtxData(ctx, authz.CtxData{UserID: NotifyUserID, OrgID: action.ResourceOwner})
+
+	switch action.Action {
+	case domain.UserLifecycleActionNotifyDeactivation,
+		domain.UserLifecycleActionNotifyRemoval:
+		return u.commands.AddHumanLifecycleNotification(ctx, action.UserID, action.ResourceOwner, action.Action, action.DueDate)
+	case domain.UserLifecycleActionDeactivate:
+		_, err = u.commands.DeactivateUser(ctx, action.UserID, action.ResourceOwner)
+		return err
+	case domain.UserLifecycleActionRemove:
+		cascadingUserMemberships, cascadingGrantIDs, err := userCascades(ctx, u.queries, action.UserID)
+		if err != nil {
+			return err
+		}
+		_, err = u.commands.RemoveUser(ctx, action.UserID, action.ResourceOwner, cascadingUserMemberships, cascadingGrantIDs...)
+		return err
+	}
+	return nil
+}
@@ -13,7 +13,9 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
 	"github.com/zitadel/zitadel/internal/notification/types"
 	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/repository/deviceauth"
 	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
 )
 
 const (
@@ -28,7 +30,9 @@ type userNotifier struct {
 	metricSuccessfulDeliveriesEmail,
 	metricFailedDeliveriesEmail,
 	metricSuccessfulDeliveriesSMS,
-	metricFailedDeliveriesSMS string
+	metricFailedDeliveriesSMS,
+	metricSuccessfulDeliveriesJSON,
+	metricFailedDeliveriesJSON string
 }
 
 func NewUserNotifier(
@@ -40,7 +44,9 @@ func NewUserNotifier(
 	metricSuccessfulDeliveriesEmail,
 	metricFailedDeliveriesEmail,
 	metricSuccessfulDeliveriesSMS,
-	metricFailedDeliveriesSMS string,
+	metricFailedDeliveriesSMS,
+	metricSuccessfulDeliveriesJSON,
+	metricFailedDeliveriesJSON string,
 ) *userNotifier {
 	p := new(userNotifier)
 	config.ProjectionName = UserNotificationsProjectionTable
@@ -53,6 +59,8 @@ func NewUserNotifier(
 	p.metricFailedDeliveriesEmail = metricFailedDeliveriesEmail
 	p.metricSuccessfulDeliveriesSMS = metricSuccessfulDeliveriesSMS
 	p.metricFailedDeliveriesSMS = metricFailedDeliveriesSMS
+	p.metricSuccessfulDeliveriesJSON = metricSuccessfulDeliveriesJSON
+	p.metricFailedDeliveriesJSON = metricFailedDeliveriesJSON
 	projection.NotificationsProjection = p
 	return p
 }
@@ -106,6 +114,48 @@ func (u *userNotifier) reducers() []handler.AggregateReducer {
 					Event:  user.HumanPasswordChangedType,
 					Reduce: u.reducePasswordChanged,
 				},
+				{
+					Event:  user.HumanDeviceTrustedType,
+					Reduce: u.reduceDeviceTrusted,
+				},
+				{
+					Event:  user.HumanLifecycleNotificationAddedType,
+					Reduce: u.reduceLifecycleNotificationAdded,
+				},
+			},
+		},
+		{
+			Aggregate: usergrant.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  usergrant.UserGrantRequestedType,
+					Reduce: u.reduceUserGrantRequested,
+				},
+				{
+					Event:  usergrant.UserGrantRequestApprovedType,
+					Reduce: u.reduceUserGrantRequestApproved,
+				},
+				{
+					Event:  usergrant.UserGrantRequestDeniedType,
+					Reduce: u.reduceUserGrantRequestDenied,
+				},
+			},
+		},
+		{
+			Aggregate: deviceauth.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  deviceauth.AddedEventType,
+					Reduce: u.reduceBackchannelAuthAdded,
+				},
+				{
+					Event:  deviceauth.ApprovedEventType,
+					Reduce: u.reduceBackchannelAuthApproved,
+				},
+				{
+					Event:  deviceauth.CanceledEventType,
+					Reduce: u.reduceBackchannelAuthCanceled,
+				},
 			},
 		},
 	}
@@ -531,6 +581,135 @@ func (u *userNotifier) reducePasswordChanged(event eventstore.Event) (*handler.S
 	return crdb.NewNoOpStatement(e), nil
 }
 
+func (u *userNotifier) reduceDeviceTrusted(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanDeviceTrustedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Tq8vn", "reduce.wrong.event.type %s", user.HumanDeviceTrustedType)
+	}
+	ctx := HandlerContext(event.Aggregate())
+	alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, map[string]interface{}{"userAgentID": e.UserAgentID}, user.AggregateType, user.HumanDeviceTrustedSentType)
+	if err != nil {
+		return nil, err
+	}
+	if alreadyHandled {
+		return crdb.NewNoOpStatement(e), nil
+	}
+	colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+	if err != nil {
+		return nil, err
+	}
+
+	notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID, false)
+	if err != nil {
+		return nil, err
+	}
+	translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.DeviceTrustedMessageType)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, origin, err := u.queries.Origin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = types.SendEmail(
+		ctx,
+		string(template.Template),
+		translator,
+		notifyUser,
+		u.queries.GetSMTPConfig,
+		u.queries.GetFileSystemProvider,
+		u.queries.GetLogProvider,
+		colors,
+		u.assetsPrefix(ctx),
+		e,
+		u.metricSuccessfulDeliveriesEmail,
+		u.metricFailedDeliveriesEmail,
+	).SendDeviceTrusted(notifyUser, origin, e.Name, e.RemoteIP)
+	if err != nil {
+		return nil, err
+	}
+	err = u.commands.HumanDeviceTrustedSent(ctx, e.Aggregate().ID, e.Aggregate().ResourceOwner, e.UserAgentID)
+	if err != nil {
+		return nil, err
+	}
+	return crdb.NewNoOpStatement(e), nil
+}
+
+func (u *userNotifier) reduceLifecycleNotificationAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanLifecycleNotificationAddedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Lc2nq", "reduce.wrong.event.type %s", user.HumanLifecycleNotificationAddedType)
+	}
+	messageType := domain.UserDeactivationMessageType
+	if e.Action == domain.UserLifecycleActionNotifyRemoval {
+		messageType = domain.UserRemovalMessageType
+	}
+	ctx := HandlerContext(event.Aggregate())
+	alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, map[string]interface{}{"action": e.Action}, user.AggregateType, user.HumanLifecycleNotificationSentType)
+	if err != nil {
+		return nil, err
+	}
+	if alreadyHandled {
+		return crdb.NewNoOpStatement(e), nil
+	}
+	colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+	if err != nil {
+		return nil, err
+	}
+
+	notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID, false)
+	if err != nil {
+		return nil, err
+	}
+	translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, messageType)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, origin, err := u.queries.Origin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	notify := types.SendEmail(
+		ctx,
+		string(template.Template),
+		translator,
+		notifyUser,
+		u.queries.GetSMTPConfig,
+		u.queries.GetFileSystemProvider,
+		u.queries.GetLogProvider,
+		colors,
+		u.assetsPrefix(ctx),
+		e,
+		u.metricSuccessfulDeliveriesEmail,
+		u.metricFailedDeliveriesEmail,
+	)
+	if e.Action == domain.UserLifecycleActionNotifyRemoval {
+		err = notify.SendUserRemoval(notifyUser, origin, e.ScheduledAt)
+	} else {
+		err = notify.SendUserDeactivation(notifyUser, origin, e.ScheduledAt)
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = u.commands.HumanLifecycleNotificationSent(ctx, e.Aggregate().ID, e.Aggregate().ResourceOwner, e.Action)
+	if err != nil {
+		return nil, err
+	}
+	return crdb.NewNoOpStatement(e), nil
+}
+
 func (u *userNotifier) reducePhoneCodeAdded(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*user.HumanPhoneCodeAddedEvent)
 	if !ok {
@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/pseudo"
+)
+
+const (
+	UserSelfDeletionExecutorProjectionTable = "projections.user_self_deletion_executor"
+)
+
+// userSelfDeletionExecutor removes the users whose requested deletion passed the grace period
+type userSelfDeletionExecutor struct {
+	crdb.StatementHandler
+	commands *command.Commands
+	queries  *NotificationQueries
+}
+
+func NewUserSelfDeletionExecutor(
+	ctx context.Context,
+	handlerCfg crdb.StatementHandlerConfig,
+	commands *command.Commands,
+	queries *NotificationQueries,
+) *userSelfDeletionExecutor {
+	p := new(userSelfDeletionExecutor)
+	handlerCfg.ProjectionName = UserSelfDeletionExecutorProjectionTable
+	handlerCfg.Reducers = p.reducers()
+	handlerCfg.ConcurrentInstances = math.MaxInt
+	p.StatementHandler = crdb.NewStatementHandler(ctx, handlerCfg)
+	p.commands = commands
+	p.queries = queries
+	return p
+}
+
+func (u *userSelfDeletionExecutor) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{{
+		Aggregate: pseudo.AggregateType,
+		EventRedusers: []handler.EventReducer{{
+			Event:  pseudo.ScheduledEventType,
+			Reduce: u.removeDueUsers,
+		}},
+	}}
+}
+
+func (u *userSelfDeletionExecutor) removeDueUsers(event eventstore.Event) (*handler.Statement, error) {
+	ctx := call.WithTimestamp(context.Background())
+	scheduledEvent, ok := event.(*pseudo.ScheduledEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Dq4mv", "reduce.wrong.event.type %s", event.Type())
+	}
+
+	isDue, err := query.NewUserSelfDeletionDueSearchQuery(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	dueDeletions, err := u.queries.Queries.SearchUserSelfDeletions(ctx, scheduledEvent.InstanceIDs, &query.UserSelfDeletionSearchQueries{
+		SearchRequest: query.SearchRequest{
+			SortingColumn: query.UserSelfDeletionColumnScheduledAt,
+			Asc:           true,
+		},
+		Queries: []query.SearchQuery{isDue},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var errs int
+	for _, deletion := range dueDeletions.UserSelfDeletions {
+		if err = u.removeUser(ctx, deletion); err != nil {
+			errs++
+			logging.WithFields("instance", deletion.InstanceID, "user", deletion.UserID).WithError(err).Warn("removing user after self deletion failed")
+		}
+	}
+	if errs > 0 {
+		return nil, fmt.Errorf("removing %d of %d users failed", errs, dueDeletions.Count)
+	}
+
+	return crdb.NewNoOpStatement(scheduledEvent), nil
+}
+
+func (u *userSelfDeletionExecutor) removeUser(ctx context.Context, deletion *query.UserSelfDeletion) error {
+	ctx = authz.WithInstanceID(ctx, deletion.InstanceID)
+	ctx = authz.SetCtxData(ctx, authz.CtxData{UserID: NotifyUserID, OrgID: deletion.ResourceOwner})
+
+	cascadingUserMemberships, cascadingGrantIDs, err := userCascades(ctx, u.queries, deletion.UserID)
+	if err != nil {
+		return err
+	}
+	_, err = u.commands.RemoveHumanAfterSelfDeletion(ctx, deletion.UserID, deletion.ResourceOwner, cascadingUserMemberships, cascadingGrantIDs...)
+	return err
+}
+
+// userCascades returns the memberships and grants of the user, which have to be removed together with the user
+func userCascades(ctx context.Context, queries *NotificationQueries, userID string) ([]*command.CascadingMembership, []string, error) {
+	userGrantUserID, err := query.NewUserGrantUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	grants, err := queries.UserGrants(ctx, &query.UserGrantsQueries{Queries: []query.SearchQuery{userGrantUserID}}, true, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	membershipUserID, err := query.NewMembershipUserIDQuery(userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	memberships, err := queries.Memberships(ctx, &query.MembershipSearchQuery{Queries: []query.SearchQuery{membershipUserID}}, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cascadingMemberships(memberships.Memberships), userGrantsToIDs(grants.UserGrants), nil
+}
+
+func cascadingMemberships(memberships []*query.Membership) []*command.CascadingMembership {
+	cascades := make([]*command.CascadingMembership, len(memberships))
+	for i, membership := range memberships {
+		cascades[i] = &command.CascadingMembership{
+			UserID:        membership.UserID,
+			ResourceOwner: membership.ResourceOwner,
+		}
+		if membership.IAM != nil {
+			cascades[i].IAM = &command.CascadingIAMMembership{IAMID: membership.IAM.IAMID}
+		}
+		if membership.Org != nil {
+			cascades[i].Org = &command.CascadingOrgMembership{OrgID: membership.Org.OrgID}
+		}
+		if membership.Project != nil {
+			cascades[i].Project = &command.CascadingProjectMembership{ProjectID: membership.Project.ProjectID}
+		}
+		if membership.ProjectGrant != nil {
+			cascades[i].ProjectGrant = &command.CascadingProjectGrantMembership{ProjectID: membership.ProjectGrant.ProjectID, GrantID: membership.ProjectGrant.GrantID}
+		}
+	}
+	return cascades
+}
+
+func userGrantsToIDs(userGrants []*query.UserGrant) []string {
+	converted := make([]string, len(userGrants))
+	for i, grant := range userGrants {
+		converted[i] = grant.ID
+	}
+	return converted
+}
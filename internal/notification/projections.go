@@ -30,6 +30,10 @@ func Start(
 	userHandlerCustomConfig projection.CustomConfig,
 	quotaHandlerCustomConfig projection.CustomConfig,
 	telemetryHandlerCustomConfig projection.CustomConfig,
+	userSelfDeletionHandlerCustomConfig projection.CustomConfig,
+	userLifecycleHandlerCustomConfig projection.CustomConfig,
+	userImportHandlerCustomConfig projection.CustomConfig,
+	secretReencryptionHandlerCustomConfig projection.CustomConfig,
 	telemetryCfg handlers.TelemetryPusherConfig,
 	externalDomain string,
 	externalPort uint16,
@@ -68,6 +72,8 @@ func Start(
 		metricFailedDeliveriesEmail,
 		metricSuccessfulDeliveriesSMS,
 		metricFailedDeliveriesSMS,
+		metricSuccessfulDeliveriesJSON,
+		metricFailedDeliveriesJSON,
 	).Start()
 	handlers.NewQuotaNotifier(
 		ctx,
@@ -77,6 +83,28 @@ func Start(
 		metricSuccessfulDeliveriesJSON,
 		metricFailedDeliveriesJSON,
 	).Start()
+	handlers.NewUserSelfDeletionExecutor(
+		ctx,
+		projection.ApplyCustomConfig(userSelfDeletionHandlerCustomConfig),
+		commands,
+		q,
+	).Start()
+	handlers.NewUserLifecycleExecutor(
+		ctx,
+		projection.ApplyCustomConfig(userLifecycleHandlerCustomConfig),
+		commands,
+		q,
+	).Start()
+	handlers.NewUserImportExecutor(
+		ctx,
+		projection.ApplyCustomConfig(userImportHandlerCustomConfig),
+		commands,
+	).Start()
+	handlers.NewSecretReencryptionExecutor(
+		ctx,
+		projection.ApplyCustomConfig(secretReencryptionHandlerCustomConfig),
+		commands,
+	).Start()
 	if telemetryCfg.Enabled {
 		handlers.NewTelemetryPusher(
 			ctx,
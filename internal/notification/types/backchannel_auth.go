@@ -0,0 +1,15 @@
+package types
+
+import (
+	"github.com/zitadel/zitadel/internal/api/ui/login"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func (notify Notify) SendBackchannelAuthRequested(user *query.NotifyUser, origin, userCode, applicationName, bindingMessage string) error {
+	url := login.DeviceAuthUserCodeLink(origin, userCode)
+	args := make(map[string]interface{})
+	args["ApplicationName"] = applicationName
+	args["BindingMessage"] = bindingMessage
+	return notify(url, args, domain.BackchannelAuthRequestedMessageType, true)
+}
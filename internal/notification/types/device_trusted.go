@@ -0,0 +1,15 @@
+package types
+
+import (
+	"github.com/zitadel/zitadel/internal/api/ui/console"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func (notify Notify) SendDeviceTrusted(user *query.NotifyUser, origin, deviceName, remoteIP string) error {
+	url := console.LoginHintLink(origin, user.PreferredLoginName)
+	args := make(map[string]interface{})
+	args["DeviceName"] = deviceName
+	args["RemoteIP"] = remoteIP
+	return notify(url, args, domain.DeviceTrustedMessageType, true)
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/api/ui/console"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func (notify Notify) SendUserGrantRequested(user *query.NotifyUser, origin, requesterLoginName, projectName string, roleKeys []string, reason string) error {
+	url := console.LoginHintLink(origin, user.PreferredLoginName)
+	args := make(map[string]interface{})
+	args["RequesterLoginName"] = requesterLoginName
+	args["ProjectName"] = projectName
+	args["Roles"] = strings.Join(roleKeys, ", ")
+	args["Reason"] = reason
+	return notify(url, args, domain.UserGrantRequestedMessageType, true)
+}
+
+func (notify Notify) SendUserGrantApproved(user *query.NotifyUser, origin, projectName string, roleKeys []string) error {
+	url := console.LoginHintLink(origin, user.PreferredLoginName)
+	args := make(map[string]interface{})
+	args["ProjectName"] = projectName
+	args["Roles"] = strings.Join(roleKeys, ", ")
+	return notify(url, args, domain.UserGrantApprovedMessageType, true)
+}
+
+func (notify Notify) SendUserGrantDenied(user *query.NotifyUser, origin, projectName string, roleKeys []string, reason string) error {
+	url := console.LoginHintLink(origin, user.PreferredLoginName)
+	args := make(map[string]interface{})
+	args["ProjectName"] = projectName
+	args["Roles"] = strings.Join(roleKeys, ", ")
+	args["Reason"] = reason
+	return notify(url, args, domain.UserGrantDeniedMessageType, true)
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/ui/console"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func (notify Notify) SendUserDeactivation(user *query.NotifyUser, origin string, scheduledAt time.Time) error {
+	url := console.LoginHintLink(origin, user.PreferredLoginName)
+	args := make(map[string]interface{})
+	args["Date"] = scheduledAt.Format("2006-01-02")
+	return notify(url, args, domain.UserDeactivationMessageType, true)
+}
+
+func (notify Notify) SendUserRemoval(user *query.NotifyUser, origin string, scheduledAt time.Time) error {
+	url := console.LoginHintLink(origin, user.PreferredLoginName)
+	args := make(map[string]interface{})
+	args["Date"] = scheduledAt.Format("2006-01-02")
+	return notify(url, args, domain.UserRemovalMessageType, true)
+}
@@ -5,6 +5,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/cache"
 	"github.com/zitadel/zitadel/internal/domain"
 	caos_errs "github.com/zitadel/zitadel/internal/errors"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -23,6 +25,7 @@ type OIDCSessionAccessTokenReadModel struct {
 	Scope                 []string
 	AuthMethods           []domain.UserAuthMethodType
 	AuthTime              time.Time
+	AuthorizationDetails  domain.AuthorizationDetails
 	State                 domain.OIDCSessionState
 	AccessTokenID         string
 	AccessTokenCreation   time.Time
@@ -75,6 +78,7 @@ func (wm *OIDCSessionAccessTokenReadModel) reduceAdded(e *oidcsession.AddedEvent
 	wm.Scope = e.Scope
 	wm.AuthMethods = e.AuthMethods
 	wm.AuthTime = e.AuthTime
+	wm.AuthorizationDetails = e.AuthorizationDetails
 	wm.State = domain.OIDCSessionStateActive
 }
 
@@ -99,7 +103,30 @@ func (q *Queries) ActiveAccessTokenByToken(ctx context.Context, token string) (m
 	if len(split) != 2 {
 		return nil, caos_errs.ThrowPermissionDenied(nil, "QUERY-LJK2W", "Errors.OIDCSession.Token.Invalid")
 	}
-	model, err = q.accessTokenByOIDCSessionAndTokenID(ctx, split[0], split[1])
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	cached, err := q.caches.accessToken.Get(ctx, instanceID+":"+token, func(ctx context.Context) (*cachedAccessToken, []cache.Aggregate, error) {
+		model, err := q.activeAccessToken(ctx, split[0], split[1])
+		if err != nil {
+			return nil, nil, err
+		}
+		return newCachedAccessToken(model), []cache.Aggregate{
+			{InstanceID: instanceID, Type: oidcsession.AggregateType, ID: model.AggregateID},
+			{InstanceID: instanceID, Type: session.AggregateType, ID: model.SessionID},
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	model = cached.model()
+	// the token might have expired since it was cached
+	if !model.AccessTokenExpiration.After(time.Now()) {
+		return nil, caos_errs.ThrowPermissionDenied(nil, "QUERY-SAF3rf", "Errors.OIDCSession.Token.Expired")
+	}
+	return model, nil
+}
+
+func (q *Queries) activeAccessToken(ctx context.Context, oidcSessionID, tokenID string) (*OIDCSessionAccessTokenReadModel, error) {
+	model, err := q.accessTokenByOIDCSessionAndTokenID(ctx, oidcSessionID, tokenID)
 	if err != nil {
 		return nil, err
 	}
@@ -58,6 +58,11 @@ type OIDCApp struct {
 	AdditionalOrigins        database.StringArray
 	AllowedOrigins           database.StringArray
 	SkipNativeAppSuccessPage bool
+	IDTokenSigningAlgorithm  string
+	ConsentRequired          bool
+
+	BackchannelTokenDeliveryMode          domain.OIDCBackchannelTokenDeliveryMode
+	BackchannelClientNotificationEndpoint string
 }
 
 type SAMLApp struct {
@@ -246,6 +251,22 @@ var (
 		name:  projection.AppOIDCConfigColumnSkipNativeAppSuccessPage,
 		table: appOIDCConfigsTable,
 	}
+	AppOIDCConfigColumnIDTokenSigningAlgorithm = Column{
+		name:  projection.AppOIDCConfigColumnIDTokenSigningAlgorithm,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnConsentRequired = Column{
+		name:  projection.AppOIDCConfigColumnConsentRequired,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnBackchannelTokenDeliveryMode = Column{
+		name:  projection.AppOIDCConfigColumnBackchannelTokenDeliveryMode,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnBackchannelClientNotificationEndpoint = Column{
+		name:  projection.AppOIDCConfigColumnBackchannelClientNotificationEndpoint,
+		table: appOIDCConfigsTable,
+	}
 )
 
 func (q *Queries) AppByProjectAndAppID(ctx context.Context, shouldTriggerBulk bool, projectID, appID string, withOwnerRemoved bool) (_ *App, err error) {
@@ -541,6 +562,10 @@ func prepareAppQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder,
 			AppOIDCConfigColumnClockSkew.identifier(),
 			AppOIDCConfigColumnAdditionalOrigins.identifier(),
 			AppOIDCConfigColumnSkipNativeAppSuccessPage.identifier(),
+			AppOIDCConfigColumnIDTokenSigningAlgorithm.identifier(),
+			AppOIDCConfigColumnConsentRequired.identifier(),
+			AppOIDCConfigColumnBackchannelTokenDeliveryMode.identifier(),
+			AppOIDCConfigColumnBackchannelClientNotificationEndpoint.identifier(),
 
 			AppSAMLConfigColumnAppID.identifier(),
 			AppSAMLConfigColumnEntityID.identifier(),
@@ -590,6 +615,10 @@ func prepareAppQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder,
 				&oidcConfig.clockSkew,
 				&oidcConfig.additionalOrigins,
 				&oidcConfig.skipNativeAppSuccessPage,
+				&oidcConfig.idTokenSigningAlgorithm,
+				&oidcConfig.consentRequired,
+				&oidcConfig.backchannelTokenDeliveryMode,
+				&oidcConfig.backchannelClientNotificationEndpoint,
 
 				&samlConfig.appID,
 				&samlConfig.entityID,
@@ -711,6 +740,10 @@ func prepareAppsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder
 			AppOIDCConfigColumnClockSkew.identifier(),
 			AppOIDCConfigColumnAdditionalOrigins.identifier(),
 			AppOIDCConfigColumnSkipNativeAppSuccessPage.identifier(),
+			AppOIDCConfigColumnIDTokenSigningAlgorithm.identifier(),
+			AppOIDCConfigColumnConsentRequired.identifier(),
+			AppOIDCConfigColumnBackchannelTokenDeliveryMode.identifier(),
+			AppOIDCConfigColumnBackchannelClientNotificationEndpoint.identifier(),
 
 			AppSAMLConfigColumnAppID.identifier(),
 			AppSAMLConfigColumnEntityID.identifier(),
@@ -763,6 +796,10 @@ func prepareAppsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder
 					&oidcConfig.clockSkew,
 					&oidcConfig.additionalOrigins,
 					&oidcConfig.skipNativeAppSuccessPage,
+					&oidcConfig.idTokenSigningAlgorithm,
+					&oidcConfig.consentRequired,
+					&oidcConfig.backchannelTokenDeliveryMode,
+					&oidcConfig.backchannelClientNotificationEndpoint,
 
 					&samlConfig.appID,
 					&samlConfig.entityID,
@@ -835,6 +872,11 @@ type sqlOIDCConfig struct {
 	responseTypes            database.EnumArray[domain.OIDCResponseType]
 	grantTypes               database.EnumArray[domain.OIDCGrantType]
 	skipNativeAppSuccessPage sql.NullBool
+	idTokenSigningAlgorithm  sql.NullString
+	consentRequired          sql.NullBool
+
+	backchannelTokenDeliveryMode          sql.NullInt16
+	backchannelClientNotificationEndpoint sql.NullString
 }
 
 func (c sqlOIDCConfig) set(app *App) {
@@ -858,6 +900,11 @@ func (c sqlOIDCConfig) set(app *App) {
 		ResponseTypes:            c.responseTypes,
 		GrantTypes:               c.grantTypes,
 		SkipNativeAppSuccessPage: c.skipNativeAppSuccessPage.Bool,
+		IDTokenSigningAlgorithm:  c.idTokenSigningAlgorithm.String,
+		ConsentRequired:          c.consentRequired.Bool,
+
+		BackchannelTokenDeliveryMode:          domain.OIDCBackchannelTokenDeliveryMode(c.backchannelTokenDeliveryMode.Int16),
+		BackchannelClientNotificationEndpoint: c.backchannelClientNotificationEndpoint.String,
 	}
 	compliance := domain.GetOIDCCompliance(app.OIDCConfig.Version, app.OIDCConfig.AppType, app.OIDCConfig.GrantTypes, app.OIDCConfig.ResponseTypes, app.OIDCConfig.AuthMethodType, app.OIDCConfig.RedirectURIs)
 	app.OIDCConfig.ComplianceProblems = compliance.Problems
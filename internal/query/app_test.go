@@ -15,98 +15,106 @@ import (
 )
 
 var (
-	expectedAppQuery = regexp.QuoteMeta(`SELECT projections.apps5.id,` +
-		` projections.apps5.name,` +
-		` projections.apps5.project_id,` +
-		` projections.apps5.creation_date,` +
-		` projections.apps5.change_date,` +
-		` projections.apps5.resource_owner,` +
-		` projections.apps5.state,` +
-		` projections.apps5.sequence,` +
+	expectedAppQuery = regexp.QuoteMeta(`SELECT projections.apps8.id,` +
+		` projections.apps8.name,` +
+		` projections.apps8.project_id,` +
+		` projections.apps8.creation_date,` +
+		` projections.apps8.change_date,` +
+		` projections.apps8.resource_owner,` +
+		` projections.apps8.state,` +
+		` projections.apps8.sequence,` +
 		// api config
-		` projections.apps5_api_configs.app_id,` +
-		` projections.apps5_api_configs.client_id,` +
-		` projections.apps5_api_configs.auth_method,` +
+		` projections.apps8_api_configs.app_id,` +
+		` projections.apps8_api_configs.client_id,` +
+		` projections.apps8_api_configs.auth_method,` +
 		// oidc config
-		` projections.apps5_oidc_configs.app_id,` +
-		` projections.apps5_oidc_configs.version,` +
-		` projections.apps5_oidc_configs.client_id,` +
-		` projections.apps5_oidc_configs.redirect_uris,` +
-		` projections.apps5_oidc_configs.response_types,` +
-		` projections.apps5_oidc_configs.grant_types,` +
-		` projections.apps5_oidc_configs.application_type,` +
-		` projections.apps5_oidc_configs.auth_method_type,` +
-		` projections.apps5_oidc_configs.post_logout_redirect_uris,` +
-		` projections.apps5_oidc_configs.is_dev_mode,` +
-		` projections.apps5_oidc_configs.access_token_type,` +
-		` projections.apps5_oidc_configs.access_token_role_assertion,` +
-		` projections.apps5_oidc_configs.id_token_role_assertion,` +
-		` projections.apps5_oidc_configs.id_token_userinfo_assertion,` +
-		` projections.apps5_oidc_configs.clock_skew,` +
-		` projections.apps5_oidc_configs.additional_origins,` +
-		` projections.apps5_oidc_configs.skip_native_app_success_page,` +
+		` projections.apps8_oidc_configs.app_id,` +
+		` projections.apps8_oidc_configs.version,` +
+		` projections.apps8_oidc_configs.client_id,` +
+		` projections.apps8_oidc_configs.redirect_uris,` +
+		` projections.apps8_oidc_configs.response_types,` +
+		` projections.apps8_oidc_configs.grant_types,` +
+		` projections.apps8_oidc_configs.application_type,` +
+		` projections.apps8_oidc_configs.auth_method_type,` +
+		` projections.apps8_oidc_configs.post_logout_redirect_uris,` +
+		` projections.apps8_oidc_configs.is_dev_mode,` +
+		` projections.apps8_oidc_configs.access_token_type,` +
+		` projections.apps8_oidc_configs.access_token_role_assertion,` +
+		` projections.apps8_oidc_configs.id_token_role_assertion,` +
+		` projections.apps8_oidc_configs.id_token_userinfo_assertion,` +
+		` projections.apps8_oidc_configs.clock_skew,` +
+		` projections.apps8_oidc_configs.additional_origins,` +
+		` projections.apps8_oidc_configs.skip_native_app_success_page,` +
+		` projections.apps8_oidc_configs.id_token_signing_algorithm,` +
+		` projections.apps8_oidc_configs.consent_required,` +
+		` projections.apps8_oidc_configs.backchannel_token_delivery_mode,` +
+		` projections.apps8_oidc_configs.backchannel_client_notification_endpoint,` +
 		//saml config
-		` projections.apps5_saml_configs.app_id,` +
-		` projections.apps5_saml_configs.entity_id,` +
-		` projections.apps5_saml_configs.metadata,` +
-		` projections.apps5_saml_configs.metadata_url` +
-		` FROM projections.apps5` +
-		` LEFT JOIN projections.apps5_api_configs ON projections.apps5.id = projections.apps5_api_configs.app_id AND projections.apps5.instance_id = projections.apps5_api_configs.instance_id` +
-		` LEFT JOIN projections.apps5_oidc_configs ON projections.apps5.id = projections.apps5_oidc_configs.app_id AND projections.apps5.instance_id = projections.apps5_oidc_configs.instance_id` +
-		` LEFT JOIN projections.apps5_saml_configs ON projections.apps5.id = projections.apps5_saml_configs.app_id AND projections.apps5.instance_id = projections.apps5_saml_configs.instance_id` +
+		` projections.apps8_saml_configs.app_id,` +
+		` projections.apps8_saml_configs.entity_id,` +
+		` projections.apps8_saml_configs.metadata,` +
+		` projections.apps8_saml_configs.metadata_url` +
+		` FROM projections.apps8` +
+		` LEFT JOIN projections.apps8_api_configs ON projections.apps8.id = projections.apps8_api_configs.app_id AND projections.apps8.instance_id = projections.apps8_api_configs.instance_id` +
+		` LEFT JOIN projections.apps8_oidc_configs ON projections.apps8.id = projections.apps8_oidc_configs.app_id AND projections.apps8.instance_id = projections.apps8_oidc_configs.instance_id` +
+		` LEFT JOIN projections.apps8_saml_configs ON projections.apps8.id = projections.apps8_saml_configs.app_id AND projections.apps8.instance_id = projections.apps8_saml_configs.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
-	expectedAppsQuery = regexp.QuoteMeta(`SELECT projections.apps5.id,` +
-		` projections.apps5.name,` +
-		` projections.apps5.project_id,` +
-		` projections.apps5.creation_date,` +
-		` projections.apps5.change_date,` +
-		` projections.apps5.resource_owner,` +
-		` projections.apps5.state,` +
-		` projections.apps5.sequence,` +
+	expectedAppsQuery = regexp.QuoteMeta(`SELECT projections.apps8.id,` +
+		` projections.apps8.name,` +
+		` projections.apps8.project_id,` +
+		` projections.apps8.creation_date,` +
+		` projections.apps8.change_date,` +
+		` projections.apps8.resource_owner,` +
+		` projections.apps8.state,` +
+		` projections.apps8.sequence,` +
 		// api config
-		` projections.apps5_api_configs.app_id,` +
-		` projections.apps5_api_configs.client_id,` +
-		` projections.apps5_api_configs.auth_method,` +
+		` projections.apps8_api_configs.app_id,` +
+		` projections.apps8_api_configs.client_id,` +
+		` projections.apps8_api_configs.auth_method,` +
 		// oidc config
-		` projections.apps5_oidc_configs.app_id,` +
-		` projections.apps5_oidc_configs.version,` +
-		` projections.apps5_oidc_configs.client_id,` +
-		` projections.apps5_oidc_configs.redirect_uris,` +
-		` projections.apps5_oidc_configs.response_types,` +
-		` projections.apps5_oidc_configs.grant_types,` +
-		` projections.apps5_oidc_configs.application_type,` +
-		` projections.apps5_oidc_configs.auth_method_type,` +
-		` projections.apps5_oidc_configs.post_logout_redirect_uris,` +
-		` projections.apps5_oidc_configs.is_dev_mode,` +
-		` projections.apps5_oidc_configs.access_token_type,` +
-		` projections.apps5_oidc_configs.access_token_role_assertion,` +
-		` projections.apps5_oidc_configs.id_token_role_assertion,` +
-		` projections.apps5_oidc_configs.id_token_userinfo_assertion,` +
-		` projections.apps5_oidc_configs.clock_skew,` +
-		` projections.apps5_oidc_configs.additional_origins,` +
-		` projections.apps5_oidc_configs.skip_native_app_success_page,` +
+		` projections.apps8_oidc_configs.app_id,` +
+		` projections.apps8_oidc_configs.version,` +
+		` projections.apps8_oidc_configs.client_id,` +
+		` projections.apps8_oidc_configs.redirect_uris,` +
+		` projections.apps8_oidc_configs.response_types,` +
+		` projections.apps8_oidc_configs.grant_types,` +
+		` projections.apps8_oidc_configs.application_type,` +
+		` projections.apps8_oidc_configs.auth_method_type,` +
+		` projections.apps8_oidc_configs.post_logout_redirect_uris,` +
+		` projections.apps8_oidc_configs.is_dev_mode,` +
+		` projections.apps8_oidc_configs.access_token_type,` +
+		` projections.apps8_oidc_configs.access_token_role_assertion,` +
+		` projections.apps8_oidc_configs.id_token_role_assertion,` +
+		` projections.apps8_oidc_configs.id_token_userinfo_assertion,` +
+		` projections.apps8_oidc_configs.clock_skew,` +
+		` projections.apps8_oidc_configs.additional_origins,` +
+		` projections.apps8_oidc_configs.skip_native_app_success_page,` +
+		` projections.apps8_oidc_configs.id_token_signing_algorithm,` +
+		` projections.apps8_oidc_configs.consent_required,` +
+		` projections.apps8_oidc_configs.backchannel_token_delivery_mode,` +
+		` projections.apps8_oidc_configs.backchannel_client_notification_endpoint,` +
 		//saml config
-		` projections.apps5_saml_configs.app_id,` +
-		` projections.apps5_saml_configs.entity_id,` +
-		` projections.apps5_saml_configs.metadata,` +
-		` projections.apps5_saml_configs.metadata_url,` +
+		` projections.apps8_saml_configs.app_id,` +
+		` projections.apps8_saml_configs.entity_id,` +
+		` projections.apps8_saml_configs.metadata,` +
+		` projections.apps8_saml_configs.metadata_url,` +
 		` COUNT(*) OVER ()` +
-		` FROM projections.apps5` +
-		` LEFT JOIN projections.apps5_api_configs ON projections.apps5.id = projections.apps5_api_configs.app_id AND projections.apps5.instance_id = projections.apps5_api_configs.instance_id` +
-		` LEFT JOIN projections.apps5_oidc_configs ON projections.apps5.id = projections.apps5_oidc_configs.app_id AND projections.apps5.instance_id = projections.apps5_oidc_configs.instance_id` +
-		` LEFT JOIN projections.apps5_saml_configs ON projections.apps5.id = projections.apps5_saml_configs.app_id AND projections.apps5.instance_id = projections.apps5_saml_configs.instance_id` +
+		` FROM projections.apps8` +
+		` LEFT JOIN projections.apps8_api_configs ON projections.apps8.id = projections.apps8_api_configs.app_id AND projections.apps8.instance_id = projections.apps8_api_configs.instance_id` +
+		` LEFT JOIN projections.apps8_oidc_configs ON projections.apps8.id = projections.apps8_oidc_configs.app_id AND projections.apps8.instance_id = projections.apps8_oidc_configs.instance_id` +
+		` LEFT JOIN projections.apps8_saml_configs ON projections.apps8.id = projections.apps8_saml_configs.app_id AND projections.apps8.instance_id = projections.apps8_saml_configs.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
-	expectedAppIDsQuery = regexp.QuoteMeta(`SELECT projections.apps5_api_configs.client_id,` +
-		` projections.apps5_oidc_configs.client_id` +
-		` FROM projections.apps5` +
-		` LEFT JOIN projections.apps5_api_configs ON projections.apps5.id = projections.apps5_api_configs.app_id AND projections.apps5.instance_id = projections.apps5_api_configs.instance_id` +
-		` LEFT JOIN projections.apps5_oidc_configs ON projections.apps5.id = projections.apps5_oidc_configs.app_id AND projections.apps5.instance_id = projections.apps5_oidc_configs.instance_id` +
+	expectedAppIDsQuery = regexp.QuoteMeta(`SELECT projections.apps8_api_configs.client_id,` +
+		` projections.apps8_oidc_configs.client_id` +
+		` FROM projections.apps8` +
+		` LEFT JOIN projections.apps8_api_configs ON projections.apps8.id = projections.apps8_api_configs.app_id AND projections.apps8.instance_id = projections.apps8_api_configs.instance_id` +
+		` LEFT JOIN projections.apps8_oidc_configs ON projections.apps8.id = projections.apps8_oidc_configs.app_id AND projections.apps8.instance_id = projections.apps8_oidc_configs.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
-	expectedProjectIDByAppQuery = regexp.QuoteMeta(`SELECT projections.apps5.project_id` +
-		` FROM projections.apps5` +
-		` LEFT JOIN projections.apps5_api_configs ON projections.apps5.id = projections.apps5_api_configs.app_id AND projections.apps5.instance_id = projections.apps5_api_configs.instance_id` +
-		` LEFT JOIN projections.apps5_oidc_configs ON projections.apps5.id = projections.apps5_oidc_configs.app_id AND projections.apps5.instance_id = projections.apps5_oidc_configs.instance_id` +
-		` LEFT JOIN projections.apps5_saml_configs ON projections.apps5.id = projections.apps5_saml_configs.app_id AND projections.apps5.instance_id = projections.apps5_saml_configs.instance_id` +
+	expectedProjectIDByAppQuery = regexp.QuoteMeta(`SELECT projections.apps8.project_id` +
+		` FROM projections.apps8` +
+		` LEFT JOIN projections.apps8_api_configs ON projections.apps8.id = projections.apps8_api_configs.app_id AND projections.apps8.instance_id = projections.apps8_api_configs.instance_id` +
+		` LEFT JOIN projections.apps8_oidc_configs ON projections.apps8.id = projections.apps8_oidc_configs.app_id AND projections.apps8.instance_id = projections.apps8_oidc_configs.instance_id` +
+		` LEFT JOIN projections.apps8_saml_configs ON projections.apps8.id = projections.apps8_saml_configs.app_id AND projections.apps8.instance_id = projections.apps8_saml_configs.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
 	expectedProjectByAppQuery = regexp.QuoteMeta(`SELECT projections.projects3.id,` +
 		` projections.projects3.creation_date,` +
@@ -120,10 +128,10 @@ var (
 		` projections.projects3.has_project_check,` +
 		` projections.projects3.private_labeling_setting` +
 		` FROM projections.projects3` +
-		` JOIN projections.apps5 ON projections.projects3.id = projections.apps5.project_id AND projections.projects3.instance_id = projections.apps5.instance_id` +
-		` LEFT JOIN projections.apps5_api_configs ON projections.apps5.id = projections.apps5_api_configs.app_id AND projections.apps5.instance_id = projections.apps5_api_configs.instance_id` +
-		` LEFT JOIN projections.apps5_oidc_configs ON projections.apps5.id = projections.apps5_oidc_configs.app_id AND projections.apps5.instance_id = projections.apps5_oidc_configs.instance_id` +
-		` LEFT JOIN projections.apps5_saml_configs ON projections.apps5.id = projections.apps5_saml_configs.app_id AND projections.apps5.instance_id = projections.apps5_saml_configs.instance_id` +
+		` JOIN projections.apps8 ON projections.projects3.id = projections.apps8.project_id AND projections.projects3.instance_id = projections.apps8.instance_id` +
+		` LEFT JOIN projections.apps8_api_configs ON projections.apps8.id = projections.apps8_api_configs.app_id AND projections.apps8.instance_id = projections.apps8_api_configs.instance_id` +
+		` LEFT JOIN projections.apps8_oidc_configs ON projections.apps8.id = projections.apps8_oidc_configs.app_id AND projections.apps8.instance_id = projections.apps8_oidc_configs.instance_id` +
+		` LEFT JOIN projections.apps8_saml_configs ON projections.apps8.id = projections.apps8_saml_configs.app_id AND projections.apps8.instance_id = projections.apps8_saml_configs.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
 
 	appCols = database.StringArray{
@@ -157,6 +165,10 @@ var (
 		"clock_skew",
 		"additional_origins",
 		"skip_native_app_success_page",
+		"id_token_signing_algorithm",
+		"consent_required",
+		"backchannel_token_delivery_mode",
+		"backchannel_client_notification_endpoint",
 		//saml config
 		"app_id",
 		"entity_id",
@@ -228,6 +240,10 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -294,6 +310,10 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -363,6 +383,10 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"app-id",
 							"https://test.com/saml/metadata",
@@ -434,6 +458,10 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.StringArray{"additional.origin"},
 							false,
+							"",
+							false,
+							domain.OIDCBackchannelTokenDeliveryModePoll,
+							"",
 							// saml config
 							nil,
 							nil,
@@ -520,6 +548,10 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.StringArray{"additional.origin"},
 							false,
+							"",
+							false,
+							domain.OIDCBackchannelTokenDeliveryModePoll,
+							"",
 							// saml config
 							nil,
 							nil,
@@ -606,6 +638,10 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.StringArray{"additional.origin"},
 							false,
+							"",
+							false,
+							domain.OIDCBackchannelTokenDeliveryModePoll,
+							"",
 							// saml config
 							nil,
 							nil,
@@ -692,6 +728,10 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.StringArray{"additional.origin"},
 							false,
+							"",
+							false,
+							domain.OIDCBackchannelTokenDeliveryModePoll,
+							"",
 							// saml config
 							nil,
 							nil,
@@ -778,6 +818,10 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.StringArray{"additional.origin"},
 							false,
+							"",
+							false,
+							domain.OIDCBackchannelTokenDeliveryModePoll,
+							"",
 							// saml config
 							nil,
 							nil,
@@ -864,6 +908,10 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.StringArray{"additional.origin"},
 							true,
+							"ES256",
+							true,
+							domain.OIDCBackchannelTokenDeliveryModePing,
+							"https://client.ch/ciba",
 							// saml config
 							nil,
 							nil,
@@ -888,24 +936,28 @@ func Test_AppsPrepare(t *testing.T) {
 						Name:          "app-name",
 						ProjectID:     "project-id",
 						OIDCConfig: &OIDCApp{
-							Version:                  domain.OIDCVersionV1,
-							ClientID:                 "oidc-client-id",
-							RedirectURIs:             database.StringArray{"https://redirect.to/me"},
-							ResponseTypes:            database.EnumArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
-							GrantTypes:               database.EnumArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
-							AppType:                  domain.OIDCApplicationTypeNative,
-							AuthMethodType:           domain.OIDCAuthMethodTypeNone,
-							PostLogoutRedirectURIs:   database.StringArray{"post.logout.ch"},
-							IsDevMode:                false,
-							AccessTokenType:          domain.OIDCTokenTypeJWT,
-							AssertAccessTokenRole:    false,
-							AssertIDTokenRole:        false,
-							AssertIDTokenUserinfo:    true,
-							ClockSkew:                1 * time.Second,
-							AdditionalOrigins:        database.StringArray{"additional.origin"},
-							ComplianceProblems:       nil,
-							AllowedOrigins:           database.StringArray{"https://redirect.to", "additional.origin"},
-							SkipNativeAppSuccessPage: true,
+							Version:                               domain.OIDCVersionV1,
+							ClientID:                              "oidc-client-id",
+							RedirectURIs:                          database.StringArray{"https://redirect.to/me"},
+							ResponseTypes:                         database.EnumArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
+							GrantTypes:                            database.EnumArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
+							AppType:                               domain.OIDCApplicationTypeNative,
+							AuthMethodType:                        domain.OIDCAuthMethodTypeNone,
+							PostLogoutRedirectURIs:                database.StringArray{"post.logout.ch"},
+							IsDevMode:                             false,
+							AccessTokenType:                       domain.OIDCTokenTypeJWT,
+							AssertAccessTokenRole:                 false,
+							AssertIDTokenRole:                     false,
+							AssertIDTokenUserinfo:                 true,
+							ClockSkew:                             1 * time.Second,
+							AdditionalOrigins:                     database.StringArray{"additional.origin"},
+							ComplianceProblems:                    nil,
+							AllowedOrigins:                        database.StringArray{"https://redirect.to", "additional.origin"},
+							SkipNativeAppSuccessPage:              true,
+							IDTokenSigningAlgorithm:               "ES256",
+							ConsentRequired:                       true,
+							BackchannelTokenDeliveryMode:          domain.OIDCBackchannelTokenDeliveryModePing,
+							BackchannelClientNotificationEndpoint: "https://client.ch/ciba",
 						},
 					},
 				},
@@ -950,6 +1002,10 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.StringArray{"additional.origin"},
 							false,
+							"",
+							false,
+							domain.OIDCBackchannelTokenDeliveryModePoll,
+							"",
 							// saml config
 							nil,
 							nil,
@@ -987,6 +1043,10 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1024,6 +1084,10 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"saml-app-id",
 							"https://test.com/saml/metadata",
@@ -1192,6 +1256,10 @@ func Test_AppPrepare(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						// saml config
 						nil,
 						nil,
@@ -1250,6 +1318,10 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1313,6 +1385,10 @@ func Test_AppPrepare(t *testing.T) {
 							1 * time.Second,
 							database.StringArray{"additional.origin"},
 							false,
+							"",
+							false,
+							domain.OIDCBackchannelTokenDeliveryModePoll,
+							"",
 							// saml config
 							nil,
 							nil,
@@ -1391,6 +1467,10 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"app-id",
 							"https://test.com/saml/metadata",
@@ -1455,6 +1535,10 @@ func Test_AppPrepare(t *testing.T) {
 							1 * time.Second,
 							database.StringArray{"additional.origin"},
 							false,
+							"",
+							false,
+							domain.OIDCBackchannelTokenDeliveryModePoll,
+							"",
 							// saml config
 							nil,
 							nil,
@@ -1534,6 +1618,10 @@ func Test_AppPrepare(t *testing.T) {
 							1 * time.Second,
 							database.StringArray{"additional.origin"},
 							false,
+							"",
+							false,
+							domain.OIDCBackchannelTokenDeliveryModePoll,
+							"",
 							// saml config
 							nil,
 							nil,
@@ -1613,6 +1701,10 @@ func Test_AppPrepare(t *testing.T) {
 							1 * time.Second,
 							database.StringArray{"additional.origin"},
 							false,
+							"",
+							false,
+							domain.OIDCBackchannelTokenDeliveryModePoll,
+							"",
 							// saml config
 							nil,
 							nil,
@@ -1692,6 +1784,10 @@ func Test_AppPrepare(t *testing.T) {
 							1 * time.Second,
 							database.StringArray{"additional.origin"},
 							false,
+							"",
+							false,
+							domain.OIDCBackchannelTokenDeliveryModePoll,
+							"",
 							// saml config
 							nil,
 							nil,
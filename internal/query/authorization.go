@@ -0,0 +1,427 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// AuthorizationCheck asks whether a user has a role or a permission on a project.
+// Exactly one of Role and Permission is set.
+type AuthorizationCheck struct {
+	UserID    string
+	ProjectID string
+	// OrgID optionally restricts the check to the organization owning the project or a granted organization
+	OrgID string
+	// Role is a role key of the project, evaluated against the user grants
+	Role string
+	// Permission is a ZITADEL permission (e.g. project.read), evaluated against the memberships of the user
+	Permission string
+}
+
+type AuthorizationCheckResult struct {
+	Allowed bool
+	// OrgIDs contains the organizations in which the role or permission was granted
+	OrgIDs []string
+}
+
+type authorizationGrant struct {
+	UserID        string
+	ProjectID     string
+	ResourceOwner string
+	Roles         database.StringArray
+}
+
+type authorizationProject struct {
+	ID            string
+	ResourceOwner string
+}
+
+// CheckAuthorizations evaluates the checks with a fixed number of statements regardless of their number.
+// Only grants and memberships visible to the organization of the caller (ownerID) are taken into account:
+// on projects owned by it all of them, on projects granted to it only the ones inside the organization itself.
+func (q *Queries) CheckAuthorizations(ctx context.Context, ownerID string, checks []*AuthorizationCheck) (_ []*AuthorizationCheckResult, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	userIDs, projectIDs, hasPermissionChecks := authorizationCheckIDs(checks)
+	projects, err := q.authorizationProjects(ctx, projectIDs)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := q.authorizationGrants(ctx, userIDs, projectIDs)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		memberships  []*Membership
+		roleMappings []authz.RoleMapping
+	)
+	if hasPermissionChecks {
+		memberships, err = q.authorizationMemberships(ctx, userIDs)
+		if err != nil {
+			return nil, err
+		}
+		customRoleMappings, err := q.CustomRoleMappings(ctx)
+		if err != nil {
+			return nil, err
+		}
+		roleMappings = append(append(roleMappings, q.zitadelRoles...), customRoleMappings...)
+	}
+
+	results := make([]*AuthorizationCheckResult, len(checks))
+	for i, check := range checks {
+		projectOwner, ok := projects[check.ProjectID]
+		if !ok {
+			results[i] = &AuthorizationCheckResult{OrgIDs: []string{}}
+			continue
+		}
+		if check.Role != "" {
+			results[i] = checkAuthorizationRole(check, ownerID, projectOwner, grants)
+			continue
+		}
+		results[i] = checkAuthorizationPermission(check, ownerID, projectOwner, memberships, roleMappings)
+	}
+	return results, nil
+}
+
+// AuthorizedUsers lists the active user grants of the project containing the role,
+// including the roles granted to the groups of the users as checked by CheckAuthorizations,
+// restricted to the visibility of the organization of the caller (ownerID)
+func (q *Queries) AuthorizedUsers(ctx context.Context, ownerID, projectID, role, orgID string, request SearchRequest) (_ *UserGrants, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	queries := make([]SearchQuery, 0, 6)
+	projectQuery, err := NewUserGrantProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+	roleQuery, err := NewUserGrantRoleQuery(role)
+	if err != nil {
+		return nil, err
+	}
+	stateQuery, err := NewUserGrantStateQuery(domain.UserGrantStateActive)
+	if err != nil {
+		return nil, err
+	}
+	ownerQuery, err := NewUserGrantWithGrantedQuery(ownerID)
+	if err != nil {
+		return nil, err
+	}
+	validQuery, err := NewUserGrantValidAtQuery(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	queries = append(queries, projectQuery, roleQuery, stateQuery, ownerQuery, validQuery)
+	if orgID != "" {
+		orgQuery, err := NewUserGrantResourceOwnerSearchQuery(orgID)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, orgQuery)
+	}
+	return q.UserGrants(ctx, &UserGrantsQueries{SearchRequest: request, Queries: queries, WithGroupGrants: true}, false, false)
+}
+
+func authorizationCheckIDs(checks []*AuthorizationCheck) (userIDs, projectIDs []string, hasPermissionChecks bool) {
+	users := make(map[string]struct{}, len(checks))
+	projects := make(map[string]struct{}, len(checks))
+	for _, check := range checks {
+		if _, ok := users[check.UserID]; !ok {
+			users[check.UserID] = struct{}{}
+			userIDs = append(userIDs, check.UserID)
+		}
+		if _, ok := projects[check.ProjectID]; !ok {
+			projects[check.ProjectID] = struct{}{}
+			projectIDs = append(projectIDs, check.ProjectID)
+		}
+		if check.Permission != "" {
+			hasPermissionChecks = true
+		}
+	}
+	return userIDs, projectIDs, hasPermissionChecks
+}
+
+// visibleOrg returns if grants and memberships inside orgID are visible to the caller
+// and match the optional organization of the check
+func visibleOrg(check *AuthorizationCheck, ownerID, projectOwner, orgID string) bool {
+	if check.OrgID != "" && check.OrgID != orgID {
+		return false
+	}
+	return ownerID == projectOwner || ownerID == orgID
+}
+
+func checkAuthorizationRole(check *AuthorizationCheck, ownerID, projectOwner string, grants []*authorizationGrant) *AuthorizationCheckResult {
+	result := &AuthorizationCheckResult{OrgIDs: []string{}}
+	for _, grant := range grants {
+		if grant.UserID != check.UserID || grant.ProjectID != check.ProjectID || !visibleOrg(check, ownerID, projectOwner, grant.ResourceOwner) {
+			continue
+		}
+		for _, role := range grant.Roles {
+			if role == check.Role {
+				result.Allowed = true
+				result.OrgIDs = appendOrgID(result.OrgIDs, grant.ResourceOwner)
+			}
+		}
+	}
+	return result
+}
+
+func checkAuthorizationPermission(check *AuthorizationCheck, ownerID, projectOwner string, memberships []*Membership, roleMappings []authz.RoleMapping) *AuthorizationCheckResult {
+	result := &AuthorizationCheckResult{OrgIDs: []string{}}
+	for _, membership := range memberships {
+		if membership.UserID != check.UserID {
+			continue
+		}
+		orgID, ok := membershipOrgOnProject(membership, check.ProjectID, projectOwner)
+		if !ok || (orgID != "" && !visibleOrg(check, ownerID, projectOwner, orgID)) {
+			continue
+		}
+		if !rolesGrantPermission(membership.Roles, check.Permission, roleMappings) {
+			continue
+		}
+		result.Allowed = true
+		if orgID != "" {
+			result.OrgIDs = appendOrgID(result.OrgIDs, orgID)
+		}
+	}
+	return result
+}
+
+// membershipOrgOnProject returns if the membership applies to the project
+// and the organization it is scoped to (empty for instance members)
+func membershipOrgOnProject(membership *Membership, projectID, projectOwner string) (string, bool) {
+	switch {
+	case membership.IAM != nil:
+		return "", true
+	case membership.Org != nil:
+		return membership.Org.OrgID, membership.Org.OrgID == projectOwner
+	case membership.Project != nil:
+		return projectOwner, membership.Project.ProjectID == projectID
+	case membership.ProjectGrant != nil:
+		return membership.ProjectGrant.GrantedOrgID, membership.ProjectGrant.ProjectID == projectID
+	}
+	return "", false
+}
+
+func rolesGrantPermission(roles []string, permission string, roleMappings []authz.RoleMapping) bool {
+	for _, role := range roles {
+		for _, mapping := range roleMappings {
+			if mapping.Role != role {
+				continue
+			}
+			for _, p := range mapping.Permissions {
+				if p == permission {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func appendOrgID(orgIDs []string, orgID string) []string {
+	for _, id := range orgIDs {
+		if id == orgID {
+			return orgIDs
+		}
+	}
+	return append(orgIDs, orgID)
+}
+
+func (q *Queries) authorizationProjects(ctx context.Context, projectIDs []string) (_ map[string]string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareAuthorizationProjectsQuery(ctx, q.client)
+	stmt, args, err := query.Where(sq.Eq{
+		ProjectColumnID.identifier():           projectIDs,
+		ProjectColumnInstanceID.identifier():   authz.GetInstance(ctx).InstanceID(),
+		ProjectColumnOwnerRemoved.identifier(): false,
+	}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Az1pq", "Errors.Query.SQLStatment")
+	}
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Az2pe", "Errors.Internal")
+	}
+	projects, err := scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	owners := make(map[string]string, len(projects))
+	for _, project := range projects {
+		owners[project.ID] = project.ResourceOwner
+	}
+	return owners, nil
+}
+
+func (q *Queries) authorizationGrants(ctx context.Context, userIDs, projectIDs []string) (_ []*authorizationGrant, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareAuthorizationGrantsQuery(ctx, q.client)
+	eq := sq.Eq{
+		UserGrantUserID.identifier():     userIDs,
+		UserGrantProjectID.identifier():  projectIDs,
+		UserGrantState.identifier():      domain.UserGrantStateActive,
+		UserGrantInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+
+		UserGrantOwnerRemoved.identifier():           false,
+		UserGrantUserOwnerRemoved.identifier():       false,
+		UserGrantProjectOwnerRemoved.identifier():    false,
+		UserGrantGrantGrantedOrgRemoved.identifier(): false,
+	}
+	validQuery, err := NewUserGrantValidAtQuery(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	stmt, args, err := validQuery.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Az3gq", "Errors.Query.SQLStatment")
+	}
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Az4ge", "Errors.Internal")
+	}
+	grants, err := scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	groupGrants, err := q.authorizationGroupGrants(ctx, userIDs, projectIDs)
+	if err != nil {
+		return nil, err
+	}
+	return append(grants, groupGrants...), nil
+}
+
+// authorizationGroupGrants returns the roles granted to the groups of the users
+func (q *Queries) authorizationGroupGrants(ctx context.Context, userIDs, projectIDs []string) (_ []*authorizationGrant, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareAuthorizationGroupGrantsQuery(ctx, q.client)
+	stmt, args, err := query.Where(sq.Eq{
+		GroupUserColumnUserID.identifier():        userIDs,
+		GroupGrantColumnProjectID.identifier():    projectIDs,
+		GroupGrantColumnInstanceID.identifier():   authz.GetInstance(ctx).InstanceID(),
+		GroupGrantColumnOwnerRemoved.identifier(): false,
+		GroupUserColumnOwnerRemoved.identifier():  false,
+	}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Az9gq", "Errors.Query.SQLStatment")
+	}
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Az0ge", "Errors.Internal")
+	}
+	return scan(rows)
+}
+
+func (q *Queries) authorizationMemberships(ctx context.Context, userIDs []string) (_ []*Membership, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	userIDsQuery, err := NewMembershipUserIDsQuery(userIDs...)
+	if err != nil {
+		return nil, err
+	}
+	query, queryArgs, scan := prepareMembershipsQuery(ctx, q.client, false)
+	stmt, args, err := userIDsQuery.toQuery(query).
+		Where(sq.Eq{membershipInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}).
+		ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Az5mq", "Errors.Query.SQLStatment")
+	}
+	rows, err := q.client.QueryContext(ctx, stmt, append(queryArgs, args...)...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Az6me", "Errors.Internal")
+	}
+	memberships, err := scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	return memberships.Memberships, nil
+}
+
+func prepareAuthorizationProjectsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) ([]*authorizationProject, error)) {
+	return sq.Select(
+			ProjectColumnID.identifier(),
+			ProjectColumnResourceOwner.identifier(),
+		).From(projectsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]*authorizationProject, error) {
+			projects := make([]*authorizationProject, 0)
+			for rows.Next() {
+				project := new(authorizationProject)
+				if err := rows.Scan(&project.ID, &project.ResourceOwner); err != nil {
+					return nil, err
+				}
+				projects = append(projects, project)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Az7cl", "Errors.Query.CloseRows")
+			}
+			return projects, nil
+		}
+}
+
+func prepareAuthorizationGrantsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) ([]*authorizationGrant, error)) {
+	return sq.Select(
+			UserGrantUserID.identifier(),
+			UserGrantProjectID.identifier(),
+			UserGrantResourceOwner.identifier(),
+			UserGrantRoles.identifier(),
+		).From(userGrantTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]*authorizationGrant, error) {
+			grants := make([]*authorizationGrant, 0)
+			for rows.Next() {
+				grant := new(authorizationGrant)
+				if err := rows.Scan(&grant.UserID, &grant.ProjectID, &grant.ResourceOwner, &grant.Roles); err != nil {
+					return nil, err
+				}
+				grants = append(grants, grant)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Az8cl", "Errors.Query.CloseRows")
+			}
+			return grants, nil
+		}
+}
+
+func prepareAuthorizationGroupGrantsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) ([]*authorizationGrant, error)) {
+	return sq.Select(
+			GroupUserColumnUserID.identifier(),
+			GroupGrantColumnProjectID.identifier(),
+			GroupGrantColumnResourceOwner.identifier(),
+			GroupGrantColumnRoles.identifier(),
+		).From(groupGrantsTable.identifier()).
+			Join(join(GroupUserColumnGroupID, GroupGrantColumnGroupID) + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]*authorizationGrant, error) {
+			grants := make([]*authorizationGrant, 0)
+			for rows.Next() {
+				grant := new(authorizationGrant)
+				if err := rows.Scan(&grant.UserID, &grant.ProjectID, &grant.ResourceOwner, &grant.Roles); err != nil {
+					return nil, err
+				}
+				grants = append(grants, grant)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Az1cl", "Errors.Query.CloseRows")
+			}
+			return grants, nil
+		}
+}
@@ -0,0 +1,200 @@
+package query
+
+import (
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+)
+
+var (
+	prepareAuthorizationProjectsStmt = `SELECT projections.projects3.id,` +
+		` projections.projects3.resource_owner` +
+		` FROM projections.projects3` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareAuthorizationGrantsStmt = `SELECT projections.user_grants4.user_id,` +
+		` projections.user_grants4.project_id,` +
+		` projections.user_grants4.resource_owner,` +
+		` projections.user_grants4.roles` +
+		` FROM projections.user_grants4` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareAuthorizationGroupGrantsStmt = `SELECT projections.group_users.user_id,` +
+		` projections.group_grants.project_id,` +
+		` projections.group_grants.resource_owner,` +
+		` projections.group_grants.roles` +
+		` FROM projections.group_grants` +
+		` JOIN projections.group_users ON projections.group_grants.group_id = projections.group_users.group_id AND projections.group_grants.instance_id = projections.group_users.instance_id` +
+		` AS OF SYSTEM TIME '-1 ms'`
+)
+
+func Test_AuthorizationPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareAuthorizationProjectsQuery",
+			prepare: prepareAuthorizationProjectsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareAuthorizationProjectsStmt),
+					[]string{"id", "resource_owner"},
+					[][]driver.Value{
+						{"project", "org"},
+					},
+				),
+			},
+			object: []*authorizationProject{
+				{ID: "project", ResourceOwner: "org"},
+			},
+		},
+		{
+			name:    "prepareAuthorizationGrantsQuery",
+			prepare: prepareAuthorizationGrantsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareAuthorizationGrantsStmt),
+					[]string{"user_id", "project_id", "resource_owner", "roles"},
+					[][]driver.Value{
+						{"user", "project", "org", database.StringArray{"admin"}},
+					},
+				),
+			},
+			object: []*authorizationGrant{
+				{UserID: "user", ProjectID: "project", ResourceOwner: "org", Roles: database.StringArray{"admin"}},
+			},
+		},
+		{
+			name:    "prepareAuthorizationGroupGrantsQuery",
+			prepare: prepareAuthorizationGroupGrantsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareAuthorizationGroupGrantsStmt),
+					[]string{"user_id", "project_id", "resource_owner", "roles"},
+					[][]driver.Value{
+						{"user", "project", "org", database.StringArray{"reader"}},
+						{"other", "project", "org", database.StringArray{"reader"}},
+					},
+				),
+			},
+			object: []*authorizationGrant{
+				{UserID: "user", ProjectID: "project", ResourceOwner: "org", Roles: database.StringArray{"reader"}},
+				{UserID: "other", ProjectID: "project", ResourceOwner: "org", Roles: database.StringArray{"reader"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
+
+func Test_checkAuthorizationRole(t *testing.T) {
+	grants := []*authorizationGrant{
+		{UserID: "user", ProjectID: "project", ResourceOwner: "owner", Roles: database.StringArray{"admin"}},
+		{UserID: "user", ProjectID: "project", ResourceOwner: "granted", Roles: database.StringArray{"reader"}},
+		{UserID: "other", ProjectID: "project", ResourceOwner: "owner", Roles: database.StringArray{"reader"}},
+	}
+	tests := []struct {
+		name    string
+		check   *AuthorizationCheck
+		ownerID string
+		want    *AuthorizationCheckResult
+	}{
+		{
+			name:    "role granted in project owner",
+			check:   &AuthorizationCheck{UserID: "user", ProjectID: "project", Role: "admin"},
+			ownerID: "owner",
+			want:    &AuthorizationCheckResult{Allowed: true, OrgIDs: []string{"owner"}},
+		},
+		{
+			name:    "role granted in granted org",
+			check:   &AuthorizationCheck{UserID: "user", ProjectID: "project", Role: "reader"},
+			ownerID: "owner",
+			want:    &AuthorizationCheckResult{Allowed: true, OrgIDs: []string{"granted"}},
+		},
+		{
+			name:    "role not granted in requested org",
+			check:   &AuthorizationCheck{UserID: "user", ProjectID: "project", OrgID: "owner", Role: "reader"},
+			ownerID: "owner",
+			want:    &AuthorizationCheckResult{OrgIDs: []string{}},
+		},
+		{
+			name:    "granted org only sees own grants",
+			check:   &AuthorizationCheck{UserID: "user", ProjectID: "project", Role: "admin"},
+			ownerID: "granted",
+			want:    &AuthorizationCheckResult{OrgIDs: []string{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := checkAuthorizationRole(tt.check, tt.ownerID, "owner", grants)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_checkAuthorizationPermission(t *testing.T) {
+	roleMappings := []authz.RoleMapping{
+		{Role: "IAM_OWNER", Permissions: []string{"project.read", "project.write"}},
+		{Role: "ORG_OWNER", Permissions: []string{"project.read", "project.write"}},
+		{Role: "PROJECT_OWNER_VIEWER", Permissions: []string{"project.read"}},
+		{Role: "PROJECT_GRANT_OWNER", Permissions: []string{"project.read"}},
+	}
+	tests := []struct {
+		name        string
+		check       *AuthorizationCheck
+		memberships []*Membership
+		want        *AuthorizationCheckResult
+	}{
+		{
+			name:  "instance member",
+			check: &AuthorizationCheck{UserID: "user", ProjectID: "project", Permission: "project.write"},
+			memberships: []*Membership{
+				{UserID: "user", Roles: database.StringArray{"IAM_OWNER"}, IAM: &IAMMembership{IAMID: "instance"}},
+			},
+			want: &AuthorizationCheckResult{Allowed: true, OrgIDs: []string{}},
+		},
+		{
+			name:  "member of other org",
+			check: &AuthorizationCheck{UserID: "user", ProjectID: "project", Permission: "project.write"},
+			memberships: []*Membership{
+				{UserID: "user", Roles: database.StringArray{"ORG_OWNER"}, Org: &OrgMembership{OrgID: "other"}},
+			},
+			want: &AuthorizationCheckResult{OrgIDs: []string{}},
+		},
+		{
+			name:  "project member without permission",
+			check: &AuthorizationCheck{UserID: "user", ProjectID: "project", Permission: "project.write"},
+			memberships: []*Membership{
+				{UserID: "user", Roles: database.StringArray{"PROJECT_OWNER_VIEWER"}, Project: &ProjectMembership{ProjectID: "project"}},
+			},
+			want: &AuthorizationCheckResult{OrgIDs: []string{}},
+		},
+		{
+			name:  "project grant member",
+			check: &AuthorizationCheck{UserID: "user", ProjectID: "project", OrgID: "granted", Permission: "project.read"},
+			memberships: []*Membership{
+				{UserID: "user", Roles: database.StringArray{"PROJECT_GRANT_OWNER"}, ProjectGrant: &ProjectGrantMembership{ProjectID: "project", GrantedOrgID: "granted"}},
+			},
+			want: &AuthorizationCheckResult{Allowed: true, OrgIDs: []string{"granted"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := checkAuthorizationPermission(tt.check, "owner", "owner", tt.memberships, roleMappings)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
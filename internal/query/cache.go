@@ -0,0 +1,112 @@
+package query
+
+import (
+	"github.com/zitadel/zitadel/internal/cache"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/oidcsession"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+// CachedAggregates are the aggregates whose events invalidate the cached query results
+var CachedAggregates = []eventstore.AggregateType{
+	instance.AggregateType,
+	org.AggregateType,
+	user.AggregateType,
+	oidcsession.AggregateType,
+	session.AggregateType,
+}
+
+// queryCaches are the caches of the frequently executed queries,
+// the queries are executed on every call if their cache is nil
+type queryCaches struct {
+	caches         *cache.QueryCaches
+	instanceByHost *cache.Query[*cachedInstance]
+	loginPolicy    *cache.Query[*LoginPolicy]
+	labelPolicy    *cache.Query[*LabelPolicy]
+	accessToken    *cache.Query[*cachedAccessToken]
+}
+
+func newQueryCaches(caches *cache.QueryCaches) queryCaches {
+	return queryCaches{
+		caches:         caches,
+		instanceByHost: cache.NewQuery[*cachedInstance](caches, cache.QueryInstanceByHost),
+		loginPolicy:    cache.NewQuery[*LoginPolicy](caches, cache.QueryLoginPolicy),
+		labelPolicy:    cache.NewQuery[*LabelPolicy](caches, cache.QueryLabelPolicy),
+		accessToken:    cache.NewQuery[*cachedAccessToken](caches, cache.QueryAccessToken),
+	}
+}
+
+// Caches returns the caches of the queries,
+// so that the queries of other repositories can be cached in the same backend
+func (q *Queries) Caches() *cache.QueryCaches {
+	return q.caches.caches
+}
+
+// policyAggregates are the aggregates of the policies of the organization and its ancestors,
+// which fall back to the default policies of the instance
+// ownerIDs are the ids returned by policyOwnerIDs, the last one being the instance
+func policyAggregates(instanceID string, ownerIDs []string) []cache.Aggregate {
+	aggregates := make([]cache.Aggregate, 0, len(ownerIDs))
+	for _, id := range ownerIDs {
+		if id == instanceID {
+			aggregates = append(aggregates, cache.Aggregate{InstanceID: instanceID, Type: instance.AggregateType, ID: instanceID})
+			continue
+		}
+		aggregates = append(aggregates, cache.Aggregate{InstanceID: instanceID, Type: org.AggregateType, ID: id})
+	}
+	return aggregates
+}
+
+// cachedInstance contains the unexported fields of the [Instance], which are not marshalled
+type cachedInstance struct {
+	*Instance
+	CSPEnabled     bool
+	AllowedOrigins database.StringArray
+}
+
+func newCachedInstance(instance *Instance) *cachedInstance {
+	return &cachedInstance{
+		Instance:       instance,
+		CSPEnabled:     instance.csp.enabled,
+		AllowedOrigins: instance.csp.allowedOrigins,
+	}
+}
+
+func (c *cachedInstance) instance(host string) *Instance {
+	instance := *c.Instance
+	instance.host = host
+	instance.csp = csp{
+		enabled:        c.CSPEnabled,
+		allowedOrigins: c.AllowedOrigins,
+	}
+	return &instance
+}
+
+// cachedAccessToken contains the fields of the [eventstore.WriteModel], which are not marshalled
+type cachedAccessToken struct {
+	*OIDCSessionAccessTokenReadModel
+	AggregateID   string
+	ResourceOwner string
+	InstanceID    string
+}
+
+func newCachedAccessToken(model *OIDCSessionAccessTokenReadModel) *cachedAccessToken {
+	return &cachedAccessToken{
+		OIDCSessionAccessTokenReadModel: model,
+		AggregateID:                     model.AggregateID,
+		ResourceOwner:                   model.ResourceOwner,
+		InstanceID:                      model.InstanceID,
+	}
+}
+
+func (c *cachedAccessToken) model() *OIDCSessionAccessTokenReadModel {
+	model := c.OIDCSessionAccessTokenReadModel
+	model.AggregateID = c.AggregateID
+	model.ResourceOwner = c.ResourceOwner
+	model.InstanceID = c.InstanceID
+	return model
+}
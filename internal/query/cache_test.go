@@ -0,0 +1,62 @@
+package query
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func Test_cachedInstance(t *testing.T) {
+	instance := &Instance{
+		ID:          "instance",
+		DefaultLang: language.German,
+		Domains:     []*InstanceDomain{{Domain: "zitadel.ch", InstanceID: "instance"}},
+		host:        "zitadel.ch:443",
+		csp: csp{
+			enabled:        true,
+			allowedOrigins: database.StringArray{"https://zitadel.ch"},
+		},
+	}
+	data, err := json.Marshal(newCachedInstance(instance))
+	if err != nil {
+		t.Fatalf("unable to marshal: %v", err)
+	}
+	cached := new(cachedInstance)
+	if err = json.Unmarshal(data, cached); err != nil {
+		t.Fatalf("unable to unmarshal: %v", err)
+	}
+	if got := cached.instance("zitadel.ch:443"); !reflect.DeepEqual(got, instance) {
+		t.Errorf("cached instance differs: want %+v, got %+v", instance, got)
+	}
+}
+
+func Test_cachedAccessToken(t *testing.T) {
+	model := &OIDCSessionAccessTokenReadModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   "oidcSession",
+			ResourceOwner: "org",
+			InstanceID:    "instance",
+		},
+		UserID:                "user",
+		AccessTokenID:         "token",
+		AccessTokenExpiration: time.Now().Add(time.Hour).Round(0),
+	}
+	data, err := json.Marshal(newCachedAccessToken(model))
+	if err != nil {
+		t.Fatalf("unable to marshal: %v", err)
+	}
+	cached := new(cachedAccessToken)
+	if err = json.Unmarshal(data, cached); err != nil {
+		t.Fatalf("unable to unmarshal: %v", err)
+	}
+	got := cached.model()
+	if got.AggregateID != "oidcSession" || got.ResourceOwner != "org" || got.InstanceID != "instance" || got.UserID != "user" || !got.AccessTokenExpiration.Equal(model.AccessTokenExpiration) {
+		t.Errorf("cached token differs: want %+v, got %+v", model, got)
+	}
+}
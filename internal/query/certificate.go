@@ -79,6 +79,7 @@ func (q *Queries) ActiveCertificates(ctx context.Context, t time.Time, usage dom
 			sq.Eq{
 				KeyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
 				KeyColUse.identifier():        usage,
+				KeyColState.identifier():      domain.KeyPairStateActive,
 			},
 			sq.Gt{CertificateColExpiry.identifier(): t},
 			sq.Gt{KeyPrivateColExpiry.identifier(): t},
@@ -103,6 +104,37 @@ func (q *Queries) ActiveCertificates(ctx context.Context, t time.Time, usage dom
 	return keys, nil
 }
 
+// PublicCertificates returns the certificates of the usage, which must be published:
+// the active ones used for signing and the ones only published for a staged rotation
+func (q *Queries) PublicCertificates(ctx context.Context, t time.Time, usage domain.KeyUsage) (_ *Certificates, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareCertificateQuery(ctx, q.client)
+	if t.IsZero() {
+		t = time.Now()
+	}
+	stmt, args, err := query.Where(
+		sq.And{
+			sq.Eq{
+				KeyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+				KeyColUse.identifier():        usage,
+				KeyColState.identifier():      []domain.KeyPairState{domain.KeyPairStateActive, domain.KeyPairStatePublished},
+			},
+			sq.Gt{CertificateColExpiry.identifier(): t},
+		},
+	).OrderBy(CertificateColExpiry.identifier()).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Wk3ms", "Errors.Query.SQLStatement")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Lw2ks", "Errors.Internal")
+	}
+	return scan(rows)
+}
+
 func prepareCertificateQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*Certificates, error)) {
 	return sq.Select(
 			KeyColID.identifier(),
@@ -14,20 +14,20 @@ import (
 )
 
 var (
-	prepareCertificateStmt = `SELECT projections.keys4.id,` +
-		` projections.keys4.creation_date,` +
-		` projections.keys4.change_date,` +
-		` projections.keys4.sequence,` +
-		` projections.keys4.resource_owner,` +
-		` projections.keys4.algorithm,` +
-		` projections.keys4.use,` +
-		` projections.keys4_certificate.expiry,` +
-		` projections.keys4_certificate.certificate,` +
-		` projections.keys4_private.key,` +
+	prepareCertificateStmt = `SELECT projections.keys5.id,` +
+		` projections.keys5.creation_date,` +
+		` projections.keys5.change_date,` +
+		` projections.keys5.sequence,` +
+		` projections.keys5.resource_owner,` +
+		` projections.keys5.algorithm,` +
+		` projections.keys5.use,` +
+		` projections.keys5_certificate.expiry,` +
+		` projections.keys5_certificate.certificate,` +
+		` projections.keys5_private.key,` +
 		` COUNT(*) OVER ()` +
-		` FROM projections.keys4` +
-		` LEFT JOIN projections.keys4_certificate ON projections.keys4.id = projections.keys4_certificate.id AND projections.keys4.instance_id = projections.keys4_certificate.instance_id` +
-		` LEFT JOIN projections.keys4_private ON projections.keys4.id = projections.keys4_private.id AND projections.keys4.instance_id = projections.keys4_private.instance_id` +
+		` FROM projections.keys5` +
+		` LEFT JOIN projections.keys5_certificate ON projections.keys5.id = projections.keys5_certificate.id AND projections.keys5.instance_id = projections.keys5_certificate.instance_id` +
+		` LEFT JOIN projections.keys5_private ON projections.keys5.id = projections.keys5_private.id AND projections.keys5.instance_id = projections.keys5_private.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`
 	prepareCertificateCols = []string{
 		"id",
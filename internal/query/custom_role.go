@@ -0,0 +1,233 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	errs "errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+var (
+	customRolesTable = table{
+		name:          projection.CustomRoleProjectionTable,
+		instanceIDCol: projection.CustomRoleColumnInstanceID,
+	}
+	CustomRoleColumnInstanceID = Column{
+		name:  projection.CustomRoleColumnInstanceID,
+		table: customRolesTable,
+	}
+	CustomRoleColumnRole = Column{
+		name:  projection.CustomRoleColumnRole,
+		table: customRolesTable,
+	}
+	CustomRoleColumnCreationDate = Column{
+		name:  projection.CustomRoleColumnCreationDate,
+		table: customRolesTable,
+	}
+	CustomRoleColumnChangeDate = Column{
+		name:  projection.CustomRoleColumnChangeDate,
+		table: customRolesTable,
+	}
+	CustomRoleColumnResourceOwner = Column{
+		name:  projection.CustomRoleColumnResourceOwner,
+		table: customRolesTable,
+	}
+	CustomRoleColumnSequence = Column{
+		name:  projection.CustomRoleColumnSequence,
+		table: customRolesTable,
+	}
+	CustomRoleColumnDisplayName = Column{
+		name:  projection.CustomRoleColumnDisplayName,
+		table: customRolesTable,
+	}
+	CustomRoleColumnPermissions = Column{
+		name:  projection.CustomRoleColumnPermissions,
+		table: customRolesTable,
+	}
+)
+
+type CustomRoles struct {
+	SearchResponse
+	CustomRoles []*CustomRole
+}
+
+type CustomRole struct {
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	Sequence      uint64
+
+	Role        string
+	DisplayName string
+	Permissions database.StringArray
+}
+
+type CustomRoleSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *CustomRoleSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func NewCustomRoleRoleSearchQuery(method TextComparison, value string) (SearchQuery, error) {
+	return NewTextQuery(CustomRoleColumnRole, value, method)
+}
+
+func NewCustomRoleDisplayNameSearchQuery(method TextComparison, value string) (SearchQuery, error) {
+	return NewTextQuery(CustomRoleColumnDisplayName, value, method)
+}
+
+func (q *Queries) CustomRoleByName(ctx context.Context, role string) (_ *CustomRole, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	stmt, scan := prepareCustomRoleQuery(ctx, q.client)
+	query, args, err := stmt.Where(sq.Eq{
+		CustomRoleColumnRole.identifier():       role,
+		CustomRoleColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Cr1sq", "Errors.Query.SQLStatment")
+	}
+
+	row := q.client.QueryRowContext(ctx, query, args...)
+	return scan(row)
+}
+
+func (q *Queries) SearchCustomRoles(ctx context.Context, queries *CustomRoleSearchQueries) (roles *CustomRoles, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareCustomRolesQuery(ctx, q.client)
+	stmt, args, err := queries.toQuery(query).
+		Where(sq.Eq{
+			CustomRoleColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Cr2sq", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Cr3ex", "Errors.Internal")
+	}
+	roles, err = scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	roles.LatestSequence, err = q.latestSequence(ctx, customRolesTable)
+	return roles, err
+}
+
+// CustomRoleMappings returns the custom roles of the instance
+// in the same form as the static role mappings of the configuration
+func (q *Queries) CustomRoleMappings(ctx context.Context) (_ []authz.RoleMapping, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	roles, err := q.SearchCustomRoles(ctx, &CustomRoleSearchQueries{})
+	if err != nil {
+		return nil, err
+	}
+	mappings := make([]authz.RoleMapping, len(roles.CustomRoles))
+	for i, role := range roles.CustomRoles {
+		mappings[i] = authz.RoleMapping{
+			Role:        role.Role,
+			Permissions: role.Permissions,
+		}
+	}
+	return mappings, nil
+}
+
+func prepareCustomRoleQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*CustomRole, error)) {
+	return sq.Select(
+			CustomRoleColumnCreationDate.identifier(),
+			CustomRoleColumnChangeDate.identifier(),
+			CustomRoleColumnResourceOwner.identifier(),
+			CustomRoleColumnSequence.identifier(),
+			CustomRoleColumnRole.identifier(),
+			CustomRoleColumnDisplayName.identifier(),
+			CustomRoleColumnPermissions.identifier()).
+			From(customRolesTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*CustomRole, error) {
+			role := new(CustomRole)
+			err := row.Scan(
+				&role.CreationDate,
+				&role.ChangeDate,
+				&role.ResourceOwner,
+				&role.Sequence,
+				&role.Role,
+				&role.DisplayName,
+				&role.Permissions,
+			)
+			if err != nil {
+				if errs.Is(err, sql.ErrNoRows) {
+					return nil, errors.ThrowNotFound(err, "QUERY-Cr4nf", "Errors.CustomRole.NotFound")
+				}
+				return nil, errors.ThrowInternal(err, "QUERY-Cr5sc", "Errors.Internal")
+			}
+			return role, nil
+		}
+}
+
+func prepareCustomRolesQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*CustomRoles, error)) {
+	return sq.Select(
+			CustomRoleColumnCreationDate.identifier(),
+			CustomRoleColumnChangeDate.identifier(),
+			CustomRoleColumnResourceOwner.identifier(),
+			CustomRoleColumnSequence.identifier(),
+			CustomRoleColumnRole.identifier(),
+			CustomRoleColumnDisplayName.identifier(),
+			CustomRoleColumnPermissions.identifier(),
+			countColumn.identifier()).
+			From(customRolesTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*CustomRoles, error) {
+			roles := make([]*CustomRole, 0)
+			var count uint64
+			for rows.Next() {
+				role := new(CustomRole)
+				err := rows.Scan(
+					&role.CreationDate,
+					&role.ChangeDate,
+					&role.ResourceOwner,
+					&role.Sequence,
+					&role.Role,
+					&role.DisplayName,
+					&role.Permissions,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				roles = append(roles, role)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Cr6cl", "Errors.Query.CloseRows")
+			}
+
+			return &CustomRoles{
+				CustomRoles: roles,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -0,0 +1,185 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	errs "github.com/zitadel/zitadel/internal/errors"
+)
+
+var (
+	prepareCustomRoleStmt = `SELECT projections.custom_roles.creation_date,` +
+		` projections.custom_roles.change_date,` +
+		` projections.custom_roles.resource_owner,` +
+		` projections.custom_roles.sequence,` +
+		` projections.custom_roles.role,` +
+		` projections.custom_roles.display_name,` +
+		` projections.custom_roles.permissions` +
+		` FROM projections.custom_roles` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareCustomRoleCols = []string{
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"role",
+		"display_name",
+		"permissions",
+	}
+	prepareCustomRolesStmt = `SELECT projections.custom_roles.creation_date,` +
+		` projections.custom_roles.change_date,` +
+		` projections.custom_roles.resource_owner,` +
+		` projections.custom_roles.sequence,` +
+		` projections.custom_roles.role,` +
+		` projections.custom_roles.display_name,` +
+		` projections.custom_roles.permissions,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.custom_roles` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareCustomRolesCols = []string{
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"role",
+		"display_name",
+		"permissions",
+		"count",
+	}
+)
+
+func Test_CustomRolePrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareCustomRolesQuery no result",
+			prepare: prepareCustomRolesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareCustomRolesStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &CustomRoles{CustomRoles: []*CustomRole{}},
+		},
+		{
+			name:    "prepareCustomRolesQuery one result",
+			prepare: prepareCustomRolesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareCustomRolesStmt),
+					prepareCustomRolesCols,
+					[][]driver.Value{
+						{
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211108),
+							"ORG_HELPDESK",
+							"Helpdesk",
+							database.StringArray{"org.read", "org.member.read"},
+						},
+					},
+				),
+			},
+			object: &CustomRoles{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				CustomRoles: []*CustomRole{
+					{
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						Sequence:      20211108,
+						Role:          "ORG_HELPDESK",
+						DisplayName:   "Helpdesk",
+						Permissions:   database.StringArray{"org.read", "org.member.read"},
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareCustomRolesQuery sql err",
+			prepare: prepareCustomRolesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareCustomRolesStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+		{
+			name:    "prepareCustomRoleQuery no result",
+			prepare: prepareCustomRoleQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					prepareCustomRoleStmt,
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*CustomRole)(nil),
+		},
+		{
+			name:    "prepareCustomRoleQuery found",
+			prepare: prepareCustomRoleQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareCustomRoleStmt),
+					prepareCustomRoleCols,
+					[]driver.Value{
+						testNow,
+						testNow,
+						"ro",
+						uint64(20211108),
+						"ORG_HELPDESK",
+						"Helpdesk",
+						database.StringArray{"org.read"},
+					},
+				),
+			},
+			object: &CustomRole{
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				ResourceOwner: "ro",
+				Sequence:      20211108,
+				Role:          "ORG_HELPDESK",
+				DisplayName:   "Helpdesk",
+				Permissions:   database.StringArray{"org.read"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
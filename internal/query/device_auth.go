@@ -52,6 +52,14 @@ var (
 		name:  projection.DeviceAuthColumnSubject,
 		table: deviceAuthTable,
 	}
+	DeviceAuthColumnUserID = Column{
+		name:  projection.DeviceAuthColumnUserID,
+		table: deviceAuthTable,
+	}
+	DeviceAuthColumnBindingMessage = Column{
+		name:  projection.DeviceAuthColumnBindingMessage,
+		table: deviceAuthTable,
+	}
 	DeviceAuthColumnCreationDate = Column{
 		name:  projection.DeviceAuthColumnCreationDate,
 		table: deviceAuthTable,
@@ -105,6 +113,25 @@ func (q *Queries) DeviceAuthByUserCode(ctx context.Context, userCode string) (_
 	return scan(q.client.QueryRowContext(ctx, query, args...))
 }
 
+// DeviceAuthByID returns the device authorization by its aggregate id.
+// It's used to show the details of a backchannel authentication request (CIBA) to the user.
+func (q *Queries) DeviceAuthByID(ctx context.Context, id string) (_ *domain.DeviceAuth, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	stmt, scan := prepareDeviceAuthQuery(ctx, q.client)
+	eq := sq.Eq{
+		DeviceAuthColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		DeviceAuthColumnID.identifier():         id,
+	}
+	query, args, err := stmt.Where(eq).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Bc9qi", "Errors.Query.SQLStatement")
+	}
+
+	return scan(q.client.QueryRowContext(ctx, query, args...))
+}
+
 var deviceAuthSelectColumns = []string{
 	DeviceAuthColumnID.identifier(),
 	DeviceAuthColumnClientID.identifier(),
@@ -112,6 +139,9 @@ var deviceAuthSelectColumns = []string{
 	DeviceAuthColumnExpires.identifier(),
 	DeviceAuthColumnState.identifier(),
 	DeviceAuthColumnSubject.identifier(),
+	DeviceAuthColumnUserID.identifier(),
+	DeviceAuthColumnBindingMessage.identifier(),
+	DeviceAuthColumnChangeDate.identifier(),
 }
 
 func prepareDeviceAuthQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*domain.DeviceAuth, error)) {
@@ -127,6 +157,9 @@ func prepareDeviceAuthQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 				&dst.Expires,
 				&dst.State,
 				&dst.Subject,
+				&dst.UserID,
+				&dst.BindingMessage,
+				&dst.ChangeDate,
 			)
 			if errs.Is(err, sql.ErrNoRows) {
 				return nil, errors.ThrowNotFound(err, "QUERY-Sah9a", "Errors.DeviceAuth.NotExisting")
@@ -20,26 +20,33 @@ import (
 
 const (
 	expectedDeviceAuthQueryC = `SELECT` +
-		` projections.device_authorizations.id,` +
-		` projections.device_authorizations.client_id,` +
-		` projections.device_authorizations.scopes,` +
-		` projections.device_authorizations.expires,` +
-		` projections.device_authorizations.state,` +
-		` projections.device_authorizations.subject` +
-		` FROM projections.device_authorizations`
+		` projections.device_authorizations1.id,` +
+		` projections.device_authorizations1.client_id,` +
+		` projections.device_authorizations1.scopes,` +
+		` projections.device_authorizations1.expires,` +
+		` projections.device_authorizations1.state,` +
+		` projections.device_authorizations1.subject,` +
+		` projections.device_authorizations1.user_id,` +
+		` projections.device_authorizations1.binding_message,` +
+		` projections.device_authorizations1.change_date` +
+		` FROM projections.device_authorizations1`
 	expectedDeviceAuthWhereDeviceCodeQueryC = expectedDeviceAuthQueryC +
-		` WHERE projections.device_authorizations.client_id = $1` +
-		` AND projections.device_authorizations.device_code = $2` +
-		` AND projections.device_authorizations.instance_id = $3`
+		` WHERE projections.device_authorizations1.client_id = $1` +
+		` AND projections.device_authorizations1.device_code = $2` +
+		` AND projections.device_authorizations1.instance_id = $3`
 	expectedDeviceAuthWhereUserCodeQueryC = expectedDeviceAuthQueryC +
-		` WHERE projections.device_authorizations.instance_id = $1` +
-		` AND projections.device_authorizations.user_code = $2`
+		` WHERE projections.device_authorizations1.instance_id = $1` +
+		` AND projections.device_authorizations1.user_code = $2`
+	expectedDeviceAuthWhereIDQueryC = expectedDeviceAuthQueryC +
+		` WHERE projections.device_authorizations1.id = $1` +
+		` AND projections.device_authorizations1.instance_id = $2`
 )
 
 var (
 	expectedDeviceAuthQuery                = regexp.QuoteMeta(expectedDeviceAuthQueryC)
 	expectedDeviceAuthWhereDeviceCodeQuery = regexp.QuoteMeta(expectedDeviceAuthWhereDeviceCodeQueryC)
 	expectedDeviceAuthWhereUserCodeQuery   = regexp.QuoteMeta(expectedDeviceAuthWhereUserCodeQueryC)
+	expectedDeviceAuthWhereIDQuery         = regexp.QuoteMeta(expectedDeviceAuthWhereIDQueryC)
 	expectedDeviceAuthValues               = []driver.Value{
 		"primary-id",
 		"client-id",
@@ -47,16 +54,22 @@ var (
 		testNow,
 		domain.DeviceAuthStateApproved,
 		"subject",
+		"user-id",
+		"binding-message",
+		testNow,
 	}
 	expectedDeviceAuth = &domain.DeviceAuth{
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: "primary-id",
+			ChangeDate:  testNow,
 		},
-		ClientID: "client-id",
-		Scopes:   []string{"a", "b", "c"},
-		Expires:  testNow,
-		State:    domain.DeviceAuthStateApproved,
-		Subject:  "subject",
+		ClientID:       "client-id",
+		Scopes:         []string{"a", "b", "c"},
+		Expires:        testNow,
+		State:          domain.DeviceAuthStateApproved,
+		Subject:        "subject",
+		UserID:         "user-id",
+		BindingMessage: "binding-message",
 	}
 )
 
@@ -98,6 +111,25 @@ func TestQueries_DeviceAuthByUserCode(t *testing.T) {
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestQueries_DeviceAuthByID(t *testing.T) {
+	client, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to build mock client: %v", err)
+	}
+	defer client.Close()
+
+	mock.ExpectQuery(expectedDeviceAuthWhereIDQuery).WillReturnRows(
+		sqlmock.NewRows(deviceAuthSelectColumns).AddRow(expectedDeviceAuthValues...),
+	)
+	q := Queries{
+		client: &database.DB{DB: client},
+	}
+	got, err := q.DeviceAuthByID(context.TODO(), "primary-id")
+	require.NoError(t, err)
+	assert.Equal(t, expectedDeviceAuth, got)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func Test_prepareDeviceAuthQuery(t *testing.T) {
 	type want struct {
 		sqlExpectations sqlExpectation
@@ -93,12 +93,13 @@ func (q *Queries) DomainPolicyByOrg(ctx context.Context, shouldTriggerBulk bool,
 	if shouldTriggerBulk {
 		ctx = projection.DomainPolicyProjection.Trigger(ctx)
 	}
+	ownerIDs, err := q.policyOwnerIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
 	eq := sq.And{
 		sq.Eq{DomainPolicyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()},
-		sq.Or{
-			sq.Eq{DomainPolicyColID.identifier(): orgID},
-			sq.Eq{DomainPolicyColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-		},
+		sq.Eq{DomainPolicyColID.identifier(): ownerIDs},
 	}
 	if !withOwnerRemoved {
 		eq = sq.And{
@@ -106,15 +107,12 @@ func (q *Queries) DomainPolicyByOrg(ctx context.Context, shouldTriggerBulk bool,
 				DomainPolicyColInstanceID.identifier():   authz.GetInstance(ctx).InstanceID(),
 				DomainPolicyColOwnerRemoved.identifier(): false,
 			},
-			sq.Or{
-				sq.Eq{DomainPolicyColID.identifier(): orgID},
-				sq.Eq{DomainPolicyColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
+			sq.Eq{DomainPolicyColID.identifier(): ownerIDs},
 		}
 	}
 
 	stmt, scan := prepareDomainPolicyQuery(ctx, q.client)
-	query, args, err := stmt.Where(eq).OrderBy(DomainPolicyColIsDefault.identifier()).
+	query, args, err := stmt.Where(eq).OrderByClause(policyOwnerPrecedence(DomainPolicyColID, ownerIDs)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, errors.ThrowInternal(err, "QUERY-D3CqT", "Errors.Query.SQLStatement")
@@ -0,0 +1,11 @@
+with recursive hierarchy (id, parent_org_id, depth) as (
+    select o.id, o.parent_org_id, 0
+    from projections.orgs1 o
+    where o.instance_id = $1 and o.id = $2
+    union all
+    select p.id, p.parent_org_id, h.depth + 1
+    from projections.orgs1 p
+    join hierarchy h on p.id = h.parent_org_id
+    where p.instance_id = $1 and p.org_state <> $3 and h.depth < $4
+)
+select id from hierarchy order by depth
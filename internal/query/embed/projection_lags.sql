@@ -0,0 +1,32 @@
+select
+    s.projection_name,
+    s.instance_id,
+    s.current_sequence,
+    s."timestamp",
+    p.events_behind,
+    p.oldest_pending,
+    p.latest_event,
+    (select e.creation_date from eventstore.events e where e.instance_id = s.instance_id and e.aggregate_type = s.aggregate_type and e.event_sequence = s.current_sequence limit 1) as processed_event,
+    l.locker_id,
+    l.locked_until,
+    f.error,
+    f.last_failed
+from projections.current_sequences s
+left join lateral (
+    select
+        count(*) as events_behind,
+        min(e.creation_date) as oldest_pending,
+        max(e.creation_date) as latest_event
+    from eventstore.events e
+    where e.instance_id = s.instance_id and e.aggregate_type = s.aggregate_type and e.event_sequence > s.current_sequence
+) p on true
+left join projections.locks l on l.projection_name = s.projection_name and l.instance_id = s.instance_id and l.locked_until > now()
+left join lateral (
+    select fe.error, fe.last_failed
+    from projections.failed_events fe
+    where fe.projection_name = s.projection_name and fe.instance_id = s.instance_id
+    order by fe.last_failed desc nulls last
+    limit 1
+) f on true
+where ($1::TEXT = '' or s.projection_name = $1) and ($2::TEXT = '' or s.instance_id = $2)
+order by s.projection_name, s.instance_id;
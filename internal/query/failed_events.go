@@ -3,13 +3,17 @@ package query
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	errs "errors"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
 
 	"github.com/zitadel/zitadel/internal/api/call"
 	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 )
 
 const (
@@ -19,6 +23,9 @@ const (
 	failedEventsColumnLastFailed     = "last_failed"
 	failedEventsColumnError          = "error"
 	failedEventsColumnInstanceID     = "instance_id"
+	failedEventsColumnErrorHistory   = "error_history"
+	failedEventsColumnSkipReason     = "skip_reason"
+	failedEventsColumnSkippedAt      = "skipped_at"
 )
 
 var (
@@ -50,6 +57,18 @@ var (
 		name:  failedEventsColumnInstanceID,
 		table: failedEventsTable,
 	}
+	FailedEventsColumnErrorHistory = Column{
+		name:  failedEventsColumnErrorHistory,
+		table: failedEventsTable,
+	}
+	FailedEventsColumnSkipReason = Column{
+		name:  failedEventsColumnSkipReason,
+		table: failedEventsTable,
+	}
+	FailedEventsColumnSkippedAt = Column{
+		name:  failedEventsColumnSkippedAt,
+		table: failedEventsTable,
+	}
 )
 
 type FailedEvents struct {
@@ -63,6 +82,28 @@ type FailedEvent struct {
 	FailureCount   uint64
 	Error          string
 	LastFailed     time.Time
+	InstanceID     string
+	SkipReason     string
+	SkippedAt      time.Time
+
+	// ErrorHistory and Event are only set by GetFailedEvent
+	ErrorHistory []*FailedEventError
+	Event        *FailedEventData
+}
+
+type FailedEventError struct {
+	Error    string    `json:"error"`
+	FailedAt time.Time `json:"failedAt"`
+}
+
+// FailedEventData is the event whose statement failed
+type FailedEventData struct {
+	AggregateType string
+	AggregateID   string
+	ResourceOwner string
+	EventType     string
+	CreationDate  time.Time
+	Payload       []byte
 }
 
 type FailedEventSearchQueries struct {
@@ -103,6 +144,74 @@ func (q *Queries) RemoveFailedEvent(ctx context.Context, projectionName, instanc
 	return nil
 }
 
+// GetFailedEvent returns the failed event including the history of its errors and the payload of the event
+func (q *Queries) GetFailedEvent(ctx context.Context, projectionName, instanceID string, sequence uint64) (failedEvent *FailedEvent, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareFailedEventQuery(ctx, q.client)
+	stmt, args, err := query.Where(sq.Eq{
+		FailedEventsColumnProjectionName.identifier(): projectionName,
+		FailedEventsColumnFailedSequence.identifier(): sequence,
+		FailedEventsColumnInstanceID.identifier():     instanceID,
+	}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Wq9sk", "Errors.Query.SQLStatement")
+	}
+	failedEvent, err = scan(q.client.QueryRowContext(ctx, stmt, args...))
+	if err != nil {
+		return nil, err
+	}
+	events, err := q.eventstore.Filter(ctx, eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		SequenceGreater(sequence-1).
+		SequenceLess(sequence+1).
+		InstanceID(instanceID).
+		Builder(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 1 {
+		failedEvent.Event = &FailedEventData{
+			AggregateType: string(events[0].Aggregate().Type),
+			AggregateID:   events[0].Aggregate().ID,
+			ResourceOwner: events[0].Aggregate().ResourceOwner,
+			EventType:     string(events[0].Type()),
+			CreationDate:  events[0].CreationDate(),
+			Payload:       events[0].DataAsBytes(),
+		}
+	}
+	return failedEvent, nil
+}
+
+// RetryFailedEvent reduces the skipped failed event again,
+// the failed event is removed if its statement succeeds
+func (q *Queries) RetryFailedEvent(ctx context.Context, projectionName, instanceID string, sequence uint64) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	return projection.RetryFailedEvent(ctx, projectionName, instanceID, sequence)
+}
+
+// RetryProjectionFailedEvents reduces all skipped failed events of the projection again
+// and returns the amount of events which failed again
+func (q *Queries) RetryProjectionFailedEvents(ctx context.Context, projectionName string) (failed int, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	return projection.RetryFailedEvents(ctx, projectionName)
+}
+
+// SkipFailedEvent lets the projection continue with the next event
+// and records the reason why the failed event was skipped
+func (q *Queries) SkipFailedEvent(ctx context.Context, projectionName, instanceID string, sequence uint64, reason string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	return projection.SkipFailedEvent(ctx, projectionName, instanceID, sequence, reason)
+}
+
 func NewFailedEventInstanceIDSearchQuery(instanceID string) (SearchQuery, error) {
 	return NewTextQuery(FailedEventsColumnInstanceID, instanceID, TextEquals)
 }
@@ -131,6 +240,9 @@ func prepareFailedEventsQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 			FailedEventsColumnFailureCount.identifier(),
 			FailedEventsColumnLastFailed.identifier(),
 			FailedEventsColumnError.identifier(),
+			FailedEventsColumnInstanceID.identifier(),
+			FailedEventsColumnSkipReason.identifier(),
+			FailedEventsColumnSkippedAt.identifier(),
 			countColumn.identifier()).
 			From(failedEventsTable.identifier() + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar),
@@ -139,19 +251,28 @@ func prepareFailedEventsQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 			var count uint64
 			for rows.Next() {
 				failedEvent := new(FailedEvent)
-				var lastFailed sql.NullTime
+				var (
+					lastFailed sql.NullTime
+					skipReason sql.NullString
+					skippedAt  sql.NullTime
+				)
 				err := rows.Scan(
 					&failedEvent.ProjectionName,
 					&failedEvent.FailedSequence,
 					&failedEvent.FailureCount,
 					&lastFailed,
 					&failedEvent.Error,
+					&failedEvent.InstanceID,
+					&skipReason,
+					&skippedAt,
 					&count,
 				)
 				if err != nil {
 					return nil, err
 				}
 				failedEvent.LastFailed = lastFailed.Time
+				failedEvent.SkipReason = skipReason.String
+				failedEvent.SkippedAt = skippedAt.Time
 				failedEvents = append(failedEvents, failedEvent)
 			}
 
@@ -167,3 +288,53 @@ func prepareFailedEventsQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 			}, nil
 		}
 }
+
+func prepareFailedEventQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*FailedEvent, error)) {
+	return sq.Select(
+			FailedEventsColumnProjectionName.identifier(),
+			FailedEventsColumnFailedSequence.identifier(),
+			FailedEventsColumnFailureCount.identifier(),
+			FailedEventsColumnLastFailed.identifier(),
+			FailedEventsColumnError.identifier(),
+			FailedEventsColumnInstanceID.identifier(),
+			FailedEventsColumnSkipReason.identifier(),
+			FailedEventsColumnSkippedAt.identifier(),
+			FailedEventsColumnErrorHistory.identifier()).
+			From(failedEventsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*FailedEvent, error) {
+			failedEvent := new(FailedEvent)
+			var (
+				lastFailed   sql.NullTime
+				skipReason   sql.NullString
+				skippedAt    sql.NullTime
+				errorHistory []byte
+			)
+			err := row.Scan(
+				&failedEvent.ProjectionName,
+				&failedEvent.FailedSequence,
+				&failedEvent.FailureCount,
+				&lastFailed,
+				&failedEvent.Error,
+				&failedEvent.InstanceID,
+				&skipReason,
+				&skippedAt,
+				&errorHistory,
+			)
+			if err != nil {
+				if errs.Is(err, sql.ErrNoRows) {
+					return nil, errors.ThrowNotFound(err, "QUERY-Lw2pd", "Errors.FailedEvent.NotFound")
+				}
+				return nil, errors.ThrowInternal(err, "QUERY-Tz8sd", "Errors.Internal")
+			}
+			failedEvent.LastFailed = lastFailed.Time
+			failedEvent.SkipReason = skipReason.String
+			failedEvent.SkippedAt = skippedAt.Time
+			if len(errorHistory) > 0 {
+				if err = json.Unmarshal(errorHistory, &failedEvent.ErrorHistory); err != nil {
+					return nil, errors.ThrowInternal(err, "QUERY-Gh4sf", "Errors.Internal")
+				}
+			}
+			return failedEvent, nil
+		}
+}
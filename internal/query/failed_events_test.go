@@ -7,6 +7,9 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
+
+	errs "github.com/zitadel/zitadel/internal/errors"
 )
 
 var (
@@ -15,6 +18,9 @@ var (
 		` projections.failed_events.failure_count,` +
 		` projections.failed_events.last_failed,` +
 		` projections.failed_events.error,` +
+		` projections.failed_events.instance_id,` +
+		` projections.failed_events.skip_reason,` +
+		` projections.failed_events.skipped_at,` +
 		` COUNT(*) OVER ()` +
 		` FROM projections.failed_events` +
 		` AS OF SYSTEM TIME '-1 ms'`
@@ -25,8 +31,35 @@ var (
 		"failure_count",
 		"last_failed",
 		"error",
+		"instance_id",
+		"skip_reason",
+		"skipped_at",
 		"count",
 	}
+
+	prepareFailedEventStmt = `SELECT projections.failed_events.projection_name,` +
+		` projections.failed_events.failed_sequence,` +
+		` projections.failed_events.failure_count,` +
+		` projections.failed_events.last_failed,` +
+		` projections.failed_events.error,` +
+		` projections.failed_events.instance_id,` +
+		` projections.failed_events.skip_reason,` +
+		` projections.failed_events.skipped_at,` +
+		` projections.failed_events.error_history` +
+		` FROM projections.failed_events` +
+		` AS OF SYSTEM TIME '-1 ms'`
+
+	prepareFailedEventCols = []string{
+		"projection_name",
+		"failed_sequence",
+		"failure_count",
+		"last_failed",
+		"error",
+		"instance_id",
+		"skip_reason",
+		"skipped_at",
+		"error_history",
+	}
 )
 
 func Test_FailedEventsPrepares(t *testing.T) {
@@ -66,6 +99,9 @@ func Test_FailedEventsPrepares(t *testing.T) {
 							uint64(2),
 							testNow,
 							"error",
+							"instance-id",
+							"reason",
+							testNow,
 						},
 					},
 				),
@@ -81,6 +117,9 @@ func Test_FailedEventsPrepares(t *testing.T) {
 						FailureCount:   2,
 						LastFailed:     testNow,
 						Error:          "error",
+						InstanceID:     "instance-id",
+						SkipReason:     "reason",
+						SkippedAt:      testNow,
 					},
 				},
 			},
@@ -99,6 +138,9 @@ func Test_FailedEventsPrepares(t *testing.T) {
 							2,
 							testNow,
 							"error",
+							"instance-id",
+							nil,
+							nil,
 						},
 						{
 							"projection-name-2",
@@ -106,6 +148,9 @@ func Test_FailedEventsPrepares(t *testing.T) {
 							2,
 							nil,
 							"error",
+							"instance-id",
+							nil,
+							nil,
 						},
 					},
 				),
@@ -121,12 +166,14 @@ func Test_FailedEventsPrepares(t *testing.T) {
 						FailureCount:   2,
 						LastFailed:     testNow,
 						Error:          "error",
+						InstanceID:     "instance-id",
 					},
 					{
 						ProjectionName: "projection-name-2",
 						FailedSequence: 20211108,
 						FailureCount:   2,
 						Error:          "error",
+						InstanceID:     "instance-id",
 					},
 				},
 			},
@@ -148,6 +195,57 @@ func Test_FailedEventsPrepares(t *testing.T) {
 			},
 			object: nil,
 		},
+		{
+			name:    "prepareFailedEventQuery no result",
+			prepare: prepareFailedEventQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareFailedEventStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*FailedEvent)(nil),
+		},
+		{
+			name:    "prepareFailedEventQuery found",
+			prepare: prepareFailedEventQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareFailedEventStmt),
+					prepareFailedEventCols,
+					[]driver.Value{
+						"projection-name",
+						uint64(20211108),
+						uint64(5),
+						testNow,
+						"error",
+						"instance-id",
+						nil,
+						nil,
+						[]byte(`[{"error": "first", "failedAt": "2021-11-08T10:00:00Z"}, {"error": "error", "failedAt": "2021-11-08T10:00:01Z"}]`),
+					},
+				),
+			},
+			object: &FailedEvent{
+				ProjectionName: "projection-name",
+				FailedSequence: 20211108,
+				FailureCount:   5,
+				LastFailed:     testNow,
+				Error:          "error",
+				InstanceID:     "instance-id",
+				ErrorHistory: []*FailedEventError{
+					{Error: "first", FailedAt: time.Date(2021, 11, 8, 10, 0, 0, 0, time.UTC)},
+					{Error: "error", FailedAt: time.Date(2021, 11, 8, 10, 0, 1, 0, time.UTC)},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,272 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	errs "errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+var (
+	groupsTable = table{
+		name:          projection.GroupProjectionTable,
+		instanceIDCol: projection.GroupColumnInstanceID,
+	}
+	GroupColumnID = Column{
+		name:  projection.GroupColumnID,
+		table: groupsTable,
+	}
+	GroupColumnCreationDate = Column{
+		name:  projection.GroupColumnCreationDate,
+		table: groupsTable,
+	}
+	GroupColumnChangeDate = Column{
+		name:  projection.GroupColumnChangeDate,
+		table: groupsTable,
+	}
+	GroupColumnResourceOwner = Column{
+		name:  projection.GroupColumnResourceOwner,
+		table: groupsTable,
+	}
+	GroupColumnInstanceID = Column{
+		name:  projection.GroupColumnInstanceID,
+		table: groupsTable,
+	}
+	GroupColumnState = Column{
+		name:  projection.GroupColumnState,
+		table: groupsTable,
+	}
+	GroupColumnSequence = Column{
+		name:  projection.GroupColumnSequence,
+		table: groupsTable,
+	}
+	GroupColumnName = Column{
+		name:           projection.GroupColumnName,
+		table:          groupsTable,
+		isOrderByLower: true,
+	}
+	GroupColumnDescription = Column{
+		name:  projection.GroupColumnDescription,
+		table: groupsTable,
+	}
+	GroupColumnOwnerRemoved = Column{
+		name:  projection.GroupColumnOwnerRemoved,
+		table: groupsTable,
+	}
+)
+
+type Groups struct {
+	SearchResponse
+	Groups []*Group
+}
+
+type Group struct {
+	ID            string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	State         domain.GroupState
+	Sequence      uint64
+
+	Name        string
+	Description string
+}
+
+type GroupSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *GroupSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func (q *GroupSearchQueries) AppendMyResourceOwnerQuery(orgID string) error {
+	query, err := NewGroupResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return err
+	}
+	q.Queries = append(q.Queries, query)
+	return nil
+}
+
+func (q *Queries) GroupByID(ctx context.Context, shouldTriggerBulk bool, id string, queries ...SearchQuery) (_ *Group, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		ctx = projection.GroupProjection.Trigger(ctx)
+	}
+
+	query, scan := prepareGroupQuery(ctx, q.client)
+	for _, q := range queries {
+		query = q.toQuery(query)
+	}
+	stmt, args, err := query.Where(sq.Eq{
+		GroupColumnID.identifier():           id,
+		GroupColumnInstanceID.identifier():   authz.GetInstance(ctx).InstanceID(),
+		GroupColumnOwnerRemoved.identifier(): false,
+	}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Gq2pe", "Errors.Query.SQLStatment")
+	}
+
+	row := q.client.QueryRowContext(ctx, stmt, args...)
+	return scan(row)
+}
+
+func (q *Queries) SearchGroups(ctx context.Context, queries *GroupSearchQueries, withOwnerRemoved bool) (groups *Groups, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareGroupsQuery(ctx, q.client)
+	eq := sq.Eq{
+		GroupColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	if !withOwnerRemoved {
+		eq[GroupColumnOwnerRemoved.identifier()] = false
+	}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Gq7wc", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Gq5mn", "Errors.Internal")
+	}
+	groups, err = scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	groups.LatestSequence, err = q.latestSequence(ctx, groupsTable)
+	return groups, err
+}
+
+func NewGroupResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupColumnResourceOwner, value, TextEquals)
+}
+
+func NewGroupIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupColumnID, value, TextEquals)
+}
+
+func NewGroupNameSearchQuery(method TextComparison, value string) (SearchQuery, error) {
+	return NewTextQuery(GroupColumnName, value, method)
+}
+
+// NewGroupUserIDSearchQuery restricts the groups to the ones the user is part of
+func NewGroupUserIDSearchQuery(userID string) (SearchQuery, error) {
+	instanceQuery, err := NewColumnComparisonQuery(GroupUserColumnInstanceID, GroupColumnInstanceID, ColumnEquals)
+	if err != nil {
+		return nil, err
+	}
+	userIDQuery, err := NewTextQuery(GroupUserColumnUserID, userID, TextEquals)
+	if err != nil {
+		return nil, err
+	}
+	subSelect, err := NewSubSelect(GroupUserColumnGroupID, []SearchQuery{instanceQuery, userIDQuery})
+	if err != nil {
+		return nil, err
+	}
+	return NewListQuery(
+		GroupColumnID,
+		subSelect,
+		ListIn,
+	)
+}
+
+func prepareGroupQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*Group, error)) {
+	return sq.Select(
+			GroupColumnID.identifier(),
+			GroupColumnCreationDate.identifier(),
+			GroupColumnChangeDate.identifier(),
+			GroupColumnResourceOwner.identifier(),
+			GroupColumnState.identifier(),
+			GroupColumnSequence.identifier(),
+			GroupColumnName.identifier(),
+			GroupColumnDescription.identifier()).
+			From(groupsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*Group, error) {
+			g := new(Group)
+			err := row.Scan(
+				&g.ID,
+				&g.CreationDate,
+				&g.ChangeDate,
+				&g.ResourceOwner,
+				&g.State,
+				&g.Sequence,
+				&g.Name,
+				&g.Description,
+			)
+			if err != nil {
+				if errs.Is(err, sql.ErrNoRows) {
+					return nil, errors.ThrowNotFound(err, "QUERY-Gq4hr", "Errors.Group.NotFound")
+				}
+				return nil, errors.ThrowInternal(err, "QUERY-Gq1zd", "Errors.Internal")
+			}
+			return g, nil
+		}
+}
+
+func prepareGroupsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*Groups, error)) {
+	return sq.Select(
+			GroupColumnID.identifier(),
+			GroupColumnCreationDate.identifier(),
+			GroupColumnChangeDate.identifier(),
+			GroupColumnResourceOwner.identifier(),
+			GroupColumnState.identifier(),
+			GroupColumnSequence.identifier(),
+			GroupColumnName.identifier(),
+			GroupColumnDescription.identifier(),
+			countColumn.identifier()).
+			From(groupsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*Groups, error) {
+			groups := make([]*Group, 0)
+			var count uint64
+			for rows.Next() {
+				g := new(Group)
+				err := rows.Scan(
+					&g.ID,
+					&g.CreationDate,
+					&g.ChangeDate,
+					&g.ResourceOwner,
+					&g.State,
+					&g.Sequence,
+					&g.Name,
+					&g.Description,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				groups = append(groups, g)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Gq9ks", "Errors.Query.CloseRows")
+			}
+
+			return &Groups{
+				Groups: groups,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -0,0 +1,293 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+var (
+	groupGrantsTable = table{
+		name:          projection.GroupGrantProjectionTable,
+		instanceIDCol: projection.GroupGrantColumnInstanceID,
+	}
+	GroupGrantColumnGroupID = Column{
+		name:  projection.GroupGrantColumnGroupID,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnProjectID = Column{
+		name:  projection.GroupGrantColumnProjectID,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnGrantID = Column{
+		name:  projection.GroupGrantColumnGrantID,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnRoles = Column{
+		name:  projection.GroupGrantColumnRoles,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnCreationDate = Column{
+		name:  projection.GroupGrantColumnCreationDate,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnChangeDate = Column{
+		name:  projection.GroupGrantColumnChangeDate,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnSequence = Column{
+		name:  projection.GroupGrantColumnSequence,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnResourceOwner = Column{
+		name:  projection.GroupGrantColumnResourceOwner,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnInstanceID = Column{
+		name:  projection.GroupGrantColumnInstanceID,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnOwnerRemoved = Column{
+		name:  projection.GroupGrantColumnOwnerRemoved,
+		table: groupGrantsTable,
+	}
+)
+
+type GroupGrants struct {
+	SearchResponse
+	Grants []*GroupGrant
+}
+
+type GroupGrant struct {
+	GroupID       string
+	ProjectID     string
+	GrantID       string
+	Roles         database.StringArray
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	ResourceOwner string
+
+	ProjectName string
+}
+
+type GroupGrantSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *GroupGrantSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func (q *GroupGrantSearchQueries) AppendMyResourceOwnerQuery(orgID string) error {
+	query, err := NewGroupGrantResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return err
+	}
+	q.Queries = append(q.Queries, query)
+	return nil
+}
+
+func (q *Queries) SearchGroupGrants(ctx context.Context, queries *GroupGrantSearchQueries, withOwnerRemoved bool) (grants *GroupGrants, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareGroupGrantsQuery(ctx, q.client)
+	eq := sq.Eq{
+		GroupGrantColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	if !withOwnerRemoved {
+		eq[GroupGrantColumnOwnerRemoved.identifier()] = false
+	}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Gt4lo", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Gt9ra", "Errors.Internal")
+	}
+	grants, err = scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	grants.LatestSequence, err = q.latestSequence(ctx, groupGrantsTable)
+	return grants, err
+}
+
+// UserGroupGrants returns the roles granted to the user through the groups the user is part of,
+// as user grants with the GroupID set.
+// If projectIDs are provided, only grants of these projects are returned.
+func (q *Queries) UserGroupGrants(ctx context.Context, shouldTriggerBulk bool, userID string, projectIDs ...string) (_ []*UserGrant, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		ctx = projection.GroupUserProjection.Trigger(ctx)
+		ctx = projection.GroupGrantProjection.Trigger(ctx)
+	}
+
+	query, scan := prepareUserGroupGrantsQuery(ctx, q.client)
+	eq := sq.Eq{
+		GroupUserColumnUserID.identifier():        userID,
+		GroupGrantColumnInstanceID.identifier():   authz.GetInstance(ctx).InstanceID(),
+		GroupGrantColumnOwnerRemoved.identifier(): false,
+		GroupUserColumnOwnerRemoved.identifier():  false,
+	}
+	if len(projectIDs) > 0 {
+		eq[GroupGrantColumnProjectID.identifier()] = projectIDs
+	}
+	stmt, args, err := query.Where(eq).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Gt2ve", "Errors.Query.SQLStatment")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Gt6xb", "Errors.Internal")
+	}
+	return scan(rows)
+}
+
+func NewGroupGrantGroupIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupGrantColumnGroupID, value, TextEquals)
+}
+
+func NewGroupGrantProjectIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupGrantColumnProjectID, value, TextEquals)
+}
+
+func NewGroupGrantGrantIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupGrantColumnGrantID, value, TextEquals)
+}
+
+func NewGroupGrantResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupGrantColumnResourceOwner, value, TextEquals)
+}
+
+func prepareGroupGrantsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*GroupGrants, error)) {
+	return sq.Select(
+			GroupGrantColumnGroupID.identifier(),
+			GroupGrantColumnProjectID.identifier(),
+			GroupGrantColumnGrantID.identifier(),
+			GroupGrantColumnRoles.identifier(),
+			GroupGrantColumnCreationDate.identifier(),
+			GroupGrantColumnChangeDate.identifier(),
+			GroupGrantColumnSequence.identifier(),
+			GroupGrantColumnResourceOwner.identifier(),
+			ProjectColumnName.identifier(),
+			countColumn.identifier()).
+			From(groupGrantsTable.identifier()).
+			LeftJoin(join(ProjectColumnID, GroupGrantColumnProjectID) + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*GroupGrants, error) {
+			grants := make([]*GroupGrant, 0)
+			var count uint64
+			for rows.Next() {
+				g := new(GroupGrant)
+				var projectName sql.NullString
+				err := rows.Scan(
+					&g.GroupID,
+					&g.ProjectID,
+					&g.GrantID,
+					&g.Roles,
+					&g.CreationDate,
+					&g.ChangeDate,
+					&g.Sequence,
+					&g.ResourceOwner,
+					&projectName,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				g.ProjectName = projectName.String
+				grants = append(grants, g)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Gt0jk", "Errors.Query.CloseRows")
+			}
+
+			return &GroupGrants{
+				Grants: grants,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareUserGroupGrantsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) ([]*UserGrant, error)) {
+	return sq.Select(
+			GroupGrantColumnGroupID.identifier(),
+			GroupGrantColumnCreationDate.identifier(),
+			GroupGrantColumnChangeDate.identifier(),
+			GroupGrantColumnSequence.identifier(),
+			GroupGrantColumnGrantID.identifier(),
+			GroupGrantColumnRoles.identifier(),
+			GroupUserColumnUserID.identifier(),
+			GroupGrantColumnResourceOwner.identifier(),
+			OrgColumnName.identifier(),
+			OrgColumnDomain.identifier(),
+			GroupGrantColumnProjectID.identifier(),
+			ProjectColumnName.identifier()).
+			From(groupGrantsTable.identifier()).
+			Join(join(GroupUserColumnGroupID, GroupGrantColumnGroupID)).
+			LeftJoin(join(OrgColumnID, GroupGrantColumnResourceOwner)).
+			LeftJoin(join(ProjectColumnID, GroupGrantColumnProjectID) + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]*UserGrant, error) {
+			grants := make([]*UserGrant, 0)
+			for rows.Next() {
+				g := &UserGrant{State: domain.UserGrantStateActive}
+				var (
+					orgName          sql.NullString
+					orgPrimaryDomain sql.NullString
+					projectName      sql.NullString
+				)
+				err := rows.Scan(
+					&g.GroupID,
+					&g.CreationDate,
+					&g.ChangeDate,
+					&g.Sequence,
+					&g.GrantID,
+					&g.Roles,
+					&g.UserID,
+					&g.ResourceOwner,
+					&orgName,
+					&orgPrimaryDomain,
+					&g.ProjectID,
+					&projectName,
+				)
+				if err != nil {
+					return nil, err
+				}
+				g.OrgName = orgName.String
+				g.OrgPrimaryDomain = orgPrimaryDomain.String
+				g.ProjectName = projectName.String
+				grants = append(grants, g)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Gt5wn", "Errors.Query.CloseRows")
+			}
+			return grants, nil
+		}
+}
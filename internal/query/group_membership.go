@@ -0,0 +1,184 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+var (
+	groupMembershipsTable = table{
+		name:          projection.GroupMembershipProjectionTable,
+		instanceIDCol: projection.GroupMembershipColumnInstanceID,
+	}
+	GroupMembershipColumnGroupID = Column{
+		name:  projection.GroupMembershipColumnGroupID,
+		table: groupMembershipsTable,
+	}
+	GroupMembershipColumnProjectID = Column{
+		name:  projection.GroupMembershipColumnProjectID,
+		table: groupMembershipsTable,
+	}
+	GroupMembershipColumnRoles = Column{
+		name:  projection.GroupMembershipColumnRoles,
+		table: groupMembershipsTable,
+	}
+	GroupMembershipColumnCreationDate = Column{
+		name:  projection.GroupMembershipColumnCreationDate,
+		table: groupMembershipsTable,
+	}
+	GroupMembershipColumnChangeDate = Column{
+		name:  projection.GroupMembershipColumnChangeDate,
+		table: groupMembershipsTable,
+	}
+	GroupMembershipColumnSequence = Column{
+		name:  projection.GroupMembershipColumnSequence,
+		table: groupMembershipsTable,
+	}
+	GroupMembershipColumnResourceOwner = Column{
+		name:  projection.GroupMembershipColumnResourceOwner,
+		table: groupMembershipsTable,
+	}
+	GroupMembershipColumnInstanceID = Column{
+		name:  projection.GroupMembershipColumnInstanceID,
+		table: groupMembershipsTable,
+	}
+	GroupMembershipColumnOwnerRemoved = Column{
+		name:  projection.GroupMembershipColumnOwnerRemoved,
+		table: groupMembershipsTable,
+	}
+)
+
+type GroupMemberships struct {
+	SearchResponse
+	Memberships []*GroupMembership
+}
+
+// GroupMembership grants member roles on the organization of the group
+// or, if the ProjectID is set, on the project to all users of the group
+type GroupMembership struct {
+	GroupID       string
+	ProjectID     string
+	Roles         database.StringArray
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	ResourceOwner string
+}
+
+type GroupMembershipSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *GroupMembershipSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func (q *GroupMembershipSearchQueries) AppendMyResourceOwnerQuery(orgID string) error {
+	query, err := NewGroupMembershipResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return err
+	}
+	q.Queries = append(q.Queries, query)
+	return nil
+}
+
+func (q *Queries) SearchGroupMemberships(ctx context.Context, queries *GroupMembershipSearchQueries, withOwnerRemoved bool) (memberships *GroupMemberships, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareGroupMembershipsQuery(ctx, q.client)
+	eq := sq.Eq{
+		GroupMembershipColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	if !withOwnerRemoved {
+		eq[GroupMembershipColumnOwnerRemoved.identifier()] = false
+	}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Gn3ce", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Gn7ad", "Errors.Internal")
+	}
+	memberships, err = scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	memberships.LatestSequence, err = q.latestSequence(ctx, groupMembershipsTable)
+	return memberships, err
+}
+
+func NewGroupMembershipGroupIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupMembershipColumnGroupID, value, TextEquals)
+}
+
+func NewGroupMembershipProjectIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupMembershipColumnProjectID, value, TextEquals)
+}
+
+func NewGroupMembershipResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupMembershipColumnResourceOwner, value, TextEquals)
+}
+
+func prepareGroupMembershipsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*GroupMemberships, error)) {
+	return sq.Select(
+			GroupMembershipColumnGroupID.identifier(),
+			GroupMembershipColumnProjectID.identifier(),
+			GroupMembershipColumnRoles.identifier(),
+			GroupMembershipColumnCreationDate.identifier(),
+			GroupMembershipColumnChangeDate.identifier(),
+			GroupMembershipColumnSequence.identifier(),
+			GroupMembershipColumnResourceOwner.identifier(),
+			countColumn.identifier()).
+			From(groupMembershipsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*GroupMemberships, error) {
+			memberships := make([]*GroupMembership, 0)
+			var count uint64
+			for rows.Next() {
+				m := new(GroupMembership)
+				err := rows.Scan(
+					&m.GroupID,
+					&m.ProjectID,
+					&m.Roles,
+					&m.CreationDate,
+					&m.ChangeDate,
+					&m.Sequence,
+					&m.ResourceOwner,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				memberships = append(memberships, m)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Gn5ho", "Errors.Query.CloseRows")
+			}
+
+			return &GroupMemberships{
+				Memberships: memberships,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
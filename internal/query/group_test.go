@@ -0,0 +1,325 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	errs "github.com/zitadel/zitadel/internal/errors"
+)
+
+var (
+	groupStmt = regexp.QuoteMeta(
+		"SELECT projections.groups.id," +
+			" projections.groups.creation_date," +
+			" projections.groups.change_date," +
+			" projections.groups.resource_owner," +
+			" projections.groups.state," +
+			" projections.groups.sequence," +
+			" projections.groups.name," +
+			" projections.groups.description" +
+			" FROM projections.groups" +
+			` AS OF SYSTEM TIME '-1 ms'`)
+	groupCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"state",
+		"sequence",
+		"name",
+		"description",
+	}
+	groupsStmt = regexp.QuoteMeta(
+		"SELECT projections.groups.id," +
+			" projections.groups.creation_date," +
+			" projections.groups.change_date," +
+			" projections.groups.resource_owner," +
+			" projections.groups.state," +
+			" projections.groups.sequence," +
+			" projections.groups.name," +
+			" projections.groups.description," +
+			" COUNT(*) OVER ()" +
+			" FROM projections.groups" +
+			` AS OF SYSTEM TIME '-1 ms'`)
+	groupsCols = append(groupCols, "count")
+
+	userGroupGrantsStmt = regexp.QuoteMeta(
+		"SELECT projections.group_grants.group_id," +
+			" projections.group_grants.creation_date," +
+			" projections.group_grants.change_date," +
+			" projections.group_grants.sequence," +
+			" projections.group_grants.grant_id," +
+			" projections.group_grants.roles," +
+			" projections.group_users.user_id," +
+			" projections.group_grants.resource_owner," +
+			" projections.orgs1.name," +
+			" projections.orgs1.primary_domain," +
+			" projections.group_grants.project_id," +
+			" projections.projects3.name" +
+			" FROM projections.group_grants" +
+			" JOIN projections.group_users ON projections.group_grants.group_id = projections.group_users.group_id AND projections.group_grants.instance_id = projections.group_users.instance_id" +
+			" LEFT JOIN projections.orgs1 ON projections.group_grants.resource_owner = projections.orgs1.id AND projections.group_grants.instance_id = projections.orgs1.instance_id" +
+			" LEFT JOIN projections.projects3 ON projections.group_grants.project_id = projections.projects3.id AND projections.group_grants.instance_id = projections.projects3.instance_id" +
+			` AS OF SYSTEM TIME '-1 ms'`)
+	userGroupGrantsCols = []string{
+		"group_id",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"grant_id",
+		"roles",
+		"user_id",
+		"resource_owner",
+		"name",
+		"primary_domain",
+		"project_id",
+		"name",
+	}
+)
+
+func Test_GroupPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareGroupQuery no result",
+			prepare: prepareGroupQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					groupStmt,
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*Group)(nil),
+		},
+		{
+			name:    "prepareGroupQuery found",
+			prepare: prepareGroupQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					groupStmt,
+					groupCols,
+					[]driver.Value{
+						"group-id",
+						testNow,
+						testNow,
+						"ro",
+						domain.GroupStateActive,
+						uint64(20211111),
+						"developers",
+						"all developers",
+					},
+				),
+			},
+			object: &Group{
+				ID:            "group-id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				ResourceOwner: "ro",
+				State:         domain.GroupStateActive,
+				Sequence:      20211111,
+				Name:          "developers",
+				Description:   "all developers",
+			},
+		},
+		{
+			name:    "prepareGroupQuery sql err",
+			prepare: prepareGroupQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					groupStmt,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+		{
+			name:    "prepareGroupsQuery no result",
+			prepare: prepareGroupsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					groupsStmt,
+					nil,
+					nil,
+				),
+			},
+			object: &Groups{Groups: []*Group{}},
+		},
+		{
+			name:    "prepareGroupsQuery multiple result",
+			prepare: prepareGroupsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					groupsStmt,
+					groupsCols,
+					[][]driver.Value{
+						{
+							"group-id",
+							testNow,
+							testNow,
+							"ro",
+							domain.GroupStateActive,
+							uint64(20211111),
+							"developers",
+							"all developers",
+						},
+						{
+							"group-id2",
+							testNow,
+							testNow,
+							"ro",
+							domain.GroupStateActive,
+							uint64(20211112),
+							"support",
+							"",
+						},
+					},
+				),
+			},
+			object: &Groups{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				Groups: []*Group{
+					{
+						ID:            "group-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						State:         domain.GroupStateActive,
+						Sequence:      20211111,
+						Name:          "developers",
+						Description:   "all developers",
+					},
+					{
+						ID:            "group-id2",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						State:         domain.GroupStateActive,
+						Sequence:      20211112,
+						Name:          "support",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareGroupsQuery sql err",
+			prepare: prepareGroupsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					groupsStmt,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+		{
+			name:    "prepareUserGroupGrantsQuery no result",
+			prepare: prepareUserGroupGrantsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					userGroupGrantsStmt,
+					nil,
+					nil,
+				),
+			},
+			object: []*UserGrant{},
+		},
+		{
+			name:    "prepareUserGroupGrantsQuery found",
+			prepare: prepareUserGroupGrantsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					userGroupGrantsStmt,
+					userGroupGrantsCols,
+					[][]driver.Value{
+						{
+							"group-id",
+							testNow,
+							testNow,
+							uint64(20211111),
+							"",
+							database.StringArray{"role-key"},
+							"user-id",
+							"ro",
+							"org-name",
+							"primary-domain",
+							"project-id",
+							nil,
+						},
+					},
+				),
+			},
+			object: []*UserGrant{
+				{
+					GroupID:          "group-id",
+					CreationDate:     testNow,
+					ChangeDate:       testNow,
+					Sequence:         20211111,
+					Roles:            database.StringArray{"role-key"},
+					UserID:           "user-id",
+					ResourceOwner:    "ro",
+					OrgName:          "org-name",
+					OrgPrimaryDomain: "primary-domain",
+					ProjectID:        "project-id",
+					State:            domain.UserGrantStateActive,
+				},
+			},
+		},
+		{
+			name:    "prepareUserGroupGrantsQuery sql err",
+			prepare: prepareUserGroupGrantsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					userGroupGrantsStmt,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+var (
+	groupUsersTable = table{
+		name:          projection.GroupUserProjectionTable,
+		instanceIDCol: projection.GroupUserColumnInstanceID,
+	}
+	GroupUserColumnGroupID = Column{
+		name:  projection.GroupUserColumnGroupID,
+		table: groupUsersTable,
+	}
+	GroupUserColumnUserID = Column{
+		name:  projection.GroupUserColumnUserID,
+		table: groupUsersTable,
+	}
+	GroupUserColumnCreationDate = Column{
+		name:  projection.GroupUserColumnCreationDate,
+		table: groupUsersTable,
+	}
+	GroupUserColumnChangeDate = Column{
+		name:  projection.GroupUserColumnChangeDate,
+		table: groupUsersTable,
+	}
+	GroupUserColumnSequence = Column{
+		name:  projection.GroupUserColumnSequence,
+		table: groupUsersTable,
+	}
+	GroupUserColumnResourceOwner = Column{
+		name:  projection.GroupUserColumnResourceOwner,
+		table: groupUsersTable,
+	}
+	GroupUserColumnInstanceID = Column{
+		name:  projection.GroupUserColumnInstanceID,
+		table: groupUsersTable,
+	}
+	GroupUserColumnOwnerRemoved = Column{
+		name:  projection.GroupUserColumnOwnerRemoved,
+		table: groupUsersTable,
+	}
+)
+
+type GroupUsers struct {
+	SearchResponse
+	Users []*GroupUser
+}
+
+type GroupUser struct {
+	GroupID       string
+	UserID        string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	ResourceOwner string
+
+	PreferredLoginName string
+	DisplayName        string
+}
+
+type GroupUserSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *GroupUserSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func (q *GroupUserSearchQueries) AppendMyResourceOwnerQuery(orgID string) error {
+	query, err := NewGroupUserResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return err
+	}
+	q.Queries = append(q.Queries, query)
+	return nil
+}
+
+func (q *Queries) SearchGroupUsers(ctx context.Context, queries *GroupUserSearchQueries, withOwnerRemoved bool) (users *GroupUsers, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareGroupUsersQuery(ctx, q.client)
+	eq := sq.Eq{
+		GroupUserColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		LoginNameIsPrimaryCol.identifier():     true,
+	}
+	if !withOwnerRemoved {
+		eq[GroupUserColumnOwnerRemoved.identifier()] = false
+	}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Gs3ud", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Gs8mx", "Errors.Internal")
+	}
+	users, err = scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	users.LatestSequence, err = q.latestSequence(ctx, groupUsersTable)
+	return users, err
+}
+
+func NewGroupUserGroupIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupUserColumnGroupID, value, TextEquals)
+}
+
+func NewGroupUserUserIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupUserColumnUserID, value, TextEquals)
+}
+
+func NewGroupUserResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupUserColumnResourceOwner, value, TextEquals)
+}
+
+func prepareGroupUsersQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*GroupUsers, error)) {
+	return sq.Select(
+			GroupUserColumnGroupID.identifier(),
+			GroupUserColumnUserID.identifier(),
+			GroupUserColumnCreationDate.identifier(),
+			GroupUserColumnChangeDate.identifier(),
+			GroupUserColumnSequence.identifier(),
+			GroupUserColumnResourceOwner.identifier(),
+			LoginNameNameCol.identifier(),
+			HumanDisplayNameCol.identifier(),
+			MachineNameCol.identifier(),
+			countColumn.identifier()).
+			From(groupUsersTable.identifier()).
+			LeftJoin(join(HumanUserIDCol, GroupUserColumnUserID)).
+			LeftJoin(join(MachineUserIDCol, GroupUserColumnUserID)).
+			LeftJoin(join(LoginNameUserIDCol, GroupUserColumnUserID) + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*GroupUsers, error) {
+			users := make([]*GroupUser, 0)
+			var count uint64
+			for rows.Next() {
+				u := new(GroupUser)
+				var (
+					preferredLoginName sql.NullString
+					displayName        sql.NullString
+					machineName        sql.NullString
+				)
+				err := rows.Scan(
+					&u.GroupID,
+					&u.UserID,
+					&u.CreationDate,
+					&u.ChangeDate,
+					&u.Sequence,
+					&u.ResourceOwner,
+					&preferredLoginName,
+					&displayName,
+					&machineName,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				u.PreferredLoginName = preferredLoginName.String
+				u.DisplayName = displayName.String
+				if !displayName.Valid {
+					u.DisplayName = machineName.String
+				}
+				users = append(users, u)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Gs1qp", "Errors.Query.CloseRows")
+			}
+
+			return &GroupUsers{
+				Users: users,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -195,6 +195,10 @@ func (q *Queries) IDPByIDAndResourceOwner(ctx context.Context, shouldTriggerBulk
 	if shouldTriggerBulk {
 		ctx = projection.IDPProjection.Trigger(ctx)
 	}
+	ownerIDs, err := q.policyOwnerIDs(ctx, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
 
 	eq := sq.Eq{
 		IDPIDCol.identifier():         id,
@@ -205,10 +209,7 @@ func (q *Queries) IDPByIDAndResourceOwner(ctx context.Context, shouldTriggerBulk
 	}
 	where := sq.And{
 		eq,
-		sq.Or{
-			sq.Eq{IDPResourceOwnerCol.identifier(): resourceOwner},
-			sq.Eq{IDPResourceOwnerCol.identifier(): authz.GetInstance(ctx).InstanceID()},
-		},
+		sq.Eq{IDPResourceOwnerCol.identifier(): ownerIDs},
 	}
 	stmt, scan := prepareIDPByIDQuery(ctx, q.client)
 	query, args, err := stmt.Where(where).ToSql()
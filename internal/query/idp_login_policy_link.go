@@ -84,7 +84,6 @@ var (
 	idpLoginPolicyOwnerTable           = loginPolicyTable.setAlias("login_policy_owner")
 	idpLoginPolicyOwnerIDCol           = LoginPolicyColumnOrgID.setTable(idpLoginPolicyOwnerTable)
 	idpLoginPolicyOwnerInstanceIDCol   = LoginPolicyColumnInstanceID.setTable(idpLoginPolicyOwnerTable)
-	idpLoginPolicyOwnerIsDefaultCol    = LoginPolicyColumnIsDefault.setTable(idpLoginPolicyOwnerTable)
 	idpLoginPolicyOwnerOwnerRemovedCol = LoginPolicyColumnOwnerRemoved.setTable(idpLoginPolicyOwnerTable)
 )
 
@@ -92,7 +91,11 @@ func (q *Queries) IDPLoginPolicyLinks(ctx context.Context, resourceOwner string,
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	query, scan := prepareIDPLoginPolicyLinksQuery(ctx, q.client, resourceOwner)
+	ownerIDs, err := q.policyOwnerIDs(ctx, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	query, scan := prepareIDPLoginPolicyLinksQuery(ctx, q.client, ownerIDs)
 	eq := sq.Eq{
 		IDPLoginPolicyLinkInstanceIDCol.identifier(): authz.GetInstance(ctx).InstanceID(),
 	}
@@ -117,8 +120,8 @@ func (q *Queries) IDPLoginPolicyLinks(ctx context.Context, resourceOwner string,
 	return idps, err
 }
 
-func prepareIDPLoginPolicyLinksQuery(ctx context.Context, db prepareDatabase, resourceOwner string) (sq.SelectBuilder, func(*sql.Rows) (*IDPLoginPolicyLinks, error)) {
-	resourceOwnerQuery, resourceOwnerArgs, err := prepareIDPLoginPolicyLinksResourceOwnerQuery(ctx, resourceOwner)
+func prepareIDPLoginPolicyLinksQuery(ctx context.Context, db prepareDatabase, ownerIDs []string) (sq.SelectBuilder, func(*sql.Rows) (*IDPLoginPolicyLinks, error)) {
+	resourceOwnerQuery, resourceOwnerArgs, err := prepareIDPLoginPolicyLinksResourceOwnerQuery(ctx, ownerIDs)
 	if err != nil {
 		return sq.SelectBuilder{}, nil
 	}
@@ -180,7 +183,9 @@ func prepareIDPLoginPolicyLinksQuery(ctx context.Context, db prepareDatabase, re
 		}
 }
 
-func prepareIDPLoginPolicyLinksResourceOwnerQuery(ctx context.Context, resourceOwner string) (string, []interface{}, error) {
+// prepareIDPLoginPolicyLinksResourceOwnerQuery selects the owner of the login policy
+// of the nearest organization in the hierarchy or the instance
+func prepareIDPLoginPolicyLinksResourceOwnerQuery(ctx context.Context, ownerIDs []string) (string, []interface{}, error) {
 	eqPolicy := sq.Eq{idpLoginPolicyOwnerInstanceIDCol.identifier(): authz.GetInstance(ctx).InstanceID()}
 	return sq.Select(
 		idpLoginPolicyOwnerIDCol.identifier(),
@@ -191,10 +196,7 @@ func prepareIDPLoginPolicyLinksResourceOwnerQuery(ctx context.Context, resourceO
 		Where(
 			sq.And{
 				eqPolicy,
-				sq.Or{
-					sq.Eq{idpLoginPolicyOwnerIDCol.identifier(): resourceOwner},
-					sq.Eq{idpLoginPolicyOwnerIDCol.identifier(): authz.GetInstance(ctx).InstanceID()},
-				},
+				sq.Eq{idpLoginPolicyOwnerIDCol.identifier(): ownerIDs},
 			}).
-		Limit(1).OrderBy(idpLoginPolicyOwnerIsDefaultCol.identifier()).ToSql()
+		Limit(1).OrderByClause(policyOwnerPrecedence(idpLoginPolicyOwnerIDCol, ownerIDs)).ToSql()
 }
@@ -22,8 +22,8 @@ var (
 		` COUNT(*) OVER ()` +
 		` FROM projections.idp_login_policy_links5` +
 		` LEFT JOIN projections.idp_templates5 ON projections.idp_login_policy_links5.idp_id = projections.idp_templates5.id AND projections.idp_login_policy_links5.instance_id = projections.idp_templates5.instance_id` +
-		` RIGHT JOIN (SELECT login_policy_owner.aggregate_id, login_policy_owner.instance_id, login_policy_owner.owner_removed FROM projections.login_policies5 AS login_policy_owner` +
-		` WHERE (login_policy_owner.instance_id = $1 AND (login_policy_owner.aggregate_id = $2 OR login_policy_owner.aggregate_id = $3)) ORDER BY login_policy_owner.is_default LIMIT 1) AS login_policy_owner` +
+		` RIGHT JOIN (SELECT login_policy_owner.aggregate_id, login_policy_owner.instance_id, login_policy_owner.owner_removed FROM projections.login_policies6 AS login_policy_owner` +
+		` WHERE (login_policy_owner.instance_id = $1 AND login_policy_owner.aggregate_id IN ($2,$3)) ORDER BY array_position($4::TEXT[], login_policy_owner.aggregate_id) LIMIT 1) AS login_policy_owner` +
 		` ON login_policy_owner.aggregate_id = projections.idp_login_policy_links5.resource_owner AND login_policy_owner.instance_id = projections.idp_login_policy_links5.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
 	loginPolicyIDPLinksCols = []string{
@@ -49,7 +49,7 @@ func Test_IDPLoginPolicyLinkPrepares(t *testing.T) {
 		{
 			name: "prepareIDPsQuery found",
 			prepare: func(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*IDPLoginPolicyLinks, error)) {
-				return prepareIDPLoginPolicyLinksQuery(ctx, db, "resourceOwner")
+				return prepareIDPLoginPolicyLinksQuery(ctx, db, []string{"resourceOwner", "instanceID"})
 			},
 			want: want{
 				sqlExpectations: mockQueries(
@@ -82,7 +82,7 @@ func Test_IDPLoginPolicyLinkPrepares(t *testing.T) {
 		{
 			name: "prepareIDPsQuery no idp",
 			prepare: func(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*IDPLoginPolicyLinks, error)) {
-				return prepareIDPLoginPolicyLinksQuery(ctx, db, "resourceOwner")
+				return prepareIDPLoginPolicyLinksQuery(ctx, db, []string{"resourceOwner", "instanceID"})
 			},
 			want: want{
 				sqlExpectations: mockQueries(
@@ -114,7 +114,7 @@ func Test_IDPLoginPolicyLinkPrepares(t *testing.T) {
 		{
 			name: "prepareIDPsQuery sql err",
 			prepare: func(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*IDPLoginPolicyLinks, error)) {
-				return prepareIDPLoginPolicyLinksQuery(ctx, db, "resourceOwner")
+				return prepareIDPLoginPolicyLinksQuery(ctx, db, []string{"resourceOwner", "instanceID"})
 			},
 			want: want{
 				sqlExpectations: mockQueryErr(
@@ -12,9 +12,11 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/cache"
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/errors"
 	"github.com/zitadel/zitadel/internal/query/projection"
+	instance_repo "github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 )
 
@@ -204,6 +206,20 @@ func (q *Queries) InstanceByHost(ctx context.Context, host string) (_ authz.Inst
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	cached, err := q.caches.instanceByHost.Get(ctx, host, func(ctx context.Context) (*cachedInstance, []cache.Aggregate, error) {
+		instance, err := q.instanceByHost(ctx, host)
+		if err != nil {
+			return nil, nil, err
+		}
+		return newCachedInstance(instance), []cache.Aggregate{{InstanceID: instance.ID, Type: instance_repo.AggregateType, ID: instance.ID}}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cached.instance(host), nil
+}
+
+func (q *Queries) instanceByHost(ctx context.Context, host string) (*Instance, error) {
 	stmt, scan := prepareAuthzInstanceQuery(ctx, q.client, host)
 	host = strings.Split(host, ":")[0] //remove possible port
 	query, args, err := stmt.Where(sq.Eq{
@@ -2,7 +2,6 @@ package query
 
 import (
 	"context"
-	"crypto/rsa"
 	"database/sql"
 	"time"
 
@@ -86,18 +85,19 @@ func (k *privateKey) Key() *crypto.CryptoValue {
 	return k.privateKey
 }
 
-type rsaPublicKey struct {
+type publicKey struct {
 	key
-	expiry    time.Time
-	publicKey *rsa.PublicKey
+	expiry time.Time
+	// publicKey is either a *rsa.PublicKey, *ecdsa.PublicKey or ed25519.PublicKey
+	publicKey interface{}
 }
 
-func (r *rsaPublicKey) Expiry() time.Time {
-	return r.expiry
+func (k *publicKey) Expiry() time.Time {
+	return k.expiry
 }
 
-func (r *rsaPublicKey) Key() interface{} {
-	return r.publicKey
+func (k *publicKey) Key() interface{} {
+	return k.publicKey
 }
 
 var (
@@ -137,6 +137,10 @@ var (
 		name:  projection.KeyColumnUse,
 		table: keyTable,
 	}
+	KeyColState = Column{
+		name:  projection.KeyColumnState,
+		table: keyTable,
+	}
 )
 
 var (
@@ -187,7 +191,10 @@ func (q *Queries) ActivePublicKeys(ctx context.Context, t time.Time) (_ *PublicK
 	}
 	stmt, args, err := query.Where(
 		sq.And{
-			sq.Eq{KeyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()},
+			sq.Eq{
+				KeyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+				KeyColState.identifier():      []domain.KeyPairState{domain.KeyPairStateActive, domain.KeyPairStatePublished},
+			},
 			sq.Gt{KeyPublicColExpiry.identifier(): t},
 		}).ToSql()
 	if err != nil {
@@ -222,6 +229,7 @@ func (q *Queries) ActivePrivateSigningKey(ctx context.Context, t time.Time) (_ *
 			sq.Eq{
 				KeyColUse.identifier():        domain.KeyUsageSigning,
 				KeyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+				KeyColState.identifier():      domain.KeyPairStateActive,
 			},
 			sq.Gt{KeyPrivateColExpiry.identifier(): t},
 		}).OrderBy(KeyPrivateColExpiry.identifier()).ToSql()
@@ -263,7 +271,7 @@ func preparePublicKeysQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 			keys := make([]PublicKey, 0)
 			var count uint64
 			for rows.Next() {
-				k := new(rsaPublicKey)
+				k := new(publicKey)
 				var keyValue []byte
 				err := rows.Scan(
 					&k.id,
@@ -280,7 +288,7 @@ func preparePublicKeysQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 				if err != nil {
 					return nil, err
 				}
-				k.publicKey, err = crypto.BytesToPublicKey(keyValue)
+				k.publicKey, err = crypto.BytesToSigningPublicKey(keyValue)
 				if err != nil {
 					return nil, err
 				}
@@ -0,0 +1,150 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+type KeyPairs struct {
+	SearchResponse
+	KeyPairs []*KeyPair
+}
+
+type KeyPair struct {
+	ID            string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	Sequence      uint64
+
+	Algorithm         string
+	Use               domain.KeyUsage
+	State             domain.KeyPairState
+	PrivateKeyExpiry  time.Time
+	PublicKeyExpiry   time.Time
+	CertificateExpiry time.Time
+}
+
+type KeyPairSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *KeyPairSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func NewKeyPairIDSearchQuery(id string) (SearchQuery, error) {
+	return NewTextQuery(KeyColID, id, TextEquals)
+}
+
+func NewKeyPairUseSearchQuery(use domain.KeyUsage) (SearchQuery, error) {
+	return NewNumberQuery(KeyColUse, int(use), NumberEquals)
+}
+
+func NewKeyPairStateSearchQuery(state domain.KeyPairState) (SearchQuery, error) {
+	return NewNumberQuery(KeyColState, int(state), NumberEquals)
+}
+
+func (q *Queries) SearchKeyPairs(ctx context.Context, queries *KeyPairSearchQueries) (keyPairs *KeyPairs, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareKeyPairsQuery(ctx, q.client)
+	stmt, args, err := queries.toQuery(query).
+		Where(sq.Eq{
+			KeyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Kp6la", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Kp6lb", "Errors.Internal")
+	}
+	keyPairs, err = scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	keyPairs.LatestSequence, err = q.latestSequence(ctx, keyTable)
+	return keyPairs, err
+}
+
+func prepareKeyPairsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*KeyPairs, error)) {
+	return sq.Select(
+			KeyColID.identifier(),
+			KeyColCreationDate.identifier(),
+			KeyColChangeDate.identifier(),
+			KeyColResourceOwner.identifier(),
+			KeyColSequence.identifier(),
+			KeyColAlgorithm.identifier(),
+			KeyColUse.identifier(),
+			KeyColState.identifier(),
+			KeyPrivateColExpiry.identifier(),
+			KeyPublicColExpiry.identifier(),
+			CertificateColExpiry.identifier(),
+			countColumn.identifier(),
+		).From(keyTable.identifier()).
+			LeftJoin(join(KeyPrivateColID, KeyColID)).
+			LeftJoin(join(KeyPublicColID, KeyColID)).
+			LeftJoin(join(CertificateColID, KeyColID) + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*KeyPairs, error) {
+			keyPairs := make([]*KeyPair, 0)
+			var count uint64
+			for rows.Next() {
+				keyPair := new(KeyPair)
+				var (
+					privateKeyExpiry  sql.NullTime
+					publicKeyExpiry   sql.NullTime
+					certificateExpiry sql.NullTime
+				)
+				err := rows.Scan(
+					&keyPair.ID,
+					&keyPair.CreationDate,
+					&keyPair.ChangeDate,
+					&keyPair.ResourceOwner,
+					&keyPair.Sequence,
+					&keyPair.Algorithm,
+					&keyPair.Use,
+					&keyPair.State,
+					&privateKeyExpiry,
+					&publicKeyExpiry,
+					&certificateExpiry,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				keyPair.PrivateKeyExpiry = privateKeyExpiry.Time
+				keyPair.PublicKeyExpiry = publicKeyExpiry.Time
+				keyPair.CertificateExpiry = certificateExpiry.Time
+				keyPairs = append(keyPairs, keyPair)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Kp6lc", "Errors.Query.CloseRows")
+			}
+
+			return &KeyPairs{
+				KeyPairs: keyPairs,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -0,0 +1,165 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	prepareKeyPairsStmt = `SELECT projections.keys5.id,` +
+		` projections.keys5.creation_date,` +
+		` projections.keys5.change_date,` +
+		` projections.keys5.resource_owner,` +
+		` projections.keys5.sequence,` +
+		` projections.keys5.algorithm,` +
+		` projections.keys5.use,` +
+		` projections.keys5.state,` +
+		` projections.keys5_private.expiry,` +
+		` projections.keys5_public.expiry,` +
+		` projections.keys5_certificate.expiry,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.keys5` +
+		` LEFT JOIN projections.keys5_private ON projections.keys5.id = projections.keys5_private.id AND projections.keys5.instance_id = projections.keys5_private.instance_id` +
+		` LEFT JOIN projections.keys5_public ON projections.keys5.id = projections.keys5_public.id AND projections.keys5.instance_id = projections.keys5_public.instance_id` +
+		` LEFT JOIN projections.keys5_certificate ON projections.keys5.id = projections.keys5_certificate.id AND projections.keys5.instance_id = projections.keys5_certificate.instance_id` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareKeyPairsCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"algorithm",
+		"use",
+		"state",
+		"expiry",
+		"expiry",
+		"expiry",
+		"count",
+	}
+)
+
+func Test_KeyPairPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareKeyPairsQuery no result",
+			prepare: prepareKeyPairsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareKeyPairsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &KeyPairs{KeyPairs: []*KeyPair{}},
+		},
+		{
+			name:    "prepareKeyPairsQuery found",
+			prepare: prepareKeyPairsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareKeyPairsStmt),
+					prepareKeyPairsCols,
+					[][]driver.Value{
+						{
+							"key-id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211109),
+							"ES256",
+							domain.KeyUsageSigning,
+							domain.KeyPairStatePublished,
+							testNow,
+							testNow,
+							nil,
+						},
+						{
+							"cert-id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211110),
+							"RS256",
+							domain.KeyUsageSAMLResponseSinging,
+							domain.KeyPairStateInactive,
+							nil,
+							testNow,
+							testNow,
+						},
+					},
+				),
+			},
+			object: &KeyPairs{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				KeyPairs: []*KeyPair{
+					{
+						ID:               "key-id",
+						CreationDate:     testNow,
+						ChangeDate:       testNow,
+						ResourceOwner:    "ro",
+						Sequence:         20211109,
+						Algorithm:        "ES256",
+						Use:              domain.KeyUsageSigning,
+						State:            domain.KeyPairStatePublished,
+						PrivateKeyExpiry: testNow,
+						PublicKeyExpiry:  testNow,
+					},
+					{
+						ID:                "cert-id",
+						CreationDate:      testNow,
+						ChangeDate:        testNow,
+						ResourceOwner:     "ro",
+						Sequence:          20211110,
+						Algorithm:         "RS256",
+						Use:               domain.KeyUsageSAMLResponseSinging,
+						State:             domain.KeyPairStateInactive,
+						PrivateKeyExpiry:  time.Time{},
+						PublicKeyExpiry:   testNow,
+						CertificateExpiry: testNow,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareKeyPairsQuery sql err",
+			prepare: prepareKeyPairsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareKeyPairsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package query
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rsa"
 	"database/sql"
 	"database/sql/driver"
@@ -16,18 +18,18 @@ import (
 )
 
 var (
-	preparePublicKeysStmt = `SELECT projections.keys4.id,` +
-		` projections.keys4.creation_date,` +
-		` projections.keys4.change_date,` +
-		` projections.keys4.sequence,` +
-		` projections.keys4.resource_owner,` +
-		` projections.keys4.algorithm,` +
-		` projections.keys4.use,` +
-		` projections.keys4_public.expiry,` +
-		` projections.keys4_public.key,` +
+	preparePublicKeysStmt = `SELECT projections.keys5.id,` +
+		` projections.keys5.creation_date,` +
+		` projections.keys5.change_date,` +
+		` projections.keys5.sequence,` +
+		` projections.keys5.resource_owner,` +
+		` projections.keys5.algorithm,` +
+		` projections.keys5.use,` +
+		` projections.keys5_public.expiry,` +
+		` projections.keys5_public.key,` +
 		` COUNT(*) OVER ()` +
-		` FROM projections.keys4` +
-		` LEFT JOIN projections.keys4_public ON projections.keys4.id = projections.keys4_public.id AND projections.keys4.instance_id = projections.keys4_public.instance_id` +
+		` FROM projections.keys5` +
+		` LEFT JOIN projections.keys5_public ON projections.keys5.id = projections.keys5_public.id AND projections.keys5.instance_id = projections.keys5_public.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms' `
 	preparePublicKeysCols = []string{
 		"id",
@@ -42,18 +44,18 @@ var (
 		"count",
 	}
 
-	preparePrivateKeysStmt = `SELECT projections.keys4.id,` +
-		` projections.keys4.creation_date,` +
-		` projections.keys4.change_date,` +
-		` projections.keys4.sequence,` +
-		` projections.keys4.resource_owner,` +
-		` projections.keys4.algorithm,` +
-		` projections.keys4.use,` +
-		` projections.keys4_private.expiry,` +
-		` projections.keys4_private.key,` +
+	preparePrivateKeysStmt = `SELECT projections.keys5.id,` +
+		` projections.keys5.creation_date,` +
+		` projections.keys5.change_date,` +
+		` projections.keys5.sequence,` +
+		` projections.keys5.resource_owner,` +
+		` projections.keys5.algorithm,` +
+		` projections.keys5.use,` +
+		` projections.keys5_private.expiry,` +
+		` projections.keys5_private.key,` +
 		` COUNT(*) OVER ()` +
-		` FROM projections.keys4` +
-		` LEFT JOIN projections.keys4_private ON projections.keys4.id = projections.keys4_private.id AND projections.keys4.instance_id = projections.keys4_private.instance_id` +
+		` FROM projections.keys5` +
+		` LEFT JOIN projections.keys5_private ON projections.keys5.id = projections.keys5_private.id AND projections.keys5.instance_id = projections.keys5_private.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms' `
 )
 
@@ -113,7 +115,7 @@ func Test_KeyPrepares(t *testing.T) {
 					Count: 1,
 				},
 				Keys: []PublicKey{
-					&rsaPublicKey{
+					&publicKey{
 						key: key{
 							id:            "key-id",
 							creationDate:  testNow,
@@ -132,6 +134,53 @@ func Test_KeyPrepares(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "preparePublicKeysQuery ec key found",
+			prepare: preparePublicKeysQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(preparePublicKeysStmt),
+					preparePublicKeysCols,
+					[][]driver.Value{
+						{
+							"key-id",
+							testNow,
+							testNow,
+							uint64(20211109),
+							"ro",
+							"ES256",
+							0,
+							testNow,
+							[]byte("-----BEGIN PUBLIC KEY-----\nMFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAE2o3EaRiFiD5eroowthXEq3AqhPeP\nEr5bxBmVo/QaFpAGuXH/wV/1ysyVmdmpS0kX5Xjcd9JJw7BxrtlRiJwZpQ==\n-----END PUBLIC KEY-----\n"),
+						},
+					},
+				),
+			},
+			object: &PublicKeys{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Keys: []PublicKey{
+					&publicKey{
+						key: key{
+							id:            "key-id",
+							creationDate:  testNow,
+							changeDate:    testNow,
+							sequence:      20211109,
+							resourceOwner: "ro",
+							algorithm:     "ES256",
+							use:           domain.KeyUsageSigning,
+						},
+						expiry: testNow,
+						publicKey: &ecdsa.PublicKey{
+							Curve: elliptic.P256(),
+							X:     fromBase16("da8dc4691885883e5eae8a30b615c4ab702a84f78f12be5bc41995a3f41a1690"),
+							Y:     fromBase16("6b971ffc15ff5cacc9599d9a94b4917e578dc77d249c3b071aed951889c19a5"),
+						},
+					},
+				},
+			},
+		},
 		{
 			name:    "preparePublicKeysQuery sql err",
 			prepare: preparePublicKeysQuery,
@@ -4,12 +4,14 @@ import (
 	"context"
 	"database/sql"
 	errs "errors"
+	"strconv"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/cache"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/errors"
 	"github.com/zitadel/zitadel/internal/query/projection"
@@ -46,6 +48,22 @@ func (q *Queries) ActiveLabelPolicyByOrg(ctx context.Context, orgID string, with
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	key := instanceID + ":" + orgID + ":" + strconv.FormatBool(withOwnerRemoved)
+	return q.caches.labelPolicy.Get(ctx, key, func(ctx context.Context) (*LabelPolicy, []cache.Aggregate, error) {
+		ownerIDs, err := q.policyOwnerIDs(ctx, orgID)
+		if err != nil {
+			return nil, nil, err
+		}
+		policy, err := q.activeLabelPolicyByOrg(ctx, ownerIDs, withOwnerRemoved)
+		if err != nil {
+			return nil, nil, err
+		}
+		return policy, policyAggregates(instanceID, ownerIDs), nil
+	})
+}
+
+func (q *Queries) activeLabelPolicyByOrg(ctx context.Context, ownerIDs []string, withOwnerRemoved bool) (*LabelPolicy, error) {
 	stmt, scan := prepareLabelPolicyQuery(ctx, q.client)
 	eq := sq.Eq{
 		LabelPolicyColState.identifier():      domain.LabelPolicyStateActive,
@@ -56,13 +74,10 @@ func (q *Queries) ActiveLabelPolicyByOrg(ctx context.Context, orgID string, with
 	}
 	query, args, err := stmt.Where(
 		sq.And{
-			sq.Or{
-				sq.Eq{LabelPolicyColID.identifier(): orgID},
-				sq.Eq{LabelPolicyColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
+			sq.Eq{LabelPolicyColID.identifier(): ownerIDs},
 			eq,
 		}).
-		OrderBy(LabelPolicyColIsDefault.identifier()).
+		OrderByClause(policyOwnerPrecedence(LabelPolicyColID, ownerIDs)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, errors.ThrowInternal(err, "QUERY-V22un", "unable to create sql stmt")
@@ -76,23 +91,21 @@ func (q *Queries) PreviewLabelPolicyByOrg(ctx context.Context, orgID string) (_
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	ownerIDs, err := q.policyOwnerIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	stmt, scan := prepareLabelPolicyQuery(ctx, q.client)
 	query, args, err := stmt.Where(
 		sq.And{
-			sq.Or{
-				sq.Eq{
-					LabelPolicyColID.identifier(): orgID,
-				},
-				sq.Eq{
-					LabelPolicyColID.identifier(): authz.GetInstance(ctx).InstanceID(),
-				},
-			},
+			sq.Eq{LabelPolicyColID.identifier(): ownerIDs},
 			sq.Eq{
 				LabelPolicyColState.identifier():      domain.LabelPolicyStatePreview,
 				LabelPolicyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
 			},
 		}).
-		OrderBy(LabelPolicyColIsDefault.identifier()).
+		OrderByClause(policyOwnerPrecedence(LabelPolicyColID, ownerIDs)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, errors.ThrowInternal(err, "QUERY-AG5eq", "unable to create sql stmt")
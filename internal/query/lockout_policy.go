@@ -88,6 +88,10 @@ func (q *Queries) LockoutPolicyByOrg(ctx context.Context, shouldTriggerBulk bool
 	if shouldTriggerBulk {
 		ctx = projection.LockoutPolicyProjection.Trigger(ctx)
 	}
+	ownerIDs, err := q.policyOwnerIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
 	eq := sq.Eq{
 		LockoutColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
 	}
@@ -99,12 +103,9 @@ func (q *Queries) LockoutPolicyByOrg(ctx context.Context, shouldTriggerBulk bool
 	query, args, err := stmt.Where(
 		sq.And{
 			eq,
-			sq.Or{
-				sq.Eq{LockoutColID.identifier(): orgID},
-				sq.Eq{LockoutColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
+			sq.Eq{LockoutColID.identifier(): ownerIDs},
 		}).
-		OrderBy(LockoutColIsDefault.identifier()).
+		OrderByClause(policyOwnerPrecedence(LockoutColID, ownerIDs)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, errors.ThrowInternal(err, "QUERY-SKR6X", "Errors.Query.SQLStatement")
@@ -4,12 +4,14 @@ import (
 	"context"
 	"database/sql"
 	errs "errors"
+	"strconv"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/cache"
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/errors"
@@ -42,6 +44,7 @@ type LoginPolicy struct {
 	MFAInitSkipLifetime        time.Duration
 	SecondFactorCheckLifetime  time.Duration
 	MultiFactorCheckLifetime   time.Duration
+	TrustedDeviceLifetime      time.Duration
 	IDPLinks                   []*IDPLoginPolicyLink
 }
 
@@ -160,6 +163,10 @@ var (
 		name:  projection.MultiFactorCheckLifetimeCol,
 		table: loginPolicyTable,
 	}
+	LoginPolicyColumnTrustedDeviceLifetime = Column{
+		name:  projection.TrustedDeviceLifetimeCol,
+		table: loginPolicyTable,
+	}
 	LoginPolicyColumnOwnerRemoved = Column{
 		name:  projection.LoginPolicyOwnerRemovedCol,
 		table: loginPolicyTable,
@@ -172,7 +179,28 @@ func (q *Queries) LoginPolicyByID(ctx context.Context, shouldTriggerBulk bool, o
 
 	if shouldTriggerBulk {
 		ctx = projection.LoginPolicyProjection.Trigger(ctx)
-	}
+		ownerIDs, err := q.policyOwnerIDs(ctx, orgID)
+		if err != nil {
+			return nil, err
+		}
+		return q.loginPolicyByID(ctx, ownerIDs, withOwnerRemoved)
+	}
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	key := instanceID + ":" + orgID + ":" + strconv.FormatBool(withOwnerRemoved)
+	return q.caches.loginPolicy.Get(ctx, key, func(ctx context.Context) (*LoginPolicy, []cache.Aggregate, error) {
+		ownerIDs, err := q.policyOwnerIDs(ctx, orgID)
+		if err != nil {
+			return nil, nil, err
+		}
+		policy, err := q.loginPolicyByID(ctx, ownerIDs, withOwnerRemoved)
+		if err != nil {
+			return nil, nil, err
+		}
+		return policy, policyAggregates(instanceID, ownerIDs), nil
+	})
+}
+
+func (q *Queries) loginPolicyByID(ctx context.Context, ownerIDs []string, withOwnerRemoved bool) (*LoginPolicy, error) {
 	eq := sq.Eq{LoginPolicyColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
 	if !withOwnerRemoved {
 		eq[LoginPolicyColumnOwnerRemoved.identifier()] = false
@@ -182,11 +210,8 @@ func (q *Queries) LoginPolicyByID(ctx context.Context, shouldTriggerBulk bool, o
 	stmt, args, err := query.Where(
 		sq.And{
 			eq,
-			sq.Or{
-				sq.Eq{LoginPolicyColumnOrgID.identifier(): orgID},
-				sq.Eq{LoginPolicyColumnOrgID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
-		}).Limit(1).OrderBy(LoginPolicyColumnIsDefault.identifier()).ToSql()
+			sq.Eq{LoginPolicyColumnOrgID.identifier(): ownerIDs},
+		}).Limit(1).OrderByClause(policyOwnerPrecedence(LoginPolicyColumnOrgID, ownerIDs)).ToSql()
 	if err != nil {
 		return nil, errors.ThrowInternal(err, "QUERY-scVHo", "Errors.Query.SQLStatement")
 	}
@@ -238,22 +263,20 @@ func (q *Queries) SecondFactorsByOrg(ctx context.Context, orgID string) (_ *Seco
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	ownerIDs, err := q.policyOwnerIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	query, scan := prepareLoginPolicy2FAsQuery(ctx, q.client)
 	stmt, args, err := query.Where(
 		sq.And{
 			sq.Eq{
 				LoginPolicyColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
 			},
-			sq.Or{
-				sq.Eq{
-					LoginPolicyColumnOrgID.identifier(): orgID,
-				},
-				sq.Eq{
-					LoginPolicyColumnOrgID.identifier(): authz.GetInstance(ctx).InstanceID(),
-				},
-			},
+			sq.Eq{LoginPolicyColumnOrgID.identifier(): ownerIDs},
 		}).
-		OrderBy(LoginPolicyColumnIsDefault.identifier()).
+		OrderByClause(policyOwnerPrecedence(LoginPolicyColumnOrgID, ownerIDs)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, errors.ThrowInternal(err, "QUERY-scVHo", "Errors.Query.SQLStatement")
@@ -294,22 +317,20 @@ func (q *Queries) MultiFactorsByOrg(ctx context.Context, orgID string) (_ *Multi
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	ownerIDs, err := q.policyOwnerIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	query, scan := prepareLoginPolicyMFAsQuery(ctx, q.client)
 	stmt, args, err := query.Where(
 		sq.And{
 			sq.Eq{
 				LoginPolicyColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
 			},
-			sq.Or{
-				sq.Eq{
-					LoginPolicyColumnOrgID.identifier(): orgID,
-				},
-				sq.Eq{
-					LoginPolicyColumnOrgID.identifier(): authz.GetInstance(ctx).InstanceID(),
-				},
-			},
+			sq.Eq{LoginPolicyColumnOrgID.identifier(): ownerIDs},
 		}).
-		OrderBy(LoginPolicyColumnIsDefault.identifier()).
+		OrderByClause(policyOwnerPrecedence(LoginPolicyColumnOrgID, ownerIDs)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, errors.ThrowInternal(err, "QUERY-B4o7h", "Errors.Query.SQLStatement")
@@ -372,6 +393,7 @@ func prepareLoginPolicyQuery(ctx context.Context, db prepareDatabase) (sq.Select
 			LoginPolicyColumnMFAInitSkipLifetime.identifier(),
 			LoginPolicyColumnSecondFactorCheckLifetime.identifier(),
 			LoginPolicyColumnMultiFactorCheckLifetime.identifier(),
+			LoginPolicyColumnTrustedDeviceLifetime.identifier(),
 		).From(loginPolicyTable.identifier() + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar),
 		func(rows *sql.Rows) (*LoginPolicy, error) {
@@ -403,6 +425,7 @@ func prepareLoginPolicyQuery(ctx context.Context, db prepareDatabase) (sq.Select
 					&p.MFAInitSkipLifetime,
 					&p.SecondFactorCheckLifetime,
 					&p.MultiFactorCheckLifetime,
+					&p.TrustedDeviceLifetime,
 				)
 				if err != nil {
 					return nil, errors.ThrowInternal(err, "QUERY-YcC53", "Errors.Internal")
@@ -74,6 +74,11 @@ func (q *Queries) MailTemplateByOrg(ctx context.Context, orgID string, withOwner
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	ownerIDs, err := q.policyOwnerIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	stmt, scan := prepareMailTemplateQuery(ctx, q.client)
 	eq := sq.Eq{MailTemplateColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
 	if !withOwnerRemoved {
@@ -82,12 +87,9 @@ func (q *Queries) MailTemplateByOrg(ctx context.Context, orgID string, withOwner
 	query, args, err := stmt.Where(
 		sq.And{
 			eq,
-			sq.Or{
-				sq.Eq{MailTemplateColAggregateID.identifier(): orgID},
-				sq.Eq{MailTemplateColAggregateID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
+			sq.Eq{MailTemplateColAggregateID.identifier(): ownerIDs},
 		}).
-		OrderBy(MailTemplateColIsDefault.identifier()).
+		OrderByClause(policyOwnerPrecedence(MailTemplateColAggregateID, ownerIDs)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, errors.ThrowInternal(err, "QUERY-m0sJg", "Errors.Query.SQLStatement")
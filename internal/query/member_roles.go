@@ -8,17 +8,17 @@ import (
 	"github.com/zitadel/zitadel/internal/domain"
 )
 
-func (q *Queries) GetIAMMemberRoles() []string {
+func (q *Queries) GetIAMMemberRoles(ctx context.Context) ([]string, error) {
 	roles := make([]string, 0)
 	for _, roleMap := range q.zitadelRoles {
 		if strings.HasPrefix(roleMap.Role, "IAM") {
 			roles = append(roles, roleMap.Role)
 		}
 	}
-	return roles
+	return q.appendCustomMemberRoles(ctx, roles, domain.IAMRolePrefix)
 }
 
-func (q *Queries) GetOrgMemberRoles(isGlobal bool) []string {
+func (q *Queries) GetOrgMemberRoles(ctx context.Context, isGlobal bool) ([]string, error) {
 	roles := make([]string, 0)
 	for _, roleMap := range q.zitadelRoles {
 		if strings.HasPrefix(roleMap.Role, "ORG") {
@@ -28,7 +28,7 @@ func (q *Queries) GetOrgMemberRoles(isGlobal bool) []string {
 	if isGlobal {
 		roles = append(roles, domain.RoleSelfManagementGlobal)
 	}
-	return roles
+	return q.appendCustomMemberRoles(ctx, roles, domain.OrgRolePrefix)
 }
 
 func (q *Queries) GetProjectMemberRoles(ctx context.Context) ([]string, error) {
@@ -46,15 +46,29 @@ func (q *Queries) GetProjectMemberRoles(ctx context.Context) ([]string, error) {
 			roles = append(roles, roleMap.Role)
 		}
 	}
-	return roles, nil
+	return q.appendCustomMemberRoles(ctx, roles, domain.ProjectRolePrefix)
 }
 
-func (q *Queries) GetProjectGrantMemberRoles() []string {
+func (q *Queries) GetProjectGrantMemberRoles(ctx context.Context) ([]string, error) {
 	roles := make([]string, 0)
 	for _, roleMap := range q.zitadelRoles {
 		if strings.HasPrefix(roleMap.Role, "PROJECT_GRANT") {
 			roles = append(roles, roleMap.Role)
 		}
 	}
-	return roles
+	return q.appendCustomMemberRoles(ctx, roles, domain.ProjectGrantRolePrefix)
+}
+
+// appendCustomMemberRoles adds the custom roles of the instance assignable to the member type of the prefix
+func (q *Queries) appendCustomMemberRoles(ctx context.Context, roles []string, rolePrefix string) ([]string, error) {
+	customRoles, err := q.CustomRoleMappings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, customRole := range customRoles {
+		if domain.RolePrefix(customRole.Role) == rolePrefix {
+			roles = append(roles, customRole.Role)
+		}
+	}
+	return roles, nil
 }
@@ -33,6 +33,13 @@ type MessageTexts struct {
 	DomainClaimed            MessageText
 	PasswordlessRegistration MessageText
 	PasswordChange           MessageText
+	DeviceTrusted            MessageText
+	UserDeactivation         MessageText
+	UserRemoval              MessageText
+	UserGrantRequested       MessageText
+	UserGrantApproved        MessageText
+	UserGrantDenied          MessageText
+	BackchannelAuthRequested MessageText
 }
 
 type MessageText struct {
@@ -341,6 +348,20 @@ func (m *MessageTexts) GetMessageTextByType(msgType string) *MessageText {
 		return &m.PasswordlessRegistration
 	case domain.PasswordChangeMessageType:
 		return &m.PasswordChange
+	case domain.DeviceTrustedMessageType:
+		return &m.DeviceTrusted
+	case domain.UserDeactivationMessageType:
+		return &m.UserDeactivation
+	case domain.UserRemovalMessageType:
+		return &m.UserRemoval
+	case domain.UserGrantRequestedMessageType:
+		return &m.UserGrantRequested
+	case domain.UserGrantApprovedMessageType:
+		return &m.UserGrantApproved
+	case domain.UserGrantDeniedMessageType:
+		return &m.UserGrantDenied
+	case domain.BackchannelAuthRequestedMessageType:
+		return &m.BackchannelAuthRequested
 	}
 	return nil
 }
@@ -86,6 +86,10 @@ func (q *Queries) NotificationPolicyByOrg(ctx context.Context, shouldTriggerBulk
 			return nil, err
 		}
 	}
+	ownerIDs, err := q.policyOwnerIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
 	eq := sq.Eq{NotificationPolicyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
 	if !withOwnerRemoved {
 		eq[NotificationPolicyColOwnerRemoved.identifier()] = false
@@ -94,12 +98,9 @@ func (q *Queries) NotificationPolicyByOrg(ctx context.Context, shouldTriggerBulk
 	query, args, err := stmt.Where(
 		sq.And{
 			eq,
-			sq.Or{
-				sq.Eq{NotificationPolicyColID.identifier(): orgID},
-				sq.Eq{NotificationPolicyColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
+			sq.Eq{NotificationPolicyColID.identifier(): ownerIDs},
 		}).
-		OrderBy(NotificationPolicyColIsDefault.identifier()).Limit(1).ToSql()
+		OrderByClause(policyOwnerPrecedence(NotificationPolicyColID, ownerIDs)).Limit(1).ToSql()
 	if err != nil {
 		return nil, errors.ThrowInternal(err, "QUERY-Xuoapqm", "Errors.Query.SQLStatement")
 	}
@@ -60,6 +60,10 @@ var (
 		name:  projection.OIDCSettingsColumnRefreshTokenExpiration,
 		table: oidcSettingsTable,
 	}
+	OIDCSettingsColumnSigningAlgorithm = Column{
+		name:  projection.OIDCSettingsColumnSigningAlgorithm,
+		table: oidcSettingsTable,
+	}
 )
 
 type OIDCSettings struct {
@@ -73,6 +77,7 @@ type OIDCSettings struct {
 	IdTokenLifetime            time.Duration
 	RefreshTokenIdleExpiration time.Duration
 	RefreshTokenExpiration     time.Duration
+	SigningAlgorithm           string
 }
 
 func (q *Queries) OIDCSettingsByAggID(ctx context.Context, aggregateID string) (_ *OIDCSettings, err error) {
@@ -102,7 +107,8 @@ func prepareOIDCSettingsQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 			OIDCSettingsColumnAccessTokenLifetime.identifier(),
 			OIDCSettingsColumnIdTokenLifetime.identifier(),
 			OIDCSettingsColumnRefreshTokenIdleExpiration.identifier(),
-			OIDCSettingsColumnRefreshTokenExpiration.identifier()).
+			OIDCSettingsColumnRefreshTokenExpiration.identifier(),
+			OIDCSettingsColumnSigningAlgorithm.identifier()).
 			From(oidcSettingsTable.identifier() + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar),
 		func(row *sql.Row) (*OIDCSettings, error) {
@@ -117,6 +123,7 @@ func prepareOIDCSettingsQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 				&oidcSettings.IdTokenLifetime,
 				&oidcSettings.RefreshTokenIdleExpiration,
 				&oidcSettings.RefreshTokenExpiration,
+				&oidcSettings.SigningAlgorithm,
 			)
 			if err != nil {
 				if errs.Is(err, sql.ErrNoRows) {
@@ -57,6 +57,10 @@ var (
 		name:  projection.OrgColumnDomain,
 		table: orgsTable,
 	}
+	OrgColumnParentID = Column{
+		name:  projection.OrgColumnParentID,
+		table: orgsTable,
+	}
 )
 
 type Orgs struct {
@@ -72,8 +76,9 @@ type Org struct {
 	State         domain_pkg.OrgState
 	Sequence      uint64
 
-	Name   string
-	Domain string
+	Name        string
+	Domain      string
+	ParentOrgID string
 }
 
 type OrgSearchQueries struct {
@@ -233,6 +238,10 @@ func NewOrgStateSearchQuery(value domain_pkg.OrgState) (SearchQuery, error) {
 	return NewNumberQuery(OrgColumnState, value, NumberEquals)
 }
 
+func NewOrgParentIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(OrgColumnParentID, value, TextEquals)
+}
+
 func NewOrgIDsSearchQuery(ids ...string) (SearchQuery, error) {
 	list := make([]interface{}, len(ids))
 	for i, value := range ids {
@@ -251,6 +260,7 @@ func prepareOrgsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder
 			OrgColumnSequence.identifier(),
 			OrgColumnName.identifier(),
 			OrgColumnDomain.identifier(),
+			OrgColumnParentID.identifier(),
 			countColumn.identifier()).
 			From(orgsTable.identifier() + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar),
@@ -268,6 +278,7 @@ func prepareOrgsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder
 					&org.Sequence,
 					&org.Name,
 					&org.Domain,
+					&org.ParentOrgID,
 					&count,
 				)
 				if err != nil {
@@ -299,6 +310,7 @@ func prepareOrgQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder,
 			OrgColumnSequence.identifier(),
 			OrgColumnName.identifier(),
 			OrgColumnDomain.identifier(),
+			OrgColumnParentID.identifier(),
 		).
 			From(orgsTable.identifier() + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar),
@@ -313,6 +325,7 @@ func prepareOrgQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder,
 				&o.Sequence,
 				&o.Name,
 				&o.Domain,
+				&o.ParentOrgID,
 			)
 			if err != nil {
 				if errs.Is(err, sql.ErrNoRows) {
@@ -334,6 +347,7 @@ func prepareOrgWithDomainsQuery(ctx context.Context, db prepareDatabase) (sq.Sel
 			OrgColumnSequence.identifier(),
 			OrgColumnName.identifier(),
 			OrgColumnDomain.identifier(),
+			OrgColumnParentID.identifier(),
 		).
 			From(orgsTable.identifier()).
 			LeftJoin(join(OrgDomainOrgIDCol, OrgColumnID) + db.Timetravel(call.Took(ctx))).
@@ -349,6 +363,7 @@ func prepareOrgWithDomainsQuery(ctx context.Context, db prepareDatabase) (sq.Sel
 				&o.Sequence,
 				&o.Name,
 				&o.Domain,
+				&o.ParentOrgID,
 			)
 			if err != nil {
 				if errs.Is(err, sql.ErrNoRows) {
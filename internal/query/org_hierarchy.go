@@ -0,0 +1,67 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+//go:embed embed/org_hierarchy.sql
+var orgHierarchyQuery string
+
+// OrgHierarchy returns the id of the organization followed by the ids of its ancestors,
+// ordered from the organization up to the topmost parent
+func (q *Queries) OrgHierarchy(ctx context.Context, orgID string) (_ []string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return nil, nil
+	}
+	rows, err := q.client.QueryContext(ctx, orgHierarchyQuery, authz.GetInstance(ctx).InstanceID(), orgID, domain.OrgStateRemoved, domain.OrgHierarchyMaxDepth)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Oh3ks", "Errors.Internal")
+	}
+	return scanOrgHierarchy(rows, orgID)
+}
+
+// scanOrgHierarchy falls back to the organization itself if it is not (yet) projected
+func scanOrgHierarchy(rows *sql.Rows, orgID string) ([]string, error) {
+	ids := make([]string, 0, 1)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, errors.ThrowInternal(err, "QUERY-Oh8vn", "Errors.Internal")
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Oh5dq", "Errors.Query.CloseRows")
+	}
+	if len(ids) == 0 {
+		return []string{orgID}, nil
+	}
+	return ids, nil
+}
+
+// policyOwnerIDs returns the ids of the possible owners of a policy of the organization,
+// the organization, its ancestors and the instance, ordered by precedence
+func (q *Queries) policyOwnerIDs(ctx context.Context, orgID string) ([]string, error) {
+	hierarchy, err := q.OrgHierarchy(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return append(hierarchy, authz.GetInstance(ctx).InstanceID()), nil
+}
+
+// policyOwnerPrecedence orders the policies by the precedence of their owners (see policyOwnerIDs),
+// so the policy of the nearest organization in the hierarchy is returned first
+func policyOwnerPrecedence(ownerCol Column, ownerIDs []string) (string, interface{}) {
+	return "array_position(?::TEXT[], " + ownerCol.identifier() + ")", database.StringArray(ownerIDs)
+}
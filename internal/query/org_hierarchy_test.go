@@ -0,0 +1,73 @@
+package query
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/zitadel/zitadel/internal/database"
+)
+
+func Test_scanOrgHierarchy(t *testing.T) {
+	tests := []struct {
+		name string
+		ids  []string
+		want []string
+	}{
+		{
+			name: "org not projected, org only",
+			want: []string{"org"},
+		},
+		{
+			name: "org without parent",
+			ids:  []string{"org"},
+			want: []string{"org"},
+		},
+		{
+			name: "org with ancestors",
+			ids:  []string{"org", "parent", "grandparent"},
+			want: []string{"org", "parent", "grandparent"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer client.Close()
+
+			result := sqlmock.NewRows([]string{"id"})
+			for _, id := range tt.ids {
+				result.AddRow(id)
+			}
+			mock.ExpectQuery(regexp.QuoteMeta(orgHierarchyQuery)).
+				WithArgs("instance", "org").
+				WillReturnRows(result)
+
+			rows, err := client.Query(orgHierarchyQuery, "instance", "org")
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := scanOrgHierarchy(rows, "org")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("scanOrgHierarchy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_policyOwnerPrecedence(t *testing.T) {
+	pred, arg := policyOwnerPrecedence(OrgColumnID, []string{"org", "parent", "instance"})
+	if want := "array_position(?::TEXT[], projections.orgs1.id)"; pred != want {
+		t.Errorf("policyOwnerPrecedence() pred = %q, want %q", pred, want)
+	}
+	if want := (database.StringArray{"org", "parent", "instance"}); !reflect.DeepEqual(arg, want) {
+		t.Errorf("policyOwnerPrecedence() arg = %v, want %v", arg, want)
+	}
+}
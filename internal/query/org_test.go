@@ -17,19 +17,20 @@ import (
 )
 
 var (
-	orgUniqueQuery = "SELECT COUNT(*) = 0 FROM projections.orgs LEFT JOIN projections.org_domains2 ON projections.orgs.id = projections.org_domains2.org_id AND projections.orgs.instance_id = projections.org_domains2.instance_id AS OF SYSTEM TIME '-1 ms' WHERE (projections.org_domains2.is_verified = $1 AND projections.orgs.instance_id = $2 AND (projections.org_domains2.domain ILIKE $3 OR projections.orgs.name ILIKE $4) AND projections.orgs.org_state <> $5)"
+	orgUniqueQuery = "SELECT COUNT(*) = 0 FROM projections.orgs1 LEFT JOIN projections.org_domains2 ON projections.orgs1.id = projections.org_domains2.org_id AND projections.orgs1.instance_id = projections.org_domains2.instance_id AS OF SYSTEM TIME '-1 ms' WHERE (projections.org_domains2.is_verified = $1 AND projections.orgs1.instance_id = $2 AND (projections.org_domains2.domain ILIKE $3 OR projections.orgs1.name ILIKE $4) AND projections.orgs1.org_state <> $5)"
 	orgUniqueCols  = []string{"is_unique"}
 
-	prepareOrgsQueryStmt = `SELECT projections.orgs.id,` +
-		` projections.orgs.creation_date,` +
-		` projections.orgs.change_date,` +
-		` projections.orgs.resource_owner,` +
-		` projections.orgs.org_state,` +
-		` projections.orgs.sequence,` +
-		` projections.orgs.name,` +
-		` projections.orgs.primary_domain,` +
+	prepareOrgsQueryStmt = `SELECT projections.orgs1.id,` +
+		` projections.orgs1.creation_date,` +
+		` projections.orgs1.change_date,` +
+		` projections.orgs1.resource_owner,` +
+		` projections.orgs1.org_state,` +
+		` projections.orgs1.sequence,` +
+		` projections.orgs1.name,` +
+		` projections.orgs1.primary_domain,` +
+		` projections.orgs1.parent_org_id,` +
 		` COUNT(*) OVER ()` +
-		` FROM projections.orgs` +
+		` FROM projections.orgs1` +
 		` AS OF SYSTEM TIME '-1 ms' `
 	prepareOrgsQueryCols = []string{
 		"id",
@@ -40,18 +41,20 @@ var (
 		"sequence",
 		"name",
 		"primary_domain",
+		"parent_org_id",
 		"count",
 	}
 
-	prepareOrgQueryStmt = `SELECT projections.orgs.id,` +
-		` projections.orgs.creation_date,` +
-		` projections.orgs.change_date,` +
-		` projections.orgs.resource_owner,` +
-		` projections.orgs.org_state,` +
-		` projections.orgs.sequence,` +
-		` projections.orgs.name,` +
-		` projections.orgs.primary_domain` +
-		` FROM projections.orgs` +
+	prepareOrgQueryStmt = `SELECT projections.orgs1.id,` +
+		` projections.orgs1.creation_date,` +
+		` projections.orgs1.change_date,` +
+		` projections.orgs1.resource_owner,` +
+		` projections.orgs1.org_state,` +
+		` projections.orgs1.sequence,` +
+		` projections.orgs1.name,` +
+		` projections.orgs1.primary_domain,` +
+		` projections.orgs1.parent_org_id` +
+		` FROM projections.orgs1` +
 		` AS OF SYSTEM TIME '-1 ms' `
 	prepareOrgQueryCols = []string{
 		"id",
@@ -62,11 +65,12 @@ var (
 		"sequence",
 		"name",
 		"primary_domain",
+		"parent_org_id",
 	}
 
 	prepareOrgUniqueStmt = `SELECT COUNT(*) = 0` +
-		` FROM projections.orgs` +
-		` LEFT JOIN projections.org_domains2 ON projections.orgs.id = projections.org_domains2.org_id AND projections.orgs.instance_id = projections.org_domains2.instance_id` +
+		` FROM projections.orgs1` +
+		` LEFT JOIN projections.org_domains2 ON projections.orgs1.id = projections.org_domains2.org_id AND projections.orgs1.instance_id = projections.org_domains2.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms' `
 	prepareOrgUniqueCols = []string{
 		"count",
@@ -113,6 +117,7 @@ func Test_OrgPrepares(t *testing.T) {
 							uint64(20211109),
 							"org-name",
 							"zitadel.ch",
+							"parent-id",
 						},
 					},
 				),
@@ -131,6 +136,7 @@ func Test_OrgPrepares(t *testing.T) {
 						Sequence:      20211109,
 						Name:          "org-name",
 						Domain:        "zitadel.ch",
+						ParentOrgID:   "parent-id",
 					},
 				},
 			},
@@ -152,6 +158,7 @@ func Test_OrgPrepares(t *testing.T) {
 							uint64(20211108),
 							"org-name-1",
 							"zitadel.ch",
+							"",
 						},
 						{
 							"id-2",
@@ -162,6 +169,7 @@ func Test_OrgPrepares(t *testing.T) {
 							uint64(20211108),
 							"org-name-2",
 							"caos.ch",
+							"",
 						},
 					},
 				),
@@ -245,6 +253,7 @@ func Test_OrgPrepares(t *testing.T) {
 						uint64(20211108),
 						"org-name",
 						"zitadel.ch",
+						"",
 					},
 				),
 			},
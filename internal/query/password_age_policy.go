@@ -88,6 +88,10 @@ func (q *Queries) PasswordAgePolicyByOrg(ctx context.Context, shouldTriggerBulk
 	if shouldTriggerBulk {
 		ctx = projection.PasswordAgeProjection.Trigger(ctx)
 	}
+	ownerIDs, err := q.policyOwnerIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
 	eq := sq.Eq{PasswordAgeColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
 	if !withOwnerRemoved {
 		eq[PasswordAgeColOwnerRemoved.identifier()] = false
@@ -96,12 +100,9 @@ func (q *Queries) PasswordAgePolicyByOrg(ctx context.Context, shouldTriggerBulk
 	query, args, err := stmt.Where(
 		sq.And{
 			eq,
-			sq.Or{
-				sq.Eq{PasswordAgeColID.identifier(): orgID},
-				sq.Eq{PasswordAgeColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
+			sq.Eq{PasswordAgeColID.identifier(): ownerIDs},
 		}).
-		OrderBy(PasswordAgeColIsDefault.identifier()).
+		OrderByClause(policyOwnerPrecedence(PasswordAgeColID, ownerIDs)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, errors.ThrowInternal(err, "QUERY-SKR6X", "Errors.Query.SQLStatement")
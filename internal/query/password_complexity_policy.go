@@ -40,6 +40,10 @@ func (q *Queries) PasswordComplexityPolicyByOrg(ctx context.Context, shouldTrigg
 	if shouldTriggerBulk {
 		ctx = projection.PasswordComplexityProjection.Trigger(ctx)
 	}
+	ownerIDs, err := q.policyOwnerIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
 	eq := sq.Eq{PasswordComplexityColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
 	if !withOwnerRemoved {
 		eq[PasswordComplexityColOwnerRemoved.identifier()] = false
@@ -48,12 +52,9 @@ func (q *Queries) PasswordComplexityPolicyByOrg(ctx context.Context, shouldTrigg
 	query, args, err := stmt.Where(
 		sq.And{
 			eq,
-			sq.Or{
-				sq.Eq{PasswordComplexityColID.identifier(): orgID},
-				sq.Eq{PasswordComplexityColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
+			sq.Eq{PasswordComplexityColID.identifier(): ownerIDs},
 		}).
-		OrderBy(PasswordComplexityColIsDefault.identifier()).
+		OrderByClause(policyOwnerPrecedence(PasswordComplexityColID, ownerIDs)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, errors.ThrowInternal(err, "QUERY-lDnrk", "Errors.Query.SQLStatement")
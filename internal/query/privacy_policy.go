@@ -24,10 +24,11 @@ type PrivacyPolicy struct {
 	ResourceOwner string
 	State         domain.PolicyState
 
-	TOSLink      string
-	PrivacyLink  string
-	HelpLink     string
-	SupportEmail domain.EmailAddress
+	TOSLink           string
+	PrivacyLink       string
+	HelpLink          string
+	SupportEmail      domain.EmailAddress
+	AllowSelfDeletion bool
 
 	IsDefault bool
 }
@@ -77,6 +78,10 @@ var (
 		name:  projection.PrivacyPolicySupportEmailCol,
 		table: privacyTable,
 	}
+	PrivacyColAllowSelfDeletion = Column{
+		name:  projection.PrivacyPolicyAllowSelfDeletionCol,
+		table: privacyTable,
+	}
 	PrivacyColIsDefault = Column{
 		name:  projection.PrivacyPolicyIsDefaultCol,
 		table: privacyTable,
@@ -98,6 +103,10 @@ func (q *Queries) PrivacyPolicyByOrg(ctx context.Context, shouldTriggerBulk bool
 	if shouldTriggerBulk {
 		ctx = projection.PrivacyPolicyProjection.Trigger(ctx)
 	}
+	ownerIDs, err := q.policyOwnerIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
 	eq := sq.Eq{PrivacyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
 	if !withOwnerRemoved {
 		eq[PrivacyColOwnerRemoved.identifier()] = false
@@ -106,12 +115,9 @@ func (q *Queries) PrivacyPolicyByOrg(ctx context.Context, shouldTriggerBulk bool
 	query, args, err := stmt.Where(
 		sq.And{
 			eq,
-			sq.Or{
-				sq.Eq{PrivacyColID.identifier(): orgID},
-				sq.Eq{PrivacyColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
+			sq.Eq{PrivacyColID.identifier(): ownerIDs},
 		}).
-		OrderBy(PrivacyColIsDefault.identifier()).Limit(1).ToSql()
+		OrderByClause(policyOwnerPrecedence(PrivacyColID, ownerIDs)).Limit(1).ToSql()
 	if err != nil {
 		return nil, errors.ThrowInternal(err, "QUERY-UXuPI", "Errors.Query.SQLStatement")
 	}
@@ -154,6 +160,7 @@ func preparePrivacyPolicyQuery(ctx context.Context, db prepareDatabase) (sq.Sele
 			PrivacyColTOSLink.identifier(),
 			PrivacyColHelpLink.identifier(),
 			PrivacyColSupportEmail.identifier(),
+			PrivacyColAllowSelfDeletion.identifier(),
 			PrivacyColIsDefault.identifier(),
 			PrivacyColState.identifier(),
 		).
@@ -171,6 +178,7 @@ func preparePrivacyPolicyQuery(ctx context.Context, db prepareDatabase) (sq.Sele
 				&policy.TOSLink,
 				&policy.HelpLink,
 				&policy.SupportEmail,
+				&policy.AllowSelfDeletion,
 				&policy.IsDefault,
 				&policy.State,
 			)
@@ -186,10 +194,11 @@ func preparePrivacyPolicyQuery(ctx context.Context, db prepareDatabase) (sq.Sele
 
 func (p *PrivacyPolicy) ToDomain() *domain.PrivacyPolicy {
 	return &domain.PrivacyPolicy{
-		TOSLink:      p.TOSLink,
-		PrivacyLink:  p.PrivacyLink,
-		HelpLink:     p.HelpLink,
-		SupportEmail: p.SupportEmail,
-		Default:      p.IsDefault,
+		TOSLink:           p.TOSLink,
+		PrivacyLink:       p.PrivacyLink,
+		HelpLink:          p.HelpLink,
+		SupportEmail:      p.SupportEmail,
+		AllowSelfDeletion: p.AllowSelfDeletion,
+		Default:           p.IsDefault,
 	}
 }
@@ -0,0 +1,242 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+var (
+	projectAuthorizationDetailTypesTable = table{
+		name:          projection.ProjectAuthorizationDetailTypeProjectionTable,
+		instanceIDCol: projection.ProjectAuthorizationDetailTypeColumnInstanceID,
+	}
+	ProjectAuthorizationDetailTypeColumnCreationDate = Column{
+		name:  projection.ProjectAuthorizationDetailTypeColumnCreationDate,
+		table: projectAuthorizationDetailTypesTable,
+	}
+	ProjectAuthorizationDetailTypeColumnChangeDate = Column{
+		name:  projection.ProjectAuthorizationDetailTypeColumnChangeDate,
+		table: projectAuthorizationDetailTypesTable,
+	}
+	ProjectAuthorizationDetailTypeColumnResourceOwner = Column{
+		name:  projection.ProjectAuthorizationDetailTypeColumnResourceOwner,
+		table: projectAuthorizationDetailTypesTable,
+	}
+	ProjectAuthorizationDetailTypeColumnInstanceID = Column{
+		name:  projection.ProjectAuthorizationDetailTypeColumnInstanceID,
+		table: projectAuthorizationDetailTypesTable,
+	}
+	ProjectAuthorizationDetailTypeColumnSequence = Column{
+		name:  projection.ProjectAuthorizationDetailTypeColumnSequence,
+		table: projectAuthorizationDetailTypesTable,
+	}
+	ProjectAuthorizationDetailTypeColumnProjectID = Column{
+		name:  projection.ProjectAuthorizationDetailTypeColumnProjectID,
+		table: projectAuthorizationDetailTypesTable,
+	}
+	ProjectAuthorizationDetailTypeColumnType = Column{
+		name:  projection.ProjectAuthorizationDetailTypeColumnType,
+		table: projectAuthorizationDetailTypesTable,
+	}
+	ProjectAuthorizationDetailTypeColumnDisplayName = Column{
+		name:  projection.ProjectAuthorizationDetailTypeColumnDisplayName,
+		table: projectAuthorizationDetailTypesTable,
+	}
+	ProjectAuthorizationDetailTypeColumnDescription = Column{
+		name:  projection.ProjectAuthorizationDetailTypeColumnDescription,
+		table: projectAuthorizationDetailTypesTable,
+	}
+	ProjectAuthorizationDetailTypeColumnSchema = Column{
+		name:  projection.ProjectAuthorizationDetailTypeColumnSchema,
+		table: projectAuthorizationDetailTypesTable,
+	}
+	ProjectAuthorizationDetailTypeColumnOwnerRemoved = Column{
+		name:  projection.ProjectAuthorizationDetailTypeColumnOwnerRemoved,
+		table: projectAuthorizationDetailTypesTable,
+	}
+)
+
+type ProjectAuthorizationDetailTypes struct {
+	SearchResponse
+	ProjectAuthorizationDetailTypes []*ProjectAuthorizationDetailType
+}
+
+type ProjectAuthorizationDetailType struct {
+	ProjectID     string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	Sequence      uint64
+
+	Type        string
+	DisplayName string
+	Description string
+	Schema      string
+}
+
+type ProjectAuthorizationDetailTypeSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *Queries) SearchProjectAuthorizationDetailTypes(ctx context.Context, shouldTriggerBulk bool, queries *ProjectAuthorizationDetailTypeSearchQueries, withOwnerRemoved bool) (detailTypes *ProjectAuthorizationDetailTypes, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		ctx = projection.ProjectAuthorizationDetailTypeProjection.Trigger(ctx)
+	}
+
+	eq := sq.Eq{ProjectAuthorizationDetailTypeColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
+	if !withOwnerRemoved {
+		eq[ProjectAuthorizationDetailTypeColumnOwnerRemoved.identifier()] = false
+	}
+
+	query, scan := prepareProjectAuthorizationDetailTypesQuery(ctx, q.client)
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Ad3ow", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Ad8lv", "Errors.Internal")
+	}
+	detailTypes, err = scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	detailTypes.LatestSequence, err = q.latestSequence(ctx, projectAuthorizationDetailTypesTable)
+	return detailTypes, err
+}
+
+// ProjectAuthorizationDetailTypeSchemas returns the authorization detail types currently registered on the project
+// mapped to their parsed JSON schema, types without a schema are mapped to nil
+func (q *Queries) ProjectAuthorizationDetailTypeSchemas(ctx context.Context, shouldTriggerBulk bool, projectID string) (_ map[string]*domain.JSONSchema, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	projectQuery, err := NewProjectAuthorizationDetailTypeProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+	detailTypes, err := q.SearchProjectAuthorizationDetailTypes(ctx, shouldTriggerBulk, &ProjectAuthorizationDetailTypeSearchQueries{Queries: []SearchQuery{projectQuery}}, false)
+	if err != nil {
+		return nil, err
+	}
+	schemas := make(map[string]*domain.JSONSchema, len(detailTypes.ProjectAuthorizationDetailTypes))
+	for _, detailType := range detailTypes.ProjectAuthorizationDetailTypes {
+		if detailType.Schema == "" {
+			schemas[detailType.Type] = nil
+			continue
+		}
+		schema, err := domain.ParseJSONSchema([]byte(detailType.Schema))
+		if err != nil {
+			return nil, errors.ThrowInternal(err, "QUERY-Ad2sc", "Errors.Internal")
+		}
+		schemas[detailType.Type] = schema
+	}
+	return schemas, nil
+}
+
+func NewProjectAuthorizationDetailTypeProjectIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(ProjectAuthorizationDetailTypeColumnProjectID, value, TextEquals)
+}
+
+func NewProjectAuthorizationDetailTypeResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(ProjectAuthorizationDetailTypeColumnResourceOwner, value, TextEquals)
+}
+
+func NewProjectAuthorizationDetailTypeTypeSearchQuery(method TextComparison, value string) (SearchQuery, error) {
+	return NewTextQuery(ProjectAuthorizationDetailTypeColumnType, value, method)
+}
+
+func NewProjectAuthorizationDetailTypeDisplayNameSearchQuery(method TextComparison, value string) (SearchQuery, error) {
+	return NewTextQuery(ProjectAuthorizationDetailTypeColumnDisplayName, value, method)
+}
+
+func (r *ProjectAuthorizationDetailTypeSearchQueries) AppendProjectIDQuery(projectID string) error {
+	query, err := NewProjectAuthorizationDetailTypeProjectIDSearchQuery(projectID)
+	if err != nil {
+		return err
+	}
+	r.Queries = append(r.Queries, query)
+	return nil
+}
+
+func (r *ProjectAuthorizationDetailTypeSearchQueries) AppendMyResourceOwnerQuery(orgID string) error {
+	query, err := NewProjectAuthorizationDetailTypeResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return err
+	}
+	r.Queries = append(r.Queries, query)
+	return nil
+}
+
+func (q *ProjectAuthorizationDetailTypeSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func prepareProjectAuthorizationDetailTypesQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*ProjectAuthorizationDetailTypes, error)) {
+	return sq.Select(
+			ProjectAuthorizationDetailTypeColumnProjectID.identifier(),
+			ProjectAuthorizationDetailTypeColumnCreationDate.identifier(),
+			ProjectAuthorizationDetailTypeColumnChangeDate.identifier(),
+			ProjectAuthorizationDetailTypeColumnResourceOwner.identifier(),
+			ProjectAuthorizationDetailTypeColumnSequence.identifier(),
+			ProjectAuthorizationDetailTypeColumnType.identifier(),
+			ProjectAuthorizationDetailTypeColumnDisplayName.identifier(),
+			ProjectAuthorizationDetailTypeColumnDescription.identifier(),
+			ProjectAuthorizationDetailTypeColumnSchema.identifier(),
+			countColumn.identifier()).
+			From(projectAuthorizationDetailTypesTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*ProjectAuthorizationDetailTypes, error) {
+			detailTypes := make([]*ProjectAuthorizationDetailType, 0)
+			var count uint64
+			for rows.Next() {
+				detailType := new(ProjectAuthorizationDetailType)
+				err := rows.Scan(
+					&detailType.ProjectID,
+					&detailType.CreationDate,
+					&detailType.ChangeDate,
+					&detailType.ResourceOwner,
+					&detailType.Sequence,
+					&detailType.Type,
+					&detailType.DisplayName,
+					&detailType.Description,
+					&detailType.Schema,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				detailTypes = append(detailTypes, detailType)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Ad5uy", "Errors.Query.CloseRows")
+			}
+
+			return &ProjectAuthorizationDetailTypes{
+				ProjectAuthorizationDetailTypes: detailTypes,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
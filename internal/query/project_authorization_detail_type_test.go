@@ -0,0 +1,189 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+var (
+	prepareProjectAuthorizationDetailTypesStmt = `SELECT projections.project_authorization_detail_types.project_id,` +
+		` projections.project_authorization_detail_types.creation_date,` +
+		` projections.project_authorization_detail_types.change_date,` +
+		` projections.project_authorization_detail_types.resource_owner,` +
+		` projections.project_authorization_detail_types.sequence,` +
+		` projections.project_authorization_detail_types.detail_type,` +
+		` projections.project_authorization_detail_types.display_name,` +
+		` projections.project_authorization_detail_types.description,` +
+		` projections.project_authorization_detail_types.json_schema,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.project_authorization_detail_types` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareProjectAuthorizationDetailTypesCols = []string{
+		"project_id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"detail_type",
+		"display_name",
+		"description",
+		"json_schema",
+		"count",
+	}
+)
+
+func Test_ProjectAuthorizationDetailTypePrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareProjectAuthorizationDetailTypesQuery no result",
+			prepare: prepareProjectAuthorizationDetailTypesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareProjectAuthorizationDetailTypesStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &ProjectAuthorizationDetailTypes{ProjectAuthorizationDetailTypes: []*ProjectAuthorizationDetailType{}},
+		},
+		{
+			name:    "prepareProjectAuthorizationDetailTypesQuery one result",
+			prepare: prepareProjectAuthorizationDetailTypesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareProjectAuthorizationDetailTypesStmt),
+					prepareProjectAuthorizationDetailTypesCols,
+					[][]driver.Value{
+						{
+							"project-id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211111),
+							"detail-type",
+							"detail-type-display-name",
+							"detail-type-description",
+							`{"type": "object"}`,
+						},
+					},
+				),
+			},
+			object: &ProjectAuthorizationDetailTypes{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				ProjectAuthorizationDetailTypes: []*ProjectAuthorizationDetailType{
+					{
+						ProjectID:     "project-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						Sequence:      20211111,
+						Type:          "detail-type",
+						DisplayName:   "detail-type-display-name",
+						Description:   "detail-type-description",
+						Schema:        `{"type": "object"}`,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareProjectAuthorizationDetailTypesQuery multiple result",
+			prepare: prepareProjectAuthorizationDetailTypesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareProjectAuthorizationDetailTypesStmt),
+					prepareProjectAuthorizationDetailTypesCols,
+					[][]driver.Value{
+						{
+							"project-id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211111),
+							"detail-type-1",
+							"detail-type-display-name-1",
+							"detail-type-description",
+							`{"type": "object"}`,
+						},
+						{
+							"project-id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211111),
+							"detail-type-2",
+							"detail-type-display-name-2",
+							"detail-type-description",
+							`{"type": "object"}`,
+						},
+					},
+				),
+			},
+			object: &ProjectAuthorizationDetailTypes{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				ProjectAuthorizationDetailTypes: []*ProjectAuthorizationDetailType{
+					{
+						ProjectID:     "project-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						Sequence:      20211111,
+						Type:          "detail-type-1",
+						DisplayName:   "detail-type-display-name-1",
+						Description:   "detail-type-description",
+						Schema:        `{"type": "object"}`,
+					},
+					{
+						ProjectID:     "project-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						Sequence:      20211111,
+						Type:          "detail-type-2",
+						DisplayName:   "detail-type-display-name-2",
+						Description:   "detail-type-description",
+						Schema:        `{"type": "object"}`,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareProjectAuthorizationDetailTypesQuery sql err",
+			prepare: prepareProjectAuthorizationDetailTypesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareProjectAuthorizationDetailTypesStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
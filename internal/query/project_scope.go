@@ -0,0 +1,225 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+var (
+	projectScopesTable = table{
+		name:          projection.ProjectScopeProjectionTable,
+		instanceIDCol: projection.ProjectScopeColumnInstanceID,
+	}
+	ProjectScopeColumnCreationDate = Column{
+		name:  projection.ProjectScopeColumnCreationDate,
+		table: projectScopesTable,
+	}
+	ProjectScopeColumnChangeDate = Column{
+		name:  projection.ProjectScopeColumnChangeDate,
+		table: projectScopesTable,
+	}
+	ProjectScopeColumnResourceOwner = Column{
+		name:  projection.ProjectScopeColumnResourceOwner,
+		table: projectScopesTable,
+	}
+	ProjectScopeColumnInstanceID = Column{
+		name:  projection.ProjectScopeColumnInstanceID,
+		table: projectScopesTable,
+	}
+	ProjectScopeColumnSequence = Column{
+		name:  projection.ProjectScopeColumnSequence,
+		table: projectScopesTable,
+	}
+	ProjectScopeColumnProjectID = Column{
+		name:  projection.ProjectScopeColumnProjectID,
+		table: projectScopesTable,
+	}
+	ProjectScopeColumnKey = Column{
+		name:  projection.ProjectScopeColumnKey,
+		table: projectScopesTable,
+	}
+	ProjectScopeColumnDisplayName = Column{
+		name:  projection.ProjectScopeColumnDisplayName,
+		table: projectScopesTable,
+	}
+	ProjectScopeColumnDescription = Column{
+		name:  projection.ProjectScopeColumnDescription,
+		table: projectScopesTable,
+	}
+	ProjectScopeColumnOwnerRemoved = Column{
+		name:  projection.ProjectScopeColumnOwnerRemoved,
+		table: projectScopesTable,
+	}
+)
+
+type ProjectScopes struct {
+	SearchResponse
+	ProjectScopes []*ProjectScope
+}
+
+type ProjectScope struct {
+	ProjectID     string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	Sequence      uint64
+
+	Key         string
+	DisplayName string
+	Description string
+}
+
+type ProjectScopeSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *Queries) SearchProjectScopes(ctx context.Context, shouldTriggerBulk bool, queries *ProjectScopeSearchQueries, withOwnerRemoved bool) (scopes *ProjectScopes, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		ctx = projection.ProjectScopeProjection.Trigger(ctx)
+	}
+
+	eq := sq.Eq{ProjectScopeColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
+	if !withOwnerRemoved {
+		eq[ProjectScopeColumnOwnerRemoved.identifier()] = false
+	}
+
+	query, scan := prepareProjectScopesQuery(ctx, q.client)
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Sc3ow", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Sc8lv", "Errors.Internal")
+	}
+	scopes, err = scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	scopes.LatestSequence, err = q.latestSequence(ctx, projectScopesTable)
+	return scopes, err
+}
+
+// ProjectScopeKeys returns the keys of all scopes currently defined on the project
+func (q *Queries) ProjectScopeKeys(ctx context.Context, shouldTriggerBulk bool, projectID string) (_ []string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	projectQuery, err := NewProjectScopeProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+	scopes, err := q.SearchProjectScopes(ctx, shouldTriggerBulk, &ProjectScopeSearchQueries{Queries: []SearchQuery{projectQuery}}, false)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, len(scopes.ProjectScopes))
+	for i, scope := range scopes.ProjectScopes {
+		keys[i] = scope.Key
+	}
+	return keys, nil
+}
+
+func NewProjectScopeProjectIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(ProjectScopeColumnProjectID, value, TextEquals)
+}
+
+func NewProjectScopeResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(ProjectScopeColumnResourceOwner, value, TextEquals)
+}
+
+func NewProjectScopeKeySearchQuery(method TextComparison, value string) (SearchQuery, error) {
+	return NewTextQuery(ProjectScopeColumnKey, value, method)
+}
+
+func NewProjectScopeDisplayNameSearchQuery(method TextComparison, value string) (SearchQuery, error) {
+	return NewTextQuery(ProjectScopeColumnDisplayName, value, method)
+}
+
+func (r *ProjectScopeSearchQueries) AppendProjectIDQuery(projectID string) error {
+	query, err := NewProjectScopeProjectIDSearchQuery(projectID)
+	if err != nil {
+		return err
+	}
+	r.Queries = append(r.Queries, query)
+	return nil
+}
+
+func (r *ProjectScopeSearchQueries) AppendMyResourceOwnerQuery(orgID string) error {
+	query, err := NewProjectScopeResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return err
+	}
+	r.Queries = append(r.Queries, query)
+	return nil
+}
+
+func (q *ProjectScopeSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func prepareProjectScopesQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*ProjectScopes, error)) {
+	return sq.Select(
+			ProjectScopeColumnProjectID.identifier(),
+			ProjectScopeColumnCreationDate.identifier(),
+			ProjectScopeColumnChangeDate.identifier(),
+			ProjectScopeColumnResourceOwner.identifier(),
+			ProjectScopeColumnSequence.identifier(),
+			ProjectScopeColumnKey.identifier(),
+			ProjectScopeColumnDisplayName.identifier(),
+			ProjectScopeColumnDescription.identifier(),
+			countColumn.identifier()).
+			From(projectScopesTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*ProjectScopes, error) {
+			scopes := make([]*ProjectScope, 0)
+			var count uint64
+			for rows.Next() {
+				scope := new(ProjectScope)
+				err := rows.Scan(
+					&scope.ProjectID,
+					&scope.CreationDate,
+					&scope.ChangeDate,
+					&scope.ResourceOwner,
+					&scope.Sequence,
+					&scope.Key,
+					&scope.DisplayName,
+					&scope.Description,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				scopes = append(scopes, scope)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Sc5uy", "Errors.Query.CloseRows")
+			}
+
+			return &ProjectScopes{
+				ProjectScopes: scopes,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
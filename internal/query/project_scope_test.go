@@ -0,0 +1,181 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+var (
+	prepareProjectScopesStmt = `SELECT projections.project_scopes.project_id,` +
+		` projections.project_scopes.creation_date,` +
+		` projections.project_scopes.change_date,` +
+		` projections.project_scopes.resource_owner,` +
+		` projections.project_scopes.sequence,` +
+		` projections.project_scopes.scope_key,` +
+		` projections.project_scopes.display_name,` +
+		` projections.project_scopes.description,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.project_scopes` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareProjectScopesCols = []string{
+		"project_id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"scope_key",
+		"display_name",
+		"description",
+		"count",
+	}
+)
+
+func Test_ProjectScopePrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareProjectScopesQuery no result",
+			prepare: prepareProjectScopesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareProjectScopesStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &ProjectScopes{ProjectScopes: []*ProjectScope{}},
+		},
+		{
+			name:    "prepareProjectScopesQuery one result",
+			prepare: prepareProjectScopesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareProjectScopesStmt),
+					prepareProjectScopesCols,
+					[][]driver.Value{
+						{
+							"project-id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211111),
+							"scope-key",
+							"scope-display-name",
+							"scope-description",
+						},
+					},
+				),
+			},
+			object: &ProjectScopes{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				ProjectScopes: []*ProjectScope{
+					{
+						ProjectID:     "project-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						Sequence:      20211111,
+						Key:           "scope-key",
+						DisplayName:   "scope-display-name",
+						Description:   "scope-description",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareProjectScopesQuery multiple result",
+			prepare: prepareProjectScopesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareProjectScopesStmt),
+					prepareProjectScopesCols,
+					[][]driver.Value{
+						{
+							"project-id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211111),
+							"scope-key-1",
+							"scope-display-name-1",
+							"scope-description",
+						},
+						{
+							"project-id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211111),
+							"scope-key-2",
+							"scope-display-name-2",
+							"scope-description",
+						},
+					},
+				),
+			},
+			object: &ProjectScopes{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				ProjectScopes: []*ProjectScope{
+					{
+						ProjectID:     "project-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						Sequence:      20211111,
+						Key:           "scope-key-1",
+						DisplayName:   "scope-display-name-1",
+						Description:   "scope-description",
+					},
+					{
+						ProjectID:     "project-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						Sequence:      20211111,
+						Key:           "scope-key-2",
+						DisplayName:   "scope-display-name-2",
+						Description:   "scope-description",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareProjectScopesQuery sql err",
+			prepare: prepareProjectScopesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareProjectScopesStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
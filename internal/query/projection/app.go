@@ -15,7 +15,7 @@ import (
 )
 
 const (
-	AppProjectionTable = "projections.apps5"
+	AppProjectionTable = "projections.apps8"
 	AppAPITable        = AppProjectionTable + "_" + appAPITableSuffix
 	AppOIDCTable       = AppProjectionTable + "_" + appOIDCTableSuffix
 	AppSAMLTable       = AppProjectionTable + "_" + appSAMLTableSuffix
@@ -58,6 +58,11 @@ const (
 	AppOIDCConfigColumnClockSkew                = "clock_skew"
 	AppOIDCConfigColumnAdditionalOrigins        = "additional_origins"
 	AppOIDCConfigColumnSkipNativeAppSuccessPage = "skip_native_app_success_page"
+	AppOIDCConfigColumnIDTokenSigningAlgorithm  = "id_token_signing_algorithm"
+	AppOIDCConfigColumnConsentRequired          = "consent_required"
+
+	AppOIDCConfigColumnBackchannelTokenDeliveryMode          = "backchannel_token_delivery_mode"
+	AppOIDCConfigColumnBackchannelClientNotificationEndpoint = "backchannel_client_notification_endpoint"
 
 	appSAMLTableSuffix             = "saml_configs"
 	AppSAMLConfigColumnAppID       = "app_id"
@@ -124,6 +129,10 @@ func newAppProjection(ctx context.Context, config crdb.StatementHandlerConfig) *
 			crdb.NewColumn(AppOIDCConfigColumnClockSkew, crdb.ColumnTypeInt64, crdb.Default(0)),
 			crdb.NewColumn(AppOIDCConfigColumnAdditionalOrigins, crdb.ColumnTypeTextArray, crdb.Nullable()),
 			crdb.NewColumn(AppOIDCConfigColumnSkipNativeAppSuccessPage, crdb.ColumnTypeBool, crdb.Default(false)),
+			crdb.NewColumn(AppOIDCConfigColumnIDTokenSigningAlgorithm, crdb.ColumnTypeText, crdb.Default("")),
+			crdb.NewColumn(AppOIDCConfigColumnConsentRequired, crdb.ColumnTypeBool, crdb.Default(false)),
+			crdb.NewColumn(AppOIDCConfigColumnBackchannelTokenDeliveryMode, crdb.ColumnTypeEnum, crdb.Default(0)),
+			crdb.NewColumn(AppOIDCConfigColumnBackchannelClientNotificationEndpoint, crdb.ColumnTypeText, crdb.Default("")),
 		},
 			crdb.NewPrimaryKey(AppOIDCConfigColumnInstanceID, AppOIDCConfigColumnAppID),
 			appOIDCTableSuffix,
@@ -466,6 +475,10 @@ func (p *appProjection) reduceOIDCConfigAdded(event eventstore.Event) (*handler.
 				handler.NewCol(AppOIDCConfigColumnClockSkew, e.ClockSkew),
 				handler.NewCol(AppOIDCConfigColumnAdditionalOrigins, database.StringArray(e.AdditionalOrigins)),
 				handler.NewCol(AppOIDCConfigColumnSkipNativeAppSuccessPage, e.SkipNativeAppSuccessPage),
+				handler.NewCol(AppOIDCConfigColumnIDTokenSigningAlgorithm, e.IDTokenSigningAlgorithm),
+				handler.NewCol(AppOIDCConfigColumnConsentRequired, e.ConsentRequired),
+				handler.NewCol(AppOIDCConfigColumnBackchannelTokenDeliveryMode, e.BackchannelTokenDeliveryMode),
+				handler.NewCol(AppOIDCConfigColumnBackchannelClientNotificationEndpoint, e.BackchannelClientNotificationEndpoint),
 			},
 			crdb.WithTableSuffix(appOIDCTableSuffix),
 		),
@@ -488,7 +501,7 @@ func (p *appProjection) reduceOIDCConfigChanged(event eventstore.Event) (*handle
 		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-GNHU1", "reduce.wrong.event.type %s", project.OIDCConfigChangedType)
 	}
 
-	cols := make([]handler.Column, 0, 15)
+	cols := make([]handler.Column, 0, 16)
 	if e.Version != nil {
 		cols = append(cols, handler.NewCol(AppOIDCConfigColumnVersion, *e.Version))
 	}
@@ -534,6 +547,18 @@ func (p *appProjection) reduceOIDCConfigChanged(event eventstore.Event) (*handle
 	if e.SkipNativeAppSuccessPage != nil {
 		cols = append(cols, handler.NewCol(AppOIDCConfigColumnSkipNativeAppSuccessPage, *e.SkipNativeAppSuccessPage))
 	}
+	if e.IDTokenSigningAlgorithm != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnIDTokenSigningAlgorithm, *e.IDTokenSigningAlgorithm))
+	}
+	if e.ConsentRequired != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnConsentRequired, *e.ConsentRequired))
+	}
+	if e.BackchannelTokenDeliveryMode != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnBackchannelTokenDeliveryMode, *e.BackchannelTokenDeliveryMode))
+	}
+	if e.BackchannelClientNotificationEndpoint != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnBackchannelClientNotificationEndpoint, *e.BackchannelClientNotificationEndpoint))
+	}
 
 	if len(cols) == 0 {
 		return crdb.NewNoOpStatement(e), nil
@@ -12,6 +12,16 @@ type Config struct {
 	BulkLimit             uint64
 	Customizations        map[string]CustomConfig
 	HandleActiveInstances time.Duration
+	FailedEventsThreshold uint
+	Readiness             ReadinessConfig
+}
+
+// ReadinessConfig defines when the projections are too far behind for ZITADEL to be ready
+type ReadinessConfig struct {
+	// LagBudget is the maximum lag of a projection, 0 disables the check
+	LagBudget time.Duration
+	// CriticalProjections are checked against the lag budget, all projections are checked if empty
+	CriticalProjections []string
 }
 
 type CustomConfig struct {
@@ -0,0 +1,154 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+func TestCustomRoleProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceCustomRoleAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.CustomRoleAddedEventType),
+					instance.AggregateType,
+					[]byte(`{"role": "ORG_HELPDESK", "displayName": "Helpdesk", "permissions": ["org.read", "org.member.read"]}`),
+				), instance.CustomRoleAddedEventMapper),
+			},
+			reduce: (&customRoleProjection{}).reduceCustomRoleAdded,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.custom_roles (instance_id, role, creation_date, change_date, sequence, resource_owner, display_name, permissions) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ORG_HELPDESK",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"Helpdesk",
+								database.StringArray{"org.read", "org.member.read"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceCustomRoleChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.CustomRoleChangedEventType),
+					instance.AggregateType,
+					[]byte(`{"role": "ORG_HELPDESK", "permissions": ["org.read"]}`),
+				), instance.CustomRoleChangedEventMapper),
+			},
+			reduce: (&customRoleProjection{}).reduceCustomRoleChanged,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.custom_roles SET (change_date, sequence, permissions) = ($1, $2, $3) WHERE (instance_id = $4) AND (role = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								database.StringArray{"org.read"},
+								"instance-id",
+								"ORG_HELPDESK",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceCustomRoleRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.CustomRoleRemovedEventType),
+					instance.AggregateType,
+					[]byte(`{"role": "ORG_HELPDESK"}`),
+				), instance.CustomRoleRemovedEventMapper),
+			},
+			reduce: (&customRoleProjection{}).reduceCustomRoleRemoved,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.custom_roles WHERE (instance_id = $1) AND (role = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ORG_HELPDESK",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceInstanceRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.InstanceRemovedEventType),
+					instance.AggregateType,
+					nil,
+				), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(CustomRoleColumnInstanceID),
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.custom_roles WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if _, ok := err.(errors.InvalidArgument); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, CustomRoleProjectionTable, tt.want)
+		})
+	}
+}
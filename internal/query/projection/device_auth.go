@@ -12,7 +12,7 @@ import (
 )
 
 const (
-	DeviceAuthProjectionTable = "projections.device_authorizations"
+	DeviceAuthProjectionTable = "projections.device_authorizations1"
 
 	DeviceAuthColumnID         = "id"
 	DeviceAuthColumnClientID   = "client_id"
@@ -23,6 +23,9 @@ const (
 	DeviceAuthColumnState      = "state"
 	DeviceAuthColumnSubject    = "subject"
 
+	DeviceAuthColumnUserID         = "user_id"
+	DeviceAuthColumnBindingMessage = "binding_message"
+
 	DeviceAuthColumnCreationDate = "creation_date"
 	DeviceAuthColumnChangeDate   = "change_date"
 	DeviceAuthColumnSequence     = "sequence"
@@ -47,6 +50,8 @@ func newDeviceAuthProjection(ctx context.Context, config crdb.StatementHandlerCo
 			crdb.NewColumn(DeviceAuthColumnScopes, crdb.ColumnTypeTextArray),
 			crdb.NewColumn(DeviceAuthColumnState, crdb.ColumnTypeEnum, crdb.Default(domain.DeviceAuthStateInitiated)),
 			crdb.NewColumn(DeviceAuthColumnSubject, crdb.ColumnTypeText, crdb.Default("")),
+			crdb.NewColumn(DeviceAuthColumnUserID, crdb.ColumnTypeText, crdb.Default("")),
+			crdb.NewColumn(DeviceAuthColumnBindingMessage, crdb.ColumnTypeText, crdb.Default("")),
 			crdb.NewColumn(DeviceAuthColumnCreationDate, crdb.ColumnTypeTimestamp),
 			crdb.NewColumn(DeviceAuthColumnChangeDate, crdb.ColumnTypeTimestamp),
 			crdb.NewColumn(DeviceAuthColumnSequence, crdb.ColumnTypeInt64),
@@ -93,21 +98,25 @@ func (p *deviceAuthProjection) reduceAdded(event eventstore.Event) (*handler.Sta
 	if !ok {
 		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-chu6O", "reduce.wrong.event.type %T != %s", event, deviceauth.AddedEventType)
 	}
-	return crdb.NewCreateStatement(
-		e,
-		[]handler.Column{
-			handler.NewCol(DeviceAuthColumnID, e.Aggregate().ID),
-			handler.NewCol(DeviceAuthColumnClientID, e.ClientID),
-			handler.NewCol(DeviceAuthColumnDeviceCode, e.DeviceCode),
-			handler.NewCol(DeviceAuthColumnUserCode, e.UserCode),
-			handler.NewCol(DeviceAuthColumnExpires, e.Expires),
-			handler.NewCol(DeviceAuthColumnScopes, e.Scopes),
-			handler.NewCol(DeviceAuthColumnCreationDate, e.CreationDate()),
-			handler.NewCol(DeviceAuthColumnChangeDate, e.CreationDate()),
-			handler.NewCol(DeviceAuthColumnSequence, e.Sequence()),
-			handler.NewCol(DeviceAuthColumnInstanceID, e.Aggregate().InstanceID),
-		},
-	), nil
+	cols := []handler.Column{
+		handler.NewCol(DeviceAuthColumnID, e.Aggregate().ID),
+		handler.NewCol(DeviceAuthColumnClientID, e.ClientID),
+		handler.NewCol(DeviceAuthColumnDeviceCode, e.DeviceCode),
+		handler.NewCol(DeviceAuthColumnUserCode, e.UserCode),
+		handler.NewCol(DeviceAuthColumnExpires, e.Expires),
+		handler.NewCol(DeviceAuthColumnScopes, e.Scopes),
+		handler.NewCol(DeviceAuthColumnCreationDate, e.CreationDate()),
+		handler.NewCol(DeviceAuthColumnChangeDate, e.CreationDate()),
+		handler.NewCol(DeviceAuthColumnSequence, e.Sequence()),
+		handler.NewCol(DeviceAuthColumnInstanceID, e.Aggregate().InstanceID),
+	}
+	if e.Backchannel != nil {
+		cols = append(cols,
+			handler.NewCol(DeviceAuthColumnUserID, e.Backchannel.UserID),
+			handler.NewCol(DeviceAuthColumnBindingMessage, e.Backchannel.BindingMessage),
+		)
+	}
+	return crdb.NewCreateStatement(e, cols), nil
 }
 
 func (p *deviceAuthProjection) reduceAppoved(event eventstore.Event) (*handler.Statement, error) {
@@ -0,0 +1,66 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/telemetry/metrics"
+)
+
+type failedEventsProjection interface {
+	projection
+	Name() string
+	RetryFailedEvent(ctx context.Context, instanceID string, sequence uint64) error
+	RetryFailedEvents(ctx context.Context) (failed int, err error)
+	SkipFailedEvent(ctx context.Context, instanceID string, sequence uint64, reason string) error
+}
+
+func failedEventsHandler(projectionName string) (failedEventsProjection, error) {
+	for _, p := range projections {
+		f, ok := p.(failedEventsProjection)
+		if ok && f.Name() == projectionName {
+			return f, nil
+		}
+	}
+	return nil, errors.ThrowNotFound(nil, "PROJE-Mw8sk", "Errors.ProjectionName.Invalid")
+}
+
+// RetryFailedEvent reduces the skipped failed event again
+// together with the later events of its aggregate the projection already processed
+func RetryFailedEvent(ctx context.Context, projectionName, instanceID string, sequence uint64) error {
+	p, err := failedEventsHandler(projectionName)
+	if err != nil {
+		return err
+	}
+	return p.RetryFailedEvent(ctx, instanceID, sequence)
+}
+
+// RetryFailedEvents reduces all skipped failed events of the projection again
+// and returns the amount of events which failed again
+func RetryFailedEvents(ctx context.Context, projectionName string) (failed int, err error) {
+	p, err := failedEventsHandler(projectionName)
+	if err != nil {
+		return 0, err
+	}
+	return p.RetryFailedEvents(ctx)
+}
+
+// SkipFailedEvent lets the projection continue with the next event
+// and stores the reason why the failed event was skipped
+func SkipFailedEvent(ctx context.Context, projectionName, instanceID string, sequence uint64, reason string) error {
+	p, err := failedEventsHandler(projectionName)
+	if err != nil {
+		return err
+	}
+	return p.SkipFailedEvent(ctx, instanceID, sequence, reason)
+}
+
+func registerFailedEventsMetrics() {
+	err := metrics.RegisterCounter(crdb.FailedEventsCounter, crdb.FailedEventsCounterDescription)
+	logging.WithFields("metric", crdb.FailedEventsCounter).OnError(err).Panic("unable to register counter")
+	err = metrics.RegisterCounter(crdb.FailedEventsThresholdExceeded, crdb.FailedEventsThresholdExceededDescription)
+	logging.WithFields("metric", crdb.FailedEventsThresholdExceeded).OnError(err).Panic("unable to register counter")
+}
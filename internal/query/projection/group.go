@@ -0,0 +1,178 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+const (
+	GroupProjectionTable = "projections.groups"
+
+	GroupColumnID            = "id"
+	GroupColumnCreationDate  = "creation_date"
+	GroupColumnChangeDate    = "change_date"
+	GroupColumnResourceOwner = "resource_owner"
+	GroupColumnInstanceID    = "instance_id"
+	GroupColumnState         = "state"
+	GroupColumnSequence      = "sequence"
+	GroupColumnName          = "name"
+	GroupColumnDescription   = "description"
+	GroupColumnOwnerRemoved  = "owner_removed"
+)
+
+type groupProjection struct {
+	crdb.StatementHandler
+}
+
+func newGroupProjection(ctx context.Context, config crdb.StatementHandlerConfig) *groupProjection {
+	p := new(groupProjection)
+	config.ProjectionName = GroupProjectionTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(GroupColumnID, crdb.ColumnTypeText),
+			crdb.NewColumn(GroupColumnCreationDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(GroupColumnChangeDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(GroupColumnResourceOwner, crdb.ColumnTypeText),
+			crdb.NewColumn(GroupColumnInstanceID, crdb.ColumnTypeText),
+			crdb.NewColumn(GroupColumnState, crdb.ColumnTypeEnum),
+			crdb.NewColumn(GroupColumnSequence, crdb.ColumnTypeInt64),
+			crdb.NewColumn(GroupColumnName, crdb.ColumnTypeText),
+			crdb.NewColumn(GroupColumnDescription, crdb.ColumnTypeText, crdb.Default("")),
+			crdb.NewColumn(GroupColumnOwnerRemoved, crdb.ColumnTypeBool, crdb.Default(false)),
+		},
+			crdb.NewPrimaryKey(GroupColumnInstanceID, GroupColumnID),
+			crdb.WithIndex(crdb.NewIndex("resource_owner", []string{GroupColumnResourceOwner})),
+			crdb.WithIndex(crdb.NewIndex("owner_removed", []string{GroupColumnOwnerRemoved})),
+		),
+	)
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *groupProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: group.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  group.AddedEventType,
+					Reduce: p.reduceGroupAdded,
+				},
+				{
+					Event:  group.ChangedEventType,
+					Reduce: p.reduceGroupChanged,
+				},
+				{
+					Event:  group.RemovedEventType,
+					Reduce: p.reduceGroupRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(GroupColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *groupProjection) reduceGroupAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*group.AddedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gp2nd", "reduce.wrong.event.type %s", group.AddedEventType)
+	}
+	return crdb.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(GroupColumnID, e.Aggregate().ID),
+			handler.NewCol(GroupColumnCreationDate, e.CreationDate()),
+			handler.NewCol(GroupColumnChangeDate, e.CreationDate()),
+			handler.NewCol(GroupColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(GroupColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(GroupColumnSequence, e.Sequence()),
+			handler.NewCol(GroupColumnName, e.Name),
+			handler.NewCol(GroupColumnDescription, e.Description),
+			handler.NewCol(GroupColumnState, domain.GroupStateActive),
+		},
+	), nil
+}
+
+func (p *groupProjection) reduceGroupChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*group.ChangedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gp8ws", "reduce.wrong.event.type %s", group.ChangedEventType)
+	}
+	values := []handler.Column{
+		handler.NewCol(GroupColumnChangeDate, e.CreationDate()),
+		handler.NewCol(GroupColumnSequence, e.Sequence()),
+	}
+	if e.Name != nil {
+		values = append(values, handler.NewCol(GroupColumnName, *e.Name))
+	}
+	if e.Description != nil {
+		values = append(values, handler.NewCol(GroupColumnDescription, *e.Description))
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		values,
+		[]handler.Condition{
+			handler.NewCond(GroupColumnID, e.Aggregate().ID),
+			handler.NewCond(GroupColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *groupProjection) reduceGroupRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*group.RemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gp5kr", "reduce.wrong.event.type %s", group.RemovedEventType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupColumnID, e.Aggregate().ID),
+			handler.NewCond(GroupColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *groupProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gp1oq", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(GroupColumnChangeDate, e.CreationDate()),
+			handler.NewCol(GroupColumnSequence, e.Sequence()),
+			handler.NewCol(GroupColumnOwnerRemoved, true),
+		},
+		[]handler.Condition{
+			handler.NewCond(GroupColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(GroupColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,290 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+const (
+	GroupGrantProjectionTable = "projections.group_grants"
+
+	GroupGrantColumnGroupID       = "group_id"
+	GroupGrantColumnProjectID     = "project_id"
+	GroupGrantColumnGrantID       = "grant_id"
+	GroupGrantColumnRoles         = "roles"
+	GroupGrantColumnCreationDate  = "creation_date"
+	GroupGrantColumnChangeDate    = "change_date"
+	GroupGrantColumnSequence      = "sequence"
+	GroupGrantColumnResourceOwner = "resource_owner"
+	GroupGrantColumnInstanceID    = "instance_id"
+	GroupGrantColumnOwnerRemoved  = "owner_removed"
+)
+
+type groupGrantProjection struct {
+	crdb.StatementHandler
+}
+
+func newGroupGrantProjection(ctx context.Context, config crdb.StatementHandlerConfig) *groupGrantProjection {
+	p := new(groupGrantProjection)
+	config.ProjectionName = GroupGrantProjectionTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(GroupGrantColumnGroupID, crdb.ColumnTypeText),
+			crdb.NewColumn(GroupGrantColumnProjectID, crdb.ColumnTypeText),
+			crdb.NewColumn(GroupGrantColumnGrantID, crdb.ColumnTypeText, crdb.Default("")),
+			crdb.NewColumn(GroupGrantColumnRoles, crdb.ColumnTypeTextArray, crdb.Nullable()),
+			crdb.NewColumn(GroupGrantColumnCreationDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(GroupGrantColumnChangeDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(GroupGrantColumnSequence, crdb.ColumnTypeInt64),
+			crdb.NewColumn(GroupGrantColumnResourceOwner, crdb.ColumnTypeText),
+			crdb.NewColumn(GroupGrantColumnInstanceID, crdb.ColumnTypeText),
+			crdb.NewColumn(GroupGrantColumnOwnerRemoved, crdb.ColumnTypeBool, crdb.Default(false)),
+		},
+			crdb.NewPrimaryKey(GroupGrantColumnInstanceID, GroupGrantColumnGroupID, GroupGrantColumnProjectID, GroupGrantColumnGrantID),
+			crdb.WithIndex(crdb.NewIndex("project_id", []string{GroupGrantColumnProjectID})),
+			crdb.WithIndex(crdb.NewIndex("owner_removed", []string{GroupGrantColumnOwnerRemoved})),
+		),
+	)
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *groupGrantProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: group.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  group.GrantAddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  group.GrantChangedEventType,
+					Reduce: p.reduceChanged,
+				},
+				{
+					Event:  group.GrantRemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  group.RemovedEventType,
+					Reduce: p.reduceGroupRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: project.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  project.ProjectRemovedType,
+					Reduce: p.reduceProjectRemoved,
+				},
+				{
+					Event:  project.GrantRemovedType,
+					Reduce: p.reduceProjectGrantRemoved,
+				},
+				{
+					Event:  project.RoleRemovedType,
+					Reduce: p.reduceRoleRemoved,
+				},
+				{
+					Event:  project.GrantChangedType,
+					Reduce: p.reduceProjectGrantChanged,
+				},
+				{
+					Event:  project.GrantCascadeChangedType,
+					Reduce: p.reduceProjectGrantChanged,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(GroupGrantColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *groupGrantProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*group.GrantAddedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gg5ty", "reduce.wrong.event.type %s", group.GrantAddedEventType)
+	}
+	return crdb.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(GroupGrantColumnGroupID, e.Aggregate().ID),
+			handler.NewCol(GroupGrantColumnProjectID, e.ProjectID),
+			handler.NewCol(GroupGrantColumnGrantID, e.ProjectGrantID),
+			handler.NewCol(GroupGrantColumnRoles, database.StringArray(e.RoleKeys)),
+			handler.NewCol(GroupGrantColumnCreationDate, e.CreationDate()),
+			handler.NewCol(GroupGrantColumnChangeDate, e.CreationDate()),
+			handler.NewCol(GroupGrantColumnSequence, e.Sequence()),
+			handler.NewCol(GroupGrantColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(GroupGrantColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *groupGrantProjection) reduceChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*group.GrantChangedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gg1bw", "reduce.wrong.event.type %s", group.GrantChangedEventType)
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(GroupGrantColumnRoles, database.StringArray(e.RoleKeys)),
+			handler.NewCol(GroupGrantColumnChangeDate, e.CreationDate()),
+			handler.NewCol(GroupGrantColumnSequence, e.Sequence()),
+		},
+		[]handler.Condition{
+			handler.NewCond(GroupGrantColumnGroupID, e.Aggregate().ID),
+			handler.NewCond(GroupGrantColumnProjectID, e.ProjectID),
+			handler.NewCond(GroupGrantColumnGrantID, e.ProjectGrantID),
+			handler.NewCond(GroupGrantColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *groupGrantProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*group.GrantRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gg8ni", "reduce.wrong.event.type %s", group.GrantRemovedEventType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupGrantColumnGroupID, e.Aggregate().ID),
+			handler.NewCond(GroupGrantColumnProjectID, e.ProjectID),
+			handler.NewCond(GroupGrantColumnGrantID, e.ProjectGrantID),
+			handler.NewCond(GroupGrantColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *groupGrantProjection) reduceGroupRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*group.RemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gg4ud", "reduce.wrong.event.type %s", group.RemovedEventType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupGrantColumnGroupID, e.Aggregate().ID),
+			handler.NewCond(GroupGrantColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *groupGrantProjection) reduceProjectRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*project.ProjectRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gg6fe", "reduce.wrong.event.type %s", project.ProjectRemovedType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupGrantColumnProjectID, e.Aggregate().ID),
+			handler.NewCond(GroupGrantColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *groupGrantProjection) reduceProjectGrantRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*project.GrantRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gg3jw", "reduce.wrong.event.type %s", project.GrantRemovedType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupGrantColumnGrantID, e.GrantID),
+			handler.NewCond(GroupGrantColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *groupGrantProjection) reduceRoleRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*project.RoleRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gg9ap", "reduce.wrong.event.type %s", project.RoleRemovedType)
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			crdb.NewArrayRemoveCol(GroupGrantColumnRoles, e.Key),
+		},
+		[]handler.Condition{
+			handler.NewCond(GroupGrantColumnProjectID, e.Aggregate().ID),
+			handler.NewCond(GroupGrantColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *groupGrantProjection) reduceProjectGrantChanged(event eventstore.Event) (*handler.Statement, error) {
+	var grantID string
+	var keys []string
+	switch e := event.(type) {
+	case *project.GrantChangedEvent:
+		grantID = e.GrantID
+		keys = e.RoleKeys
+	case *project.GrantCascadeChangedEvent:
+		grantID = e.GrantID
+		keys = e.RoleKeys
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gg2ls", "reduce.wrong.event.type %v", []eventstore.EventType{project.GrantChangedType, project.GrantCascadeChangedType})
+	}
+	return crdb.NewUpdateStatement(
+		event,
+		[]handler.Column{
+			crdb.NewArrayIntersectCol(GroupGrantColumnRoles, database.StringArray(keys)),
+		},
+		[]handler.Condition{
+			handler.NewCond(GroupGrantColumnGrantID, grantID),
+			handler.NewCond(GroupGrantColumnInstanceID, event.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *groupGrantProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gg7rc", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(GroupGrantColumnChangeDate, e.CreationDate()),
+			handler.NewCol(GroupGrantColumnSequence, e.Sequence()),
+			handler.NewCol(GroupGrantColumnOwnerRemoved, true),
+		},
+		[]handler.Condition{
+			handler.NewCond(GroupGrantColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(GroupGrantColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,189 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+func TestGroupGrantProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(group.GrantAddedEventType),
+					group.AggregateType,
+					[]byte(`{"projectId": "project-id", "projectGrantId": "grant-id", "roleKeys": ["role"]}`),
+				), group.GrantAddedEventMapper),
+			},
+			reduce: (&groupGrantProjection{}).reduceAdded,
+			want: wantReduce{
+				aggregateType:    group.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.group_grants (group_id, project_id, grant_id, roles, creation_date, change_date, sequence, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"project-id",
+								"grant-id",
+								database.StringArray{"role"},
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(group.GrantChangedEventType),
+					group.AggregateType,
+					[]byte(`{"projectId": "project-id", "roleKeys": ["role", "role2"]}`),
+				), group.GrantChangedEventMapper),
+			},
+			reduce: (&groupGrantProjection{}).reduceChanged,
+			want: wantReduce{
+				aggregateType:    group.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.group_grants SET (roles, change_date, sequence) = ($1, $2, $3) WHERE (group_id = $4) AND (project_id = $5) AND (grant_id = $6) AND (instance_id = $7)",
+							expectedArgs: []interface{}{
+								database.StringArray{"role", "role2"},
+								anyArg{},
+								uint64(15),
+								"agg-id",
+								"project-id",
+								"",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(group.GrantRemovedEventType),
+					group.AggregateType,
+					[]byte(`{"projectId": "project-id"}`),
+				), group.GrantRemovedEventMapper),
+			},
+			reduce: (&groupGrantProjection{}).reduceRemoved,
+			want: wantReduce{
+				aggregateType:    group.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.group_grants WHERE (group_id = $1) AND (project_id = $2) AND (grant_id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"project-id",
+								"",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRoleRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(project.RoleRemovedType),
+					project.AggregateType,
+					[]byte(`{"key": "key"}`),
+				), project.RoleRemovedEventMapper),
+			},
+			reduce: (&groupGrantProjection{}).reduceRoleRemoved,
+			want: wantReduce{
+				aggregateType:    project.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.group_grants SET roles = array_remove(roles, $1) WHERE (project_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"key",
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceProjectGrantRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(project.GrantRemovedType),
+					project.AggregateType,
+					[]byte(`{"grantId": "grant-id"}`),
+				), project.GrantRemovedEventMapper),
+			},
+			reduce: (&groupGrantProjection{}).reduceProjectGrantRemoved,
+			want: wantReduce{
+				aggregateType:    project.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.group_grants WHERE (grant_id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"grant-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if _, ok := err.(errors.InvalidArgument); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, GroupGrantProjectionTable, tt.want)
+		})
+	}
+}
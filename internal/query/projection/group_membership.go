@@ -0,0 +1,212 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+const (
+	GroupMembershipProjectionTable = "projections.group_memberships"
+
+	GroupMembershipColumnGroupID       = "group_id"
+	GroupMembershipColumnProjectID     = "project_id"
+	GroupMembershipColumnRoles         = "roles"
+	GroupMembershipColumnCreationDate  = "creation_date"
+	GroupMembershipColumnChangeDate    = "change_date"
+	GroupMembershipColumnSequence      = "sequence"
+	GroupMembershipColumnResourceOwner = "resource_owner"
+	GroupMembershipColumnInstanceID    = "instance_id"
+	GroupMembershipColumnOwnerRemoved  = "owner_removed"
+)
+
+type groupMembershipProjection struct {
+	crdb.StatementHandler
+}
+
+func newGroupMembershipProjection(ctx context.Context, config crdb.StatementHandlerConfig) *groupMembershipProjection {
+	p := new(groupMembershipProjection)
+	config.ProjectionName = GroupMembershipProjectionTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(GroupMembershipColumnGroupID, crdb.ColumnTypeText),
+			crdb.NewColumn(GroupMembershipColumnProjectID, crdb.ColumnTypeText, crdb.Default("")),
+			crdb.NewColumn(GroupMembershipColumnRoles, crdb.ColumnTypeTextArray, crdb.Nullable()),
+			crdb.NewColumn(GroupMembershipColumnCreationDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(GroupMembershipColumnChangeDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(GroupMembershipColumnSequence, crdb.ColumnTypeInt64),
+			crdb.NewColumn(GroupMembershipColumnResourceOwner, crdb.ColumnTypeText),
+			crdb.NewColumn(GroupMembershipColumnInstanceID, crdb.ColumnTypeText),
+			crdb.NewColumn(GroupMembershipColumnOwnerRemoved, crdb.ColumnTypeBool, crdb.Default(false)),
+		},
+			crdb.NewPrimaryKey(GroupMembershipColumnInstanceID, GroupMembershipColumnGroupID, GroupMembershipColumnProjectID),
+			crdb.WithIndex(crdb.NewIndex("owner_removed", []string{GroupMembershipColumnOwnerRemoved})),
+		),
+	)
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *groupMembershipProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: group.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  group.MembershipAddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  group.MembershipChangedEventType,
+					Reduce: p.reduceChanged,
+				},
+				{
+					Event:  group.MembershipRemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  group.RemovedEventType,
+					Reduce: p.reduceGroupRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: project.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  project.ProjectRemovedType,
+					Reduce: p.reduceProjectRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(GroupMembershipColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *groupMembershipProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*group.MembershipAddedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gm3wr", "reduce.wrong.event.type %s", group.MembershipAddedEventType)
+	}
+	return crdb.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(GroupMembershipColumnGroupID, e.Aggregate().ID),
+			handler.NewCol(GroupMembershipColumnProjectID, e.ProjectID),
+			handler.NewCol(GroupMembershipColumnRoles, database.StringArray(e.Roles)),
+			handler.NewCol(GroupMembershipColumnCreationDate, e.CreationDate()),
+			handler.NewCol(GroupMembershipColumnChangeDate, e.CreationDate()),
+			handler.NewCol(GroupMembershipColumnSequence, e.Sequence()),
+			handler.NewCol(GroupMembershipColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(GroupMembershipColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *groupMembershipProjection) reduceChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*group.MembershipChangedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gm8ko", "reduce.wrong.event.type %s", group.MembershipChangedEventType)
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(GroupMembershipColumnRoles, database.StringArray(e.Roles)),
+			handler.NewCol(GroupMembershipColumnChangeDate, e.CreationDate()),
+			handler.NewCol(GroupMembershipColumnSequence, e.Sequence()),
+		},
+		[]handler.Condition{
+			handler.NewCond(GroupMembershipColumnGroupID, e.Aggregate().ID),
+			handler.NewCond(GroupMembershipColumnProjectID, e.ProjectID),
+			handler.NewCond(GroupMembershipColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *groupMembershipProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*group.MembershipRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gm5ya", "reduce.wrong.event.type %s", group.MembershipRemovedEventType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupMembershipColumnGroupID, e.Aggregate().ID),
+			handler.NewCond(GroupMembershipColumnProjectID, e.ProjectID),
+			handler.NewCond(GroupMembershipColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *groupMembershipProjection) reduceGroupRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*group.RemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gm0bt", "reduce.wrong.event.type %s", group.RemovedEventType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupMembershipColumnGroupID, e.Aggregate().ID),
+			handler.NewCond(GroupMembershipColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *groupMembershipProjection) reduceProjectRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*project.ProjectRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gm6hd", "reduce.wrong.event.type %s", project.ProjectRemovedType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupMembershipColumnProjectID, e.Aggregate().ID),
+			handler.NewCond(GroupMembershipColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *groupMembershipProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gm2vq", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(GroupMembershipColumnChangeDate, e.CreationDate()),
+			handler.NewCol(GroupMembershipColumnSequence, e.Sequence()),
+			handler.NewCol(GroupMembershipColumnOwnerRemoved, true),
+		},
+		[]handler.Condition{
+			handler.NewCond(GroupMembershipColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(GroupMembershipColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
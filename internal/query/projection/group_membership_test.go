@@ -0,0 +1,158 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+func TestGroupMembershipProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(group.MembershipAddedEventType),
+					group.AggregateType,
+					[]byte(`{"projectId": "project-id", "roles": ["PROJECT_OWNER"]}`),
+				), group.MembershipAddedEventMapper),
+			},
+			reduce: (&groupMembershipProjection{}).reduceAdded,
+			want: wantReduce{
+				aggregateType:    group.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.group_memberships (group_id, project_id, roles, creation_date, change_date, sequence, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"project-id",
+								database.StringArray{"PROJECT_OWNER"},
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(group.MembershipChangedEventType),
+					group.AggregateType,
+					[]byte(`{"roles": ["ORG_OWNER"]}`),
+				), group.MembershipChangedEventMapper),
+			},
+			reduce: (&groupMembershipProjection{}).reduceChanged,
+			want: wantReduce{
+				aggregateType:    group.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.group_memberships SET (roles, change_date, sequence) = ($1, $2, $3) WHERE (group_id = $4) AND (project_id = $5) AND (instance_id = $6)",
+							expectedArgs: []interface{}{
+								database.StringArray{"ORG_OWNER"},
+								anyArg{},
+								uint64(15),
+								"agg-id",
+								"",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(group.MembershipRemovedEventType),
+					group.AggregateType,
+					[]byte(`{"projectId": "project-id"}`),
+				), group.MembershipRemovedEventMapper),
+			},
+			reduce: (&groupMembershipProjection{}).reduceRemoved,
+			want: wantReduce{
+				aggregateType:    group.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.group_memberships WHERE (group_id = $1) AND (project_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"project-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceProjectRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(project.ProjectRemovedType),
+					project.AggregateType,
+					nil,
+				), project.ProjectRemovedEventMapper),
+			},
+			reduce: (&groupMembershipProjection{}).reduceProjectRemoved,
+			want: wantReduce{
+				aggregateType:    project.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.group_memberships WHERE (project_id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if _, ok := err.(errors.InvalidArgument); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, GroupMembershipProjectionTable, tt.want)
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+const (
+	GroupUserProjectionTable = "projections.group_users"
+
+	GroupUserColumnGroupID       = "group_id"
+	GroupUserColumnUserID        = "user_id"
+	GroupUserColumnCreationDate  = "creation_date"
+	GroupUserColumnChangeDate    = "change_date"
+	GroupUserColumnSequence      = "sequence"
+	GroupUserColumnResourceOwner = "resource_owner"
+	GroupUserColumnInstanceID    = "instance_id"
+	GroupUserColumnOwnerRemoved  = "owner_removed"
+)
+
+type groupUserProjection struct {
+	crdb.StatementHandler
+}
+
+func newGroupUserProjection(ctx context.Context, config crdb.StatementHandlerConfig) *groupUserProjection {
+	p := new(groupUserProjection)
+	config.ProjectionName = GroupUserProjectionTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(GroupUserColumnGroupID, crdb.ColumnTypeText),
+			crdb.NewColumn(GroupUserColumnUserID, crdb.ColumnTypeText),
+			crdb.NewColumn(GroupUserColumnCreationDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(GroupUserColumnChangeDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(GroupUserColumnSequence, crdb.ColumnTypeInt64),
+			crdb.NewColumn(GroupUserColumnResourceOwner, crdb.ColumnTypeText),
+			crdb.NewColumn(GroupUserColumnInstanceID, crdb.ColumnTypeText),
+			crdb.NewColumn(GroupUserColumnOwnerRemoved, crdb.ColumnTypeBool, crdb.Default(false)),
+		},
+			crdb.NewPrimaryKey(GroupUserColumnInstanceID, GroupUserColumnGroupID, GroupUserColumnUserID),
+			crdb.WithIndex(crdb.NewIndex("user_id", []string{GroupUserColumnUserID})),
+			crdb.WithIndex(crdb.NewIndex("owner_removed", []string{GroupUserColumnOwnerRemoved})),
+		),
+	)
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *groupUserProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: group.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  group.UserAddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  group.UserRemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  group.RemovedEventType,
+					Reduce: p.reduceGroupRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(GroupUserColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *groupUserProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*group.UserAddedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gu4pz", "reduce.wrong.event.type %s", group.UserAddedEventType)
+	}
+	return crdb.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(GroupUserColumnGroupID, e.Aggregate().ID),
+			handler.NewCol(GroupUserColumnUserID, e.UserID),
+			handler.NewCol(GroupUserColumnCreationDate, e.CreationDate()),
+			handler.NewCol(GroupUserColumnChangeDate, e.CreationDate()),
+			handler.NewCol(GroupUserColumnSequence, e.Sequence()),
+			handler.NewCol(GroupUserColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(GroupUserColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *groupUserProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*group.UserRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gu9ol", "reduce.wrong.event.type %s", group.UserRemovedEventType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupUserColumnGroupID, e.Aggregate().ID),
+			handler.NewCond(GroupUserColumnUserID, e.UserID),
+			handler.NewCond(GroupUserColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *groupUserProjection) reduceGroupRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*group.RemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gu2cx", "reduce.wrong.event.type %s", group.RemovedEventType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupUserColumnGroupID, e.Aggregate().ID),
+			handler.NewCond(GroupUserColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *groupUserProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gu7ma", "reduce.wrong.event.type %s", user.UserRemovedType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupUserColumnUserID, e.Aggregate().ID),
+			handler.NewCond(GroupUserColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *groupUserProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gu3qe", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(GroupUserColumnChangeDate, e.CreationDate()),
+			handler.NewCol(GroupUserColumnSequence, e.Sequence()),
+			handler.NewCol(GroupUserColumnOwnerRemoved, true),
+		},
+		[]handler.Condition{
+			handler.NewCond(GroupUserColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(GroupUserColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/handler"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/repository/idp"
 	"github.com/zitadel/zitadel/internal/repository/idpconfig"
 	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/repository/org"
@@ -149,6 +150,10 @@ func (p *idpProjection) reducers() []handler.AggregateReducer {
 					Event:  instance.IDPOIDCConfigChangedEventType,
 					Reduce: p.reduceOIDCConfigChanged,
 				},
+				{
+					Event:  instance.IDPSecretReencryptedEventType,
+					Reduce: p.reduceOIDCConfigSecretReencrypted,
+				},
 				{
 					Event:  instance.IDPJWTConfigAddedEventType,
 					Reduce: p.reduceJWTConfigAdded,
@@ -194,6 +199,10 @@ func (p *idpProjection) reducers() []handler.AggregateReducer {
 					Event:  org.IDPOIDCConfigChangedEventType,
 					Reduce: p.reduceOIDCConfigChanged,
 				},
+				{
+					Event:  org.IDPSecretReencryptedEventType,
+					Reduce: p.reduceOIDCConfigSecretReencrypted,
+				},
 				{
 					Event:  org.IDPJWTConfigAddedEventType,
 					Reduce: p.reduceJWTConfigAdded,
@@ -458,6 +467,34 @@ func (p *idpProjection) reduceOIDCConfigChanged(event eventstore.Event) (*handle
 	), nil
 }
 
+func (p *idpProjection) reduceOIDCConfigSecretReencrypted(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.SecretReencryptedEvent
+	switch e := event.(type) {
+	case *org.IDPSecretReencryptedEvent:
+		idpEvent = e.SecretReencryptedEvent
+	case *instance.IDPSecretReencryptedEvent:
+		idpEvent = e.SecretReencryptedEvent
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Re6nc", "reduce.wrong.event.type %v", []eventstore.EventType{org.IDPSecretReencryptedEventType, instance.IDPSecretReencryptedEventType})
+	}
+	// only (old) OIDC configurations are projected with their secret
+	if idpEvent.IDPType != domain.IDPTypeOIDC {
+		return crdb.NewNoOpStatement(&idpEvent), nil
+	}
+
+	return crdb.NewUpdateStatement(
+		&idpEvent,
+		[]handler.Column{
+			handler.NewCol(OIDCConfigClientSecretCol, idpEvent.Secret),
+		},
+		[]handler.Condition{
+			handler.NewCond(OIDCConfigIDPIDCol, idpEvent.ID),
+			handler.NewCond(OIDCConfigInstanceIDCol, idpEvent.Aggregate().InstanceID),
+		},
+		crdb.WithTableSuffix(IDPOIDCSuffix),
+	), nil
+}
+
 func (p *idpProjection) reduceJWTConfigAdded(event eventstore.Event) (*handler.Statement, error) {
 	var idpEvent idpconfig.JWTConfigAddedEvent
 	switch e := event.(type) {
@@ -451,6 +451,10 @@ func (p *idpTemplateProjection) reducers() []handler.AggregateReducer {
 					Event:  instance.IDPRemovedEventType,
 					Reduce: p.reduceIDPRemoved,
 				},
+				{
+					Event:  instance.IDPSecretReencryptedEventType,
+					Reduce: p.reduceIDPSecretReencrypted,
+				},
 				{
 					Event:  instance.InstanceRemovedEventType,
 					Reduce: reduceInstanceRemovedHelper(IDPTemplateInstanceIDCol),
@@ -580,6 +584,10 @@ func (p *idpTemplateProjection) reducers() []handler.AggregateReducer {
 					Event:  org.IDPRemovedEventType,
 					Reduce: p.reduceIDPRemoved,
 				},
+				{
+					Event:  org.IDPSecretReencryptedEventType,
+					Reduce: p.reduceIDPSecretReencrypted,
+				},
 				{
 					Event:  org.OrgRemovedEventType,
 					Reduce: p.reduceOwnerRemoved,
@@ -1898,6 +1906,55 @@ func (p *idpTemplateProjection) reduceIDPRemoved(event eventstore.Event) (*handl
 	), nil
 }
 
+func (p *idpTemplateProjection) reduceIDPSecretReencrypted(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.SecretReencryptedEvent
+	switch e := event.(type) {
+	case *org.IDPSecretReencryptedEvent:
+		idpEvent = e.SecretReencryptedEvent
+	case *instance.IDPSecretReencryptedEvent:
+		idpEvent = e.SecretReencryptedEvent
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Re5nc", "reduce.wrong.event.type %v", []eventstore.EventType{org.IDPSecretReencryptedEventType, instance.IDPSecretReencryptedEventType})
+	}
+
+	var suffix, column string
+	switch idpEvent.IDPType {
+	case domain.IDPTypeOAuth:
+		suffix, column = IDPTemplateOAuthSuffix, OAuthClientSecretCol
+	case domain.IDPTypeOIDC:
+		suffix, column = IDPTemplateOIDCSuffix, OIDCClientSecretCol
+	case domain.IDPTypeAzureAD:
+		suffix, column = IDPTemplateAzureADSuffix, AzureADClientSecretCol
+	case domain.IDPTypeGitHub:
+		suffix, column = IDPTemplateGitHubSuffix, GitHubClientSecretCol
+	case domain.IDPTypeGitHubEnterprise:
+		suffix, column = IDPTemplateGitHubEnterpriseSuffix, GitHubEnterpriseClientSecretCol
+	case domain.IDPTypeGitLab:
+		suffix, column = IDPTemplateGitLabSuffix, GitLabClientSecretCol
+	case domain.IDPTypeGitLabSelfHosted:
+		suffix, column = IDPTemplateGitLabSelfHostedSuffix, GitLabSelfHostedClientSecretCol
+	case domain.IDPTypeGoogle:
+		suffix, column = IDPTemplateGoogleSuffix, GoogleClientSecretCol
+	case domain.IDPTypeLDAP:
+		suffix, column = IDPTemplateLDAPSuffix, LDAPBindPasswordCol
+	case domain.IDPTypeUnspecified, domain.IDPTypeJWT:
+		return crdb.NewNoOpStatement(&idpEvent), nil
+	}
+
+	return crdb.NewUpdateStatement(
+		&idpEvent,
+		[]handler.Column{
+			handler.NewCol(column, idpEvent.Secret),
+		},
+		[]handler.Condition{
+			// all provider specific tables use the same columns for the id and instance
+			handler.NewCond(OAuthIDCol, idpEvent.ID),
+			handler.NewCond(OAuthInstanceIDCol, idpEvent.Aggregate().InstanceID),
+		},
+		crdb.WithTableSuffix(suffix),
+	), nil
+}
+
 func (p *idpTemplateProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*org.OrgRemovedEvent)
 	if !ok {
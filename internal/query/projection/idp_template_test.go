@@ -164,6 +164,126 @@ func TestIDPTemplateProjection_reducesRemove(t *testing.T) {
 	}
 }
 
+func TestIDPTemplateProjection_reducesSecretReencrypted(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "instance reduceIDPSecretReencrypted ldap",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.IDPSecretReencryptedEventType),
+					instance.AggregateType,
+					[]byte(`{
+	"id": "idp-id",
+	"idpType": 4,
+	"secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    }
+}`),
+				), instance.IDPSecretReencryptedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceIDPSecretReencrypted,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.idp_templates5_ldap2 SET bind_password = $1 WHERE (idp_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceIDPSecretReencrypted google",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(org.IDPSecretReencryptedEventType),
+					org.AggregateType,
+					[]byte(`{
+	"id": "idp-id",
+	"idpType": 10,
+	"secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    }
+}`),
+				), org.IDPSecretReencryptedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceIDPSecretReencrypted,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("org"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.idp_templates5_google SET client_secret = $1 WHERE (idp_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceIDPSecretReencrypted jwt",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(org.IDPSecretReencryptedEventType),
+					org.AggregateType,
+					[]byte(`{
+	"id": "idp-id",
+	"idpType": 2
+}`),
+				), org.IDPSecretReencryptedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceIDPSecretReencrypted,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("org"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !errors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, IDPTemplateTable, tt.want)
+		})
+	}
+}
+
 func TestIDPTemplateProjection_reducesOAuth(t *testing.T) {
 	type args struct {
 		event func(t *testing.T) eventstore.Event
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/errors"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/handler"
@@ -14,7 +15,7 @@ import (
 )
 
 const (
-	KeyProjectionTable = "projections.keys4"
+	KeyProjectionTable = "projections.keys5"
 	KeyPrivateTable    = KeyProjectionTable + "_" + privateKeyTableSuffix
 	KeyPublicTable     = KeyProjectionTable + "_" + publicKeyTableSuffix
 	CertificateTable   = KeyProjectionTable + "_" + certificateTableSuffix
@@ -27,6 +28,7 @@ const (
 	KeyColumnSequence      = "sequence"
 	KeyColumnAlgorithm     = "algorithm"
 	KeyColumnUse           = "use"
+	KeyColumnState         = "state"
 
 	privateKeyTableSuffix      = "private"
 	KeyPrivateColumnID         = "id"
@@ -67,6 +69,7 @@ func newKeyProjection(ctx context.Context, config crdb.StatementHandlerConfig, k
 			crdb.NewColumn(KeyColumnSequence, crdb.ColumnTypeInt64),
 			crdb.NewColumn(KeyColumnAlgorithm, crdb.ColumnTypeText, crdb.Default("")),
 			crdb.NewColumn(KeyColumnUse, crdb.ColumnTypeEnum, crdb.Default(0)),
+			crdb.NewColumn(KeyColumnState, crdb.ColumnTypeEnum, crdb.Default(domain.KeyPairStateActive)),
 		},
 			crdb.NewPrimaryKey(KeyColumnInstanceID, KeyColumnID),
 		),
@@ -121,6 +124,14 @@ func (p *keyProjection) reducers() []handler.AggregateReducer {
 					Event:  keypair.AddedCertificateEventType,
 					Reduce: p.reduceCertificateAdded,
 				},
+				{
+					Event:  keypair.ActivatedEventType,
+					Reduce: p.reduceKeyPairActivated,
+				},
+				{
+					Event:  keypair.DeactivatedEventType,
+					Reduce: p.reduceKeyPairDeactivated,
+				},
 			},
 		},
 		{
@@ -143,6 +154,10 @@ func (p *keyProjection) reduceKeyPairAdded(event eventstore.Event) (*handler.Sta
 	if e.PrivateKey.Expiry.Before(time.Now()) && e.PublicKey.Expiry.Before(time.Now()) {
 		return crdb.NewNoOpStatement(e), nil
 	}
+	state := domain.KeyPairStateActive
+	if e.Staged {
+		state = domain.KeyPairStatePublished
+	}
 	creates := []func(eventstore.Event) crdb.Exec{
 		crdb.AddCreateStatement(
 			[]handler.Column{
@@ -154,6 +169,7 @@ func (p *keyProjection) reduceKeyPairAdded(event eventstore.Event) (*handler.Sta
 				handler.NewCol(KeyColumnSequence, e.Sequence()),
 				handler.NewCol(KeyColumnAlgorithm, e.Algorithm),
 				handler.NewCol(KeyColumnUse, e.Usage),
+				handler.NewCol(KeyColumnState, state),
 			},
 		),
 	}
@@ -214,3 +230,34 @@ func (p *keyProjection) reduceCertificateAdded(event eventstore.Event) (*handler
 
 	return crdb.NewMultiStatement(e, creates...), nil
 }
+
+func (p *keyProjection) reduceKeyPairActivated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*keypair.ActivatedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Kp2la", "reduce.wrong.event.type %s", keypair.ActivatedEventType)
+	}
+	return p.reduceKeyPairStateChanged(e, domain.KeyPairStateActive), nil
+}
+
+func (p *keyProjection) reduceKeyPairDeactivated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*keypair.DeactivatedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Kp2lb", "reduce.wrong.event.type %s", keypair.DeactivatedEventType)
+	}
+	return p.reduceKeyPairStateChanged(e, domain.KeyPairStateInactive), nil
+}
+
+func (p *keyProjection) reduceKeyPairStateChanged(event eventstore.Event, state domain.KeyPairState) *handler.Statement {
+	return crdb.NewUpdateStatement(
+		event,
+		[]handler.Column{
+			handler.NewCol(KeyColumnChangeDate, event.CreationDate()),
+			handler.NewCol(KeyColumnSequence, event.Sequence()),
+			handler.NewCol(KeyColumnState, state),
+		},
+		[]handler.Condition{
+			handler.NewCond(KeyColumnID, event.Aggregate().ID),
+			handler.NewCond(KeyColumnInstanceID, event.Aggregate().InstanceID),
+		},
+	)
+}
@@ -44,7 +44,7 @@ func TestKeyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.keys4 (id, creation_date, change_date, resource_owner, instance_id, sequence, algorithm, use) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+							expectedStmt: "INSERT INTO projections.keys5 (id, creation_date, change_date, resource_owner, instance_id, sequence, algorithm, use, state) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								anyArg{},
@@ -54,10 +54,11 @@ func TestKeyProjection_reduces(t *testing.T) {
 								uint64(15),
 								"algorithm",
 								domain.KeyUsageSigning,
+								domain.KeyPairStateActive,
 							},
 						},
 						{
-							expectedStmt: "INSERT INTO projections.keys4_private (id, instance_id, expiry, key) VALUES ($1, $2, $3, $4)",
+							expectedStmt: "INSERT INTO projections.keys5_private (id, instance_id, expiry, key) VALUES ($1, $2, $3, $4)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								"instance-id",
@@ -71,7 +72,7 @@ func TestKeyProjection_reduces(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "INSERT INTO projections.keys4_public (id, instance_id, expiry, key) VALUES ($1, $2, $3, $4)",
+							expectedStmt: "INSERT INTO projections.keys5_public (id, instance_id, expiry, key) VALUES ($1, $2, $3, $4)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								"instance-id",
@@ -100,6 +101,109 @@ func TestKeyProjection_reduces(t *testing.T) {
 				executer:         &testExecuter{},
 			},
 		},
+		{
+			name: "reduceKeyPairAdded staged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(keypair.AddedEventType),
+					keypair.AggregateType,
+					stagedKeypairAddedEventData(time.Now().Add(-time.Hour), time.Now().Add(time.Hour)),
+				), keypair.AddedEventMapper),
+			},
+			reduce: (&keyProjection{encryptionAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t))}).reduceKeyPairAdded,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("key_pair"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.keys5 (id, creation_date, change_date, resource_owner, instance_id, sequence, algorithm, use, state) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								"instance-id",
+								uint64(15),
+								"algorithm",
+								domain.KeyUsageSigning,
+								domain.KeyPairStatePublished,
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.keys5_public (id, instance_id, expiry, key) VALUES ($1, $2, $3, $4)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+								anyArg{},
+								[]byte("publicKey"),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceKeyPairActivated",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(keypair.ActivatedEventType),
+					keypair.AggregateType,
+					nil,
+				), keypair.ActivatedEventMapper),
+			},
+			reduce: (&keyProjection{}).reduceKeyPairActivated,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("key_pair"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.keys5 SET (change_date, sequence, state) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.KeyPairStateActive,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceKeyPairDeactivated",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(keypair.DeactivatedEventType),
+					keypair.AggregateType,
+					nil,
+				), keypair.DeactivatedEventMapper),
+			},
+			reduce: (&keyProjection{}).reduceKeyPairDeactivated,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("key_pair"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.keys5 SET (change_date, sequence, state) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.KeyPairStateInactive,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "instance reduceInstanceRemoved",
 			args: args{
@@ -117,7 +221,7 @@ func TestKeyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "DELETE FROM projections.keys4 WHERE (instance_id = $1)",
+							expectedStmt: "DELETE FROM projections.keys5 WHERE (instance_id = $1)",
 							expectedArgs: []interface{}{
 								"agg-id",
 							},
@@ -143,7 +247,7 @@ func TestKeyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.keys4_certificate (id, instance_id, expiry, certificate) VALUES ($1, $2, $3, $4)",
+							expectedStmt: "INSERT INTO projections.keys5_certificate (id, instance_id, expiry, certificate) VALUES ($1, $2, $3, $4)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								"instance-id",
@@ -178,3 +282,7 @@ func keypairAddedEventData(usage domain.KeyUsage, t time.Time) []byte {
 func certificateAddedEventData(usage domain.KeyUsage, t time.Time) []byte {
 	return []byte(`{"algorithm": "algorithm", "usage": ` + fmt.Sprintf("%d", usage) + `, "certificate": {"key": {"cryptoType": 0, "algorithm": "enc", "keyID": "id", "crypted": "cHJpdmF0ZUtleQ=="}, "expiry": "` + t.Format(time.RFC3339) + `"}}`)
 }
+
+func stagedKeypairAddedEventData(privateExpiry, publicExpiry time.Time) []byte {
+	return []byte(`{"algorithm": "algorithm", "usage": 0, "staged": true, "privateKey": {"key": {"cryptoType": 0, "algorithm": "enc", "keyID": "id", "crypted": "cHJpdmF0ZUtleQ=="}, "expiry": "` + privateExpiry.Format(time.RFC3339) + `"}, "publicKey": {"key": {"cryptoType": 0, "algorithm": "enc", "keyID": "id", "crypted": "cHVibGljS2V5"}, "expiry": "` + publicExpiry.Format(time.RFC3339) + `"}}`)
+}
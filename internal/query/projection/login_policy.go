@@ -13,7 +13,7 @@ import (
 )
 
 const (
-	LoginPolicyTable = "projections.login_policies5"
+	LoginPolicyTable = "projections.login_policies6"
 
 	LoginPolicyIDCol                    = "aggregate_id"
 	LoginPolicyInstanceIDCol            = "instance_id"
@@ -40,6 +40,7 @@ const (
 	MFAInitSkipLifetimeCol              = "mfa_init_skip_lifetime"
 	SecondFactorCheckLifetimeCol        = "second_factor_check_lifetime"
 	MultiFactorCheckLifetimeCol         = "multi_factor_check_lifetime"
+	TrustedDeviceLifetimeCol            = "trusted_device_lifetime"
 	LoginPolicyOwnerRemovedCol          = "owner_removed"
 )
 
@@ -78,6 +79,7 @@ func newLoginPolicyProjection(ctx context.Context, config crdb.StatementHandlerC
 			crdb.NewColumn(MFAInitSkipLifetimeCol, crdb.ColumnTypeInt64),
 			crdb.NewColumn(SecondFactorCheckLifetimeCol, crdb.ColumnTypeInt64),
 			crdb.NewColumn(MultiFactorCheckLifetimeCol, crdb.ColumnTypeInt64),
+			crdb.NewColumn(TrustedDeviceLifetimeCol, crdb.ColumnTypeInt64),
 			crdb.NewColumn(LoginPolicyOwnerRemovedCol, crdb.ColumnTypeBool, crdb.Default(false)),
 		},
 			crdb.NewPrimaryKey(LoginPolicyInstanceIDCol, LoginPolicyIDCol),
@@ -201,6 +203,7 @@ func (p *loginPolicyProjection) reduceLoginPolicyAdded(event eventstore.Event) (
 		handler.NewCol(MFAInitSkipLifetimeCol, policyEvent.MFAInitSkipLifetime),
 		handler.NewCol(SecondFactorCheckLifetimeCol, policyEvent.SecondFactorCheckLifetime),
 		handler.NewCol(MultiFactorCheckLifetimeCol, policyEvent.MultiFactorCheckLifetime),
+		handler.NewCol(TrustedDeviceLifetimeCol, policyEvent.TrustedDeviceLifetime),
 	}), nil
 }
 
@@ -270,6 +273,9 @@ func (p *loginPolicyProjection) reduceLoginPolicyChanged(event eventstore.Event)
 	if policyEvent.MultiFactorCheckLifetime != nil {
 		cols = append(cols, handler.NewCol(MultiFactorCheckLifetimeCol, *policyEvent.MultiFactorCheckLifetime))
 	}
+	if policyEvent.TrustedDeviceLifetime != nil {
+		cols = append(cols, handler.NewCol(TrustedDeviceLifetimeCol, *policyEvent.TrustedDeviceLifetime))
+	}
 
 	return crdb.NewUpdateStatement(
 		&policyEvent,
@@ -276,7 +276,14 @@ func isMessageTemplate(template string) bool {
 		template == domain.VerifyEmailOTPMessageType ||
 		template == domain.DomainClaimedMessageType ||
 		template == domain.PasswordlessRegistrationMessageType ||
-		template == domain.PasswordChangeMessageType
+		template == domain.PasswordChangeMessageType ||
+		template == domain.DeviceTrustedMessageType ||
+		template == domain.UserDeactivationMessageType ||
+		template == domain.UserRemovalMessageType ||
+		template == domain.UserGrantRequestedMessageType ||
+		template == domain.UserGrantApprovedMessageType ||
+		template == domain.UserGrantDeniedMessageType ||
+		template == domain.BackchannelAuthRequestedMessageType
 }
 func isTitle(key string) bool {
 	return key == domain.MessageTitle
@@ -27,7 +27,7 @@ func TestOIDCSettingsProjection_reduces(t *testing.T) {
 				event: getEvent(testEvent(
 					repository.EventType(instance.OIDCSettingsChangedEventType),
 					instance.AggregateType,
-					[]byte(`{"accessTokenLifetime": 10000000, "idTokenLifetime": 10000000, "refreshTokenIdleExpiration": 10000000, "refreshTokenExpiration": 10000000}`),
+					[]byte(`{"accessTokenLifetime": 10000000, "idTokenLifetime": 10000000, "refreshTokenIdleExpiration": 10000000, "refreshTokenExpiration": 10000000, "signingAlgorithm": "ES256"}`),
 				), instance.OIDCSettingsChangedEventMapper),
 			},
 			reduce: (&oidcSettingsProjection{}).reduceOIDCSettingsChanged,
@@ -38,7 +38,7 @@ func TestOIDCSettingsProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.oidc_settings2 SET (change_date, sequence, access_token_lifetime, id_token_lifetime, refresh_token_idle_expiration, refresh_token_expiration) = ($1, $2, $3, $4, $5, $6) WHERE (aggregate_id = $7) AND (instance_id = $8)",
+							expectedStmt: "UPDATE projections.oidc_settings3 SET (change_date, sequence, access_token_lifetime, id_token_lifetime, refresh_token_idle_expiration, refresh_token_expiration, signing_algorithm) = ($1, $2, $3, $4, $5, $6, $7) WHERE (aggregate_id = $8) AND (instance_id = $9)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								uint64(15),
@@ -46,6 +46,7 @@ func TestOIDCSettingsProjection_reduces(t *testing.T) {
 								time.Millisecond * 10,
 								time.Millisecond * 10,
 								time.Millisecond * 10,
+								"ES256",
 								"agg-id",
 								"instance-id",
 							},
@@ -60,7 +61,7 @@ func TestOIDCSettingsProjection_reduces(t *testing.T) {
 				event: getEvent(testEvent(
 					repository.EventType(instance.OIDCSettingsAddedEventType),
 					instance.AggregateType,
-					[]byte(`{"accessTokenLifetime": 10000000, "idTokenLifetime": 10000000, "refreshTokenIdleExpiration": 10000000, "refreshTokenExpiration": 10000000}`),
+					[]byte(`{"accessTokenLifetime": 10000000, "idTokenLifetime": 10000000, "refreshTokenIdleExpiration": 10000000, "refreshTokenExpiration": 10000000, "signingAlgorithm": "ES256"}`),
 				), instance.OIDCSettingsAddedEventMapper),
 			},
 			reduce: (&oidcSettingsProjection{}).reduceOIDCSettingsAdded,
@@ -71,7 +72,7 @@ func TestOIDCSettingsProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.oidc_settings2 (aggregate_id, creation_date, change_date, resource_owner, instance_id, sequence, access_token_lifetime, id_token_lifetime, refresh_token_idle_expiration, refresh_token_expiration) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+							expectedStmt: "INSERT INTO projections.oidc_settings3 (aggregate_id, creation_date, change_date, resource_owner, instance_id, sequence, access_token_lifetime, id_token_lifetime, refresh_token_idle_expiration, refresh_token_expiration, signing_algorithm) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								anyArg{},
@@ -83,6 +84,7 @@ func TestOIDCSettingsProjection_reduces(t *testing.T) {
 								time.Millisecond * 10,
 								time.Millisecond * 10,
 								time.Millisecond * 10,
+								"ES256",
 							},
 						},
 					},
@@ -106,7 +108,7 @@ func TestOIDCSettingsProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "DELETE FROM projections.oidc_settings2 WHERE (instance_id = $1)",
+							expectedStmt: "DELETE FROM projections.oidc_settings3 WHERE (instance_id = $1)",
 							expectedArgs: []interface{}{
 								"agg-id",
 							},
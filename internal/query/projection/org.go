@@ -13,7 +13,7 @@ import (
 )
 
 const (
-	OrgProjectionTable = "projections.orgs"
+	OrgProjectionTable = "projections.orgs1"
 
 	OrgColumnID            = "id"
 	OrgColumnCreationDate  = "creation_date"
@@ -24,6 +24,7 @@ const (
 	OrgColumnSequence      = "sequence"
 	OrgColumnName          = "name"
 	OrgColumnDomain        = "primary_domain"
+	OrgColumnParentID      = "parent_org_id"
 )
 
 type orgProjection struct {
@@ -45,10 +46,12 @@ func newOrgProjection(ctx context.Context, config crdb.StatementHandlerConfig) *
 			crdb.NewColumn(OrgColumnSequence, crdb.ColumnTypeInt64),
 			crdb.NewColumn(OrgColumnName, crdb.ColumnTypeText),
 			crdb.NewColumn(OrgColumnDomain, crdb.ColumnTypeText, crdb.Default("")),
+			crdb.NewColumn(OrgColumnParentID, crdb.ColumnTypeText, crdb.Default("")),
 		},
 			crdb.NewPrimaryKey(OrgColumnInstanceID, OrgColumnID),
 			crdb.WithIndex(crdb.NewIndex("domain", []string{OrgColumnDomain})),
 			crdb.WithIndex(crdb.NewIndex("name", []string{OrgColumnName})),
+			crdb.WithIndex(crdb.NewIndex("parent", []string{OrgColumnParentID})),
 		),
 	)
 	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
@@ -84,6 +87,14 @@ func (p *orgProjection) reducers() []handler.AggregateReducer {
 					Event:  org.OrgDomainPrimarySetEventType,
 					Reduce: p.reducePrimaryDomainSet,
 				},
+				{
+					Event:  org.OrgParentSetEventType,
+					Reduce: p.reduceParentSet,
+				},
+				{
+					Event:  org.OrgParentRemovedEventType,
+					Reduce: p.reduceParentRemoved,
+				},
 			},
 		},
 		{
@@ -215,3 +226,41 @@ func (p *orgProjection) reduceOrgRemoved(event eventstore.Event) (*handler.State
 		},
 	), nil
 }
+
+func (p *orgProjection) reduceParentSet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgParentSetEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-Ph3nw", "reduce.wrong.event.type %s", org.OrgParentSetEventType)
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(OrgColumnChangeDate, e.CreationDate()),
+			handler.NewCol(OrgColumnSequence, e.Sequence()),
+			handler.NewCol(OrgColumnParentID, e.ParentOrgID),
+		},
+		[]handler.Condition{
+			handler.NewCond(OrgColumnID, e.Aggregate().ID),
+			handler.NewCond(OrgColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *orgProjection) reduceParentRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgParentRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-Ph7xc", "reduce.wrong.event.type %s", org.OrgParentRemovedEventType)
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(OrgColumnChangeDate, e.CreationDate()),
+			handler.NewCol(OrgColumnSequence, e.Sequence()),
+			handler.NewCol(OrgColumnParentID, ""),
+		},
+		[]handler.Condition{
+			handler.NewCond(OrgColumnID, e.Aggregate().ID),
+			handler.NewCond(OrgColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
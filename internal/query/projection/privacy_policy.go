@@ -14,21 +14,22 @@ import (
 )
 
 const (
-	PrivacyPolicyTable = "projections.privacy_policies3"
+	PrivacyPolicyTable = "projections.privacy_policies4"
 
-	PrivacyPolicyIDCol            = "id"
-	PrivacyPolicyCreationDateCol  = "creation_date"
-	PrivacyPolicyChangeDateCol    = "change_date"
-	PrivacyPolicySequenceCol      = "sequence"
-	PrivacyPolicyStateCol         = "state"
-	PrivacyPolicyIsDefaultCol     = "is_default"
-	PrivacyPolicyResourceOwnerCol = "resource_owner"
-	PrivacyPolicyInstanceIDCol    = "instance_id"
-	PrivacyPolicyPrivacyLinkCol   = "privacy_link"
-	PrivacyPolicyTOSLinkCol       = "tos_link"
-	PrivacyPolicyHelpLinkCol      = "help_link"
-	PrivacyPolicySupportEmailCol  = "support_email"
-	PrivacyPolicyOwnerRemovedCol  = "owner_removed"
+	PrivacyPolicyIDCol                = "id"
+	PrivacyPolicyCreationDateCol      = "creation_date"
+	PrivacyPolicyChangeDateCol        = "change_date"
+	PrivacyPolicySequenceCol          = "sequence"
+	PrivacyPolicyStateCol             = "state"
+	PrivacyPolicyIsDefaultCol         = "is_default"
+	PrivacyPolicyResourceOwnerCol     = "resource_owner"
+	PrivacyPolicyInstanceIDCol        = "instance_id"
+	PrivacyPolicyPrivacyLinkCol       = "privacy_link"
+	PrivacyPolicyTOSLinkCol           = "tos_link"
+	PrivacyPolicyHelpLinkCol          = "help_link"
+	PrivacyPolicySupportEmailCol      = "support_email"
+	PrivacyPolicyOwnerRemovedCol      = "owner_removed"
+	PrivacyPolicyAllowSelfDeletionCol = "allow_self_deletion"
 )
 
 type privacyPolicyProjection struct {
@@ -54,6 +55,7 @@ func newPrivacyPolicyProjection(ctx context.Context, config crdb.StatementHandle
 			crdb.NewColumn(PrivacyPolicyHelpLinkCol, crdb.ColumnTypeText),
 			crdb.NewColumn(PrivacyPolicySupportEmailCol, crdb.ColumnTypeText),
 			crdb.NewColumn(PrivacyPolicyOwnerRemovedCol, crdb.ColumnTypeBool, crdb.Default(false)),
+			crdb.NewColumn(PrivacyPolicyAllowSelfDeletionCol, crdb.ColumnTypeBool, crdb.Default(false)),
 		},
 			crdb.NewPrimaryKey(PrivacyPolicyInstanceIDCol, PrivacyPolicyIDCol),
 			crdb.WithIndex(crdb.NewIndex("owner_removed", []string{PrivacyPolicyOwnerRemovedCol})),
@@ -131,6 +133,7 @@ func (p *privacyPolicyProjection) reduceAdded(event eventstore.Event) (*handler.
 			handler.NewCol(PrivacyPolicyTOSLinkCol, policyEvent.TOSLink),
 			handler.NewCol(PrivacyPolicyHelpLinkCol, policyEvent.HelpLink),
 			handler.NewCol(PrivacyPolicySupportEmailCol, policyEvent.SupportEmail),
+			handler.NewCol(PrivacyPolicyAllowSelfDeletionCol, policyEvent.AllowSelfDeletion),
 			handler.NewCol(PrivacyPolicyIsDefaultCol, isDefault),
 			handler.NewCol(PrivacyPolicyResourceOwnerCol, policyEvent.Aggregate().ResourceOwner),
 			handler.NewCol(PrivacyPolicyInstanceIDCol, policyEvent.Aggregate().InstanceID),
@@ -163,6 +166,9 @@ func (p *privacyPolicyProjection) reduceChanged(event eventstore.Event) (*handle
 	if policyEvent.SupportEmail != nil {
 		cols = append(cols, handler.NewCol(PrivacyPolicySupportEmailCol, *policyEvent.SupportEmail))
 	}
+	if policyEvent.AllowSelfDeletion != nil {
+		cols = append(cols, handler.NewCol(PrivacyPolicyAllowSelfDeletionCol, *policyEvent.AllowSelfDeletion))
+	}
 	return crdb.NewUpdateStatement(
 		&policyEvent,
 		cols,
@@ -0,0 +1,205 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+const (
+	ProjectAuthorizationDetailTypeProjectionTable = "projections.project_authorization_detail_types"
+
+	ProjectAuthorizationDetailTypeColumnProjectID     = "project_id"
+	ProjectAuthorizationDetailTypeColumnType          = "detail_type"
+	ProjectAuthorizationDetailTypeColumnCreationDate  = "creation_date"
+	ProjectAuthorizationDetailTypeColumnChangeDate    = "change_date"
+	ProjectAuthorizationDetailTypeColumnSequence      = "sequence"
+	ProjectAuthorizationDetailTypeColumnResourceOwner = "resource_owner"
+	ProjectAuthorizationDetailTypeColumnInstanceID    = "instance_id"
+	ProjectAuthorizationDetailTypeColumnDisplayName   = "display_name"
+	ProjectAuthorizationDetailTypeColumnDescription   = "description"
+	ProjectAuthorizationDetailTypeColumnSchema        = "json_schema"
+	ProjectAuthorizationDetailTypeColumnOwnerRemoved  = "owner_removed"
+)
+
+type projectAuthorizationDetailTypeProjection struct {
+	crdb.StatementHandler
+}
+
+func newProjectAuthorizationDetailTypeProjection(ctx context.Context, config crdb.StatementHandlerConfig) *projectAuthorizationDetailTypeProjection {
+	p := new(projectAuthorizationDetailTypeProjection)
+	config.ProjectionName = ProjectAuthorizationDetailTypeProjectionTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(ProjectAuthorizationDetailTypeColumnProjectID, crdb.ColumnTypeText),
+			crdb.NewColumn(ProjectAuthorizationDetailTypeColumnType, crdb.ColumnTypeText),
+			crdb.NewColumn(ProjectAuthorizationDetailTypeColumnCreationDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(ProjectAuthorizationDetailTypeColumnChangeDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(ProjectAuthorizationDetailTypeColumnSequence, crdb.ColumnTypeInt64),
+			crdb.NewColumn(ProjectAuthorizationDetailTypeColumnResourceOwner, crdb.ColumnTypeText),
+			crdb.NewColumn(ProjectAuthorizationDetailTypeColumnInstanceID, crdb.ColumnTypeText),
+			crdb.NewColumn(ProjectAuthorizationDetailTypeColumnDisplayName, crdb.ColumnTypeText),
+			crdb.NewColumn(ProjectAuthorizationDetailTypeColumnDescription, crdb.ColumnTypeText),
+			crdb.NewColumn(ProjectAuthorizationDetailTypeColumnSchema, crdb.ColumnTypeText),
+			crdb.NewColumn(ProjectAuthorizationDetailTypeColumnOwnerRemoved, crdb.ColumnTypeBool, crdb.Default(false)),
+		},
+			crdb.NewPrimaryKey(ProjectAuthorizationDetailTypeColumnInstanceID, ProjectAuthorizationDetailTypeColumnProjectID, ProjectAuthorizationDetailTypeColumnType),
+			crdb.WithIndex(crdb.NewIndex("owner_removed", []string{ProjectAuthorizationDetailTypeColumnOwnerRemoved})),
+		),
+	)
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *projectAuthorizationDetailTypeProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: project.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  project.AuthorizationDetailTypeAddedType,
+					Reduce: p.reduceAuthorizationDetailTypeAdded,
+				},
+				{
+					Event:  project.AuthorizationDetailTypeChangedType,
+					Reduce: p.reduceAuthorizationDetailTypeChanged,
+				},
+				{
+					Event:  project.AuthorizationDetailTypeRemovedType,
+					Reduce: p.reduceAuthorizationDetailTypeRemoved,
+				},
+				{
+					Event:  project.ProjectRemovedType,
+					Reduce: p.reduceProjectRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(ProjectAuthorizationDetailTypeColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *projectAuthorizationDetailTypeProjection) reduceAuthorizationDetailTypeAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*project.AuthorizationDetailTypeAddedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Ad4gq", "reduce.wrong.event.type %s", project.AuthorizationDetailTypeAddedType)
+	}
+	return crdb.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(ProjectAuthorizationDetailTypeColumnType, e.DetailType),
+			handler.NewCol(ProjectAuthorizationDetailTypeColumnProjectID, e.Aggregate().ID),
+			handler.NewCol(ProjectAuthorizationDetailTypeColumnCreationDate, e.CreationDate()),
+			handler.NewCol(ProjectAuthorizationDetailTypeColumnChangeDate, e.CreationDate()),
+			handler.NewCol(ProjectAuthorizationDetailTypeColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(ProjectAuthorizationDetailTypeColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(ProjectAuthorizationDetailTypeColumnSequence, e.Sequence()),
+			handler.NewCol(ProjectAuthorizationDetailTypeColumnDisplayName, e.DisplayName),
+			handler.NewCol(ProjectAuthorizationDetailTypeColumnDescription, e.Description),
+			handler.NewCol(ProjectAuthorizationDetailTypeColumnSchema, e.Schema),
+		},
+	), nil
+}
+
+func (p *projectAuthorizationDetailTypeProjection) reduceAuthorizationDetailTypeChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*project.AuthorizationDetailTypeChangedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Ad8dw", "reduce.wrong.event.type %s", project.AuthorizationDetailTypeChangedType)
+	}
+	if e.DisplayName == nil && e.Description == nil && e.Schema == nil {
+		return crdb.NewNoOpStatement(e), nil
+	}
+	columns := make([]handler.Column, 0, 7)
+	columns = append(columns, handler.NewCol(ProjectAuthorizationDetailTypeColumnChangeDate, e.CreationDate()),
+		handler.NewCol(ProjectAuthorizationDetailTypeColumnSequence, e.Sequence()))
+	if e.DisplayName != nil {
+		columns = append(columns, handler.NewCol(ProjectAuthorizationDetailTypeColumnDisplayName, *e.DisplayName))
+	}
+	if e.Description != nil {
+		columns = append(columns, handler.NewCol(ProjectAuthorizationDetailTypeColumnDescription, *e.Description))
+	}
+	if e.Schema != nil {
+		columns = append(columns, handler.NewCol(ProjectAuthorizationDetailTypeColumnSchema, *e.Schema))
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		columns,
+		[]handler.Condition{
+			handler.NewCond(ProjectAuthorizationDetailTypeColumnType, e.DetailType),
+			handler.NewCond(ProjectAuthorizationDetailTypeColumnProjectID, e.Aggregate().ID),
+			handler.NewCond(ProjectAuthorizationDetailTypeColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *projectAuthorizationDetailTypeProjection) reduceAuthorizationDetailTypeRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*project.AuthorizationDetailTypeRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Ad2nv", "reduce.wrong.event.type %s", project.AuthorizationDetailTypeRemovedType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(ProjectAuthorizationDetailTypeColumnType, e.DetailType),
+			handler.NewCond(ProjectAuthorizationDetailTypeColumnProjectID, e.Aggregate().ID),
+			handler.NewCond(ProjectAuthorizationDetailTypeColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *projectAuthorizationDetailTypeProjection) reduceProjectRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*project.ProjectRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Ad6ph", "reduce.wrong.event.type %s", project.ProjectRemovedType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(ProjectAuthorizationDetailTypeColumnProjectID, e.Aggregate().ID),
+			handler.NewCond(ProjectAuthorizationDetailTypeColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *projectAuthorizationDetailTypeProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-Ad9fz", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(ProjectAuthorizationDetailTypeColumnChangeDate, e.CreationDate()),
+			handler.NewCol(ProjectAuthorizationDetailTypeColumnSequence, e.Sequence()),
+			handler.NewCol(ProjectAuthorizationDetailTypeColumnOwnerRemoved, true),
+		},
+		[]handler.Condition{
+			handler.NewCond(ProjectAuthorizationDetailTypeColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(ProjectAuthorizationDetailTypeColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
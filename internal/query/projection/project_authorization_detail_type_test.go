@@ -0,0 +1,235 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+func TestProjectAuthorizationDetailTypeProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceProjectRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(project.ProjectRemovedType),
+					project.AggregateType,
+					nil,
+				), project.ProjectRemovedEventMapper),
+			},
+			reduce: (&projectAuthorizationDetailTypeProjection{}).reduceProjectRemoved,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("project"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.project_authorization_detail_types WHERE (project_id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.InstanceRemovedEventType),
+					instance.AggregateType,
+					nil,
+				), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(ProjectAuthorizationDetailTypeColumnInstanceID),
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.project_authorization_detail_types WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceAuthorizationDetailTypeRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(project.AuthorizationDetailTypeRemovedType),
+					project.AggregateType,
+					[]byte(`{"type": "payment_initiation"}`),
+				), project.AuthorizationDetailTypeRemovedEventMapper),
+			},
+			reduce: (&projectAuthorizationDetailTypeProjection{}).reduceAuthorizationDetailTypeRemoved,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("project"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.project_authorization_detail_types WHERE (detail_type = $1) AND (project_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"payment_initiation",
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceAuthorizationDetailTypeChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(project.AuthorizationDetailTypeChangedType),
+					project.AggregateType,
+					[]byte(`{"type": "payment_initiation", "displayName": "New Payment", "description": "New Description", "schema": "{\"type\": \"object\"}"}`),
+				), project.AuthorizationDetailTypeChangedEventMapper),
+			},
+			reduce: (&projectAuthorizationDetailTypeProjection{}).reduceAuthorizationDetailTypeChanged,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("project"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.project_authorization_detail_types SET (change_date, sequence, display_name, description, json_schema) = ($1, $2, $3, $4, $5) WHERE (detail_type = $6) AND (project_id = $7) AND (instance_id = $8)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"New Payment",
+								"New Description",
+								`{"type": "object"}`,
+								"payment_initiation",
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceAuthorizationDetailTypeChanged no changes",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(project.AuthorizationDetailTypeChangedType),
+					project.AggregateType,
+					[]byte(`{}`),
+				), project.AuthorizationDetailTypeChangedEventMapper),
+			},
+			reduce: (&projectAuthorizationDetailTypeProjection{}).reduceAuthorizationDetailTypeChanged,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("project"),
+				sequence:         15,
+				previousSequence: 10,
+				executer:         &testExecuter{},
+			},
+		},
+		{
+			name: "reduceAuthorizationDetailTypeAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(project.AuthorizationDetailTypeAddedType),
+					project.AggregateType,
+					[]byte(`{"type": "payment_initiation", "displayName": "Payment", "description": "Description", "schema": "{\"type\": \"object\"}"}`),
+				), project.AuthorizationDetailTypeAddedEventMapper),
+			},
+			reduce: (&projectAuthorizationDetailTypeProjection{}).reduceAuthorizationDetailTypeAdded,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("project"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.project_authorization_detail_types (detail_type, project_id, creation_date, change_date, resource_owner, instance_id, sequence, display_name, description, json_schema) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+							expectedArgs: []interface{}{
+								"payment_initiation",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								"instance-id",
+								uint64(15),
+								"Payment",
+								"Description",
+								`{"type": "object"}`,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "org.reduceOwnerRemoved",
+			reduce: (&projectAuthorizationDetailTypeProjection{}).reduceOwnerRemoved,
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(org.OrgRemovedEventType),
+					org.AggregateType,
+					nil,
+				), org.OrgRemovedEventMapper),
+			},
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("org"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.project_authorization_detail_types SET (change_date, sequence, owner_removed) = ($1, $2, $3) WHERE (instance_id = $4) AND (resource_owner = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								true,
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if _, ok := err.(errors.InvalidArgument); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, ProjectAuthorizationDetailTypeProjectionTable, tt.want)
+		})
+	}
+}
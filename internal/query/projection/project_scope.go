@@ -0,0 +1,199 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+const (
+	ProjectScopeProjectionTable = "projections.project_scopes"
+
+	ProjectScopeColumnProjectID     = "project_id"
+	ProjectScopeColumnKey           = "scope_key"
+	ProjectScopeColumnCreationDate  = "creation_date"
+	ProjectScopeColumnChangeDate    = "change_date"
+	ProjectScopeColumnSequence      = "sequence"
+	ProjectScopeColumnResourceOwner = "resource_owner"
+	ProjectScopeColumnInstanceID    = "instance_id"
+	ProjectScopeColumnDisplayName   = "display_name"
+	ProjectScopeColumnDescription   = "description"
+	ProjectScopeColumnOwnerRemoved  = "owner_removed"
+)
+
+type projectScopeProjection struct {
+	crdb.StatementHandler
+}
+
+func newProjectScopeProjection(ctx context.Context, config crdb.StatementHandlerConfig) *projectScopeProjection {
+	p := new(projectScopeProjection)
+	config.ProjectionName = ProjectScopeProjectionTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(ProjectScopeColumnProjectID, crdb.ColumnTypeText),
+			crdb.NewColumn(ProjectScopeColumnKey, crdb.ColumnTypeText),
+			crdb.NewColumn(ProjectScopeColumnCreationDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(ProjectScopeColumnChangeDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(ProjectScopeColumnSequence, crdb.ColumnTypeInt64),
+			crdb.NewColumn(ProjectScopeColumnResourceOwner, crdb.ColumnTypeText),
+			crdb.NewColumn(ProjectScopeColumnInstanceID, crdb.ColumnTypeText),
+			crdb.NewColumn(ProjectScopeColumnDisplayName, crdb.ColumnTypeText),
+			crdb.NewColumn(ProjectScopeColumnDescription, crdb.ColumnTypeText),
+			crdb.NewColumn(ProjectScopeColumnOwnerRemoved, crdb.ColumnTypeBool, crdb.Default(false)),
+		},
+			crdb.NewPrimaryKey(ProjectScopeColumnInstanceID, ProjectScopeColumnProjectID, ProjectScopeColumnKey),
+			crdb.WithIndex(crdb.NewIndex("owner_removed", []string{ProjectScopeColumnOwnerRemoved})),
+		),
+	)
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *projectScopeProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: project.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  project.ScopeAddedType,
+					Reduce: p.reduceProjectScopeAdded,
+				},
+				{
+					Event:  project.ScopeChangedType,
+					Reduce: p.reduceProjectScopeChanged,
+				},
+				{
+					Event:  project.ScopeRemovedType,
+					Reduce: p.reduceProjectScopeRemoved,
+				},
+				{
+					Event:  project.ProjectRemovedType,
+					Reduce: p.reduceProjectRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(ProjectScopeColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *projectScopeProjection) reduceProjectScopeAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*project.ScopeAddedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Sc4gq", "reduce.wrong.event.type %s", project.ScopeAddedType)
+	}
+	return crdb.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(ProjectScopeColumnKey, e.Key),
+			handler.NewCol(ProjectScopeColumnProjectID, e.Aggregate().ID),
+			handler.NewCol(ProjectScopeColumnCreationDate, e.CreationDate()),
+			handler.NewCol(ProjectScopeColumnChangeDate, e.CreationDate()),
+			handler.NewCol(ProjectScopeColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(ProjectScopeColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(ProjectScopeColumnSequence, e.Sequence()),
+			handler.NewCol(ProjectScopeColumnDisplayName, e.DisplayName),
+			handler.NewCol(ProjectScopeColumnDescription, e.Description),
+		},
+	), nil
+}
+
+func (p *projectScopeProjection) reduceProjectScopeChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*project.ScopeChangedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Sc8dw", "reduce.wrong.event.type %s", project.ScopeChangedType)
+	}
+	if e.DisplayName == nil && e.Description == nil {
+		return crdb.NewNoOpStatement(e), nil
+	}
+	columns := make([]handler.Column, 0, 7)
+	columns = append(columns, handler.NewCol(ProjectScopeColumnChangeDate, e.CreationDate()),
+		handler.NewCol(ProjectScopeColumnSequence, e.Sequence()))
+	if e.DisplayName != nil {
+		columns = append(columns, handler.NewCol(ProjectScopeColumnDisplayName, *e.DisplayName))
+	}
+	if e.Description != nil {
+		columns = append(columns, handler.NewCol(ProjectScopeColumnDescription, *e.Description))
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		columns,
+		[]handler.Condition{
+			handler.NewCond(ProjectScopeColumnKey, e.Key),
+			handler.NewCond(ProjectScopeColumnProjectID, e.Aggregate().ID),
+			handler.NewCond(ProjectScopeColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *projectScopeProjection) reduceProjectScopeRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*project.ScopeRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Sc2nv", "reduce.wrong.event.type %s", project.ScopeRemovedType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(ProjectScopeColumnKey, e.Key),
+			handler.NewCond(ProjectScopeColumnProjectID, e.Aggregate().ID),
+			handler.NewCond(ProjectScopeColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *projectScopeProjection) reduceProjectRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*project.ProjectRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Sc6ph", "reduce.wrong.event.type %s", project.ProjectRemovedType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(ProjectScopeColumnProjectID, e.Aggregate().ID),
+			handler.NewCond(ProjectScopeColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *projectScopeProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-Sc9fz", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(ProjectScopeColumnChangeDate, e.CreationDate()),
+			handler.NewCol(ProjectScopeColumnSequence, e.Sequence()),
+			handler.NewCol(ProjectScopeColumnOwnerRemoved, true),
+		},
+		[]handler.Condition{
+			handler.NewCond(ProjectScopeColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(ProjectScopeColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
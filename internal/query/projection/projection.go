@@ -17,58 +17,72 @@ const (
 )
 
 var (
-	projectionConfig                    crdb.StatementHandlerConfig
-	OrgProjection                       *orgProjection
-	OrgMetadataProjection               *orgMetadataProjection
-	ActionProjection                    *actionProjection
-	FlowProjection                      *flowProjection
-	ProjectProjection                   *projectProjection
-	PasswordComplexityProjection        *passwordComplexityProjection
-	PasswordAgeProjection               *passwordAgeProjection
-	LockoutPolicyProjection             *lockoutPolicyProjection
-	PrivacyPolicyProjection             *privacyPolicyProjection
-	DomainPolicyProjection              *domainPolicyProjection
-	LabelPolicyProjection               *labelPolicyProjection
-	ProjectGrantProjection              *projectGrantProjection
-	ProjectRoleProjection               *projectRoleProjection
-	OrgDomainProjection                 *orgDomainProjection
-	LoginPolicyProjection               *loginPolicyProjection
-	IDPProjection                       *idpProjection
-	AppProjection                       *appProjection
-	IDPUserLinkProjection               *idpUserLinkProjection
-	IDPLoginPolicyLinkProjection        *idpLoginPolicyLinkProjection
-	IDPTemplateProjection               *idpTemplateProjection
-	MailTemplateProjection              *mailTemplateProjection
-	MessageTextProjection               *messageTextProjection
-	CustomTextProjection                *customTextProjection
-	UserProjection                      *userProjection
-	LoginNameProjection                 *loginNameProjection
-	OrgMemberProjection                 *orgMemberProjection
-	InstanceDomainProjection            *instanceDomainProjection
-	InstanceMemberProjection            *instanceMemberProjection
-	ProjectMemberProjection             *projectMemberProjection
-	ProjectGrantMemberProjection        *projectGrantMemberProjection
-	AuthNKeyProjection                  *authNKeyProjection
-	PersonalAccessTokenProjection       *personalAccessTokenProjection
-	UserGrantProjection                 *userGrantProjection
-	UserMetadataProjection              *userMetadataProjection
-	UserAuthMethodProjection            *userAuthMethodProjection
-	InstanceProjection                  *instanceProjection
-	SecretGeneratorProjection           *secretGeneratorProjection
-	SMTPConfigProjection                *smtpConfigProjection
-	SMSConfigProjection                 *smsConfigProjection
-	OIDCSettingsProjection              *oidcSettingsProjection
-	DebugNotificationProviderProjection *debugNotificationProviderProjection
-	KeyProjection                       *keyProjection
-	SecurityPolicyProjection            *securityPolicyProjection
-	NotificationPolicyProjection        *notificationPolicyProjection
-	NotificationsProjection             interface{}
-	NotificationsQuotaProjection        interface{}
-	TelemetryPusherProjection           interface{}
-	DeviceAuthProjection                *deviceAuthProjection
-	SessionProjection                   *sessionProjection
-	AuthRequestProjection               *authRequestProjection
-	MilestoneProjection                 *milestoneProjection
+	projectionConfig                         crdb.StatementHandlerConfig
+	OrgProjection                            *orgProjection
+	OrgMetadataProjection                    *orgMetadataProjection
+	ActionProjection                         *actionProjection
+	FlowProjection                           *flowProjection
+	ProjectProjection                        *projectProjection
+	PasswordComplexityProjection             *passwordComplexityProjection
+	PasswordAgeProjection                    *passwordAgeProjection
+	LockoutPolicyProjection                  *lockoutPolicyProjection
+	PrivacyPolicyProjection                  *privacyPolicyProjection
+	DomainPolicyProjection                   *domainPolicyProjection
+	LabelPolicyProjection                    *labelPolicyProjection
+	ProjectGrantProjection                   *projectGrantProjection
+	ProjectRoleProjection                    *projectRoleProjection
+	ProjectScopeProjection                   *projectScopeProjection
+	ProjectAuthorizationDetailTypeProjection *projectAuthorizationDetailTypeProjection
+	OrgDomainProjection                      *orgDomainProjection
+	LoginPolicyProjection                    *loginPolicyProjection
+	IDPProjection                            *idpProjection
+	AppProjection                            *appProjection
+	IDPUserLinkProjection                    *idpUserLinkProjection
+	IDPLoginPolicyLinkProjection             *idpLoginPolicyLinkProjection
+	IDPTemplateProjection                    *idpTemplateProjection
+	MailTemplateProjection                   *mailTemplateProjection
+	MessageTextProjection                    *messageTextProjection
+	CustomTextProjection                     *customTextProjection
+	UserProjection                           *userProjection
+	LoginNameProjection                      *loginNameProjection
+	OrgMemberProjection                      *orgMemberProjection
+	InstanceDomainProjection                 *instanceDomainProjection
+	InstanceMemberProjection                 *instanceMemberProjection
+	ProjectMemberProjection                  *projectMemberProjection
+	ProjectGrantMemberProjection             *projectGrantMemberProjection
+	AuthNKeyProjection                       *authNKeyProjection
+	PersonalAccessTokenProjection            *personalAccessTokenProjection
+	UserGrantProjection                      *userGrantProjection
+	UserGrantRequestProjection               *userGrantRequestProjection
+	GroupProjection                          *groupProjection
+	GroupUserProjection                      *groupUserProjection
+	GroupGrantProjection                     *groupGrantProjection
+	GroupMembershipProjection                *groupMembershipProjection
+	UserMetadataProjection                   *userMetadataProjection
+	UserAuthMethodProjection                 *userAuthMethodProjection
+	TrustedDeviceProjection                  *trustedDeviceProjection
+	UserConsentProjection                    *userConsentProjection
+	UserSelfDeletionProjection               *userSelfDeletionProjection
+	UserLifecyclePolicyProjection            *userLifecyclePolicyProjection
+	UserActivityProjection                   *userActivityProjection
+	UserImportProjection                     *userImportProjection
+	InstanceProjection                       *instanceProjection
+	SecretGeneratorProjection                *secretGeneratorProjection
+	CustomRoleProjection                     *customRoleProjection
+	SMTPConfigProjection                     *smtpConfigProjection
+	SMSConfigProjection                      *smsConfigProjection
+	OIDCSettingsProjection                   *oidcSettingsProjection
+	DebugNotificationProviderProjection      *debugNotificationProviderProjection
+	KeyProjection                            *keyProjection
+	SecurityPolicyProjection                 *securityPolicyProjection
+	NotificationPolicyProjection             *notificationPolicyProjection
+	NotificationsProjection                  interface{}
+	NotificationsQuotaProjection             interface{}
+	TelemetryPusherProjection                interface{}
+	DeviceAuthProjection                     *deviceAuthProjection
+	SessionProjection                        *sessionProjection
+	AuthRequestProjection                    *authRequestProjection
+	MilestoneProjection                      *milestoneProjection
 )
 
 type projection interface {
@@ -98,7 +112,10 @@ func Create(ctx context.Context, sqlClient *database.DB, es *eventstore.Eventsto
 		FailedEventsTable: FailedEventsTable,
 		MaxFailureCount:   config.MaxFailureCount,
 		BulkLimit:         config.BulkLimit,
+
+		FailedEventsThreshold: config.FailedEventsThreshold,
 	}
+	registerFailedEventsMetrics()
 
 	OrgProjection = newOrgProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["orgs"]))
 	OrgMetadataProjection = newOrgMetadataProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["org_metadata"]))
@@ -113,6 +130,8 @@ func Create(ctx context.Context, sqlClient *database.DB, es *eventstore.Eventsto
 	LabelPolicyProjection = newLabelPolicyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["label_policy"]))
 	ProjectGrantProjection = newProjectGrantProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["project_grants"]))
 	ProjectRoleProjection = newProjectRoleProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["project_roles"]))
+	ProjectScopeProjection = newProjectScopeProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["project_scopes"]))
+	ProjectAuthorizationDetailTypeProjection = newProjectAuthorizationDetailTypeProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["project_authorization_detail_types"]))
 	OrgDomainProjection = newOrgDomainProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["org_domains"]))
 	LoginPolicyProjection = newLoginPolicyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["login_policies"]))
 	IDPProjection = newIDPProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["idps"]))
@@ -133,10 +152,22 @@ func Create(ctx context.Context, sqlClient *database.DB, es *eventstore.Eventsto
 	AuthNKeyProjection = newAuthNKeyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["authn_keys"]))
 	PersonalAccessTokenProjection = newPersonalAccessTokenProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["personal_access_tokens"]))
 	UserGrantProjection = newUserGrantProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_grants"]))
+	UserGrantRequestProjection = newUserGrantRequestProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_grant_requests"]))
+	GroupProjection = newGroupProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["groups"]))
+	GroupUserProjection = newGroupUserProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["group_users"]))
+	GroupGrantProjection = newGroupGrantProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["group_grants"]))
+	GroupMembershipProjection = newGroupMembershipProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["group_memberships"]))
 	UserMetadataProjection = newUserMetadataProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_metadata"]))
 	UserAuthMethodProjection = newUserAuthMethodProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_auth_method"]))
+	TrustedDeviceProjection = newTrustedDeviceProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["trusted_devices"]))
+	UserConsentProjection = newUserConsentProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_consents"]))
+	UserSelfDeletionProjection = newUserSelfDeletionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_self_deletions"]))
+	UserLifecyclePolicyProjection = newUserLifecyclePolicyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_lifecycle_policies"]))
+	UserActivityProjection = newUserActivityProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_activities"]))
+	UserImportProjection = newUserImportProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_import_jobs"]))
 	InstanceProjection = newInstanceProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["instances"]))
 	SecretGeneratorProjection = newSecretGeneratorProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["secret_generators"]))
+	CustomRoleProjection = newCustomRoleProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["custom_roles"]))
 	SMTPConfigProjection = newSMTPConfigProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["smtp_configs"]))
 	SMSConfigProjection = newSMSConfigProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["sms_config"]))
 	OIDCSettingsProjection = newOIDCSettingsProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["oidc_settings"]))
@@ -212,6 +243,8 @@ func newProjectionsList() {
 		LabelPolicyProjection,
 		ProjectGrantProjection,
 		ProjectRoleProjection,
+		ProjectScopeProjection,
+		ProjectAuthorizationDetailTypeProjection,
 		OrgDomainProjection,
 		LoginPolicyProjection,
 		IDPProjection,
@@ -232,10 +265,22 @@ func newProjectionsList() {
 		AuthNKeyProjection,
 		PersonalAccessTokenProjection,
 		UserGrantProjection,
+		UserGrantRequestProjection,
+		GroupProjection,
+		GroupUserProjection,
+		GroupGrantProjection,
+		GroupMembershipProjection,
 		UserMetadataProjection,
 		UserAuthMethodProjection,
+		TrustedDeviceProjection,
+		UserConsentProjection,
+		UserSelfDeletionProjection,
+		UserLifecyclePolicyProjection,
+		UserActivityProjection,
+		UserImportProjection,
 		InstanceProjection,
 		SecretGeneratorProjection,
+		CustomRoleProjection,
 		SMTPConfigProjection,
 		SMSConfigProjection,
 		OIDCSettingsProjection,
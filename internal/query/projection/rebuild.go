@@ -0,0 +1,70 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+)
+
+type rebuildableProjection interface {
+	projection
+	Name() string
+	StartRebuild(ctx context.Context, rebuildID string) error
+	Rebuild(ctx context.Context, rebuildID string, progress crdb.RebuildProgress) error
+	AbortRebuild(ctx context.Context) error
+	RebuildStatus(ctx context.Context) (*handler.Rebuild, error)
+}
+
+func rebuildable(projectionName string) (rebuildableProjection, error) {
+	for _, p := range projections {
+		r, ok := p.(rebuildableProjection)
+		if ok && r.Name() == projectionName {
+			return r, nil
+		}
+	}
+	return nil, errors.ThrowNotFound(nil, "PROJE-Qd7s3", "Errors.ProjectionName.Invalid")
+}
+
+// StartRebuild marks the rebuild of the projection as running.
+// The rebuild itself is executed by Rebuild
+func StartRebuild(ctx context.Context, projectionName, rebuildID string) error {
+	p, err := rebuildable(projectionName)
+	if err != nil {
+		return err
+	}
+	return p.StartRebuild(systemContext(ctx), rebuildID)
+}
+
+// Rebuild projects all events of a projection into shadow tables
+// and replaces the projection tables with them as soon as they caught up
+func Rebuild(ctx context.Context, projectionName, rebuildID string, progress crdb.RebuildProgress) error {
+	p, err := rebuildable(projectionName)
+	if err != nil {
+		return err
+	}
+	return p.Rebuild(systemContext(ctx), rebuildID, progress)
+}
+
+func AbortRebuild(ctx context.Context, projectionName string) error {
+	p, err := rebuildable(projectionName)
+	if err != nil {
+		return err
+	}
+	return p.AbortRebuild(systemContext(ctx))
+}
+
+func RebuildStatus(ctx context.Context, projectionName string) (*handler.Rebuild, error) {
+	p, err := rebuildable(projectionName)
+	if err != nil {
+		return nil, err
+	}
+	return p.RebuildStatus(systemContext(ctx))
+}
+
+// the state of the rebuilds is stored on the system aggregate, which does not belong to an instance
+func systemContext(ctx context.Context) context.Context {
+	return authz.WithInstanceID(ctx, "")
+}
@@ -86,6 +86,10 @@ func (p *smsConfigProjection) reducers() []handler.AggregateReducer {
 					Event:  instance.SMSConfigTwilioTokenChangedEventType,
 					Reduce: p.reduceSMSConfigTwilioTokenChanged,
 				},
+				{
+					Event:  instance.SMSConfigTwilioTokenReencryptedEventType,
+					Reduce: p.reduceSMSConfigTwilioTokenReencrypted,
+				},
 				{
 					Event:  instance.SMSConfigActivatedEventType,
 					Reduce: p.reduceSMSConfigActivated,
@@ -209,6 +213,25 @@ func (p *smsConfigProjection) reduceSMSConfigTwilioTokenChanged(event eventstore
 	), nil
 }
 
+func (p *smsConfigProjection) reduceSMSConfigTwilioTokenReencrypted(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.SMSConfigTwilioTokenReencryptedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Re4nc", "reduce.wrong.event.type %s", instance.SMSConfigTwilioTokenReencryptedEventType)
+	}
+
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SMSTwilioConfigColumnToken, e.Token),
+		},
+		[]handler.Condition{
+			handler.NewCond(SMSTwilioConfigColumnSMSID, e.ID),
+			handler.NewCond(SMSTwilioColumnInstanceID, e.Aggregate().InstanceID),
+		},
+		crdb.WithTableSuffix(smsTwilioTableSuffix),
+	), nil
+}
+
 func (p *smsConfigProjection) reduceSMSConfigActivated(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*instance.SMSConfigActivatedEvent)
 	if !ok {
@@ -172,6 +172,47 @@ func TestSMSProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "instance reduceSMSConfigTwilioTokenReencrypted",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.SMSConfigTwilioTokenReencryptedEventType),
+					instance.AggregateType,
+					[]byte(`{
+						"id": "id",
+						"token": {
+							"cryptoType": 0,
+							"algorithm": "RSA-265",
+							"keyId": "key-id",
+							"crypted": "Y3J5cHRlZA=="
+						}
+					}`),
+				), instance.SMSConfigTwilioTokenReencryptedEventMapper),
+			},
+			reduce: (&smsConfigProjection{}).reduceSMSConfigTwilioTokenReencrypted,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.sms_configs2_twilio SET token = $1 WHERE (sms_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "RSA-265",
+									KeyID:      "key-id",
+									Crypted:    []byte("crypted"),
+								},
+								"id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "instance reduceSMSConfigActivated",
 			args: args{
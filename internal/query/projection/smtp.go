@@ -74,6 +74,10 @@ func (p *smtpConfigProjection) reducers() []handler.AggregateReducer {
 					Event:  instance.SMTPConfigPasswordChangedEventType,
 					Reduce: p.reduceSMTPConfigPasswordChanged,
 				},
+				{
+					Event:  instance.SMTPConfigPasswordReencryptedEventType,
+					Reduce: p.reduceSMTPConfigPasswordReencrypted,
+				},
 				{
 					Event:  instance.InstanceRemovedEventType,
 					Reduce: reduceInstanceRemovedHelper(SMTPConfigColumnInstanceID),
@@ -160,3 +164,21 @@ func (p *smtpConfigProjection) reduceSMTPConfigPasswordChanged(event eventstore.
 		},
 	), nil
 }
+
+func (p *smtpConfigProjection) reduceSMTPConfigPasswordReencrypted(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.SMTPConfigPasswordReencryptedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Re3nc", "reduce.wrong.event.type %s", instance.SMTPConfigPasswordReencryptedEventType)
+	}
+
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SMTPConfigColumnSMTPPassword, e.Password),
+		},
+		[]handler.Condition{
+			handler.NewCond(SMTPConfigColumnAggregateID, e.Aggregate().ID),
+			handler.NewCond(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
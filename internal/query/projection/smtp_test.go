@@ -145,6 +145,40 @@ func TestSMTPConfigProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reduceSMTPConfigPasswordReencrypted",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.SMTPConfigPasswordReencryptedEventType),
+					instance.AggregateType,
+					[]byte(`{
+						"password": {
+							"cryptoType": 0,
+							"algorithm": "RSA-265",
+							"keyId": "key-id"
+						}
+					}`),
+				), instance.SMTPConfigPasswordReencryptedEventMapper),
+			},
+			reduce: (&smtpConfigProjection{}).reduceSMTPConfigPasswordReencrypted,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.smtp_configs SET password = $1 WHERE (aggregate_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "instance reduceInstanceRemoved",
 			args: args{
@@ -0,0 +1,438 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+const (
+	UserActivityProjectionTable = "projections.user_activities"
+
+	UserActivityColumnUserID           = "user_id"
+	UserActivityColumnCreationDate     = "creation_date"
+	UserActivityColumnChangeDate       = "change_date"
+	UserActivityColumnSequence         = "sequence"
+	UserActivityColumnResourceOwner    = "resource_owner"
+	UserActivityColumnInstanceID       = "instance_id"
+	UserActivityColumnState            = "state"
+	UserActivityColumnLastLogin        = "last_login"
+	UserActivityColumnLastActivity     = "last_activity"
+	UserActivityColumnDeactivationDate = "deactivation_date"
+	UserActivityColumnNotifiedAction   = "notified_action"
+	UserActivityColumnOwnerRemoved     = "owner_removed"
+
+	UserActivitySessionSuffix = "sessions"
+	UserActivitySessionTable  = UserActivityProjectionTable + "_" + UserActivitySessionSuffix
+
+	UserActivitySessionColumnSessionID  = "session_id"
+	UserActivitySessionColumnInstanceID = "instance_id"
+	UserActivitySessionColumnUserID     = "user_id"
+)
+
+// userActivityProjection keeps track of the last successful login of human users,
+// which is the base of the inactivity deactivation of the user lifecycle policy.
+// As the checks of the session API (v2) are stored on the session aggregate without the user,
+// the user of each session is kept in a separate table.
+type userActivityProjection struct {
+	crdb.StatementHandler
+}
+
+func newUserActivityProjection(ctx context.Context, config crdb.StatementHandlerConfig) *userActivityProjection {
+	p := new(userActivityProjection)
+	config.ProjectionName = UserActivityProjectionTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewMultiTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(UserActivityColumnUserID, crdb.ColumnTypeText),
+			crdb.NewColumn(UserActivityColumnCreationDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(UserActivityColumnChangeDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(UserActivityColumnSequence, crdb.ColumnTypeInt64),
+			crdb.NewColumn(UserActivityColumnResourceOwner, crdb.ColumnTypeText),
+			crdb.NewColumn(UserActivityColumnInstanceID, crdb.ColumnTypeText),
+			crdb.NewColumn(UserActivityColumnState, crdb.ColumnTypeEnum),
+			crdb.NewColumn(UserActivityColumnLastLogin, crdb.ColumnTypeTimestamp, crdb.Nullable()),
+			crdb.NewColumn(UserActivityColumnLastActivity, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(UserActivityColumnDeactivationDate, crdb.ColumnTypeTimestamp, crdb.Nullable()),
+			crdb.NewColumn(UserActivityColumnNotifiedAction, crdb.ColumnTypeEnum, crdb.Default(0)),
+			crdb.NewColumn(UserActivityColumnOwnerRemoved, crdb.ColumnTypeBool, crdb.Default(false)),
+		},
+			crdb.NewPrimaryKey(UserActivityColumnInstanceID, UserActivityColumnUserID),
+			crdb.WithIndex(crdb.NewIndex("resource_owner", []string{UserActivityColumnResourceOwner})),
+			crdb.WithIndex(crdb.NewIndex("owner_removed", []string{UserActivityColumnOwnerRemoved})),
+		),
+		crdb.NewSuffixedTable([]*crdb.Column{
+			crdb.NewColumn(UserActivitySessionColumnSessionID, crdb.ColumnTypeText),
+			crdb.NewColumn(UserActivitySessionColumnInstanceID, crdb.ColumnTypeText),
+			crdb.NewColumn(UserActivitySessionColumnUserID, crdb.ColumnTypeText),
+		},
+			crdb.NewPrimaryKey(UserActivitySessionColumnInstanceID, UserActivitySessionColumnSessionID),
+			UserActivitySessionSuffix,
+			crdb.WithIndex(crdb.NewIndex("user_id", []string{UserActivitySessionColumnUserID})),
+		),
+	)
+
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *userActivityProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  user.UserV1AddedType,
+					Reduce: p.reduceHumanAdded,
+				},
+				{
+					Event:  user.HumanAddedType,
+					Reduce: p.reduceHumanAdded,
+				},
+				{
+					Event:  user.UserV1RegisteredType,
+					Reduce: p.reduceHumanAdded,
+				},
+				{
+					Event:  user.HumanRegisteredType,
+					Reduce: p.reduceHumanAdded,
+				},
+				{
+					Event:  user.UserV1InitialCodeAddedType,
+					Reduce: p.reduceInitCodeAdded,
+				},
+				{
+					Event:  user.HumanInitialCodeAddedType,
+					Reduce: p.reduceInitCodeAdded,
+				},
+				{
+					Event:  user.UserV1InitializedCheckSucceededType,
+					Reduce: p.reduceInitCodeSucceeded,
+				},
+				{
+					Event:  user.HumanInitializedCheckSucceededType,
+					Reduce: p.reduceInitCodeSucceeded,
+				},
+				{
+					Event:  user.UserV1PasswordCheckSucceededType,
+					Reduce: p.reduceLoginSucceeded,
+				},
+				{
+					Event:  user.HumanPasswordCheckSucceededType,
+					Reduce: p.reduceLoginSucceeded,
+				},
+				{
+					Event:  user.HumanPasswordlessTokenCheckSucceededType,
+					Reduce: p.reduceLoginSucceeded,
+				},
+				{
+					Event:  user.UserIDPLoginCheckSucceededType,
+					Reduce: p.reduceLoginSucceeded,
+				},
+				{
+					Event:  user.UserDeactivatedType,
+					Reduce: p.reduceUserDeactivated,
+				},
+				{
+					Event:  user.UserReactivatedType,
+					Reduce: p.reduceUserReactivated,
+				},
+				{
+					Event:  user.HumanLifecycleNotificationAddedType,
+					Reduce: p.reduceLifecycleNotificationAdded,
+				},
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: session.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  session.UserCheckedType,
+					Reduce: p.reduceSessionUserChecked,
+				},
+				{
+					Event:  session.PasswordCheckedType,
+					Reduce: p.reduceSessionChecked,
+				},
+				{
+					Event:  session.IntentCheckedType,
+					Reduce: p.reduceSessionChecked,
+				},
+				{
+					Event:  session.WebAuthNCheckedType,
+					Reduce: p.reduceSessionChecked,
+				},
+				{
+					Event:  session.TerminateType,
+					Reduce: p.reduceSessionTerminated,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: p.reduceInstanceRemoved,
+				},
+			},
+		},
+	}
+}
+
+func (p *userActivityProjection) reduceHumanAdded(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *user.HumanAddedEvent, *user.HumanRegisteredEvent:
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Ua1hd", "reduce.wrong.event.type %v", []eventstore.EventType{user.HumanAddedType, user.HumanRegisteredType})
+	}
+	return crdb.NewCreateStatement(
+		event,
+		[]handler.Column{
+			handler.NewCol(UserActivityColumnUserID, event.Aggregate().ID),
+			handler.NewCol(UserActivityColumnCreationDate, event.CreationDate()),
+			handler.NewCol(UserActivityColumnChangeDate, event.CreationDate()),
+			handler.NewCol(UserActivityColumnSequence, event.Sequence()),
+			handler.NewCol(UserActivityColumnResourceOwner, event.Aggregate().ResourceOwner),
+			handler.NewCol(UserActivityColumnInstanceID, event.Aggregate().InstanceID),
+			handler.NewCol(UserActivityColumnState, domain.UserStateActive),
+			handler.NewCol(UserActivityColumnLastActivity, event.CreationDate()),
+		},
+	), nil
+}
+
+func (p *userActivityProjection) reduceInitCodeAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanInitialCodeAddedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Ua2ic", "reduce.wrong.event.type %s", user.HumanInitialCodeAddedType)
+	}
+	return p.updateActivity(e, handler.NewCol(UserActivityColumnState, domain.UserStateInitial)), nil
+}
+
+func (p *userActivityProjection) reduceInitCodeSucceeded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanInitializedCheckSucceededEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Ua3is", "reduce.wrong.event.type %s", user.HumanInitializedCheckSucceededType)
+	}
+	return p.updateActivity(e,
+		handler.NewCol(UserActivityColumnState, domain.UserStateActive),
+		handler.NewCol(UserActivityColumnLastActivity, e.CreationDate()),
+	), nil
+}
+
+func (p *userActivityProjection) reduceLoginSucceeded(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *user.HumanPasswordCheckSucceededEvent,
+		*user.HumanPasswordlessCheckSucceededEvent,
+		*user.UserIDPCheckSucceededEvent:
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Ua4ls", "reduce.wrong.event.type %v", []eventstore.EventType{user.HumanPasswordCheckSucceededType, user.HumanPasswordlessTokenCheckSucceededType, user.UserIDPLoginCheckSucceededType})
+	}
+	return p.updateActivity(event,
+		handler.NewCol(UserActivityColumnLastLogin, event.CreationDate()),
+		handler.NewCol(UserActivityColumnLastActivity, event.CreationDate()),
+		handler.NewCol(UserActivityColumnNotifiedAction, domain.UserLifecycleActionUnspecified),
+	), nil
+}
+
+func (p *userActivityProjection) reduceUserDeactivated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserDeactivatedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Ua5de", "reduce.wrong.event.type %s", user.UserDeactivatedType)
+	}
+	return p.updateActivity(e,
+		handler.NewCol(UserActivityColumnState, domain.UserStateInactive),
+		handler.NewCol(UserActivityColumnDeactivationDate, e.CreationDate()),
+		handler.NewCol(UserActivityColumnNotifiedAction, domain.UserLifecycleActionUnspecified),
+	), nil
+}
+
+func (p *userActivityProjection) reduceUserReactivated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserReactivatedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Ua6re", "reduce.wrong.event.type %s", user.UserReactivatedType)
+	}
+	return p.updateActivity(e,
+		handler.NewCol(UserActivityColumnState, domain.UserStateActive),
+		handler.NewCol(UserActivityColumnLastActivity, e.CreationDate()),
+		handler.NewCol(UserActivityColumnDeactivationDate, nil),
+		handler.NewCol(UserActivityColumnNotifiedAction, domain.UserLifecycleActionUnspecified),
+	), nil
+}
+
+func (p *userActivityProjection) reduceLifecycleNotificationAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanLifecycleNotificationAddedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Ua7na", "reduce.wrong.event.type %s", user.HumanLifecycleNotificationAddedType)
+	}
+	return p.updateActivity(e, handler.NewCol(UserActivityColumnNotifiedAction, e.Action)), nil
+}
+
+func (p *userActivityProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Ua8rm", "reduce.wrong.event.type %s", user.UserRemovedType)
+	}
+	return crdb.NewMultiStatement(
+		e,
+		crdb.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(UserActivityColumnUserID, e.Aggregate().ID),
+				handler.NewCond(UserActivityColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
+		crdb.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(UserActivitySessionColumnUserID, e.Aggregate().ID),
+				handler.NewCond(UserActivitySessionColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			crdb.WithTableSuffix(UserActivitySessionSuffix),
+		),
+	), nil
+}
+
+func (p *userActivityProjection) reduceSessionUserChecked(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.UserCheckedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Ua1su", "reduce.wrong.event.type %s", session.UserCheckedType)
+	}
+	return crdb.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserActivitySessionColumnInstanceID, nil),
+			handler.NewCol(UserActivitySessionColumnSessionID, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(UserActivitySessionColumnSessionID, e.Aggregate().ID),
+			handler.NewCol(UserActivitySessionColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(UserActivitySessionColumnUserID, e.UserID),
+		},
+		crdb.WithTableSuffix(UserActivitySessionSuffix),
+	), nil
+}
+
+// reduceSessionChecked updates the last login of the user of the session,
+// which was set by a previous [session.UserCheckedEvent]
+func (p *userActivityProjection) reduceSessionChecked(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *session.PasswordCheckedEvent,
+		*session.IntentCheckedEvent,
+		*session.WebAuthNCheckedEvent:
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Ua2sc", "reduce.wrong.event.type %v", []eventstore.EventType{session.PasswordCheckedType, session.IntentCheckedType, session.WebAuthNCheckedType})
+	}
+	return crdb.NewUpdateStatement(
+		event,
+		[]handler.Column{
+			handler.NewCol(UserActivityColumnChangeDate, event.CreationDate()),
+			handler.NewCol(UserActivityColumnSequence, event.Sequence()),
+			handler.NewCol(UserActivityColumnLastLogin, event.CreationDate()),
+			handler.NewCol(UserActivityColumnLastActivity, event.CreationDate()),
+			handler.NewCol(UserActivityColumnNotifiedAction, domain.UserLifecycleActionUnspecified),
+		},
+		[]handler.Condition{
+			newUserActivitySessionUserCond(event.Aggregate().ID),
+			handler.NewCond(UserActivityColumnInstanceID, event.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+// newUserActivitySessionUserCond selects the user of the session in the same instance
+func newUserActivitySessionUserCond(sessionID string) handler.Condition {
+	return func(param string) (string, interface{}) {
+		return UserActivityColumnUserID + " = (SELECT s." + UserActivitySessionColumnUserID +
+			" FROM " + UserActivitySessionTable + " s WHERE s." + UserActivitySessionColumnSessionID + " = " + param +
+			" AND s." + UserActivitySessionColumnInstanceID + " = " + UserActivityProjectionTable + "." + UserActivityColumnInstanceID + ")", sessionID
+	}
+}
+
+func (p *userActivityProjection) reduceSessionTerminated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.TerminateEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Ua3st", "reduce.wrong.event.type %s", session.TerminateType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserActivitySessionColumnSessionID, e.Aggregate().ID),
+			handler.NewCond(UserActivitySessionColumnInstanceID, e.Aggregate().InstanceID),
+		},
+		crdb.WithTableSuffix(UserActivitySessionSuffix),
+	), nil
+}
+
+func (p *userActivityProjection) reduceInstanceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.InstanceRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Ua4ir", "reduce.wrong.event.type %s", instance.InstanceRemovedEventType)
+	}
+	return crdb.NewMultiStatement(
+		e,
+		crdb.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(UserActivityColumnInstanceID, e.Aggregate().ID),
+			},
+		),
+		crdb.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(UserActivitySessionColumnInstanceID, e.Aggregate().ID),
+			},
+			crdb.WithTableSuffix(UserActivitySessionSuffix),
+		),
+	), nil
+}
+
+func (p *userActivityProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-Ua9or", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserActivityColumnChangeDate, e.CreationDate()),
+			handler.NewCol(UserActivityColumnSequence, e.Sequence()),
+			handler.NewCol(UserActivityColumnOwnerRemoved, true),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserActivityColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserActivityColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *userActivityProjection) updateActivity(event eventstore.Event, cols ...handler.Column) *handler.Statement {
+	return crdb.NewUpdateStatement(
+		event,
+		append([]handler.Column{
+			handler.NewCol(UserActivityColumnChangeDate, event.CreationDate()),
+			handler.NewCol(UserActivityColumnSequence, event.Sequence()),
+		}, cols...),
+		[]handler.Condition{
+			handler.NewCond(UserActivityColumnUserID, event.Aggregate().ID),
+			handler.NewCond(UserActivityColumnInstanceID, event.Aggregate().InstanceID),
+		},
+	)
+}
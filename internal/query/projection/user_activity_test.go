@@ -0,0 +1,485 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+func TestUserActivityProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceHumanAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.HumanAddedType),
+					user.AggregateType,
+					[]byte(`{"userName": "username"}`),
+				), user.HumanAddedEventMapper),
+			},
+			reduce: (&userActivityProjection{}).reduceHumanAdded,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("user"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.user_activities (user_id, creation_date, change_date, sequence, resource_owner, instance_id, state, last_activity) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								domain.UserStateActive,
+								anyArg{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceInitCodeAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.HumanInitialCodeAddedType),
+					user.AggregateType,
+					[]byte(`{}`),
+				), user.HumanInitialCodeAddedEventMapper),
+			},
+			reduce: (&userActivityProjection{}).reduceInitCodeAdded,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("user"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_activities SET (change_date, sequence, state) = ($1, $2, $3) WHERE (user_id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.UserStateInitial,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceLoginSucceeded password",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.HumanPasswordCheckSucceededType),
+					user.AggregateType,
+					[]byte(`{}`),
+				), user.HumanPasswordCheckSucceededEventMapper),
+			},
+			reduce: (&userActivityProjection{}).reduceLoginSucceeded,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("user"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_activities SET (change_date, sequence, last_login, last_activity, notified_action) = ($1, $2, $3, $4, $5) WHERE (user_id = $6) AND (instance_id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								anyArg{},
+								anyArg{},
+								domain.UserLifecycleActionUnspecified,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceLoginSucceeded idp",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.UserIDPLoginCheckSucceededType),
+					user.AggregateType,
+					[]byte(`{}`),
+				), user.UserIDPCheckSucceededEventMapper),
+			},
+			reduce: (&userActivityProjection{}).reduceLoginSucceeded,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("user"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_activities SET (change_date, sequence, last_login, last_activity, notified_action) = ($1, $2, $3, $4, $5) WHERE (user_id = $6) AND (instance_id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								anyArg{},
+								anyArg{},
+								domain.UserLifecycleActionUnspecified,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserDeactivated",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.UserDeactivatedType),
+					user.AggregateType,
+					nil,
+				), user.UserDeactivatedEventMapper),
+			},
+			reduce: (&userActivityProjection{}).reduceUserDeactivated,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("user"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_activities SET (change_date, sequence, state, deactivation_date, notified_action) = ($1, $2, $3, $4, $5) WHERE (user_id = $6) AND (instance_id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.UserStateInactive,
+								anyArg{},
+								domain.UserLifecycleActionUnspecified,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserReactivated",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.UserReactivatedType),
+					user.AggregateType,
+					nil,
+				), user.UserReactivatedEventMapper),
+			},
+			reduce: (&userActivityProjection{}).reduceUserReactivated,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("user"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_activities SET (change_date, sequence, state, last_activity, deactivation_date, notified_action) = ($1, $2, $3, $4, $5, $6) WHERE (user_id = $7) AND (instance_id = $8)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.UserStateActive,
+								anyArg{},
+								nil,
+								domain.UserLifecycleActionUnspecified,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceLifecycleNotificationAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.HumanLifecycleNotificationAddedType),
+					user.AggregateType,
+					[]byte(`{"action": 1, "scheduledAt": "2023-08-01T12:00:00Z"}`),
+				), user.HumanLifecycleNotificationAddedEventMapper),
+			},
+			reduce: (&userActivityProjection{}).reduceLifecycleNotificationAdded,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("user"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_activities SET (change_date, sequence, notified_action) = ($1, $2, $3) WHERE (user_id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.UserLifecycleActionNotifyDeactivation,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.UserRemovedType),
+					user.AggregateType,
+					nil,
+				), user.UserRemovedEventMapper),
+			},
+			reduce: (&userActivityProjection{}).reduceUserRemoved,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("user"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_activities WHERE (user_id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.user_activities_sessions WHERE (user_id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "session reduceSessionUserChecked",
+			args: args{
+				event: getEvent(testEvent(
+					session.UserCheckedType,
+					session.AggregateType,
+					[]byte(`{
+						"userId": "user-id",
+						"checkedAt": "2023-05-04T00:00:00Z"
+					}`),
+				), session.UserCheckedEventMapper),
+			},
+			reduce: (&userActivityProjection{}).reduceSessionUserChecked,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("session"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.user_activities_sessions (session_id, instance_id, user_id) VALUES ($1, $2, $3) ON CONFLICT (instance_id, session_id) DO UPDATE SET user_id = EXCLUDED.user_id",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+								"user-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "session reduceSessionChecked password",
+			args: args{
+				event: getEvent(testEvent(
+					session.PasswordCheckedType,
+					session.AggregateType,
+					[]byte(`{
+						"checkedAt": "2023-05-04T00:00:00Z"
+					}`),
+				), session.PasswordCheckedEventMapper),
+			},
+			reduce: (&userActivityProjection{}).reduceSessionChecked,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("session"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_activities SET (change_date, sequence, last_login, last_activity, notified_action) = ($1, $2, $3, $4, $5) WHERE (user_id = (SELECT s.user_id FROM projections.user_activities_sessions s WHERE s.session_id = $6 AND s.instance_id = projections.user_activities.instance_id)) AND (instance_id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								anyArg{},
+								anyArg{},
+								domain.UserLifecycleActionUnspecified,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "session reduceSessionChecked intent",
+			args: args{
+				event: getEvent(testEvent(
+					session.IntentCheckedType,
+					session.AggregateType,
+					[]byte(`{
+						"checkedAt": "2023-05-04T00:00:00Z"
+					}`),
+				), session.IntentCheckedEventMapper),
+			},
+			reduce: (&userActivityProjection{}).reduceSessionChecked,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("session"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_activities SET (change_date, sequence, last_login, last_activity, notified_action) = ($1, $2, $3, $4, $5) WHERE (user_id = (SELECT s.user_id FROM projections.user_activities_sessions s WHERE s.session_id = $6 AND s.instance_id = projections.user_activities.instance_id)) AND (instance_id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								anyArg{},
+								anyArg{},
+								domain.UserLifecycleActionUnspecified,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "session reduceSessionTerminated",
+			args: args{
+				event: getEvent(testEvent(
+					session.TerminateType,
+					session.AggregateType,
+					[]byte(`{}`),
+				), session.TerminateEventMapper),
+			},
+			reduce: (&userActivityProjection{}).reduceSessionTerminated,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("session"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_activities_sessions WHERE (session_id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "org reduceOwnerRemoved",
+			reduce: (&userActivityProjection{}).reduceOwnerRemoved,
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(org.OrgRemovedEventType),
+					org.AggregateType,
+					nil,
+				), org.OrgRemovedEventMapper),
+			},
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("org"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_activities SET (change_date, sequence, owner_removed) = ($1, $2, $3) WHERE (instance_id = $4) AND (resource_owner = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								true,
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.InstanceRemovedEventType),
+					instance.AggregateType,
+					nil,
+				), instance.InstanceRemovedEventMapper),
+			},
+			reduce: (&userActivityProjection{}).reduceInstanceRemoved,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_activities WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.user_activities_sessions WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if _, ok := err.(errors.InvalidArgument); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, UserActivityProjectionTable, tt.want)
+		})
+	}
+}
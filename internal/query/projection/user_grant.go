@@ -2,6 +2,7 @@ package projection
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/database"
@@ -18,7 +19,7 @@ import (
 )
 
 const (
-	UserGrantProjectionTable = "projections.user_grants3"
+	UserGrantProjectionTable = "projections.user_grants4"
 
 	UserGrantID                   = "id"
 	UserGrantCreationDate         = "creation_date"
@@ -38,6 +39,8 @@ const (
 	UserGrantGrantedOrgRemoved    = "granted_org_removed"
 	UserGrantRoles                = "roles"
 	UserGrantOwnerRemoved         = "owner_removed"
+	UserGrantValidFrom            = "valid_from"
+	UserGrantValidUntil           = "valid_until"
 )
 
 type userGrantProjection struct {
@@ -68,6 +71,8 @@ func newUserGrantProjection(ctx context.Context, config crdb.StatementHandlerCon
 			crdb.NewColumn(UserGrantGrantedOrgRemoved, crdb.ColumnTypeBool, crdb.Default(false)),
 			crdb.NewColumn(UserGrantRoles, crdb.ColumnTypeTextArray, crdb.Nullable()),
 			crdb.NewColumn(UserGrantOwnerRemoved, crdb.ColumnTypeBool, crdb.Default(false)),
+			crdb.NewColumn(UserGrantValidFrom, crdb.ColumnTypeTimestamp, crdb.Nullable()),
+			crdb.NewColumn(UserGrantValidUntil, crdb.ColumnTypeTimestamp, crdb.Nullable()),
 		},
 			crdb.NewPrimaryKey(UserGrantInstanceID, UserGrantID),
 			crdb.WithIndex(crdb.NewIndex("user_id", []string{UserGrantUserID})),
@@ -116,6 +121,14 @@ func (p *userGrantProjection) reducers() []handler.AggregateReducer {
 					Event:  usergrant.UserGrantReactivatedType,
 					Reduce: p.reduceReactivated,
 				},
+				{
+					Event:  usergrant.UserGrantRequestApprovedType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  usergrant.UserGrantValiditySetType,
+					Reduce: p.reduceValiditySet,
+				},
 			},
 		},
 		{
@@ -174,52 +187,87 @@ func (p *userGrantProjection) reducers() []handler.AggregateReducer {
 }
 
 func (p *userGrantProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
-	e, ok := event.(*usergrant.UserGrantAddedEvent)
-	if !ok {
-		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-MQHVB", "reduce.wrong.event.type %s", usergrant.UserGrantAddedType)
+	var userID, projectID, projectGrantID string
+	var roleKeys []string
+	switch e := event.(type) {
+	case *usergrant.UserGrantAddedEvent:
+		userID, projectID, projectGrantID, roleKeys = e.UserID, e.ProjectID, e.ProjectGrantID, e.RoleKeys
+	case *usergrant.UserGrantRequestApprovedEvent:
+		userID, projectID, projectGrantID, roleKeys = e.UserID, e.ProjectID, e.ProjectGrantID, e.RoleKeys
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-MQHVB", "reduce.wrong.event.type %v", []eventstore.EventType{usergrant.UserGrantAddedType, usergrant.UserGrantRequestApprovedType})
 	}
 
-	ctx := setUserGrantContext(e.Aggregate())
-	userOwner, err := getResourceOwnerOfUser(ctx, p.Eventstore, e.Aggregate().InstanceID, e.UserID)
+	ctx := setUserGrantContext(event.Aggregate())
+	userOwner, err := getResourceOwnerOfUser(ctx, p.Eventstore, event.Aggregate().InstanceID, userID)
 	if err != nil {
 		return nil, err
 	}
 
 	projectOwner := ""
 	grantOwner := ""
-	if e.ProjectGrantID != "" {
-		grantOwner, err = getGrantedOrgOfGrantedProject(ctx, p.Eventstore, e.Aggregate().InstanceID, e.ProjectID, e.ProjectGrantID)
+	if projectGrantID != "" {
+		grantOwner, err = getGrantedOrgOfGrantedProject(ctx, p.Eventstore, event.Aggregate().InstanceID, projectID, projectGrantID)
 		if err != nil {
 			return nil, err
 		}
 	} else {
-		projectOwner, err = getResourceOwnerOfProject(ctx, p.Eventstore, e.Aggregate().InstanceID, e.ProjectID)
+		projectOwner, err = getResourceOwnerOfProject(ctx, p.Eventstore, event.Aggregate().InstanceID, projectID)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	return crdb.NewCreateStatement(
-		e,
+		event,
 		[]handler.Column{
-			handler.NewCol(UserGrantID, e.Aggregate().ID),
-			handler.NewCol(UserGrantResourceOwner, e.Aggregate().ResourceOwner),
-			handler.NewCol(UserGrantInstanceID, e.Aggregate().InstanceID),
-			handler.NewCol(UserGrantCreationDate, e.CreationDate()),
-			handler.NewCol(UserGrantChangeDate, e.CreationDate()),
-			handler.NewCol(UserGrantSequence, e.Sequence()),
-			handler.NewCol(UserGrantUserID, e.UserID),
+			handler.NewCol(UserGrantID, event.Aggregate().ID),
+			handler.NewCol(UserGrantResourceOwner, event.Aggregate().ResourceOwner),
+			handler.NewCol(UserGrantInstanceID, event.Aggregate().InstanceID),
+			handler.NewCol(UserGrantCreationDate, event.CreationDate()),
+			handler.NewCol(UserGrantChangeDate, event.CreationDate()),
+			handler.NewCol(UserGrantSequence, event.Sequence()),
+			handler.NewCol(UserGrantUserID, userID),
 			handler.NewCol(UserGrantResourceOwnerUser, userOwner),
-			handler.NewCol(UserGrantProjectID, e.ProjectID),
+			handler.NewCol(UserGrantProjectID, projectID),
 			handler.NewCol(UserGrantResourceOwnerProject, projectOwner),
-			handler.NewCol(UserGrantGrantID, e.ProjectGrantID),
+			handler.NewCol(UserGrantGrantID, projectGrantID),
 			handler.NewCol(UserGrantGrantedOrg, grantOwner),
-			handler.NewCol(UserGrantRoles, database.StringArray(e.RoleKeys)),
+			handler.NewCol(UserGrantRoles, database.StringArray(roleKeys)),
 			handler.NewCol(UserGrantState, domain.UserGrantStateActive),
 		},
 	), nil
 }
 
+func (p *userGrantProjection) reduceValiditySet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*usergrant.UserGrantValiditySetEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-Vq3mz", "reduce.wrong.event.type %s", usergrant.UserGrantValiditySetType)
+	}
+
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserGrantChangeDate, e.CreationDate()),
+			handler.NewCol(UserGrantSequence, e.Sequence()),
+			handler.NewCol(UserGrantValidFrom, nullableTime(e.ValidFrom)),
+			handler.NewCol(UserGrantValidUntil, nullableTime(e.ValidUntil)),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserGrantID, e.Aggregate().ID),
+			handler.NewCond(UserGrantInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+// nullableTime stores zero times as NULL
+func nullableTime(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return t
+}
+
 func (p *userGrantProjection) reduceChanged(event eventstore.Event) (*handler.Statement, error) {
 	var roles database.StringArray
 
@@ -0,0 +1,258 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+)
+
+const (
+	UserGrantRequestProjectionTable = "projections.user_grant_requests"
+
+	UserGrantRequestColumnID            = "id"
+	UserGrantRequestColumnCreationDate  = "creation_date"
+	UserGrantRequestColumnChangeDate    = "change_date"
+	UserGrantRequestColumnSequence      = "sequence"
+	UserGrantRequestColumnState         = "state"
+	UserGrantRequestColumnResourceOwner = "resource_owner"
+	UserGrantRequestColumnInstanceID    = "instance_id"
+	UserGrantRequestColumnUserID        = "user_id"
+	UserGrantRequestColumnProjectID     = "project_id"
+	UserGrantRequestColumnGrantID       = "grant_id"
+	UserGrantRequestColumnRoles         = "roles"
+	UserGrantRequestColumnReason        = "reason"
+	UserGrantRequestColumnDenialReason  = "denial_reason"
+	UserGrantRequestColumnOwnerRemoved  = "owner_removed"
+)
+
+type userGrantRequestProjection struct {
+	crdb.StatementHandler
+}
+
+func newUserGrantRequestProjection(ctx context.Context, config crdb.StatementHandlerConfig) *userGrantRequestProjection {
+	p := new(userGrantRequestProjection)
+	config.ProjectionName = UserGrantRequestProjectionTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(UserGrantRequestColumnID, crdb.ColumnTypeText),
+			crdb.NewColumn(UserGrantRequestColumnCreationDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(UserGrantRequestColumnChangeDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(UserGrantRequestColumnSequence, crdb.ColumnTypeInt64),
+			crdb.NewColumn(UserGrantRequestColumnState, crdb.ColumnTypeEnum),
+			crdb.NewColumn(UserGrantRequestColumnResourceOwner, crdb.ColumnTypeText),
+			crdb.NewColumn(UserGrantRequestColumnInstanceID, crdb.ColumnTypeText),
+			crdb.NewColumn(UserGrantRequestColumnUserID, crdb.ColumnTypeText),
+			crdb.NewColumn(UserGrantRequestColumnProjectID, crdb.ColumnTypeText),
+			crdb.NewColumn(UserGrantRequestColumnGrantID, crdb.ColumnTypeText),
+			crdb.NewColumn(UserGrantRequestColumnRoles, crdb.ColumnTypeTextArray, crdb.Nullable()),
+			crdb.NewColumn(UserGrantRequestColumnReason, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(UserGrantRequestColumnDenialReason, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(UserGrantRequestColumnOwnerRemoved, crdb.ColumnTypeBool, crdb.Default(false)),
+		},
+			crdb.NewPrimaryKey(UserGrantRequestColumnInstanceID, UserGrantRequestColumnID),
+			crdb.WithIndex(crdb.NewIndex("user_id", []string{UserGrantRequestColumnUserID})),
+			crdb.WithIndex(crdb.NewIndex("resource_owner", []string{UserGrantRequestColumnResourceOwner})),
+			crdb.WithIndex(crdb.NewIndex("owner_removed", []string{UserGrantRequestColumnOwnerRemoved})),
+		),
+	)
+
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *userGrantRequestProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: usergrant.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  usergrant.UserGrantRequestedType,
+					Reduce: p.reduceRequested,
+				},
+				{
+					Event:  usergrant.UserGrantRequestApprovedType,
+					Reduce: p.reduceApproved,
+				},
+				{
+					Event:  usergrant.UserGrantRequestDeniedType,
+					Reduce: p.reduceDenied,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: project.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  project.ProjectRemovedType,
+					Reduce: p.reduceProjectRemoved,
+				},
+				{
+					Event:  project.GrantRemovedType,
+					Reduce: p.reduceProjectGrantRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(UserGrantRequestColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *userGrantRequestProjection) reduceRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*usergrant.UserGrantRequestedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-Gr4qa", "reduce.wrong.event.type %s", usergrant.UserGrantRequestedType)
+	}
+	return crdb.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserGrantRequestColumnID, e.Aggregate().ID),
+			handler.NewCol(UserGrantRequestColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(UserGrantRequestColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(UserGrantRequestColumnCreationDate, e.CreationDate()),
+			handler.NewCol(UserGrantRequestColumnChangeDate, e.CreationDate()),
+			handler.NewCol(UserGrantRequestColumnSequence, e.Sequence()),
+			handler.NewCol(UserGrantRequestColumnState, domain.UserGrantRequestStateRequested),
+			handler.NewCol(UserGrantRequestColumnUserID, e.UserID),
+			handler.NewCol(UserGrantRequestColumnProjectID, e.ProjectID),
+			handler.NewCol(UserGrantRequestColumnGrantID, e.ProjectGrantID),
+			handler.NewCol(UserGrantRequestColumnRoles, database.StringArray(e.RoleKeys)),
+			handler.NewCol(UserGrantRequestColumnReason, e.Reason),
+		},
+	), nil
+}
+
+func (p *userGrantRequestProjection) reduceApproved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*usergrant.UserGrantRequestApprovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-Gr8wc", "reduce.wrong.event.type %s", usergrant.UserGrantRequestApprovedType)
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserGrantRequestColumnChangeDate, e.CreationDate()),
+			handler.NewCol(UserGrantRequestColumnSequence, e.Sequence()),
+			handler.NewCol(UserGrantRequestColumnState, domain.UserGrantRequestStateApproved),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserGrantRequestColumnID, e.Aggregate().ID),
+			handler.NewCond(UserGrantRequestColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *userGrantRequestProjection) reduceDenied(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*usergrant.UserGrantRequestDeniedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-Gr1dn", "reduce.wrong.event.type %s", usergrant.UserGrantRequestDeniedType)
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserGrantRequestColumnChangeDate, e.CreationDate()),
+			handler.NewCol(UserGrantRequestColumnSequence, e.Sequence()),
+			handler.NewCol(UserGrantRequestColumnState, domain.UserGrantRequestStateDenied),
+			handler.NewCol(UserGrantRequestColumnDenialReason, e.Reason),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserGrantRequestColumnID, e.Aggregate().ID),
+			handler.NewCond(UserGrantRequestColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *userGrantRequestProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-Gr6us", "reduce.wrong.event.type %s", user.UserRemovedType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserGrantRequestColumnUserID, e.Aggregate().ID),
+			handler.NewCond(UserGrantRequestColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *userGrantRequestProjection) reduceProjectRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*project.ProjectRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-Gr3pr", "reduce.wrong.event.type %s", project.ProjectRemovedType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserGrantRequestColumnProjectID, e.Aggregate().ID),
+			handler.NewCond(UserGrantRequestColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *userGrantRequestProjection) reduceProjectGrantRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*project.GrantRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-Gr5gr", "reduce.wrong.event.type %s", project.GrantRemovedType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserGrantRequestColumnGrantID, e.GrantID),
+			handler.NewCond(UserGrantRequestColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *userGrantRequestProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-Gr7or", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserGrantRequestColumnChangeDate, e.CreationDate()),
+			handler.NewCol(UserGrantRequestColumnSequence, e.Sequence()),
+			handler.NewCol(UserGrantRequestColumnOwnerRemoved, true),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserGrantRequestColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserGrantRequestColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,238 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/userimport"
+)
+
+const (
+	UserImportJobProjectionTable = "projections.user_import_jobs"
+	UserImportResultTable        = UserImportJobProjectionTable + "_" + UserImportResultSuffix
+	UserImportResultSuffix       = "results"
+
+	UserImportJobColumnID            = "id"
+	UserImportJobColumnCreationDate  = "creation_date"
+	UserImportJobColumnChangeDate    = "change_date"
+	UserImportJobColumnSequence      = "sequence"
+	UserImportJobColumnResourceOwner = "resource_owner"
+	UserImportJobColumnInstanceID    = "instance_id"
+	UserImportJobColumnCreator       = "creator"
+	UserImportJobColumnState         = "state"
+	UserImportJobColumnFormat        = "format"
+	UserImportJobColumnTotalRows     = "total_rows"
+	UserImportJobColumnProcessedRows = "processed_rows"
+	UserImportJobColumnCreatedRows   = "created_rows"
+	UserImportJobColumnUpdatedRows   = "updated_rows"
+	UserImportJobColumnFailedRows    = "failed_rows"
+
+	UserImportResultColumnJobID      = "job_id"
+	UserImportResultColumnInstanceID = "instance_id"
+	UserImportResultColumnRow        = "row_number"
+	UserImportResultColumnUserID     = "user_id"
+	UserImportResultColumnState      = "state"
+	UserImportResultColumnError      = "error"
+)
+
+type userImportProjection struct {
+	crdb.StatementHandler
+}
+
+func newUserImportProjection(ctx context.Context, config crdb.StatementHandlerConfig) *userImportProjection {
+	p := new(userImportProjection)
+	config.ProjectionName = UserImportJobProjectionTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewMultiTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(UserImportJobColumnID, crdb.ColumnTypeText),
+			crdb.NewColumn(UserImportJobColumnCreationDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(UserImportJobColumnChangeDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(UserImportJobColumnSequence, crdb.ColumnTypeInt64),
+			crdb.NewColumn(UserImportJobColumnResourceOwner, crdb.ColumnTypeText),
+			crdb.NewColumn(UserImportJobColumnInstanceID, crdb.ColumnTypeText),
+			crdb.NewColumn(UserImportJobColumnCreator, crdb.ColumnTypeText),
+			crdb.NewColumn(UserImportJobColumnState, crdb.ColumnTypeEnum),
+			crdb.NewColumn(UserImportJobColumnFormat, crdb.ColumnTypeEnum),
+			crdb.NewColumn(UserImportJobColumnTotalRows, crdb.ColumnTypeInt64),
+			crdb.NewColumn(UserImportJobColumnProcessedRows, crdb.ColumnTypeInt64, crdb.Default(0)),
+			crdb.NewColumn(UserImportJobColumnCreatedRows, crdb.ColumnTypeInt64, crdb.Default(0)),
+			crdb.NewColumn(UserImportJobColumnUpdatedRows, crdb.ColumnTypeInt64, crdb.Default(0)),
+			crdb.NewColumn(UserImportJobColumnFailedRows, crdb.ColumnTypeInt64, crdb.Default(0)),
+		},
+			crdb.NewPrimaryKey(UserImportJobColumnInstanceID, UserImportJobColumnID),
+			crdb.WithIndex(crdb.NewIndex("resource_owner", []string{UserImportJobColumnResourceOwner})),
+		),
+		crdb.NewSuffixedTable([]*crdb.Column{
+			crdb.NewColumn(UserImportResultColumnJobID, crdb.ColumnTypeText),
+			crdb.NewColumn(UserImportResultColumnInstanceID, crdb.ColumnTypeText),
+			crdb.NewColumn(UserImportResultColumnRow, crdb.ColumnTypeInt64),
+			crdb.NewColumn(UserImportResultColumnUserID, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(UserImportResultColumnState, crdb.ColumnTypeEnum),
+			crdb.NewColumn(UserImportResultColumnError, crdb.ColumnTypeText, crdb.Nullable()),
+		},
+			crdb.NewPrimaryKey(UserImportResultColumnInstanceID, UserImportResultColumnJobID, UserImportResultColumnRow),
+			UserImportResultSuffix,
+			crdb.WithForeignKey(crdb.NewForeignKey(
+				"job",
+				[]string{UserImportResultColumnInstanceID, UserImportResultColumnJobID},
+				[]string{UserImportJobColumnInstanceID, UserImportJobColumnID},
+			)),
+		),
+	)
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *userImportProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: userimport.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  userimport.AddedType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  userimport.BatchProcessedType,
+					Reduce: p.reduceBatchProcessed,
+				},
+				{
+					Event:  userimport.DoneType,
+					Reduce: p.reduceDone,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(UserImportJobColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *userImportProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*userimport.AddedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Ui1ad", "reduce.wrong.event.type %s", userimport.AddedType)
+	}
+	return crdb.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserImportJobColumnID, e.Aggregate().ID),
+			handler.NewCol(UserImportJobColumnCreationDate, e.CreationDate()),
+			handler.NewCol(UserImportJobColumnChangeDate, e.CreationDate()),
+			handler.NewCol(UserImportJobColumnSequence, e.Sequence()),
+			handler.NewCol(UserImportJobColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(UserImportJobColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(UserImportJobColumnCreator, e.EditorUser()),
+			handler.NewCol(UserImportJobColumnState, domain.UserImportJobStateRunning),
+			handler.NewCol(UserImportJobColumnFormat, e.Format),
+			handler.NewCol(UserImportJobColumnTotalRows, e.TotalRows),
+		},
+	), nil
+}
+
+func (p *userImportProjection) reduceBatchProcessed(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*userimport.BatchProcessedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Ui2bp", "reduce.wrong.event.type %s", userimport.BatchProcessedType)
+	}
+	var created, updated, failed uint64
+	for _, result := range e.Results {
+		switch result.State {
+		case domain.UserImportRowStateCreated:
+			created++
+		case domain.UserImportRowStateUpdated:
+			updated++
+		case domain.UserImportRowStateFailed:
+			failed++
+		}
+	}
+	stmts := make([]func(eventstore.Event) crdb.Exec, 0, len(e.Results)+1)
+	stmts = append(stmts, crdb.AddUpdateStatement(
+		[]handler.Column{
+			handler.NewCol(UserImportJobColumnChangeDate, e.CreationDate()),
+			handler.NewCol(UserImportJobColumnSequence, e.Sequence()),
+			crdb.NewIncrementCol(UserImportJobColumnProcessedRows, uint64(len(e.Results))),
+			crdb.NewIncrementCol(UserImportJobColumnCreatedRows, created),
+			crdb.NewIncrementCol(UserImportJobColumnUpdatedRows, updated),
+			crdb.NewIncrementCol(UserImportJobColumnFailedRows, failed),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserImportJobColumnID, e.Aggregate().ID),
+			handler.NewCond(UserImportJobColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	))
+	for _, result := range e.Results {
+		stmts = append(stmts, crdb.AddUpsertStatement(
+			[]handler.Column{
+				handler.NewCol(UserImportResultColumnInstanceID, nil),
+				handler.NewCol(UserImportResultColumnJobID, nil),
+				handler.NewCol(UserImportResultColumnRow, nil),
+			},
+			[]handler.Column{
+				handler.NewCol(UserImportResultColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(UserImportResultColumnJobID, e.Aggregate().ID),
+				handler.NewCol(UserImportResultColumnRow, result.Row),
+				handler.NewCol(UserImportResultColumnUserID, result.UserID),
+				handler.NewCol(UserImportResultColumnState, result.State),
+				handler.NewCol(UserImportResultColumnError, result.Error),
+			},
+			crdb.WithTableSuffix(UserImportResultSuffix),
+		))
+	}
+	return crdb.NewMultiStatement(e, stmts...), nil
+}
+
+func (p *userImportProjection) reduceDone(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*userimport.DoneEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Ui3dn", "reduce.wrong.event.type %s", userimport.DoneType)
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserImportJobColumnChangeDate, e.CreationDate()),
+			handler.NewCol(UserImportJobColumnSequence, e.Sequence()),
+			handler.NewCol(UserImportJobColumnState, domain.UserImportJobStateDone),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserImportJobColumnID, e.Aggregate().ID),
+			handler.NewCond(UserImportJobColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *userImportProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Ui4or", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserImportJobColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserImportJobColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
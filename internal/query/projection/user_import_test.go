@@ -0,0 +1,213 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/userimport"
+)
+
+func TestUserImportProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(userimport.AddedType),
+					userimport.AggregateType,
+					[]byte(`{"format": 2, "totalRows": 150, "batchCount": 2}`),
+				), eventstore.GenericEventMapper[userimport.AddedEvent]),
+			},
+			reduce: (&userImportProjection{}).reduceAdded,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("user_import"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.user_import_jobs (id, creation_date, change_date, sequence, resource_owner, instance_id, creator, state, format, total_rows) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								"editor-user",
+								domain.UserImportJobStateRunning,
+								domain.UserImportFormatCSV,
+								uint64(150),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceBatchProcessed",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(userimport.BatchProcessedType),
+					userimport.AggregateType,
+					[]byte(`{"batch": 1, "results": [{"row": 2, "userId": "user1", "state": 1}, {"row": 3, "state": 3, "error": "Errors.UserImport.InvalidRow"}]}`),
+				), eventstore.GenericEventMapper[userimport.BatchProcessedEvent]),
+			},
+			reduce: (&userImportProjection{}).reduceBatchProcessed,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("user_import"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_import_jobs SET (change_date, sequence, processed_rows, created_rows, updated_rows, failed_rows) = ($1, $2, processed_rows + $3, created_rows + $4, updated_rows + $5, failed_rows + $6) WHERE (id = $7) AND (instance_id = $8)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								uint64(2),
+								uint64(1),
+								uint64(0),
+								uint64(1),
+								"agg-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.user_import_jobs_results (instance_id, job_id, row_number, user_id, state, error) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (instance_id, job_id, row_number) DO UPDATE SET (user_id, state, error) = (EXCLUDED.user_id, EXCLUDED.state, EXCLUDED.error)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								uint64(2),
+								"user1",
+								domain.UserImportRowStateCreated,
+								"",
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.user_import_jobs_results (instance_id, job_id, row_number, user_id, state, error) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (instance_id, job_id, row_number) DO UPDATE SET (user_id, state, error) = (EXCLUDED.user_id, EXCLUDED.state, EXCLUDED.error)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								uint64(3),
+								"",
+								domain.UserImportRowStateFailed,
+								"Errors.UserImport.InvalidRow",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDone",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(userimport.DoneType),
+					userimport.AggregateType,
+					nil,
+				), eventstore.GenericEventMapper[userimport.DoneEvent]),
+			},
+			reduce: (&userImportProjection{}).reduceDone,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("user_import"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_import_jobs SET (change_date, sequence, state) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.UserImportJobStateDone,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceOwnerRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(org.OrgRemovedEventType),
+					org.AggregateType,
+					nil,
+				), org.OrgRemovedEventMapper),
+			},
+			reduce: (&userImportProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("org"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_import_jobs WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.InstanceRemovedEventType),
+					instance.AggregateType,
+					nil,
+				), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(UserImportJobColumnInstanceID),
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_import_jobs WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if _, ok := err.(errors.InvalidArgument); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, UserImportJobProjectionTable, tt.want)
+		})
+	}
+}
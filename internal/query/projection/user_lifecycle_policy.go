@@ -0,0 +1,200 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+const (
+	UserLifecyclePolicyTable = "projections.user_lifecycle_policies"
+
+	UserLifecyclePolicyIDCol                     = "id"
+	UserLifecyclePolicyCreationDateCol           = "creation_date"
+	UserLifecyclePolicyChangeDateCol             = "change_date"
+	UserLifecyclePolicySequenceCol               = "sequence"
+	UserLifecyclePolicyStateCol                  = "state"
+	UserLifecyclePolicyIsDefaultCol              = "is_default"
+	UserLifecyclePolicyResourceOwnerCol          = "resource_owner"
+	UserLifecyclePolicyInstanceIDCol             = "instance_id"
+	UserLifecyclePolicyInactivityDeactivationCol = "inactivity_deactivation"
+	UserLifecyclePolicyDeactivatedRemovalCol     = "deactivated_removal"
+	UserLifecyclePolicyNotificationPeriodCol     = "notification_period"
+	UserLifecyclePolicyOwnerRemovedCol           = "owner_removed"
+)
+
+type userLifecyclePolicyProjection struct {
+	crdb.StatementHandler
+}
+
+func newUserLifecyclePolicyProjection(ctx context.Context, config crdb.StatementHandlerConfig) *userLifecyclePolicyProjection {
+	p := new(userLifecyclePolicyProjection)
+	config.ProjectionName = UserLifecyclePolicyTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(UserLifecyclePolicyIDCol, crdb.ColumnTypeText),
+			crdb.NewColumn(UserLifecyclePolicyCreationDateCol, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(UserLifecyclePolicyChangeDateCol, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(UserLifecyclePolicySequenceCol, crdb.ColumnTypeInt64),
+			crdb.NewColumn(UserLifecyclePolicyStateCol, crdb.ColumnTypeEnum),
+			crdb.NewColumn(UserLifecyclePolicyIsDefaultCol, crdb.ColumnTypeBool, crdb.Default(false)),
+			crdb.NewColumn(UserLifecyclePolicyResourceOwnerCol, crdb.ColumnTypeText),
+			crdb.NewColumn(UserLifecyclePolicyInstanceIDCol, crdb.ColumnTypeText),
+			crdb.NewColumn(UserLifecyclePolicyInactivityDeactivationCol, crdb.ColumnTypeInt64),
+			crdb.NewColumn(UserLifecyclePolicyDeactivatedRemovalCol, crdb.ColumnTypeInt64),
+			crdb.NewColumn(UserLifecyclePolicyNotificationPeriodCol, crdb.ColumnTypeInt64),
+			crdb.NewColumn(UserLifecyclePolicyOwnerRemovedCol, crdb.ColumnTypeBool, crdb.Default(false)),
+		},
+			crdb.NewPrimaryKey(UserLifecyclePolicyInstanceIDCol, UserLifecyclePolicyIDCol),
+			crdb.WithIndex(crdb.NewIndex("owner_removed", []string{UserLifecyclePolicyOwnerRemovedCol})),
+		),
+	)
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *userLifecyclePolicyProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: org.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  org.UserLifecyclePolicyAddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  org.UserLifecyclePolicyChangedEventType,
+					Reduce: p.reduceChanged,
+				},
+				{
+					Event:  org.UserLifecyclePolicyRemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  instance.UserLifecyclePolicyAddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  instance.UserLifecyclePolicyChangedEventType,
+					Reduce: p.reduceChanged,
+				},
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(UserLifecyclePolicyInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *userLifecyclePolicyProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	var policyEvent policy.UserLifecyclePolicyAddedEvent
+	var isDefault bool
+	switch e := event.(type) {
+	case *org.UserLifecyclePolicyAddedEvent:
+		policyEvent = e.UserLifecyclePolicyAddedEvent
+		isDefault = false
+	case *instance.UserLifecyclePolicyAddedEvent:
+		policyEvent = e.UserLifecyclePolicyAddedEvent
+		isDefault = true
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-Ul1ad", "reduce.wrong.event.type, %v", []eventstore.EventType{org.UserLifecyclePolicyAddedEventType, instance.UserLifecyclePolicyAddedEventType})
+	}
+	return crdb.NewCreateStatement(
+		&policyEvent,
+		[]handler.Column{
+			handler.NewCol(UserLifecyclePolicyCreationDateCol, policyEvent.CreationDate()),
+			handler.NewCol(UserLifecyclePolicyChangeDateCol, policyEvent.CreationDate()),
+			handler.NewCol(UserLifecyclePolicySequenceCol, policyEvent.Sequence()),
+			handler.NewCol(UserLifecyclePolicyIDCol, policyEvent.Aggregate().ID),
+			handler.NewCol(UserLifecyclePolicyStateCol, domain.PolicyStateActive),
+			handler.NewCol(UserLifecyclePolicyInactivityDeactivationCol, policyEvent.InactivityDeactivation),
+			handler.NewCol(UserLifecyclePolicyDeactivatedRemovalCol, policyEvent.DeactivatedRemoval),
+			handler.NewCol(UserLifecyclePolicyNotificationPeriodCol, policyEvent.NotificationPeriod),
+			handler.NewCol(UserLifecyclePolicyIsDefaultCol, isDefault),
+			handler.NewCol(UserLifecyclePolicyResourceOwnerCol, policyEvent.Aggregate().ResourceOwner),
+			handler.NewCol(UserLifecyclePolicyInstanceIDCol, policyEvent.Aggregate().InstanceID),
+		}), nil
+}
+
+func (p *userLifecyclePolicyProjection) reduceChanged(event eventstore.Event) (*handler.Statement, error) {
+	var policyEvent policy.UserLifecyclePolicyChangedEvent
+	switch e := event.(type) {
+	case *org.UserLifecyclePolicyChangedEvent:
+		policyEvent = e.UserLifecyclePolicyChangedEvent
+	case *instance.UserLifecyclePolicyChangedEvent:
+		policyEvent = e.UserLifecyclePolicyChangedEvent
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-Ul2ch", "reduce.wrong.event.type, %v", []eventstore.EventType{org.UserLifecyclePolicyChangedEventType, instance.UserLifecyclePolicyChangedEventType})
+	}
+	cols := []handler.Column{
+		handler.NewCol(UserLifecyclePolicyChangeDateCol, policyEvent.CreationDate()),
+		handler.NewCol(UserLifecyclePolicySequenceCol, policyEvent.Sequence()),
+	}
+	if policyEvent.InactivityDeactivation != nil {
+		cols = append(cols, handler.NewCol(UserLifecyclePolicyInactivityDeactivationCol, *policyEvent.InactivityDeactivation))
+	}
+	if policyEvent.DeactivatedRemoval != nil {
+		cols = append(cols, handler.NewCol(UserLifecyclePolicyDeactivatedRemovalCol, *policyEvent.DeactivatedRemoval))
+	}
+	if policyEvent.NotificationPeriod != nil {
+		cols = append(cols, handler.NewCol(UserLifecyclePolicyNotificationPeriodCol, *policyEvent.NotificationPeriod))
+	}
+	return crdb.NewUpdateStatement(
+		&policyEvent,
+		cols,
+		[]handler.Condition{
+			handler.NewCond(UserLifecyclePolicyIDCol, policyEvent.Aggregate().ID),
+			handler.NewCond(UserLifecyclePolicyInstanceIDCol, event.Aggregate().InstanceID),
+		}), nil
+}
+
+func (p *userLifecyclePolicyProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	policyEvent, ok := event.(*org.UserLifecyclePolicyRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-Ul3rm", "reduce.wrong.event.type %s", org.UserLifecyclePolicyRemovedEventType)
+	}
+	return crdb.NewDeleteStatement(
+		policyEvent,
+		[]handler.Condition{
+			handler.NewCond(UserLifecyclePolicyIDCol, policyEvent.Aggregate().ID),
+			handler.NewCond(UserLifecyclePolicyInstanceIDCol, event.Aggregate().InstanceID),
+		}), nil
+}
+
+func (p *userLifecyclePolicyProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-Ul4or", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserLifecyclePolicyChangeDateCol, e.CreationDate()),
+			handler.NewCol(UserLifecyclePolicySequenceCol, e.Sequence()),
+			handler.NewCol(UserLifecyclePolicyOwnerRemovedCol, true),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserLifecyclePolicyInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(UserLifecyclePolicyResourceOwnerCol, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,166 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+const (
+	UserSelfDeletionProjectionTable = "projections.user_self_deletions"
+
+	UserSelfDeletionColumnUserID        = "user_id"
+	UserSelfDeletionColumnCreationDate  = "creation_date"
+	UserSelfDeletionColumnChangeDate    = "change_date"
+	UserSelfDeletionColumnSequence      = "sequence"
+	UserSelfDeletionColumnResourceOwner = "resource_owner"
+	UserSelfDeletionColumnInstanceID    = "instance_id"
+	UserSelfDeletionColumnScheduledAt   = "scheduled_at"
+	UserSelfDeletionColumnOwnerRemoved  = "owner_removed"
+)
+
+type userSelfDeletionProjection struct {
+	crdb.StatementHandler
+}
+
+func newUserSelfDeletionProjection(ctx context.Context, config crdb.StatementHandlerConfig) *userSelfDeletionProjection {
+	p := new(userSelfDeletionProjection)
+	config.ProjectionName = UserSelfDeletionProjectionTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(UserSelfDeletionColumnUserID, crdb.ColumnTypeText),
+			crdb.NewColumn(UserSelfDeletionColumnCreationDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(UserSelfDeletionColumnChangeDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(UserSelfDeletionColumnSequence, crdb.ColumnTypeInt64),
+			crdb.NewColumn(UserSelfDeletionColumnResourceOwner, crdb.ColumnTypeText),
+			crdb.NewColumn(UserSelfDeletionColumnInstanceID, crdb.ColumnTypeText),
+			crdb.NewColumn(UserSelfDeletionColumnScheduledAt, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(UserSelfDeletionColumnOwnerRemoved, crdb.ColumnTypeBool, crdb.Default(false)),
+		},
+			crdb.NewPrimaryKey(UserSelfDeletionColumnInstanceID, UserSelfDeletionColumnUserID),
+			crdb.WithIndex(crdb.NewIndex("scheduled_at", []string{UserSelfDeletionColumnScheduledAt})),
+			crdb.WithIndex(crdb.NewIndex("owner_removed", []string{UserSelfDeletionColumnOwnerRemoved})),
+		),
+	)
+
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *userSelfDeletionProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  user.HumanSelfDeletionRequestedType,
+					Reduce: p.reduceSelfDeletionRequested,
+				},
+				{
+					Event:  user.HumanSelfDeletionCanceledType,
+					Reduce: p.reduceSelfDeletionCanceled,
+				},
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(UserSelfDeletionColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *userSelfDeletionProjection) reduceSelfDeletionRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanSelfDeletionRequestedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Fk3nq", "reduce.wrong.event.type %s", user.HumanSelfDeletionRequestedType)
+	}
+	return crdb.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserSelfDeletionColumnInstanceID, nil),
+			handler.NewCol(UserSelfDeletionColumnUserID, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(UserSelfDeletionColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(UserSelfDeletionColumnUserID, e.Aggregate().ID),
+			handler.NewCol(UserSelfDeletionColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(UserSelfDeletionColumnCreationDate, e.CreationDate()),
+			handler.NewCol(UserSelfDeletionColumnChangeDate, e.CreationDate()),
+			handler.NewCol(UserSelfDeletionColumnSequence, e.Sequence()),
+			handler.NewCol(UserSelfDeletionColumnScheduledAt, e.ScheduledAt),
+		},
+	), nil
+}
+
+func (p *userSelfDeletionProjection) reduceSelfDeletionCanceled(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanSelfDeletionCanceledEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Qm2ve", "reduce.wrong.event.type %s", user.HumanSelfDeletionCanceledType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserSelfDeletionColumnUserID, e.Aggregate().ID),
+			handler.NewCond(UserSelfDeletionColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *userSelfDeletionProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Ub8xw", "reduce.wrong.event.type %s", user.UserRemovedType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserSelfDeletionColumnUserID, e.Aggregate().ID),
+			handler.NewCond(UserSelfDeletionColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *userSelfDeletionProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-Zo4nf", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserSelfDeletionColumnChangeDate, e.CreationDate()),
+			handler.NewCol(UserSelfDeletionColumnSequence, e.Sequence()),
+			handler.NewCol(UserSelfDeletionColumnOwnerRemoved, true),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserSelfDeletionColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserSelfDeletionColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
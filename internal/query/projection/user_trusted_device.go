@@ -0,0 +1,177 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+const (
+	TrustedDeviceProjectionTable = "projections.trusted_devices"
+
+	TrustedDeviceColumnUserAgentID   = "user_agent_id"
+	TrustedDeviceColumnUserID        = "user_id"
+	TrustedDeviceColumnCreationDate  = "creation_date"
+	TrustedDeviceColumnChangeDate    = "change_date"
+	TrustedDeviceColumnSequence      = "sequence"
+	TrustedDeviceColumnResourceOwner = "resource_owner"
+	TrustedDeviceColumnInstanceID    = "instance_id"
+	TrustedDeviceColumnName          = "name"
+	TrustedDeviceColumnRemoteIP      = "remote_ip"
+	TrustedDeviceColumnExpiration    = "expiration"
+	TrustedDeviceColumnOwnerRemoved  = "owner_removed"
+)
+
+type trustedDeviceProjection struct {
+	crdb.StatementHandler
+}
+
+func newTrustedDeviceProjection(ctx context.Context, config crdb.StatementHandlerConfig) *trustedDeviceProjection {
+	p := new(trustedDeviceProjection)
+	config.ProjectionName = TrustedDeviceProjectionTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(TrustedDeviceColumnUserAgentID, crdb.ColumnTypeText),
+			crdb.NewColumn(TrustedDeviceColumnUserID, crdb.ColumnTypeText),
+			crdb.NewColumn(TrustedDeviceColumnCreationDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(TrustedDeviceColumnChangeDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(TrustedDeviceColumnSequence, crdb.ColumnTypeInt64),
+			crdb.NewColumn(TrustedDeviceColumnResourceOwner, crdb.ColumnTypeText),
+			crdb.NewColumn(TrustedDeviceColumnInstanceID, crdb.ColumnTypeText),
+			crdb.NewColumn(TrustedDeviceColumnName, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(TrustedDeviceColumnRemoteIP, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(TrustedDeviceColumnExpiration, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(TrustedDeviceColumnOwnerRemoved, crdb.ColumnTypeBool, crdb.Default(false)),
+		},
+			crdb.NewPrimaryKey(TrustedDeviceColumnInstanceID, TrustedDeviceColumnUserID, TrustedDeviceColumnUserAgentID),
+			crdb.WithIndex(crdb.NewIndex("resource_owner", []string{TrustedDeviceColumnResourceOwner})),
+			crdb.WithIndex(crdb.NewIndex("owner_removed", []string{TrustedDeviceColumnOwnerRemoved})),
+		),
+	)
+
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *trustedDeviceProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  user.HumanDeviceTrustedType,
+					Reduce: p.reduceDeviceTrusted,
+				},
+				{
+					Event:  user.HumanDeviceTrustRevokedType,
+					Reduce: p.reduceDeviceTrustRevoked,
+				},
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(TrustedDeviceColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *trustedDeviceProjection) reduceDeviceTrusted(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanDeviceTrustedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Vb3qe", "reduce.wrong.event.type %s", user.HumanDeviceTrustedType)
+	}
+	return crdb.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(TrustedDeviceColumnInstanceID, nil),
+			handler.NewCol(TrustedDeviceColumnUserID, nil),
+			handler.NewCol(TrustedDeviceColumnUserAgentID, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(TrustedDeviceColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(TrustedDeviceColumnUserID, e.Aggregate().ID),
+			handler.NewCol(TrustedDeviceColumnUserAgentID, e.UserAgentID),
+			handler.NewCol(TrustedDeviceColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(TrustedDeviceColumnCreationDate, e.CreationDate()),
+			handler.NewCol(TrustedDeviceColumnChangeDate, e.CreationDate()),
+			handler.NewCol(TrustedDeviceColumnSequence, e.Sequence()),
+			handler.NewCol(TrustedDeviceColumnName, e.Name),
+			handler.NewCol(TrustedDeviceColumnRemoteIP, e.RemoteIP),
+			handler.NewCol(TrustedDeviceColumnExpiration, e.Expiration),
+		},
+	), nil
+}
+
+func (p *trustedDeviceProjection) reduceDeviceTrustRevoked(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanDeviceTrustRevokedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Nq2vd", "reduce.wrong.event.type %s", user.HumanDeviceTrustRevokedType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(TrustedDeviceColumnUserID, e.Aggregate().ID),
+			handler.NewCond(TrustedDeviceColumnUserAgentID, e.UserAgentID),
+			handler.NewCond(TrustedDeviceColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *trustedDeviceProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Ws9bm", "reduce.wrong.event.type %s", user.UserRemovedType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(TrustedDeviceColumnUserID, e.Aggregate().ID),
+			handler.NewCond(TrustedDeviceColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *trustedDeviceProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-Ke3ld", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(TrustedDeviceColumnChangeDate, e.CreationDate()),
+			handler.NewCol(TrustedDeviceColumnSequence, e.Sequence()),
+			handler.NewCol(TrustedDeviceColumnOwnerRemoved, true),
+		},
+		[]handler.Condition{
+			handler.NewCond(TrustedDeviceColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(TrustedDeviceColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
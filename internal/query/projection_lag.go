@@ -0,0 +1,285 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zitadel/logging"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/instrument"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/telemetry/metrics"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// projectionLagsCacheDuration prevents that each observed metric queries the lags
+const projectionLagsCacheDuration = 10 * time.Second
+
+//go:embed embed/projection_lags.sql
+var projectionLagsQuery string
+
+var errorIDRegexp = regexp.MustCompile(`ID=(\S+)`)
+
+// ProjectionLag is the state of a projection for an instance
+type ProjectionLag struct {
+	ProjectionName string
+	InstanceID     string
+	// Sequence is the highest sequence processed by the projection
+	Sequence      uint64
+	LastProcessed time.Time
+	// EventsBehind is the amount of events not yet processed by the projection
+	EventsBehind uint64
+	// Lag is the time between the latest event and the latest event processed by the projection
+	Lag time.Duration
+
+	LockerID    string
+	LockedUntil time.Time
+
+	LastError  string
+	LastFailed time.Time
+}
+
+// ErrorID returns the id of the last error if it is a ZITADEL error
+func (l *ProjectionLag) ErrorID() string {
+	match := errorIDRegexp.FindStringSubmatch(l.LastError)
+	if len(match) < 2 {
+		return ""
+	}
+	return match[1]
+}
+
+// projectionLagsCache shares the lags between the readiness check and the metrics.
+// The lags are queried without holding the mutex, the expired lags are returned to concurrent callers meanwhile.
+type projectionLagsCache struct {
+	mutex      sync.Mutex
+	lags       []*ProjectionLag
+	expiresAt  time.Time
+	refreshing bool
+}
+
+// ProjectionLags returns the lag of the projections per instance
+// projectionName and instanceID are optional filters
+func (q *Queries) ProjectionLags(ctx context.Context, projectionName, instanceID string) (lags []*ProjectionLag, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	rows, err := q.client.QueryContext(ctx, projectionLagsQuery, projectionName, instanceID)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Lg3sd", "Errors.Internal")
+	}
+	return scanProjectionLags(rows)
+}
+
+// scanProjectionLags merges the rows of the aggregate types of a projection and instance
+func scanProjectionLags(rows *sql.Rows) ([]*ProjectionLag, error) {
+	lags := make([]*ProjectionLag, 0)
+	var lag *ProjectionLag
+	for rows.Next() {
+		var (
+			projectionName string
+			instanceID     string
+			sequence       uint64
+			timestamp      sql.NullTime
+			eventsBehind   uint64
+			oldestPending  sql.NullTime
+			latestEvent    sql.NullTime
+			processedEvent sql.NullTime
+			lockerID       sql.NullString
+			lockedUntil    sql.NullTime
+			lastError      sql.NullString
+			lastFailed     sql.NullTime
+		)
+		err := rows.Scan(
+			&projectionName,
+			&instanceID,
+			&sequence,
+			&timestamp,
+			&eventsBehind,
+			&oldestPending,
+			&latestEvent,
+			&processedEvent,
+			&lockerID,
+			&lockedUntil,
+			&lastError,
+			&lastFailed,
+		)
+		if err != nil {
+			rows.Close()
+			return nil, errors.ThrowInternal(err, "QUERY-Lg4sc", "Errors.Internal")
+		}
+		if lag == nil || lag.ProjectionName != projectionName || lag.InstanceID != instanceID {
+			lag = &ProjectionLag{
+				ProjectionName: projectionName,
+				InstanceID:     instanceID,
+				LockerID:       lockerID.String,
+				LockedUntil:    lockedUntil.Time,
+				LastError:      lastError.String,
+				LastFailed:     lastFailed.Time,
+			}
+			lags = append(lags, lag)
+		}
+		if sequence > lag.Sequence {
+			lag.Sequence = sequence
+		}
+		if timestamp.Time.After(lag.LastProcessed) {
+			lag.LastProcessed = timestamp.Time
+		}
+		lag.EventsBehind += eventsBehind
+		if eventsBehind == 0 {
+			continue
+		}
+		// the processed event is missing if the projection did not process an event of the aggregate type yet
+		processed := processedEvent.Time
+		if !processedEvent.Valid {
+			processed = oldestPending.Time
+		}
+		if behind := latestEvent.Time.Sub(processed); behind > lag.Lag {
+			lag.Lag = behind
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Lg5cl", "Errors.Query.CloseRows")
+	}
+	return lags, nil
+}
+
+func (q *Queries) cachedProjectionLags(ctx context.Context) ([]*ProjectionLag, error) {
+	cache := &q.projectionLags
+	cache.mutex.Lock()
+	if time.Now().Before(cache.expiresAt) || cache.refreshing && cache.lags != nil {
+		lags := cache.lags
+		cache.mutex.Unlock()
+		return lags, nil
+	}
+	cache.refreshing = true
+	cache.mutex.Unlock()
+
+	lags, err := q.ProjectionLags(ctx, "", "")
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.refreshing = false
+	if err != nil {
+		return nil, err
+	}
+	cache.lags = lags
+	cache.expiresAt = time.Now().Add(projectionLagsCacheDuration)
+	return lags, nil
+}
+
+// ProjectionsReady returns an error if a critical projection exceeds the lag budget
+// it returns the lags of the projections exceeding the budget
+func (q *Queries) ProjectionsReady(ctx context.Context) (lagging []*ProjectionLag, err error) {
+	if q.readiness.LagBudget == 0 {
+		return nil, nil
+	}
+	lags, err := q.cachedProjectionLags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, lag := range lags {
+		if lag.Lag > q.readiness.LagBudget && q.isCriticalProjection(lag.ProjectionName) {
+			lagging = append(lagging, lag)
+		}
+	}
+	if len(lagging) > 0 {
+		names := make([]string, len(lagging))
+		for i, lag := range lagging {
+			names[i] = lag.ProjectionName + "@" + lag.InstanceID
+		}
+		return lagging, errors.ThrowUnavailablef(nil, "QUERY-Lg6bd", "projections exceed lag budget: %s", strings.Join(names, ", "))
+	}
+	return nil, nil
+}
+
+func (q *Queries) isCriticalProjection(projectionName string) bool {
+	if len(q.readiness.CriticalProjections) == 0 {
+		return true
+	}
+	for _, critical := range q.readiness.CriticalProjections {
+		if critical == projectionName {
+			return true
+		}
+	}
+	return false
+}
+
+// registerProjectionLagMetrics observes the lags only if the readiness check is enabled,
+// so the lags are queried once for both of them
+func (q *Queries) registerProjectionLagMetrics() error {
+	if q.readiness.LagBudget == 0 {
+		return nil
+	}
+	observers := []struct {
+		name        string
+		description string
+		observe     func(instrument.Int64Observer, *ProjectionLag)
+	}{
+		{
+			name:        metrics.ProjectionEventsBehind,
+			description: metrics.ProjectionEventsBehindDescription,
+			observe: func(observer instrument.Int64Observer, lag *ProjectionLag) {
+				observer.Observe(int64(lag.EventsBehind), projectionLagAttributes(lag)...)
+			},
+		},
+		{
+			name:        metrics.ProjectionSecondsBehind,
+			description: metrics.ProjectionSecondsBehindDescription,
+			observe: func(observer instrument.Int64Observer, lag *ProjectionLag) {
+				observer.Observe(int64(lag.Lag.Seconds()), projectionLagAttributes(lag)...)
+			},
+		},
+		{
+			name:        metrics.ProjectionLocked,
+			description: metrics.ProjectionLockedDescription,
+			observe: func(observer instrument.Int64Observer, lag *ProjectionLag) {
+				if lag.LockerID == "" {
+					observer.Observe(0, projectionLagAttributes(lag)...)
+					return
+				}
+				observer.Observe(1, append(projectionLagAttributes(lag), attribute.String(metrics.Locker, lag.LockerID))...)
+			},
+		},
+		{
+			name:        metrics.ProjectionLastFailure,
+			description: metrics.ProjectionLastFailureDescription,
+			observe: func(observer instrument.Int64Observer, lag *ProjectionLag) {
+				if lag.LastFailed.IsZero() {
+					return
+				}
+				observer.Observe(lag.LastFailed.Unix(), append(projectionLagAttributes(lag), attribute.String(metrics.ErrorID, lag.ErrorID()))...)
+			},
+		},
+	}
+	for _, o := range observers {
+		observe := o.observe
+		err := metrics.RegisterValueObserver(o.name, o.description, func(ctx context.Context, observer instrument.Int64Observer) error {
+			lags, err := q.cachedProjectionLags(ctx)
+			if err != nil {
+				logging.WithError(err).Warn("unable to observe projection lags")
+				return err
+			}
+			for _, lag := range lags {
+				observe(observer, lag)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func projectionLagAttributes(lag *ProjectionLag) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String(metrics.Projection, lag.ProjectionName),
+		attribute.String(metrics.Instance, lag.InstanceID),
+	}
+}
@@ -0,0 +1,114 @@
+package query
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func Test_scanProjectionLags(t *testing.T) {
+	client, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	processed := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	cols := []string{"projection_name", "instance_id", "current_sequence", "timestamp", "events_behind", "oldest_pending", "latest_event", "processed_event", "locker_id", "locked_until", "error", "last_failed"}
+	mock.ExpectQuery(regexp.QuoteMeta(projectionLagsQuery)).
+		WithArgs("", "").
+		WillReturnRows(
+			sqlmock.NewRows(cols).
+				AddRow("projections.users", "instance1", uint64(10), processed, uint64(0), nil, nil, processed, "locker", processed.Add(time.Hour), "ID=CRDB-oRkaN Message=unable execute stmt", processed).
+				AddRow("projections.users", "instance1", uint64(12), processed.Add(time.Second), uint64(3), processed.Add(2*time.Second), processed.Add(time.Minute), processed, "locker", processed.Add(time.Hour), "ID=CRDB-oRkaN Message=unable execute stmt", processed).
+				AddRow("projections.users", "instance2", uint64(5), processed, uint64(2), processed.Add(time.Second), processed.Add(time.Hour), nil, nil, nil, nil, nil),
+		)
+
+	rows, err := client.Query(projectionLagsQuery, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := scanProjectionLags(rows)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []*ProjectionLag{
+		{
+			ProjectionName: "projections.users",
+			InstanceID:     "instance1",
+			Sequence:       12,
+			LastProcessed:  processed.Add(time.Second),
+			EventsBehind:   3,
+			Lag:            time.Minute,
+			LockerID:       "locker",
+			LockedUntil:    processed.Add(time.Hour),
+			LastError:      "ID=CRDB-oRkaN Message=unable execute stmt",
+			LastFailed:     processed,
+		},
+		{
+			ProjectionName: "projections.users",
+			InstanceID:     "instance2",
+			Sequence:       5,
+			LastProcessed:  processed,
+			EventsBehind:   2,
+			Lag:            time.Hour - time.Second,
+		},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("scanProjectionLags() returned %d lags, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if !reflect.DeepEqual(got[i], want[i]) {
+			t.Errorf("scanProjectionLags()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestProjectionLag_ErrorID(t *testing.T) {
+	lag := &ProjectionLag{LastError: "ID=CRDB-oRkaN Message=unable execute stmt Parent=(duplicate key)"}
+	if id := lag.ErrorID(); id != "CRDB-oRkaN" {
+		t.Errorf("ErrorID() = %q, want %q", id, "CRDB-oRkaN")
+	}
+	if id := new(ProjectionLag).ErrorID(); id != "" {
+		t.Errorf("ErrorID() of empty error = %q", id)
+	}
+}
+
+func TestQueries_cachedProjectionLags(t *testing.T) {
+	lags := []*ProjectionLag{{ProjectionName: "projections.users8", InstanceID: "instance"}}
+	tests := []struct {
+		name       string
+		expiresAt  time.Time
+		refreshing bool
+	}{
+		{
+			name:      "not expired",
+			expiresAt: time.Now().Add(time.Minute),
+		},
+		{
+			name:       "expired, refreshed concurrently",
+			expiresAt:  time.Now().Add(-time.Minute),
+			refreshing: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the client is not set, so the cached lags must be returned without a query
+			q := new(Queries)
+			q.projectionLags.lags = lags
+			q.projectionLags.expiresAt = tt.expiresAt
+			q.projectionLags.refreshing = tt.refreshing
+			got, err := q.cachedProjectionLags(context.Background())
+			if err != nil {
+				t.Fatalf("cachedProjectionLags() unexpected error = %v", err)
+			}
+			if !reflect.DeepEqual(got, lags) {
+				t.Errorf("cachedProjectionLags() = %v, want %v", got, lags)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package query
+
+import (
+	"context"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// StartProjectionRebuild starts the rebuild of the projection in the background
+// the state of the rebuild can be retrieved using ProjectionRebuildStatus
+func (q *Queries) StartProjectionRebuild(ctx context.Context, projectionName string) (rebuildID string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	rebuildID, err = id.SonyFlakeGenerator().Next()
+	if err != nil {
+		return "", err
+	}
+	if err = projection.StartRebuild(ctx, projectionName, rebuildID); err != nil {
+		return "", err
+	}
+	go func() {
+		err := projection.Rebuild(context.Background(), projectionName, rebuildID, nil)
+		logging.WithFields("projection", projectionName, "rebuild", rebuildID).OnError(err).Warn("rebuild failed")
+	}()
+	return rebuildID, nil
+}
+
+func (q *Queries) ProjectionRebuildStatus(ctx context.Context, projectionName string) (_ *handler.Rebuild, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	return projection.RebuildStatus(ctx, projectionName)
+}
+
+func (q *Queries) AbortProjectionRebuild(ctx context.Context, projectionName string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	return projection.AbortRebuild(ctx, projectionName)
+}
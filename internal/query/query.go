@@ -12,6 +12,7 @@ import (
 	"golang.org/x/text/language"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/cache"
 	sd "github.com/zitadel/zitadel/internal/config/systemdefaults"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/database"
@@ -20,6 +21,7 @@ import (
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/repository/action"
 	"github.com/zitadel/zitadel/internal/repository/authrequest"
+	"github.com/zitadel/zitadel/internal/repository/group"
 	"github.com/zitadel/zitadel/internal/repository/idpintent"
 	iam_repo "github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/repository/keypair"
@@ -29,6 +31,7 @@ import (
 	"github.com/zitadel/zitadel/internal/repository/session"
 	usr_repo "github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/repository/userimport"
 )
 
 type Queries struct {
@@ -48,6 +51,27 @@ type Queries struct {
 	supportedLangs                      []language.Tag
 	zitadelRoles                        []authz.RoleMapping
 	multifactors                        domain.MultifactorConfigs
+
+	readiness      projection.ReadinessConfig
+	projectionLags projectionLagsCache
+	caches         queryCaches
+}
+
+// RegisterEventMappers registers the mappers of all events reduced by the projections
+func RegisterEventMappers(es *eventstore.Eventstore) {
+	iam_repo.RegisterEventMappers(es)
+	usr_repo.RegisterEventMappers(es)
+	org.RegisterEventMappers(es)
+	project.RegisterEventMappers(es)
+	action.RegisterEventMappers(es)
+	keypair.RegisterEventMappers(es)
+	usergrant.RegisterEventMappers(es)
+	session.RegisterEventMappers(es)
+	idpintent.RegisterEventMappers(es)
+	authrequest.RegisterEventMappers(es)
+	oidcsession.RegisterEventMappers(es)
+	userimport.RegisterEventMappers(es)
+	group.RegisterEventMappers(es)
 }
 
 func StartQueries(
@@ -60,6 +84,7 @@ func StartQueries(
 	zitadelRoles []authz.RoleMapping,
 	sessionTokenVerifier func(ctx context.Context, sessionToken string, sessionID string, tokenID string) (err error),
 	permissionCheck func(q *Queries) domain.PermissionCheck,
+	caches *cache.QueryCaches,
 ) (repo *Queries, err error) {
 	statikLoginFS, err := fs.NewWithNamespace("login")
 	if err != nil {
@@ -81,18 +106,10 @@ func StartQueries(
 		NotificationTranslationFileContents: make(map[string][]byte),
 		zitadelRoles:                        zitadelRoles,
 		sessionTokenVerifier:                sessionTokenVerifier,
+		readiness:                           projections.Readiness,
+		caches:                              newQueryCaches(caches),
 	}
-	iam_repo.RegisterEventMappers(repo.eventstore)
-	usr_repo.RegisterEventMappers(repo.eventstore)
-	org.RegisterEventMappers(repo.eventstore)
-	project.RegisterEventMappers(repo.eventstore)
-	action.RegisterEventMappers(repo.eventstore)
-	keypair.RegisterEventMappers(repo.eventstore)
-	usergrant.RegisterEventMappers(repo.eventstore)
-	session.RegisterEventMappers(repo.eventstore)
-	idpintent.RegisterEventMappers(repo.eventstore)
-	authrequest.RegisterEventMappers(repo.eventstore)
-	oidcsession.RegisterEventMappers(repo.eventstore)
+	RegisterEventMappers(repo.eventstore)
 
 	repo.idpConfigEncryption = idpConfigEncryption
 	repo.multifactors = domain.MultifactorConfigs{
@@ -110,6 +127,10 @@ func StartQueries(
 	}
 	projection.Start()
 
+	if err = repo.registerProjectionLagMetrics(); err != nil {
+		return nil, err
+	}
+
 	return repo, nil
 }
 
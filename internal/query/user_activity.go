@@ -0,0 +1,291 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+var (
+	userActivitiesTable = table{
+		name:          projection.UserActivityProjectionTable,
+		instanceIDCol: projection.UserActivityColumnInstanceID,
+	}
+	UserActivityColumnUserID = Column{
+		name:  projection.UserActivityColumnUserID,
+		table: userActivitiesTable,
+	}
+	UserActivityColumnCreationDate = Column{
+		name:  projection.UserActivityColumnCreationDate,
+		table: userActivitiesTable,
+	}
+	UserActivityColumnChangeDate = Column{
+		name:  projection.UserActivityColumnChangeDate,
+		table: userActivitiesTable,
+	}
+	UserActivityColumnResourceOwner = Column{
+		name:  projection.UserActivityColumnResourceOwner,
+		table: userActivitiesTable,
+	}
+	UserActivityColumnInstanceID = Column{
+		name:  projection.UserActivityColumnInstanceID,
+		table: userActivitiesTable,
+	}
+	UserActivityColumnSequence = Column{
+		name:  projection.UserActivityColumnSequence,
+		table: userActivitiesTable,
+	}
+	UserActivityColumnState = Column{
+		name:  projection.UserActivityColumnState,
+		table: userActivitiesTable,
+	}
+	UserActivityColumnLastLogin = Column{
+		name:  projection.UserActivityColumnLastLogin,
+		table: userActivitiesTable,
+	}
+	UserActivityColumnLastActivity = Column{
+		name:  projection.UserActivityColumnLastActivity,
+		table: userActivitiesTable,
+	}
+	UserActivityColumnDeactivationDate = Column{
+		name:  projection.UserActivityColumnDeactivationDate,
+		table: userActivitiesTable,
+	}
+	UserActivityColumnNotifiedAction = Column{
+		name:  projection.UserActivityColumnNotifiedAction,
+		table: userActivitiesTable,
+	}
+	UserActivityColumnOwnerRemoved = Column{
+		name:  projection.UserActivityColumnOwnerRemoved,
+		table: userActivitiesTable,
+	}
+)
+
+type UserActivities struct {
+	SearchResponse
+	UserActivities []*UserActivity
+}
+
+type UserActivity struct {
+	InstanceID    string
+	UserID        string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	Sequence      uint64
+
+	State            domain.UserState
+	LastLogin        time.Time
+	LastActivity     time.Time
+	DeactivationDate time.Time
+	NotifiedAction   domain.UserLifecycleAction
+}
+
+type UserActivitySearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+type UserLifecycleActions struct {
+	SearchResponse
+	Actions []*UserLifecycleAction
+}
+
+// UserLifecycleAction is an action the user lifecycle policy requires for the user
+type UserLifecycleAction struct {
+	*UserActivity
+	Action  domain.UserLifecycleAction
+	DueDate time.Time
+}
+
+// SearchUserActivities searches the activities of the human users of the given instances,
+// if no instance is provided, the instance of the context is used
+func (q *Queries) SearchUserActivities(ctx context.Context, instanceIDs []string, queries *UserActivitySearchQueries) (_ *UserActivities, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareUserActivitiesQuery(ctx, q.client)
+	if len(instanceIDs) == 0 {
+		instanceIDs = []string{authz.GetInstance(ctx).InstanceID()}
+	}
+	stmt, args, err := queries.toQuery(query).Where(sq.Eq{
+		UserActivityColumnInstanceID.identifier():   instanceIDs,
+		UserActivityColumnOwnerRemoved.identifier(): false,
+	}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Ua2qe", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Ua3ie", "Errors.Internal")
+	}
+	activities, err := scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	activities.LatestSequence, err = q.latestSequence(ctx, userActivitiesTable)
+	return activities, err
+}
+
+// UserLifecycleActions evaluates the user lifecycle policies of the given instances
+// and returns the actions which are due at the given time.
+// It's used by the scheduled job as well as for the dry-run report,
+// so both always agree on which users are affected.
+func (q *Queries) UserLifecycleActions(ctx context.Context, instanceIDs []string, now time.Time, queries *UserActivitySearchQueries) (_ *UserLifecycleActions, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if len(instanceIDs) == 0 {
+		instanceIDs = []string{authz.GetInstance(ctx).InstanceID()}
+	}
+	policies, err := q.searchUserLifecyclePolicies(ctx, instanceIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(policies.Policies) == 0 {
+		actions := &UserLifecycleActions{Actions: []*UserLifecycleAction{}}
+		actions.LatestSequence, err = q.latestSequence(ctx, userActivitiesTable)
+		return actions, err
+	}
+	if queries == nil {
+		queries = new(UserActivitySearchQueries)
+	}
+	stateQuery, err := NewUserActivityStateSearchQuery(domain.UserStateActive, domain.UserStateInactive)
+	if err != nil {
+		return nil, err
+	}
+	queries.Queries = append(queries.Queries, stateQuery)
+	activities, err := q.SearchUserActivities(ctx, instanceIDs, queries)
+	if err != nil {
+		return nil, err
+	}
+	actions := evaluateUserLifecycleActions(policies.Policies, activities.UserActivities, now)
+	actions.LatestSequence = activities.LatestSequence
+	return actions, nil
+}
+
+type userLifecyclePolicyKey struct {
+	instanceID string
+	id         string
+}
+
+// evaluateUserLifecycleActions applies the policy of the organisation of each user,
+// or the default policy of the instance if the organisation has none
+func evaluateUserLifecycleActions(policies []*UserLifecyclePolicy, activities []*UserActivity, now time.Time) *UserLifecycleActions {
+	byKey := make(map[userLifecyclePolicyKey]*domain.UserLifecyclePolicy, len(policies))
+	for _, policy := range policies {
+		byKey[userLifecyclePolicyKey{instanceID: policy.InstanceID, id: policy.ID}] = policy.toDomain()
+	}
+	actions := make([]*UserLifecycleAction, 0)
+	for _, activity := range activities {
+		policy, ok := byKey[userLifecyclePolicyKey{instanceID: activity.InstanceID, id: activity.ResourceOwner}]
+		if !ok {
+			policy = byKey[userLifecyclePolicyKey{instanceID: activity.InstanceID, id: activity.InstanceID}]
+		}
+		action, due := policy.NextAction(now, activity.State, activity.LastActivity, activity.DeactivationDate, activity.NotifiedAction)
+		if action == domain.UserLifecycleActionUnspecified {
+			continue
+		}
+		actions = append(actions, &UserLifecycleAction{
+			UserActivity: activity,
+			Action:       action,
+			DueDate:      due,
+		})
+	}
+	return &UserLifecycleActions{
+		Actions: actions,
+		SearchResponse: SearchResponse{
+			Count: uint64(len(actions)),
+		},
+	}
+}
+
+func NewUserActivityResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(UserActivityColumnResourceOwner, value, TextEquals)
+}
+
+func NewUserActivityUserIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(UserActivityColumnUserID, value, TextEquals)
+}
+
+func NewUserActivityStateSearchQuery(states ...domain.UserState) (SearchQuery, error) {
+	return NewListQuery(UserActivityColumnState, states, ListIn)
+}
+
+func (q *UserActivitySearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func prepareUserActivitiesQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*UserActivities, error)) {
+	return sq.Select(
+			UserActivityColumnInstanceID.identifier(),
+			UserActivityColumnUserID.identifier(),
+			UserActivityColumnCreationDate.identifier(),
+			UserActivityColumnChangeDate.identifier(),
+			UserActivityColumnResourceOwner.identifier(),
+			UserActivityColumnSequence.identifier(),
+			UserActivityColumnState.identifier(),
+			UserActivityColumnLastLogin.identifier(),
+			UserActivityColumnLastActivity.identifier(),
+			UserActivityColumnDeactivationDate.identifier(),
+			UserActivityColumnNotifiedAction.identifier(),
+			countColumn.identifier()).
+			From(userActivitiesTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*UserActivities, error) {
+			activities := make([]*UserActivity, 0)
+			var count uint64
+			for rows.Next() {
+				activity := new(UserActivity)
+				var (
+					lastLogin        sql.NullTime
+					deactivationDate sql.NullTime
+				)
+				err := rows.Scan(
+					&activity.InstanceID,
+					&activity.UserID,
+					&activity.CreationDate,
+					&activity.ChangeDate,
+					&activity.ResourceOwner,
+					&activity.Sequence,
+					&activity.State,
+					&lastLogin,
+					&activity.LastActivity,
+					&deactivationDate,
+					&activity.NotifiedAction,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				activity.LastLogin = lastLogin.Time
+				activity.DeactivationDate = deactivationDate.Time
+				activities = append(activities, activity)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Ua4cr", "Errors.Query.CloseRows")
+			}
+
+			return &UserActivities{
+				UserActivities: activities,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
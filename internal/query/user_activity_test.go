@@ -0,0 +1,256 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	userActivitiesStmt = regexp.QuoteMeta(
+		"SELECT projections.user_activities.instance_id," +
+			" projections.user_activities.user_id," +
+			" projections.user_activities.creation_date," +
+			" projections.user_activities.change_date," +
+			" projections.user_activities.resource_owner," +
+			" projections.user_activities.sequence," +
+			" projections.user_activities.state," +
+			" projections.user_activities.last_login," +
+			" projections.user_activities.last_activity," +
+			" projections.user_activities.deactivation_date," +
+			" projections.user_activities.notified_action," +
+			" COUNT(*) OVER ()" +
+			" FROM projections.user_activities" +
+			" AS OF SYSTEM TIME '-1 ms'")
+	userActivitiesCols = []string{
+		"instance_id",
+		"user_id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"state",
+		"last_login",
+		"last_activity",
+		"deactivation_date",
+		"notified_action",
+		"count",
+	}
+)
+
+func Test_UserActivityPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareUserActivitiesQuery no result",
+			prepare: prepareUserActivitiesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					userActivitiesStmt,
+					nil,
+					nil,
+				),
+			},
+			object: &UserActivities{UserActivities: []*UserActivity{}},
+		},
+		{
+			name:    "prepareUserActivitiesQuery never logged in",
+			prepare: prepareUserActivitiesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					userActivitiesStmt,
+					userActivitiesCols,
+					[][]driver.Value{
+						{
+							"instance-id",
+							"user-id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211202),
+							domain.UserStateActive,
+							nil,
+							testNow,
+							nil,
+							domain.UserLifecycleActionUnspecified,
+						},
+					},
+				),
+			},
+			object: &UserActivities{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				UserActivities: []*UserActivity{
+					{
+						InstanceID:    "instance-id",
+						UserID:        "user-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						Sequence:      20211202,
+						State:         domain.UserStateActive,
+						LastActivity:  testNow,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareUserActivitiesQuery deactivated",
+			prepare: prepareUserActivitiesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					userActivitiesStmt,
+					userActivitiesCols,
+					[][]driver.Value{
+						{
+							"instance-id",
+							"user-id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211202),
+							domain.UserStateInactive,
+							testNow,
+							testNow,
+							testNow,
+							domain.UserLifecycleActionNotifyRemoval,
+						},
+					},
+				),
+			},
+			object: &UserActivities{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				UserActivities: []*UserActivity{
+					{
+						InstanceID:       "instance-id",
+						UserID:           "user-id",
+						CreationDate:     testNow,
+						ChangeDate:       testNow,
+						ResourceOwner:    "ro",
+						Sequence:         20211202,
+						State:            domain.UserStateInactive,
+						LastLogin:        testNow,
+						LastActivity:     testNow,
+						DeactivationDate: testNow,
+						NotifiedAction:   domain.UserLifecycleActionNotifyRemoval,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareUserActivitiesQuery sql err",
+			prepare: prepareUserActivitiesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					userActivitiesStmt,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
+
+func Test_evaluateUserLifecycleActions(t *testing.T) {
+	now := time.Date(2023, 8, 1, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	policies := []*UserLifecyclePolicy{
+		{
+			ID:                     "instance-id",
+			InstanceID:             "instance-id",
+			InactivityDeactivation: 90 * day,
+			DeactivatedRemoval:     30 * day,
+			NotificationPeriod:     7 * day,
+			IsDefault:              true,
+		},
+		{
+			ID:         "org-disabled",
+			InstanceID: "instance-id",
+		},
+	}
+	active := &UserActivity{
+		InstanceID:    "instance-id",
+		UserID:        "active",
+		ResourceOwner: "org",
+		State:         domain.UserStateActive,
+		LastActivity:  now.Add(-day),
+	}
+	inactiveTooLong := &UserActivity{
+		InstanceID:    "instance-id",
+		UserID:        "inactive-too-long",
+		ResourceOwner: "org",
+		State:         domain.UserStateActive,
+		LastActivity:  now.Add(-91 * day),
+	}
+	disabledOrg := &UserActivity{
+		InstanceID:    "instance-id",
+		UserID:        "disabled-org",
+		ResourceOwner: "org-disabled",
+		State:         domain.UserStateActive,
+		LastActivity:  now.Add(-91 * day),
+	}
+	removalNotification := &UserActivity{
+		InstanceID:       "instance-id",
+		UserID:           "removal-notification",
+		ResourceOwner:    "org",
+		State:            domain.UserStateInactive,
+		LastActivity:     now.Add(-200 * day),
+		DeactivationDate: now.Add(-25 * day),
+	}
+	otherInstance := &UserActivity{
+		InstanceID:    "other-instance",
+		UserID:        "other-instance",
+		ResourceOwner: "org",
+		State:         domain.UserStateActive,
+		LastActivity:  now.Add(-91 * day),
+	}
+
+	got := evaluateUserLifecycleActions(policies, []*UserActivity{active, inactiveTooLong, disabledOrg, removalNotification, otherInstance}, now)
+	assert.Equal(t, &UserLifecycleActions{
+		SearchResponse: SearchResponse{
+			Count: 2,
+		},
+		Actions: []*UserLifecycleAction{
+			{
+				UserActivity: inactiveTooLong,
+				Action:       domain.UserLifecycleActionDeactivate,
+				DueDate:      now.Add(-day),
+			},
+			{
+				UserActivity: removalNotification,
+				Action:       domain.UserLifecycleActionNotifyRemoval,
+				DueDate:      now.Add(5 * day),
+			},
+		},
+	}, got)
+}
@@ -0,0 +1,269 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	errs "errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+var (
+	userConsentsTable = table{
+		name:          projection.UserConsentProjectionTable,
+		instanceIDCol: projection.UserConsentColumnInstanceID,
+	}
+	UserConsentColumnUserID = Column{
+		name:  projection.UserConsentColumnUserID,
+		table: userConsentsTable,
+	}
+	UserConsentColumnClientID = Column{
+		name:  projection.UserConsentColumnClientID,
+		table: userConsentsTable,
+	}
+	UserConsentColumnProjectID = Column{
+		name:  projection.UserConsentColumnProjectID,
+		table: userConsentsTable,
+	}
+	UserConsentColumnCreationDate = Column{
+		name:  projection.UserConsentColumnCreationDate,
+		table: userConsentsTable,
+	}
+	UserConsentColumnChangeDate = Column{
+		name:  projection.UserConsentColumnChangeDate,
+		table: userConsentsTable,
+	}
+	UserConsentColumnResourceOwner = Column{
+		name:  projection.UserConsentColumnResourceOwner,
+		table: userConsentsTable,
+	}
+	UserConsentColumnInstanceID = Column{
+		name:  projection.UserConsentColumnInstanceID,
+		table: userConsentsTable,
+	}
+	UserConsentColumnSequence = Column{
+		name:  projection.UserConsentColumnSequence,
+		table: userConsentsTable,
+	}
+	UserConsentColumnScopes = Column{
+		name:  projection.UserConsentColumnScopes,
+		table: userConsentsTable,
+	}
+	UserConsentColumnOwnerRemoved = Column{
+		name:  projection.UserConsentColumnOwnerRemoved,
+		table: userConsentsTable,
+	}
+)
+
+type UserConsents struct {
+	SearchResponse
+	Consents []*UserConsent
+}
+
+type UserConsent struct {
+	ClientID      string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	Sequence      uint64
+
+	UserID    string
+	ProjectID string
+	Scopes    database.StringArray
+}
+
+// ContainsScopes returns if the user consented to all of the provided scopes
+func (c *UserConsent) ContainsScopes(scopes []string) bool {
+	if c == nil {
+		return len(scopes) == 0
+	}
+	granted := make(map[string]struct{}, len(c.Scopes))
+	for _, scope := range c.Scopes {
+		granted[scope] = struct{}{}
+	}
+	for _, scope := range scopes {
+		if _, ok := granted[scope]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+type UserConsentSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *Queries) UserConsentByClientID(ctx context.Context, shouldTriggerBulk bool, userID, clientID string) (_ *UserConsent, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		ctx = projection.UserConsentProjection.Trigger(ctx)
+	}
+
+	query, scan := prepareUserConsentQuery(ctx, q.client)
+	stmt, args, err := query.Where(sq.Eq{
+		UserConsentColumnUserID.identifier():       userID,
+		UserConsentColumnClientID.identifier():     clientID,
+		UserConsentColumnInstanceID.identifier():   authz.GetInstance(ctx).InstanceID(),
+		UserConsentColumnOwnerRemoved.identifier(): false,
+	}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Cq2nx", "Errors.Query.SQLStatment")
+	}
+
+	row := q.client.QueryRowContext(ctx, stmt, args...)
+	return scan(row)
+}
+
+func (q *Queries) SearchUserConsents(ctx context.Context, queries *UserConsentSearchQueries, withOwnerRemoved bool) (consents *UserConsents, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareUserConsentsQuery(ctx, q.client)
+	eq := sq.Eq{
+		UserConsentColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	if !withOwnerRemoved {
+		eq[UserConsentColumnOwnerRemoved.identifier()] = false
+	}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Cw4kd", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Cz7ma", "Errors.Internal")
+	}
+	consents, err = scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	consents.LatestSequence, err = q.latestSequence(ctx, userConsentsTable)
+	return consents, err
+}
+
+func NewUserConsentResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(UserConsentColumnResourceOwner, value, TextEquals)
+}
+
+func NewUserConsentUserIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(UserConsentColumnUserID, value, TextEquals)
+}
+
+func NewUserConsentClientIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(UserConsentColumnClientID, value, TextEquals)
+}
+
+func (r *UserConsentSearchQueries) AppendMyResourceOwnerQuery(orgID string) error {
+	query, err := NewUserConsentResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return err
+	}
+	r.Queries = append(r.Queries, query)
+	return nil
+}
+
+func (q *UserConsentSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func prepareUserConsentQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*UserConsent, error)) {
+	return sq.Select(
+			UserConsentColumnClientID.identifier(),
+			UserConsentColumnCreationDate.identifier(),
+			UserConsentColumnChangeDate.identifier(),
+			UserConsentColumnResourceOwner.identifier(),
+			UserConsentColumnSequence.identifier(),
+			UserConsentColumnUserID.identifier(),
+			UserConsentColumnProjectID.identifier(),
+			UserConsentColumnScopes.identifier()).
+			From(userConsentsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*UserConsent, error) {
+			c := new(UserConsent)
+			var projectID sql.NullString
+			err := row.Scan(
+				&c.ClientID,
+				&c.CreationDate,
+				&c.ChangeDate,
+				&c.ResourceOwner,
+				&c.Sequence,
+				&c.UserID,
+				&projectID,
+				&c.Scopes,
+			)
+			if err != nil {
+				if errs.Is(err, sql.ErrNoRows) {
+					return nil, errors.ThrowNotFound(err, "QUERY-Cx3vb", "Errors.User.Consent.NotFound")
+				}
+				return nil, errors.ThrowInternal(err, "QUERY-Cp8wq", "Errors.Internal")
+			}
+			c.ProjectID = projectID.String
+			return c, nil
+		}
+}
+
+func prepareUserConsentsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*UserConsents, error)) {
+	return sq.Select(
+			UserConsentColumnClientID.identifier(),
+			UserConsentColumnCreationDate.identifier(),
+			UserConsentColumnChangeDate.identifier(),
+			UserConsentColumnResourceOwner.identifier(),
+			UserConsentColumnSequence.identifier(),
+			UserConsentColumnUserID.identifier(),
+			UserConsentColumnProjectID.identifier(),
+			UserConsentColumnScopes.identifier(),
+			countColumn.identifier()).
+			From(userConsentsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*UserConsents, error) {
+			consents := make([]*UserConsent, 0)
+			var count uint64
+			for rows.Next() {
+				consent := new(UserConsent)
+				var projectID sql.NullString
+				err := rows.Scan(
+					&consent.ClientID,
+					&consent.CreationDate,
+					&consent.ChangeDate,
+					&consent.ResourceOwner,
+					&consent.Sequence,
+					&consent.UserID,
+					&projectID,
+					&consent.Scopes,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				consent.ProjectID = projectID.String
+				consents = append(consents, consent)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Cf6tj", "Errors.Query.CloseRows")
+			}
+
+			return &UserConsents{
+				Consents: consents,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
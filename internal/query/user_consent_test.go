@@ -0,0 +1,252 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	errs "github.com/zitadel/zitadel/internal/errors"
+)
+
+var (
+	userConsentStmt = regexp.QuoteMeta(
+		"SELECT projections.user_consents.client_id," +
+			" projections.user_consents.creation_date," +
+			" projections.user_consents.change_date," +
+			" projections.user_consents.resource_owner," +
+			" projections.user_consents.sequence," +
+			" projections.user_consents.user_id," +
+			" projections.user_consents.project_id," +
+			" projections.user_consents.scopes" +
+			" FROM projections.user_consents" +
+			` AS OF SYSTEM TIME '-1 ms'`)
+	userConsentCols = []string{
+		"client_id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"user_id",
+		"project_id",
+		"scopes",
+	}
+	userConsentsStmt = regexp.QuoteMeta(
+		"SELECT projections.user_consents.client_id," +
+			" projections.user_consents.creation_date," +
+			" projections.user_consents.change_date," +
+			" projections.user_consents.resource_owner," +
+			" projections.user_consents.sequence," +
+			" projections.user_consents.user_id," +
+			" projections.user_consents.project_id," +
+			" projections.user_consents.scopes," +
+			" COUNT(*) OVER ()" +
+			" FROM projections.user_consents" +
+			" AS OF SYSTEM TIME '-1 ms'")
+	userConsentsCols = []string{
+		"client_id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"user_id",
+		"project_id",
+		"scopes",
+		"count",
+	}
+)
+
+func Test_UserConsentPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareUserConsentQuery no result",
+			prepare: prepareUserConsentQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					userConsentStmt,
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*UserConsent)(nil),
+		},
+		{
+			name:    "prepareUserConsentQuery found",
+			prepare: prepareUserConsentQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					userConsentStmt,
+					userConsentCols,
+					[]driver.Value{
+						"client-id",
+						testNow,
+						testNow,
+						"ro",
+						uint64(20211202),
+						"user-id",
+						"project-id",
+						database.StringArray{"openid", "invoices:read"},
+					},
+				),
+			},
+			object: &UserConsent{
+				ClientID:      "client-id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				ResourceOwner: "ro",
+				Sequence:      20211202,
+				UserID:        "user-id",
+				ProjectID:     "project-id",
+				Scopes:        database.StringArray{"openid", "invoices:read"},
+			},
+		},
+		{
+			name:    "prepareUserConsentQuery sql err",
+			prepare: prepareUserConsentQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					userConsentStmt,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+		{
+			name:    "prepareUserConsentsQuery no result",
+			prepare: prepareUserConsentsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					userConsentsStmt,
+					nil,
+					nil,
+				),
+			},
+			object: &UserConsents{Consents: []*UserConsent{}},
+		},
+		{
+			name:    "prepareUserConsentsQuery one consent without project",
+			prepare: prepareUserConsentsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					userConsentsStmt,
+					userConsentsCols,
+					[][]driver.Value{
+						{
+							"client-id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211202),
+							"user-id",
+							nil,
+							database.StringArray{"openid"},
+						},
+					},
+				),
+			},
+			object: &UserConsents{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Consents: []*UserConsent{
+					{
+						ClientID:      "client-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						Sequence:      20211202,
+						UserID:        "user-id",
+						Scopes:        database.StringArray{"openid"},
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareUserConsentsQuery sql err",
+			prepare: prepareUserConsentsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					userConsentsStmt,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
+
+func TestUserConsent_ContainsScopes(t *testing.T) {
+	tests := []struct {
+		name    string
+		consent *UserConsent
+		scopes  []string
+		want    bool
+	}{
+		{
+			name:    "no consent, no scopes",
+			consent: nil,
+			scopes:  nil,
+			want:    true,
+		},
+		{
+			name:    "no consent",
+			consent: nil,
+			scopes:  []string{"openid"},
+			want:    false,
+		},
+		{
+			name:    "missing scope",
+			consent: &UserConsent{Scopes: database.StringArray{"openid"}},
+			scopes:  []string{"openid", "invoices:read"},
+			want:    false,
+		},
+		{
+			name:    "all scopes granted",
+			consent: &UserConsent{Scopes: database.StringArray{"openid", "invoices:read"}},
+			scopes:  []string{"invoices:read"},
+			want:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.consent.ContainsScopes(tt.scopes); got != tt.want {
+				t.Errorf("ContainsScopes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	errs "errors"
+	"strconv"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -27,6 +28,10 @@ type UserGrant struct {
 	// GrantID represents the project grant id
 	GrantID string
 	State   domain.UserGrantState
+	// ValidFrom and ValidUntil optionally limit the validity of the grant,
+	// active grants outside of the validity are returned with state expired
+	ValidFrom  time.Time
+	ValidUntil time.Time
 
 	UserID             string
 	Username           string
@@ -45,6 +50,9 @@ type UserGrant struct {
 
 	ProjectID   string
 	ProjectName string
+
+	// GroupID is set if the roles are granted to a group of the user, see UserGroupGrants
+	GroupID string
 }
 
 type UserGrants struct {
@@ -55,6 +63,9 @@ type UserGrants struct {
 type UserGrantsQueries struct {
 	SearchRequest
 	Queries []SearchQuery
+	// WithGroupGrants adds the roles granted to the groups of the users
+	// as user grants with the GroupID set, see UserGroupGrants
+	WithGroupGrants bool
 }
 
 func (q *UserGrantsQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
@@ -137,6 +148,39 @@ func NewUserGrantRoleQuery(value string) (SearchQuery, error) {
 	return NewTextQuery(UserGrantRoles, value, TextListContains)
 }
 
+func NewUserGrantStateQuery(state domain.UserGrantState) (SearchQuery, error) {
+	return NewNumberQuery(UserGrantState, state, NumberEquals)
+}
+
+// NewUserGrantValidAtQuery filters the grants which are valid at the provided point in time
+func NewUserGrantValidAtQuery(t time.Time) (SearchQuery, error) {
+	return &userGrantValidAtQuery{at: t, grants: userGrantTable}, nil
+}
+
+type userGrantValidAtQuery struct {
+	at     time.Time
+	grants table
+}
+
+func (q *userGrantValidAtQuery) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	return query.Where(q.comp())
+}
+
+func (q *userGrantValidAtQuery) comp() sq.Sqlizer {
+	validFrom := UserGrantValidFrom.setTable(q.grants).identifier()
+	validUntil := UserGrantValidUntil.setTable(q.grants).identifier()
+	return sq.And{
+		sq.Or{
+			sq.Eq{validFrom: nil},
+			sq.LtOrEq{validFrom: q.at},
+		},
+		sq.Or{
+			sq.Eq{validUntil: nil},
+			sq.Gt{validUntil: q.at},
+		},
+	}
+}
+
 func NewUserGrantWithGrantedQuery(owner string) (SearchQuery, error) {
 	orgQuery, err := NewUserGrantResourceOwnerSearchQuery(owner)
 	if err != nil {
@@ -206,6 +250,14 @@ var (
 		name:  projection.UserGrantState,
 		table: userGrantTable,
 	}
+	UserGrantValidFrom = Column{
+		name:  projection.UserGrantValidFrom,
+		table: userGrantTable,
+	}
+	UserGrantValidUntil = Column{
+		name:  projection.UserGrantValidUntil,
+		table: userGrantTable,
+	}
 	UserGrantOwnerRemoved = Column{
 		name:  projection.UserGrantOwnerRemoved,
 		table: userGrantTable,
@@ -222,13 +274,28 @@ var (
 		name:  projection.UserGrantGrantedOrgRemoved,
 		table: userGrantTable,
 	}
+
+	// userAndGroupGrantsTable is the union of the user grants and the roles granted to the groups of the users,
+	// it provides the columns of the user grants and the group id
+	userAndGroupGrantsTable = table{
+		name:          "user_and_group_grants",
+		instanceIDCol: projection.UserGrantInstanceID,
+	}
+	userAndGroupGrantGroupID = Column{
+		name:  projection.GroupGrantColumnGroupID,
+		table: userAndGroupGrantsTable,
+	}
 )
 
 func addUserGrantWithoutOwnerRemoved(eq map[string]interface{}) {
-	eq[UserGrantOwnerRemoved.identifier()] = false
-	eq[UserGrantUserOwnerRemoved.identifier()] = false
-	eq[UserGrantProjectOwnerRemoved.identifier()] = false
-	eq[UserGrantGrantGrantedOrgRemoved.identifier()] = false
+	addUserGrantOnTableWithoutOwnerRemoved(eq, userGrantTable)
+}
+
+func addUserGrantOnTableWithoutOwnerRemoved(eq map[string]interface{}, grants table) {
+	eq[UserGrantOwnerRemoved.setTable(grants).identifier()] = false
+	eq[UserGrantUserOwnerRemoved.setTable(grants).identifier()] = false
+	eq[UserGrantProjectOwnerRemoved.setTable(grants).identifier()] = false
+	eq[UserGrantGrantGrantedOrgRemoved.setTable(grants).identifier()] = false
 	addLoginNameWithoutOwnerRemoved(eq)
 }
 
@@ -261,6 +328,10 @@ func (q *Queries) UserGrants(ctx context.Context, queries *UserGrantsQueries, sh
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	if queries.WithGroupGrants {
+		return q.userAndGroupGrants(ctx, queries, shouldTriggerBulk, withOwnerRemoved)
+	}
+
 	if shouldTriggerBulk {
 		ctx = projection.UserGrantProjection.Trigger(ctx)
 	}
@@ -293,6 +364,222 @@ func (q *Queries) UserGrants(ctx context.Context, queries *UserGrantsQueries, sh
 	return grants, nil
 }
 
+// userAndGroupGrants returns the user grants together with the roles granted to the groups of the users.
+// The queries are applied on the union of both, see userAndGroupGrantsTable
+func (q *Queries) userAndGroupGrants(ctx context.Context, queries *UserGrantsQueries, shouldTriggerBulk, withOwnerRemoved bool) (_ *UserGrants, err error) {
+	if shouldTriggerBulk {
+		ctx = projection.UserGrantProjection.Trigger(ctx)
+		ctx = projection.GroupUserProjection.Trigger(ctx)
+		ctx = projection.GroupGrantProjection.Trigger(ctx)
+	}
+
+	queries, err = queries.onTable(userAndGroupGrantsTable)
+	if err != nil {
+		return nil, err
+	}
+	query, queryArgs, scan := prepareUserAndGroupGrantsQuery(ctx, q.client)
+	eq := sq.Eq{UserGrantInstanceID.setTable(userAndGroupGrantsTable).identifier(): authz.GetInstance(ctx).InstanceID()}
+	if !withOwnerRemoved {
+		addUserGrantOnTableWithoutOwnerRemoved(eq, userAndGroupGrantsTable)
+	}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Gt3ug", "Errors.Query.SQLStatement")
+	}
+	queryArgs = append(queryArgs, args...)
+
+	latestSequence, err := q.latestSequence(ctx, userGrantTable, groupUsersTable, groupGrantsTable)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, queryArgs...)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := scan(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	grants.LatestSequence = latestSequence
+	return grants, nil
+}
+
+// onTable returns a copy of the queries with the user grant columns set to the provided table
+func (q *UserGrantsQueries) onTable(grants table) (*UserGrantsQueries, error) {
+	queries := &UserGrantsQueries{
+		SearchRequest:   q.SearchRequest,
+		Queries:         make([]SearchQuery, len(q.Queries)),
+		WithGroupGrants: q.WithGroupGrants,
+	}
+	queries.SortingColumn = userGrantColumnOnTable(q.SortingColumn, grants)
+	for i, query := range q.Queries {
+		onTable, err := userGrantQueryOnTable(query, grants)
+		if err != nil {
+			return nil, err
+		}
+		queries.Queries[i] = onTable
+	}
+	return queries, nil
+}
+
+func userGrantQueryOnTable(query SearchQuery, grants table) (_ SearchQuery, err error) {
+	switch q := query.(type) {
+	case *TextQuery:
+		return &TextQuery{Column: userGrantColumnOnTable(q.Column, grants), Text: q.Text, Compare: q.Compare}, nil
+	case *InTextQuery:
+		return &InTextQuery{Column: userGrantColumnOnTable(q.Column, grants), Values: q.Values}, nil
+	case *NumberQuery:
+		return &NumberQuery{Column: userGrantColumnOnTable(q.Column, grants), Number: q.Number, Compare: q.Compare}, nil
+	case *ListQuery:
+		return &ListQuery{Column: userGrantColumnOnTable(q.Column, grants), Data: q.Data, Compare: q.Compare}, nil
+	case *BoolQuery:
+		return &BoolQuery{Column: userGrantColumnOnTable(q.Column, grants), Value: q.Value}, nil
+	case *NotNullQuery:
+		return &NotNullQuery{Column: userGrantColumnOnTable(q.Column, grants)}, nil
+	case *IsNullQuery:
+		return &IsNullQuery{Column: userGrantColumnOnTable(q.Column, grants)}, nil
+	case *userGrantValidAtQuery:
+		return &userGrantValidAtQuery{at: q.at, grants: grants}, nil
+	case *orQuery:
+		queries := make([]SearchQuery, len(q.queries))
+		for i, query := range q.queries {
+			if queries[i], err = userGrantQueryOnTable(query, grants); err != nil {
+				return nil, err
+			}
+		}
+		return &orQuery{queries: queries}, nil
+	case *or:
+		queries := make([]SearchQuery, len(q.queries))
+		for i, query := range q.queries {
+			if queries[i], err = userGrantQueryOnTable(query, grants); err != nil {
+				return nil, err
+			}
+		}
+		return Or(queries...), nil
+	}
+	return nil, errors.ThrowInvalidArgument(nil, "QUERY-Gt4ut", "Errors.Query.InvalidRequest")
+}
+
+func userGrantColumnOnTable(c Column, grants table) Column {
+	if c.table != userGrantTable {
+		return c
+	}
+	return c.setTable(grants)
+}
+
+func getUserAndGroupGrantsFromQuery() (string, []interface{}) {
+	userGrants, userGrantsArgs := prepareUserGrantsOfUnion()
+	groupGrants, groupGrantsArgs := prepareGroupGrantsOfUnion()
+	return "(" +
+			userGrants +
+			" UNION ALL " +
+			groupGrants +
+			") AS " + userAndGroupGrantsTable.identifier(),
+		append(userGrantsArgs, groupGrantsArgs...)
+}
+
+func prepareUserGrantsOfUnion() (string, []interface{}) {
+	return sq.Select(
+		UserGrantID.identifier(),
+		UserGrantCreationDate.identifier(),
+		UserGrantChangeDate.identifier(),
+		UserGrantSequence.identifier(),
+		UserGrantGrantID.identifier(),
+		UserGrantRoles.identifier(),
+		UserGrantState.identifier(),
+		UserGrantValidFrom.identifier(),
+		UserGrantValidUntil.identifier(),
+		UserGrantUserID.identifier(),
+		UserGrantResourceOwner.identifier(),
+		UserGrantProjectID.identifier(),
+		UserGrantInstanceID.identifier(),
+		UserGrantOwnerRemoved.identifier(),
+		UserGrantUserOwnerRemoved.identifier(),
+		UserGrantProjectOwnerRemoved.identifier(),
+		UserGrantGrantGrantedOrgRemoved.identifier(),
+		"NULL::TEXT AS "+userAndGroupGrantGroupID.name,
+	).From(userGrantTable.identifier()).MustSql()
+}
+
+// prepareGroupGrantsOfUnion returns the roles granted to the groups as active grants of the users of the groups
+func prepareGroupGrantsOfUnion() (string, []interface{}) {
+	return sq.Select(
+		"''::TEXT AS "+UserGrantID.name,
+		GroupGrantColumnCreationDate.identifier(),
+		GroupGrantColumnChangeDate.identifier(),
+		GroupGrantColumnSequence.identifier(),
+		GroupGrantColumnGrantID.identifier(),
+		GroupGrantColumnRoles.identifier(),
+		strconv.Itoa(int(domain.UserGrantStateActive))+"::SMALLINT AS "+UserGrantState.name,
+		"NULL::TIMESTAMPTZ AS "+UserGrantValidFrom.name,
+		"NULL::TIMESTAMPTZ AS "+UserGrantValidUntil.name,
+		GroupUserColumnUserID.identifier(),
+		GroupGrantColumnResourceOwner.identifier(),
+		GroupGrantColumnProjectID.identifier(),
+		GroupGrantColumnInstanceID.identifier(),
+		GroupGrantColumnOwnerRemoved.identifier(),
+		GroupUserColumnOwnerRemoved.identifier()+" AS "+UserGrantUserOwnerRemoved.name,
+		"FALSE AS "+UserGrantProjectOwnerRemoved.name,
+		"FALSE AS "+UserGrantGrantGrantedOrgRemoved.name,
+		GroupGrantColumnGroupID.identifier(),
+	).From(groupGrantsTable.identifier()).
+		Join(join(GroupUserColumnGroupID, GroupGrantColumnGroupID)).
+		MustSql()
+}
+
+func prepareUserAndGroupGrantsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, []interface{}, func(*sql.Rows) (*UserGrants, error)) {
+	query, args := getUserAndGroupGrantsFromQuery()
+	grantUserID := UserGrantUserID.setTable(userAndGroupGrantsTable)
+	return sq.Select(
+			UserGrantID.setTable(userAndGroupGrantsTable).identifier(),
+			UserGrantCreationDate.setTable(userAndGroupGrantsTable).identifier(),
+			UserGrantChangeDate.setTable(userAndGroupGrantsTable).identifier(),
+			UserGrantSequence.setTable(userAndGroupGrantsTable).identifier(),
+			UserGrantGrantID.setTable(userAndGroupGrantsTable).identifier(),
+			UserGrantRoles.setTable(userAndGroupGrantsTable).identifier(),
+			UserGrantState.setTable(userAndGroupGrantsTable).identifier(),
+			UserGrantValidFrom.setTable(userAndGroupGrantsTable).identifier(),
+			UserGrantValidUntil.setTable(userAndGroupGrantsTable).identifier(),
+
+			grantUserID.identifier(),
+			UserUsernameCol.identifier(),
+			UserTypeCol.identifier(),
+			UserResourceOwnerCol.identifier(),
+			HumanFirstNameCol.identifier(),
+			HumanLastNameCol.identifier(),
+			HumanEmailCol.identifier(),
+			HumanDisplayNameCol.identifier(),
+			HumanAvatarURLCol.identifier(),
+			LoginNameNameCol.identifier(),
+
+			UserGrantResourceOwner.setTable(userAndGroupGrantsTable).identifier(),
+			OrgColumnName.identifier(),
+			OrgColumnDomain.identifier(),
+
+			UserGrantProjectID.setTable(userAndGroupGrantsTable).identifier(),
+			ProjectColumnName.identifier(),
+
+			userAndGroupGrantGroupID.identifier(),
+
+			countColumn.identifier(),
+		).
+			From(query).
+			LeftJoin(join(UserIDCol, grantUserID)).
+			LeftJoin(join(HumanUserIDCol, grantUserID)).
+			LeftJoin(join(OrgColumnID, UserGrantResourceOwner.setTable(userAndGroupGrantsTable))).
+			LeftJoin(join(ProjectColumnID, UserGrantProjectID.setTable(userAndGroupGrantsTable))).
+			LeftJoin(join(LoginNameUserIDCol, grantUserID) + db.Timetravel(call.Took(ctx))).
+			Where(
+				sq.Eq{LoginNameIsPrimaryCol.identifier(): true},
+			).PlaceholderFormat(sq.Dollar),
+		args,
+		func(rows *sql.Rows) (*UserGrants, error) {
+			return scanUserGrants(rows, true)
+		}
+}
+
 func prepareUserGrantQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*UserGrant, error)) {
 	return sq.Select(
 			UserGrantID.identifier(),
@@ -302,6 +589,8 @@ func prepareUserGrantQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 			UserGrantGrantID.identifier(),
 			UserGrantRoles.identifier(),
 			UserGrantState.identifier(),
+			UserGrantValidFrom.identifier(),
+			UserGrantValidUntil.identifier(),
 
 			UserGrantUserID.identifier(),
 			UserUsernameCol.identifier(),
@@ -348,6 +637,9 @@ func prepareUserGrantQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 				orgDomain sql.NullString
 
 				projectName sql.NullString
+
+				validFrom  sql.NullTime
+				validUntil sql.NullTime
 			)
 
 			err := row.Scan(
@@ -358,6 +650,8 @@ func prepareUserGrantQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 				&g.GrantID,
 				&g.Roles,
 				&g.State,
+				&validFrom,
+				&validUntil,
 
 				&g.UserID,
 				&username,
@@ -396,6 +690,7 @@ func prepareUserGrantQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 			g.OrgName = orgName.String
 			g.OrgPrimaryDomain = orgDomain.String
 			g.ProjectName = projectName.String
+			g.setValidity(validFrom.Time, validUntil.Time)
 
 			return g, nil
 		}
@@ -410,6 +705,8 @@ func prepareUserGrantsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 			UserGrantGrantID.identifier(),
 			UserGrantRoles.identifier(),
 			UserGrantState.identifier(),
+			UserGrantValidFrom.identifier(),
+			UserGrantValidUntil.identifier(),
 
 			UserGrantUserID.identifier(),
 			UserUsernameCol.identifier(),
@@ -441,86 +738,109 @@ func prepareUserGrantsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 				sq.Eq{LoginNameIsPrimaryCol.identifier(): true},
 			).PlaceholderFormat(sq.Dollar),
 		func(rows *sql.Rows) (*UserGrants, error) {
-			userGrants := make([]*UserGrant, 0)
-			var count uint64
-			for rows.Next() {
-				g := new(UserGrant)
-
-				var (
-					username           sql.NullString
-					userType           sql.NullInt32
-					userOwner          sql.NullString
-					firstName          sql.NullString
-					lastName           sql.NullString
-					email              sql.NullString
-					displayName        sql.NullString
-					avatarURL          sql.NullString
-					preferredLoginName sql.NullString
-
-					orgName   sql.NullString
-					orgDomain sql.NullString
-
-					projectName sql.NullString
-				)
-
-				err := rows.Scan(
-					&g.ID,
-					&g.CreationDate,
-					&g.ChangeDate,
-					&g.Sequence,
-					&g.GrantID,
-					&g.Roles,
-					&g.State,
-
-					&g.UserID,
-					&username,
-					&userType,
-					&userOwner,
-					&firstName,
-					&lastName,
-					&email,
-					&displayName,
-					&avatarURL,
-					&preferredLoginName,
-
-					&g.ResourceOwner,
-					&orgName,
-					&orgDomain,
-
-					&g.ProjectID,
-					&projectName,
-
-					&count,
-				)
-				if err != nil {
-					return nil, err
-				}
+			return scanUserGrants(rows, false)
+		}
+}
 
-				g.Username = username.String
-				g.UserType = domain.UserType(userType.Int32)
-				g.UserResourceOwner = userOwner.String
-				g.FirstName = firstName.String
-				g.LastName = lastName.String
-				g.Email = email.String
-				g.DisplayName = displayName.String
-				g.AvatarURL = avatarURL.String
-				g.PreferredLoginName = preferredLoginName.String
-				g.OrgName = orgName.String
-				g.OrgPrimaryDomain = orgDomain.String
-				g.ProjectName = projectName.String
-
-				userGrants = append(userGrants, g)
-			}
+func scanUserGrants(rows *sql.Rows, withGroupID bool) (*UserGrants, error) {
+	userGrants := make([]*UserGrant, 0)
+	var count uint64
+	for rows.Next() {
+		g := new(UserGrant)
+
+		var (
+			username           sql.NullString
+			userType           sql.NullInt32
+			userOwner          sql.NullString
+			firstName          sql.NullString
+			lastName           sql.NullString
+			email              sql.NullString
+			displayName        sql.NullString
+			avatarURL          sql.NullString
+			preferredLoginName sql.NullString
+
+			orgName   sql.NullString
+			orgDomain sql.NullString
+
+			projectName sql.NullString
+
+			validFrom  sql.NullTime
+			validUntil sql.NullTime
+
+			groupID sql.NullString
+		)
+
+		dest := []interface{}{
+			&g.ID,
+			&g.CreationDate,
+			&g.ChangeDate,
+			&g.Sequence,
+			&g.GrantID,
+			&g.Roles,
+			&g.State,
+			&validFrom,
+			&validUntil,
+
+			&g.UserID,
+			&username,
+			&userType,
+			&userOwner,
+			&firstName,
+			&lastName,
+			&email,
+			&displayName,
+			&avatarURL,
+			&preferredLoginName,
+
+			&g.ResourceOwner,
+			&orgName,
+			&orgDomain,
+
+			&g.ProjectID,
+			&projectName,
+		}
+		if withGroupID {
+			dest = append(dest, &groupID)
+		}
+		err := rows.Scan(append(dest, &count)...)
+		if err != nil {
+			return nil, err
+		}
 
-			if err := rows.Close(); err != nil {
-				return nil, errors.ThrowInternal(err, "QUERY-iGvmP", "Errors.Query.CloseRows")
-			}
+		g.Username = username.String
+		g.UserType = domain.UserType(userType.Int32)
+		g.UserResourceOwner = userOwner.String
+		g.FirstName = firstName.String
+		g.LastName = lastName.String
+		g.Email = email.String
+		g.DisplayName = displayName.String
+		g.AvatarURL = avatarURL.String
+		g.PreferredLoginName = preferredLoginName.String
+		g.OrgName = orgName.String
+		g.OrgPrimaryDomain = orgDomain.String
+		g.ProjectName = projectName.String
+		g.GroupID = groupID.String
+		g.setValidity(validFrom.Time, validUntil.Time)
+
+		userGrants = append(userGrants, g)
+	}
 
-			return &UserGrants{
-				UserGrants: userGrants,
-				SearchResponse: SearchResponse{
-					Count: count,
-				},
-			}, nil
-		}
+	if err := rows.Close(); err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-iGvmP", "Errors.Query.CloseRows")
+	}
+
+	return &UserGrants{
+		UserGrants: userGrants,
+		SearchResponse: SearchResponse{
+			Count: count,
+		},
+	}, nil
+}
+
+func (g *UserGrant) setValidity(validFrom, validUntil time.Time) {
+	g.ValidFrom = validFrom
+	g.ValidUntil = validUntil
+	if g.State == domain.UserGrantStateActive && !domain.IsGrantValidAt(validFrom, validUntil, time.Now()) {
+		g.State = domain.UserGrantStateExpired
+	}
 }
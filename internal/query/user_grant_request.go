@@ -0,0 +1,307 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	errs "errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+var (
+	userGrantRequestsTable = table{
+		name:          projection.UserGrantRequestProjectionTable,
+		instanceIDCol: projection.UserGrantRequestColumnInstanceID,
+	}
+	UserGrantRequestColumnID = Column{
+		name:  projection.UserGrantRequestColumnID,
+		table: userGrantRequestsTable,
+	}
+	UserGrantRequestColumnCreationDate = Column{
+		name:  projection.UserGrantRequestColumnCreationDate,
+		table: userGrantRequestsTable,
+	}
+	UserGrantRequestColumnChangeDate = Column{
+		name:  projection.UserGrantRequestColumnChangeDate,
+		table: userGrantRequestsTable,
+	}
+	UserGrantRequestColumnSequence = Column{
+		name:  projection.UserGrantRequestColumnSequence,
+		table: userGrantRequestsTable,
+	}
+	UserGrantRequestColumnState = Column{
+		name:  projection.UserGrantRequestColumnState,
+		table: userGrantRequestsTable,
+	}
+	UserGrantRequestColumnResourceOwner = Column{
+		name:  projection.UserGrantRequestColumnResourceOwner,
+		table: userGrantRequestsTable,
+	}
+	UserGrantRequestColumnInstanceID = Column{
+		name:  projection.UserGrantRequestColumnInstanceID,
+		table: userGrantRequestsTable,
+	}
+	UserGrantRequestColumnUserID = Column{
+		name:  projection.UserGrantRequestColumnUserID,
+		table: userGrantRequestsTable,
+	}
+	UserGrantRequestColumnProjectID = Column{
+		name:  projection.UserGrantRequestColumnProjectID,
+		table: userGrantRequestsTable,
+	}
+	UserGrantRequestColumnGrantID = Column{
+		name:  projection.UserGrantRequestColumnGrantID,
+		table: userGrantRequestsTable,
+	}
+	UserGrantRequestColumnRoles = Column{
+		name:  projection.UserGrantRequestColumnRoles,
+		table: userGrantRequestsTable,
+	}
+	UserGrantRequestColumnReason = Column{
+		name:  projection.UserGrantRequestColumnReason,
+		table: userGrantRequestsTable,
+	}
+	UserGrantRequestColumnDenialReason = Column{
+		name:  projection.UserGrantRequestColumnDenialReason,
+		table: userGrantRequestsTable,
+	}
+	UserGrantRequestColumnOwnerRemoved = Column{
+		name:  projection.UserGrantRequestColumnOwnerRemoved,
+		table: userGrantRequestsTable,
+	}
+)
+
+type UserGrantRequests struct {
+	SearchResponse
+	Requests []*UserGrantRequest
+}
+
+type UserGrantRequest struct {
+	ID            string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	ResourceOwner string
+	State         domain.UserGrantRequestState
+
+	UserID       string
+	ProjectID    string
+	GrantID      string
+	Roles        database.StringArray
+	Reason       string
+	DenialReason string
+}
+
+type UserGrantRequestSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *Queries) UserGrantRequestByID(ctx context.Context, shouldTriggerBulk bool, id string, queries ...SearchQuery) (_ *UserGrantRequest, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		ctx = projection.UserGrantRequestProjection.Trigger(ctx)
+	}
+
+	query, scan := prepareUserGrantRequestQuery(ctx, q.client)
+	for _, q := range queries {
+		query = q.toQuery(query)
+	}
+	stmt, args, err := query.Where(sq.Eq{
+		UserGrantRequestColumnID.identifier():           id,
+		UserGrantRequestColumnInstanceID.identifier():   authz.GetInstance(ctx).InstanceID(),
+		UserGrantRequestColumnOwnerRemoved.identifier(): false,
+	}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Gr4qa", "Errors.Query.SQLStatment")
+	}
+
+	row := q.client.QueryRowContext(ctx, stmt, args...)
+	return scan(row)
+}
+
+func (q *Queries) SearchUserGrantRequests(ctx context.Context, queries *UserGrantRequestSearchQueries, withOwnerRemoved bool) (requests *UserGrantRequests, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareUserGrantRequestsQuery(ctx, q.client)
+	eq := sq.Eq{
+		UserGrantRequestColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	if !withOwnerRemoved {
+		eq[UserGrantRequestColumnOwnerRemoved.identifier()] = false
+	}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Rq2lw", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Rq8sd", "Errors.Internal")
+	}
+	requests, err = scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	requests.LatestSequence, err = q.latestSequence(ctx, userGrantRequestsTable)
+	return requests, err
+}
+
+func NewUserGrantRequestResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(UserGrantRequestColumnResourceOwner, value, TextEquals)
+}
+
+func NewUserGrantRequestUserIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(UserGrantRequestColumnUserID, value, TextEquals)
+}
+
+func NewUserGrantRequestProjectIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(UserGrantRequestColumnProjectID, value, TextEquals)
+}
+
+func NewUserGrantRequestGrantIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(UserGrantRequestColumnGrantID, value, TextEquals)
+}
+
+func NewUserGrantRequestStateSearchQuery(value domain.UserGrantRequestState) (SearchQuery, error) {
+	return NewNumberQuery(UserGrantRequestColumnState, int32(value), NumberEquals)
+}
+
+func (r *UserGrantRequestSearchQueries) AppendMyResourceOwnerQuery(orgID string) error {
+	query, err := NewUserGrantRequestResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return err
+	}
+	r.Queries = append(r.Queries, query)
+	return nil
+}
+
+func (q *UserGrantRequestSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func prepareUserGrantRequestQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*UserGrantRequest, error)) {
+	return sq.Select(
+			UserGrantRequestColumnID.identifier(),
+			UserGrantRequestColumnCreationDate.identifier(),
+			UserGrantRequestColumnChangeDate.identifier(),
+			UserGrantRequestColumnSequence.identifier(),
+			UserGrantRequestColumnResourceOwner.identifier(),
+			UserGrantRequestColumnState.identifier(),
+			UserGrantRequestColumnUserID.identifier(),
+			UserGrantRequestColumnProjectID.identifier(),
+			UserGrantRequestColumnGrantID.identifier(),
+			UserGrantRequestColumnRoles.identifier(),
+			UserGrantRequestColumnReason.identifier(),
+			UserGrantRequestColumnDenialReason.identifier()).
+			From(userGrantRequestsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*UserGrantRequest, error) {
+			r := new(UserGrantRequest)
+			var (
+				reason       sql.NullString
+				denialReason sql.NullString
+			)
+			err := row.Scan(
+				&r.ID,
+				&r.CreationDate,
+				&r.ChangeDate,
+				&r.Sequence,
+				&r.ResourceOwner,
+				&r.State,
+				&r.UserID,
+				&r.ProjectID,
+				&r.GrantID,
+				&r.Roles,
+				&reason,
+				&denialReason,
+			)
+			if err != nil {
+				if errs.Is(err, sql.ErrNoRows) {
+					return nil, errors.ThrowNotFound(err, "QUERY-Rq3mf", "Errors.UserGrant.Request.NotFound")
+				}
+				return nil, errors.ThrowInternal(err, "QUERY-Rq9vb", "Errors.Internal")
+			}
+			r.Reason = reason.String
+			r.DenialReason = denialReason.String
+			return r, nil
+		}
+}
+
+func prepareUserGrantRequestsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*UserGrantRequests, error)) {
+	return sq.Select(
+			UserGrantRequestColumnID.identifier(),
+			UserGrantRequestColumnCreationDate.identifier(),
+			UserGrantRequestColumnChangeDate.identifier(),
+			UserGrantRequestColumnSequence.identifier(),
+			UserGrantRequestColumnResourceOwner.identifier(),
+			UserGrantRequestColumnState.identifier(),
+			UserGrantRequestColumnUserID.identifier(),
+			UserGrantRequestColumnProjectID.identifier(),
+			UserGrantRequestColumnGrantID.identifier(),
+			UserGrantRequestColumnRoles.identifier(),
+			UserGrantRequestColumnReason.identifier(),
+			UserGrantRequestColumnDenialReason.identifier(),
+			countColumn.identifier()).
+			From(userGrantRequestsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*UserGrantRequests, error) {
+			requests := make([]*UserGrantRequest, 0)
+			var count uint64
+			for rows.Next() {
+				r := new(UserGrantRequest)
+				var (
+					reason       sql.NullString
+					denialReason sql.NullString
+				)
+				err := rows.Scan(
+					&r.ID,
+					&r.CreationDate,
+					&r.ChangeDate,
+					&r.Sequence,
+					&r.ResourceOwner,
+					&r.State,
+					&r.UserID,
+					&r.ProjectID,
+					&r.GrantID,
+					&r.Roles,
+					&reason,
+					&denialReason,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				r.Reason = reason.String
+				r.DenialReason = denialReason.String
+				requests = append(requests, r)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Rq0ps", "Errors.Query.CloseRows")
+			}
+
+			return &UserGrantRequests{
+				Requests: requests,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -0,0 +1,228 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	errs "github.com/zitadel/zitadel/internal/errors"
+)
+
+var (
+	userGrantRequestStmt = regexp.QuoteMeta(
+		"SELECT projections.user_grant_requests.id," +
+			" projections.user_grant_requests.creation_date," +
+			" projections.user_grant_requests.change_date," +
+			" projections.user_grant_requests.sequence," +
+			" projections.user_grant_requests.resource_owner," +
+			" projections.user_grant_requests.state," +
+			" projections.user_grant_requests.user_id," +
+			" projections.user_grant_requests.project_id," +
+			" projections.user_grant_requests.grant_id," +
+			" projections.user_grant_requests.roles," +
+			" projections.user_grant_requests.reason," +
+			" projections.user_grant_requests.denial_reason" +
+			" FROM projections.user_grant_requests" +
+			` AS OF SYSTEM TIME '-1 ms'`)
+	userGrantRequestCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"resource_owner",
+		"state",
+		"user_id",
+		"project_id",
+		"grant_id",
+		"roles",
+		"reason",
+		"denial_reason",
+	}
+	userGrantRequestsStmt = regexp.QuoteMeta(
+		"SELECT projections.user_grant_requests.id," +
+			" projections.user_grant_requests.creation_date," +
+			" projections.user_grant_requests.change_date," +
+			" projections.user_grant_requests.sequence," +
+			" projections.user_grant_requests.resource_owner," +
+			" projections.user_grant_requests.state," +
+			" projections.user_grant_requests.user_id," +
+			" projections.user_grant_requests.project_id," +
+			" projections.user_grant_requests.grant_id," +
+			" projections.user_grant_requests.roles," +
+			" projections.user_grant_requests.reason," +
+			" projections.user_grant_requests.denial_reason," +
+			" COUNT(*) OVER ()" +
+			" FROM projections.user_grant_requests" +
+			` AS OF SYSTEM TIME '-1 ms'`)
+	userGrantRequestsCols = append(userGrantRequestCols, "count")
+)
+
+func Test_UserGrantRequestPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareUserGrantRequestQuery no result",
+			prepare: prepareUserGrantRequestQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					userGrantRequestStmt,
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*UserGrantRequest)(nil),
+		},
+		{
+			name:    "prepareUserGrantRequestQuery found",
+			prepare: prepareUserGrantRequestQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					userGrantRequestStmt,
+					userGrantRequestCols,
+					[]driver.Value{
+						"request-id",
+						testNow,
+						testNow,
+						uint64(20211111),
+						"ro",
+						domain.UserGrantRequestStateRequested,
+						"user-id",
+						"project-id",
+						"",
+						database.StringArray{"role-key"},
+						"need access",
+						nil,
+					},
+				),
+			},
+			object: &UserGrantRequest{
+				ID:            "request-id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				Sequence:      20211111,
+				ResourceOwner: "ro",
+				State:         domain.UserGrantRequestStateRequested,
+				UserID:        "user-id",
+				ProjectID:     "project-id",
+				Roles:         database.StringArray{"role-key"},
+				Reason:        "need access",
+			},
+		},
+		{
+			name:    "prepareUserGrantRequestQuery sql err",
+			prepare: prepareUserGrantRequestQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					userGrantRequestStmt,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+		{
+			name:    "prepareUserGrantRequestsQuery no result",
+			prepare: prepareUserGrantRequestsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					userGrantRequestsStmt,
+					nil,
+					nil,
+				),
+			},
+			object: &UserGrantRequests{Requests: []*UserGrantRequest{}},
+		},
+		{
+			name:    "prepareUserGrantRequestsQuery denied request",
+			prepare: prepareUserGrantRequestsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					userGrantRequestsStmt,
+					userGrantRequestsCols,
+					[][]driver.Value{
+						{
+							"request-id",
+							testNow,
+							testNow,
+							uint64(20211111),
+							"ro",
+							domain.UserGrantRequestStateDenied,
+							"user-id",
+							"project-id",
+							"grant-id",
+							database.StringArray{"role-key"},
+							nil,
+							"not needed",
+						},
+					},
+				),
+			},
+			object: &UserGrantRequests{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Requests: []*UserGrantRequest{
+					{
+						ID:            "request-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211111,
+						ResourceOwner: "ro",
+						State:         domain.UserGrantRequestStateDenied,
+						UserID:        "user-id",
+						ProjectID:     "project-id",
+						GrantID:       "grant-id",
+						Roles:         database.StringArray{"role-key"},
+						DenialReason:  "not needed",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareUserGrantRequestsQuery sql err",
+			prepare: prepareUserGrantRequestsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					userGrantRequestsStmt,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package query
 
 import (
+	"context"
 	"database/sql"
 	"database/sql/driver"
 	"errors"
@@ -8,6 +9,8 @@ import (
 	"regexp"
 	"testing"
 
+	sq "github.com/Masterminds/squirrel"
+
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	errs "github.com/zitadel/zitadel/internal/errors"
@@ -15,14 +18,16 @@ import (
 
 var (
 	userGrantStmt = regexp.QuoteMeta(
-		"SELECT projections.user_grants3.id" +
-			", projections.user_grants3.creation_date" +
-			", projections.user_grants3.change_date" +
-			", projections.user_grants3.sequence" +
-			", projections.user_grants3.grant_id" +
-			", projections.user_grants3.roles" +
-			", projections.user_grants3.state" +
-			", projections.user_grants3.user_id" +
+		"SELECT projections.user_grants4.id" +
+			", projections.user_grants4.creation_date" +
+			", projections.user_grants4.change_date" +
+			", projections.user_grants4.sequence" +
+			", projections.user_grants4.grant_id" +
+			", projections.user_grants4.roles" +
+			", projections.user_grants4.state" +
+			", projections.user_grants4.valid_from" +
+			", projections.user_grants4.valid_until" +
+			", projections.user_grants4.user_id" +
 			", projections.users8.username" +
 			", projections.users8.type" +
 			", projections.users8.resource_owner" +
@@ -32,17 +37,17 @@ var (
 			", projections.users8_humans.display_name" +
 			", projections.users8_humans.avatar_key" +
 			", projections.login_names2.login_name" +
-			", projections.user_grants3.resource_owner" +
-			", projections.orgs.name" +
-			", projections.orgs.primary_domain" +
-			", projections.user_grants3.project_id" +
+			", projections.user_grants4.resource_owner" +
+			", projections.orgs1.name" +
+			", projections.orgs1.primary_domain" +
+			", projections.user_grants4.project_id" +
 			", projections.projects3.name" +
-			" FROM projections.user_grants3" +
-			" LEFT JOIN projections.users8 ON projections.user_grants3.user_id = projections.users8.id AND projections.user_grants3.instance_id = projections.users8.instance_id" +
-			" LEFT JOIN projections.users8_humans ON projections.user_grants3.user_id = projections.users8_humans.user_id AND projections.user_grants3.instance_id = projections.users8_humans.instance_id" +
-			" LEFT JOIN projections.orgs ON projections.user_grants3.resource_owner = projections.orgs.id AND projections.user_grants3.instance_id = projections.orgs.instance_id" +
-			" LEFT JOIN projections.projects3 ON projections.user_grants3.project_id = projections.projects3.id AND projections.user_grants3.instance_id = projections.projects3.instance_id" +
-			" LEFT JOIN projections.login_names2 ON projections.user_grants3.user_id = projections.login_names2.user_id AND projections.user_grants3.instance_id = projections.login_names2.instance_id" +
+			" FROM projections.user_grants4" +
+			" LEFT JOIN projections.users8 ON projections.user_grants4.user_id = projections.users8.id AND projections.user_grants4.instance_id = projections.users8.instance_id" +
+			" LEFT JOIN projections.users8_humans ON projections.user_grants4.user_id = projections.users8_humans.user_id AND projections.user_grants4.instance_id = projections.users8_humans.instance_id" +
+			" LEFT JOIN projections.orgs1 ON projections.user_grants4.resource_owner = projections.orgs1.id AND projections.user_grants4.instance_id = projections.orgs1.instance_id" +
+			" LEFT JOIN projections.projects3 ON projections.user_grants4.project_id = projections.projects3.id AND projections.user_grants4.instance_id = projections.projects3.instance_id" +
+			" LEFT JOIN projections.login_names2 ON projections.user_grants4.user_id = projections.login_names2.user_id AND projections.user_grants4.instance_id = projections.login_names2.instance_id" +
 			` AS OF SYSTEM TIME '-1 ms' ` +
 			" WHERE projections.login_names2.is_primary = $1")
 	userGrantCols = []string{
@@ -53,6 +58,8 @@ var (
 		"grant_id",
 		"roles",
 		"state",
+		"valid_from",
+		"valid_until",
 		"user_id",
 		"username",
 		"type",
@@ -70,14 +77,16 @@ var (
 		"name", //project name
 	}
 	userGrantsStmt = regexp.QuoteMeta(
-		"SELECT projections.user_grants3.id" +
-			", projections.user_grants3.creation_date" +
-			", projections.user_grants3.change_date" +
-			", projections.user_grants3.sequence" +
-			", projections.user_grants3.grant_id" +
-			", projections.user_grants3.roles" +
-			", projections.user_grants3.state" +
-			", projections.user_grants3.user_id" +
+		"SELECT projections.user_grants4.id" +
+			", projections.user_grants4.creation_date" +
+			", projections.user_grants4.change_date" +
+			", projections.user_grants4.sequence" +
+			", projections.user_grants4.grant_id" +
+			", projections.user_grants4.roles" +
+			", projections.user_grants4.state" +
+			", projections.user_grants4.valid_from" +
+			", projections.user_grants4.valid_until" +
+			", projections.user_grants4.user_id" +
 			", projections.users8.username" +
 			", projections.users8.type" +
 			", projections.users8.resource_owner" +
@@ -87,24 +96,103 @@ var (
 			", projections.users8_humans.display_name" +
 			", projections.users8_humans.avatar_key" +
 			", projections.login_names2.login_name" +
-			", projections.user_grants3.resource_owner" +
-			", projections.orgs.name" +
-			", projections.orgs.primary_domain" +
-			", projections.user_grants3.project_id" +
+			", projections.user_grants4.resource_owner" +
+			", projections.orgs1.name" +
+			", projections.orgs1.primary_domain" +
+			", projections.user_grants4.project_id" +
 			", projections.projects3.name" +
 			", COUNT(*) OVER ()" +
-			" FROM projections.user_grants3" +
-			" LEFT JOIN projections.users8 ON projections.user_grants3.user_id = projections.users8.id AND projections.user_grants3.instance_id = projections.users8.instance_id" +
-			" LEFT JOIN projections.users8_humans ON projections.user_grants3.user_id = projections.users8_humans.user_id AND projections.user_grants3.instance_id = projections.users8_humans.instance_id" +
-			" LEFT JOIN projections.orgs ON projections.user_grants3.resource_owner = projections.orgs.id AND projections.user_grants3.instance_id = projections.orgs.instance_id" +
-			" LEFT JOIN projections.projects3 ON projections.user_grants3.project_id = projections.projects3.id AND projections.user_grants3.instance_id = projections.projects3.instance_id" +
-			" LEFT JOIN projections.login_names2 ON projections.user_grants3.user_id = projections.login_names2.user_id AND projections.user_grants3.instance_id = projections.login_names2.instance_id" +
+			" FROM projections.user_grants4" +
+			" LEFT JOIN projections.users8 ON projections.user_grants4.user_id = projections.users8.id AND projections.user_grants4.instance_id = projections.users8.instance_id" +
+			" LEFT JOIN projections.users8_humans ON projections.user_grants4.user_id = projections.users8_humans.user_id AND projections.user_grants4.instance_id = projections.users8_humans.instance_id" +
+			" LEFT JOIN projections.orgs1 ON projections.user_grants4.resource_owner = projections.orgs1.id AND projections.user_grants4.instance_id = projections.orgs1.instance_id" +
+			" LEFT JOIN projections.projects3 ON projections.user_grants4.project_id = projections.projects3.id AND projections.user_grants4.instance_id = projections.projects3.instance_id" +
+			" LEFT JOIN projections.login_names2 ON projections.user_grants4.user_id = projections.login_names2.user_id AND projections.user_grants4.instance_id = projections.login_names2.instance_id" +
 			` AS OF SYSTEM TIME '-1 ms' ` +
 			" WHERE projections.login_names2.is_primary = $1")
 	userGrantsCols = append(
 		userGrantCols,
 		"count",
 	)
+	userAndGroupGrantsStmt = regexp.QuoteMeta(
+		"SELECT user_and_group_grants.id" +
+			", user_and_group_grants.creation_date" +
+			", user_and_group_grants.change_date" +
+			", user_and_group_grants.sequence" +
+			", user_and_group_grants.grant_id" +
+			", user_and_group_grants.roles" +
+			", user_and_group_grants.state" +
+			", user_and_group_grants.valid_from" +
+			", user_and_group_grants.valid_until" +
+			", user_and_group_grants.user_id" +
+			", projections.users8.username" +
+			", projections.users8.type" +
+			", projections.users8.resource_owner" +
+			", projections.users8_humans.first_name" +
+			", projections.users8_humans.last_name" +
+			", projections.users8_humans.email" +
+			", projections.users8_humans.display_name" +
+			", projections.users8_humans.avatar_key" +
+			", projections.login_names2.login_name" +
+			", user_and_group_grants.resource_owner" +
+			", projections.orgs1.name" +
+			", projections.orgs1.primary_domain" +
+			", user_and_group_grants.project_id" +
+			", projections.projects3.name" +
+			", user_and_group_grants.group_id" +
+			", COUNT(*) OVER ()" +
+			" FROM (SELECT projections.user_grants4.id" +
+			", projections.user_grants4.creation_date" +
+			", projections.user_grants4.change_date" +
+			", projections.user_grants4.sequence" +
+			", projections.user_grants4.grant_id" +
+			", projections.user_grants4.roles" +
+			", projections.user_grants4.state" +
+			", projections.user_grants4.valid_from" +
+			", projections.user_grants4.valid_until" +
+			", projections.user_grants4.user_id" +
+			", projections.user_grants4.resource_owner" +
+			", projections.user_grants4.project_id" +
+			", projections.user_grants4.instance_id" +
+			", projections.user_grants4.owner_removed" +
+			", projections.user_grants4.user_owner_removed" +
+			", projections.user_grants4.project_owner_removed" +
+			", projections.user_grants4.granted_org_removed" +
+			", NULL::TEXT AS group_id" +
+			" FROM projections.user_grants4" +
+			" UNION ALL SELECT ''::TEXT AS id" +
+			", projections.group_grants.creation_date" +
+			", projections.group_grants.change_date" +
+			", projections.group_grants.sequence" +
+			", projections.group_grants.grant_id" +
+			", projections.group_grants.roles" +
+			", 1::SMALLINT AS state" +
+			", NULL::TIMESTAMPTZ AS valid_from" +
+			", NULL::TIMESTAMPTZ AS valid_until" +
+			", projections.group_users.user_id" +
+			", projections.group_grants.resource_owner" +
+			", projections.group_grants.project_id" +
+			", projections.group_grants.instance_id" +
+			", projections.group_grants.owner_removed" +
+			", projections.group_users.owner_removed AS user_owner_removed" +
+			", FALSE AS project_owner_removed" +
+			", FALSE AS granted_org_removed" +
+			", projections.group_grants.group_id" +
+			" FROM projections.group_grants" +
+			" JOIN projections.group_users ON projections.group_grants.group_id = projections.group_users.group_id AND projections.group_grants.instance_id = projections.group_users.instance_id" +
+			") AS user_and_group_grants" +
+			" LEFT JOIN projections.users8 ON user_and_group_grants.user_id = projections.users8.id AND user_and_group_grants.instance_id = projections.users8.instance_id" +
+			" LEFT JOIN projections.users8_humans ON user_and_group_grants.user_id = projections.users8_humans.user_id AND user_and_group_grants.instance_id = projections.users8_humans.instance_id" +
+			" LEFT JOIN projections.orgs1 ON user_and_group_grants.resource_owner = projections.orgs1.id AND user_and_group_grants.instance_id = projections.orgs1.instance_id" +
+			" LEFT JOIN projections.projects3 ON user_and_group_grants.project_id = projections.projects3.id AND user_and_group_grants.instance_id = projections.projects3.instance_id" +
+			" LEFT JOIN projections.login_names2 ON user_and_group_grants.user_id = projections.login_names2.user_id AND user_and_group_grants.instance_id = projections.login_names2.instance_id" +
+			` AS OF SYSTEM TIME '-1 ms' ` +
+			" WHERE projections.login_names2.is_primary = $1")
+	userAndGroupGrantsCols = append(
+		append([]string{}, userGrantCols...),
+		"group_id",
+		"count",
+	)
 )
 
 func Test_UserGrantPrepares(t *testing.T) {
@@ -151,6 +239,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						database.StringArray{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
+						nil,
 						"user-id",
 						"username",
 						domain.UserTypeHuman,
@@ -194,6 +284,67 @@ func Test_UserGrantPrepares(t *testing.T) {
 				ProjectName:        "project-name",
 			},
 		},
+		{
+			name:    "prepareUserGrantQuery expired",
+			prepare: prepareUserGrantQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					userGrantStmt,
+					userGrantCols,
+					[]driver.Value{
+						"id",
+						testNow,
+						testNow,
+						20211111,
+						"grant-id",
+						database.StringArray{"role-key"},
+						domain.UserGrantStateActive,
+						nil,
+						testNow,
+						"user-id",
+						"username",
+						domain.UserTypeHuman,
+						"resource-owner",
+						"first-name",
+						"last-name",
+						"email",
+						"display-name",
+						"avatar-key",
+						"login-name",
+						"ro",
+						"org-name",
+						"primary-domain",
+						"project-id",
+						"project-name",
+					},
+				),
+			},
+			object: &UserGrant{
+				ID:                 "id",
+				CreationDate:       testNow,
+				ChangeDate:         testNow,
+				Sequence:           20211111,
+				Roles:              database.StringArray{"role-key"},
+				GrantID:            "grant-id",
+				State:              domain.UserGrantStateExpired,
+				ValidUntil:         testNow,
+				UserID:             "user-id",
+				Username:           "username",
+				UserType:           domain.UserTypeHuman,
+				UserResourceOwner:  "resource-owner",
+				FirstName:          "first-name",
+				LastName:           "last-name",
+				Email:              "email",
+				DisplayName:        "display-name",
+				AvatarURL:          "avatar-key",
+				PreferredLoginName: "login-name",
+				ResourceOwner:      "ro",
+				OrgName:            "org-name",
+				OrgPrimaryDomain:   "primary-domain",
+				ProjectID:          "project-id",
+				ProjectName:        "project-name",
+			},
+		},
 		{
 			name:    "prepareUserGrantQuery machine user found",
 			prepare: prepareUserGrantQuery,
@@ -209,6 +360,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						database.StringArray{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
+						nil,
 						"user-id",
 						"username",
 						domain.UserTypeMachine,
@@ -267,6 +420,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						database.StringArray{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
+						nil,
 						"user-id",
 						"username",
 						domain.UserTypeHuman,
@@ -325,6 +480,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						database.StringArray{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
+						nil,
 						"user-id",
 						"username",
 						domain.UserTypeHuman,
@@ -383,6 +540,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						database.StringArray{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
+						nil,
 						"user-id",
 						"username",
 						domain.UserTypeHuman,
@@ -471,6 +630,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.StringArray{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -538,6 +699,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.StringArray{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeMachine,
@@ -605,6 +768,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.StringArray{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeMachine,
@@ -672,6 +837,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.StringArray{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -739,6 +906,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.StringArray{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -806,6 +975,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.StringArray{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -830,6 +1001,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.StringArray{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -905,6 +1078,138 @@ func Test_UserGrantPrepares(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "prepareUserAndGroupGrantsQuery no result",
+			prepare: prepareUserAndGroupGrantsWrapper,
+			want: want{
+				sqlExpectations: mockQueries(
+					userAndGroupGrantsStmt,
+					nil,
+					nil,
+				),
+			},
+			object: &UserGrants{UserGrants: []*UserGrant{}},
+		},
+		{
+			name:    "prepareUserAndGroupGrantsQuery user and group grant",
+			prepare: prepareUserAndGroupGrantsWrapper,
+			want: want{
+				sqlExpectations: mockQueries(
+					userAndGroupGrantsStmt,
+					userAndGroupGrantsCols,
+					[][]driver.Value{
+						{
+							"id",
+							testNow,
+							testNow,
+							20211111,
+							"grant-id",
+							database.StringArray{"role-key"},
+							domain.UserGrantStateActive,
+							nil,
+							nil,
+							"user-id",
+							"username",
+							domain.UserTypeHuman,
+							"resource-owner",
+							"first-name",
+							"last-name",
+							"email",
+							"display-name",
+							"avatar-key",
+							"login-name",
+							"ro",
+							"org-name",
+							"primary-domain",
+							"project-id",
+							"project-name",
+							nil,
+						},
+						{
+							"",
+							testNow,
+							testNow,
+							20211112,
+							"",
+							database.StringArray{"group-role-key"},
+							domain.UserGrantStateActive,
+							nil,
+							nil,
+							"user-id",
+							"username",
+							domain.UserTypeHuman,
+							"resource-owner",
+							"first-name",
+							"last-name",
+							"email",
+							"display-name",
+							"avatar-key",
+							"login-name",
+							"ro",
+							"org-name",
+							"primary-domain",
+							"project-id",
+							"project-name",
+							"group-id",
+						},
+					},
+				),
+			},
+			object: &UserGrants{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				UserGrants: []*UserGrant{
+					{
+						ID:                 "id",
+						CreationDate:       testNow,
+						ChangeDate:         testNow,
+						Sequence:           20211111,
+						Roles:              database.StringArray{"role-key"},
+						GrantID:            "grant-id",
+						State:              domain.UserGrantStateActive,
+						UserID:             "user-id",
+						Username:           "username",
+						UserType:           domain.UserTypeHuman,
+						UserResourceOwner:  "resource-owner",
+						FirstName:          "first-name",
+						LastName:           "last-name",
+						Email:              "email",
+						DisplayName:        "display-name",
+						AvatarURL:          "avatar-key",
+						PreferredLoginName: "login-name",
+						ResourceOwner:      "ro",
+						OrgName:            "org-name",
+						OrgPrimaryDomain:   "primary-domain",
+						ProjectID:          "project-id",
+						ProjectName:        "project-name",
+					},
+					{
+						CreationDate:       testNow,
+						ChangeDate:         testNow,
+						Sequence:           20211112,
+						Roles:              database.StringArray{"group-role-key"},
+						State:              domain.UserGrantStateActive,
+						UserID:             "user-id",
+						Username:           "username",
+						UserType:           domain.UserTypeHuman,
+						UserResourceOwner:  "resource-owner",
+						FirstName:          "first-name",
+						LastName:           "last-name",
+						Email:              "email",
+						DisplayName:        "display-name",
+						AvatarURL:          "avatar-key",
+						PreferredLoginName: "login-name",
+						ResourceOwner:      "ro",
+						OrgName:            "org-name",
+						OrgPrimaryDomain:   "primary-domain",
+						ProjectID:          "project-id",
+						ProjectName:        "project-name",
+						GroupID:            "group-id",
+					},
+				},
+			},
+		},
 		{
 			name:    "prepareUserGrantsQuery sql err",
 			prepare: prepareUserGrantsQuery,
@@ -929,3 +1234,67 @@ func Test_UserGrantPrepares(t *testing.T) {
 		})
 	}
 }
+
+func prepareUserAndGroupGrantsWrapper(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*UserGrants, error)) {
+	builder, _, scan := prepareUserAndGroupGrantsQuery(ctx, db)
+	return builder, scan
+}
+
+func TestUserGrantsQueries_onTable(t *testing.T) {
+	userID, _ := NewUserGrantUserIDSearchQuery("user-id")
+	role, _ := NewUserGrantRoleQuery("role-key")
+	withGranted, _ := NewUserGrantWithGrantedQuery("org-id")
+	validAt, _ := NewUserGrantValidAtQuery(testNow)
+	email, _ := NewUserGrantEmailQuery("email", TextEquals)
+	unsupported, _ := NewSubSelect(UserGrantUserID, []SearchQuery{userID})
+	tests := []struct {
+		name    string
+		queries *UserGrantsQueries
+		want    string
+		wantErr func(error) bool
+	}{
+		{
+			name: "user grant columns on table",
+			queries: &UserGrantsQueries{
+				SearchRequest: SearchRequest{SortingColumn: UserGrantCreationDate},
+				Queries:       []SearchQuery{userID, role, withGranted, validAt, email},
+			},
+			want: "SELECT x FROM y" +
+				" WHERE user_and_group_grants.user_id = $1" +
+				" AND user_and_group_grants.roles @> $2 " +
+				" AND (user_and_group_grants.resource_owner = $3 OR projections.projects3.resource_owner = $4)" +
+				" AND ((user_and_group_grants.valid_from IS NULL OR user_and_group_grants.valid_from <= $5)" +
+				" AND (user_and_group_grants.valid_until IS NULL OR user_and_group_grants.valid_until > $6))" +
+				" AND projections.users8_humans.email = $7" +
+				" ORDER BY user_and_group_grants.creation_date DESC",
+		},
+		{
+			name: "unsupported query, error",
+			queries: &UserGrantsQueries{
+				Queries: []SearchQuery{unsupported},
+			},
+			wantErr: errs.IsErrorInvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.queries.onTable(userAndGroupGrantsTable)
+			if tt.wantErr != nil {
+				if !tt.wantErr(err) {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			stmt, _, err := got.toQuery(sq.Select("x").From("y").PlaceholderFormat(sq.Dollar)).ToSql()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if stmt != tt.want {
+				t.Errorf("unexpected statement:\n got: %s\nwant: %s", stmt, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,297 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	errs "errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+var (
+	userImportJobsTable = table{
+		name:          projection.UserImportJobProjectionTable,
+		instanceIDCol: projection.UserImportJobColumnInstanceID,
+	}
+	UserImportJobColumnID = Column{
+		name:  projection.UserImportJobColumnID,
+		table: userImportJobsTable,
+	}
+	UserImportJobColumnCreationDate = Column{
+		name:  projection.UserImportJobColumnCreationDate,
+		table: userImportJobsTable,
+	}
+	UserImportJobColumnChangeDate = Column{
+		name:  projection.UserImportJobColumnChangeDate,
+		table: userImportJobsTable,
+	}
+	UserImportJobColumnSequence = Column{
+		name:  projection.UserImportJobColumnSequence,
+		table: userImportJobsTable,
+	}
+	UserImportJobColumnResourceOwner = Column{
+		name:  projection.UserImportJobColumnResourceOwner,
+		table: userImportJobsTable,
+	}
+	UserImportJobColumnInstanceID = Column{
+		name:  projection.UserImportJobColumnInstanceID,
+		table: userImportJobsTable,
+	}
+	UserImportJobColumnCreator = Column{
+		name:  projection.UserImportJobColumnCreator,
+		table: userImportJobsTable,
+	}
+	UserImportJobColumnState = Column{
+		name:  projection.UserImportJobColumnState,
+		table: userImportJobsTable,
+	}
+	UserImportJobColumnFormat = Column{
+		name:  projection.UserImportJobColumnFormat,
+		table: userImportJobsTable,
+	}
+	UserImportJobColumnTotalRows = Column{
+		name:  projection.UserImportJobColumnTotalRows,
+		table: userImportJobsTable,
+	}
+	UserImportJobColumnProcessedRows = Column{
+		name:  projection.UserImportJobColumnProcessedRows,
+		table: userImportJobsTable,
+	}
+	UserImportJobColumnCreatedRows = Column{
+		name:  projection.UserImportJobColumnCreatedRows,
+		table: userImportJobsTable,
+	}
+	UserImportJobColumnUpdatedRows = Column{
+		name:  projection.UserImportJobColumnUpdatedRows,
+		table: userImportJobsTable,
+	}
+	UserImportJobColumnFailedRows = Column{
+		name:  projection.UserImportJobColumnFailedRows,
+		table: userImportJobsTable,
+	}
+
+	userImportResultsTable = table{
+		name:          projection.UserImportResultTable,
+		instanceIDCol: projection.UserImportResultColumnInstanceID,
+	}
+	UserImportResultColumnJobID = Column{
+		name:  projection.UserImportResultColumnJobID,
+		table: userImportResultsTable,
+	}
+	UserImportResultColumnInstanceID = Column{
+		name:  projection.UserImportResultColumnInstanceID,
+		table: userImportResultsTable,
+	}
+	UserImportResultColumnRow = Column{
+		name:  projection.UserImportResultColumnRow,
+		table: userImportResultsTable,
+	}
+	UserImportResultColumnUserID = Column{
+		name:  projection.UserImportResultColumnUserID,
+		table: userImportResultsTable,
+	}
+	UserImportResultColumnState = Column{
+		name:  projection.UserImportResultColumnState,
+		table: userImportResultsTable,
+	}
+	UserImportResultColumnError = Column{
+		name:  projection.UserImportResultColumnError,
+		table: userImportResultsTable,
+	}
+)
+
+type UserImportJob struct {
+	ID            string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	ResourceOwner string
+	Creator       string
+	State         domain.UserImportJobState
+	Format        domain.UserImportFormat
+	TotalRows     uint64
+	ProcessedRows uint64
+	CreatedRows   uint64
+	UpdatedRows   uint64
+	FailedRows    uint64
+}
+
+type UserImportResults struct {
+	SearchResponse
+	Results []*UserImportResult
+}
+
+type UserImportResult struct {
+	JobID  string
+	Row    uint64
+	UserID string
+	State  domain.UserImportRowState
+	// Error is the message key of the error, if the row failed
+	Error string
+}
+
+type UserImportResultSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *Queries) UserImportJobByID(ctx context.Context, shouldTriggerBulk bool, jobID, resourceOwner string) (_ *UserImportJob, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		ctx = projection.UserImportProjection.Trigger(ctx)
+	}
+
+	query, scan := prepareUserImportJobQuery(ctx, q.client)
+	stmt, args, err := query.Where(sq.Eq{
+		UserImportJobColumnID.identifier():            jobID,
+		UserImportJobColumnResourceOwner.identifier(): resourceOwner,
+		UserImportJobColumnInstanceID.identifier():    authz.GetInstance(ctx).InstanceID(),
+	}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Ui1sq", "Errors.Query.SQLStatment")
+	}
+
+	row := q.client.QueryRowContext(ctx, stmt, args...)
+	return scan(row)
+}
+
+// SearchUserImportResults returns the results of the processed rows of the import job.
+// The caller is responsible to check that the job belongs to the requested organization, see [Queries.UserImportJobByID]
+func (q *Queries) SearchUserImportResults(ctx context.Context, jobID string, queries *UserImportResultSearchQueries) (_ *UserImportResults, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareUserImportResultsQuery(ctx, q.client)
+	stmt, args, err := queries.toQuery(query).Where(sq.Eq{
+		UserImportResultColumnJobID.identifier():      jobID,
+		UserImportResultColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Ui2sq", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Ui3qe", "Errors.Internal")
+	}
+	results, err := scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	results.LatestSequence, err = q.latestSequence(ctx, userImportJobsTable)
+	return results, err
+}
+
+func NewUserImportResultStateSearchQuery(state domain.UserImportRowState) (SearchQuery, error) {
+	return NewNumberQuery(UserImportResultColumnState, state, NumberEquals)
+}
+
+func (q *UserImportResultSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func prepareUserImportJobQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*UserImportJob, error)) {
+	return sq.Select(
+			UserImportJobColumnID.identifier(),
+			UserImportJobColumnCreationDate.identifier(),
+			UserImportJobColumnChangeDate.identifier(),
+			UserImportJobColumnSequence.identifier(),
+			UserImportJobColumnResourceOwner.identifier(),
+			UserImportJobColumnCreator.identifier(),
+			UserImportJobColumnState.identifier(),
+			UserImportJobColumnFormat.identifier(),
+			UserImportJobColumnTotalRows.identifier(),
+			UserImportJobColumnProcessedRows.identifier(),
+			UserImportJobColumnCreatedRows.identifier(),
+			UserImportJobColumnUpdatedRows.identifier(),
+			UserImportJobColumnFailedRows.identifier()).
+			From(userImportJobsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*UserImportJob, error) {
+			job := new(UserImportJob)
+			err := row.Scan(
+				&job.ID,
+				&job.CreationDate,
+				&job.ChangeDate,
+				&job.Sequence,
+				&job.ResourceOwner,
+				&job.Creator,
+				&job.State,
+				&job.Format,
+				&job.TotalRows,
+				&job.ProcessedRows,
+				&job.CreatedRows,
+				&job.UpdatedRows,
+				&job.FailedRows,
+			)
+			if err != nil {
+				if errs.Is(err, sql.ErrNoRows) {
+					return nil, errors.ThrowNotFound(err, "QUERY-Ui4nf", "Errors.UserImport.NotFound")
+				}
+				return nil, errors.ThrowInternal(err, "QUERY-Ui5in", "Errors.Internal")
+			}
+			return job, nil
+		}
+}
+
+func prepareUserImportResultsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*UserImportResults, error)) {
+	return sq.Select(
+			UserImportResultColumnJobID.identifier(),
+			UserImportResultColumnRow.identifier(),
+			UserImportResultColumnUserID.identifier(),
+			UserImportResultColumnState.identifier(),
+			UserImportResultColumnError.identifier(),
+			countColumn.identifier()).
+			From(userImportResultsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*UserImportResults, error) {
+			results := make([]*UserImportResult, 0)
+			var count uint64
+			for rows.Next() {
+				result := new(UserImportResult)
+				var (
+					userID   sql.NullString
+					errorKey sql.NullString
+				)
+				err := rows.Scan(
+					&result.JobID,
+					&result.Row,
+					&userID,
+					&result.State,
+					&errorKey,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				result.UserID = userID.String
+				result.Error = errorKey.String
+				results = append(results, result)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Ui6cr", "Errors.Query.CloseRows")
+			}
+
+			return &UserImportResults{
+				Results: results,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -0,0 +1,232 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	errs "github.com/zitadel/zitadel/internal/errors"
+)
+
+var (
+	userImportJobStmt = regexp.QuoteMeta(
+		"SELECT projections.user_import_jobs.id," +
+			" projections.user_import_jobs.creation_date," +
+			" projections.user_import_jobs.change_date," +
+			" projections.user_import_jobs.sequence," +
+			" projections.user_import_jobs.resource_owner," +
+			" projections.user_import_jobs.creator," +
+			" projections.user_import_jobs.state," +
+			" projections.user_import_jobs.format," +
+			" projections.user_import_jobs.total_rows," +
+			" projections.user_import_jobs.processed_rows," +
+			" projections.user_import_jobs.created_rows," +
+			" projections.user_import_jobs.updated_rows," +
+			" projections.user_import_jobs.failed_rows" +
+			" FROM projections.user_import_jobs" +
+			` AS OF SYSTEM TIME '-1 ms'`)
+	userImportJobCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"resource_owner",
+		"creator",
+		"state",
+		"format",
+		"total_rows",
+		"processed_rows",
+		"created_rows",
+		"updated_rows",
+		"failed_rows",
+	}
+	userImportResultsStmt = regexp.QuoteMeta(
+		"SELECT projections.user_import_jobs_results.job_id," +
+			" projections.user_import_jobs_results.row_number," +
+			" projections.user_import_jobs_results.user_id," +
+			" projections.user_import_jobs_results.state," +
+			" projections.user_import_jobs_results.error," +
+			" COUNT(*) OVER ()" +
+			" FROM projections.user_import_jobs_results" +
+			" AS OF SYSTEM TIME '-1 ms'")
+	userImportResultsCols = []string{
+		"job_id",
+		"row_number",
+		"user_id",
+		"state",
+		"error",
+		"count",
+	}
+)
+
+func Test_UserImportPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareUserImportJobQuery no result",
+			prepare: prepareUserImportJobQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					userImportJobStmt,
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*UserImportJob)(nil),
+		},
+		{
+			name:    "prepareUserImportJobQuery found",
+			prepare: prepareUserImportJobQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					userImportJobStmt,
+					userImportJobCols,
+					[]driver.Value{
+						"job-id",
+						testNow,
+						testNow,
+						uint64(20211202),
+						"ro",
+						"creator",
+						domain.UserImportJobStateRunning,
+						domain.UserImportFormatCSV,
+						uint64(150),
+						uint64(100),
+						uint64(90),
+						uint64(5),
+						uint64(5),
+					},
+				),
+			},
+			object: &UserImportJob{
+				ID:            "job-id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				Sequence:      20211202,
+				ResourceOwner: "ro",
+				Creator:       "creator",
+				State:         domain.UserImportJobStateRunning,
+				Format:        domain.UserImportFormatCSV,
+				TotalRows:     150,
+				ProcessedRows: 100,
+				CreatedRows:   90,
+				UpdatedRows:   5,
+				FailedRows:    5,
+			},
+		},
+		{
+			name:    "prepareUserImportJobQuery sql err",
+			prepare: prepareUserImportJobQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					userImportJobStmt,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+		{
+			name:    "prepareUserImportResultsQuery no result",
+			prepare: prepareUserImportResultsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					userImportResultsStmt,
+					nil,
+					nil,
+				),
+			},
+			object: &UserImportResults{Results: []*UserImportResult{}},
+		},
+		{
+			name:    "prepareUserImportResultsQuery multiple results",
+			prepare: prepareUserImportResultsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					userImportResultsStmt,
+					userImportResultsCols,
+					[][]driver.Value{
+						{
+							"job-id",
+							uint64(2),
+							"user-id",
+							domain.UserImportRowStateCreated,
+							nil,
+						},
+						{
+							"job-id",
+							uint64(3),
+							nil,
+							domain.UserImportRowStateFailed,
+							"Errors.UserImport.InvalidRow",
+						},
+					},
+				),
+			},
+			object: &UserImportResults{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				Results: []*UserImportResult{
+					{
+						JobID:  "job-id",
+						Row:    2,
+						UserID: "user-id",
+						State:  domain.UserImportRowStateCreated,
+					},
+					{
+						JobID: "job-id",
+						Row:   3,
+						State: domain.UserImportRowStateFailed,
+						Error: "Errors.UserImport.InvalidRow",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareUserImportResultsQuery sql err",
+			prepare: prepareUserImportResultsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					userImportResultsStmt,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -0,0 +1,279 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	errs "errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+type UserLifecyclePolicy struct {
+	ID            string
+	InstanceID    string
+	Sequence      uint64
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	State         domain.PolicyState
+
+	InactivityDeactivation time.Duration
+	DeactivatedRemoval     time.Duration
+	NotificationPeriod     time.Duration
+
+	IsDefault bool
+}
+
+type UserLifecyclePolicies struct {
+	SearchResponse
+	Policies []*UserLifecyclePolicy
+}
+
+var (
+	userLifecyclePolicyTable = table{
+		name:          projection.UserLifecyclePolicyTable,
+		instanceIDCol: projection.UserLifecyclePolicyInstanceIDCol,
+	}
+	UserLifecyclePolicyColID = Column{
+		name:  projection.UserLifecyclePolicyIDCol,
+		table: userLifecyclePolicyTable,
+	}
+	UserLifecyclePolicyColInstanceID = Column{
+		name:  projection.UserLifecyclePolicyInstanceIDCol,
+		table: userLifecyclePolicyTable,
+	}
+	UserLifecyclePolicyColSequence = Column{
+		name:  projection.UserLifecyclePolicySequenceCol,
+		table: userLifecyclePolicyTable,
+	}
+	UserLifecyclePolicyColCreationDate = Column{
+		name:  projection.UserLifecyclePolicyCreationDateCol,
+		table: userLifecyclePolicyTable,
+	}
+	UserLifecyclePolicyColChangeDate = Column{
+		name:  projection.UserLifecyclePolicyChangeDateCol,
+		table: userLifecyclePolicyTable,
+	}
+	UserLifecyclePolicyColResourceOwner = Column{
+		name:  projection.UserLifecyclePolicyResourceOwnerCol,
+		table: userLifecyclePolicyTable,
+	}
+	UserLifecyclePolicyColInactivityDeactivation = Column{
+		name:  projection.UserLifecyclePolicyInactivityDeactivationCol,
+		table: userLifecyclePolicyTable,
+	}
+	UserLifecyclePolicyColDeactivatedRemoval = Column{
+		name:  projection.UserLifecyclePolicyDeactivatedRemovalCol,
+		table: userLifecyclePolicyTable,
+	}
+	UserLifecyclePolicyColNotificationPeriod = Column{
+		name:  projection.UserLifecyclePolicyNotificationPeriodCol,
+		table: userLifecyclePolicyTable,
+	}
+	UserLifecyclePolicyColIsDefault = Column{
+		name:  projection.UserLifecyclePolicyIsDefaultCol,
+		table: userLifecyclePolicyTable,
+	}
+	UserLifecyclePolicyColState = Column{
+		name:  projection.UserLifecyclePolicyStateCol,
+		table: userLifecyclePolicyTable,
+	}
+	UserLifecyclePolicyColOwnerRemoved = Column{
+		name:  projection.UserLifecyclePolicyOwnerRemovedCol,
+		table: userLifecyclePolicyTable,
+	}
+)
+
+func (q *Queries) UserLifecyclePolicyByOrg(ctx context.Context, shouldTriggerBulk bool, orgID string, withOwnerRemoved bool) (_ *UserLifecyclePolicy, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		ctx = projection.UserLifecyclePolicyProjection.Trigger(ctx)
+	}
+	ownerIDs, err := q.policyOwnerIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	eq := sq.Eq{
+		UserLifecyclePolicyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	if !withOwnerRemoved {
+		eq[UserLifecyclePolicyColOwnerRemoved.identifier()] = false
+	}
+
+	stmt, scan := prepareUserLifecyclePolicyQuery(ctx, q.client)
+	query, args, err := stmt.Where(
+		sq.And{
+			eq,
+			sq.Eq{UserLifecyclePolicyColID.identifier(): ownerIDs},
+		}).
+		OrderByClause(policyOwnerPrecedence(UserLifecyclePolicyColID, ownerIDs)).
+		Limit(1).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Ul1sq", "Errors.Query.SQLStatement")
+	}
+
+	row := q.client.QueryRowContext(ctx, query, args...)
+	return scan(row)
+}
+
+func (q *Queries) DefaultUserLifecyclePolicy(ctx context.Context, shouldTriggerBulk bool) (_ *UserLifecyclePolicy, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		ctx = projection.UserLifecyclePolicyProjection.Trigger(ctx)
+	}
+
+	stmt, scan := prepareUserLifecyclePolicyQuery(ctx, q.client)
+	query, args, err := stmt.Where(sq.Eq{
+		UserLifecyclePolicyColID.identifier():         authz.GetInstance(ctx).InstanceID(),
+		UserLifecyclePolicyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}).
+		OrderBy(UserLifecyclePolicyColIsDefault.identifier()).
+		Limit(1).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Ul2sq", "Errors.Query.SQLStatement")
+	}
+
+	row := q.client.QueryRowContext(ctx, query, args...)
+	return scan(row)
+}
+
+// searchUserLifecyclePolicies returns the default and org policies of the given instances
+func (q *Queries) searchUserLifecyclePolicies(ctx context.Context, instanceIDs []string) (_ *UserLifecyclePolicies, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	stmt, scan := prepareUserLifecyclePoliciesQuery(ctx, q.client)
+	query, args, err := stmt.Where(sq.Eq{
+		UserLifecyclePolicyColInstanceID.identifier():   instanceIDs,
+		UserLifecyclePolicyColOwnerRemoved.identifier(): false,
+	}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Ul3sq", "Errors.Query.SQLStatement")
+	}
+
+	rows, err := q.client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Ul4qe", "Errors.Internal")
+	}
+	return scan(rows)
+}
+
+func (p *UserLifecyclePolicy) toDomain() *domain.UserLifecyclePolicy {
+	if p == nil {
+		return nil
+	}
+	return &domain.UserLifecyclePolicy{
+		Default:                p.IsDefault,
+		InactivityDeactivation: p.InactivityDeactivation,
+		DeactivatedRemoval:     p.DeactivatedRemoval,
+		NotificationPeriod:     p.NotificationPeriod,
+	}
+}
+
+func prepareUserLifecyclePolicyQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*UserLifecyclePolicy, error)) {
+	return sq.Select(
+			UserLifecyclePolicyColID.identifier(),
+			UserLifecyclePolicyColInstanceID.identifier(),
+			UserLifecyclePolicyColSequence.identifier(),
+			UserLifecyclePolicyColCreationDate.identifier(),
+			UserLifecyclePolicyColChangeDate.identifier(),
+			UserLifecyclePolicyColResourceOwner.identifier(),
+			UserLifecyclePolicyColInactivityDeactivation.identifier(),
+			UserLifecyclePolicyColDeactivatedRemoval.identifier(),
+			UserLifecyclePolicyColNotificationPeriod.identifier(),
+			UserLifecyclePolicyColIsDefault.identifier(),
+			UserLifecyclePolicyColState.identifier(),
+		).
+			From(userLifecyclePolicyTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*UserLifecyclePolicy, error) {
+			policy := new(UserLifecyclePolicy)
+			err := row.Scan(
+				&policy.ID,
+				&policy.InstanceID,
+				&policy.Sequence,
+				&policy.CreationDate,
+				&policy.ChangeDate,
+				&policy.ResourceOwner,
+				&policy.InactivityDeactivation,
+				&policy.DeactivatedRemoval,
+				&policy.NotificationPeriod,
+				&policy.IsDefault,
+				&policy.State,
+			)
+			if err != nil {
+				if errs.Is(err, sql.ErrNoRows) {
+					return nil, errors.ThrowNotFound(err, "QUERY-Ul5nf", "Errors.UserLifecyclePolicy.NotFound")
+				}
+				return nil, errors.ThrowInternal(err, "QUERY-Ul6ie", "Errors.Internal")
+			}
+			return policy, nil
+		}
+}
+
+func prepareUserLifecyclePoliciesQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*UserLifecyclePolicies, error)) {
+	return sq.Select(
+			UserLifecyclePolicyColID.identifier(),
+			UserLifecyclePolicyColInstanceID.identifier(),
+			UserLifecyclePolicyColSequence.identifier(),
+			UserLifecyclePolicyColCreationDate.identifier(),
+			UserLifecyclePolicyColChangeDate.identifier(),
+			UserLifecyclePolicyColResourceOwner.identifier(),
+			UserLifecyclePolicyColInactivityDeactivation.identifier(),
+			UserLifecyclePolicyColDeactivatedRemoval.identifier(),
+			UserLifecyclePolicyColNotificationPeriod.identifier(),
+			UserLifecyclePolicyColIsDefault.identifier(),
+			UserLifecyclePolicyColState.identifier(),
+			countColumn.identifier(),
+		).
+			From(userLifecyclePolicyTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*UserLifecyclePolicies, error) {
+			policies := make([]*UserLifecyclePolicy, 0)
+			var count uint64
+			for rows.Next() {
+				policy := new(UserLifecyclePolicy)
+				err := rows.Scan(
+					&policy.ID,
+					&policy.InstanceID,
+					&policy.Sequence,
+					&policy.CreationDate,
+					&policy.ChangeDate,
+					&policy.ResourceOwner,
+					&policy.InactivityDeactivation,
+					&policy.DeactivatedRemoval,
+					&policy.NotificationPeriod,
+					&policy.IsDefault,
+					&policy.State,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				policies = append(policies, policy)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Ul7cr", "Errors.Query.CloseRows")
+			}
+
+			return &UserLifecyclePolicies{
+				Policies: policies,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
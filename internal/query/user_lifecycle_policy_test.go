@@ -0,0 +1,222 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	errs "github.com/zitadel/zitadel/internal/errors"
+)
+
+var (
+	prepareUserLifecyclePolicyStmt = `SELECT projections.user_lifecycle_policies.id,` +
+		` projections.user_lifecycle_policies.instance_id,` +
+		` projections.user_lifecycle_policies.sequence,` +
+		` projections.user_lifecycle_policies.creation_date,` +
+		` projections.user_lifecycle_policies.change_date,` +
+		` projections.user_lifecycle_policies.resource_owner,` +
+		` projections.user_lifecycle_policies.inactivity_deactivation,` +
+		` projections.user_lifecycle_policies.deactivated_removal,` +
+		` projections.user_lifecycle_policies.notification_period,` +
+		` projections.user_lifecycle_policies.is_default,` +
+		` projections.user_lifecycle_policies.state` +
+		` FROM projections.user_lifecycle_policies` +
+		` AS OF SYSTEM TIME '-1 ms'`
+
+	prepareUserLifecyclePolicyCols = []string{
+		"id",
+		"instance_id",
+		"sequence",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"inactivity_deactivation",
+		"deactivated_removal",
+		"notification_period",
+		"is_default",
+		"state",
+	}
+
+	prepareUserLifecyclePoliciesStmt = `SELECT projections.user_lifecycle_policies.id,` +
+		` projections.user_lifecycle_policies.instance_id,` +
+		` projections.user_lifecycle_policies.sequence,` +
+		` projections.user_lifecycle_policies.creation_date,` +
+		` projections.user_lifecycle_policies.change_date,` +
+		` projections.user_lifecycle_policies.resource_owner,` +
+		` projections.user_lifecycle_policies.inactivity_deactivation,` +
+		` projections.user_lifecycle_policies.deactivated_removal,` +
+		` projections.user_lifecycle_policies.notification_period,` +
+		` projections.user_lifecycle_policies.is_default,` +
+		` projections.user_lifecycle_policies.state,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.user_lifecycle_policies` +
+		` AS OF SYSTEM TIME '-1 ms'`
+
+	prepareUserLifecyclePoliciesCols = append(prepareUserLifecyclePolicyCols, "count")
+)
+
+func Test_UserLifecyclePolicyPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareUserLifecyclePolicyQuery no result",
+			prepare: prepareUserLifecyclePolicyQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareUserLifecyclePolicyStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*UserLifecyclePolicy)(nil),
+		},
+		{
+			name:    "prepareUserLifecyclePolicyQuery found",
+			prepare: prepareUserLifecyclePolicyQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareUserLifecyclePolicyStmt),
+					prepareUserLifecyclePolicyCols,
+					[]driver.Value{
+						"pol-id",
+						"instance-id",
+						uint64(20211109),
+						testNow,
+						testNow,
+						"ro",
+						int64(90 * 24 * time.Hour),
+						int64(30 * 24 * time.Hour),
+						int64(7 * 24 * time.Hour),
+						true,
+						domain.PolicyStateActive,
+					},
+				),
+			},
+			object: &UserLifecyclePolicy{
+				ID:                     "pol-id",
+				InstanceID:             "instance-id",
+				CreationDate:           testNow,
+				ChangeDate:             testNow,
+				Sequence:               20211109,
+				ResourceOwner:          "ro",
+				State:                  domain.PolicyStateActive,
+				InactivityDeactivation: 90 * 24 * time.Hour,
+				DeactivatedRemoval:     30 * 24 * time.Hour,
+				NotificationPeriod:     7 * 24 * time.Hour,
+				IsDefault:              true,
+			},
+		},
+		{
+			name:    "prepareUserLifecyclePolicyQuery sql err",
+			prepare: prepareUserLifecyclePolicyQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareUserLifecyclePolicyStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+		{
+			name:    "prepareUserLifecyclePoliciesQuery no result",
+			prepare: prepareUserLifecyclePoliciesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareUserLifecyclePoliciesStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &UserLifecyclePolicies{Policies: []*UserLifecyclePolicy{}},
+		},
+		{
+			name:    "prepareUserLifecyclePoliciesQuery one result",
+			prepare: prepareUserLifecyclePoliciesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareUserLifecyclePoliciesStmt),
+					prepareUserLifecyclePoliciesCols,
+					[][]driver.Value{
+						{
+							"org-id",
+							"instance-id",
+							uint64(20211109),
+							testNow,
+							testNow,
+							"org-id",
+							int64(90 * 24 * time.Hour),
+							int64(0),
+							int64(0),
+							false,
+							domain.PolicyStateActive,
+						},
+					},
+				),
+			},
+			object: &UserLifecyclePolicies{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Policies: []*UserLifecyclePolicy{
+					{
+						ID:                     "org-id",
+						InstanceID:             "instance-id",
+						CreationDate:           testNow,
+						ChangeDate:             testNow,
+						Sequence:               20211109,
+						ResourceOwner:          "org-id",
+						State:                  domain.PolicyStateActive,
+						InactivityDeactivation: 90 * 24 * time.Hour,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareUserLifecyclePoliciesQuery sql err",
+			prepare: prepareUserLifecyclePoliciesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareUserLifecyclePoliciesStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
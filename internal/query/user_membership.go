@@ -65,6 +65,14 @@ func NewMembershipUserIDQuery(userID string) (SearchQuery, error) {
 	return NewTextQuery(membershipUserID.setTable(membershipAlias), userID, TextEquals)
 }
 
+func NewMembershipUserIDsQuery(userIDs ...string) (SearchQuery, error) {
+	list := make([]interface{}, len(userIDs))
+	for i, value := range userIDs {
+		list[i] = value
+	}
+	return NewListQuery(membershipUserID.setTable(membershipAlias), list, ListIn)
+}
+
 func NewMembershipResourceOwnerQuery(value string) (SearchQuery, error) {
 	return NewTextQuery(membershipResourceOwner.setTable(membershipAlias), value, TextEquals)
 }
@@ -115,7 +123,7 @@ func (q *Queries) Memberships(ctx context.Context, queries *MembershipSearchQuer
 	if err != nil {
 		return nil, errors.ThrowInvalidArgument(err, "QUERY-T84X9", "Errors.Query.InvalidRequest")
 	}
-	latestSequence, err := q.latestSequence(ctx, orgMemberTable, instanceMemberTable, projectMemberTable, projectGrantMemberTable)
+	latestSequence, err := q.latestSequence(ctx, orgMemberTable, instanceMemberTable, projectMemberTable, projectGrantMemberTable, groupMembershipsTable)
 	if err != nil {
 		return nil, err
 	}
@@ -207,8 +215,9 @@ func getMembershipFromQuery(withOwnerRemoved bool) (string, []interface{}) {
 	iamMembers, iamMembersArgs := prepareIAMMember(withOwnerRemoved)
 	projectMembers, projectMembersArgs := prepareProjectMember(withOwnerRemoved)
 	projectGrantMembers, projectGrantMembersArgs := prepareProjectGrantMember(withOwnerRemoved)
+	groupMembers, groupMembersArgs := prepareGroupMember(withOwnerRemoved)
 	args := make([]interface{}, 0)
-	args = append(append(append(append(args, orgMembersArgs...), iamMembersArgs...), projectMembersArgs...), projectGrantMembersArgs...)
+	args = append(append(append(append(append(args, orgMembersArgs...), iamMembersArgs...), projectMembersArgs...), projectGrantMembersArgs...), groupMembersArgs...)
 
 	return "(" +
 			orgMembers +
@@ -218,6 +227,8 @@ func getMembershipFromQuery(withOwnerRemoved bool) (string, []interface{}) {
 			projectMembers +
 			" UNION ALL " +
 			projectGrantMembers +
+			" UNION ALL " +
+			groupMembers +
 			") AS " + membershipAlias.identifier(),
 		args
 }
@@ -409,3 +420,29 @@ func prepareProjectGrantMember(withOwnerRemoved bool) (string, []interface{}) {
 	}
 	return builder.MustSql()
 }
+
+// prepareGroupMember returns the memberships of the groups for each user of the group,
+// memberships without project are memberships of the organization of the group
+func prepareGroupMember(withOwnerRemoved bool) (string, []interface{}) {
+	builder := sq.Select(
+		GroupUserColumnUserID.identifier(),
+		GroupMembershipColumnRoles.identifier(),
+		GroupMembershipColumnCreationDate.identifier(),
+		GroupMembershipColumnChangeDate.identifier(),
+		GroupMembershipColumnSequence.identifier(),
+		GroupMembershipColumnResourceOwner.identifier(),
+		GroupMembershipColumnInstanceID.identifier(),
+		"CASE WHEN "+GroupMembershipColumnProjectID.identifier()+" = '' THEN "+GroupMembershipColumnResourceOwner.identifier()+" END AS "+membershipOrgID.name,
+		"NULL::TEXT AS "+membershipIAMID.name,
+		"NULLIF("+GroupMembershipColumnProjectID.identifier()+", '') AS "+membershipProjectID.name,
+		"NULL::TEXT AS "+membershipGrantID.name,
+	).From(groupMembershipsTable.identifier()).
+		Join(join(GroupUserColumnGroupID, GroupMembershipColumnGroupID))
+	if !withOwnerRemoved {
+		builder = builder.Where(sq.Eq{
+			GroupMembershipColumnOwnerRemoved.identifier(): false,
+			GroupUserColumnOwnerRemoved.identifier():       false,
+		})
+	}
+	return builder.MustSql()
+}
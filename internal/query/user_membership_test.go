@@ -28,7 +28,7 @@ var (
 			", memberships.grant_id" +
 			", projections.project_grants3.granted_org_id" +
 			", projections.projects3.name" +
-			", projections.orgs.name" +
+			", projections.orgs1.name" +
 			", COUNT(*) OVER ()" +
 			" FROM (" +
 			"SELECT members.user_id" +
@@ -86,9 +86,24 @@ var (
 			", members.grant_id" +
 			" FROM projections.project_grant_members3 AS members" +
 			" WHERE members.granted_org_removed = $7 AND members.owner_removed = $8 AND members.user_owner_removed = $9" +
+			" UNION ALL " +
+			"SELECT projections.group_users.user_id" +
+			", projections.group_memberships.roles" +
+			", projections.group_memberships.creation_date" +
+			", projections.group_memberships.change_date" +
+			", projections.group_memberships.sequence" +
+			", projections.group_memberships.resource_owner" +
+			", projections.group_memberships.instance_id" +
+			", CASE WHEN projections.group_memberships.project_id = '' THEN projections.group_memberships.resource_owner END AS org_id" +
+			", NULL::TEXT AS id" +
+			", NULLIF(projections.group_memberships.project_id, '') AS project_id" +
+			", NULL::TEXT AS grant_id" +
+			" FROM projections.group_memberships" +
+			" JOIN projections.group_users ON projections.group_memberships.group_id = projections.group_users.group_id AND projections.group_memberships.instance_id = projections.group_users.instance_id" +
+			" WHERE projections.group_memberships.owner_removed = $10 AND projections.group_users.owner_removed = $11" +
 			") AS memberships" +
 			" LEFT JOIN projections.projects3 ON memberships.project_id = projections.projects3.id AND memberships.instance_id = projections.projects3.instance_id" +
-			" LEFT JOIN projections.orgs ON memberships.org_id = projections.orgs.id AND memberships.instance_id = projections.orgs.instance_id" +
+			" LEFT JOIN projections.orgs1 ON memberships.org_id = projections.orgs1.id AND memberships.instance_id = projections.orgs1.instance_id" +
 			" LEFT JOIN projections.project_grants3 ON memberships.grant_id = projections.project_grants3.grant_id AND memberships.instance_id = projections.project_grants3.instance_id" +
 			` AS OF SYSTEM TIME '-1 ms'`)
 	membershipCols = []string{
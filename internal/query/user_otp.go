@@ -67,6 +67,8 @@ func (wm *HumanOTPReadModel) Reduce() error {
 		case *user.HumanOTPAddedEvent:
 			wm.Secret = e.Secret
 			wm.State = domain.MFAStateNotReady
+		case *user.HumanOTPSecretReencryptedEvent:
+			wm.Secret = e.Secret
 		case *user.HumanOTPVerifiedEvent:
 			wm.State = domain.MFAStateReady
 		case *user.HumanOTPRemovedEvent:
@@ -85,6 +87,7 @@ func (wm *HumanOTPReadModel) Query() *eventstore.SearchQueryBuilder {
 		AggregateIDs(wm.AggregateID).
 		EventTypes(user.HumanMFAOTPAddedType,
 			user.HumanMFAOTPVerifiedType,
+			user.HumanMFAOTPSecretReencryptedType,
 			user.HumanMFAOTPRemovedType,
 			user.UserRemovedType,
 			user.UserV1MFAOTPAddedType,
@@ -0,0 +1,233 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	errs "errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+var (
+	userSelfDeletionsTable = table{
+		name:          projection.UserSelfDeletionProjectionTable,
+		instanceIDCol: projection.UserSelfDeletionColumnInstanceID,
+	}
+	UserSelfDeletionColumnUserID = Column{
+		name:  projection.UserSelfDeletionColumnUserID,
+		table: userSelfDeletionsTable,
+	}
+	UserSelfDeletionColumnCreationDate = Column{
+		name:  projection.UserSelfDeletionColumnCreationDate,
+		table: userSelfDeletionsTable,
+	}
+	UserSelfDeletionColumnChangeDate = Column{
+		name:  projection.UserSelfDeletionColumnChangeDate,
+		table: userSelfDeletionsTable,
+	}
+	UserSelfDeletionColumnResourceOwner = Column{
+		name:  projection.UserSelfDeletionColumnResourceOwner,
+		table: userSelfDeletionsTable,
+	}
+	UserSelfDeletionColumnInstanceID = Column{
+		name:  projection.UserSelfDeletionColumnInstanceID,
+		table: userSelfDeletionsTable,
+	}
+	UserSelfDeletionColumnSequence = Column{
+		name:  projection.UserSelfDeletionColumnSequence,
+		table: userSelfDeletionsTable,
+	}
+	UserSelfDeletionColumnScheduledAt = Column{
+		name:  projection.UserSelfDeletionColumnScheduledAt,
+		table: userSelfDeletionsTable,
+	}
+	UserSelfDeletionColumnOwnerRemoved = Column{
+		name:  projection.UserSelfDeletionColumnOwnerRemoved,
+		table: userSelfDeletionsTable,
+	}
+)
+
+type UserSelfDeletions struct {
+	SearchResponse
+	UserSelfDeletions []*UserSelfDeletion
+}
+
+type UserSelfDeletion struct {
+	InstanceID    string
+	UserID        string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	Sequence      uint64
+	ScheduledAt   time.Time
+}
+
+type UserSelfDeletionSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+// UserSelfDeletionByUserID returns the pending deletion of the user, if requested
+func (q *Queries) UserSelfDeletionByUserID(ctx context.Context, shouldTriggerBulk bool, userID string) (_ *UserSelfDeletion, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		ctx = projection.UserSelfDeletionProjection.Trigger(ctx)
+	}
+
+	query, scan := prepareUserSelfDeletionQuery(ctx, q.client)
+	stmt, args, err := query.Where(sq.Eq{
+		UserSelfDeletionColumnUserID.identifier():       userID,
+		UserSelfDeletionColumnInstanceID.identifier():   authz.GetInstance(ctx).InstanceID(),
+		UserSelfDeletionColumnOwnerRemoved.identifier(): false,
+	}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Hs8wl", "Errors.Query.SQLStatment")
+	}
+
+	row := q.client.QueryRowContext(ctx, stmt, args...)
+	return scan(row)
+}
+
+// SearchUserSelfDeletions searches the pending deletions of the given instances,
+// if no instance is provided, the instance of the context is used
+func (q *Queries) SearchUserSelfDeletions(ctx context.Context, instanceIDs []string, queries *UserSelfDeletionSearchQueries) (_ *UserSelfDeletions, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareUserSelfDeletionsQuery(ctx, q.client)
+	if len(instanceIDs) == 0 {
+		instanceIDs = []string{authz.GetInstance(ctx).InstanceID()}
+	}
+	stmt, args, err := queries.toQuery(query).Where(sq.Eq{
+		UserSelfDeletionColumnInstanceID.identifier():   instanceIDs,
+		UserSelfDeletionColumnOwnerRemoved.identifier(): false,
+	}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Ct2nq", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Rk4pd", "Errors.Internal")
+	}
+	deletions, err := scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	deletions.LatestSequence, err = q.latestSequence(ctx, userSelfDeletionsTable)
+	return deletions, err
+}
+
+// NewUserSelfDeletionDueSearchQuery filters the deletions whose grace period is over
+func NewUserSelfDeletionDueSearchQuery(now time.Time) (SearchQuery, error) {
+	return &userSelfDeletionDueQuery{now: now}, nil
+}
+
+// userSelfDeletionDueQuery compares the scheduled date as timestamp,
+// which the [NumberQuery] does not allow
+type userSelfDeletionDueQuery struct {
+	now time.Time
+}
+
+func (q *userSelfDeletionDueQuery) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	return query.Where(q.comp())
+}
+
+func (q *userSelfDeletionDueQuery) comp() sq.Sqlizer {
+	return sq.Lt{UserSelfDeletionColumnScheduledAt.identifier(): q.now}
+}
+
+func (q *UserSelfDeletionSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func prepareUserSelfDeletionQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*UserSelfDeletion, error)) {
+	return sq.Select(
+			UserSelfDeletionColumnInstanceID.identifier(),
+			UserSelfDeletionColumnUserID.identifier(),
+			UserSelfDeletionColumnCreationDate.identifier(),
+			UserSelfDeletionColumnChangeDate.identifier(),
+			UserSelfDeletionColumnResourceOwner.identifier(),
+			UserSelfDeletionColumnSequence.identifier(),
+			UserSelfDeletionColumnScheduledAt.identifier()).
+			From(userSelfDeletionsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*UserSelfDeletion, error) {
+			d := new(UserSelfDeletion)
+			err := row.Scan(
+				&d.InstanceID,
+				&d.UserID,
+				&d.CreationDate,
+				&d.ChangeDate,
+				&d.ResourceOwner,
+				&d.Sequence,
+				&d.ScheduledAt,
+			)
+			if err != nil {
+				if errs.Is(err, sql.ErrNoRows) {
+					return nil, errors.ThrowNotFound(err, "QUERY-Ow2kd", "Errors.User.SelfDeletion.NotRequested")
+				}
+				return nil, errors.ThrowInternal(err, "QUERY-Ea7fm", "Errors.Internal")
+			}
+			return d, nil
+		}
+}
+
+func prepareUserSelfDeletionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*UserSelfDeletions, error)) {
+	return sq.Select(
+			UserSelfDeletionColumnInstanceID.identifier(),
+			UserSelfDeletionColumnUserID.identifier(),
+			UserSelfDeletionColumnCreationDate.identifier(),
+			UserSelfDeletionColumnChangeDate.identifier(),
+			UserSelfDeletionColumnResourceOwner.identifier(),
+			UserSelfDeletionColumnSequence.identifier(),
+			UserSelfDeletionColumnScheduledAt.identifier(),
+			countColumn.identifier()).
+			From(userSelfDeletionsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*UserSelfDeletions, error) {
+			deletions := make([]*UserSelfDeletion, 0)
+			var count uint64
+			for rows.Next() {
+				deletion := new(UserSelfDeletion)
+				err := rows.Scan(
+					&deletion.InstanceID,
+					&deletion.UserID,
+					&deletion.CreationDate,
+					&deletion.ChangeDate,
+					&deletion.ResourceOwner,
+					&deletion.Sequence,
+					&deletion.ScheduledAt,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				deletions = append(deletions, deletion)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Mv3sk", "Errors.Query.CloseRows")
+			}
+
+			return &UserSelfDeletions{
+				UserSelfDeletions: deletions,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
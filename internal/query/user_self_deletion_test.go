@@ -0,0 +1,257 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/database"
+	errs "github.com/zitadel/zitadel/internal/errors"
+)
+
+var (
+	userSelfDeletionStmt = regexp.QuoteMeta(
+		"SELECT projections.user_self_deletions.instance_id," +
+			" projections.user_self_deletions.user_id," +
+			" projections.user_self_deletions.creation_date," +
+			" projections.user_self_deletions.change_date," +
+			" projections.user_self_deletions.resource_owner," +
+			" projections.user_self_deletions.sequence," +
+			" projections.user_self_deletions.scheduled_at" +
+			" FROM projections.user_self_deletions" +
+			` AS OF SYSTEM TIME '-1 ms'`)
+	userSelfDeletionCols = []string{
+		"instance_id",
+		"user_id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"scheduled_at",
+	}
+	userSelfDeletionsStmt = regexp.QuoteMeta(
+		"SELECT projections.user_self_deletions.instance_id," +
+			" projections.user_self_deletions.user_id," +
+			" projections.user_self_deletions.creation_date," +
+			" projections.user_self_deletions.change_date," +
+			" projections.user_self_deletions.resource_owner," +
+			" projections.user_self_deletions.sequence," +
+			" projections.user_self_deletions.scheduled_at," +
+			" COUNT(*) OVER ()" +
+			" FROM projections.user_self_deletions" +
+			" AS OF SYSTEM TIME '-1 ms'")
+	userSelfDeletionsCols = []string{
+		"instance_id",
+		"user_id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"scheduled_at",
+		"count",
+	}
+)
+
+func Test_UserSelfDeletionPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareUserSelfDeletionQuery no result",
+			prepare: prepareUserSelfDeletionQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					userSelfDeletionStmt,
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*UserSelfDeletion)(nil),
+		},
+		{
+			name:    "prepareUserSelfDeletionQuery found",
+			prepare: prepareUserSelfDeletionQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					userSelfDeletionStmt,
+					userSelfDeletionCols,
+					[]driver.Value{
+						"instance-id",
+						"user-id",
+						testNow,
+						testNow,
+						"ro",
+						uint64(20211202),
+						time.Date(2023, 8, 1, 12, 0, 0, 0, time.UTC),
+					},
+				),
+			},
+			object: &UserSelfDeletion{
+				InstanceID:    "instance-id",
+				UserID:        "user-id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				ResourceOwner: "ro",
+				Sequence:      20211202,
+				ScheduledAt:   time.Date(2023, 8, 1, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:    "prepareUserSelfDeletionQuery sql err",
+			prepare: prepareUserSelfDeletionQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					userSelfDeletionStmt,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+		{
+			name:    "prepareUserSelfDeletionsQuery no result",
+			prepare: prepareUserSelfDeletionsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					userSelfDeletionsStmt,
+					nil,
+					nil,
+				),
+			},
+			object: &UserSelfDeletions{UserSelfDeletions: []*UserSelfDeletion{}},
+		},
+		{
+			name:    "prepareUserSelfDeletionsQuery one result",
+			prepare: prepareUserSelfDeletionsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					userSelfDeletionsStmt,
+					userSelfDeletionsCols,
+					[][]driver.Value{
+						{
+							"instance-id",
+							"user-id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211202),
+							time.Date(2023, 8, 1, 12, 0, 0, 0, time.UTC),
+						},
+					},
+				),
+			},
+			object: &UserSelfDeletions{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				UserSelfDeletions: []*UserSelfDeletion{
+					{
+						InstanceID:    "instance-id",
+						UserID:        "user-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						Sequence:      20211202,
+						ScheduledAt:   time.Date(2023, 8, 1, 12, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareUserSelfDeletionsQuery sql err",
+			prepare: prepareUserSelfDeletionsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					userSelfDeletionsStmt,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
+
+func TestQueries_SearchUserSelfDeletions_due(t *testing.T) {
+	now := time.Date(2023, 8, 2, 12, 0, 0, 0, time.UTC)
+	scheduledAt := time.Date(2023, 8, 1, 12, 0, 0, 0, time.UTC)
+	isDue, err := NewUserSelfDeletionDueSearchQuery(now)
+	require.NoError(t, err)
+
+	execMock(t, func(m sqlmock.Sqlmock) sqlmock.Sqlmock {
+		m.ExpectQuery(userSelfDeletionsStmt+
+			regexp.QuoteMeta(" WHERE projections.user_self_deletions.scheduled_at < $1"+
+				" AND projections.user_self_deletions.instance_id IN ($2)"+
+				" AND projections.user_self_deletions.owner_removed = $3"+
+				" ORDER BY projections.user_self_deletions.scheduled_at")).
+			WithArgs(now, "instance-id", false).
+			WillReturnRows(sqlmock.NewRows(userSelfDeletionsCols).AddRow(
+				"instance-id",
+				"user-id",
+				testNow,
+				testNow,
+				"ro",
+				uint64(20211202),
+				scheduledAt,
+				1,
+			))
+		m.ExpectQuery(regexp.QuoteMeta("projections.current_sequences")).
+			WillReturnRows(sqlmock.NewRows([]string{"current_sequence", "timestamp"}).AddRow(uint64(20211202), testNow))
+		return m
+	}, func(db *sql.DB) {
+		q := &Queries{
+			client: &database.DB{
+				DB:       db,
+				Database: &prepareDB{},
+			},
+		}
+		got, err := q.SearchUserSelfDeletions(context.Background(), []string{"instance-id"}, &UserSelfDeletionSearchQueries{
+			SearchRequest: SearchRequest{
+				SortingColumn: UserSelfDeletionColumnScheduledAt,
+				Asc:           true,
+			},
+			Queries: []SearchQuery{isDue},
+		})
+		require.NoError(t, err)
+		require.Len(t, got.UserSelfDeletions, 1)
+		assert.Equal(t, "user-id", got.UserSelfDeletions[0].UserID)
+		assert.Equal(t, scheduledAt, got.UserSelfDeletions[0].ScheduledAt)
+	})
+}
@@ -0,0 +1,288 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	errs "errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+var (
+	trustedDevicesTable = table{
+		name:          projection.TrustedDeviceProjectionTable,
+		instanceIDCol: projection.TrustedDeviceColumnInstanceID,
+	}
+	TrustedDeviceColumnUserAgentID = Column{
+		name:  projection.TrustedDeviceColumnUserAgentID,
+		table: trustedDevicesTable,
+	}
+	TrustedDeviceColumnUserID = Column{
+		name:  projection.TrustedDeviceColumnUserID,
+		table: trustedDevicesTable,
+	}
+	TrustedDeviceColumnCreationDate = Column{
+		name:  projection.TrustedDeviceColumnCreationDate,
+		table: trustedDevicesTable,
+	}
+	TrustedDeviceColumnChangeDate = Column{
+		name:  projection.TrustedDeviceColumnChangeDate,
+		table: trustedDevicesTable,
+	}
+	TrustedDeviceColumnResourceOwner = Column{
+		name:  projection.TrustedDeviceColumnResourceOwner,
+		table: trustedDevicesTable,
+	}
+	TrustedDeviceColumnInstanceID = Column{
+		name:  projection.TrustedDeviceColumnInstanceID,
+		table: trustedDevicesTable,
+	}
+	TrustedDeviceColumnSequence = Column{
+		name:  projection.TrustedDeviceColumnSequence,
+		table: trustedDevicesTable,
+	}
+	TrustedDeviceColumnName = Column{
+		name:  projection.TrustedDeviceColumnName,
+		table: trustedDevicesTable,
+	}
+	TrustedDeviceColumnRemoteIP = Column{
+		name:  projection.TrustedDeviceColumnRemoteIP,
+		table: trustedDevicesTable,
+	}
+	TrustedDeviceColumnExpiration = Column{
+		name:  projection.TrustedDeviceColumnExpiration,
+		table: trustedDevicesTable,
+	}
+	TrustedDeviceColumnOwnerRemoved = Column{
+		name:  projection.TrustedDeviceColumnOwnerRemoved,
+		table: trustedDevicesTable,
+	}
+)
+
+type TrustedDevices struct {
+	SearchResponse
+	TrustedDevices []*TrustedDevice
+}
+
+type TrustedDevice struct {
+	UserAgentID   string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	Sequence      uint64
+
+	UserID     string
+	Name       string
+	RemoteIP   string
+	Expiration time.Time
+}
+
+// IsTrusted returns if the trust of the device did not expire yet
+func (d *TrustedDevice) IsTrusted() bool {
+	return d != nil && time.Now().Before(d.Expiration)
+}
+
+type TrustedDeviceSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *Queries) TrustedDeviceByUserAgentID(ctx context.Context, shouldTriggerBulk bool, userID, userAgentID string) (_ *TrustedDevice, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		ctx = projection.TrustedDeviceProjection.Trigger(ctx)
+	}
+
+	query, scan := prepareTrustedDeviceQuery(ctx, q.client)
+	stmt, args, err := query.Where(sq.Eq{
+		TrustedDeviceColumnUserID.identifier():       userID,
+		TrustedDeviceColumnUserAgentID.identifier():  userAgentID,
+		TrustedDeviceColumnInstanceID.identifier():   authz.GetInstance(ctx).InstanceID(),
+		TrustedDeviceColumnOwnerRemoved.identifier(): false,
+	}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Pw3nf", "Errors.Query.SQLStatment")
+	}
+
+	row := q.client.QueryRowContext(ctx, stmt, args...)
+	return scan(row)
+}
+
+func (q *Queries) SearchTrustedDevices(ctx context.Context, queries *TrustedDeviceSearchQueries, withOwnerRemoved bool) (trustedDevices *TrustedDevices, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareTrustedDevicesQuery(ctx, q.client)
+	eq := sq.Eq{
+		TrustedDeviceColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	if !withOwnerRemoved {
+		eq[TrustedDeviceColumnOwnerRemoved.identifier()] = false
+	}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Xk2da", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Wc8qn", "Errors.Internal")
+	}
+	trustedDevices, err = scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	trustedDevices.LatestSequence, err = q.latestSequence(ctx, trustedDevicesTable)
+	return trustedDevices, err
+}
+
+func NewTrustedDeviceResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(TrustedDeviceColumnResourceOwner, value, TextEquals)
+}
+
+func NewTrustedDeviceUserIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(TrustedDeviceColumnUserID, value, TextEquals)
+}
+
+// NewTrustedDeviceNotExpiredSearchQuery filters out devices whose trust already expired
+func NewTrustedDeviceNotExpiredSearchQuery() (SearchQuery, error) {
+	return &trustedDeviceNotExpiredQuery{now: time.Now()}, nil
+}
+
+// trustedDeviceNotExpiredQuery compares the expiration as timestamp,
+// which the [NumberQuery] does not allow
+type trustedDeviceNotExpiredQuery struct {
+	now time.Time
+}
+
+func (q *trustedDeviceNotExpiredQuery) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	return query.Where(q.comp())
+}
+
+func (q *trustedDeviceNotExpiredQuery) comp() sq.Sqlizer {
+	return sq.Gt{TrustedDeviceColumnExpiration.identifier(): q.now}
+}
+
+func (r *TrustedDeviceSearchQueries) AppendMyResourceOwnerQuery(orgID string) error {
+	query, err := NewTrustedDeviceResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return err
+	}
+	r.Queries = append(r.Queries, query)
+	return nil
+}
+
+func (q *TrustedDeviceSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func prepareTrustedDeviceQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*TrustedDevice, error)) {
+	return sq.Select(
+			TrustedDeviceColumnUserAgentID.identifier(),
+			TrustedDeviceColumnCreationDate.identifier(),
+			TrustedDeviceColumnChangeDate.identifier(),
+			TrustedDeviceColumnResourceOwner.identifier(),
+			TrustedDeviceColumnSequence.identifier(),
+			TrustedDeviceColumnUserID.identifier(),
+			TrustedDeviceColumnName.identifier(),
+			TrustedDeviceColumnRemoteIP.identifier(),
+			TrustedDeviceColumnExpiration.identifier()).
+			From(trustedDevicesTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*TrustedDevice, error) {
+			d := new(TrustedDevice)
+			var (
+				name     sql.NullString
+				remoteIP sql.NullString
+			)
+			err := row.Scan(
+				&d.UserAgentID,
+				&d.CreationDate,
+				&d.ChangeDate,
+				&d.ResourceOwner,
+				&d.Sequence,
+				&d.UserID,
+				&name,
+				&remoteIP,
+				&d.Expiration,
+			)
+			if err != nil {
+				if errs.Is(err, sql.ErrNoRows) {
+					return nil, errors.ThrowNotFound(err, "QUERY-Gq3mf", "Errors.User.TrustedDevice.NotFound")
+				}
+				return nil, errors.ThrowInternal(err, "QUERY-Vn2qe", "Errors.Internal")
+			}
+			d.Name = name.String
+			d.RemoteIP = remoteIP.String
+			return d, nil
+		}
+}
+
+func prepareTrustedDevicesQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*TrustedDevices, error)) {
+	return sq.Select(
+			TrustedDeviceColumnUserAgentID.identifier(),
+			TrustedDeviceColumnCreationDate.identifier(),
+			TrustedDeviceColumnChangeDate.identifier(),
+			TrustedDeviceColumnResourceOwner.identifier(),
+			TrustedDeviceColumnSequence.identifier(),
+			TrustedDeviceColumnUserID.identifier(),
+			TrustedDeviceColumnName.identifier(),
+			TrustedDeviceColumnRemoteIP.identifier(),
+			TrustedDeviceColumnExpiration.identifier(),
+			countColumn.identifier()).
+			From(trustedDevicesTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*TrustedDevices, error) {
+			trustedDevices := make([]*TrustedDevice, 0)
+			var count uint64
+			for rows.Next() {
+				device := new(TrustedDevice)
+				var (
+					name     sql.NullString
+					remoteIP sql.NullString
+				)
+				err := rows.Scan(
+					&device.UserAgentID,
+					&device.CreationDate,
+					&device.ChangeDate,
+					&device.ResourceOwner,
+					&device.Sequence,
+					&device.UserID,
+					&name,
+					&remoteIP,
+					&device.Expiration,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				device.Name = name.String
+				device.RemoteIP = remoteIP.String
+				trustedDevices = append(trustedDevices, device)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Ja9vd", "Errors.Query.CloseRows")
+			}
+
+			return &TrustedDevices{
+				TrustedDevices: trustedDevices,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
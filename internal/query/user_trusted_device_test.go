@@ -0,0 +1,238 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	errs "github.com/zitadel/zitadel/internal/errors"
+)
+
+var (
+	trustedDeviceStmt = regexp.QuoteMeta(
+		"SELECT projections.trusted_devices.user_agent_id," +
+			" projections.trusted_devices.creation_date," +
+			" projections.trusted_devices.change_date," +
+			" projections.trusted_devices.resource_owner," +
+			" projections.trusted_devices.sequence," +
+			" projections.trusted_devices.user_id," +
+			" projections.trusted_devices.name," +
+			" projections.trusted_devices.remote_ip," +
+			" projections.trusted_devices.expiration" +
+			" FROM projections.trusted_devices" +
+			` AS OF SYSTEM TIME '-1 ms'`)
+	trustedDeviceCols = []string{
+		"user_agent_id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"user_id",
+		"name",
+		"remote_ip",
+		"expiration",
+	}
+	trustedDevicesStmt = regexp.QuoteMeta(
+		"SELECT projections.trusted_devices.user_agent_id," +
+			" projections.trusted_devices.creation_date," +
+			" projections.trusted_devices.change_date," +
+			" projections.trusted_devices.resource_owner," +
+			" projections.trusted_devices.sequence," +
+			" projections.trusted_devices.user_id," +
+			" projections.trusted_devices.name," +
+			" projections.trusted_devices.remote_ip," +
+			" projections.trusted_devices.expiration," +
+			" COUNT(*) OVER ()" +
+			" FROM projections.trusted_devices" +
+			" AS OF SYSTEM TIME '-1 ms'")
+	trustedDevicesCols = []string{
+		"user_agent_id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"user_id",
+		"name",
+		"remote_ip",
+		"expiration",
+		"count",
+	}
+)
+
+func Test_TrustedDevicePrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareTrustedDeviceQuery no result",
+			prepare: prepareTrustedDeviceQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					trustedDeviceStmt,
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*TrustedDevice)(nil),
+		},
+		{
+			name:    "prepareTrustedDeviceQuery found",
+			prepare: prepareTrustedDeviceQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					trustedDeviceStmt,
+					trustedDeviceCols,
+					[]driver.Value{
+						"agent-id",
+						testNow,
+						testNow,
+						"ro",
+						uint64(20211202),
+						"user-id",
+						"Mozilla/5.0",
+						"127.0.0.1",
+						time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+					},
+				),
+			},
+			object: &TrustedDevice{
+				UserAgentID:   "agent-id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				ResourceOwner: "ro",
+				Sequence:      20211202,
+				UserID:        "user-id",
+				Name:          "Mozilla/5.0",
+				RemoteIP:      "127.0.0.1",
+				Expiration:    time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+			},
+		},
+		{
+			name:    "prepareTrustedDeviceQuery sql err",
+			prepare: prepareTrustedDeviceQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					trustedDeviceStmt,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+		{
+			name:    "prepareTrustedDevicesQuery no result",
+			prepare: prepareTrustedDevicesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					trustedDevicesStmt,
+					nil,
+					nil,
+				),
+			},
+			object: &TrustedDevices{TrustedDevices: []*TrustedDevice{}},
+		},
+		{
+			name:    "prepareTrustedDevicesQuery one device without name",
+			prepare: prepareTrustedDevicesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					trustedDevicesStmt,
+					trustedDevicesCols,
+					[][]driver.Value{
+						{
+							"agent-id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211202),
+							"user-id",
+							nil,
+							nil,
+							time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+						},
+					},
+				),
+			},
+			object: &TrustedDevices{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				TrustedDevices: []*TrustedDevice{
+					{
+						UserAgentID:   "agent-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						Sequence:      20211202,
+						UserID:        "user-id",
+						Expiration:    time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareTrustedDevicesQuery sql err",
+			prepare: prepareTrustedDevicesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					trustedDevicesStmt,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
+
+func TestNewTrustedDeviceNotExpiredSearchQuery(t *testing.T) {
+	q, err := NewTrustedDeviceNotExpiredSearchQuery()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	stmt, args, err := q.comp().ToSql()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stmt != "projections.trusted_devices.expiration > ?" {
+		t.Errorf("unexpected statement: %s", stmt)
+	}
+	if len(args) != 1 {
+		t.Fatalf("expected one argument, got %d", len(args))
+	}
+	if _, ok := args[0].(time.Time); !ok {
+		t.Errorf("expected time argument, got %T", args[0])
+	}
+}
@@ -9,11 +9,12 @@ import (
 )
 
 const (
-	eventTypePrefix   eventstore.EventType = "device.authorization."
-	AddedEventType                         = eventTypePrefix + "added"
-	ApprovedEventType                      = eventTypePrefix + "approved"
-	CanceledEventType                      = eventTypePrefix + "canceled"
-	RemovedEventType                       = eventTypePrefix + "removed"
+	eventTypePrefix           eventstore.EventType = "device.authorization."
+	AddedEventType                                 = eventTypePrefix + "added"
+	ApprovedEventType                              = eventTypePrefix + "approved"
+	CanceledEventType                              = eventTypePrefix + "canceled"
+	RemovedEventType                               = eventTypePrefix + "removed"
+	NotificationSentEventType                      = eventTypePrefix + "notification.sent"
 )
 
 type AddedEvent struct {
@@ -25,6 +26,9 @@ type AddedEvent struct {
 	Expires    time.Time
 	Scopes     []string
 	State      domain.DeviceAuthState
+
+	// Backchannel is only set for client initiated backchannel authentication requests (CIBA)
+	Backchannel *domain.BackchannelAuth `json:",omitempty"`
 }
 
 func (e *AddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
@@ -52,7 +56,7 @@ func NewAddedEvent(
 		eventstore.NewBaseEventForPush(
 			ctx, aggregate, AddedEventType,
 		),
-		clientID, deviceCode, userCode, expires, scopes, domain.DeviceAuthStateInitiated}
+		clientID, deviceCode, userCode, expires, scopes, domain.DeviceAuthStateInitiated, nil}
 }
 
 type ApprovedEvent struct {
@@ -139,3 +143,27 @@ func NewRemovedEvent(
 		clientID, deviceCode, userCode,
 	}
 }
+
+// NotificationSentEvent marks the notification of the user about a backchannel authentication request (initiated state)
+// or the ping of the client about its result (approved or denied state) as sent.
+type NotificationSentEvent struct {
+	*eventstore.BaseEvent
+
+	State domain.DeviceAuthState `json:"state"`
+}
+
+func (e *NotificationSentEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *NotificationSentEvent) Data() any {
+	return e
+}
+
+func (e *NotificationSentEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewNotificationSentEvent(ctx context.Context, aggregate *eventstore.Aggregate, state domain.DeviceAuthState) *NotificationSentEvent {
+	return &NotificationSentEvent{eventstore.NewBaseEventForPush(ctx, aggregate, NotificationSentEventType), state}
+}
@@ -0,0 +1,17 @@
+package group
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+func RegisterEventMappers(es *eventstore.Eventstore) {
+	es.RegisterFilterEventMapper(AggregateType, AddedEventType, AddedEventMapper).
+		RegisterFilterEventMapper(AggregateType, ChangedEventType, ChangedEventMapper).
+		RegisterFilterEventMapper(AggregateType, RemovedEventType, RemovedEventMapper).
+		RegisterFilterEventMapper(AggregateType, UserAddedEventType, UserAddedEventMapper).
+		RegisterFilterEventMapper(AggregateType, UserRemovedEventType, UserRemovedEventMapper).
+		RegisterFilterEventMapper(AggregateType, GrantAddedEventType, GrantAddedEventMapper).
+		RegisterFilterEventMapper(AggregateType, GrantChangedEventType, GrantChangedEventMapper).
+		RegisterFilterEventMapper(AggregateType, GrantRemovedEventType, GrantRemovedEventMapper).
+		RegisterFilterEventMapper(AggregateType, MembershipAddedEventType, MembershipAddedEventMapper).
+		RegisterFilterEventMapper(AggregateType, MembershipChangedEventType, MembershipChangedEventMapper).
+		RegisterFilterEventMapper(AggregateType, MembershipRemovedEventType, MembershipRemovedEventMapper)
+}
@@ -0,0 +1,151 @@
+package group
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+const (
+	membershipEventTypePrefix  = eventTypePrefix + "membership."
+	MembershipAddedEventType   = membershipEventTypePrefix + "added"
+	MembershipChangedEventType = membershipEventTypePrefix + "changed"
+	MembershipRemovedEventType = membershipEventTypePrefix + "removed"
+)
+
+// MembershipAddedEvent makes the users of the group members of the organization of the group
+// or, if the ProjectID is set, of the project.
+type MembershipAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ProjectID string   `json:"projectId,omitempty"`
+	Roles     []string `json:"roles"`
+}
+
+func (e *MembershipAddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *MembershipAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewMembershipAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	projectID string,
+	roles []string,
+) *MembershipAddedEvent {
+	return &MembershipAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			MembershipAddedEventType,
+		),
+		ProjectID: projectID,
+		Roles:     roles,
+	}
+}
+
+func MembershipAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &MembershipAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "GROUP-Gm4ka", "unable to unmarshal group membership added")
+	}
+
+	return e, nil
+}
+
+type MembershipChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ProjectID string   `json:"projectId,omitempty"`
+	Roles     []string `json:"roles"`
+}
+
+func (e *MembershipChangedEvent) Data() interface{} {
+	return e
+}
+
+func (e *MembershipChangedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewMembershipChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	projectID string,
+	roles []string,
+) *MembershipChangedEvent {
+	return &MembershipChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			MembershipChangedEventType,
+		),
+		ProjectID: projectID,
+		Roles:     roles,
+	}
+}
+
+func MembershipChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &MembershipChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "GROUP-Gm9pc", "unable to unmarshal group membership changed")
+	}
+
+	return e, nil
+}
+
+type MembershipRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ProjectID string `json:"projectId,omitempty"`
+}
+
+func (e *MembershipRemovedEvent) Data() interface{} {
+	return e
+}
+
+func (e *MembershipRemovedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewMembershipRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	projectID string,
+) *MembershipRemovedEvent {
+	return &MembershipRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			MembershipRemovedEventType,
+		),
+		ProjectID: projectID,
+	}
+}
+
+func MembershipRemovedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &MembershipRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "GROUP-Gm1ws", "unable to unmarshal group membership removed")
+	}
+
+	return e, nil
+}
@@ -0,0 +1,100 @@
+package group
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+const (
+	userEventTypePrefix  = eventTypePrefix + "user."
+	UserAddedEventType   = userEventTypePrefix + "added"
+	UserRemovedEventType = userEventTypePrefix + "removed"
+)
+
+type UserAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID string `json:"userId"`
+}
+
+func (e *UserAddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *UserAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewUserAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID string,
+) *UserAddedEvent {
+	return &UserAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserAddedEventType,
+		),
+		UserID: userID,
+	}
+}
+
+func UserAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &UserAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "GROUP-Gu3ma", "unable to unmarshal group user added")
+	}
+
+	return e, nil
+}
+
+type UserRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID string `json:"userId"`
+}
+
+func (e *UserRemovedEvent) Data() interface{} {
+	return e
+}
+
+func (e *UserRemovedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewUserRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID string,
+) *UserRemovedEvent {
+	return &UserRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserRemovedEventType,
+		),
+		UserID: userID,
+	}
+}
+
+func UserRemovedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &UserRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "GROUP-Gu8vd", "unable to unmarshal group user removed")
+	}
+
+	return e, nil
+}
@@ -2,12 +2,20 @@ package idp
 
 import (
 	"encoding/json"
+	"strconv"
+	"strings"
 
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/errors"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/repository"
 )
 
+const (
+	uniqueSecretReencryption = "idp_secret_reencryption"
+)
+
 type Options struct {
 	IsCreationAllowed bool `json:"isCreationAllowed,omitempty"`
 	IsLinkingAllowed  bool `json:"isLinkingAllowed,omitempty"`
@@ -94,3 +102,64 @@ func RemovedEventMapper(event *repository.Event) (eventstore.Event, error) {
 
 	return e, nil
 }
+
+// SecretReencryptedEvent replaces the client secret (or the bind password for LDAP)
+// of the identity provider with the same secret encrypted by the current encryption key
+type SecretReencryptedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID      string              `json:"id"`
+	IDPType domain.IDPType      `json:"idpType"`
+	Secret  *crypto.CryptoValue `json:"secret"`
+
+	replacedSequence uint64
+}
+
+// NewSecretReencryptedEvent replaces the secret set by the event with the replacedSequence
+func NewSecretReencryptedEvent(
+	base *eventstore.BaseEvent,
+	id string,
+	idpType domain.IDPType,
+	secret *crypto.CryptoValue,
+	replacedSequence uint64,
+) *SecretReencryptedEvent {
+	return &SecretReencryptedEvent{
+		BaseEvent:        *base,
+		ID:               id,
+		IDPType:          idpType,
+		Secret:           secret,
+		replacedSequence: replacedSequence,
+	}
+}
+
+func (e *SecretReencryptedEvent) Data() interface{} {
+	return e
+}
+
+// UniqueConstraints prevents that the same secret is re-encrypted multiple times,
+// e.g. by concurrent re-encryptions
+func (e *SecretReencryptedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{NewAddSecretReencryptionUniqueConstraint(e.Aggregate().ID, e.ID, e.replacedSequence)}
+}
+
+// NewAddSecretReencryptionUniqueConstraint identifies the value of a secret by the sequence of the event which set it
+func NewAddSecretReencryptionUniqueConstraint(aggregateID, idpID string, replacedSequence uint64) *eventstore.EventUniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		uniqueSecretReencryption,
+		strings.Join([]string{aggregateID, idpID, strconv.FormatUint(replacedSequence, 10)}, ":"),
+		"Errors.SecretReencryption.AlreadyReencrypted",
+	)
+}
+
+func SecretReencryptedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &SecretReencryptedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "IDP-Re5nc", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
@@ -0,0 +1,165 @@
+package instance
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+const (
+	customRolePrefix           = "custom.role."
+	CustomRoleAddedEventType   = instanceEventTypePrefix + customRolePrefix + "added"
+	CustomRoleChangedEventType = instanceEventTypePrefix + customRolePrefix + "changed"
+	CustomRoleRemovedEventType = instanceEventTypePrefix + customRolePrefix + "removed"
+)
+
+type CustomRoleAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Role        string   `json:"role"`
+	DisplayName string   `json:"displayName,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+func NewCustomRoleAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	role,
+	displayName string,
+	permissions []string,
+) *CustomRoleAddedEvent {
+	return &CustomRoleAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			CustomRoleAddedEventType,
+		),
+		Role:        role,
+		DisplayName: displayName,
+		Permissions: permissions,
+	}
+}
+
+func (e *CustomRoleAddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *CustomRoleAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func CustomRoleAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &CustomRoleAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	if err := json.Unmarshal(event.Data, e); err != nil {
+		return nil, errors.ThrowInternal(err, "INST-Cr1ad", "unable to unmarshal custom role added")
+	}
+	return e, nil
+}
+
+type CustomRoleChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Role        string   `json:"role"`
+	DisplayName *string  `json:"displayName,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+func NewCustomRoleChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	role string,
+	changes []CustomRoleChanges,
+) (*CustomRoleChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, errors.ThrowPreconditionFailed(nil, "INST-Cr2ch", "Errors.NoChangesFound")
+	}
+	changeEvent := &CustomRoleChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			CustomRoleChangedEventType,
+		),
+		Role: role,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type CustomRoleChanges func(event *CustomRoleChangedEvent)
+
+func ChangeCustomRoleDisplayName(displayName string) func(event *CustomRoleChangedEvent) {
+	return func(e *CustomRoleChangedEvent) {
+		e.DisplayName = &displayName
+	}
+}
+
+// ChangeCustomRolePermissions replaces all permissions of the role
+func ChangeCustomRolePermissions(permissions []string) func(event *CustomRoleChangedEvent) {
+	return func(e *CustomRoleChangedEvent) {
+		e.Permissions = permissions
+	}
+}
+
+func (e *CustomRoleChangedEvent) Data() interface{} {
+	return e
+}
+
+func (e *CustomRoleChangedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func CustomRoleChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &CustomRoleChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	if err := json.Unmarshal(event.Data, e); err != nil {
+		return nil, errors.ThrowInternal(err, "INST-Cr3ch", "unable to unmarshal custom role changed")
+	}
+	return e, nil
+}
+
+type CustomRoleRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Role string `json:"role"`
+}
+
+func NewCustomRoleRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	role string,
+) *CustomRoleRemovedEvent {
+	return &CustomRoleRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			CustomRoleRemovedEventType,
+		),
+		Role: role,
+	}
+}
+
+func (e *CustomRoleRemovedEvent) Data() interface{} {
+	return e
+}
+
+func (e *CustomRoleRemovedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func CustomRoleRemovedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &CustomRoleRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	if err := json.Unmarshal(event.Data, e); err != nil {
+		return nil, errors.ThrowInternal(err, "INST-Cr4rm", "unable to unmarshal custom role removed")
+	}
+	return e, nil
+}
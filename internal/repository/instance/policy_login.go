@@ -39,7 +39,8 @@ func NewLoginPolicyAddedEvent(
 	externalLoginCheckLifetime,
 	mfaInitSkipLifetime,
 	secondFactorCheckLifetime,
-	multiFactorCheckLifetime time.Duration,
+	multiFactorCheckLifetime,
+	trustedDeviceLifetime time.Duration,
 ) *LoginPolicyAddedEvent {
 	return &LoginPolicyAddedEvent{
 		LoginPolicyAddedEvent: *policy.NewLoginPolicyAddedEvent(
@@ -63,7 +64,8 @@ func NewLoginPolicyAddedEvent(
 			externalLoginCheckLifetime,
 			mfaInitSkipLifetime,
 			secondFactorCheckLifetime,
-			multiFactorCheckLifetime),
+			multiFactorCheckLifetime,
+			trustedDeviceLifetime),
 	}
 }
 
@@ -0,0 +1,23 @@
+package instance
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	uniqueSecretReencryption = "instance_secret_reencryption"
+)
+
+// NewAddSecretReencryptionUniqueConstraint prevents that the same value of the SMTP password or an SMS token
+// is re-encrypted multiple times, e.g. by concurrent re-encryptions.
+// The value is identified by the sequence of the event which set it.
+func NewAddSecretReencryptionUniqueConstraint(instanceID, id string, replacedSequence uint64) *eventstore.EventUniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		uniqueSecretReencryption,
+		strings.Join([]string{instanceID, id, strconv.FormatUint(replacedSequence, 10)}, ":"),
+		"Errors.SecretReencryption.AlreadyReencrypted",
+	)
+}
@@ -11,14 +11,15 @@ import (
 )
 
 const (
-	smsConfigPrefix                      = "sms.config"
-	smsConfigTwilioPrefix                = "twilio."
-	SMSConfigTwilioAddedEventType        = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "added"
-	SMSConfigTwilioChangedEventType      = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "changed"
-	SMSConfigTwilioTokenChangedEventType = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "token.changed"
-	SMSConfigActivatedEventType          = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "activated"
-	SMSConfigDeactivatedEventType        = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "deactivated"
-	SMSConfigRemovedEventType            = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "removed"
+	smsConfigPrefix                          = "sms.config"
+	smsConfigTwilioPrefix                    = "twilio."
+	SMSConfigTwilioAddedEventType            = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "added"
+	SMSConfigTwilioChangedEventType          = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "changed"
+	SMSConfigTwilioTokenChangedEventType     = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "token.changed"
+	SMSConfigTwilioTokenReencryptedEventType = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "token.reencrypted"
+	SMSConfigActivatedEventType              = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "activated"
+	SMSConfigDeactivatedEventType            = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "deactivated"
+	SMSConfigRemovedEventType                = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "removed"
 )
 
 type SMSConfigTwilioAddedEvent struct {
@@ -180,6 +181,57 @@ func SMSConfigTwilioTokenChangedEventMapper(event *repository.Event) (eventstore
 	return smtpConfigTokenChagned, nil
 }
 
+// SMSConfigTwilioTokenReencryptedEvent replaces the Twilio token
+// with the same token encrypted by the current encryption key
+type SMSConfigTwilioTokenReencryptedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID    string              `json:"id,omitempty"`
+	Token *crypto.CryptoValue `json:"token,omitempty"`
+
+	replacedSequence uint64
+}
+
+// NewSMSConfigTokenReencryptedEvent replaces the token set by the event with the replacedSequence
+func NewSMSConfigTokenReencryptedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	token *crypto.CryptoValue,
+	replacedSequence uint64,
+) *SMSConfigTwilioTokenReencryptedEvent {
+	return &SMSConfigTwilioTokenReencryptedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigTwilioTokenReencryptedEventType,
+		),
+		ID:               id,
+		Token:            token,
+		replacedSequence: replacedSequence,
+	}
+}
+
+func (e *SMSConfigTwilioTokenReencryptedEvent) Data() interface{} {
+	return e
+}
+
+func (e *SMSConfigTwilioTokenReencryptedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{NewAddSecretReencryptionUniqueConstraint(e.Aggregate().ID, e.ID, e.replacedSequence)}
+}
+
+func SMSConfigTwilioTokenReencryptedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	smsConfigTokenReencrypted := &SMSConfigTwilioTokenReencryptedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, smsConfigTokenReencrypted)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "IAM-Re4nc", "unable to unmarshal sms config token reencrypted")
+	}
+
+	return smsConfigTokenReencrypted, nil
+}
+
 type SMSConfigActivatedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 	ID                   string `json:"id,omitempty"`
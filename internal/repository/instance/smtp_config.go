@@ -11,11 +11,12 @@ import (
 )
 
 const (
-	smtpConfigPrefix                   = "smtp.config."
-	SMTPConfigAddedEventType           = instanceEventTypePrefix + smtpConfigPrefix + "added"
-	SMTPConfigChangedEventType         = instanceEventTypePrefix + smtpConfigPrefix + "changed"
-	SMTPConfigPasswordChangedEventType = instanceEventTypePrefix + smtpConfigPrefix + "password.changed"
-	SMTPConfigRemovedEventType         = instanceEventTypePrefix + smtpConfigPrefix + "removed"
+	smtpConfigPrefix                       = "smtp.config."
+	SMTPConfigAddedEventType               = instanceEventTypePrefix + smtpConfigPrefix + "added"
+	SMTPConfigChangedEventType             = instanceEventTypePrefix + smtpConfigPrefix + "changed"
+	SMTPConfigPasswordChangedEventType     = instanceEventTypePrefix + smtpConfigPrefix + "password.changed"
+	SMTPConfigPasswordReencryptedEventType = instanceEventTypePrefix + smtpConfigPrefix + "password.reencrypted"
+	SMTPConfigRemovedEventType             = instanceEventTypePrefix + smtpConfigPrefix + "removed"
 )
 
 type SMTPConfigAddedEvent struct {
@@ -199,6 +200,54 @@ func SMTPConfigPasswordChangedEventMapper(event *repository.Event) (eventstore.E
 	return smtpConfigPasswordChagned, nil
 }
 
+// SMTPConfigPasswordReencryptedEvent replaces the SMTP password
+// with the same password encrypted by the current encryption key
+type SMTPConfigPasswordReencryptedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Password *crypto.CryptoValue `json:"password,omitempty"`
+
+	replacedSequence uint64
+}
+
+// NewSMTPConfigPasswordReencryptedEvent replaces the password set by the event with the replacedSequence
+func NewSMTPConfigPasswordReencryptedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	password *crypto.CryptoValue,
+	replacedSequence uint64,
+) *SMTPConfigPasswordReencryptedEvent {
+	return &SMTPConfigPasswordReencryptedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMTPConfigPasswordReencryptedEventType,
+		),
+		Password:         password,
+		replacedSequence: replacedSequence,
+	}
+}
+
+func (e *SMTPConfigPasswordReencryptedEvent) Data() interface{} {
+	return e
+}
+
+func (e *SMTPConfigPasswordReencryptedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{NewAddSecretReencryptionUniqueConstraint(e.Aggregate().ID, "", e.replacedSequence)}
+}
+
+func SMTPConfigPasswordReencryptedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	smtpConfigPasswordReencrypted := &SMTPConfigPasswordReencryptedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, smtpConfigPasswordReencrypted)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "IAM-Re3nc", "unable to unmarshal smtp config password reencrypted")
+	}
+
+	return smtpConfigPasswordReencrypted, nil
+}
+
 type SMTPConfigRemovedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 }
@@ -13,8 +13,10 @@ import (
 )
 
 const (
-	eventTypePrefix = eventstore.EventType("key_pair.")
-	AddedEventType  = eventTypePrefix + "added"
+	eventTypePrefix      = eventstore.EventType("key_pair.")
+	AddedEventType       = eventTypePrefix + "added"
+	ActivatedEventType   = eventTypePrefix + "activated"
+	DeactivatedEventType = eventTypePrefix + "deactivated"
 )
 
 type AddedEvent struct {
@@ -24,6 +26,10 @@ type AddedEvent struct {
 	Algorithm  string          `json:"algorithm"`
 	PrivateKey *Key            `json:"privateKey"`
 	PublicKey  *Key            `json:"publicKey"`
+	// Staged key pairs are published, but not used for signing until they are activated
+	Staged bool `json:"staged,omitempty"`
+	// Imported is set if the key pair was provided by an administrator instead of generated
+	Imported bool `json:"imported,omitempty"`
 }
 
 type Key struct {
@@ -79,3 +85,59 @@ func AddedEventMapper(event *repository.Event) (eventstore.Event, error) {
 
 	return e, nil
 }
+
+type ActivatedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *ActivatedEvent) Data() interface{} {
+	return nil
+}
+
+func (e *ActivatedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewActivatedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *ActivatedEvent {
+	return &ActivatedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ActivatedEventType,
+		),
+	}
+}
+
+func ActivatedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	return &ActivatedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
+
+type DeactivatedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *DeactivatedEvent) Data() interface{} {
+	return nil
+}
+
+func (e *DeactivatedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewDeactivatedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *DeactivatedEvent {
+	return &DeactivatedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DeactivatedEventType,
+		),
+	}
+}
+
+func DeactivatedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	return &DeactivatedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -28,6 +28,8 @@ type AddedEvent struct {
 	Scope       []string                    `json:"scope"`
 	AuthMethods []domain.UserAuthMethodType `json:"authMethods"`
 	AuthTime    time.Time                   `json:"authTime"`
+
+	AuthorizationDetails domain.AuthorizationDetails `json:"authorizationDetails,omitempty"`
 }
 
 func (e *AddedEvent) Data() interface{} {
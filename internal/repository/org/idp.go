@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/repository"
 	"github.com/zitadel/zitadel/internal/repository/idp"
@@ -34,6 +35,7 @@ const (
 	LDAPIDPAddedEventType               eventstore.EventType = "org.idp.ldap.added"
 	LDAPIDPChangedEventType             eventstore.EventType = "org.idp.ldap.changed"
 	IDPRemovedEventType                 eventstore.EventType = "org.idp.removed"
+	IDPSecretReencryptedEventType       eventstore.EventType = "org.idp.secret.reencrypted"
 )
 
 type OAuthIDPAddedEvent struct {
@@ -953,3 +955,43 @@ func IDPRemovedEventMapper(event *repository.Event) (eventstore.Event, error) {
 
 	return &IDPRemovedEvent{RemovedEvent: *e.(*idp.RemovedEvent)}, nil
 }
+
+type IDPSecretReencryptedEvent struct {
+	idp.SecretReencryptedEvent
+}
+
+func NewIDPSecretReencryptedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	idpType domain.IDPType,
+	secret *crypto.CryptoValue,
+	replacedSequence uint64,
+) *IDPSecretReencryptedEvent {
+	return &IDPSecretReencryptedEvent{
+		SecretReencryptedEvent: *idp.NewSecretReencryptedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				IDPSecretReencryptedEventType,
+			),
+			id,
+			idpType,
+			secret,
+			replacedSequence,
+		),
+	}
+}
+
+func (e *IDPSecretReencryptedEvent) Data() interface{} {
+	return e
+}
+
+func IDPSecretReencryptedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e, err := idp.SecretReencryptedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IDPSecretReencryptedEvent{SecretReencryptedEvent: *e.(*idp.SecretReencryptedEvent)}, nil
+}
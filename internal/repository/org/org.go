@@ -19,6 +19,9 @@ const (
 	OrgDeactivatedEventType = orgEventTypePrefix + "deactivated"
 	OrgReactivatedEventType = orgEventTypePrefix + "reactivated"
 	OrgRemovedEventType     = orgEventTypePrefix + "removed"
+
+	OrgParentSetEventType     = orgEventTypePrefix + "parent.set"
+	OrgParentRemovedEventType = orgEventTypePrefix + "parent.removed"
 )
 
 func NewAddOrgNameUniqueConstraint(orgName string) *eventstore.EventUniqueConstraint {
@@ -223,3 +226,79 @@ func OrgRemovedEventMapper(event *repository.Event) (eventstore.Event, error) {
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
 	}, nil
 }
+
+// OrgParentSetEvent places the organization below the parent organization in the hierarchy
+type OrgParentSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ParentOrgID string `json:"parentOrgId"`
+}
+
+func (e *OrgParentSetEvent) Data() interface{} {
+	return e
+}
+
+func (e *OrgParentSetEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewOrgParentSetEvent(ctx context.Context, aggregate *eventstore.Aggregate, parentOrgID string) *OrgParentSetEvent {
+	return &OrgParentSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OrgParentSetEventType,
+		),
+		ParentOrgID: parentOrgID,
+	}
+}
+
+func OrgParentSetEventMapper(event *repository.Event) (eventstore.Event, error) {
+	parentSet := &OrgParentSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, parentSet)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "ORG-Ph2ks", "unable to unmarshal org parent set")
+	}
+
+	return parentSet, nil
+}
+
+// OrgParentRemovedEvent makes the organization a root organization of the instance again
+type OrgParentRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ParentOrgID string `json:"parentOrgId"`
+}
+
+func (e *OrgParentRemovedEvent) Data() interface{} {
+	return e
+}
+
+func (e *OrgParentRemovedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewOrgParentRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate, parentOrgID string) *OrgParentRemovedEvent {
+	return &OrgParentRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OrgParentRemovedEventType,
+		),
+		ParentOrgID: parentOrgID,
+	}
+}
+
+func OrgParentRemovedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	parentRemoved := &OrgParentRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, parentRemoved)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "ORG-Ph8vd", "unable to unmarshal org parent removed")
+	}
+
+	return parentRemoved, nil
+}
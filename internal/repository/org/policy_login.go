@@ -40,7 +40,8 @@ func NewLoginPolicyAddedEvent(
 	externalLoginCheckLifetime,
 	mfaInitSkipLifetime,
 	secondFactorCheckLifetime,
-	multiFactorCheckLifetime time.Duration,
+	multiFactorCheckLifetime,
+	trustedDeviceLifetime time.Duration,
 ) *LoginPolicyAddedEvent {
 	return &LoginPolicyAddedEvent{
 		LoginPolicyAddedEvent: *policy.NewLoginPolicyAddedEvent(
@@ -65,6 +66,7 @@ func NewLoginPolicyAddedEvent(
 			mfaInitSkipLifetime,
 			secondFactorCheckLifetime,
 			multiFactorCheckLifetime,
+			trustedDeviceLifetime,
 		),
 	}
 }
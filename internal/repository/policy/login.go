@@ -37,6 +37,7 @@ type LoginPolicyAddedEvent struct {
 	MFAInitSkipLifetime        time.Duration           `json:"mfaInitSkipLifetime,omitempty"`
 	SecondFactorCheckLifetime  time.Duration           `json:"secondFactorCheckLifetime,omitempty"`
 	MultiFactorCheckLifetime   time.Duration           `json:"multiFactorCheckLifetime,omitempty"`
+	TrustedDeviceLifetime      time.Duration           `json:"trustedDeviceLifetime,omitempty"`
 }
 
 func (e *LoginPolicyAddedEvent) Data() interface{} {
@@ -65,7 +66,8 @@ func NewLoginPolicyAddedEvent(
 	externalLoginCheckLifetime,
 	mfaInitSkipLifetime,
 	secondFactorCheckLifetime,
-	multiFactorCheckLifetime time.Duration,
+	multiFactorCheckLifetime,
+	trustedDeviceLifetime time.Duration,
 ) *LoginPolicyAddedEvent {
 	return &LoginPolicyAddedEvent{
 		BaseEvent:                  *base,
@@ -84,6 +86,7 @@ func NewLoginPolicyAddedEvent(
 		MFAInitSkipLifetime:        mfaInitSkipLifetime,
 		SecondFactorCheckLifetime:  secondFactorCheckLifetime,
 		MultiFactorCheckLifetime:   multiFactorCheckLifetime,
+		TrustedDeviceLifetime:      trustedDeviceLifetime,
 		DisableLoginWithEmail:      disableLoginWithEmail,
 		DisableLoginWithPhone:      disableLoginWithPhone,
 	}
@@ -122,6 +125,7 @@ type LoginPolicyChangedEvent struct {
 	MFAInitSkipLifetime        *time.Duration           `json:"mfaInitSkipLifetime,omitempty"`
 	SecondFactorCheckLifetime  *time.Duration           `json:"secondFactorCheckLifetime,omitempty"`
 	MultiFactorCheckLifetime   *time.Duration           `json:"multiFactorCheckLifetime,omitempty"`
+	TrustedDeviceLifetime      *time.Duration           `json:"trustedDeviceLifetime,omitempty"`
 }
 
 func (e *LoginPolicyChangedEvent) Data() interface{} {
@@ -222,6 +226,12 @@ func ChangeMultiFactorCheckLifetime(multiFactorCheckLifetime time.Duration) func
 	}
 }
 
+func ChangeTrustedDeviceLifetime(trustedDeviceLifetime time.Duration) func(*LoginPolicyChangedEvent) {
+	return func(e *LoginPolicyChangedEvent) {
+		e.TrustedDeviceLifetime = &trustedDeviceLifetime
+	}
+}
+
 func ChangeIgnoreUnknownUsernames(ignoreUnknownUsernames bool) func(*LoginPolicyChangedEvent) {
 	return func(e *LoginPolicyChangedEvent) {
 		e.IgnoreUnknownUsernames = &ignoreUnknownUsernames
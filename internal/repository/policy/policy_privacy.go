@@ -18,10 +18,11 @@ const (
 type PrivacyPolicyAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	TOSLink      string              `json:"tosLink,omitempty"`
-	PrivacyLink  string              `json:"privacyLink,omitempty"`
-	HelpLink     string              `json:"helpLink,omitempty"`
-	SupportEmail domain.EmailAddress `json:"supportEmail,omitempty"`
+	TOSLink           string              `json:"tosLink,omitempty"`
+	PrivacyLink       string              `json:"privacyLink,omitempty"`
+	HelpLink          string              `json:"helpLink,omitempty"`
+	SupportEmail      domain.EmailAddress `json:"supportEmail,omitempty"`
+	AllowSelfDeletion bool                `json:"allowSelfDeletion,omitempty"`
 }
 
 func (e *PrivacyPolicyAddedEvent) Data() interface{} {
@@ -38,13 +39,15 @@ func NewPrivacyPolicyAddedEvent(
 	privacyLink,
 	helpLink string,
 	supportEmail domain.EmailAddress,
+	allowSelfDeletion bool,
 ) *PrivacyPolicyAddedEvent {
 	return &PrivacyPolicyAddedEvent{
-		BaseEvent:    *base,
-		TOSLink:      tosLink,
-		PrivacyLink:  privacyLink,
-		HelpLink:     helpLink,
-		SupportEmail: supportEmail,
+		BaseEvent:         *base,
+		TOSLink:           tosLink,
+		PrivacyLink:       privacyLink,
+		HelpLink:          helpLink,
+		SupportEmail:      supportEmail,
+		AllowSelfDeletion: allowSelfDeletion,
 	}
 }
 
@@ -63,10 +66,11 @@ func PrivacyPolicyAddedEventMapper(event *repository.Event) (eventstore.Event, e
 type PrivacyPolicyChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	TOSLink      *string              `json:"tosLink,omitempty"`
-	PrivacyLink  *string              `json:"privacyLink,omitempty"`
-	HelpLink     *string              `json:"helpLink,omitempty"`
-	SupportEmail *domain.EmailAddress `json:"supportEmail,omitempty"`
+	TOSLink           *string              `json:"tosLink,omitempty"`
+	PrivacyLink       *string              `json:"privacyLink,omitempty"`
+	HelpLink          *string              `json:"helpLink,omitempty"`
+	SupportEmail      *domain.EmailAddress `json:"supportEmail,omitempty"`
+	AllowSelfDeletion *bool                `json:"allowSelfDeletion,omitempty"`
 }
 
 func (e *PrivacyPolicyChangedEvent) Data() interface{} {
@@ -119,6 +123,12 @@ func ChangeSupportEmail(supportEmail domain.EmailAddress) func(*PrivacyPolicyCha
 	}
 }
 
+func ChangeAllowSelfDeletion(allowSelfDeletion bool) func(*PrivacyPolicyChangedEvent) {
+	return func(e *PrivacyPolicyChangedEvent) {
+		e.AllowSelfDeletion = &allowSelfDeletion
+	}
+}
+
 func PrivacyPolicyChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
 	e := &PrivacyPolicyChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
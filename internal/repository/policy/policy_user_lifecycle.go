@@ -0,0 +1,148 @@
+package policy
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+const (
+	UserLifecyclePolicyAddedEventType   = "policy.user.lifecycle.added"
+	UserLifecyclePolicyChangedEventType = "policy.user.lifecycle.changed"
+	UserLifecyclePolicyRemovedEventType = "policy.user.lifecycle.removed"
+)
+
+type UserLifecyclePolicyAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	InactivityDeactivation time.Duration `json:"inactivityDeactivation,omitempty"`
+	DeactivatedRemoval     time.Duration `json:"deactivatedRemoval,omitempty"`
+	NotificationPeriod     time.Duration `json:"notificationPeriod,omitempty"`
+}
+
+func (e *UserLifecyclePolicyAddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *UserLifecyclePolicyAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewUserLifecyclePolicyAddedEvent(
+	base *eventstore.BaseEvent,
+	inactivityDeactivation,
+	deactivatedRemoval,
+	notificationPeriod time.Duration,
+) *UserLifecyclePolicyAddedEvent {
+	return &UserLifecyclePolicyAddedEvent{
+		BaseEvent:              *base,
+		InactivityDeactivation: inactivityDeactivation,
+		DeactivatedRemoval:     deactivatedRemoval,
+		NotificationPeriod:     notificationPeriod,
+	}
+}
+
+func UserLifecyclePolicyAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &UserLifecyclePolicyAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "POLIC-Ul3fa", "unable to unmarshal policy")
+	}
+
+	return e, nil
+}
+
+type UserLifecyclePolicyChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	InactivityDeactivation *time.Duration `json:"inactivityDeactivation,omitempty"`
+	DeactivatedRemoval     *time.Duration `json:"deactivatedRemoval,omitempty"`
+	NotificationPeriod     *time.Duration `json:"notificationPeriod,omitempty"`
+}
+
+func (e *UserLifecyclePolicyChangedEvent) Data() interface{} {
+	return e
+}
+
+func (e *UserLifecyclePolicyChangedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewUserLifecyclePolicyChangedEvent(
+	base *eventstore.BaseEvent,
+	changes []UserLifecyclePolicyChanges,
+) (*UserLifecyclePolicyChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, errors.ThrowPreconditionFailed(nil, "POLICY-Lc8qs", "Errors.NoChangesFound")
+	}
+	changeEvent := &UserLifecyclePolicyChangedEvent{
+		BaseEvent: *base,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type UserLifecyclePolicyChanges func(*UserLifecyclePolicyChangedEvent)
+
+func ChangeInactivityDeactivation(inactivityDeactivation time.Duration) func(*UserLifecyclePolicyChangedEvent) {
+	return func(e *UserLifecyclePolicyChangedEvent) {
+		e.InactivityDeactivation = &inactivityDeactivation
+	}
+}
+
+func ChangeDeactivatedRemoval(deactivatedRemoval time.Duration) func(*UserLifecyclePolicyChangedEvent) {
+	return func(e *UserLifecyclePolicyChangedEvent) {
+		e.DeactivatedRemoval = &deactivatedRemoval
+	}
+}
+
+func ChangeNotificationPeriod(notificationPeriod time.Duration) func(*UserLifecyclePolicyChangedEvent) {
+	return func(e *UserLifecyclePolicyChangedEvent) {
+		e.NotificationPeriod = &notificationPeriod
+	}
+}
+
+func UserLifecyclePolicyChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &UserLifecyclePolicyChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "POLIC-Xq2ml", "unable to unmarshal policy")
+	}
+
+	return e, nil
+}
+
+type UserLifecyclePolicyRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *UserLifecyclePolicyRemovedEvent) Data() interface{} {
+	return nil
+}
+
+func (e *UserLifecyclePolicyRemovedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewUserLifecyclePolicyRemovedEvent(base *eventstore.BaseEvent) *UserLifecyclePolicyRemovedEvent {
+	return &UserLifecyclePolicyRemovedEvent{
+		BaseEvent: *base,
+	}
+}
+
+func UserLifecyclePolicyRemovedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	return &UserLifecyclePolicyRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -0,0 +1,197 @@
+package project
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+var (
+	UniqueAuthorizationDetailTypeType      = "project_authorization_detail_type"
+	authorizationDetailTypeEventTypePrefix = projectEventTypePrefix + "authorization_detail_type."
+	AuthorizationDetailTypeAddedType       = authorizationDetailTypeEventTypePrefix + "added"
+	AuthorizationDetailTypeChangedType     = authorizationDetailTypeEventTypePrefix + "changed"
+	AuthorizationDetailTypeRemovedType     = authorizationDetailTypeEventTypePrefix + "removed"
+)
+
+func NewAddProjectAuthorizationDetailTypeUniqueConstraint(detailType, projectID string) *eventstore.EventUniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		UniqueAuthorizationDetailTypeType,
+		fmt.Sprintf("%s:%s", detailType, projectID),
+		"Errors.Project.AuthorizationDetailType.AlreadyExists")
+}
+
+func NewRemoveProjectAuthorizationDetailTypeUniqueConstraint(detailType, projectID string) *eventstore.EventUniqueConstraint {
+	return eventstore.NewRemoveEventUniqueConstraint(
+		UniqueAuthorizationDetailTypeType,
+		fmt.Sprintf("%s:%s", detailType, projectID))
+}
+
+type AuthorizationDetailTypeAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DetailType  string `json:"type,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+	Description string `json:"description,omitempty"`
+	Schema      string `json:"schema,omitempty"`
+}
+
+func (e *AuthorizationDetailTypeAddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *AuthorizationDetailTypeAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{NewAddProjectAuthorizationDetailTypeUniqueConstraint(e.DetailType, e.Aggregate().ID)}
+}
+
+func NewAuthorizationDetailTypeAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	detailType,
+	displayName,
+	description,
+	schema string,
+) *AuthorizationDetailTypeAddedEvent {
+	return &AuthorizationDetailTypeAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AuthorizationDetailTypeAddedType,
+		),
+		DetailType:  detailType,
+		DisplayName: displayName,
+		Description: description,
+		Schema:      schema,
+	}
+}
+
+func AuthorizationDetailTypeAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &AuthorizationDetailTypeAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "PROJECT-Ad2ka", "unable to unmarshal project authorization detail type")
+	}
+
+	return e, nil
+}
+
+type AuthorizationDetailTypeChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DetailType  string  `json:"type,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Schema      *string `json:"schema,omitempty"`
+}
+
+func (e *AuthorizationDetailTypeChangedEvent) Data() interface{} {
+	return e
+}
+
+func (e *AuthorizationDetailTypeChangedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewAuthorizationDetailTypeChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	detailType string,
+	changes []AuthorizationDetailTypeChanges,
+) (*AuthorizationDetailTypeChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, errors.ThrowPreconditionFailed(nil, "PROJECT-Ad8vn", "Errors.NoChangesFound")
+	}
+	changeEvent := &AuthorizationDetailTypeChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AuthorizationDetailTypeChangedType,
+		),
+		DetailType: detailType,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type AuthorizationDetailTypeChanges func(event *AuthorizationDetailTypeChangedEvent)
+
+func ChangeAuthorizationDetailTypeDisplayName(displayName string) func(event *AuthorizationDetailTypeChangedEvent) {
+	return func(e *AuthorizationDetailTypeChangedEvent) {
+		e.DisplayName = &displayName
+	}
+}
+
+func ChangeAuthorizationDetailTypeDescription(description string) func(event *AuthorizationDetailTypeChangedEvent) {
+	return func(e *AuthorizationDetailTypeChangedEvent) {
+		e.Description = &description
+	}
+}
+
+func ChangeAuthorizationDetailTypeSchema(schema string) func(event *AuthorizationDetailTypeChangedEvent) {
+	return func(e *AuthorizationDetailTypeChangedEvent) {
+		e.Schema = &schema
+	}
+}
+
+func AuthorizationDetailTypeChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &AuthorizationDetailTypeChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "PROJECT-Ad4wq", "unable to unmarshal project authorization detail type")
+	}
+
+	return e, nil
+}
+
+type AuthorizationDetailTypeRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DetailType string `json:"type,omitempty"`
+}
+
+func (e *AuthorizationDetailTypeRemovedEvent) Data() interface{} {
+	return e
+}
+
+func (e *AuthorizationDetailTypeRemovedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{NewRemoveProjectAuthorizationDetailTypeUniqueConstraint(e.DetailType, e.Aggregate().ID)}
+}
+
+func NewAuthorizationDetailTypeRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	detailType string) *AuthorizationDetailTypeRemovedEvent {
+	return &AuthorizationDetailTypeRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AuthorizationDetailTypeRemovedType,
+		),
+		DetailType: detailType,
+	}
+}
+
+func AuthorizationDetailTypeRemovedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &AuthorizationDetailTypeRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "PROJECT-Ad6jd", "unable to unmarshal project authorization detail type")
+	}
+
+	return e, nil
+}
@@ -17,6 +17,12 @@ func RegisterEventMappers(es *eventstore.Eventstore) {
 		RegisterFilterEventMapper(AggregateType, RoleAddedType, RoleAddedEventMapper).
 		RegisterFilterEventMapper(AggregateType, RoleChangedType, RoleChangedEventMapper).
 		RegisterFilterEventMapper(AggregateType, RoleRemovedType, RoleRemovedEventMapper).
+		RegisterFilterEventMapper(AggregateType, ScopeAddedType, ScopeAddedEventMapper).
+		RegisterFilterEventMapper(AggregateType, ScopeChangedType, ScopeChangedEventMapper).
+		RegisterFilterEventMapper(AggregateType, ScopeRemovedType, ScopeRemovedEventMapper).
+		RegisterFilterEventMapper(AggregateType, AuthorizationDetailTypeAddedType, AuthorizationDetailTypeAddedEventMapper).
+		RegisterFilterEventMapper(AggregateType, AuthorizationDetailTypeChangedType, AuthorizationDetailTypeChangedEventMapper).
+		RegisterFilterEventMapper(AggregateType, AuthorizationDetailTypeRemovedType, AuthorizationDetailTypeRemovedEventMapper).
 		RegisterFilterEventMapper(AggregateType, GrantAddedType, GrantAddedEventMapper).
 		RegisterFilterEventMapper(AggregateType, GrantChangedType, GrantChangedEventMapper).
 		RegisterFilterEventMapper(AggregateType, GrantCascadeChangedType, GrantCascadeChangedEventMapper).
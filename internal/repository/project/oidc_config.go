@@ -41,6 +41,11 @@ type OIDCConfigAddedEvent struct {
 	ClockSkew                time.Duration              `json:"clockSkew,omitempty"`
 	AdditionalOrigins        []string                   `json:"additionalOrigins,omitempty"`
 	SkipNativeAppSuccessPage bool                       `json:"skipNativeAppSuccessPage,omitempty"`
+	IDTokenSigningAlgorithm  string                     `json:"idTokenSigningAlgorithm,omitempty"`
+	ConsentRequired          bool                       `json:"consentRequired,omitempty"`
+
+	BackchannelTokenDeliveryMode          domain.OIDCBackchannelTokenDeliveryMode `json:"backchannelTokenDeliveryMode,omitempty"`
+	BackchannelClientNotificationEndpoint string                                  `json:"backchannelClientNotificationEndpoint,omitempty"`
 }
 
 func (e *OIDCConfigAddedEvent) Data() interface{} {
@@ -72,6 +77,10 @@ func NewOIDCConfigAddedEvent(
 	clockSkew time.Duration,
 	additionalOrigins []string,
 	skipNativeAppSuccessPage bool,
+	idTokenSigningAlgorithm string,
+	consentRequired bool,
+	backchannelTokenDeliveryMode domain.OIDCBackchannelTokenDeliveryMode,
+	backchannelClientNotificationEndpoint string,
 ) *OIDCConfigAddedEvent {
 	return &OIDCConfigAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -97,6 +106,11 @@ func NewOIDCConfigAddedEvent(
 		ClockSkew:                clockSkew,
 		AdditionalOrigins:        additionalOrigins,
 		SkipNativeAppSuccessPage: skipNativeAppSuccessPage,
+		IDTokenSigningAlgorithm:  idTokenSigningAlgorithm,
+		ConsentRequired:          consentRequired,
+
+		BackchannelTokenDeliveryMode:          backchannelTokenDeliveryMode,
+		BackchannelClientNotificationEndpoint: backchannelClientNotificationEndpoint,
 	}
 }
 
@@ -182,7 +196,19 @@ func (e *OIDCConfigAddedEvent) Validate(cmd eventstore.Command) bool {
 			return false
 		}
 	}
-	return e.SkipNativeAppSuccessPage == c.SkipNativeAppSuccessPage
+	if e.SkipNativeAppSuccessPage != c.SkipNativeAppSuccessPage {
+		return false
+	}
+	if e.ConsentRequired != c.ConsentRequired {
+		return false
+	}
+	if e.BackchannelTokenDeliveryMode != c.BackchannelTokenDeliveryMode {
+		return false
+	}
+	if e.BackchannelClientNotificationEndpoint != c.BackchannelClientNotificationEndpoint {
+		return false
+	}
+	return e.IDTokenSigningAlgorithm == c.IDTokenSigningAlgorithm
 }
 
 func OIDCConfigAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
@@ -217,6 +243,11 @@ type OIDCConfigChangedEvent struct {
 	ClockSkew                *time.Duration              `json:"clockSkew,omitempty"`
 	AdditionalOrigins        *[]string                   `json:"additionalOrigins,omitempty"`
 	SkipNativeAppSuccessPage *bool                       `json:"skipNativeAppSuccessPage,omitempty"`
+	IDTokenSigningAlgorithm  *string                     `json:"idTokenSigningAlgorithm,omitempty"`
+	ConsentRequired          *bool                       `json:"consentRequired,omitempty"`
+
+	BackchannelTokenDeliveryMode          *domain.OIDCBackchannelTokenDeliveryMode `json:"backchannelTokenDeliveryMode,omitempty"`
+	BackchannelClientNotificationEndpoint *string                                  `json:"backchannelClientNotificationEndpoint,omitempty"`
 }
 
 func (e *OIDCConfigChangedEvent) Data() interface{} {
@@ -343,6 +374,30 @@ func ChangeSkipNativeAppSuccessPage(skipNativeAppSuccessPage bool) func(event *O
 	}
 }
 
+func ChangeIDTokenSigningAlgorithm(idTokenSigningAlgorithm string) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.IDTokenSigningAlgorithm = &idTokenSigningAlgorithm
+	}
+}
+
+func ChangeConsentRequired(consentRequired bool) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.ConsentRequired = &consentRequired
+	}
+}
+
+func ChangeBackchannelTokenDeliveryMode(deliveryMode domain.OIDCBackchannelTokenDeliveryMode) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.BackchannelTokenDeliveryMode = &deliveryMode
+	}
+}
+
+func ChangeBackchannelClientNotificationEndpoint(endpoint string) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.BackchannelClientNotificationEndpoint = &endpoint
+	}
+}
+
 func OIDCConfigChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
 	e := &OIDCConfigChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
@@ -0,0 +1,187 @@
+package project
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+var (
+	UniqueScopeType      = "project_scope"
+	scopeEventTypePrefix = projectEventTypePrefix + "scope."
+	ScopeAddedType       = scopeEventTypePrefix + "added"
+	ScopeChangedType     = scopeEventTypePrefix + "changed"
+	ScopeRemovedType     = scopeEventTypePrefix + "removed"
+)
+
+func NewAddProjectScopeUniqueConstraint(scopeKey, projectID string) *eventstore.EventUniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		UniqueScopeType,
+		fmt.Sprintf("%s:%s", scopeKey, projectID),
+		"Errors.Project.Scope.AlreadyExists")
+}
+
+func NewRemoveProjectScopeUniqueConstraint(scopeKey, projectID string) *eventstore.EventUniqueConstraint {
+	return eventstore.NewRemoveEventUniqueConstraint(
+		UniqueScopeType,
+		fmt.Sprintf("%s:%s", scopeKey, projectID))
+}
+
+type ScopeAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Key         string `json:"key,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+func (e *ScopeAddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *ScopeAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{NewAddProjectScopeUniqueConstraint(e.Key, e.Aggregate().ID)}
+}
+
+func NewScopeAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	key,
+	displayName,
+	description string,
+) *ScopeAddedEvent {
+	return &ScopeAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ScopeAddedType,
+		),
+		Key:         key,
+		DisplayName: displayName,
+		Description: description,
+	}
+}
+
+func ScopeAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &ScopeAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "PROJECT-Sc2ka", "unable to unmarshal project scope")
+	}
+
+	return e, nil
+}
+
+type ScopeChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Key         string  `json:"key,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+func (e *ScopeChangedEvent) Data() interface{} {
+	return e
+}
+
+func (e *ScopeChangedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewScopeChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	key string,
+	changes []ScopeChanges,
+) (*ScopeChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, errors.ThrowPreconditionFailed(nil, "PROJECT-Sc8vn", "Errors.NoChangesFound")
+	}
+	changeEvent := &ScopeChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ScopeChangedType,
+		),
+		Key: key,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type ScopeChanges func(event *ScopeChangedEvent)
+
+func ChangeScopeDisplayName(displayName string) func(event *ScopeChangedEvent) {
+	return func(e *ScopeChangedEvent) {
+		e.DisplayName = &displayName
+	}
+}
+
+func ChangeScopeDescription(description string) func(event *ScopeChangedEvent) {
+	return func(e *ScopeChangedEvent) {
+		e.Description = &description
+	}
+}
+
+func ScopeChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &ScopeChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "PROJECT-Sc4wq", "unable to unmarshal project scope")
+	}
+
+	return e, nil
+}
+
+type ScopeRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Key string `json:"key,omitempty"`
+}
+
+func (e *ScopeRemovedEvent) Data() interface{} {
+	return e
+}
+
+func (e *ScopeRemovedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{NewRemoveProjectScopeUniqueConstraint(e.Key, e.Aggregate().ID)}
+}
+
+func NewScopeRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	key string) *ScopeRemovedEvent {
+	return &ScopeRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ScopeRemovedType,
+		),
+		Key: key,
+	}
+}
+
+func ScopeRemovedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &ScopeRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "PROJECT-Sc6jd", "unable to unmarshal project scope")
+	}
+
+	return e, nil
+}
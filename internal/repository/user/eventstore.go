@@ -87,6 +87,7 @@ func RegisterEventMappers(es *eventstore.Eventstore) {
 		RegisterFilterEventMapper(AggregateType, HumanMFAOTPAddedType, HumanOTPAddedEventMapper).
 		RegisterFilterEventMapper(AggregateType, HumanMFAOTPVerifiedType, HumanOTPVerifiedEventMapper).
 		RegisterFilterEventMapper(AggregateType, HumanMFAOTPRemovedType, HumanOTPRemovedEventMapper).
+		RegisterFilterEventMapper(AggregateType, HumanMFAOTPSecretReencryptedType, HumanOTPSecretReencryptedEventMapper).
 		RegisterFilterEventMapper(AggregateType, HumanMFAOTPCheckSucceededType, HumanOTPCheckSucceededEventMapper).
 		RegisterFilterEventMapper(AggregateType, HumanMFAOTPCheckFailedType, HumanOTPCheckFailedEventMapper).
 		RegisterFilterEventMapper(AggregateType, HumanOTPSMSAddedType, eventstore.GenericEventMapper[HumanOTPSMSAddedEvent]).
@@ -116,6 +117,15 @@ func RegisterEventMappers(es *eventstore.Eventstore) {
 		RegisterFilterEventMapper(AggregateType, HumanPasswordlessInitCodeSentType, HumanPasswordlessInitCodeSentEventMapper).
 		RegisterFilterEventMapper(AggregateType, HumanPasswordlessInitCodeCheckFailedType, HumanPasswordlessInitCodeCodeCheckFailedEventMapper).
 		RegisterFilterEventMapper(AggregateType, HumanPasswordlessInitCodeCheckSucceededType, HumanPasswordlessInitCodeCodeCheckSucceededEventMapper).
+		RegisterFilterEventMapper(AggregateType, HumanDeviceTrustedType, HumanDeviceTrustedEventMapper).
+		RegisterFilterEventMapper(AggregateType, HumanDeviceTrustedSentType, HumanDeviceTrustedSentEventMapper).
+		RegisterFilterEventMapper(AggregateType, HumanDeviceTrustRevokedType, HumanDeviceTrustRevokedEventMapper).
+		RegisterFilterEventMapper(AggregateType, HumanConsentGrantedType, HumanConsentGrantedEventMapper).
+		RegisterFilterEventMapper(AggregateType, HumanConsentRevokedType, HumanConsentRevokedEventMapper).
+		RegisterFilterEventMapper(AggregateType, HumanSelfDeletionRequestedType, HumanSelfDeletionRequestedEventMapper).
+		RegisterFilterEventMapper(AggregateType, HumanSelfDeletionCanceledType, HumanSelfDeletionCanceledEventMapper).
+		RegisterFilterEventMapper(AggregateType, HumanLifecycleNotificationAddedType, HumanLifecycleNotificationAddedEventMapper).
+		RegisterFilterEventMapper(AggregateType, HumanLifecycleNotificationSentType, HumanLifecycleNotificationSentEventMapper).
 		RegisterFilterEventMapper(AggregateType, HumanRefreshTokenAddedType, HumanRefreshTokenAddedEventMapper).
 		RegisterFilterEventMapper(AggregateType, HumanRefreshTokenRenewedType, HumanRefreshTokenRenewedEventEventMapper).
 		RegisterFilterEventMapper(AggregateType, HumanRefreshTokenRemovedType, HumanRefreshTokenRemovedEventEventMapper).
@@ -129,4 +139,24 @@ func RegisterEventMappers(es *eventstore.Eventstore) {
 		RegisterFilterEventMapper(AggregateType, MachineSecretRemovedType, MachineSecretRemovedEventMapper).
 		RegisterFilterEventMapper(AggregateType, MachineSecretCheckSucceededType, MachineSecretCheckSucceededEventMapper).
 		RegisterFilterEventMapper(AggregateType, MachineSecretCheckFailedType, MachineSecretCheckFailedEventMapper)
+
+	registerPersonalData(es)
+}
+
+// registerPersonalData registers the fields of the user events containing personal data,
+// which are encrypted with the key of the user and become unreadable as soon as the user is removed.
+// The user name remains in plain text, because it must be unique
+func registerPersonalData(es *eventstore.Eventstore) {
+	profile := []string{"firstName", "lastName", "nickName", "displayName"}
+	address := []string{"country", "locality", "postalCode", "region", "streetAddress"}
+	human := append(append(append([]string{}, profile...), "email", "phone"), address...)
+
+	es.RegisterPersonalData(HumanAddedType, human...).
+		RegisterPersonalData(HumanRegisteredType, human...).
+		RegisterPersonalData(HumanProfileChangedType, profile...).
+		RegisterPersonalData(HumanEmailChangedType, "email").
+		RegisterPersonalData(HumanPhoneChangedType, "phone").
+		RegisterPersonalData(HumanAddressChangedType, address...).
+		RegisterPersonalData(UserIDPLinkAddedType, "displayName").
+		RegisterPersonalDataErasure(UserRemovedType)
 }
@@ -0,0 +1,100 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+const (
+	consentEventPrefix      = humanEventPrefix + "consent."
+	HumanConsentGrantedType = consentEventPrefix + "granted"
+	HumanConsentRevokedType = consentEventPrefix + "revoked"
+)
+
+// HumanConsentGrantedEvent contains all scopes the user consented to for the client,
+// including the ones granted previously
+type HumanConsentGrantedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ClientID  string   `json:"clientID"`
+	ProjectID string   `json:"projectID,omitempty"`
+	Scopes    []string `json:"scopes,omitempty"`
+}
+
+func (e *HumanConsentGrantedEvent) Data() interface{} {
+	return e
+}
+
+func (e *HumanConsentGrantedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanConsentGrantedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	clientID,
+	projectID string,
+	scopes []string,
+) *HumanConsentGrantedEvent {
+	return &HumanConsentGrantedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanConsentGrantedType,
+		),
+		ClientID:  clientID,
+		ProjectID: projectID,
+		Scopes:    scopes,
+	}
+}
+
+func HumanConsentGrantedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	granted := &HumanConsentGrantedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, granted)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "USER-Cn3gq", "unable to unmarshal human consent granted")
+	}
+	return granted, nil
+}
+
+type HumanConsentRevokedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ClientID string `json:"clientID"`
+}
+
+func (e *HumanConsentRevokedEvent) Data() interface{} {
+	return e
+}
+
+func (e *HumanConsentRevokedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanConsentRevokedEvent(ctx context.Context, aggregate *eventstore.Aggregate, clientID string) *HumanConsentRevokedEvent {
+	return &HumanConsentRevokedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanConsentRevokedType,
+		),
+		ClientID: clientID,
+	}
+}
+
+func HumanConsentRevokedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	revoked := &HumanConsentRevokedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, revoked)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "USER-Cn8rw", "unable to unmarshal human consent revoked")
+	}
+	return revoked, nil
+}
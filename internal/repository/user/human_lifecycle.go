@@ -0,0 +1,101 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+const (
+	lifecycleEventPrefix                = humanEventPrefix + "lifecycle."
+	HumanLifecycleNotificationAddedType = lifecycleEventPrefix + "notification.added"
+	HumanLifecycleNotificationSentType  = lifecycleEventPrefix + "notification.sent"
+)
+
+type HumanLifecycleNotificationAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Action      domain.UserLifecycleAction `json:"action,omitempty"`
+	ScheduledAt time.Time                  `json:"scheduledAt"`
+}
+
+func (e *HumanLifecycleNotificationAddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *HumanLifecycleNotificationAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanLifecycleNotificationAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	action domain.UserLifecycleAction,
+	scheduledAt time.Time,
+) *HumanLifecycleNotificationAddedEvent {
+	return &HumanLifecycleNotificationAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanLifecycleNotificationAddedType,
+		),
+		Action:      action,
+		ScheduledAt: scheduledAt,
+	}
+}
+
+func HumanLifecycleNotificationAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	added := &HumanLifecycleNotificationAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, added)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "USER-Lc8fa", "unable to unmarshal human lifecycle notification added")
+	}
+	return added, nil
+}
+
+type HumanLifecycleNotificationSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Action domain.UserLifecycleAction `json:"action,omitempty"`
+}
+
+func (e *HumanLifecycleNotificationSentEvent) Data() interface{} {
+	return e
+}
+
+func (e *HumanLifecycleNotificationSentEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanLifecycleNotificationSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	action domain.UserLifecycleAction,
+) *HumanLifecycleNotificationSentEvent {
+	return &HumanLifecycleNotificationSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanLifecycleNotificationSentType,
+		),
+		Action: action,
+	}
+}
+
+func HumanLifecycleNotificationSentEventMapper(event *repository.Event) (eventstore.Event, error) {
+	sent := &HumanLifecycleNotificationSentEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, sent)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "USER-Lc9sq", "unable to unmarshal human lifecycle notification sent")
+	}
+	return sent, nil
+}
@@ -3,6 +3,8 @@ package user
 import (
 	"context"
 	"encoding/json"
+	"strconv"
+	"strings"
 
 	"github.com/zitadel/zitadel/internal/eventstore"
 
@@ -12,22 +14,25 @@ import (
 )
 
 const (
-	otpEventPrefix                  = mfaEventPrefix + "otp."
-	HumanMFAOTPAddedType            = otpEventPrefix + "added"
-	HumanMFAOTPVerifiedType         = otpEventPrefix + "verified"
-	HumanMFAOTPRemovedType          = otpEventPrefix + "removed"
-	HumanMFAOTPCheckSucceededType   = otpEventPrefix + "check.succeeded"
-	HumanMFAOTPCheckFailedType      = otpEventPrefix + "check.failed"
-	otpSMSEventPrefix               = otpEventPrefix + "sms."
-	HumanOTPSMSAddedType            = otpSMSEventPrefix + "added"
-	HumanOTPSMSRemovedType          = otpSMSEventPrefix + "removed"
-	HumanOTPSMSCheckSucceededType   = otpSMSEventPrefix + "check.succeeded"
-	HumanOTPSMSCheckFailedType      = otpSMSEventPrefix + "check.failed"
-	otpEmailEventPrefix             = otpEventPrefix + "email."
-	HumanOTPEmailAddedType          = otpEmailEventPrefix + "added"
-	HumanOTPEmailRemovedType        = otpEmailEventPrefix + "removed"
-	HumanOTPEmailCheckSucceededType = otpEmailEventPrefix + "check.succeeded"
-	HumanOTPEmailCheckFailedType    = otpEmailEventPrefix + "check.failed"
+	otpEventPrefix                   = mfaEventPrefix + "otp."
+	HumanMFAOTPAddedType             = otpEventPrefix + "added"
+	HumanMFAOTPVerifiedType          = otpEventPrefix + "verified"
+	HumanMFAOTPRemovedType           = otpEventPrefix + "removed"
+	HumanMFAOTPSecretReencryptedType = otpEventPrefix + "secret.reencrypted"
+	HumanMFAOTPCheckSucceededType    = otpEventPrefix + "check.succeeded"
+	HumanMFAOTPCheckFailedType       = otpEventPrefix + "check.failed"
+	otpSMSEventPrefix                = otpEventPrefix + "sms."
+	HumanOTPSMSAddedType             = otpSMSEventPrefix + "added"
+	HumanOTPSMSRemovedType           = otpSMSEventPrefix + "removed"
+	HumanOTPSMSCheckSucceededType    = otpSMSEventPrefix + "check.succeeded"
+	HumanOTPSMSCheckFailedType       = otpSMSEventPrefix + "check.failed"
+	otpEmailEventPrefix              = otpEventPrefix + "email."
+	HumanOTPEmailAddedType           = otpEmailEventPrefix + "added"
+	HumanOTPEmailRemovedType         = otpEmailEventPrefix + "removed"
+	HumanOTPEmailCheckSucceededType  = otpEmailEventPrefix + "check.succeeded"
+	HumanOTPEmailCheckFailedType     = otpEmailEventPrefix + "check.failed"
+
+	uniqueOTPSecretReencryption = "otp_secret_reencryption"
 )
 
 type HumanOTPAddedEvent struct {
@@ -70,6 +75,64 @@ func HumanOTPAddedEventMapper(event *repository.Event) (eventstore.Event, error)
 	return otpAdded, nil
 }
 
+// HumanOTPSecretReencryptedEvent replaces the OTP secret
+// with the same secret encrypted by the current encryption key
+type HumanOTPSecretReencryptedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Secret *crypto.CryptoValue `json:"otpSecret,omitempty"`
+
+	replacedSequence uint64
+}
+
+func (e *HumanOTPSecretReencryptedEvent) Data() interface{} {
+	return e
+}
+
+// UniqueConstraints prevents that the same secret is re-encrypted multiple times,
+// e.g. by concurrent re-encryptions
+func (e *HumanOTPSecretReencryptedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{NewAddOTPSecretReencryptionUniqueConstraint(e.Aggregate().ID, e.replacedSequence)}
+}
+
+// NewAddOTPSecretReencryptionUniqueConstraint identifies the value of the secret by the sequence of the event which set it
+func NewAddOTPSecretReencryptionUniqueConstraint(userID string, replacedSequence uint64) *eventstore.EventUniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		uniqueOTPSecretReencryption,
+		strings.Join([]string{userID, strconv.FormatUint(replacedSequence, 10)}, ":"),
+		"Errors.SecretReencryption.AlreadyReencrypted",
+	)
+}
+
+// NewHumanOTPSecretReencryptedEvent replaces the secret set by the event with the replacedSequence
+func NewHumanOTPSecretReencryptedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	secret *crypto.CryptoValue,
+	replacedSequence uint64,
+) *HumanOTPSecretReencryptedEvent {
+	return &HumanOTPSecretReencryptedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanMFAOTPSecretReencryptedType,
+		),
+		Secret:           secret,
+		replacedSequence: replacedSequence,
+	}
+}
+
+func HumanOTPSecretReencryptedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	otpReencrypted := &HumanOTPSecretReencryptedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, otpReencrypted)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "USER-Re3nc", "unable to unmarshal human otp secret reencrypted")
+	}
+	return otpReencrypted, nil
+}
+
 type HumanOTPVerifiedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 	UserAgentID          string `json:"userAgentID,omitempty"`
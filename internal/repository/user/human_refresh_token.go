@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 
 	"github.com/zitadel/zitadel/internal/errors"
@@ -31,6 +32,10 @@ type HumanRefreshTokenAddedEvent struct {
 	IdleExpiration        time.Duration `json:"idleExpiration"`
 	Expiration            time.Duration `json:"expiration"`
 	PreferredLanguage     string        `json:"preferredLanguage"`
+	// AuthorizationDetails (RFC 9396) are granted again to the access tokens issued by the refresh token
+	AuthorizationDetails domain.AuthorizationDetails `json:"authorizationDetails,omitempty"`
+	// LevelOfAssurance reached by the authentication is kept for the access tokens issued by the refresh token
+	LevelOfAssurance domain.LevelOfAssurance `json:"levelOfAssurance,omitempty"`
 }
 
 func (e *HumanRefreshTokenAddedEvent) Data() interface{} {
@@ -55,6 +60,8 @@ func NewHumanRefreshTokenAddedEvent(
 	audience,
 	scopes,
 	authMethodsReferences []string,
+	authorizationDetails domain.AuthorizationDetails,
+	levelOfAssurance domain.LevelOfAssurance,
 	authTime time.Time,
 	idleExpiration,
 	expiration time.Duration,
@@ -75,6 +82,8 @@ func NewHumanRefreshTokenAddedEvent(
 		IdleExpiration:        idleExpiration,
 		Expiration:            expiration,
 		PreferredLanguage:     preferredLanguage,
+		AuthorizationDetails:  authorizationDetails,
+		LevelOfAssurance:      levelOfAssurance,
 	}
 }
 
@@ -0,0 +1,88 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+const (
+	selfDeletionEventPrefix        = humanEventPrefix + "deletion."
+	HumanSelfDeletionRequestedType = selfDeletionEventPrefix + "requested"
+	HumanSelfDeletionCanceledType  = selfDeletionEventPrefix + "canceled"
+)
+
+type HumanSelfDeletionRequestedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ScheduledAt time.Time `json:"scheduledAt"`
+}
+
+func (e *HumanSelfDeletionRequestedEvent) Data() interface{} {
+	return e
+}
+
+func (e *HumanSelfDeletionRequestedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanSelfDeletionRequestedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	scheduledAt time.Time,
+) *HumanSelfDeletionRequestedEvent {
+	return &HumanSelfDeletionRequestedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanSelfDeletionRequestedType,
+		),
+		ScheduledAt: scheduledAt,
+	}
+}
+
+func HumanSelfDeletionRequestedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	requested := &HumanSelfDeletionRequestedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, requested)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "USER-Sd8qf", "unable to unmarshal human self deletion requested")
+	}
+	return requested, nil
+}
+
+type HumanSelfDeletionCanceledEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *HumanSelfDeletionCanceledEvent) Data() interface{} {
+	return nil
+}
+
+func (e *HumanSelfDeletionCanceledEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanSelfDeletionCanceledEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *HumanSelfDeletionCanceledEvent {
+	return &HumanSelfDeletionCanceledEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanSelfDeletionCanceledType,
+		),
+	}
+}
+
+func HumanSelfDeletionCanceledEventMapper(event *repository.Event) (eventstore.Event, error) {
+	return &HumanSelfDeletionCanceledEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -0,0 +1,139 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+const (
+	trustedDeviceEventPrefix    = humanEventPrefix + "device.trust."
+	HumanDeviceTrustedType      = trustedDeviceEventPrefix + "added"
+	HumanDeviceTrustedSentType  = trustedDeviceEventPrefix + "notification.sent"
+	HumanDeviceTrustRevokedType = trustedDeviceEventPrefix + "revoked"
+)
+
+type HumanDeviceTrustedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserAgentID string    `json:"userAgentID"`
+	Name        string    `json:"name,omitempty"`
+	RemoteIP    string    `json:"remoteIP,omitempty"`
+	Expiration  time.Time `json:"expiration"`
+}
+
+func (e *HumanDeviceTrustedEvent) Data() interface{} {
+	return e
+}
+
+func (e *HumanDeviceTrustedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanDeviceTrustedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userAgentID,
+	name,
+	remoteIP string,
+	expiration time.Time,
+) *HumanDeviceTrustedEvent {
+	return &HumanDeviceTrustedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanDeviceTrustedType,
+		),
+		UserAgentID: userAgentID,
+		Name:        name,
+		RemoteIP:    remoteIP,
+		Expiration:  expiration,
+	}
+}
+
+func HumanDeviceTrustedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	trusted := &HumanDeviceTrustedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, trusted)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "USER-Dg3qk", "unable to unmarshal human device trusted")
+	}
+	return trusted, nil
+}
+
+type HumanDeviceTrustedSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserAgentID string `json:"userAgentID"`
+}
+
+func (e *HumanDeviceTrustedSentEvent) Data() interface{} {
+	return e
+}
+
+func (e *HumanDeviceTrustedSentEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanDeviceTrustedSentEvent(ctx context.Context, aggregate *eventstore.Aggregate, userAgentID string) *HumanDeviceTrustedSentEvent {
+	return &HumanDeviceTrustedSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanDeviceTrustedSentType,
+		),
+		UserAgentID: userAgentID,
+	}
+}
+
+func HumanDeviceTrustedSentEventMapper(event *repository.Event) (eventstore.Event, error) {
+	sent := &HumanDeviceTrustedSentEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, sent)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "USER-Wf2ql", "unable to unmarshal human device trusted sent")
+	}
+	return sent, nil
+}
+
+type HumanDeviceTrustRevokedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserAgentID string `json:"userAgentID"`
+}
+
+func (e *HumanDeviceTrustRevokedEvent) Data() interface{} {
+	return e
+}
+
+func (e *HumanDeviceTrustRevokedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanDeviceTrustRevokedEvent(ctx context.Context, aggregate *eventstore.Aggregate, userAgentID string) *HumanDeviceTrustRevokedEvent {
+	return &HumanDeviceTrustRevokedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanDeviceTrustRevokedType,
+		),
+		UserAgentID: userAgentID,
+	}
+}
+
+func HumanDeviceTrustRevokedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	revoked := &HumanDeviceTrustRevokedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, revoked)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "USER-Hk3dq", "unable to unmarshal human device trust revoked")
+	}
+	return revoked, nil
+}
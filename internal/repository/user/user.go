@@ -219,6 +219,9 @@ type UserTokenAddedEvent struct {
 	Scopes            []string  `json:"scopes"`
 	Expiration        time.Time `json:"expiration"`
 	PreferredLanguage string    `json:"preferredLanguage"`
+
+	AuthorizationDetails domain.AuthorizationDetails `json:"authorizationDetails,omitempty"`
+	LevelOfAssurance     domain.LevelOfAssurance     `json:"levelOfAssurance,omitempty"`
 }
 
 func (e *UserTokenAddedEvent) Data() interface{} {
@@ -239,6 +242,8 @@ func NewUserTokenAddedEvent(
 	refreshTokenID string,
 	audience,
 	scopes []string,
+	authorizationDetails domain.AuthorizationDetails,
+	levelOfAssurance domain.LevelOfAssurance,
 	expiration time.Time,
 ) *UserTokenAddedEvent {
 	return &UserTokenAddedEvent{
@@ -255,6 +260,9 @@ func NewUserTokenAddedEvent(
 		Scopes:            scopes,
 		Expiration:        expiration,
 		PreferredLanguage: preferredLanguage,
+
+		AuthorizationDetails: authorizationDetails,
+		LevelOfAssurance:     levelOfAssurance,
 	}
 }
 
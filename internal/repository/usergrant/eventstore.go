@@ -11,5 +11,10 @@ func RegisterEventMappers(es *eventstore.Eventstore) {
 		RegisterFilterEventMapper(AggregateType, UserGrantRemovedType, UserGrantRemovedEventMapper).
 		RegisterFilterEventMapper(AggregateType, UserGrantCascadeRemovedType, UserGrantCascadeRemovedEventMapper).
 		RegisterFilterEventMapper(AggregateType, UserGrantDeactivatedType, UserGrantDeactivatedEventMapper).
-		RegisterFilterEventMapper(AggregateType, UserGrantReactivatedType, UserGrantReactivatedEventMapper)
+		RegisterFilterEventMapper(AggregateType, UserGrantReactivatedType, UserGrantReactivatedEventMapper).
+		RegisterFilterEventMapper(AggregateType, UserGrantValiditySetType, UserGrantValiditySetEventMapper).
+		RegisterFilterEventMapper(AggregateType, UserGrantRequestedType, UserGrantRequestedEventMapper).
+		RegisterFilterEventMapper(AggregateType, UserGrantRequestApprovedType, UserGrantRequestApprovedEventMapper).
+		RegisterFilterEventMapper(AggregateType, UserGrantRequestDeniedType, UserGrantRequestDeniedEventMapper).
+		RegisterFilterEventMapper(AggregateType, UserGrantRequestNotificationSentType, UserGrantRequestNotificationSentEventMapper)
 }
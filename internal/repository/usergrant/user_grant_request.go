@@ -0,0 +1,240 @@
+package usergrant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+const (
+	UniqueUserGrantRequest               = "user_grant_request"
+	userGrantRequestEventTypePrefix      = userGrantEventTypePrefix + "request."
+	UserGrantRequestedType               = userGrantEventTypePrefix + "requested"
+	UserGrantRequestApprovedType         = userGrantRequestEventTypePrefix + "approved"
+	UserGrantRequestDeniedType           = userGrantRequestEventTypePrefix + "denied"
+	UserGrantRequestNotificationSentType = userGrantRequestEventTypePrefix + "notification.sent"
+)
+
+func NewAddUserGrantRequestUniqueConstraint(resourceOwner, userID, projectID, projectGrantID string) *eventstore.EventUniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		UniqueUserGrantRequest,
+		fmt.Sprintf("%s:%s:%s:%s", resourceOwner, userID, projectID, projectGrantID),
+		"Errors.UserGrant.Request.AlreadyExists")
+}
+
+func NewRemoveUserGrantRequestUniqueConstraint(resourceOwner, userID, projectID, projectGrantID string) *eventstore.EventUniqueConstraint {
+	return eventstore.NewRemoveEventUniqueConstraint(
+		UniqueUserGrantRequest,
+		fmt.Sprintf("%s:%s:%s:%s", resourceOwner, userID, projectID, projectGrantID))
+}
+
+// UserGrantRequestedEvent is pushed when a user requests roles of a project.
+// The user grant only exists after the request is approved.
+type UserGrantRequestedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID         string   `json:"userId,omitempty"`
+	ProjectID      string   `json:"projectId,omitempty"`
+	ProjectGrantID string   `json:"grantId,omitempty"`
+	RoleKeys       []string `json:"roleKeys,omitempty"`
+	Reason         string   `json:"reason,omitempty"`
+}
+
+func (e *UserGrantRequestedEvent) Data() interface{} {
+	return e
+}
+
+func (e *UserGrantRequestedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{NewAddUserGrantRequestUniqueConstraint(e.Aggregate().ResourceOwner, e.UserID, e.ProjectID, e.ProjectGrantID)}
+}
+
+func NewUserGrantRequestedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	projectID,
+	projectGrantID string,
+	roleKeys []string,
+	reason string,
+) *UserGrantRequestedEvent {
+	return &UserGrantRequestedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserGrantRequestedType,
+		),
+		UserID:         userID,
+		ProjectID:      projectID,
+		ProjectGrantID: projectGrantID,
+		RoleKeys:       roleKeys,
+		Reason:         reason,
+	}
+}
+
+func UserGrantRequestedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &UserGrantRequestedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "UGRANT-Rq2lx", "unable to unmarshal user grant request")
+	}
+
+	return e, nil
+}
+
+// UserGrantRequestApprovedEvent creates the user grant of the request,
+// therefore it contains all the information of the grant like the added event.
+type UserGrantRequestApprovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID         string   `json:"userId,omitempty"`
+	ProjectID      string   `json:"projectId,omitempty"`
+	ProjectGrantID string   `json:"grantId,omitempty"`
+	RoleKeys       []string `json:"roleKeys,omitempty"`
+}
+
+func (e *UserGrantRequestApprovedEvent) Data() interface{} {
+	return e
+}
+
+func (e *UserGrantRequestApprovedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{
+		NewRemoveUserGrantRequestUniqueConstraint(e.Aggregate().ResourceOwner, e.UserID, e.ProjectID, e.ProjectGrantID),
+		NewAddUserGrantUniqueConstraint(e.Aggregate().ResourceOwner, e.UserID, e.ProjectID, e.ProjectGrantID),
+	}
+}
+
+func NewUserGrantRequestApprovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	projectID,
+	projectGrantID string,
+	roleKeys []string,
+) *UserGrantRequestApprovedEvent {
+	return &UserGrantRequestApprovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserGrantRequestApprovedType,
+		),
+		UserID:         userID,
+		ProjectID:      projectID,
+		ProjectGrantID: projectGrantID,
+		RoleKeys:       roleKeys,
+	}
+}
+
+func UserGrantRequestApprovedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &UserGrantRequestApprovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "UGRANT-Ap9wd", "unable to unmarshal user grant request")
+	}
+
+	return e, nil
+}
+
+type UserGrantRequestDeniedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Reason         string `json:"reason,omitempty"`
+	userID         string `json:"-"`
+	projectID      string `json:"-"`
+	projectGrantID string `json:"-"`
+}
+
+func (e *UserGrantRequestDeniedEvent) Data() interface{} {
+	return e
+}
+
+func (e *UserGrantRequestDeniedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{NewRemoveUserGrantRequestUniqueConstraint(e.Aggregate().ResourceOwner, e.userID, e.projectID, e.projectGrantID)}
+}
+
+func NewUserGrantRequestDeniedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	projectID,
+	projectGrantID,
+	reason string,
+) *UserGrantRequestDeniedEvent {
+	return &UserGrantRequestDeniedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserGrantRequestDeniedType,
+		),
+		Reason:         reason,
+		userID:         userID,
+		projectID:      projectID,
+		projectGrantID: projectGrantID,
+	}
+}
+
+func UserGrantRequestDeniedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &UserGrantRequestDeniedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "UGRANT-Dn4vc", "unable to unmarshal user grant request")
+	}
+
+	return e, nil
+}
+
+// UserGrantRequestNotificationSentEvent marks the notification of the requested, approved or denied state as sent
+type UserGrantRequestNotificationSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	State domain.UserGrantRequestState `json:"state,omitempty"`
+}
+
+func (e *UserGrantRequestNotificationSentEvent) Data() interface{} {
+	return e
+}
+
+func (e *UserGrantRequestNotificationSentEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewUserGrantRequestNotificationSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	state domain.UserGrantRequestState,
+) *UserGrantRequestNotificationSentEvent {
+	return &UserGrantRequestNotificationSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserGrantRequestNotificationSentType,
+		),
+		State: state,
+	}
+}
+
+func UserGrantRequestNotificationSentEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &UserGrantRequestNotificationSentEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "UGRANT-Ns7ye", "unable to unmarshal user grant request notification")
+	}
+
+	return e, nil
+}
@@ -0,0 +1,17 @@
+package userimport
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func RegisterEventMappers(es *eventstore.Eventstore) {
+	es.RegisterFilterEventMapper(AggregateType, AddedType, eventstore.GenericEventMapper[AddedEvent]).
+		RegisterFilterEventMapper(AggregateType, RowsAddedType, eventstore.GenericEventMapper[RowsAddedEvent]).
+		RegisterFilterEventMapper(AggregateType, BatchProcessedType, eventstore.GenericEventMapper[BatchProcessedEvent]).
+		RegisterFilterEventMapper(AggregateType, DoneType, eventstore.GenericEventMapper[DoneEvent])
+
+	// the rows contain personal data like emails, phones and password hashes,
+	// which must not be kept after the import
+	es.RegisterPersonalData(RowsAddedType, "rows").
+		RegisterPersonalDataErasure(DoneType)
+}
@@ -0,0 +1,217 @@
+package userimport
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	eventTypePrefix    = eventstore.EventType("user_import.")
+	AddedType          = eventTypePrefix + "added"
+	RowsAddedType      = eventTypePrefix + "rows.added"
+	BatchProcessedType = eventTypePrefix + "batch.processed"
+	DoneType           = eventTypePrefix + "done"
+
+	uniqueBatch           = "user_import_batch"
+	duplicateBatchMessage = "Errors.UserImport.BatchAlreadyProcessed"
+)
+
+// Row is a single user of an import job.
+// The JSON Lines format of the import uses the same representation.
+type Row struct {
+	Row                    uint64      `json:"row,omitempty"`
+	UserID                 string      `json:"userId,omitempty"`
+	UserName               string      `json:"userName,omitempty"`
+	FirstName              string      `json:"firstName,omitempty"`
+	LastName               string      `json:"lastName,omitempty"`
+	NickName               string      `json:"nickName,omitempty"`
+	DisplayName            string      `json:"displayName,omitempty"`
+	PreferredLanguage      string      `json:"preferredLanguage,omitempty"`
+	Email                  string      `json:"email,omitempty"`
+	EmailVerified          bool        `json:"emailVerified,omitempty"`
+	Phone                  string      `json:"phone,omitempty"`
+	PhoneVerified          bool        `json:"phoneVerified,omitempty"`
+	PasswordHash           string      `json:"passwordHash,omitempty"`
+	PasswordChangeRequired bool        `json:"passwordChangeRequired,omitempty"`
+	Metadata               []*Metadata `json:"metadata,omitempty"`
+	Grants                 []*Grant    `json:"grants,omitempty"`
+	// Error is set if the row could not be parsed
+	Error string `json:"error,omitempty"`
+}
+
+type Metadata struct {
+	Key   string `json:"key"`
+	Value []byte `json:"value"`
+}
+
+type Grant struct {
+	ProjectID      string   `json:"projectId"`
+	ProjectGrantID string   `json:"projectGrantId,omitempty"`
+	RoleKeys       []string `json:"roleKeys,omitempty"`
+}
+
+type RowResult struct {
+	Row    uint64                    `json:"row"`
+	UserID string                    `json:"userId,omitempty"`
+	State  domain.UserImportRowState `json:"state"`
+	Error  string                    `json:"error,omitempty"`
+}
+
+type AddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Format     domain.UserImportFormat `json:"format,omitempty"`
+	TotalRows  uint64                  `json:"totalRows,omitempty"`
+	BatchCount uint64                  `json:"batchCount,omitempty"`
+}
+
+func (e *AddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *AddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func (e *AddedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	format domain.UserImportFormat,
+	totalRows,
+	batchCount uint64,
+) *AddedEvent {
+	return &AddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AddedType,
+		),
+		Format:     format,
+		TotalRows:  totalRows,
+		BatchCount: batchCount,
+	}
+}
+
+// RowsAddedEvent contains the rows of a single batch of the import job.
+// The rows are registered as personal data, so they are encrypted
+// and cannot be read anymore as soon as the job is done.
+type RowsAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	// Batch is not omitted if empty, so the batches can be queried by their number
+	Batch uint64 `json:"batch"`
+	Rows  []*Row `json:"rows,omitempty"`
+}
+
+func (e *RowsAddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *RowsAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func (e *RowsAddedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewRowsAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	batch uint64,
+	rows []*Row,
+) *RowsAddedEvent {
+	return &RowsAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RowsAddedType,
+		),
+		Batch: batch,
+		Rows:  rows,
+	}
+}
+
+type BatchProcessedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Batch   uint64       `json:"batch"`
+	Results []*RowResult `json:"results,omitempty"`
+}
+
+func (e *BatchProcessedEvent) Data() interface{} {
+	return e
+}
+
+// UniqueConstraints prevents that a batch is processed multiple times,
+// e.g. by concurrent executions of the job
+func (e *BatchProcessedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{NewAddBatchUniqueConstraint(e.Aggregate().ID, e.Batch)}
+}
+
+func NewAddBatchUniqueConstraint(jobID string, batch uint64) *eventstore.EventUniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		uniqueBatch,
+		strings.Join([]string{jobID, strconv.FormatUint(batch, 10)}, ":"),
+		duplicateBatchMessage,
+	)
+}
+
+func (e *BatchProcessedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewBatchProcessedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	batch uint64,
+	results []*RowResult,
+) *BatchProcessedEvent {
+	return &BatchProcessedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			BatchProcessedType,
+		),
+		Batch:   batch,
+		Results: results,
+	}
+}
+
+// DoneEvent marks the job as done and erases the rows of the job, see [RowsAddedEvent]
+type DoneEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *DoneEvent) Data() interface{} {
+	return nil
+}
+
+func (e *DoneEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func (e *DoneEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewDoneEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *DoneEvent {
+	return &DoneEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DoneType,
+		),
+	}
+}
@@ -16,6 +16,25 @@ const (
 	SpoolerDivCounterDescription    = "Spooler div from last successful run to now in milliseconds"
 	Database                        = "database"
 	ViewName                        = "view_name"
+
+	ProjectionEventsBehind             = "zitadel.projection_events_behind"
+	ProjectionEventsBehindDescription  = "Events of an instance which are not yet processed by the projection"
+	ProjectionSecondsBehind            = "zitadel.projection_seconds_behind"
+	ProjectionSecondsBehindDescription = "Seconds between the latest event of an instance and the latest event processed by the projection"
+	ProjectionLocked                   = "zitadel.projection_locked"
+	ProjectionLockedDescription        = "1 if a ZITADEL instance currently holds the lock of the projection for an instance"
+	ProjectionLastFailure              = "zitadel.projection_last_failure_timestamp_seconds"
+	ProjectionLastFailureDescription   = "Unix timestamp of the last failed event of the projection for an instance"
+	Projection                         = "projection"
+	Instance                           = "instance"
+	Locker                             = "locker_id"
+	ErrorID                            = "error_id"
+
+	CacheHits              = "zitadel.cache_hits"
+	CacheHitsDescription   = "Results of queries which were read from the cache"
+	CacheMisses            = "zitadel.cache_misses"
+	CacheMissesDescription = "Results of queries which were not cached and read from the database"
+	CacheQuery             = "query"
 )
 
 type Metrics interface {
@@ -22,6 +22,9 @@ type TokenView struct {
 	PreferredLanguage string
 	RefreshTokenID    string
 	IsPAT             bool
+
+	AuthorizationDetails domain.AuthorizationDetails
+	LevelOfAssurance     domain.LevelOfAssurance
 }
 
 type TokenSearchRequest struct {
@@ -1,12 +1,14 @@
 package model
 
 import (
+	"database/sql/driver"
 	"encoding/json"
 	"time"
 
 	"github.com/zitadel/logging"
 
 	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
 	caos_errs "github.com/zitadel/zitadel/internal/errors"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	es_models "github.com/zitadel/zitadel/internal/eventstore/v1/models"
@@ -42,6 +44,28 @@ type TokenView struct {
 	IsPAT             bool                 `json:"-" gorm:"is_pat"`
 	Deactivated       bool                 `json:"-" gorm:"-"`
 	InstanceID        string               `json:"instanceID" gorm:"column:instance_id;primary_key"`
+
+	AuthorizationDetails AuthorizationDetails    `json:"authorizationDetails,omitempty" gorm:"column:authorization_details"`
+	LevelOfAssurance     domain.LevelOfAssurance `json:"levelOfAssurance,omitempty" gorm:"column:level_of_assurance"`
+}
+
+type AuthorizationDetails domain.AuthorizationDetails
+
+func (d AuthorizationDetails) Value() (driver.Value, error) {
+	if d == nil {
+		return nil, nil
+	}
+	return json.Marshal(&d)
+}
+
+func (d *AuthorizationDetails) Scan(src interface{}) error {
+	if b, ok := src.([]byte); ok {
+		return json.Unmarshal(b, d)
+	}
+	if s, ok := src.(string); ok {
+		return json.Unmarshal([]byte(s), d)
+	}
+	return nil
 }
 
 func TokenViewToModel(token *TokenView) *usr_model.TokenView {
@@ -60,6 +84,9 @@ func TokenViewToModel(token *TokenView) *usr_model.TokenView {
 		PreferredLanguage: token.PreferredLanguage,
 		RefreshTokenID:    token.RefreshTokenID,
 		IsPAT:             token.IsPAT,
+
+		AuthorizationDetails: domain.AuthorizationDetails(token.AuthorizationDetails),
+		LevelOfAssurance:     token.LevelOfAssurance,
 	}
 }
 
@@ -0,0 +1,78 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	es_models "github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+func TestTokenView_AppendEvent(t *testing.T) {
+	tests := []struct {
+		name  string
+		added *user.UserTokenAddedEvent
+		want  *TokenView
+	}{
+		{
+			name: "token added without level of assurance",
+			added: &user.UserTokenAddedEvent{
+				TokenID:       "tokenID",
+				ApplicationID: "appID",
+				UserAgentID:   "agentID",
+			},
+			want: &TokenView{
+				ID:            "tokenID",
+				ApplicationID: "appID",
+				UserAgentID:   "agentID",
+			},
+		},
+		{
+			name: "token added with level of assurance",
+			added: &user.UserTokenAddedEvent{
+				TokenID:              "tokenID",
+				ApplicationID:        "appID",
+				UserAgentID:          "agentID",
+				AuthorizationDetails: domain.AuthorizationDetails{{"type": "payment_initiation"}},
+				LevelOfAssurance:     domain.LevelOfAssuranceMFA,
+			},
+			want: &TokenView{
+				ID:                   "tokenID",
+				ApplicationID:        "appID",
+				UserAgentID:          "agentID",
+				AuthorizationDetails: AuthorizationDetails{{"type": "payment_initiation"}},
+				LevelOfAssurance:     domain.LevelOfAssuranceMFA,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.added)
+			require.NoError(t, err)
+			event := &es_models.Event{
+				AggregateID:   "userID",
+				ResourceOwner: "orgID",
+				InstanceID:    "instanceID",
+				CreationDate:  now(),
+				Sequence:      1,
+				Type:          es_models.EventType(user.UserTokenAddedType),
+				Data:          data,
+			}
+			tt.want.UserID = "userID"
+			tt.want.ResourceOwner = "orgID"
+			tt.want.InstanceID = "instanceID"
+			tt.want.CreationDate = now()
+			tt.want.ChangeDate = now()
+			tt.want.Sequence = 1
+
+			token := new(TokenView)
+			require.NoError(t, token.AppendEvent(event))
+			assert.Equal(t, tt.want, token)
+			assert.Equal(t, tt.want.LevelOfAssurance, TokenViewToModel(token).LevelOfAssurance)
+		})
+	}
+}